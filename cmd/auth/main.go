@@ -1,6 +1,7 @@
 package main
 
 import (
+	"database/sql"
 	"fmt"
 	"google.golang.org/grpc"
 	"log"
@@ -10,15 +11,22 @@ import (
 	"time"
 
 	_ "github.com/jackc/pgx/stdlib"
+	"github.com/jmoiron/sqlx"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"mail/cmd/configs"
+	"mail/internal/microservice/auth/notifier"
 	"mail/internal/microservice/auth/proto"
 	"mail/internal/microservice/interceptors"
 	"mail/internal/models/microservice_ports"
 	"mail/internal/pkg/utils/connect_microservice"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	auditRepo "mail/internal/microservice/audit/repository"
+	_interface "mail/internal/microservice/auth/interface"
+	authRepo "mail/internal/microservice/auth/repository"
 	grpcAuth "mail/internal/microservice/auth/server"
+	authUc "mail/internal/microservice/auth/usecase"
 	session_proto "mail/internal/microservice/session/proto"
 	user_proto "mail/internal/microservice/user/proto"
 )
@@ -40,11 +48,22 @@ func main() {
 	defer userServiceConn.Close()
 	userServiceClient := user_proto.NewUserServiceClient(userServiceConn)
 
-	authGrpc := initializeAuth(sessionServiceClient, userServiceClient)
+	db := initializeDatabase()
+	defer db.Close()
+
+	authGrpc := initializeAuth(db, sessionServiceClient, userServiceClient)
+
+	oidcGrpc := initializeOIDC(db, sessionServiceClient, userServiceClient)
+
+	gmailTokenGrpc := initializeGMailToken(db)
+
+	externalAccountGrpc := initializeExternalAccount(db)
 
 	loggerInterceptorAccess := initializationInterceptorLogger()
 
-	startServer(authGrpc, loggerInterceptorAccess)
+	auditor := initializeAuditor(db)
+
+	startServer(authGrpc, oidcGrpc, gmailTokenGrpc, externalAccountGrpc, loggerInterceptorAccess, auditor)
 }
 
 // settingTime setting local time on server
@@ -58,8 +77,77 @@ func settingTime() {
 }
 
 // initializeAuth initializing authorization server
-func initializeAuth(sessionServiceClient session_proto.SessionServiceClient, userServiceClient user_proto.UserServiceClient) *grpcAuth.AuthServer {
-	return grpcAuth.NewAuthServer(sessionServiceClient, userServiceClient)
+func initializeAuth(db *sql.DB, sessionServiceClient session_proto.SessionServiceClient, userServiceClient user_proto.UserServiceClient) *grpcAuth.AuthServer {
+	return grpcAuth.NewAuthServer(sessionServiceClient, userServiceClient, initializeLoginLimiter(db), initializeNotifier(), configs.PASSWORD_RESET_URL,
+		configs.RECOVERY_EMAIL_VERIFY_URL, []byte(loadSecret(configs.RECOVERY_EMAIL_VERIFICATION_KEY)))
+}
+
+// loadSecret loading the secret from the environment, the service doesn't start without it
+func loadSecret(name string) string {
+	secret, err := configs.Secret(name)
+	if err != nil {
+		log.Fatalln("Can't load secret", err)
+	}
+
+	return secret
+}
+
+// initializeOIDC initializing the OpenID Connect provider server
+func initializeOIDC(db *sql.DB, sessionServiceClient session_proto.SessionServiceClient, userServiceClient user_proto.UserServiceClient) *grpcAuth.OIDCServer {
+	provider := authUc.NewOIDCProvider(authRepo.NewOIDCRepository(sqlx.NewDb(db, "pgx")), configs.OIDC_ISSUER, []byte(loadSecret(configs.OIDC_CONSENT_KEY)))
+
+	return grpcAuth.NewOIDCServer(sessionServiceClient, userServiceClient, provider)
+}
+
+// initializeGMailToken initializing the storage of the Gmail OAuth tokens
+func initializeGMailToken(db *sql.DB) *grpcAuth.GMailTokenServer {
+	store := authUc.NewGMailTokenStore(authRepo.NewGMailTokenRepository(sqlx.NewDb(db, "pgx")), loadSecret(configs.GMAIL_TOKEN_KEY))
+
+	return grpcAuth.NewGMailTokenServer(store)
+}
+
+// initializeExternalAccount initializing the storage of the linked IMAP/SMTP mailboxes
+func initializeExternalAccount(db *sql.DB) *grpcAuth.ExternalAccountServer {
+	store := authUc.NewExternalAccountStore(authRepo.NewExternalAccountRepository(sqlx.NewDb(db, "pgx")), loadSecret(configs.EXTERNAL_ACCOUNT_KEY))
+
+	return grpcAuth.NewExternalAccountServer(store)
+}
+
+// initializeLoginLimiter initializing the login attempt limiter with the storage selected in the config
+func initializeLoginLimiter(db *sql.DB) _interface.LoginLimiter {
+	switch configs.LOGIN_ATTEMPT_STORE {
+	case "postgres":
+		return authUc.NewLoginLimiter(authRepo.NewLoginAttemptRepository(sqlx.NewDb(db, "pgx")))
+	default:
+		return authUc.NewLoginLimiter(authRepo.NewMemoryLoginAttemptRepository())
+	}
+}
+
+// initializeDatabase database initialization
+func initializeDatabase() *sql.DB {
+	db, err := sql.Open("pgx", configs.DSN)
+	if err != nil {
+		log.Fatalln("Can't parse config", err)
+	}
+
+	err = db.Ping()
+	if err != nil {
+		log.Fatalln("Database is not available", err)
+	}
+
+	db.SetMaxOpenConns(10)
+
+	return db
+}
+
+// initializeNotifier initializing the delivery of security messages selected in the config
+func initializeNotifier() _interface.Notifier {
+	switch configs.PASSWORD_RESET_NOTIFIER {
+	case "smtp":
+		return notifier.NewSMTPNotifier()
+	default:
+		return notifier.NewLogNotifier()
+	}
 }
 
 // initializationInterceptorLogger initializing logger
@@ -76,8 +164,18 @@ func initializationInterceptorLogger() *interceptors.Logger {
 	return loggerAccess
 }
 
+// initializeAuditor initializing the recording of the logins in the audit log
+func initializeAuditor(db *sql.DB) *interceptors.Auditor {
+	repo := auditRepo.NewSecurityEventRepository(sqlx.NewDb(db, "pgx"))
+
+	return &interceptors.Auditor{
+		Repo:  repo,
+		Rules: grpcAuth.AuditRules(repo),
+	}
+}
+
 // startServer starting server
-func startServer(authGrpc *grpcAuth.AuthServer, interceptorsLogger *interceptors.Logger) {
+func startServer(authGrpc *grpcAuth.AuthServer, oidcGrpc *grpcAuth.OIDCServer, gmailTokenGrpc *grpcAuth.GMailTokenServer, externalAccountGrpc *grpcAuth.ExternalAccountServer, interceptorsLogger *interceptors.Logger, auditor *interceptors.Auditor) {
 	listen, err := net.Listen("tcp", ":8004")
 	if err != nil {
 		log.Fatalf("Cannot listen port: %s. Err: %s", "8004", err.Error())
@@ -88,6 +186,7 @@ func startServer(authGrpc *grpcAuth.AuthServer, interceptorsLogger *interceptors
 	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			interceptorsLogger.AccessLogInterceptor,
+			auditor.AuditInterceptor,
 			interceptors.PanicRecoveryWithoutLoggerInterceptor,
 			grpc_prometheus.UnaryServerInterceptor,
 		),
@@ -96,6 +195,9 @@ func startServer(authGrpc *grpcAuth.AuthServer, interceptorsLogger *interceptors
 	grpcServer := grpc.NewServer(opts...)
 
 	proto.RegisterAuthServiceServer(grpcServer, authGrpc)
+	proto.RegisterOIDCServiceServer(grpcServer, oidcGrpc)
+	proto.RegisterGMailTokenServiceServer(grpcServer, gmailTokenGrpc)
+	proto.RegisterExternalAccountServiceServer(grpcServer, externalAccountGrpc)
 
 	fmt.Printf("The server is running in port 8004\n")
 
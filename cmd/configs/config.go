@@ -15,6 +15,28 @@ const ACCESSKEYID = "minioadmin"
 const SECRETACCESSKEY = "minioadmin"
 
 const PROTOCOL = "http://"
+
+const PASSWORD_RESET_NOTIFIER = "log"
+
+const PASSWORD_RESET_URL = "http://localhost:8080/reset-password?token="
+
+const RECOVERY_EMAIL_VERIFY_URL = "http://localhost:8080/verify-recovery-email?token="
+
+const LOGIN_ATTEMPT_STORE = "memory"
+
+const SESSION_STORE = "postgres"
+
+const REQUIRE_SECRETS = false
+
+const REDIS_ADDRESS = "localhost:6379"
+
+const OIDC_ISSUER = "http://localhost:8080"
+
+const OIDC_LOGIN_URL = "http://localhost:8080/login?next="
+
+const OIDC_CONSENT_URL = "http://localhost:8080/oidc/consent?request="
+
+const TRUSTED_PROXIES = "127.0.0.1,::1"
 */
 // FOR PROD
 
@@ -31,3 +53,25 @@ const ACCESSKEYID = "minioadmin"
 const SECRETACCESSKEY = "minioadmin"
 
 const PROTOCOL = "https://"
+
+const PASSWORD_RESET_NOTIFIER = "smtp"
+
+const PASSWORD_RESET_URL = "https://mailhub.su/reset-password?token="
+
+const RECOVERY_EMAIL_VERIFY_URL = "https://mailhub.su/verify-recovery-email?token="
+
+const LOGIN_ATTEMPT_STORE = "postgres"
+
+const SESSION_STORE = "redis"
+
+const REQUIRE_SECRETS = true
+
+const REDIS_ADDRESS = "redis:6379"
+
+const OIDC_ISSUER = "https://mailhub.su"
+
+const OIDC_LOGIN_URL = "https://mailhub.su/login?next="
+
+const OIDC_CONSENT_URL = "https://mailhub.su/oidc/consent?request="
+
+const TRUSTED_PROXIES = "127.0.0.1,::1,172.16.0.0/12"
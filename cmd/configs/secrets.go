@@ -0,0 +1,49 @@
+package configs
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// The secrets are never committed, each one is read at the start from the environment variable of its name
+// or from the file at the path in the variable with the _FILE suffix, e.g. a docker secret.
+const (
+	REDIS_PASSWORD                  = "REDIS_PASSWORD"
+	RECOVERY_EMAIL_VERIFICATION_KEY = "RECOVERY_EMAIL_VERIFICATION_KEY"
+	OIDC_CONSENT_KEY                = "OIDC_CONSENT_KEY"
+	GMAIL_TOKEN_KEY                 = "GMAIL_TOKEN_KEY"
+	EXTERNAL_ACCOUNT_KEY            = "EXTERNAL_ACCOUNT_KEY"
+	LIST_UNSUBSCRIBE_KEY            = "LIST_UNSUBSCRIBE_KEY"
+)
+
+// Env returns the value of the environment variable, or def when it is not set.
+func Env(name, def string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return def
+}
+
+// Secret returns the value of the secret. An unset secret is an error when REQUIRE_SECRETS is set,
+// otherwise the local development value, the name in kebab case, is returned.
+func Secret(name string) (string, error) {
+	if value := os.Getenv(name); value != "" {
+		return value, nil
+	}
+
+	if path := os.Getenv(name + "_FILE"); path != "" {
+		value, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret %s: %w", name, err)
+		}
+		if secret := strings.TrimSpace(string(value)); secret != "" {
+			return secret, nil
+		}
+	}
+
+	if REQUIRE_SECRETS {
+		return "", fmt.Errorf("secret %s is not set", name)
+	}
+	return strings.ToLower(strings.ReplaceAll(name, "_", "-")), nil
+}
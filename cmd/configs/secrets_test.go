@@ -0,0 +1,45 @@
+package configs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecret(t *testing.T) {
+	t.Setenv("TEST_SECRET", "from-env")
+	secret, err := Secret("TEST_SECRET")
+	assert.NoError(t, err)
+	assert.Equal(t, "from-env", secret)
+
+	path := filepath.Join(t.TempDir(), "secret")
+	assert.NoError(t, os.WriteFile(path, []byte("from-file\n"), 0600))
+	t.Setenv("TEST_SECRET", "")
+	t.Setenv("TEST_SECRET_FILE", path)
+	secret, err = Secret("TEST_SECRET")
+	assert.NoError(t, err)
+	assert.Equal(t, "from-file", secret)
+
+	t.Setenv("TEST_SECRET_FILE", filepath.Join(t.TempDir(), "missing"))
+	_, err = Secret("TEST_SECRET")
+	assert.Error(t, err)
+
+	t.Setenv("TEST_SECRET_FILE", "")
+	secret, err = Secret("TEST_SECRET")
+	if REQUIRE_SECRETS {
+		assert.Error(t, err)
+	} else {
+		assert.NoError(t, err)
+		assert.Equal(t, "test-secret", secret)
+	}
+}
+
+func TestEnv(t *testing.T) {
+	t.Setenv("TEST_ADDRESS", "")
+	assert.Equal(t, "redis:6379", Env("TEST_ADDRESS", "redis:6379"))
+
+	t.Setenv("TEST_ADDRESS", "cache:6379")
+	assert.Equal(t, "cache:6379", Env("TEST_ADDRESS", "redis:6379"))
+}
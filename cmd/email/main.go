@@ -19,9 +19,11 @@ import (
 	"mail/internal/microservice/interceptors"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	auditRepo "mail/internal/microservice/audit/repository"
 	emailRepo "mail/internal/microservice/email/repository"
 	grpcEmail "mail/internal/microservice/email/server"
 	emailUc "mail/internal/microservice/email/usecase"
+	eventsBus "mail/internal/microservice/events/bus"
 )
 
 func main() {
@@ -34,7 +36,9 @@ func main() {
 
 	loggerInterceptorAccess := initializationInterceptorLogger()
 
-	startServer(emailGrpc, loggerInterceptorAccess)
+	auditor := initializeAuditor(db, grpcEmail.AuditRules())
+
+	startServer(emailGrpc, loggerInterceptorAccess, auditor)
 }
 
 // settingTime setting local time on server
@@ -67,7 +71,12 @@ func initializeDatabase() *sql.DB {
 // initializeEmail initializing email server
 func initializeEmail(db *sql.DB) *grpcEmail.EmailServer {
 	emailRepository := emailRepo.NewEmailRepository(sqlx.NewDb(db, "pgx"))
-	emailUseCase := emailUc.NewEmailUseCase(emailRepository)
+	unsubscribeKey, err := configs.Secret(configs.LIST_UNSUBSCRIBE_KEY)
+	if err != nil {
+		log.Fatalln("Can't load secret", err)
+	}
+
+	emailUseCase := emailUc.NewEmailUseCase(emailRepository, eventsBus.NewPostgresBus(sqlx.NewDb(db, "pgx"), configs.DSN), []byte(unsubscribeKey))
 
 	return grpcEmail.NewEmailServer(emailUseCase)
 }
@@ -86,8 +95,16 @@ func initializationInterceptorLogger() *interceptors.Logger {
 	return loggerAccess
 }
 
+// initializeAuditor initializing the recording of the security events in the audit log
+func initializeAuditor(db *sql.DB, rules map[string]interceptors.AuditRule) *interceptors.Auditor {
+	return &interceptors.Auditor{
+		Repo:  auditRepo.NewSecurityEventRepository(sqlx.NewDb(db, "pgx")),
+		Rules: rules,
+	}
+}
+
 // startServer starting server
-func startServer(emailGrpc *grpcEmail.EmailServer, interceptorsLogger *interceptors.Logger) {
+func startServer(emailGrpc *grpcEmail.EmailServer, interceptorsLogger *interceptors.Logger, auditor *interceptors.Auditor) {
 	listen, err := net.Listen("tcp", ":8002")
 	if err != nil {
 		log.Fatalf("Cannot listen port: %s. Err: %s", "8002", err.Error())
@@ -98,6 +115,7 @@ func startServer(emailGrpc *grpcEmail.EmailServer, interceptorsLogger *intercept
 	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			interceptorsLogger.AccessLogInterceptor,
+			auditor.AuditInterceptor,
 			interceptors.PanicRecoveryInterceptor,
 			grpc_prometheus.UnaryServerInterceptor,
 		),
@@ -15,6 +15,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"mail/cmd/configs"
+	eventsBus "mail/internal/microservice/events/bus"
 	"mail/internal/microservice/folder/proto"
 	"mail/internal/microservice/interceptors"
 
@@ -67,7 +68,7 @@ func initializeDatabase() *sql.DB {
 // initializeFolder initializing folder server
 func initializeFolder(db *sql.DB) *grpcFolder.FolderServer {
 	folderRepository := folderRepo.NewFolderRepository(sqlx.NewDb(db, "pgx"))
-	folderUseCase := folderUc.NewFolderUseCase(folderRepository)
+	folderUseCase := folderUc.NewFolderUseCase(folderRepository, eventsBus.NewPostgresBus(sqlx.NewDb(db, "pgx"), configs.DSN))
 
 	return grpcFolder.NewFolderServer(folderUseCase)
 }
@@ -5,11 +5,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"google.golang.org/grpc/metadata"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"text/template"
 	"time"
@@ -23,6 +23,7 @@ import (
 	"github.com/rs/cors"
 
 	_ "github.com/jackc/pgx/stdlib"
+	"github.com/jmoiron/sqlx"
 
 	"mail/cmd/configs"
 	"mail/internal/models/microservice_ports"
@@ -30,6 +31,7 @@ import (
 	"mail/internal/pkg/logger"
 	"mail/internal/pkg/middleware"
 	"mail/internal/pkg/session"
+	"mail/internal/pkg/utils/client_info"
 	"mail/internal/pkg/utils/connect_microservice"
 	"mail/internal/websocket"
 
@@ -37,17 +39,27 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 	auth_proto "mail/internal/microservice/auth/proto"
 	email_proto "mail/internal/microservice/email/proto"
+	eventsBus "mail/internal/microservice/events/bus"
+	eventsInterface "mail/internal/microservice/events/interface"
 	folder_proto "mail/internal/microservice/folder/proto"
+	domain "mail/internal/microservice/models/domain_models"
 	question_proto "mail/internal/microservice/questionnaire/proto"
 	session_proto "mail/internal/microservice/session/proto"
 	user_proto "mail/internal/microservice/user/proto"
+	converters "mail/internal/models/delivery_converters"
 	authHand "mail/internal/pkg/auth/delivery/http"
 	emailHand "mail/internal/pkg/email/delivery/http"
+	externalConnector "mail/internal/pkg/external_account/connector"
+	externalHand "mail/internal/pkg/external_account/delivery/http"
 	folderHand "mail/internal/pkg/folder/delivery/http"
 	gmailAuthHand "mail/internal/pkg/gmail/gmail_auth/delivery/http"
 	gmailEmailHand "mail/internal/pkg/gmail/gmail_handler/delivery/http"
+	gmailSync "mail/internal/pkg/gmail/gmail_sync"
+	gmailToken "mail/internal/pkg/gmail/gmail_token"
 	oauthHand "mail/internal/pkg/oauth/delivery/http"
+	oidcHand "mail/internal/pkg/oidc/delivery/http"
 	questionHand "mail/internal/pkg/questionnairy/delivery/http"
+	unifiedHand "mail/internal/pkg/unified_inbox/delivery/http"
 	userHand "mail/internal/pkg/user/delivery/http"
 
 	_ "mail/docs"
@@ -68,6 +80,10 @@ func main() {
 
 	migrateDatabase(db)
 
+	if err := client_info.SetTrustedProxies(strings.Split(configs.Env("TRUSTED_PROXIES", configs.TRUSTED_PROXIES), ",")); err != nil {
+		log.Fatalf("failed to set trusted proxies: %v", err)
+	}
+
 	loggerMiddlewareAccess := initializeMiddlewareLogger()
 
 	sessionManagerServiceConn, err := connect_microservice.OpenGRPCConnection(microservice_ports.GetPorts(microservice_ports.SessionService))
@@ -75,7 +91,6 @@ func main() {
 		log.Fatalf("connection with microservice auth fail")
 	}
 	defer sessionManagerServiceConn.Close()
-	sessionsManager := initializeSessionsManager(session_proto.NewSessionServiceClient(sessionManagerServiceConn))
 
 	authServiceConn, err := connect_microservice.OpenGRPCConnection(microservice_ports.GetPorts(microservice_ports.AuthService))
 	if err != nil {
@@ -88,6 +103,7 @@ func main() {
 		log.Fatalf("connection with microservice user fail")
 	}
 	defer userServiceConn.Close()
+	sessionsManager := initializeSessionsManager(session_proto.NewSessionServiceClient(sessionManagerServiceConn), user_proto.NewUserServiceClient(userServiceConn))
 	authHandler := initializeAuthHandler(sessionsManager, auth_proto.NewAuthServiceClient(authServiceConn), user_proto.NewUserServiceClient(userServiceConn))
 	userHandler := initializeUserHandler(sessionsManager, user_proto.NewUserServiceClient(userServiceConn))
 
@@ -103,6 +119,8 @@ func main() {
 		log.Fatalf("connection with microservice user fail")
 	}
 	defer folderServiceConn.Close()
+	mailboxEvents := eventsBus.NewPostgresBus(sqlx.NewDb(db, "pgx"), configs.DSN)
+	eventsRoom, eventsStream := initializeEventsRoom(sessionsManager, mailboxEvents)
 	folderHandler := initializeFolderHandler(sessionsManager, folder_proto.NewFolderServiceClient(folderServiceConn))
 
 	questionServiceConn, err := connect_microservice.OpenGRPCConnection(microservice_ports.GetPorts(microservice_ports.QuestionService))
@@ -114,9 +132,21 @@ func main() {
 
 	oauthHandler := initializeOAuthHandler(sessionsManager, user_proto.NewUserServiceClient(userServiceConn))
 
-	oauthGMailHandler := initializeGMailAuthHandler(sessionsManager, auth_proto.NewAuthServiceClient(authServiceConn), user_proto.NewUserServiceClient(userServiceConn))
-	emailGMailHandler := initializeEmailGMailHandler(sessionsManager)
-	router := setupRouter(authHandler, oauthHandler, oauthGMailHandler, userHandler, emailHandler, folderHandler, questionHandler, emailGMailHandler, loggerMiddlewareAccess)
+	gmailTokenStore := initializeGMailTokenStore(auth_proto.NewGMailTokenServiceClient(authServiceConn))
+	oauthGMailHandler := initializeGMailAuthHandler(sessionsManager, auth_proto.NewAuthServiceClient(authServiceConn), user_proto.NewUserServiceClient(userServiceConn), gmailTokenStore)
+	gmailSyncWorker := gmailSync.NewWorker(gmailSync.NewClientFactory(gmailTokenStore), email_proto.NewEmailServiceClient(emailServiceConn))
+	gmailSyncWorker.Start(2 * time.Minute)
+	emailGMailHandler := initializeEmailGMailHandler(sessionsManager, gmailTokenStore, email_proto.NewEmailServiceClient(emailServiceConn), gmailSyncWorker, emailHandler.MinioClient)
+	oidcHandler := initializeOIDCHandler(sessionsManager, auth_proto.NewOIDCServiceClient(authServiceConn))
+	externalAccountPolicy, err := externalConnector.NewDialPolicy(configs.Env("EXTERNAL_ACCOUNT_PORTS", ""), configs.Env("EXTERNAL_ACCOUNT_ALLOWED_HOSTS", ""))
+	if err != nil {
+		log.Fatalf("failed to set external account policy: %v", err)
+	}
+	externalAccountConnector := externalConnector.NewConnector(2, 5*time.Minute, nil, externalAccountPolicy)
+	defer externalAccountConnector.Close()
+	externalAccountHandler := initializeExternalAccountHandler(sessionsManager, auth_proto.NewExternalAccountServiceClient(authServiceConn), externalAccountConnector)
+	unifiedInboxHandler := initializeUnifiedInboxHandler(sessionsManager, email_proto.NewEmailServiceClient(emailServiceConn), gmailSyncWorker)
+	router := setupRouter(authHandler, oauthHandler, oauthGMailHandler, userHandler, emailHandler, folderHandler, questionHandler, emailGMailHandler, oidcHandler, externalAccountHandler, unifiedInboxHandler, eventsRoom, eventsStream, loggerMiddlewareAccess)
 
 	startServer(router)
 }
@@ -161,12 +191,10 @@ func migrateDatabase(db *sql.DB) {
 }
 
 // initializeSessionsManager initializing session manager
-func initializeSessionsManager(sessionServiceClient session_proto.SessionServiceClient) *session.SessionsManager {
-	sessionsManager := session.NewSessionsManager(sessionServiceClient)
+func initializeSessionsManager(sessionServiceClient session_proto.SessionServiceClient, userServiceClient user_proto.UserServiceClient) *session.SessionsManager {
+	sessionsManager := session.NewSessionsManager(sessionServiceClient, userServiceClient)
 	session.InitializationGlobalSessionManager(sessionsManager)
 
-	startSessionCleaner(24*time.Hour, sessionServiceClient)
-
 	return sessionsManager
 }
 
@@ -187,6 +215,14 @@ func initializeOAuthHandler(sessionsManager *session.SessionsManager, userServic
 	}
 }
 
+// initializeOIDCHandler initializing OpenID Connect provider handler
+func initializeOIDCHandler(sessionsManager *session.SessionsManager, oidcServiceClient auth_proto.OIDCServiceClient) *oidcHand.OIDCHandler {
+	return &oidcHand.OIDCHandler{
+		Sessions:          sessionsManager,
+		OIDCServiceClient: oidcServiceClient,
+	}
+}
+
 // initializeEmailHandler initializing email handler
 func initializeEmailHandler(sessionsManager *session.SessionsManager, emailServiceClient email_proto.EmailServiceClient) *emailHand.EmailHandler {
 	minioClient, err := minio.New(configs.ENDPOINT, &minio.Options{
@@ -271,19 +307,48 @@ func initializeUserHandler(sessionsManager *session.SessionsManager, userService
 	}
 }
 
+// initializeGMailTokenStore initializes the storage of the GMail OAuth tokens kept by the auth service
+func initializeGMailTokenStore(gmailTokenServiceClient auth_proto.GMailTokenServiceClient) *gmailToken.Store {
+	return gmailToken.NewStore(gmailTokenServiceClient, "cmd/configs/credentials_localhost.json")
+}
+
 // initializeGMailAuthHandler initializes the GMail authentication handler
-func initializeGMailAuthHandler(sessionsManager *session.SessionsManager, authServiceClient auth_proto.AuthServiceClient, userServiceClient user_proto.UserServiceClient) *gmailAuthHand.GMailAuthHandler {
+func initializeGMailAuthHandler(sessionsManager *session.SessionsManager, authServiceClient auth_proto.AuthServiceClient, userServiceClient user_proto.UserServiceClient, gmailTokenStore *gmailToken.Store) *gmailAuthHand.GMailAuthHandler {
 	return &gmailAuthHand.GMailAuthHandler{
 		Sessions:          sessionsManager,
 		AuthServiceClient: authServiceClient,
 		UserServiceClient: userServiceClient,
+		GMailTokens:       gmailTokenStore,
 	}
 }
 
 // initializeEmailGMailHandler initializes the GMail email handler using
-func initializeEmailGMailHandler(sessionsManager *session.SessionsManager) *gmailEmailHand.GMailEmailHandler {
+// the local copies of the Gmail mailboxes kept by the email service and the files bucket for the attachments
+func initializeEmailGMailHandler(sessionsManager *session.SessionsManager, gmailTokenStore *gmailToken.Store, emailServiceClient email_proto.EmailServiceClient, gmailSyncWorker *gmailSync.Worker, minioClient *minio.Client) *gmailEmailHand.GMailEmailHandler {
 	return &gmailEmailHand.GMailEmailHandler{
-		Sessions: sessionsManager,
+		Sessions:           sessionsManager,
+		GMailTokens:        gmailTokenStore,
+		EmailServiceClient: emailServiceClient,
+		GMailSync:          gmailSyncWorker,
+		MinioClient:        minioClient,
+	}
+}
+
+// initializeExternalAccountHandler initializes the handler of the linked IMAP/SMTP mailboxes
+func initializeExternalAccountHandler(sessionsManager *session.SessionsManager, externalAccountServiceClient auth_proto.ExternalAccountServiceClient, connector *externalConnector.Connector) *externalHand.ExternalAccountHandler {
+	return &externalHand.ExternalAccountHandler{
+		Sessions:                     sessionsManager,
+		ExternalAccountServiceClient: externalAccountServiceClient,
+		Connector:                    connector,
+	}
+}
+
+// initializeUnifiedInboxHandler initializes the handler of the unified inbox of the MailHub and Gmail mailboxes
+func initializeUnifiedInboxHandler(sessionsManager *session.SessionsManager, emailServiceClient email_proto.EmailServiceClient, gmailSyncWorker *gmailSync.Worker) *unifiedHand.UnifiedInboxHandler {
+	return &unifiedHand.UnifiedInboxHandler{
+		Sessions:           sessionsManager,
+		EmailServiceClient: emailServiceClient,
+		GMailSync:          gmailSyncWorker,
 	}
 }
 
@@ -300,6 +365,25 @@ func initializeFolderHandler(sessionsManager *session.SessionsManager, folderSer
 	}
 }
 
+// initializeEventsRoom initializing the websocket room the events of the bus are delivered to,
+// it returns the websocket handler and the server-sent events handler of the room
+func initializeEventsRoom(sessionsManager *session.SessionsManager, events eventsInterface.EventsBus) (http.Handler, http.Handler) {
+	room := websocket.NewRoom(sessionsManager)
+	go room.Run()
+
+	go func() {
+		err := events.Subscribe(context.Background(), func(login string, event *domain.MailboxEvent) {
+			if event.Type == domain.MailboxEventSessionRevoked {
+				sessionsManager.ForgetSessions(event.SessionIDs)
+			}
+			room.Deliver(login, converters.MailboxEventConvertCoreInApi(event))
+		}, room.Resync)
+		log.Printf("events bus subscription stopped: %v", err)
+	}()
+
+	return room, room.SSE()
+}
+
 // initializeQuestionHandler initializing question handler
 func initializeQuestionHandler(sessionsManager *session.SessionsManager, questionServiceClient question_proto.QuestionServiceClient) *questionHand.QuestionHandler {
 	return &questionHand.QuestionHandler{
@@ -323,7 +407,7 @@ func initializeMiddlewareLogger() *middleware.Logger {
 }
 
 // setupRouter configuring routers
-func setupRouter(authHandler *authHand.AuthHandler, oauthHandler *oauthHand.OAuthHandler, oauthGMailHandler *gmailAuthHand.GMailAuthHandler, userHandler *userHand.UserHandler, emailHandler *emailHand.EmailHandler, folderHandler *folderHand.FolderHandler, questionHandler *questionHand.QuestionHandler, emailGMailHandler *gmailEmailHand.GMailEmailHandler, logger *middleware.Logger) http.Handler {
+func setupRouter(authHandler *authHand.AuthHandler, oauthHandler *oauthHand.OAuthHandler, oauthGMailHandler *gmailAuthHand.GMailAuthHandler, userHandler *userHand.UserHandler, emailHandler *emailHand.EmailHandler, folderHandler *folderHand.FolderHandler, questionHandler *questionHand.QuestionHandler, emailGMailHandler *gmailEmailHand.GMailEmailHandler, oidcHandler *oidcHand.OIDCHandler, externalAccountHandler *externalHand.ExternalAccountHandler, unifiedInboxHandler *unifiedHand.UnifiedInboxHandler, eventsRoom http.Handler, eventsStream http.Handler, logger *middleware.Logger) http.Handler {
 	router := mux.NewRouter()
 
 	router.HandleFunc("/api/v1/testAuth/auth-vk/getAuthUrlSignUpVK", oauthHandler.GetSignUpURLVK).Methods("GET", "OPTIONS")
@@ -332,10 +416,17 @@ func setupRouter(authHandler *authHand.AuthHandler, oauthHandler *oauthHand.OAut
 	router.HandleFunc("/api/v1/testAuth/auth-vk/loginVK/{code}", oauthHandler.LoginVK).Methods("GET", "OPTIONS")
 	router.HandleFunc("/api/v1/testAuth/auth-vk/signupVK", oauthHandler.SignupVK).Methods("POST", "OPTIONS")
 
-	auth := setupAuthRouter(authHandler, oauthHandler, oauthGMailHandler, emailHandler, logger)
+	// The one-click unsubscribe links of the mailing lists are signed for the member and work without a session.
+	router.HandleFunc("/api/v1/list/unsubscribe/{token}", emailHandler.OneClickUnsubscribeMailingList).Methods("POST", "OPTIONS")
+
+	auth := setupAuthRouter(authHandler, oauthHandler, oauthGMailHandler, emailHandler, eventsRoom, eventsStream, logger)
 	router.PathPrefix("/api/v1/auth").Handler(auth)
 
-	logRouter := setupLogRouter(emailHandler, userHandler, folderHandler, questionHandler, emailGMailHandler, logger)
+	oidc := setupOIDCRouter(oidcHandler, logger)
+	router.PathPrefix("/api/v1/oidc").Handler(oidc)
+	router.Handle("/.well-known/openid-configuration", oidc).Methods("GET", "OPTIONS")
+
+	logRouter := setupLogRouter(authHandler, emailHandler, userHandler, folderHandler, questionHandler, emailGMailHandler, oidcHandler, externalAccountHandler, unifiedInboxHandler, logger)
 	router.PathPrefix("/api/v1").Handler(logRouter)
 
 	staticDir := "/media/"
@@ -350,17 +441,21 @@ func setupRouter(authHandler *authHand.AuthHandler, oauthHandler *oauthHand.OAut
 }
 
 // setupAuthRouter configuring authorization router
-func setupAuthRouter(authHandler *authHand.AuthHandler, oauthHandler *oauthHand.OAuthHandler, oauthGMailHandler *gmailAuthHand.GMailAuthHandler, emailHandler *emailHand.EmailHandler, logger *middleware.Logger) http.Handler {
+func setupAuthRouter(authHandler *authHand.AuthHandler, oauthHandler *oauthHand.OAuthHandler, oauthGMailHandler *gmailAuthHand.GMailAuthHandler, emailHandler *emailHand.EmailHandler, eventsRoom http.Handler, eventsStream http.Handler, logger *middleware.Logger) http.Handler {
 	auth := mux.NewRouter().PathPrefix("/api/v1/auth").Subrouter()
 	auth.Use(logger.AccessLogMiddleware, middleware.PanicMiddleware)
 
-	r := websocket.NewRoom()
-	auth.Handle("/web/websocket_connection/{login}", r)
-	go r.Run()
+	auth.Handle("/web/websocket_connection", eventsRoom)
+	auth.Handle("/web/websocket_connection/{login}", eventsRoom)
+	auth.Handle("/web/events", eventsStream).Methods("GET")
 
 	auth.HandleFunc("/login", authHandler.Login).Methods("POST", "OPTIONS")
+	auth.HandleFunc("/login/2fa", authHandler.LoginTwoFactor).Methods("POST", "OPTIONS")
 	auth.HandleFunc("/signup", authHandler.Signup).Methods("POST", "OPTIONS")
 	auth.HandleFunc("/logout", authHandler.Logout).Methods("POST", "OPTIONS")
+	auth.HandleFunc("/password/reset/request", authHandler.RequestPasswordReset).Methods("POST", "OPTIONS")
+	auth.HandleFunc("/password/reset", authHandler.ResetPassword).Methods("POST", "OPTIONS")
+	auth.HandleFunc("/recovery-email/verify", authHandler.VerifyRecoveryEmail).Methods("POST", "OPTIONS")
 	auth.HandleFunc("/sendOther", emailHandler.SendFromAnotherDomain).Methods("POST", "OPTIONS")
 	auth.HandleFunc("/addFileOther", emailHandler.AddFileFromAnotherDomain).Methods("POST", "OPTIONS")
 	auth.HandleFunc("/addFileToEmailOther/{id}/file/{file-id}", emailHandler.AddFileToEmailFromAnotherDomain).Methods("POST", "OPTIONS")
@@ -372,6 +467,20 @@ func setupAuthRouter(authHandler *authHand.AuthHandler, oauthHandler *oauthHand.
 	return auth
 }
 
+// setupOIDCRouter configuring the router of the OpenID Connect provider, its endpoints are called by other applications
+func setupOIDCRouter(oidcHandler *oidcHand.OIDCHandler, logger *middleware.Logger) http.Handler {
+	oidc := mux.NewRouter()
+	oidc.Use(logger.AccessLogMiddleware, middleware.PanicMiddleware)
+
+	oidc.HandleFunc("/.well-known/openid-configuration", oidcHandler.Discovery).Methods("GET", "OPTIONS")
+	oidc.HandleFunc("/api/v1/oidc/jwks", oidcHandler.Keys).Methods("GET", "OPTIONS")
+	oidc.HandleFunc("/api/v1/oidc/authorize", oidcHandler.Authorize).Methods("GET", "OPTIONS")
+	oidc.HandleFunc("/api/v1/oidc/token", oidcHandler.Token).Methods("POST", "OPTIONS")
+	oidc.HandleFunc("/api/v1/oidc/userinfo", oidcHandler.UserInfo).Methods("GET", "POST", "OPTIONS")
+
+	return oidc
+}
+
 // templateHandler represents a single template
 type templateHandler struct {
 	once     sync.Once
@@ -390,7 +499,7 @@ func (t *templateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 // setupLogRouter configuring router with logger
-func setupLogRouter(emailHandler *emailHand.EmailHandler, userHandler *userHand.UserHandler, folderHandler *folderHand.FolderHandler, questionHandler *questionHand.QuestionHandler, emailGMailHandler *gmailEmailHand.GMailEmailHandler, logger *middleware.Logger) http.Handler {
+func setupLogRouter(authHandler *authHand.AuthHandler, emailHandler *emailHand.EmailHandler, userHandler *userHand.UserHandler, folderHandler *folderHand.FolderHandler, questionHandler *questionHand.QuestionHandler, emailGMailHandler *gmailEmailHand.GMailEmailHandler, oidcHandler *oidcHand.OIDCHandler, externalAccountHandler *externalHand.ExternalAccountHandler, unifiedInboxHandler *unifiedHand.UnifiedInboxHandler, logger *middleware.Logger) http.Handler {
 	logRouter := mux.NewRouter().PathPrefix("/api/v1").Subrouter()
 	logRouter.Use(logger.AccessLogMiddleware, middleware.PanicMiddleware, middleware.AuthMiddleware)
 
@@ -401,12 +510,31 @@ func setupLogRouter(emailHandler *emailHand.EmailHandler, userHandler *userHand.
 	logRouter.HandleFunc("/user/avatar/upload", userHandler.UploadUserAvatar).Methods("POST", "OPTIONS")
 	logRouter.HandleFunc("/user/avatar/delete", userHandler.DeleteUserAvatar).Methods("DELETE", "OPTIONS")
 	logRouter.HandleFunc("/user/count", userHandler.GetCountUsers).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/user/2fa", userHandler.GetTwoFactorStatus).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/user/2fa/setup", userHandler.BeginTwoFactorSetup).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/user/2fa/confirm", userHandler.ConfirmTwoFactorSetup).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/user/2fa/disable", userHandler.DisableTwoFactor).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/user/sessions", userHandler.GetActiveSessions).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/user/session/delete/{id}", userHandler.DeleteActiveSession).Methods("DELETE", "OPTIONS")
+	logRouter.HandleFunc("/user/sessions/delete-others", userHandler.DeleteOtherSessions).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/user/password", authHandler.ChangePassword).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/user/recovery-email", userHandler.GetRecoveryEmail).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/user/recovery-email", authHandler.SetRecoveryEmail).Methods("PUT", "OPTIONS")
+	logRouter.HandleFunc("/user/recovery-email/verify/send", authHandler.SendRecoveryEmailVerification).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/user/tokens", userHandler.GetAPITokens).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/user/token/create", userHandler.CreateAPIToken).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/user/token/delete/{id}", userHandler.DeleteAPIToken).Methods("DELETE", "OPTIONS")
+	logRouter.HandleFunc("/user/security-events", userHandler.GetSecurityEvents).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/user/oidc/consent", oidcHandler.GetConsent).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/user/oidc/consent", oidcHandler.GrantConsent).Methods("POST", "OPTIONS")
 
 	logRouter.HandleFunc("/emails/incoming", emailHandler.Incoming).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/emails/sent", emailHandler.Sent).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/emails/draft", emailHandler.Draft).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/emails/spam", emailHandler.Spam).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/emails/bulk", emailHandler.Bulk).Methods("POST", "OPTIONS")
 	logRouter.HandleFunc("/email/{id}", emailHandler.GetByID).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/email/{id}/raw", emailHandler.GetRawByID).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/email/update/{id}", emailHandler.Update).Methods("PUT", "OPTIONS")
 	logRouter.HandleFunc("/email/delete/{id}", emailHandler.Delete).Methods("DELETE", "OPTIONS")
 	logRouter.HandleFunc("/email/send", emailHandler.Send).Methods("POST", "OPTIONS")
@@ -421,6 +549,33 @@ func setupLogRouter(emailHandler *emailHand.EmailHandler, userHandler *userHand.
 	logRouter.HandleFunc("/email/delete/file/{id}", emailHandler.DeleteFileByID).Methods("DELETE", "OPTIONS")
 	logRouter.HandleFunc("/email/update/file/{id}", emailHandler.UpdateFileByID).Methods("PUT", "OPTIONS")
 
+	logRouter.HandleFunc("/lists", emailHandler.GetMailingLists).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/list/create", emailHandler.CreateMailingList).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/list/{id}", emailHandler.GetMailingList).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/list/update/{id}", emailHandler.UpdateMailingList).Methods("PUT", "OPTIONS")
+	logRouter.HandleFunc("/list/delete/{id}", emailHandler.DeleteMailingList).Methods("DELETE", "OPTIONS")
+	logRouter.HandleFunc("/list/{id}/members", emailHandler.GetMailingListMembers).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/list/{id}/member/add", emailHandler.AddMailingListMember).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/list/{id}/member/delete", emailHandler.DeleteMailingListMember).Methods("DELETE", "OPTIONS")
+	logRouter.HandleFunc("/list/{id}/unsubscribe", emailHandler.UnsubscribeMailingList).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/list/{id}/moderation", emailHandler.GetMailingListModeration).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/list/{id}/moderate", emailHandler.ModerateMailingListEmail).Methods("POST", "OPTIONS")
+
+	logRouter.HandleFunc("/labels", emailHandler.GetLabels).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/labels/email/{id}", emailHandler.GetEmailLabels).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/label/{name}/emails", emailHandler.GetAllEmailsInLabel).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/label/create", emailHandler.CreateLabel).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/label/delete/{id}", emailHandler.DeleteLabel).Methods("DELETE", "OPTIONS")
+	logRouter.HandleFunc("/label/update/{id}", emailHandler.UpdateLabel).Methods("PUT", "OPTIONS")
+	logRouter.HandleFunc("/label/add_email", emailHandler.AddEmailInLabel).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/label/delete_email", emailHandler.DeleteEmailInLabel).Methods("DELETE", "OPTIONS")
+
+	logRouter.HandleFunc("/mailboxes/delegated", emailHandler.GetDelegatedMailboxes).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/mailbox/{mailbox}/delegates", emailHandler.GetMailboxDelegates).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/mailbox/{mailbox}/actions", emailHandler.GetDelegateActions).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/mailbox/delegate/add", emailHandler.AddMailboxDelegate).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/mailbox/delegate/delete", emailHandler.DeleteMailboxDelegate).Methods("DELETE", "OPTIONS")
+
 	logRouter.HandleFunc("/questions", questionHandler.GetAllQuestions).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/questions", questionHandler.AddQuestion).Methods("POST", "OPTIONS")
 	logRouter.HandleFunc("/answers", questionHandler.AddAnswer).Methods("POST", "OPTIONS")
@@ -432,6 +587,7 @@ func setupLogRouter(emailHandler *emailHand.EmailHandler, userHandler *userHand.
 	logRouter.HandleFunc("/folder/update/{id}", folderHandler.Update).Methods("PUT", "OPTIONS")
 	logRouter.HandleFunc("/folder/add_email", folderHandler.AddEmailInFolder).Methods("POST", "OPTIONS")
 	logRouter.HandleFunc("/folder/delete_email", folderHandler.DeleteEmailInFolder).Methods("DELETE", "OPTIONS")
+	logRouter.HandleFunc("/folder/move_email", folderHandler.MoveEmail).Methods("POST", "OPTIONS")
 	logRouter.HandleFunc("/folder/all_emails/{id}", folderHandler.GetAllEmailsInFolder).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/folder/allname/{id}", folderHandler.GetAllName).Methods("GET", "OPTIONS")
 
@@ -439,6 +595,7 @@ func setupLogRouter(emailHandler *emailHand.EmailHandler, userHandler *userHand.
 	logRouter.HandleFunc("/gmail/emails/sent", emailGMailHandler.GetSent).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/gmail/emails/spam", emailGMailHandler.GetSpam).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/gmail/email/{id}", emailGMailHandler.GetById).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/gmail/email/{id}/attachment/{part}", emailGMailHandler.GetAttachment).Methods("GET", "OPTIONS")
 	logRouter.HandleFunc("/gmail/email/update/{id}", emailGMailHandler.Update).Methods("PUT", "OPTIONS")
 	logRouter.HandleFunc("/gmail/email/delete/{id}", emailGMailHandler.Delete).Methods("DELETE", "OPTIONS")
 	logRouter.HandleFunc("/gmail/email/send", emailGMailHandler.Send).Methods("POST", "OPTIONS")
@@ -459,6 +616,19 @@ func setupLogRouter(emailHandler *emailHand.EmailHandler, userHandler *userHand.
 	logRouter.HandleFunc("/gmail/label/add_email", emailGMailHandler.AddEmailInLabel).Methods("POST", "OPTIONS")
 	logRouter.HandleFunc("/gmail/label/delete_email", emailGMailHandler.DeleteEmailInLabel).Methods("DELETE", "OPTIONS")
 
+	logRouter.HandleFunc("/external/accounts", externalAccountHandler.GetAccounts).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/external/account/link", externalAccountHandler.LinkAccount).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/external/account/delete/{id}", externalAccountHandler.UnlinkAccount).Methods("DELETE", "OPTIONS")
+	logRouter.HandleFunc("/external/{account:[0-9]+}/emails/incoming", externalAccountHandler.GetIncoming).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/external/{account:[0-9]+}/emails/sent", externalAccountHandler.GetSent).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/external/{account:[0-9]+}/email/send", externalAccountHandler.Send).Methods("POST", "OPTIONS")
+	logRouter.HandleFunc("/external/{account:[0-9]+}/drafts", externalAccountHandler.GetDrafts).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/external/{account:[0-9]+}/folders", externalAccountHandler.GetFolders).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/external/{account:[0-9]+}/folder/{name:.+}/emails", externalAccountHandler.GetFolderEmails).Methods("GET", "OPTIONS")
+
+	logRouter.HandleFunc("/unified/emails/incoming", unifiedInboxHandler.GetIncoming).Methods("GET", "OPTIONS")
+	logRouter.HandleFunc("/unified/email/action", unifiedInboxHandler.Action).Methods("POST", "OPTIONS")
+
 	return logRouter
 }
 
@@ -471,7 +641,7 @@ func startServer(router http.Handler) {
 			"https://127.0.0.1", "https://89.208.223.140", "https://mailhub.su", "https://mailhub.su", "https://localhost", "https://localhost", "https://89.208.223.140"},
 		AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodPut, http.MethodOptions},
 		AllowCredentials: true,
-		AllowedHeaders:   []string{"X-Csrf-Token", "Content-Type", "AuthToken"},
+		AllowedHeaders:   []string{"X-Csrf-Token", "Content-Type", "AuthToken", "Authorization"},
 		ExposedHeaders:   []string{"X-Csrf-Token", "AuthToken"},
 	})
 
@@ -488,34 +658,3 @@ func startServer(router http.Handler) {
 		fmt.Println("Error when starting the server:", err)
 	}
 }
-
-// startSessionCleaner starting session cleanup
-func startSessionCleaner(interval time.Duration, sessionServiceClient session_proto.SessionServiceClient) {
-	ticker := time.NewTicker(interval)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				f, err := os.OpenFile("log.txt", os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
-				if err != nil {
-					fmt.Println("Failed to create logfile" + "log.txt")
-				}
-				defer f.Close()
-
-				c := context.WithValue(context.Background(), "logger", logger.InitializationBdLog(f))
-				ctx := context.WithValue(c, "requestID", "DeleteExpiredSessionsNULL")
-
-				req, err := sessionServiceClient.CleanupExpiredSessions(
-					metadata.NewOutgoingContext(ctx,
-						metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
-					&session_proto.CleanupExpiredSessionsRequest{},
-				)
-				if err != nil {
-					fmt.Printf("Error cleaning expired sessions: %v\n", err)
-					return
-				}
-				fmt.Println(req)
-			}
-		}
-	}()
-}
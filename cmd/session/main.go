@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"google.golang.org/grpc"
@@ -13,12 +14,19 @@ import (
 	_ "github.com/jackc/pgx/stdlib"
 	"github.com/jmoiron/sqlx"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 
 	"mail/cmd/configs"
 	"mail/internal/microservice/interceptors"
+	domain "mail/internal/microservice/models/domain_models"
+	sessionInterface "mail/internal/microservice/session/interface"
 	"mail/internal/microservice/session/proto"
+	"mail/internal/pkg/logger"
+	"mail/internal/pkg/utils/constants"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	auditRepo "mail/internal/microservice/audit/repository"
+	eventsBus "mail/internal/microservice/events/bus"
 	sessionRepo "mail/internal/microservice/session/repository"
 	grpcSession "mail/internal/microservice/session/server"
 	sessionUc "mail/internal/microservice/session/usecase"
@@ -30,11 +38,15 @@ func main() {
 	db := initializeDatabase()
 	defer db.Close()
 
-	sessionGrpc := initializeSession(db)
+	sessionGrpc, sessionUseCase := initializeSession(db)
+
+	startSessionCleaner(domain.SessionCleanupInterval, sessionUseCase)
 
 	loggerInterceptorAccess := initializationInterceptorLogger()
 
-	startServer(sessionGrpc, loggerInterceptorAccess)
+	auditor := initializeAuditor(db, grpcSession.AuditRules(sessionUseCase))
+
+	startServer(sessionGrpc, loggerInterceptorAccess, auditor)
 }
 
 // settingTime setting local time on server
@@ -65,11 +77,56 @@ func initializeDatabase() *sql.DB {
 }
 
 // initializeSession initializing session server
-func initializeSession(db *sql.DB) *grpcSession.SessionServer {
-	sessionRepository := sessionRepo.NewSessionRepository(sqlx.NewDb(db, "pgx"))
-	sessionUseCase := sessionUc.NewSessionUseCase(sessionRepository)
+func initializeSession(db *sql.DB) (*grpcSession.SessionServer, sessionInterface.SessionUseCase) {
+	sessionUseCase := sessionUc.NewSessionUseCase(initializeSessionRepository(db), eventsBus.NewPostgresBus(sqlx.NewDb(db, "pgx"), configs.DSN))
+
+	return grpcSession.NewSessionServer(sessionUseCase), sessionUseCase
+}
+
+// initializeSessionRepository initializing the session storage selected in the config
+func initializeSessionRepository(db *sql.DB) sessionInterface.SessionRepository {
+	switch configs.SESSION_STORE {
+	case "redis":
+		password, err := configs.Secret(configs.REDIS_PASSWORD)
+		if err != nil {
+			log.Fatalln("Can't load Redis password", err)
+		}
+
+		client := redis.NewClient(&redis.Options{Addr: configs.Env("REDIS_ADDRESS", configs.REDIS_ADDRESS), Password: password})
+		if err := client.Ping(context.Background()).Err(); err != nil {
+			log.Fatalln("Redis is not available", err)
+		}
+
+		return sessionRepo.NewSessionRedisRepository(client, sqlx.NewDb(db, "pgx"))
+	default:
+		return sessionRepo.NewSessionRepository(sqlx.NewDb(db, "pgx"))
+	}
+}
+
+// startSessionCleaner starting periodic removal of expired sessions,
+// the log file is opened once and shared by all the cleanups
+func startSessionCleaner(interval time.Duration, sessionUseCase sessionInterface.SessionUseCase) {
+	f, err := os.OpenFile("log.txt", os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		log.Fatalln("Failed to create logfile log.txt", err)
+	}
+
+	ctxWithLogger := context.WithValue(context.Background(), interface{}(string(constants.LoggerKey)), logger.InitializationBdLog(f))
+	ctx := context.WithValue(ctxWithLogger, interface{}(string(constants.RequestIDKey)), []string{"SessionCleaner"})
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			cleanupExpiredSessions(ctx, sessionUseCase)
+		}
+	}()
+}
 
-	return grpcSession.NewSessionServer(sessionUseCase)
+// cleanupExpiredSessions removes expired sessions once
+func cleanupExpiredSessions(ctx context.Context, sessionUseCase sessionInterface.SessionUseCase) {
+	if err := sessionUseCase.CleanupExpiredSessions(ctx); err != nil {
+		log.Printf("Error cleaning expired sessions: %v\n", err)
+	}
 }
 
 // initializationInterceptorLogger initializing logger
@@ -86,8 +143,16 @@ func initializationInterceptorLogger() *interceptors.Logger {
 	return loggerAccess
 }
 
+// initializeAuditor initializing the recording of the security events in the audit log
+func initializeAuditor(db *sql.DB, rules map[string]interceptors.AuditRule) *interceptors.Auditor {
+	return &interceptors.Auditor{
+		Repo:  auditRepo.NewSecurityEventRepository(sqlx.NewDb(db, "pgx")),
+		Rules: rules,
+	}
+}
+
 // startServer starting server
-func startServer(sessionGrpc *grpcSession.SessionServer, interceptorsLogger *interceptors.Logger) {
+func startServer(sessionGrpc *grpcSession.SessionServer, interceptorsLogger *interceptors.Logger, auditor *interceptors.Auditor) {
 	listen, err := net.Listen("tcp", ":8003")
 	if err != nil {
 		log.Fatalf("Cannot listen port: %s. Err: %s", "8003", err.Error())
@@ -98,6 +163,7 @@ func startServer(sessionGrpc *grpcSession.SessionServer, interceptorsLogger *int
 	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			interceptorsLogger.AccessLogInterceptor,
+			auditor.AuditInterceptor,
 			interceptors.PanicRecoveryInterceptor,
 			grpc_prometheus.UnaryServerInterceptor,
 		),
@@ -19,6 +19,7 @@ import (
 	"mail/internal/microservice/user/proto"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	auditRepo "mail/internal/microservice/audit/repository"
 	userRepo "mail/internal/microservice/user/repository"
 	grpcUser "mail/internal/microservice/user/server"
 	userUc "mail/internal/microservice/user/usecase"
@@ -34,7 +35,9 @@ func main() {
 
 	loggerInterceptorAccess := initializationInterceptorLogger()
 
-	startServer(userGrpc, loggerInterceptorAccess)
+	auditor := initializeAuditor(db, grpcUser.AuditRules())
+
+	startServer(userGrpc, loggerInterceptorAccess, auditor)
 }
 
 // settingTime setting local time on server
@@ -86,8 +89,16 @@ func initializationInterceptorLogger() *interceptors.Logger {
 	return loggerAccess
 }
 
+// initializeAuditor initializing the recording of the security events in the audit log
+func initializeAuditor(db *sql.DB, rules map[string]interceptors.AuditRule) *interceptors.Auditor {
+	return &interceptors.Auditor{
+		Repo:  auditRepo.NewSecurityEventRepository(sqlx.NewDb(db, "pgx")),
+		Rules: rules,
+	}
+}
+
 // startServer starting server
-func startServer(userGrpc *grpcUser.UserServer, interceptorsLogger *interceptors.Logger) {
+func startServer(userGrpc *grpcUser.UserServer, interceptorsLogger *interceptors.Logger, auditor *interceptors.Auditor) {
 	listen, err := net.Listen("tcp", ":8001")
 	if err != nil {
 		log.Fatalf("Cannot listen port: %s. Err: %s", "8001", err.Error())
@@ -98,6 +109,7 @@ func startServer(userGrpc *grpcUser.UserServer, interceptorsLogger *interceptors
 	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			interceptorsLogger.AccessLogInterceptor,
+			auditor.AuditInterceptor,
 			interceptors.PanicRecoveryInterceptor,
 			grpc_prometheus.UnaryServerInterceptor,
 		),
@@ -0,0 +1,35 @@
+-- +migrate Up
+-- Создание таблицы списков рассылки (mailing_list)
+CREATE TABLE IF NOT EXISTS mailing_list (
+    id INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    address TEXT NOT NULL UNIQUE CHECK (LENGTH(address) <= 50),
+    name TEXT NOT NULL CHECK (LENGTH(name) <= 100),
+    description TEXT CHECK (LENGTH(description) <= 300),
+    post_policy TEXT NOT NULL DEFAULT 'members' CHECK (post_policy = 'anyone' OR post_policy = 'members' OR post_policy = 'owners'),
+    is_moderated BOOLEAN NOT NULL DEFAULT FALSE,
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Создание таблицы участников списка рассылки (mailing_list_member)
+CREATE TABLE IF NOT EXISTS mailing_list_member (
+    list_id INTEGER,
+    profile_id INTEGER,
+    role TEXT NOT NULL DEFAULT 'member' CHECK (role = 'owner' OR role = 'member'),
+    PRIMARY KEY ( list_id, profile_id ),
+    CONSTRAINT fk_list FOREIGN KEY (list_id) REFERENCES mailing_list(id) ON DELETE CASCADE,
+    CONSTRAINT fk_profile FOREIGN KEY (profile_id) REFERENCES profile(id) ON DELETE CASCADE
+);
+
+-- Создание таблицы писем, ожидающих модерации (mailing_list_moderation)
+CREATE TABLE IF NOT EXISTS mailing_list_moderation (
+    list_id INTEGER,
+    email_id INTEGER,
+    PRIMARY KEY ( list_id, email_id ),
+    CONSTRAINT fk_list FOREIGN KEY (list_id) REFERENCES mailing_list(id) ON DELETE CASCADE,
+    CONSTRAINT fk_email FOREIGN KEY (email_id) REFERENCES email(id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS mailing_list_moderation;
+DROP TABLE IF EXISTS mailing_list_member;
+DROP TABLE IF EXISTS mailing_list;
@@ -0,0 +1,10 @@
+-- +migrate Up
+-- Вложенные папки: ссылка на родительскую папку (folder.parent_id)
+ALTER TABLE folder ADD COLUMN IF NOT EXISTS parent_id INTEGER REFERENCES folder(id) ON DELETE CASCADE;
+
+-- Уникальность пути папки: имя не повторяется среди папок одного родителя
+CREATE UNIQUE INDEX IF NOT EXISTS folder_path_unique_idx ON folder (profile_id, COALESCE(parent_id, 0), name);
+
+-- +migrate Down
+DROP INDEX IF EXISTS folder_path_unique_idx;
+ALTER TABLE folder DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- Создание таблицы меток (label)
+CREATE TABLE IF NOT EXISTS label (
+    id INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    name TEXT NOT NULL CHECK (LENGTH(name) <= 100),
+    color TEXT NOT NULL DEFAULT '#000000' CHECK (color ~ '^#[0-9a-fA-F]{6}$'),
+    UNIQUE ( profile_id, name )
+);
+
+-- Создание таблицы связи меток с письмами (email_label)
+CREATE TABLE IF NOT EXISTS email_label (
+    label_id INTEGER,
+    email_id INTEGER,
+    PRIMARY KEY ( label_id, email_id ),
+    CONSTRAINT fk_label FOREIGN KEY (label_id) REFERENCES label(id) ON DELETE CASCADE,
+    CONSTRAINT fk_email FOREIGN KEY (email_id) REFERENCES email(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS email_label_email_idx ON email_label (email_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS email_label;
+DROP TABLE IF EXISTS label;
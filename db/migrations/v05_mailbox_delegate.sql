@@ -0,0 +1,32 @@
+-- +migrate Up
+-- Создание таблицы делегатов общих ящиков (mailbox_delegate)
+CREATE TABLE IF NOT EXISTS mailbox_delegate (
+    mailbox_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    delegate_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('read', 'send_as', 'send_on_behalf', 'manage')),
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ( mailbox_id, delegate_id ),
+    CHECK ( mailbox_id <> delegate_id )
+);
+
+CREATE INDEX IF NOT EXISTS mailbox_delegate_delegate_idx ON mailbox_delegate (delegate_id);
+
+-- Создание таблицы действий делегатов в общих ящиках (mailbox_delegate_action)
+CREATE TABLE IF NOT EXISTS mailbox_delegate_action (
+    id INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    mailbox_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    delegate_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    action TEXT NOT NULL CHECK (LENGTH(action) <= 50),
+    email_id INTEGER REFERENCES email(id) ON DELETE SET NULL,
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS mailbox_delegate_action_mailbox_idx ON mailbox_delegate_action (mailbox_id, creation_date DESC);
+
+-- Добавление делегата, отправившего письмо от имени ящика
+ALTER TABLE email ADD COLUMN IF NOT EXISTS sent_by_email TEXT CHECK (LENGTH(sent_by_email) <= 50);
+
+-- +migrate Down
+ALTER TABLE email DROP COLUMN IF EXISTS sent_by_email;
+DROP TABLE IF EXISTS mailbox_delegate_action;
+DROP TABLE IF EXISTS mailbox_delegate;
@@ -0,0 +1,34 @@
+-- +migrate Up
+-- Создание таблицы настроек двухфакторной аутентификации (profile_two_factor)
+CREATE TABLE IF NOT EXISTS profile_two_factor (
+    profile_id INTEGER PRIMARY KEY REFERENCES profile(id) ON DELETE CASCADE,
+    secret TEXT NOT NULL CHECK (LENGTH(secret) <= 64),
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    confirmation_date TIMESTAMPTZ
+);
+
+-- Создание таблицы одноразовых кодов восстановления (profile_recovery_code)
+CREATE TABLE IF NOT EXISTS profile_recovery_code (
+    id INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL CHECK (LENGTH(code_hash) <= 100),
+    used_date TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS profile_recovery_code_profile_idx ON profile_recovery_code (profile_id);
+
+-- Создание таблицы незавершённых входов, ожидающих второй фактор (two_factor_challenge)
+CREATE TABLE IF NOT EXISTS two_factor_challenge (
+    id TEXT PRIMARY KEY CHECK (LENGTH(id) <= 64),
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expiration_date TIMESTAMPTZ NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS two_factor_challenge;
+DROP TABLE IF EXISTS profile_recovery_code;
+DROP TABLE IF EXISTS profile_two_factor;
@@ -0,0 +1,11 @@
+-- +migrate Up
+-- Добавление сведений об устройстве и активности сессии (session)
+ALTER TABLE session ADD COLUMN IF NOT EXISTS ip_address TEXT NOT NULL DEFAULT '' CHECK (LENGTH(ip_address) <= 50);
+ALTER TABLE session ADD COLUMN IF NOT EXISTS last_seen_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS session_profile_idx ON session (profile_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS session_profile_idx;
+ALTER TABLE session DROP COLUMN IF EXISTS last_seen_date;
+ALTER TABLE session DROP COLUMN IF EXISTS ip_address;
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- Добавление резервного адреса для восстановления пароля (profile)
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS recovery_email TEXT CHECK (LENGTH(recovery_email) <= 100);
+
+-- Создание таблицы токенов сброса пароля (password_reset_token)
+CREATE TABLE IF NOT EXISTS password_reset_token (
+    token_hash TEXT PRIMARY KEY CHECK (LENGTH(token_hash) <= 64),
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expiration_date TIMESTAMPTZ NOT NULL,
+    used_date TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS password_reset_token_profile_idx ON password_reset_token (profile_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS password_reset_token;
+ALTER TABLE profile DROP COLUMN IF EXISTS recovery_email;
@@ -0,0 +1,13 @@
+-- +migrate Up
+-- Создание таблицы неудачных попыток входа по логину и по IP-адресу (login_attempt)
+CREATE TABLE IF NOT EXISTS login_attempt (
+    key TEXT PRIMARY KEY CHECK (LENGTH(key) <= 150),
+    failures INTEGER NOT NULL DEFAULT 0 CHECK (failures >= 0),
+    last_failure_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS login_attempt_last_failure_idx ON login_attempt (last_failure_date);
+
+-- +migrate Down
+DROP TABLE IF EXISTS login_attempt;
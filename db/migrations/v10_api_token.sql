@@ -0,0 +1,17 @@
+-- +migrate Up
+-- Создание таблицы персональных токенов доступа (api_token)
+CREATE TABLE IF NOT EXISTS api_token (
+    id SERIAL PRIMARY KEY,
+    token_hash TEXT NOT NULL UNIQUE CHECK (LENGTH(token_hash) <= 64),
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    name TEXT NOT NULL CHECK (LENGTH(name) <= 100),
+    scopes TEXT NOT NULL CHECK (LENGTH(scopes) <= 200),
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expiration_date TIMESTAMPTZ NOT NULL,
+    last_used_date TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS api_token_profile_idx ON api_token (profile_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_token;
@@ -0,0 +1,36 @@
+-- +migrate Up
+-- Создание журнала событий безопасности (security_event), записи только добавляются
+-- Ссылка на профиль без внешнего ключа, чтобы события сохранялись после удаления профиля
+CREATE TABLE IF NOT EXISTS security_event (
+    id BIGSERIAL PRIMARY KEY,
+    profile_id INTEGER,
+    login TEXT NOT NULL DEFAULT '' CHECK (LENGTH(login) <= 100),
+    event_type TEXT NOT NULL CHECK (LENGTH(event_type) <= 50),
+    method TEXT NOT NULL DEFAULT '' CHECK (LENGTH(method) <= 50),
+    success BOOLEAN NOT NULL,
+    details TEXT NOT NULL DEFAULT '' CHECK (LENGTH(details) <= 200),
+    ip_address TEXT NOT NULL DEFAULT '' CHECK (LENGTH(ip_address) <= 50),
+    user_agent TEXT NOT NULL DEFAULT '' CHECK (LENGTH(user_agent) <= 100),
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS security_event_profile_idx ON security_event (profile_id, id DESC);
+CREATE INDEX IF NOT EXISTS security_event_creation_date_idx ON security_event (creation_date);
+
+-- Запрет изменения и удаления событий
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION security_event_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'security_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER security_event_append_only
+    BEFORE UPDATE OR DELETE ON security_event
+    FOR EACH ROW EXECUTE FUNCTION security_event_append_only();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS security_event_append_only ON security_event;
+DROP FUNCTION IF EXISTS security_event_append_only();
+DROP TABLE IF EXISTS security_event;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- Добавление признака подтверждения резервного адреса (profile)
+-- Уже сохранённые адреса считаются неподтверждёнными, пока пользователь не перейдёт по ссылке из письма
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS recovery_email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE profile DROP COLUMN IF EXISTS recovery_email_verified;
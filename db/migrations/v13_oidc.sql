@@ -0,0 +1,46 @@
+-- +migrate Up
+-- Создание таблицы клиентов OpenID Connect (oidc_client)
+-- Клиенты регистрируются администраторами, для публичных клиентов секрет не задаётся
+CREATE TABLE IF NOT EXISTS oidc_client (
+    client_id TEXT PRIMARY KEY CHECK (LENGTH(client_id) <= 64),
+    secret_hash TEXT CHECK (LENGTH(secret_hash) <= 64),
+    name TEXT NOT NULL CHECK (LENGTH(name) <= 100),
+    redirect_uris TEXT NOT NULL CHECK (LENGTH(redirect_uris) <= 2000),
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Создание таблицы согласий пользователей на передачу данных клиентам (oidc_consent)
+CREATE TABLE IF NOT EXISTS oidc_consent (
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    client_id TEXT NOT NULL REFERENCES oidc_client(client_id) ON DELETE CASCADE,
+    scopes TEXT NOT NULL CHECK (LENGTH(scopes) <= 200),
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (profile_id, client_id)
+);
+
+-- Создание таблицы кодов авторизации (oidc_authorization_code)
+-- Хранится только хэш кода, код удаляется при обмене на токены
+CREATE TABLE IF NOT EXISTS oidc_authorization_code (
+    code_hash TEXT PRIMARY KEY CHECK (LENGTH(code_hash) <= 64),
+    client_id TEXT NOT NULL REFERENCES oidc_client(client_id) ON DELETE CASCADE,
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    redirect_uri TEXT NOT NULL CHECK (LENGTH(redirect_uri) <= 500),
+    scopes TEXT NOT NULL CHECK (LENGTH(scopes) <= 200),
+    nonce TEXT NOT NULL DEFAULT '' CHECK (LENGTH(nonce) <= 200),
+    code_challenge TEXT NOT NULL CHECK (LENGTH(code_challenge) <= 128),
+    expiration_date TIMESTAMPTZ NOT NULL
+);
+
+-- Создание таблицы ключей подписи токенов (oidc_signing_key)
+-- Старые ключи хранятся, пока выданные ими токены могут быть действительны
+CREATE TABLE IF NOT EXISTS oidc_signing_key (
+    kid TEXT PRIMARY KEY CHECK (LENGTH(kid) <= 64),
+    private_key TEXT NOT NULL,
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS oidc_signing_key;
+DROP TABLE IF EXISTS oidc_authorization_code;
+DROP TABLE IF EXISTS oidc_consent;
+DROP TABLE IF EXISTS oidc_client;
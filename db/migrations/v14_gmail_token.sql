@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Создание таблицы токенов OAuth пользователей Gmail (gmail_token)
+-- Токен сохраняется до регистрации профиля, поэтому связь с профилем идёт через логин - адрес Gmail
+-- Токен хранится в зашифрованном виде, ключ шифрования задаётся в конфигурации
+CREATE TABLE IF NOT EXISTS gmail_token (
+    login TEXT PRIMARY KEY CHECK (LENGTH(login) <= 50),
+    token BYTEA NOT NULL,
+    update_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS gmail_token;
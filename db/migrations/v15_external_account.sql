@@ -0,0 +1,22 @@
+-- +migrate Up
+-- Создание таблицы подключённых внешних почтовых ящиков IMAP/SMTP (external_account)
+-- Пароль хранится в зашифрованном виде, ключ шифрования задаётся в конфигурации
+-- Режим защиты соединения: tls - TLS сразу при подключении, starttls - переход на TLS командой STARTTLS, none - без шифрования
+CREATE TABLE IF NOT EXISTS external_account (
+    id SERIAL PRIMARY KEY,
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    email TEXT NOT NULL CHECK (LENGTH(email) <= 254),
+    imap_host TEXT NOT NULL CHECK (LENGTH(imap_host) <= 253),
+    imap_port INTEGER NOT NULL CHECK (imap_port BETWEEN 1 AND 65535),
+    imap_tls_mode TEXT NOT NULL CHECK (imap_tls_mode IN ('tls', 'starttls', 'none')),
+    smtp_host TEXT NOT NULL CHECK (LENGTH(smtp_host) <= 253),
+    smtp_port INTEGER NOT NULL CHECK (smtp_port BETWEEN 1 AND 65535),
+    smtp_tls_mode TEXT NOT NULL CHECK (smtp_tls_mode IN ('tls', 'starttls', 'none')),
+    username TEXT NOT NULL CHECK (LENGTH(username) <= 254),
+    password BYTEA NOT NULL,
+    creation_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (profile_id, email)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS external_account;
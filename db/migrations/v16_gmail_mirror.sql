@@ -0,0 +1,39 @@
+-- +migrate Up
+-- Создание таблицы состояния синхронизации ящиков Gmail (gmail_sync)
+-- history_id - идентификатор последнего изменения ящика, с которого начинается следующая синхронизация
+CREATE TABLE IF NOT EXISTS gmail_sync (
+    login TEXT PRIMARY KEY CHECK (LENGTH(login) <= 50),
+    history_id BIGINT NOT NULL,
+    sync_date TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Создание таблицы локальной копии меток Gmail (gmail_label)
+CREATE TABLE IF NOT EXISTS gmail_label (
+    login TEXT NOT NULL REFERENCES gmail_sync(login) ON DELETE CASCADE,
+    label_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    PRIMARY KEY (login, label_id)
+);
+
+-- Создание таблицы локальной копии писем Gmail (gmail_message)
+-- label_ids - идентификаторы меток письма через запятую, состояние прочтения хранится меткой UNREAD
+CREATE TABLE IF NOT EXISTS gmail_message (
+    login TEXT NOT NULL REFERENCES gmail_sync(login) ON DELETE CASCADE,
+    message_id TEXT NOT NULL,
+    thread_id TEXT NOT NULL,
+    label_ids TEXT NOT NULL DEFAULT '',
+    subject TEXT NOT NULL DEFAULT '',
+    sender TEXT NOT NULL DEFAULT '',
+    recipient TEXT NOT NULL DEFAULT '',
+    text TEXT NOT NULL DEFAULT '',
+    date TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (login, message_id)
+);
+
+CREATE INDEX IF NOT EXISTS gmail_message_login_date_idx ON gmail_message (login, date DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS gmail_message;
+DROP TABLE IF EXISTS gmail_label;
+DROP TABLE IF EXISTS gmail_sync;
@@ -0,0 +1,10 @@
+-- +migrate Up
+-- Создание таблицы номеров событий ящиков (mailbox_event_seq)
+-- seq - номер последнего события ящика, доставленного подключённым клиентам
+CREATE TABLE IF NOT EXISTS mailbox_event_seq (
+    login TEXT PRIMARY KEY CHECK (LENGTH(login) <= 50),
+    seq BIGINT NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS mailbox_event_seq;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- Время предыдущей попытки входа: задержка перед попыткой считается от него,
+-- так как попытка учитывается до проверки пароля
+ALTER TABLE login_attempt ADD COLUMN IF NOT EXISTS previous_failure_date TIMESTAMPTZ;
+
+-- +migrate Down
+ALTER TABLE login_attempt DROP COLUMN IF EXISTS previous_failure_date;
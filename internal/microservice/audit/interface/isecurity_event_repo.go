@@ -0,0 +1,19 @@
+//go:generate mockgen -source=./isecurity_event_repo.go -destination=../mock/security_event_repository_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// SecurityEventRepository represents the interface for recording security events in the append-only audit log.
+// It is shared by the services the audited actions are made in.
+type SecurityEventRepository interface {
+	// AddSecurityEvent records the event. If its account is unknown, the account with the login of the event is recorded.
+	AddSecurityEvent(event *domain.SecurityEvent, ctx context.Context) error
+
+	// GetProfileIDByTwoFactorChallenge returns the unique identifier of the user who has to complete the login challenge.
+	GetProfileIDByTwoFactorChallenge(challengeID string, ctx context.Context) (uint32, error)
+}
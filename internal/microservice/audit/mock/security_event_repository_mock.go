@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./isecurity_event_repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSecurityEventRepository is a mock of SecurityEventRepository interface.
+type MockSecurityEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecurityEventRepositoryMockRecorder
+}
+
+// MockSecurityEventRepositoryMockRecorder is the mock recorder for MockSecurityEventRepository.
+type MockSecurityEventRepositoryMockRecorder struct {
+	mock *MockSecurityEventRepository
+}
+
+// NewMockSecurityEventRepository creates a new mock instance.
+func NewMockSecurityEventRepository(ctrl *gomock.Controller) *MockSecurityEventRepository {
+	mock := &MockSecurityEventRepository{ctrl: ctrl}
+	mock.recorder = &MockSecurityEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecurityEventRepository) EXPECT() *MockSecurityEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSecurityEvent mocks base method.
+func (m *MockSecurityEventRepository) AddSecurityEvent(event *domain_models.SecurityEvent, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecurityEvent", event, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecurityEvent indicates an expected call of AddSecurityEvent.
+func (mr *MockSecurityEventRepositoryMockRecorder) AddSecurityEvent(event, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecurityEvent", reflect.TypeOf((*MockSecurityEventRepository)(nil).AddSecurityEvent), event, ctx)
+}
+
+// GetProfileIDByTwoFactorChallenge mocks base method.
+func (m *MockSecurityEventRepository) GetProfileIDByTwoFactorChallenge(challengeID string, ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfileIDByTwoFactorChallenge", challengeID, ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfileIDByTwoFactorChallenge indicates an expected call of GetProfileIDByTwoFactorChallenge.
+func (mr *MockSecurityEventRepositoryMockRecorder) GetProfileIDByTwoFactorChallenge(challengeID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileIDByTwoFactorChallenge", reflect.TypeOf((*MockSecurityEventRepository)(nil).GetProfileIDByTwoFactorChallenge), challengeID, ctx)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// requestIDContextKey is the context key for the request ID.
+var requestIDContextKey interface{} = "requestID"
+
+// SecurityEventRepository represents a PostgreSQL implementation of the SecurityEventRepository interface.
+type SecurityEventRepository struct {
+	DB *sqlx.DB
+}
+
+// NewSecurityEventRepository creates a new instance of SecurityEventRepository.
+func NewSecurityEventRepository(db *sqlx.DB) *SecurityEventRepository {
+	return &SecurityEventRepository{
+		DB: db,
+	}
+}
+
+// AddSecurityEvent records the event. If its account is unknown, the account with the login of the event is recorded,
+// the event of a login which does not exist is kept without an account.
+func (repo *SecurityEventRepository) AddSecurityEvent(event *domain.SecurityEvent, ctx context.Context) error {
+	query := `
+		INSERT INTO security_event (profile_id, login, event_type, method, success, details, ip_address, user_agent)
+		VALUES (COALESCE(NULLIF($1, 0), (SELECT id FROM profile WHERE login = $2)), $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, int64(event.ProfileID), event.Login, event.Type, event.Method, event.Success,
+		event.Details, event.IPAddress, event.UserAgent)
+
+	args := []interface{}{event.ProfileID, event.Login, event.Type, event.Method, event.Success}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add security event: %v", err)
+	}
+
+	return nil
+}
+
+// GetProfileIDByTwoFactorChallenge returns the unique identifier of the user who has to complete the login challenge.
+func (repo *SecurityEventRepository) GetProfileIDByTwoFactorChallenge(challengeID string, ctx context.Context) (uint32, error) {
+	query := "SELECT profile_id FROM two_factor_challenge WHERE id = $1"
+
+	var profileID uint32
+
+	start := time.Now()
+	err := repo.DB.Get(&profileID, query, challengeID)
+
+	args := []interface{}{challengeID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get two-factor challenge: %v", err)
+	}
+
+	return profileID, nil
+}
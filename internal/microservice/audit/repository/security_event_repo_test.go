@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/logger"
+	"mail/internal/pkg/utils/constants"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func GetCTX() context.Context {
+	ctx := context.WithValue(context.Background(), interface{}(string(constants.LoggerKey)), logger.InitializationBdLog(nil))
+	ctx2 := context.WithValue(ctx, interface{}(string(constants.RequestIDKey)), []string{"testID"})
+
+	return ctx2
+}
+
+func TestSecurityEventRepository_AddSecurityEvent(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewSecurityEventRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+
+	event := &domain.SecurityEvent{
+		Login:     "user@mailhub.su",
+		Type:      domain.SecurityEventLogin,
+		Method:    domain.SecurityEventMethodPassword,
+		IPAddress: "127.0.0.1",
+		UserAgent: "Firefox",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO security_event`).
+			WithArgs(int64(0), "user@mailhub.su", domain.SecurityEventLogin, domain.SecurityEventMethodPassword, false, "", "127.0.0.1", "Firefox").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		assert.NoError(t, repo.AddSecurityEvent(event, ctx))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO security_event`).WillReturnError(fmt.Errorf("db error"))
+
+		assert.Error(t, repo.AddSecurityEvent(event, ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSecurityEventRepository_GetProfileIDByTwoFactorChallenge(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewSecurityEventRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+
+	t.Run("Found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT profile_id FROM two_factor_challenge WHERE id = \$1`).WithArgs("challenge").
+			WillReturnRows(sqlmock.NewRows([]string{"profile_id"}).AddRow(2))
+
+		profileID, err := repo.GetProfileIDByTwoFactorChallenge("challenge", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), profileID)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT profile_id FROM two_factor_challenge`).WithArgs("expired").
+			WillReturnRows(sqlmock.NewRows([]string{"profile_id"}))
+
+		_, err := repo.GetProfileIDByTwoFactorChallenge("expired", ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
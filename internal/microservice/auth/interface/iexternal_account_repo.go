@@ -0,0 +1,25 @@
+//go:generate mockgen -source=./iexternal_account_repo.go -destination=../mock/external_account_repository_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// ExternalAccountRepository represents the interface for storing the mailboxes of other mail providers linked by the users.
+type ExternalAccountRepository interface {
+	// AddAccount stores the mailbox with the encrypted password and returns its unique identifier.
+	// It fails if the user has already linked the maximum number of mailboxes.
+	AddAccount(account *domain.ExternalAccount, encryptedPassword []byte, ctx context.Context) (uint32, error)
+
+	// GetAccounts returns the mailboxes linked by the user without their passwords, the oldest first.
+	GetAccounts(profileID uint32, ctx context.Context) ([]*domain.ExternalAccount, error)
+
+	// GetAccount returns the mailbox of the user and its encrypted password, or nil if the user has no such mailbox.
+	GetAccount(profileID, accountID uint32, ctx context.Context) (*domain.ExternalAccount, []byte, error)
+
+	// DeleteAccount removes the mailbox of the user, returns false if the user has no such mailbox.
+	DeleteAccount(profileID, accountID uint32, ctx context.Context) (bool, error)
+}
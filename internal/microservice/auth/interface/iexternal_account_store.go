@@ -0,0 +1,25 @@
+//go:generate mockgen -source=./iexternal_account_store.go -destination=../mock/external_account_store_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// ExternalAccountStore represents the interface of the storage of the mailboxes of other mail providers linked by the users.
+type ExternalAccountStore interface {
+	// Add checks and links the mailbox to the user, the password is encrypted before it is stored.
+	// The returned mailbox has no password.
+	Add(profileID uint32, account *domain.ExternalAccount, ctx context.Context) (*domain.ExternalAccount, error)
+
+	// GetAll returns the mailboxes linked by the user without their passwords.
+	GetAll(profileID uint32, ctx context.Context) ([]*domain.ExternalAccount, error)
+
+	// Get returns the mailbox of the user with the decrypted password, or nil if the user has no such mailbox.
+	Get(profileID, accountID uint32, ctx context.Context) (*domain.ExternalAccount, error)
+
+	// Delete unlinks the mailbox from the user, it fails if the user has no such mailbox.
+	Delete(profileID, accountID uint32, ctx context.Context) error
+}
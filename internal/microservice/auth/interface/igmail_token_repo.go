@@ -0,0 +1,16 @@
+//go:generate mockgen -source=./igmail_token_repo.go -destination=../mock/gmail_token_repository_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+)
+
+// GMailTokenRepository represents the interface for storing the encrypted OAuth tokens of the Gmail users.
+type GMailTokenRepository interface {
+	// SaveToken stores the encrypted token of the Gmail address, replacing the previous one.
+	SaveToken(login string, token []byte, ctx context.Context) error
+
+	// GetToken returns the encrypted token of the Gmail address, or nil if there is none.
+	GetToken(login string, ctx context.Context) ([]byte, error)
+}
@@ -0,0 +1,17 @@
+//go:generate mockgen -source=./igmail_token_store.go -destination=../mock/gmail_token_store_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+)
+
+// GMailTokenStore represents the interface of the storage of the Gmail OAuth tokens.
+// The tokens are passed as the JSON the gateway received from Google and are kept encrypted.
+type GMailTokenStore interface {
+	// Save encrypts and stores the token of the Gmail address.
+	Save(login string, token []byte, ctx context.Context) error
+
+	// Get returns the decrypted token of the Gmail address, or nil if there is none.
+	Get(login string, ctx context.Context) ([]byte, error)
+}
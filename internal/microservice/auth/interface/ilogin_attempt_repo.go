@@ -0,0 +1,31 @@
+//go:generate mockgen -source=./ilogin_attempt_repo.go -destination=../mock/login_attempt_repository_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// LoginAttemptRepository represents the interface for storing failed login attempts.
+// It has to be shared by all the replicas of the auth service to limit attempts across them.
+type LoginAttemptRepository interface {
+	// Get returns the failed attempts of the key, or nil if there are none.
+	Get(key string, ctx context.Context) (*domain.LoginAttempts, error)
+
+	// AddAttempt counts an attempt of the key made at now before it is checked and returns the updated attempts.
+	// The attempts made before since are forgotten.
+	AddAttempt(key string, now, since time.Time, ctx context.Context) (*domain.LoginAttempts, error)
+
+	// RemoveAttempt uncounts an attempt of the key that has turned out to be successful.
+	RemoveAttempt(key string, ctx context.Context) error
+
+	// Lock forbids the attempts of the key until the given time, unless it is already locked at now.
+	// It returns whether this call has locked the key.
+	Lock(key string, until, now time.Time, ctx context.Context) (bool, error)
+
+	// Reset forgets the failed attempts of the key and unlocks it.
+	Reset(key string, ctx context.Context) error
+}
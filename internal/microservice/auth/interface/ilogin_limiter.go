@@ -0,0 +1,22 @@
+//go:generate mockgen -source=./ilogin_limiter.go -destination=../mock/login_limiter_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+	"time"
+)
+
+// LoginLimiter represents the interface for limiting password guessing on logins.
+type LoginLimiter interface {
+	// Attempt counts an attempt on the login from the IP address before the password is checked and returns
+	// the time it has to wait for, zero if it is allowed now.
+	// It also returns the time the login is locked until if the attempt has just locked it, zero otherwise.
+	Attempt(login, ipAddress string, ctx context.Context) (time.Duration, time.Time, error)
+
+	// RegisterSuccess forgets the failed attempts on the login after a successful one and uncounts it from the IP address.
+	RegisterSuccess(login, ipAddress string, ctx context.Context) error
+
+	// Unlock forgets the failed attempts on the login and from the IP address, either of them can be empty.
+	Unlock(login, ipAddress string, ctx context.Context) error
+}
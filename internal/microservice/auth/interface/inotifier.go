@@ -0,0 +1,18 @@
+//go:generate mockgen -source=./inotifier.go -destination=../mock/notifier_mock.go -package=mock
+
+package _interface
+
+import "time"
+
+// Notifier delivers security messages to the users.
+type Notifier interface {
+	// SendPasswordReset sends the password reset link to the recipient address.
+	SendPasswordReset(recipient, link string) error
+
+	// SendRecoveryEmailVerification sends the link verifying the recovery email to the recipient address.
+	SendRecoveryEmailVerification(recipient, link string) error
+
+	// SendLoginLockout warns the owner of the recipient account that its login has been locked
+	// after too many failed attempts, the last of them made from the IP address.
+	SendLoginLockout(recipient, ipAddress string, lockedUntil time.Time) error
+}
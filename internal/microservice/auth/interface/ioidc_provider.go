@@ -0,0 +1,49 @@
+//go:generate mockgen -source=./ioidc_provider.go -destination=../mock/oidc_provider_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+
+	domain "mail/internal/microservice/models/domain_models"
+	"mail/internal/pkg/utils/jwt"
+)
+
+// OIDCProvider represents the interface of the OpenID Connect provider which signs users in to other applications.
+type OIDCProvider interface {
+	// GetClient returns the client with the unique identifier, it fails if there is none.
+	GetClient(clientID string, ctx context.Context) (*domain.OIDCClient, error)
+
+	// RegisterClient registers a client with the name and the redirect addresses.
+	// It returns the client and its secret, which is shown once, the secret is empty for public clients.
+	RegisterClient(name string, redirectURIs []string, public bool, ctx context.Context) (*domain.OIDCClient, string, error)
+
+	// HasConsent checks if the user has allowed the client of the request to read the requested scopes.
+	HasConsent(request *domain.OIDCAuthorizationRequest, ctx context.Context) (bool, error)
+
+	// GrantConsent records that the user has allowed the client of the request to read the requested scopes.
+	GrantConsent(request *domain.OIDCAuthorizationRequest, ctx context.Context) error
+
+	// SignConsentRequest returns the request in the signed form it is passed in while the user is asked for consent.
+	SignConsentRequest(request *domain.OIDCAuthorizationRequest) string
+
+	// VerifyConsentRequest checks the signed request and returns it.
+	VerifyConsentRequest(consentRequest string) (*domain.OIDCAuthorizationRequest, error)
+
+	// IssueAuthorizationCode returns a new code the client of the request exchanges for the tokens.
+	IssueAuthorizationCode(request *domain.OIDCAuthorizationRequest, ctx context.Context) (string, error)
+
+	// ExchangeAuthorizationCode returns the code issued to the authenticated client after checking the redirect address
+	// and the PKCE verifier, or nil if the code is unknown, expired or does not pass the check.
+	// The code cannot be exchanged again, even if the check fails.
+	ExchangeAuthorizationCode(code string, client *domain.OIDCClient, redirectURI, codeVerifier string, ctx context.Context) (*domain.OIDCAuthorizationCode, error)
+
+	// IssueTokens returns the ID token and the access token for the exchanged code.
+	IssueTokens(code *domain.OIDCAuthorizationCode, ctx context.Context) (string, string, error)
+
+	// VerifyAccessToken checks the access token and returns its claims.
+	VerifyAccessToken(accessToken string, ctx context.Context) (*domain.OIDCAccessTokenClaims, error)
+
+	// PublicKeys returns the keys the tokens can be verified with.
+	PublicKeys(ctx context.Context) ([]jwt.JWK, error)
+}
@@ -0,0 +1,41 @@
+//go:generate mockgen -source=./ioidc_repo.go -destination=../mock/oidc_repository_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// OIDCRepository represents the interface for storing the clients, consents, codes and keys of the OpenID Connect provider.
+type OIDCRepository interface {
+	// GetClient returns the client with the unique identifier, or nil if there is none.
+	GetClient(clientID string, ctx context.Context) (*domain.OIDCClient, error)
+
+	// CreateClient registers the client.
+	CreateClient(client *domain.OIDCClient, ctx context.Context) error
+
+	// GetConsent returns the scopes the user has allowed the client to read, or nil if the user has not given consent.
+	GetConsent(profileID uint32, clientID string, ctx context.Context) ([]string, error)
+
+	// SaveConsent records the scopes the user has allowed the client to read, replacing the previous consent.
+	SaveConsent(profileID uint32, clientID string, scopes []string, ctx context.Context) error
+
+	// CreateAuthorizationCode stores the code, the expired codes are removed on the way.
+	CreateAuthorizationCode(code *domain.OIDCAuthorizationCode, ctx context.Context) error
+
+	// TakeAuthorizationCode removes the code with the hash and returns it, or nil if there is none.
+	// A code can only be taken once, even by concurrent exchanges.
+	TakeAuthorizationCode(codeHash string, ctx context.Context) (*domain.OIDCAuthorizationCode, error)
+
+	// GetSigningKeys returns the signing keys, the newest first.
+	GetSigningKeys(ctx context.Context) ([]*domain.OIDCSigningKey, error)
+
+	// AddSigningKey stores a new signing key.
+	AddSigningKey(key *domain.OIDCSigningKey, ctx context.Context) error
+
+	// DeleteSigningKeys removes the signing keys generated before the date.
+	DeleteSigningKeys(createdBefore time.Time, ctx context.Context) error
+}
@@ -36,6 +36,26 @@ func (m *MockAuthServiceClient) EXPECT() *MockAuthServiceClientMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthServiceClient) ChangePassword(ctx context.Context, in *proto.PasswordChangeRequest, opts ...grpc.CallOption) (*proto.PasswordChangeReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*proto.PasswordChangeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceClientMockRecorder) ChangePassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ChangePassword), varargs...)
+}
+
 // Login mocks base method.
 func (m *MockAuthServiceClient) Login(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption) (*proto.LoginReply, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +96,26 @@ func (mr *MockAuthServiceClientMockRecorder) LoginOtherMail(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginOtherMail", reflect.TypeOf((*MockAuthServiceClient)(nil).LoginOtherMail), varargs...)
 }
 
+// LoginTwoFactor mocks base method.
+func (m *MockAuthServiceClient) LoginTwoFactor(ctx context.Context, in *proto.LoginTwoFactorRequest, opts ...grpc.CallOption) (*proto.LoginReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LoginTwoFactor", varargs...)
+	ret0, _ := ret[0].(*proto.LoginReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginTwoFactor indicates an expected call of LoginTwoFactor.
+func (mr *MockAuthServiceClientMockRecorder) LoginTwoFactor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginTwoFactor", reflect.TypeOf((*MockAuthServiceClient)(nil).LoginTwoFactor), varargs...)
+}
+
 // LoginVK mocks base method.
 func (m *MockAuthServiceClient) LoginVK(ctx context.Context, in *proto.LoginVKRequest, opts ...grpc.CallOption) (*proto.LoginReply, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +156,86 @@ func (mr *MockAuthServiceClientMockRecorder) Logout(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceClient)(nil).Logout), varargs...)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockAuthServiceClient) RequestPasswordReset(ctx context.Context, in *proto.PasswordResetLinkRequest, opts ...grpc.CallOption) (*proto.PasswordResetLinkReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestPasswordReset", varargs...)
+	ret0, _ := ret[0].(*proto.PasswordResetLinkReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServiceClientMockRecorder) RequestPasswordReset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthServiceClient)(nil).RequestPasswordReset), varargs...)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthServiceClient) ResetPassword(ctx context.Context, in *proto.PasswordResetRequest, opts ...grpc.CallOption) (*proto.PasswordResetReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPassword", varargs...)
+	ret0, _ := ret[0].(*proto.PasswordResetReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceClientMockRecorder) ResetPassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ResetPassword), varargs...)
+}
+
+// SendRecoveryEmailVerification mocks base method.
+func (m *MockAuthServiceClient) SendRecoveryEmailVerification(ctx context.Context, in *proto.RecoveryEmailVerificationRequest, opts ...grpc.CallOption) (*proto.RecoveryEmailVerificationReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendRecoveryEmailVerification", varargs...)
+	ret0, _ := ret[0].(*proto.RecoveryEmailVerificationReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendRecoveryEmailVerification indicates an expected call of SendRecoveryEmailVerification.
+func (mr *MockAuthServiceClientMockRecorder) SendRecoveryEmailVerification(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRecoveryEmailVerification", reflect.TypeOf((*MockAuthServiceClient)(nil).SendRecoveryEmailVerification), varargs...)
+}
+
+// SetRecoveryEmail mocks base method.
+func (m *MockAuthServiceClient) SetRecoveryEmail(ctx context.Context, in *proto.RecoveryEmailChangeRequest, opts ...grpc.CallOption) (*proto.RecoveryEmailChangeReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRecoveryEmail", varargs...)
+	ret0, _ := ret[0].(*proto.RecoveryEmailChangeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecoveryEmail indicates an expected call of SetRecoveryEmail.
+func (mr *MockAuthServiceClientMockRecorder) SetRecoveryEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecoveryEmail", reflect.TypeOf((*MockAuthServiceClient)(nil).SetRecoveryEmail), varargs...)
+}
+
 // Signup mocks base method.
 func (m *MockAuthServiceClient) Signup(ctx context.Context, in *proto.SignupRequest, opts ...grpc.CallOption) (*proto.SignupReply, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +296,46 @@ func (mr *MockAuthServiceClientMockRecorder) SignupVK(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignupVK", reflect.TypeOf((*MockAuthServiceClient)(nil).SignupVK), varargs...)
 }
 
+// UnlockLogin mocks base method.
+func (m *MockAuthServiceClient) UnlockLogin(ctx context.Context, in *proto.UnlockLoginRequest, opts ...grpc.CallOption) (*proto.UnlockLoginReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnlockLogin", varargs...)
+	ret0, _ := ret[0].(*proto.UnlockLoginReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockLogin indicates an expected call of UnlockLogin.
+func (mr *MockAuthServiceClientMockRecorder) UnlockLogin(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockLogin", reflect.TypeOf((*MockAuthServiceClient)(nil).UnlockLogin), varargs...)
+}
+
+// VerifyRecoveryEmail mocks base method.
+func (m *MockAuthServiceClient) VerifyRecoveryEmail(ctx context.Context, in *proto.VerifyRecoveryEmailRequest, opts ...grpc.CallOption) (*proto.VerifyRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyRecoveryEmail", varargs...)
+	ret0, _ := ret[0].(*proto.VerifyRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRecoveryEmail indicates an expected call of VerifyRecoveryEmail.
+func (mr *MockAuthServiceClientMockRecorder) VerifyRecoveryEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRecoveryEmail", reflect.TypeOf((*MockAuthServiceClient)(nil).VerifyRecoveryEmail), varargs...)
+}
+
 // MockAuthServiceServer is a mock of AuthServiceServer interface.
 type MockAuthServiceServer struct {
 	ctrl     *gomock.Controller
@@ -199,6 +359,21 @@ func (m *MockAuthServiceServer) EXPECT() *MockAuthServiceServerMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthServiceServer) ChangePassword(arg0 context.Context, arg1 *proto.PasswordChangeRequest) (*proto.PasswordChangeReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PasswordChangeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceServerMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServiceServer)(nil).ChangePassword), arg0, arg1)
+}
+
 // Login mocks base method.
 func (m *MockAuthServiceServer) Login(arg0 context.Context, arg1 *proto.LoginRequest) (*proto.LoginReply, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +404,21 @@ func (mr *MockAuthServiceServerMockRecorder) LoginOtherMail(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginOtherMail", reflect.TypeOf((*MockAuthServiceServer)(nil).LoginOtherMail), arg0, arg1)
 }
 
+// LoginTwoFactor mocks base method.
+func (m *MockAuthServiceServer) LoginTwoFactor(arg0 context.Context, arg1 *proto.LoginTwoFactorRequest) (*proto.LoginReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginTwoFactor", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LoginReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginTwoFactor indicates an expected call of LoginTwoFactor.
+func (mr *MockAuthServiceServerMockRecorder) LoginTwoFactor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginTwoFactor", reflect.TypeOf((*MockAuthServiceServer)(nil).LoginTwoFactor), arg0, arg1)
+}
+
 // LoginVK mocks base method.
 func (m *MockAuthServiceServer) LoginVK(arg0 context.Context, arg1 *proto.LoginVKRequest) (*proto.LoginReply, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +449,66 @@ func (mr *MockAuthServiceServerMockRecorder) Logout(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceServer)(nil).Logout), arg0, arg1)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockAuthServiceServer) RequestPasswordReset(arg0 context.Context, arg1 *proto.PasswordResetLinkRequest) (*proto.PasswordResetLinkReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PasswordResetLinkReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServiceServerMockRecorder) RequestPasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthServiceServer)(nil).RequestPasswordReset), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthServiceServer) ResetPassword(arg0 context.Context, arg1 *proto.PasswordResetRequest) (*proto.PasswordResetReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PasswordResetReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceServerMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthServiceServer)(nil).ResetPassword), arg0, arg1)
+}
+
+// SendRecoveryEmailVerification mocks base method.
+func (m *MockAuthServiceServer) SendRecoveryEmailVerification(arg0 context.Context, arg1 *proto.RecoveryEmailVerificationRequest) (*proto.RecoveryEmailVerificationReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRecoveryEmailVerification", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RecoveryEmailVerificationReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendRecoveryEmailVerification indicates an expected call of SendRecoveryEmailVerification.
+func (mr *MockAuthServiceServerMockRecorder) SendRecoveryEmailVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRecoveryEmailVerification", reflect.TypeOf((*MockAuthServiceServer)(nil).SendRecoveryEmailVerification), arg0, arg1)
+}
+
+// SetRecoveryEmail mocks base method.
+func (m *MockAuthServiceServer) SetRecoveryEmail(arg0 context.Context, arg1 *proto.RecoveryEmailChangeRequest) (*proto.RecoveryEmailChangeReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecoveryEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RecoveryEmailChangeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecoveryEmail indicates an expected call of SetRecoveryEmail.
+func (mr *MockAuthServiceServerMockRecorder) SetRecoveryEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecoveryEmail", reflect.TypeOf((*MockAuthServiceServer)(nil).SetRecoveryEmail), arg0, arg1)
+}
+
 // Signup mocks base method.
 func (m *MockAuthServiceServer) Signup(arg0 context.Context, arg1 *proto.SignupRequest) (*proto.SignupReply, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +554,36 @@ func (mr *MockAuthServiceServerMockRecorder) SignupVK(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignupVK", reflect.TypeOf((*MockAuthServiceServer)(nil).SignupVK), arg0, arg1)
 }
 
+// UnlockLogin mocks base method.
+func (m *MockAuthServiceServer) UnlockLogin(arg0 context.Context, arg1 *proto.UnlockLoginRequest) (*proto.UnlockLoginReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockLogin", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UnlockLoginReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockLogin indicates an expected call of UnlockLogin.
+func (mr *MockAuthServiceServerMockRecorder) UnlockLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockLogin", reflect.TypeOf((*MockAuthServiceServer)(nil).UnlockLogin), arg0, arg1)
+}
+
+// VerifyRecoveryEmail mocks base method.
+func (m *MockAuthServiceServer) VerifyRecoveryEmail(arg0 context.Context, arg1 *proto.VerifyRecoveryEmailRequest) (*proto.VerifyRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyRecoveryEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.VerifyRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRecoveryEmail indicates an expected call of VerifyRecoveryEmail.
+func (mr *MockAuthServiceServerMockRecorder) VerifyRecoveryEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRecoveryEmail", reflect.TypeOf((*MockAuthServiceServer)(nil).VerifyRecoveryEmail), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAuthServiceServer mocks base method.
 func (m *MockAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./external_account_grpc.pb.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	proto "mail/internal/microservice/auth/proto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockExternalAccountServiceClient is a mock of ExternalAccountServiceClient interface.
+type MockExternalAccountServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalAccountServiceClientMockRecorder
+}
+
+// MockExternalAccountServiceClientMockRecorder is the mock recorder for MockExternalAccountServiceClient.
+type MockExternalAccountServiceClientMockRecorder struct {
+	mock *MockExternalAccountServiceClient
+}
+
+// NewMockExternalAccountServiceClient creates a new mock instance.
+func NewMockExternalAccountServiceClient(ctrl *gomock.Controller) *MockExternalAccountServiceClient {
+	mock := &MockExternalAccountServiceClient{ctrl: ctrl}
+	mock.recorder = &MockExternalAccountServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalAccountServiceClient) EXPECT() *MockExternalAccountServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddExternalAccount mocks base method.
+func (m *MockExternalAccountServiceClient) AddExternalAccount(ctx context.Context, in *proto.AddExternalAccountRequest, opts ...grpc.CallOption) (*proto.AddExternalAccountReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddExternalAccount", varargs...)
+	ret0, _ := ret[0].(*proto.AddExternalAccountReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddExternalAccount indicates an expected call of AddExternalAccount.
+func (mr *MockExternalAccountServiceClientMockRecorder) AddExternalAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExternalAccount", reflect.TypeOf((*MockExternalAccountServiceClient)(nil).AddExternalAccount), varargs...)
+}
+
+// DeleteExternalAccount mocks base method.
+func (m *MockExternalAccountServiceClient) DeleteExternalAccount(ctx context.Context, in *proto.DeleteExternalAccountRequest, opts ...grpc.CallOption) (*proto.DeleteExternalAccountReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteExternalAccount", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteExternalAccountReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExternalAccount indicates an expected call of DeleteExternalAccount.
+func (mr *MockExternalAccountServiceClientMockRecorder) DeleteExternalAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalAccount", reflect.TypeOf((*MockExternalAccountServiceClient)(nil).DeleteExternalAccount), varargs...)
+}
+
+// GetExternalAccount mocks base method.
+func (m *MockExternalAccountServiceClient) GetExternalAccount(ctx context.Context, in *proto.GetExternalAccountRequest, opts ...grpc.CallOption) (*proto.GetExternalAccountReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExternalAccount", varargs...)
+	ret0, _ := ret[0].(*proto.GetExternalAccountReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalAccount indicates an expected call of GetExternalAccount.
+func (mr *MockExternalAccountServiceClientMockRecorder) GetExternalAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalAccount", reflect.TypeOf((*MockExternalAccountServiceClient)(nil).GetExternalAccount), varargs...)
+}
+
+// GetExternalAccounts mocks base method.
+func (m *MockExternalAccountServiceClient) GetExternalAccounts(ctx context.Context, in *proto.GetExternalAccountsRequest, opts ...grpc.CallOption) (*proto.GetExternalAccountsReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExternalAccounts", varargs...)
+	ret0, _ := ret[0].(*proto.GetExternalAccountsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalAccounts indicates an expected call of GetExternalAccounts.
+func (mr *MockExternalAccountServiceClientMockRecorder) GetExternalAccounts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalAccounts", reflect.TypeOf((*MockExternalAccountServiceClient)(nil).GetExternalAccounts), varargs...)
+}
+
+// MockExternalAccountServiceServer is a mock of ExternalAccountServiceServer interface.
+type MockExternalAccountServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalAccountServiceServerMockRecorder
+}
+
+// MockExternalAccountServiceServerMockRecorder is the mock recorder for MockExternalAccountServiceServer.
+type MockExternalAccountServiceServerMockRecorder struct {
+	mock *MockExternalAccountServiceServer
+}
+
+// NewMockExternalAccountServiceServer creates a new mock instance.
+func NewMockExternalAccountServiceServer(ctrl *gomock.Controller) *MockExternalAccountServiceServer {
+	mock := &MockExternalAccountServiceServer{ctrl: ctrl}
+	mock.recorder = &MockExternalAccountServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalAccountServiceServer) EXPECT() *MockExternalAccountServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddExternalAccount mocks base method.
+func (m *MockExternalAccountServiceServer) AddExternalAccount(arg0 context.Context, arg1 *proto.AddExternalAccountRequest) (*proto.AddExternalAccountReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExternalAccount", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddExternalAccountReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddExternalAccount indicates an expected call of AddExternalAccount.
+func (mr *MockExternalAccountServiceServerMockRecorder) AddExternalAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExternalAccount", reflect.TypeOf((*MockExternalAccountServiceServer)(nil).AddExternalAccount), arg0, arg1)
+}
+
+// DeleteExternalAccount mocks base method.
+func (m *MockExternalAccountServiceServer) DeleteExternalAccount(arg0 context.Context, arg1 *proto.DeleteExternalAccountRequest) (*proto.DeleteExternalAccountReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExternalAccount", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteExternalAccountReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExternalAccount indicates an expected call of DeleteExternalAccount.
+func (mr *MockExternalAccountServiceServerMockRecorder) DeleteExternalAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalAccount", reflect.TypeOf((*MockExternalAccountServiceServer)(nil).DeleteExternalAccount), arg0, arg1)
+}
+
+// GetExternalAccount mocks base method.
+func (m *MockExternalAccountServiceServer) GetExternalAccount(arg0 context.Context, arg1 *proto.GetExternalAccountRequest) (*proto.GetExternalAccountReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalAccount", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetExternalAccountReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalAccount indicates an expected call of GetExternalAccount.
+func (mr *MockExternalAccountServiceServerMockRecorder) GetExternalAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalAccount", reflect.TypeOf((*MockExternalAccountServiceServer)(nil).GetExternalAccount), arg0, arg1)
+}
+
+// GetExternalAccounts mocks base method.
+func (m *MockExternalAccountServiceServer) GetExternalAccounts(arg0 context.Context, arg1 *proto.GetExternalAccountsRequest) (*proto.GetExternalAccountsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalAccounts", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetExternalAccountsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalAccounts indicates an expected call of GetExternalAccounts.
+func (mr *MockExternalAccountServiceServerMockRecorder) GetExternalAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalAccounts", reflect.TypeOf((*MockExternalAccountServiceServer)(nil).GetExternalAccounts), arg0, arg1)
+}
+
+// mustEmbedUnimplementedExternalAccountServiceServer mocks base method.
+func (m *MockExternalAccountServiceServer) mustEmbedUnimplementedExternalAccountServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedExternalAccountServiceServer")
+}
+
+// mustEmbedUnimplementedExternalAccountServiceServer indicates an expected call of mustEmbedUnimplementedExternalAccountServiceServer.
+func (mr *MockExternalAccountServiceServerMockRecorder) mustEmbedUnimplementedExternalAccountServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedExternalAccountServiceServer", reflect.TypeOf((*MockExternalAccountServiceServer)(nil).mustEmbedUnimplementedExternalAccountServiceServer))
+}
+
+// MockUnsafeExternalAccountServiceServer is a mock of UnsafeExternalAccountServiceServer interface.
+type MockUnsafeExternalAccountServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeExternalAccountServiceServerMockRecorder
+}
+
+// MockUnsafeExternalAccountServiceServerMockRecorder is the mock recorder for MockUnsafeExternalAccountServiceServer.
+type MockUnsafeExternalAccountServiceServerMockRecorder struct {
+	mock *MockUnsafeExternalAccountServiceServer
+}
+
+// NewMockUnsafeExternalAccountServiceServer creates a new mock instance.
+func NewMockUnsafeExternalAccountServiceServer(ctrl *gomock.Controller) *MockUnsafeExternalAccountServiceServer {
+	mock := &MockUnsafeExternalAccountServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeExternalAccountServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeExternalAccountServiceServer) EXPECT() *MockUnsafeExternalAccountServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedExternalAccountServiceServer mocks base method.
+func (m *MockUnsafeExternalAccountServiceServer) mustEmbedUnimplementedExternalAccountServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedExternalAccountServiceServer")
+}
+
+// mustEmbedUnimplementedExternalAccountServiceServer indicates an expected call of mustEmbedUnimplementedExternalAccountServiceServer.
+func (mr *MockUnsafeExternalAccountServiceServerMockRecorder) mustEmbedUnimplementedExternalAccountServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedExternalAccountServiceServer", reflect.TypeOf((*MockUnsafeExternalAccountServiceServer)(nil).mustEmbedUnimplementedExternalAccountServiceServer))
+}
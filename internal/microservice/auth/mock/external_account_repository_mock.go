@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./iexternal_account_repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExternalAccountRepository is a mock of ExternalAccountRepository interface.
+type MockExternalAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalAccountRepositoryMockRecorder
+}
+
+// MockExternalAccountRepositoryMockRecorder is the mock recorder for MockExternalAccountRepository.
+type MockExternalAccountRepositoryMockRecorder struct {
+	mock *MockExternalAccountRepository
+}
+
+// NewMockExternalAccountRepository creates a new mock instance.
+func NewMockExternalAccountRepository(ctrl *gomock.Controller) *MockExternalAccountRepository {
+	mock := &MockExternalAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockExternalAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalAccountRepository) EXPECT() *MockExternalAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAccount mocks base method.
+func (m *MockExternalAccountRepository) AddAccount(account *domain_models.ExternalAccount, encryptedPassword []byte, ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccount", account, encryptedPassword, ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccount indicates an expected call of AddAccount.
+func (mr *MockExternalAccountRepositoryMockRecorder) AddAccount(account, encryptedPassword, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccount", reflect.TypeOf((*MockExternalAccountRepository)(nil).AddAccount), account, encryptedPassword, ctx)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockExternalAccountRepository) DeleteAccount(profileID, accountID uint32, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", profileID, accountID, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockExternalAccountRepositoryMockRecorder) DeleteAccount(profileID, accountID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockExternalAccountRepository)(nil).DeleteAccount), profileID, accountID, ctx)
+}
+
+// GetAccount mocks base method.
+func (m *MockExternalAccountRepository) GetAccount(profileID, accountID uint32, ctx context.Context) (*domain_models.ExternalAccount, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", profileID, accountID, ctx)
+	ret0, _ := ret[0].(*domain_models.ExternalAccount)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockExternalAccountRepositoryMockRecorder) GetAccount(profileID, accountID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockExternalAccountRepository)(nil).GetAccount), profileID, accountID, ctx)
+}
+
+// GetAccounts mocks base method.
+func (m *MockExternalAccountRepository) GetAccounts(profileID uint32, ctx context.Context) ([]*domain_models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccounts", profileID, ctx)
+	ret0, _ := ret[0].([]*domain_models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccounts indicates an expected call of GetAccounts.
+func (mr *MockExternalAccountRepositoryMockRecorder) GetAccounts(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockExternalAccountRepository)(nil).GetAccounts), profileID, ctx)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./iexternal_account_store.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExternalAccountStore is a mock of ExternalAccountStore interface.
+type MockExternalAccountStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalAccountStoreMockRecorder
+}
+
+// MockExternalAccountStoreMockRecorder is the mock recorder for MockExternalAccountStore.
+type MockExternalAccountStoreMockRecorder struct {
+	mock *MockExternalAccountStore
+}
+
+// NewMockExternalAccountStore creates a new mock instance.
+func NewMockExternalAccountStore(ctrl *gomock.Controller) *MockExternalAccountStore {
+	mock := &MockExternalAccountStore{ctrl: ctrl}
+	mock.recorder = &MockExternalAccountStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalAccountStore) EXPECT() *MockExternalAccountStoreMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockExternalAccountStore) Add(profileID uint32, account *domain_models.ExternalAccount, ctx context.Context) (*domain_models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", profileID, account, ctx)
+	ret0, _ := ret[0].(*domain_models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockExternalAccountStoreMockRecorder) Add(profileID, account, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockExternalAccountStore)(nil).Add), profileID, account, ctx)
+}
+
+// Delete mocks base method.
+func (m *MockExternalAccountStore) Delete(profileID, accountID uint32, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", profileID, accountID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExternalAccountStoreMockRecorder) Delete(profileID, accountID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExternalAccountStore)(nil).Delete), profileID, accountID, ctx)
+}
+
+// Get mocks base method.
+func (m *MockExternalAccountStore) Get(profileID, accountID uint32, ctx context.Context) (*domain_models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", profileID, accountID, ctx)
+	ret0, _ := ret[0].(*domain_models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExternalAccountStoreMockRecorder) Get(profileID, accountID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExternalAccountStore)(nil).Get), profileID, accountID, ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockExternalAccountStore) GetAll(profileID uint32, ctx context.Context) ([]*domain_models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", profileID, ctx)
+	ret0, _ := ret[0].([]*domain_models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockExternalAccountStoreMockRecorder) GetAll(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExternalAccountStore)(nil).GetAll), profileID, ctx)
+}
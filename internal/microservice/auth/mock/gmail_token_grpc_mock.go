@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./gmail_token_grpc.pb.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	proto "mail/internal/microservice/auth/proto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockGMailTokenServiceClient is a mock of GMailTokenServiceClient interface.
+type MockGMailTokenServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGMailTokenServiceClientMockRecorder
+}
+
+// MockGMailTokenServiceClientMockRecorder is the mock recorder for MockGMailTokenServiceClient.
+type MockGMailTokenServiceClientMockRecorder struct {
+	mock *MockGMailTokenServiceClient
+}
+
+// NewMockGMailTokenServiceClient creates a new mock instance.
+func NewMockGMailTokenServiceClient(ctrl *gomock.Controller) *MockGMailTokenServiceClient {
+	mock := &MockGMailTokenServiceClient{ctrl: ctrl}
+	mock.recorder = &MockGMailTokenServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGMailTokenServiceClient) EXPECT() *MockGMailTokenServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetGMailToken mocks base method.
+func (m *MockGMailTokenServiceClient) GetGMailToken(ctx context.Context, in *proto.GetGMailTokenRequest, opts ...grpc.CallOption) (*proto.GetGMailTokenReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGMailToken", varargs...)
+	ret0, _ := ret[0].(*proto.GetGMailTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailToken indicates an expected call of GetGMailToken.
+func (mr *MockGMailTokenServiceClientMockRecorder) GetGMailToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailToken", reflect.TypeOf((*MockGMailTokenServiceClient)(nil).GetGMailToken), varargs...)
+}
+
+// SaveGMailToken mocks base method.
+func (m *MockGMailTokenServiceClient) SaveGMailToken(ctx context.Context, in *proto.SaveGMailTokenRequest, opts ...grpc.CallOption) (*proto.SaveGMailTokenReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveGMailToken", varargs...)
+	ret0, _ := ret[0].(*proto.SaveGMailTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveGMailToken indicates an expected call of SaveGMailToken.
+func (mr *MockGMailTokenServiceClientMockRecorder) SaveGMailToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGMailToken", reflect.TypeOf((*MockGMailTokenServiceClient)(nil).SaveGMailToken), varargs...)
+}
+
+// MockGMailTokenServiceServer is a mock of GMailTokenServiceServer interface.
+type MockGMailTokenServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockGMailTokenServiceServerMockRecorder
+}
+
+// MockGMailTokenServiceServerMockRecorder is the mock recorder for MockGMailTokenServiceServer.
+type MockGMailTokenServiceServerMockRecorder struct {
+	mock *MockGMailTokenServiceServer
+}
+
+// NewMockGMailTokenServiceServer creates a new mock instance.
+func NewMockGMailTokenServiceServer(ctrl *gomock.Controller) *MockGMailTokenServiceServer {
+	mock := &MockGMailTokenServiceServer{ctrl: ctrl}
+	mock.recorder = &MockGMailTokenServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGMailTokenServiceServer) EXPECT() *MockGMailTokenServiceServerMockRecorder {
+	return m.recorder
+}
+
+// GetGMailToken mocks base method.
+func (m *MockGMailTokenServiceServer) GetGMailToken(arg0 context.Context, arg1 *proto.GetGMailTokenRequest) (*proto.GetGMailTokenReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailToken", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetGMailTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailToken indicates an expected call of GetGMailToken.
+func (mr *MockGMailTokenServiceServerMockRecorder) GetGMailToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailToken", reflect.TypeOf((*MockGMailTokenServiceServer)(nil).GetGMailToken), arg0, arg1)
+}
+
+// SaveGMailToken mocks base method.
+func (m *MockGMailTokenServiceServer) SaveGMailToken(arg0 context.Context, arg1 *proto.SaveGMailTokenRequest) (*proto.SaveGMailTokenReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveGMailToken", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SaveGMailTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveGMailToken indicates an expected call of SaveGMailToken.
+func (mr *MockGMailTokenServiceServerMockRecorder) SaveGMailToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGMailToken", reflect.TypeOf((*MockGMailTokenServiceServer)(nil).SaveGMailToken), arg0, arg1)
+}
+
+// mustEmbedUnimplementedGMailTokenServiceServer mocks base method.
+func (m *MockGMailTokenServiceServer) mustEmbedUnimplementedGMailTokenServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedGMailTokenServiceServer")
+}
+
+// mustEmbedUnimplementedGMailTokenServiceServer indicates an expected call of mustEmbedUnimplementedGMailTokenServiceServer.
+func (mr *MockGMailTokenServiceServerMockRecorder) mustEmbedUnimplementedGMailTokenServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedGMailTokenServiceServer", reflect.TypeOf((*MockGMailTokenServiceServer)(nil).mustEmbedUnimplementedGMailTokenServiceServer))
+}
+
+// MockUnsafeGMailTokenServiceServer is a mock of UnsafeGMailTokenServiceServer interface.
+type MockUnsafeGMailTokenServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeGMailTokenServiceServerMockRecorder
+}
+
+// MockUnsafeGMailTokenServiceServerMockRecorder is the mock recorder for MockUnsafeGMailTokenServiceServer.
+type MockUnsafeGMailTokenServiceServerMockRecorder struct {
+	mock *MockUnsafeGMailTokenServiceServer
+}
+
+// NewMockUnsafeGMailTokenServiceServer creates a new mock instance.
+func NewMockUnsafeGMailTokenServiceServer(ctrl *gomock.Controller) *MockUnsafeGMailTokenServiceServer {
+	mock := &MockUnsafeGMailTokenServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeGMailTokenServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeGMailTokenServiceServer) EXPECT() *MockUnsafeGMailTokenServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedGMailTokenServiceServer mocks base method.
+func (m *MockUnsafeGMailTokenServiceServer) mustEmbedUnimplementedGMailTokenServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedGMailTokenServiceServer")
+}
+
+// mustEmbedUnimplementedGMailTokenServiceServer indicates an expected call of mustEmbedUnimplementedGMailTokenServiceServer.
+func (mr *MockUnsafeGMailTokenServiceServerMockRecorder) mustEmbedUnimplementedGMailTokenServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedGMailTokenServiceServer", reflect.TypeOf((*MockUnsafeGMailTokenServiceServer)(nil).mustEmbedUnimplementedGMailTokenServiceServer))
+}
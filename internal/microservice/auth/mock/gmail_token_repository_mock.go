@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./igmail_token_repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGMailTokenRepository is a mock of GMailTokenRepository interface.
+type MockGMailTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGMailTokenRepositoryMockRecorder
+}
+
+// MockGMailTokenRepositoryMockRecorder is the mock recorder for MockGMailTokenRepository.
+type MockGMailTokenRepositoryMockRecorder struct {
+	mock *MockGMailTokenRepository
+}
+
+// NewMockGMailTokenRepository creates a new mock instance.
+func NewMockGMailTokenRepository(ctrl *gomock.Controller) *MockGMailTokenRepository {
+	mock := &MockGMailTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockGMailTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGMailTokenRepository) EXPECT() *MockGMailTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetToken mocks base method.
+func (m *MockGMailTokenRepository) GetToken(login string, ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToken", login, ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToken indicates an expected call of GetToken.
+func (mr *MockGMailTokenRepositoryMockRecorder) GetToken(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToken", reflect.TypeOf((*MockGMailTokenRepository)(nil).GetToken), login, ctx)
+}
+
+// SaveToken mocks base method.
+func (m *MockGMailTokenRepository) SaveToken(login string, token []byte, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveToken", login, token, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveToken indicates an expected call of SaveToken.
+func (mr *MockGMailTokenRepositoryMockRecorder) SaveToken(login, token, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveToken", reflect.TypeOf((*MockGMailTokenRepository)(nil).SaveToken), login, token, ctx)
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./igmail_token_store.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGMailTokenStore is a mock of GMailTokenStore interface.
+type MockGMailTokenStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockGMailTokenStoreMockRecorder
+}
+
+// MockGMailTokenStoreMockRecorder is the mock recorder for MockGMailTokenStore.
+type MockGMailTokenStoreMockRecorder struct {
+	mock *MockGMailTokenStore
+}
+
+// NewMockGMailTokenStore creates a new mock instance.
+func NewMockGMailTokenStore(ctrl *gomock.Controller) *MockGMailTokenStore {
+	mock := &MockGMailTokenStore{ctrl: ctrl}
+	mock.recorder = &MockGMailTokenStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGMailTokenStore) EXPECT() *MockGMailTokenStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGMailTokenStore) Get(login string, ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", login, ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGMailTokenStoreMockRecorder) Get(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGMailTokenStore)(nil).Get), login, ctx)
+}
+
+// Save mocks base method.
+func (m *MockGMailTokenStore) Save(login string, token []byte, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", login, token, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockGMailTokenStoreMockRecorder) Save(login, token, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockGMailTokenStore)(nil).Save), login, token, ctx)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./ilogin_attempt_repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoginAttemptRepository is a mock of LoginAttemptRepository interface.
+type MockLoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptRepositoryMockRecorder
+}
+
+// MockLoginAttemptRepositoryMockRecorder is the mock recorder for MockLoginAttemptRepository.
+type MockLoginAttemptRepositoryMockRecorder struct {
+	mock *MockLoginAttemptRepository
+}
+
+// NewMockLoginAttemptRepository creates a new mock instance.
+func NewMockLoginAttemptRepository(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+	mock := &MockLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptRepository) EXPECT() *MockLoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAttempt mocks base method.
+func (m *MockLoginAttemptRepository) AddAttempt(key string, now, since time.Time, ctx context.Context) (*domain_models.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttempt", key, now, since, ctx)
+	ret0, _ := ret[0].(*domain_models.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAttempt indicates an expected call of AddAttempt.
+func (mr *MockLoginAttemptRepositoryMockRecorder) AddAttempt(key, now, since, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttempt", reflect.TypeOf((*MockLoginAttemptRepository)(nil).AddAttempt), key, now, since, ctx)
+}
+
+// Get mocks base method.
+func (m *MockLoginAttemptRepository) Get(key string, ctx context.Context) (*domain_models.LoginAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key, ctx)
+	ret0, _ := ret[0].(*domain_models.LoginAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLoginAttemptRepositoryMockRecorder) Get(key, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoginAttemptRepository)(nil).Get), key, ctx)
+}
+
+// Lock mocks base method.
+func (m *MockLoginAttemptRepository) Lock(key string, until, now time.Time, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", key, until, now, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockLoginAttemptRepositoryMockRecorder) Lock(key, until, now, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockLoginAttemptRepository)(nil).Lock), key, until, now, ctx)
+}
+
+// RemoveAttempt mocks base method.
+func (m *MockLoginAttemptRepository) RemoveAttempt(key string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAttempt", key, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAttempt indicates an expected call of RemoveAttempt.
+func (mr *MockLoginAttemptRepositoryMockRecorder) RemoveAttempt(key, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAttempt", reflect.TypeOf((*MockLoginAttemptRepository)(nil).RemoveAttempt), key, ctx)
+}
+
+// Reset mocks base method.
+func (m *MockLoginAttemptRepository) Reset(key string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", key, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockLoginAttemptRepositoryMockRecorder) Reset(key, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockLoginAttemptRepository)(nil).Reset), key, ctx)
+}
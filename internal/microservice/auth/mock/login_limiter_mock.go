@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./ilogin_limiter.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoginLimiter is a mock of LoginLimiter interface.
+type MockLoginLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginLimiterMockRecorder
+}
+
+// MockLoginLimiterMockRecorder is the mock recorder for MockLoginLimiter.
+type MockLoginLimiterMockRecorder struct {
+	mock *MockLoginLimiter
+}
+
+// NewMockLoginLimiter creates a new mock instance.
+func NewMockLoginLimiter(ctrl *gomock.Controller) *MockLoginLimiter {
+	mock := &MockLoginLimiter{ctrl: ctrl}
+	mock.recorder = &MockLoginLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginLimiter) EXPECT() *MockLoginLimiterMockRecorder {
+	return m.recorder
+}
+
+// Attempt mocks base method.
+func (m *MockLoginLimiter) Attempt(login, ipAddress string, ctx context.Context) (time.Duration, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attempt", login, ipAddress, ctx)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Attempt indicates an expected call of Attempt.
+func (mr *MockLoginLimiterMockRecorder) Attempt(login, ipAddress, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attempt", reflect.TypeOf((*MockLoginLimiter)(nil).Attempt), login, ipAddress, ctx)
+}
+
+// RegisterSuccess mocks base method.
+func (m *MockLoginLimiter) RegisterSuccess(login, ipAddress string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSuccess", login, ipAddress, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterSuccess indicates an expected call of RegisterSuccess.
+func (mr *MockLoginLimiterMockRecorder) RegisterSuccess(login, ipAddress, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSuccess", reflect.TypeOf((*MockLoginLimiter)(nil).RegisterSuccess), login, ipAddress, ctx)
+}
+
+// Unlock mocks base method.
+func (m *MockLoginLimiter) Unlock(login, ipAddress string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", login, ipAddress, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockLoginLimiterMockRecorder) Unlock(login, ipAddress, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockLoginLimiter)(nil).Unlock), login, ipAddress, ctx)
+}
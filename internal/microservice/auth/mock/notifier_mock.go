@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./inotifier.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// SendLoginLockout mocks base method.
+func (m *MockNotifier) SendLoginLockout(recipient, ipAddress string, lockedUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendLoginLockout", recipient, ipAddress, lockedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendLoginLockout indicates an expected call of SendLoginLockout.
+func (mr *MockNotifierMockRecorder) SendLoginLockout(recipient, ipAddress, lockedUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLoginLockout", reflect.TypeOf((*MockNotifier)(nil).SendLoginLockout), recipient, ipAddress, lockedUntil)
+}
+
+// SendPasswordReset mocks base method.
+func (m *MockNotifier) SendPasswordReset(recipient, link string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordReset", recipient, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordReset indicates an expected call of SendPasswordReset.
+func (mr *MockNotifierMockRecorder) SendPasswordReset(recipient, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordReset", reflect.TypeOf((*MockNotifier)(nil).SendPasswordReset), recipient, link)
+}
+
+// SendRecoveryEmailVerification mocks base method.
+func (m *MockNotifier) SendRecoveryEmailVerification(recipient, link string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRecoveryEmailVerification", recipient, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendRecoveryEmailVerification indicates an expected call of SendRecoveryEmailVerification.
+func (mr *MockNotifierMockRecorder) SendRecoveryEmailVerification(recipient, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRecoveryEmailVerification", reflect.TypeOf((*MockNotifier)(nil).SendRecoveryEmailVerification), recipient, link)
+}
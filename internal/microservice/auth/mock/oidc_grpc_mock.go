@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./oidc_grpc.pb.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	proto "mail/internal/microservice/auth/proto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockOIDCServiceClient is a mock of OIDCServiceClient interface.
+type MockOIDCServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCServiceClientMockRecorder
+}
+
+// MockOIDCServiceClientMockRecorder is the mock recorder for MockOIDCServiceClient.
+type MockOIDCServiceClientMockRecorder struct {
+	mock *MockOIDCServiceClient
+}
+
+// NewMockOIDCServiceClient creates a new mock instance.
+func NewMockOIDCServiceClient(ctrl *gomock.Controller) *MockOIDCServiceClient {
+	mock := &MockOIDCServiceClient{ctrl: ctrl}
+	mock.recorder = &MockOIDCServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCServiceClient) EXPECT() *MockOIDCServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AuthorizeOIDC mocks base method.
+func (m *MockOIDCServiceClient) AuthorizeOIDC(ctx context.Context, in *proto.AuthorizeOIDCRequest, opts ...grpc.CallOption) (*proto.AuthorizeOIDCReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthorizeOIDC", varargs...)
+	ret0, _ := ret[0].(*proto.AuthorizeOIDCReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeOIDC indicates an expected call of AuthorizeOIDC.
+func (mr *MockOIDCServiceClientMockRecorder) AuthorizeOIDC(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeOIDC", reflect.TypeOf((*MockOIDCServiceClient)(nil).AuthorizeOIDC), varargs...)
+}
+
+// ExchangeOIDCCode mocks base method.
+func (m *MockOIDCServiceClient) ExchangeOIDCCode(ctx context.Context, in *proto.ExchangeOIDCCodeRequest, opts ...grpc.CallOption) (*proto.ExchangeOIDCCodeReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExchangeOIDCCode", varargs...)
+	ret0, _ := ret[0].(*proto.ExchangeOIDCCodeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeOIDCCode indicates an expected call of ExchangeOIDCCode.
+func (mr *MockOIDCServiceClientMockRecorder) ExchangeOIDCCode(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeOIDCCode", reflect.TypeOf((*MockOIDCServiceClient)(nil).ExchangeOIDCCode), varargs...)
+}
+
+// GetOIDCConsent mocks base method.
+func (m *MockOIDCServiceClient) GetOIDCConsent(ctx context.Context, in *proto.GetOIDCConsentRequest, opts ...grpc.CallOption) (*proto.GetOIDCConsentReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOIDCConsent", varargs...)
+	ret0, _ := ret[0].(*proto.GetOIDCConsentReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCConsent indicates an expected call of GetOIDCConsent.
+func (mr *MockOIDCServiceClientMockRecorder) GetOIDCConsent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCConsent", reflect.TypeOf((*MockOIDCServiceClient)(nil).GetOIDCConsent), varargs...)
+}
+
+// GetOIDCKeys mocks base method.
+func (m *MockOIDCServiceClient) GetOIDCKeys(ctx context.Context, in *proto.GetOIDCKeysRequest, opts ...grpc.CallOption) (*proto.GetOIDCKeysReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOIDCKeys", varargs...)
+	ret0, _ := ret[0].(*proto.GetOIDCKeysReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCKeys indicates an expected call of GetOIDCKeys.
+func (mr *MockOIDCServiceClientMockRecorder) GetOIDCKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCKeys", reflect.TypeOf((*MockOIDCServiceClient)(nil).GetOIDCKeys), varargs...)
+}
+
+// GetOIDCUserInfo mocks base method.
+func (m *MockOIDCServiceClient) GetOIDCUserInfo(ctx context.Context, in *proto.GetOIDCUserInfoRequest, opts ...grpc.CallOption) (*proto.GetOIDCUserInfoReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOIDCUserInfo", varargs...)
+	ret0, _ := ret[0].(*proto.GetOIDCUserInfoReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCUserInfo indicates an expected call of GetOIDCUserInfo.
+func (mr *MockOIDCServiceClientMockRecorder) GetOIDCUserInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCUserInfo", reflect.TypeOf((*MockOIDCServiceClient)(nil).GetOIDCUserInfo), varargs...)
+}
+
+// GrantOIDCConsent mocks base method.
+func (m *MockOIDCServiceClient) GrantOIDCConsent(ctx context.Context, in *proto.GrantOIDCConsentRequest, opts ...grpc.CallOption) (*proto.GrantOIDCConsentReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GrantOIDCConsent", varargs...)
+	ret0, _ := ret[0].(*proto.GrantOIDCConsentReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantOIDCConsent indicates an expected call of GrantOIDCConsent.
+func (mr *MockOIDCServiceClientMockRecorder) GrantOIDCConsent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantOIDCConsent", reflect.TypeOf((*MockOIDCServiceClient)(nil).GrantOIDCConsent), varargs...)
+}
+
+// RegisterOIDCClient mocks base method.
+func (m *MockOIDCServiceClient) RegisterOIDCClient(ctx context.Context, in *proto.RegisterOIDCClientRequest, opts ...grpc.CallOption) (*proto.RegisterOIDCClientReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterOIDCClient", varargs...)
+	ret0, _ := ret[0].(*proto.RegisterOIDCClientReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterOIDCClient indicates an expected call of RegisterOIDCClient.
+func (mr *MockOIDCServiceClientMockRecorder) RegisterOIDCClient(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterOIDCClient", reflect.TypeOf((*MockOIDCServiceClient)(nil).RegisterOIDCClient), varargs...)
+}
+
+// MockOIDCServiceServer is a mock of OIDCServiceServer interface.
+type MockOIDCServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCServiceServerMockRecorder
+}
+
+// MockOIDCServiceServerMockRecorder is the mock recorder for MockOIDCServiceServer.
+type MockOIDCServiceServerMockRecorder struct {
+	mock *MockOIDCServiceServer
+}
+
+// NewMockOIDCServiceServer creates a new mock instance.
+func NewMockOIDCServiceServer(ctrl *gomock.Controller) *MockOIDCServiceServer {
+	mock := &MockOIDCServiceServer{ctrl: ctrl}
+	mock.recorder = &MockOIDCServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCServiceServer) EXPECT() *MockOIDCServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AuthorizeOIDC mocks base method.
+func (m *MockOIDCServiceServer) AuthorizeOIDC(arg0 context.Context, arg1 *proto.AuthorizeOIDCRequest) (*proto.AuthorizeOIDCReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeOIDC", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AuthorizeOIDCReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeOIDC indicates an expected call of AuthorizeOIDC.
+func (mr *MockOIDCServiceServerMockRecorder) AuthorizeOIDC(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeOIDC", reflect.TypeOf((*MockOIDCServiceServer)(nil).AuthorizeOIDC), arg0, arg1)
+}
+
+// ExchangeOIDCCode mocks base method.
+func (m *MockOIDCServiceServer) ExchangeOIDCCode(arg0 context.Context, arg1 *proto.ExchangeOIDCCodeRequest) (*proto.ExchangeOIDCCodeReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeOIDCCode", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ExchangeOIDCCodeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeOIDCCode indicates an expected call of ExchangeOIDCCode.
+func (mr *MockOIDCServiceServerMockRecorder) ExchangeOIDCCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeOIDCCode", reflect.TypeOf((*MockOIDCServiceServer)(nil).ExchangeOIDCCode), arg0, arg1)
+}
+
+// GetOIDCConsent mocks base method.
+func (m *MockOIDCServiceServer) GetOIDCConsent(arg0 context.Context, arg1 *proto.GetOIDCConsentRequest) (*proto.GetOIDCConsentReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOIDCConsent", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetOIDCConsentReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCConsent indicates an expected call of GetOIDCConsent.
+func (mr *MockOIDCServiceServerMockRecorder) GetOIDCConsent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCConsent", reflect.TypeOf((*MockOIDCServiceServer)(nil).GetOIDCConsent), arg0, arg1)
+}
+
+// GetOIDCKeys mocks base method.
+func (m *MockOIDCServiceServer) GetOIDCKeys(arg0 context.Context, arg1 *proto.GetOIDCKeysRequest) (*proto.GetOIDCKeysReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOIDCKeys", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetOIDCKeysReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCKeys indicates an expected call of GetOIDCKeys.
+func (mr *MockOIDCServiceServerMockRecorder) GetOIDCKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCKeys", reflect.TypeOf((*MockOIDCServiceServer)(nil).GetOIDCKeys), arg0, arg1)
+}
+
+// GetOIDCUserInfo mocks base method.
+func (m *MockOIDCServiceServer) GetOIDCUserInfo(arg0 context.Context, arg1 *proto.GetOIDCUserInfoRequest) (*proto.GetOIDCUserInfoReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOIDCUserInfo", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetOIDCUserInfoReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCUserInfo indicates an expected call of GetOIDCUserInfo.
+func (mr *MockOIDCServiceServerMockRecorder) GetOIDCUserInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCUserInfo", reflect.TypeOf((*MockOIDCServiceServer)(nil).GetOIDCUserInfo), arg0, arg1)
+}
+
+// GrantOIDCConsent mocks base method.
+func (m *MockOIDCServiceServer) GrantOIDCConsent(arg0 context.Context, arg1 *proto.GrantOIDCConsentRequest) (*proto.GrantOIDCConsentReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantOIDCConsent", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GrantOIDCConsentReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantOIDCConsent indicates an expected call of GrantOIDCConsent.
+func (mr *MockOIDCServiceServerMockRecorder) GrantOIDCConsent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantOIDCConsent", reflect.TypeOf((*MockOIDCServiceServer)(nil).GrantOIDCConsent), arg0, arg1)
+}
+
+// RegisterOIDCClient mocks base method.
+func (m *MockOIDCServiceServer) RegisterOIDCClient(arg0 context.Context, arg1 *proto.RegisterOIDCClientRequest) (*proto.RegisterOIDCClientReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterOIDCClient", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RegisterOIDCClientReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterOIDCClient indicates an expected call of RegisterOIDCClient.
+func (mr *MockOIDCServiceServerMockRecorder) RegisterOIDCClient(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterOIDCClient", reflect.TypeOf((*MockOIDCServiceServer)(nil).RegisterOIDCClient), arg0, arg1)
+}
+
+// mustEmbedUnimplementedOIDCServiceServer mocks base method.
+func (m *MockOIDCServiceServer) mustEmbedUnimplementedOIDCServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedOIDCServiceServer")
+}
+
+// mustEmbedUnimplementedOIDCServiceServer indicates an expected call of mustEmbedUnimplementedOIDCServiceServer.
+func (mr *MockOIDCServiceServerMockRecorder) mustEmbedUnimplementedOIDCServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedOIDCServiceServer", reflect.TypeOf((*MockOIDCServiceServer)(nil).mustEmbedUnimplementedOIDCServiceServer))
+}
+
+// MockUnsafeOIDCServiceServer is a mock of UnsafeOIDCServiceServer interface.
+type MockUnsafeOIDCServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeOIDCServiceServerMockRecorder
+}
+
+// MockUnsafeOIDCServiceServerMockRecorder is the mock recorder for MockUnsafeOIDCServiceServer.
+type MockUnsafeOIDCServiceServerMockRecorder struct {
+	mock *MockUnsafeOIDCServiceServer
+}
+
+// NewMockUnsafeOIDCServiceServer creates a new mock instance.
+func NewMockUnsafeOIDCServiceServer(ctrl *gomock.Controller) *MockUnsafeOIDCServiceServer {
+	mock := &MockUnsafeOIDCServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeOIDCServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeOIDCServiceServer) EXPECT() *MockUnsafeOIDCServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedOIDCServiceServer mocks base method.
+func (m *MockUnsafeOIDCServiceServer) mustEmbedUnimplementedOIDCServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedOIDCServiceServer")
+}
+
+// mustEmbedUnimplementedOIDCServiceServer indicates an expected call of mustEmbedUnimplementedOIDCServiceServer.
+func (mr *MockUnsafeOIDCServiceServerMockRecorder) mustEmbedUnimplementedOIDCServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedOIDCServiceServer", reflect.TypeOf((*MockUnsafeOIDCServiceServer)(nil).mustEmbedUnimplementedOIDCServiceServer))
+}
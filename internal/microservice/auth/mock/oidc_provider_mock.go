@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./ioidc_provider.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	jwt "mail/internal/pkg/utils/jwt"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOIDCProvider is a mock of OIDCProvider interface.
+type MockOIDCProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCProviderMockRecorder
+}
+
+// MockOIDCProviderMockRecorder is the mock recorder for MockOIDCProvider.
+type MockOIDCProviderMockRecorder struct {
+	mock *MockOIDCProvider
+}
+
+// NewMockOIDCProvider creates a new mock instance.
+func NewMockOIDCProvider(ctrl *gomock.Controller) *MockOIDCProvider {
+	mock := &MockOIDCProvider{ctrl: ctrl}
+	mock.recorder = &MockOIDCProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCProvider) EXPECT() *MockOIDCProviderMockRecorder {
+	return m.recorder
+}
+
+// ExchangeAuthorizationCode mocks base method.
+func (m *MockOIDCProvider) ExchangeAuthorizationCode(code string, client *domain_models.OIDCClient, redirectURI, codeVerifier string, ctx context.Context) (*domain_models.OIDCAuthorizationCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeAuthorizationCode", code, client, redirectURI, codeVerifier, ctx)
+	ret0, _ := ret[0].(*domain_models.OIDCAuthorizationCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeAuthorizationCode indicates an expected call of ExchangeAuthorizationCode.
+func (mr *MockOIDCProviderMockRecorder) ExchangeAuthorizationCode(code, client, redirectURI, codeVerifier, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeAuthorizationCode", reflect.TypeOf((*MockOIDCProvider)(nil).ExchangeAuthorizationCode), code, client, redirectURI, codeVerifier, ctx)
+}
+
+// GetClient mocks base method.
+func (m *MockOIDCProvider) GetClient(clientID string, ctx context.Context) (*domain_models.OIDCClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient", clientID, ctx)
+	ret0, _ := ret[0].(*domain_models.OIDCClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockOIDCProviderMockRecorder) GetClient(clientID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockOIDCProvider)(nil).GetClient), clientID, ctx)
+}
+
+// GrantConsent mocks base method.
+func (m *MockOIDCProvider) GrantConsent(request *domain_models.OIDCAuthorizationRequest, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantConsent", request, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantConsent indicates an expected call of GrantConsent.
+func (mr *MockOIDCProviderMockRecorder) GrantConsent(request, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantConsent", reflect.TypeOf((*MockOIDCProvider)(nil).GrantConsent), request, ctx)
+}
+
+// HasConsent mocks base method.
+func (m *MockOIDCProvider) HasConsent(request *domain_models.OIDCAuthorizationRequest, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasConsent", request, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasConsent indicates an expected call of HasConsent.
+func (mr *MockOIDCProviderMockRecorder) HasConsent(request, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasConsent", reflect.TypeOf((*MockOIDCProvider)(nil).HasConsent), request, ctx)
+}
+
+// IssueAuthorizationCode mocks base method.
+func (m *MockOIDCProvider) IssueAuthorizationCode(request *domain_models.OIDCAuthorizationRequest, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueAuthorizationCode", request, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueAuthorizationCode indicates an expected call of IssueAuthorizationCode.
+func (mr *MockOIDCProviderMockRecorder) IssueAuthorizationCode(request, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueAuthorizationCode", reflect.TypeOf((*MockOIDCProvider)(nil).IssueAuthorizationCode), request, ctx)
+}
+
+// IssueTokens mocks base method.
+func (m *MockOIDCProvider) IssueTokens(code *domain_models.OIDCAuthorizationCode, ctx context.Context) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTokens", code, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// IssueTokens indicates an expected call of IssueTokens.
+func (mr *MockOIDCProviderMockRecorder) IssueTokens(code, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTokens", reflect.TypeOf((*MockOIDCProvider)(nil).IssueTokens), code, ctx)
+}
+
+// PublicKeys mocks base method.
+func (m *MockOIDCProvider) PublicKeys(ctx context.Context) ([]jwt.JWK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys", ctx)
+	ret0, _ := ret[0].([]jwt.JWK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockOIDCProviderMockRecorder) PublicKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockOIDCProvider)(nil).PublicKeys), ctx)
+}
+
+// RegisterClient mocks base method.
+func (m *MockOIDCProvider) RegisterClient(name string, redirectURIs []string, public bool, ctx context.Context) (*domain_models.OIDCClient, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterClient", name, redirectURIs, public, ctx)
+	ret0, _ := ret[0].(*domain_models.OIDCClient)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RegisterClient indicates an expected call of RegisterClient.
+func (mr *MockOIDCProviderMockRecorder) RegisterClient(name, redirectURIs, public, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterClient", reflect.TypeOf((*MockOIDCProvider)(nil).RegisterClient), name, redirectURIs, public, ctx)
+}
+
+// SignConsentRequest mocks base method.
+func (m *MockOIDCProvider) SignConsentRequest(request *domain_models.OIDCAuthorizationRequest) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignConsentRequest", request)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SignConsentRequest indicates an expected call of SignConsentRequest.
+func (mr *MockOIDCProviderMockRecorder) SignConsentRequest(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignConsentRequest", reflect.TypeOf((*MockOIDCProvider)(nil).SignConsentRequest), request)
+}
+
+// VerifyAccessToken mocks base method.
+func (m *MockOIDCProvider) VerifyAccessToken(accessToken string, ctx context.Context) (*domain_models.OIDCAccessTokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAccessToken", accessToken, ctx)
+	ret0, _ := ret[0].(*domain_models.OIDCAccessTokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAccessToken indicates an expected call of VerifyAccessToken.
+func (mr *MockOIDCProviderMockRecorder) VerifyAccessToken(accessToken, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAccessToken", reflect.TypeOf((*MockOIDCProvider)(nil).VerifyAccessToken), accessToken, ctx)
+}
+
+// VerifyConsentRequest mocks base method.
+func (m *MockOIDCProvider) VerifyConsentRequest(consentRequest string) (*domain_models.OIDCAuthorizationRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyConsentRequest", consentRequest)
+	ret0, _ := ret[0].(*domain_models.OIDCAuthorizationRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyConsentRequest indicates an expected call of VerifyConsentRequest.
+func (mr *MockOIDCProviderMockRecorder) VerifyConsentRequest(consentRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyConsentRequest", reflect.TypeOf((*MockOIDCProvider)(nil).VerifyConsentRequest), consentRequest)
+}
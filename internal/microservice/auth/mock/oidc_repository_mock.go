@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./ioidc_repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOIDCRepository is a mock of OIDCRepository interface.
+type MockOIDCRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCRepositoryMockRecorder
+}
+
+// MockOIDCRepositoryMockRecorder is the mock recorder for MockOIDCRepository.
+type MockOIDCRepositoryMockRecorder struct {
+	mock *MockOIDCRepository
+}
+
+// NewMockOIDCRepository creates a new mock instance.
+func NewMockOIDCRepository(ctrl *gomock.Controller) *MockOIDCRepository {
+	mock := &MockOIDCRepository{ctrl: ctrl}
+	mock.recorder = &MockOIDCRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCRepository) EXPECT() *MockOIDCRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSigningKey mocks base method.
+func (m *MockOIDCRepository) AddSigningKey(key *domain_models.OIDCSigningKey, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSigningKey", key, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSigningKey indicates an expected call of AddSigningKey.
+func (mr *MockOIDCRepositoryMockRecorder) AddSigningKey(key, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSigningKey", reflect.TypeOf((*MockOIDCRepository)(nil).AddSigningKey), key, ctx)
+}
+
+// CreateAuthorizationCode mocks base method.
+func (m *MockOIDCRepository) CreateAuthorizationCode(code *domain_models.OIDCAuthorizationCode, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthorizationCode", code, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuthorizationCode indicates an expected call of CreateAuthorizationCode.
+func (mr *MockOIDCRepositoryMockRecorder) CreateAuthorizationCode(code, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthorizationCode", reflect.TypeOf((*MockOIDCRepository)(nil).CreateAuthorizationCode), code, ctx)
+}
+
+// CreateClient mocks base method.
+func (m *MockOIDCRepository) CreateClient(client *domain_models.OIDCClient, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClient", client, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClient indicates an expected call of CreateClient.
+func (mr *MockOIDCRepositoryMockRecorder) CreateClient(client, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClient", reflect.TypeOf((*MockOIDCRepository)(nil).CreateClient), client, ctx)
+}
+
+// DeleteSigningKeys mocks base method.
+func (m *MockOIDCRepository) DeleteSigningKeys(createdBefore time.Time, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSigningKeys", createdBefore, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSigningKeys indicates an expected call of DeleteSigningKeys.
+func (mr *MockOIDCRepositoryMockRecorder) DeleteSigningKeys(createdBefore, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSigningKeys", reflect.TypeOf((*MockOIDCRepository)(nil).DeleteSigningKeys), createdBefore, ctx)
+}
+
+// GetClient mocks base method.
+func (m *MockOIDCRepository) GetClient(clientID string, ctx context.Context) (*domain_models.OIDCClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient", clientID, ctx)
+	ret0, _ := ret[0].(*domain_models.OIDCClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockOIDCRepositoryMockRecorder) GetClient(clientID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockOIDCRepository)(nil).GetClient), clientID, ctx)
+}
+
+// GetConsent mocks base method.
+func (m *MockOIDCRepository) GetConsent(profileID uint32, clientID string, ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsent", profileID, clientID, ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsent indicates an expected call of GetConsent.
+func (mr *MockOIDCRepositoryMockRecorder) GetConsent(profileID, clientID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsent", reflect.TypeOf((*MockOIDCRepository)(nil).GetConsent), profileID, clientID, ctx)
+}
+
+// GetSigningKeys mocks base method.
+func (m *MockOIDCRepository) GetSigningKeys(ctx context.Context) ([]*domain_models.OIDCSigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSigningKeys", ctx)
+	ret0, _ := ret[0].([]*domain_models.OIDCSigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSigningKeys indicates an expected call of GetSigningKeys.
+func (mr *MockOIDCRepositoryMockRecorder) GetSigningKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningKeys", reflect.TypeOf((*MockOIDCRepository)(nil).GetSigningKeys), ctx)
+}
+
+// SaveConsent mocks base method.
+func (m *MockOIDCRepository) SaveConsent(profileID uint32, clientID string, scopes []string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConsent", profileID, clientID, scopes, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConsent indicates an expected call of SaveConsent.
+func (mr *MockOIDCRepositoryMockRecorder) SaveConsent(profileID, clientID, scopes, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConsent", reflect.TypeOf((*MockOIDCRepository)(nil).SaveConsent), profileID, clientID, scopes, ctx)
+}
+
+// TakeAuthorizationCode mocks base method.
+func (m *MockOIDCRepository) TakeAuthorizationCode(codeHash string, ctx context.Context) (*domain_models.OIDCAuthorizationCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeAuthorizationCode", codeHash, ctx)
+	ret0, _ := ret[0].(*domain_models.OIDCAuthorizationCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeAuthorizationCode indicates an expected call of TakeAuthorizationCode.
+func (mr *MockOIDCRepositoryMockRecorder) TakeAuthorizationCode(codeHash, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeAuthorizationCode", reflect.TypeOf((*MockOIDCRepository)(nil).TakeAuthorizationCode), codeHash, ctx)
+}
@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// LogNotifier writes security messages to a log instead of sending them.
+// It is meant for development, where no outbound mail is available.
+type LogNotifier struct {
+	Logger *log.Logger
+}
+
+// NewLogNotifier creates a new instance of LogNotifier writing to the standard output.
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{Logger: log.New(os.Stdout, "notifier: ", log.LstdFlags)}
+}
+
+// SendPasswordReset writes the password reset link of the recipient to the log.
+func (n *LogNotifier) SendPasswordReset(recipient, link string) error {
+	n.Logger.Printf("password reset link for %s: %s", recipient, link)
+
+	return nil
+}
+
+// SendRecoveryEmailVerification writes the recovery email verification link of the recipient to the log.
+func (n *LogNotifier) SendRecoveryEmailVerification(recipient, link string) error {
+	n.Logger.Printf("recovery email verification link for %s: %s", recipient, link)
+
+	return nil
+}
+
+// SendLoginLockout writes the login lockout of the recipient to the log.
+func (n *LogNotifier) SendLoginLockout(recipient, ipAddress string, lockedUntil time.Time) error {
+	n.Logger.Printf("login %s locked until %s after failed attempts from %s", recipient, lockedUntil.Format(time.RFC3339), ipAddress)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package notifier
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogNotifier(t *testing.T) {
+	var buf bytes.Buffer
+	n := &LogNotifier{Logger: log.New(&buf, "", 0)}
+
+	assert.NoError(t, n.SendPasswordReset("user@example.com", "https://mailhub.su/reset?token=abc"))
+	assert.Equal(t, "password reset link for user@example.com: https://mailhub.su/reset?token=abc\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, n.SendRecoveryEmailVerification("user@example.com", "https://mailhub.su/verify-recovery-email?token=abc"))
+	assert.Equal(t, "recovery email verification link for user@example.com: https://mailhub.su/verify-recovery-email?token=abc\n", buf.String())
+
+	buf.Reset()
+	lockedUntil := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, n.SendLoginLockout("user@mailhub.su", "127.0.0.1", lockedUntil))
+	assert.Equal(t, "login user@mailhub.su locked until 2024-05-01T12:00:00Z after failed attempts from 127.0.0.1\n", buf.String())
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var gotAddr, gotFrom string
+		var gotTo []string
+		var gotMsg []byte
+
+		n := &SMTPNotifier{
+			Sender: NotificationSender,
+			LookupMX: func(name string) ([]*net.MX, error) {
+				assert.Equal(t, "example.com", name)
+				return []*net.MX{{Host: "mx.example.com.", Pref: 10}}, nil
+			},
+			SendMail: func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+				gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, msg
+				return nil
+			},
+		}
+
+		assert.NoError(t, n.SendPasswordReset("user@example.com", "https://mailhub.su/reset?token=abc"))
+		assert.Equal(t, "mx.example.com:25", gotAddr)
+		assert.Equal(t, NotificationSender, gotFrom)
+		assert.Equal(t, []string{"user@example.com"}, gotTo)
+		assert.True(t, strings.Contains(string(gotMsg), "https://mailhub.su/reset?token=abc"))
+	})
+
+	t.Run("LoginLockout", func(t *testing.T) {
+		var gotMsg []byte
+
+		n := &SMTPNotifier{
+			Sender:   NotificationSender,
+			LookupMX: func(string) ([]*net.MX, error) { return []*net.MX{{Host: "mx.mailhub.su."}}, nil },
+			SendMail: func(_ string, _ smtp.Auth, _ string, _ []string, msg []byte) error {
+				gotMsg = msg
+				return nil
+			},
+		}
+
+		assert.NoError(t, n.SendLoginLockout("user@mailhub.su", "10.0.0.1", time.Now().Add(time.Minute)))
+		assert.True(t, strings.Contains(string(gotMsg), "Subject: MailHub sign-in blocked"))
+		assert.True(t, strings.Contains(string(gotMsg), "10.0.0.1"))
+	})
+
+	t.Run("RecoveryEmailVerification", func(t *testing.T) {
+		var gotTo []string
+		var gotMsg []byte
+
+		n := &SMTPNotifier{
+			Sender:   NotificationSender,
+			LookupMX: func(string) ([]*net.MX, error) { return []*net.MX{{Host: "mx.example.com."}}, nil },
+			SendMail: func(_ string, _ smtp.Auth, _ string, to []string, msg []byte) error {
+				gotTo, gotMsg = to, msg
+				return nil
+			},
+		}
+
+		assert.NoError(t, n.SendRecoveryEmailVerification("user@example.com", "https://mailhub.su/verify-recovery-email?token=abc"))
+		assert.Equal(t, []string{"user@example.com"}, gotTo)
+		assert.True(t, strings.Contains(string(gotMsg), "Subject: Confirm your MailHub recovery email"))
+		assert.True(t, strings.Contains(string(gotMsg), "https://mailhub.su/verify-recovery-email?token=abc"))
+	})
+
+	t.Run("NoMailServer", func(t *testing.T) {
+		n := &SMTPNotifier{
+			LookupMX: func(string) ([]*net.MX, error) { return nil, fmt.Errorf("no such host") },
+		}
+
+		assert.Error(t, n.SendPasswordReset("user@example.com", "link"))
+	})
+
+	t.Run("InvalidRecipient", func(t *testing.T) {
+		assert.Error(t, NewSMTPNotifier().SendPasswordReset("user", "link"))
+	})
+}
@@ -0,0 +1,97 @@
+package notifier
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// NotificationSender is the address security messages are sent from.
+const NotificationSender = "noreply@mailhub.su"
+
+// SMTPNotifier sends security messages by email directly to the mail server of the recipient domain.
+type SMTPNotifier struct {
+	Sender   string
+	SendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+	LookupMX func(name string) ([]*net.MX, error)
+}
+
+// NewSMTPNotifier creates a new instance of SMTPNotifier.
+func NewSMTPNotifier() *SMTPNotifier {
+	return &SMTPNotifier{
+		Sender:   NotificationSender,
+		SendMail: smtp.SendMail,
+		LookupMX: net.LookupMX,
+	}
+}
+
+// SendPasswordReset sends the password reset link to the recipient address.
+func (n *SMTPNotifier) SendPasswordReset(recipient, link string) error {
+	body := "Someone asked to reset the password of your MailHub account.\r\n" +
+		"Follow the link to set a new password, it is valid for one hour and can be used once:\r\n" +
+		link + "\r\n" +
+		"\r\n" +
+		"If it was not you, ignore this message.\r\n"
+
+	return n.send(recipient, "MailHub password reset", body)
+}
+
+// SendRecoveryEmailVerification sends the link verifying the recovery email to the recipient address.
+func (n *SMTPNotifier) SendRecoveryEmailVerification(recipient, link string) error {
+	body := "This address has been set as the recovery email of a MailHub account.\r\n" +
+		"Follow the link to confirm it, it is valid for 24 hours:\r\n" +
+		link + "\r\n" +
+		"\r\n" +
+		"Password reset links are sent only to a confirmed address. If you did not ask for it, ignore this message.\r\n"
+
+	return n.send(recipient, "Confirm your MailHub recovery email", body)
+}
+
+// SendLoginLockout warns the owner of the recipient account that its login has been locked.
+func (n *SMTPNotifier) SendLoginLockout(recipient, ipAddress string, lockedUntil time.Time) error {
+	body := "There were too many failed attempts to sign in to your MailHub account, the last of them from " + ipAddress + ".\r\n" +
+		"Signing in is blocked until " + lockedUntil.Format("02.01.2006 15:04 MST") + ".\r\n" +
+		"\r\n" +
+		"If it was not you, someone may be guessing your password. Consider changing it and enabling two-factor authentication.\r\n"
+
+	return n.send(recipient, "MailHub sign-in blocked", body)
+}
+
+// send sends a plain text message to the recipient address.
+func (n *SMTPNotifier) send(recipient, subject, body string) error {
+	at := strings.LastIndex(recipient, "@")
+	if at < 0 {
+		return fmt.Errorf("invalid recipient address")
+	}
+
+	mxRecords, err := n.LookupMX(recipient[at+1:])
+	if err != nil || len(mxRecords) == 0 {
+		return fmt.Errorf("failed to find mail server of %s", recipient[at+1:])
+	}
+	mx := strings.TrimSuffix(mxRecords[0].Host, ".")
+
+	msg := composeMail(n.Sender, recipient, subject, body)
+
+	if err = n.SendMail(mx+":25", nil, n.Sender, []string{recipient}, msg); err != nil {
+		return fmt.Errorf("failed to send mail: %v", err)
+	}
+
+	return nil
+}
+
+// composeMail builds a plain text message.
+func composeMail(from, to, subject, body string) []byte {
+	var msg strings.Builder
+
+	msg.WriteString("From: " + from + "\r\n")
+	msg.WriteString("To: " + to + "\r\n")
+	msg.WriteString("Subject: " + subject + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(body)
+
+	return []byte(msg.String())
+}
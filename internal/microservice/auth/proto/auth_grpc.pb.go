@@ -31,6 +31,14 @@ type AuthServiceClient interface {
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error)
 	LoginOtherMail(ctx context.Context, in *LoginOtherMailRequest, opts ...grpc.CallOption) (*LoginReply, error)
 	SignupOtherMail(ctx context.Context, in *SignupOtherMailRequest, opts ...grpc.CallOption) (*SignupReply, error)
+	LoginTwoFactor(ctx context.Context, in *LoginTwoFactorRequest, opts ...grpc.CallOption) (*LoginReply, error)
+	ChangePassword(ctx context.Context, in *PasswordChangeRequest, opts ...grpc.CallOption) (*PasswordChangeReply, error)
+	RequestPasswordReset(ctx context.Context, in *PasswordResetLinkRequest, opts ...grpc.CallOption) (*PasswordResetLinkReply, error)
+	ResetPassword(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetReply, error)
+	UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginReply, error)
+	SetRecoveryEmail(ctx context.Context, in *RecoveryEmailChangeRequest, opts ...grpc.CallOption) (*RecoveryEmailChangeReply, error)
+	SendRecoveryEmailVerification(ctx context.Context, in *RecoveryEmailVerificationRequest, opts ...grpc.CallOption) (*RecoveryEmailVerificationReply, error)
+	VerifyRecoveryEmail(ctx context.Context, in *VerifyRecoveryEmailRequest, opts ...grpc.CallOption) (*VerifyRecoveryEmailReply, error)
 }
 
 type authServiceClient struct {
@@ -104,6 +112,78 @@ func (c *authServiceClient) SignupOtherMail(ctx context.Context, in *SignupOther
 	return out, nil
 }
 
+func (c *authServiceClient) LoginTwoFactor(ctx context.Context, in *LoginTwoFactorRequest, opts ...grpc.CallOption) (*LoginReply, error) {
+	out := new(LoginReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/LoginTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangePassword(ctx context.Context, in *PasswordChangeRequest, opts ...grpc.CallOption) (*PasswordChangeReply, error) {
+	out := new(PasswordChangeReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, in *PasswordResetLinkRequest, opts ...grpc.CallOption) (*PasswordResetLinkReply, error) {
+	out := new(PasswordResetLinkReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetPassword(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetReply, error) {
+	out := new(PasswordResetReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginReply, error) {
+	out := new(UnlockLoginReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/UnlockLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SetRecoveryEmail(ctx context.Context, in *RecoveryEmailChangeRequest, opts ...grpc.CallOption) (*RecoveryEmailChangeReply, error) {
+	out := new(RecoveryEmailChangeReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/SetRecoveryEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SendRecoveryEmailVerification(ctx context.Context, in *RecoveryEmailVerificationRequest, opts ...grpc.CallOption) (*RecoveryEmailVerificationReply, error) {
+	out := new(RecoveryEmailVerificationReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/SendRecoveryEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyRecoveryEmail(ctx context.Context, in *VerifyRecoveryEmailRequest, opts ...grpc.CallOption) (*VerifyRecoveryEmailReply, error) {
+	out := new(VerifyRecoveryEmailReply)
+	err := c.cc.Invoke(ctx, "/proto.AuthService/VerifyRecoveryEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility
@@ -115,6 +195,14 @@ type AuthServiceServer interface {
 	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
 	LoginOtherMail(context.Context, *LoginOtherMailRequest) (*LoginReply, error)
 	SignupOtherMail(context.Context, *SignupOtherMailRequest) (*SignupReply, error)
+	LoginTwoFactor(context.Context, *LoginTwoFactorRequest) (*LoginReply, error)
+	ChangePassword(context.Context, *PasswordChangeRequest) (*PasswordChangeReply, error)
+	RequestPasswordReset(context.Context, *PasswordResetLinkRequest) (*PasswordResetLinkReply, error)
+	ResetPassword(context.Context, *PasswordResetRequest) (*PasswordResetReply, error)
+	UnlockLogin(context.Context, *UnlockLoginRequest) (*UnlockLoginReply, error)
+	SetRecoveryEmail(context.Context, *RecoveryEmailChangeRequest) (*RecoveryEmailChangeReply, error)
+	SendRecoveryEmailVerification(context.Context, *RecoveryEmailVerificationRequest) (*RecoveryEmailVerificationReply, error)
+	VerifyRecoveryEmail(context.Context, *VerifyRecoveryEmailRequest) (*VerifyRecoveryEmailReply, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -143,6 +231,30 @@ func (UnimplementedAuthServiceServer) LoginOtherMail(context.Context, *LoginOthe
 func (UnimplementedAuthServiceServer) SignupOtherMail(context.Context, *SignupOtherMailRequest) (*SignupReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignupOtherMail not implemented")
 }
+func (UnimplementedAuthServiceServer) LoginTwoFactor(context.Context, *LoginTwoFactorRequest) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *PasswordChangeRequest) (*PasswordChangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestPasswordReset(context.Context, *PasswordResetLinkRequest) (*PasswordResetLinkReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetPassword(context.Context, *PasswordResetRequest) (*PasswordResetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) UnlockLogin(context.Context, *UnlockLoginRequest) (*UnlockLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) SetRecoveryEmail(context.Context, *RecoveryEmailChangeRequest) (*RecoveryEmailChangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecoveryEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) SendRecoveryEmailVerification(context.Context, *RecoveryEmailVerificationRequest) (*RecoveryEmailVerificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRecoveryEmailVerification not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyRecoveryEmail(context.Context, *VerifyRecoveryEmailRequest) (*VerifyRecoveryEmailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRecoveryEmail not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -282,6 +394,150 @@ func _AuthService_SignupOtherMail_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_LoginTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/LoginTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginTwoFactor(ctx, req.(*LoginTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*PasswordChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, req.(*PasswordResetLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetPassword(ctx, req.(*PasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnlockLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnlockLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/UnlockLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnlockLogin(ctx, req.(*UnlockLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SetRecoveryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoveryEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SetRecoveryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/SetRecoveryEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SetRecoveryEmail(ctx, req.(*RecoveryEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SendRecoveryEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoveryEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SendRecoveryEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/SendRecoveryEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SendRecoveryEmailVerification(ctx, req.(*RecoveryEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyRecoveryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRecoveryEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyRecoveryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AuthService/VerifyRecoveryEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyRecoveryEmail(ctx, req.(*VerifyRecoveryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +573,38 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignupOtherMail",
 			Handler:    _AuthService_SignupOtherMail_Handler,
 		},
+		{
+			MethodName: "LoginTwoFactor",
+			Handler:    _AuthService_LoginTwoFactor_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AuthService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _AuthService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "UnlockLogin",
+			Handler:    _AuthService_UnlockLogin_Handler,
+		},
+		{
+			MethodName: "SetRecoveryEmail",
+			Handler:    _AuthService_SetRecoveryEmail_Handler,
+		},
+		{
+			MethodName: "SendRecoveryEmailVerification",
+			Handler:    _AuthService_SendRecoveryEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyRecoveryEmail",
+			Handler:    _AuthService_VerifyRecoveryEmail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
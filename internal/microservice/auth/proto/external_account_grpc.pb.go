@@ -0,0 +1,216 @@
+//go:generate mockgen -source=./external_account_grpc.pb.go -destination=../mock/external_account_grpc_mock.go -package=mock proto ExternalAccountServiceClient
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.1
+// source: external_account.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ExternalAccountServiceClient is the client API for ExternalAccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExternalAccountServiceClient interface {
+	AddExternalAccount(ctx context.Context, in *AddExternalAccountRequest, opts ...grpc.CallOption) (*AddExternalAccountReply, error)
+	GetExternalAccounts(ctx context.Context, in *GetExternalAccountsRequest, opts ...grpc.CallOption) (*GetExternalAccountsReply, error)
+	GetExternalAccount(ctx context.Context, in *GetExternalAccountRequest, opts ...grpc.CallOption) (*GetExternalAccountReply, error)
+	DeleteExternalAccount(ctx context.Context, in *DeleteExternalAccountRequest, opts ...grpc.CallOption) (*DeleteExternalAccountReply, error)
+}
+
+type externalAccountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExternalAccountServiceClient(cc grpc.ClientConnInterface) ExternalAccountServiceClient {
+	return &externalAccountServiceClient{cc}
+}
+
+func (c *externalAccountServiceClient) AddExternalAccount(ctx context.Context, in *AddExternalAccountRequest, opts ...grpc.CallOption) (*AddExternalAccountReply, error) {
+	out := new(AddExternalAccountReply)
+	err := c.cc.Invoke(ctx, "/proto.ExternalAccountService/AddExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalAccountServiceClient) GetExternalAccounts(ctx context.Context, in *GetExternalAccountsRequest, opts ...grpc.CallOption) (*GetExternalAccountsReply, error) {
+	out := new(GetExternalAccountsReply)
+	err := c.cc.Invoke(ctx, "/proto.ExternalAccountService/GetExternalAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalAccountServiceClient) GetExternalAccount(ctx context.Context, in *GetExternalAccountRequest, opts ...grpc.CallOption) (*GetExternalAccountReply, error) {
+	out := new(GetExternalAccountReply)
+	err := c.cc.Invoke(ctx, "/proto.ExternalAccountService/GetExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalAccountServiceClient) DeleteExternalAccount(ctx context.Context, in *DeleteExternalAccountRequest, opts ...grpc.CallOption) (*DeleteExternalAccountReply, error) {
+	out := new(DeleteExternalAccountReply)
+	err := c.cc.Invoke(ctx, "/proto.ExternalAccountService/DeleteExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExternalAccountServiceServer is the server API for ExternalAccountService service.
+// All implementations must embed UnimplementedExternalAccountServiceServer
+// for forward compatibility
+type ExternalAccountServiceServer interface {
+	AddExternalAccount(context.Context, *AddExternalAccountRequest) (*AddExternalAccountReply, error)
+	GetExternalAccounts(context.Context, *GetExternalAccountsRequest) (*GetExternalAccountsReply, error)
+	GetExternalAccount(context.Context, *GetExternalAccountRequest) (*GetExternalAccountReply, error)
+	DeleteExternalAccount(context.Context, *DeleteExternalAccountRequest) (*DeleteExternalAccountReply, error)
+	mustEmbedUnimplementedExternalAccountServiceServer()
+}
+
+// UnimplementedExternalAccountServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExternalAccountServiceServer struct {
+}
+
+func (UnimplementedExternalAccountServiceServer) AddExternalAccount(context.Context, *AddExternalAccountRequest) (*AddExternalAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExternalAccount not implemented")
+}
+func (UnimplementedExternalAccountServiceServer) GetExternalAccounts(context.Context, *GetExternalAccountsRequest) (*GetExternalAccountsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExternalAccounts not implemented")
+}
+func (UnimplementedExternalAccountServiceServer) GetExternalAccount(context.Context, *GetExternalAccountRequest) (*GetExternalAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExternalAccount not implemented")
+}
+func (UnimplementedExternalAccountServiceServer) DeleteExternalAccount(context.Context, *DeleteExternalAccountRequest) (*DeleteExternalAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExternalAccount not implemented")
+}
+func (UnimplementedExternalAccountServiceServer) mustEmbedUnimplementedExternalAccountServiceServer() {
+}
+
+// UnsafeExternalAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExternalAccountServiceServer will
+// result in compilation errors.
+type UnsafeExternalAccountServiceServer interface {
+	mustEmbedUnimplementedExternalAccountServiceServer()
+}
+
+func RegisterExternalAccountServiceServer(s grpc.ServiceRegistrar, srv ExternalAccountServiceServer) {
+	s.RegisterService(&ExternalAccountService_ServiceDesc, srv)
+}
+
+func _ExternalAccountService_AddExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExternalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalAccountServiceServer).AddExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ExternalAccountService/AddExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalAccountServiceServer).AddExternalAccount(ctx, req.(*AddExternalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalAccountService_GetExternalAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExternalAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalAccountServiceServer).GetExternalAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ExternalAccountService/GetExternalAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalAccountServiceServer).GetExternalAccounts(ctx, req.(*GetExternalAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalAccountService_GetExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExternalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalAccountServiceServer).GetExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ExternalAccountService/GetExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalAccountServiceServer).GetExternalAccount(ctx, req.(*GetExternalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalAccountService_DeleteExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExternalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalAccountServiceServer).DeleteExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ExternalAccountService/DeleteExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalAccountServiceServer).DeleteExternalAccount(ctx, req.(*DeleteExternalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExternalAccountService_ServiceDesc is the grpc.ServiceDesc for ExternalAccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExternalAccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ExternalAccountService",
+	HandlerType: (*ExternalAccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddExternalAccount",
+			Handler:    _ExternalAccountService_AddExternalAccount_Handler,
+		},
+		{
+			MethodName: "GetExternalAccounts",
+			Handler:    _ExternalAccountService_GetExternalAccounts_Handler,
+		},
+		{
+			MethodName: "GetExternalAccount",
+			Handler:    _ExternalAccountService_GetExternalAccount_Handler,
+		},
+		{
+			MethodName: "DeleteExternalAccount",
+			Handler:    _ExternalAccountService_DeleteExternalAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "external_account.proto",
+}
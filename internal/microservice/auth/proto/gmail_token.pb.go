@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.1
+// source: gmail_token.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SaveGMailTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Token []byte `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *SaveGMailTokenRequest) Reset() {
+	*x = SaveGMailTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gmail_token_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveGMailTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGMailTokenRequest) ProtoMessage() {}
+
+func (x *SaveGMailTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gmail_token_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGMailTokenRequest.ProtoReflect.Descriptor instead.
+func (*SaveGMailTokenRequest) Descriptor() ([]byte, []int) {
+	return file_gmail_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SaveGMailTokenRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SaveGMailTokenRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type SaveGMailTokenReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status bool `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SaveGMailTokenReply) Reset() {
+	*x = SaveGMailTokenReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gmail_token_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveGMailTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGMailTokenReply) ProtoMessage() {}
+
+func (x *SaveGMailTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gmail_token_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGMailTokenReply.ProtoReflect.Descriptor instead.
+func (*SaveGMailTokenReply) Descriptor() ([]byte, []int) {
+	return file_gmail_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveGMailTokenReply) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+type GetGMailTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *GetGMailTokenRequest) Reset() {
+	*x = GetGMailTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gmail_token_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGMailTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGMailTokenRequest) ProtoMessage() {}
+
+func (x *GetGMailTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gmail_token_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGMailTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetGMailTokenRequest) Descriptor() ([]byte, []int) {
+	return file_gmail_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetGMailTokenRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type GetGMailTokenReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token []byte `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Found bool   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *GetGMailTokenReply) Reset() {
+	*x = GetGMailTokenReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gmail_token_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGMailTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGMailTokenReply) ProtoMessage() {}
+
+func (x *GetGMailTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gmail_token_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGMailTokenReply.ProtoReflect.Descriptor instead.
+func (*GetGMailTokenReply) Descriptor() ([]byte, []int) {
+	return file_gmail_token_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGMailTokenReply) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GetGMailTokenReply) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+var File_gmail_token_proto protoreflect.FileDescriptor
+
+var file_gmail_token_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x61,
+	0x76, 0x65, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x2d, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x40, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0xac,
+	0x01, 0x0a, 0x11, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x47, 0x4d, 0x61, 0x69,
+	0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x4d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x4d, 0x61, 0x69,
+	0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x0a, 0x5a,
+	0x08, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_gmail_token_proto_rawDescOnce sync.Once
+	file_gmail_token_proto_rawDescData = file_gmail_token_proto_rawDesc
+)
+
+func file_gmail_token_proto_rawDescGZIP() []byte {
+	file_gmail_token_proto_rawDescOnce.Do(func() {
+		file_gmail_token_proto_rawDescData = protoimpl.X.CompressGZIP(file_gmail_token_proto_rawDescData)
+	})
+	return file_gmail_token_proto_rawDescData
+}
+
+var file_gmail_token_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gmail_token_proto_goTypes = []interface{}{
+	(*SaveGMailTokenRequest)(nil), // 0: proto.SaveGMailTokenRequest
+	(*SaveGMailTokenReply)(nil),   // 1: proto.SaveGMailTokenReply
+	(*GetGMailTokenRequest)(nil),  // 2: proto.GetGMailTokenRequest
+	(*GetGMailTokenReply)(nil),    // 3: proto.GetGMailTokenReply
+}
+var file_gmail_token_proto_depIdxs = []int32{
+	0, // 0: proto.GMailTokenService.SaveGMailToken:input_type -> proto.SaveGMailTokenRequest
+	2, // 1: proto.GMailTokenService.GetGMailToken:input_type -> proto.GetGMailTokenRequest
+	1, // 2: proto.GMailTokenService.SaveGMailToken:output_type -> proto.SaveGMailTokenReply
+	3, // 3: proto.GMailTokenService.GetGMailToken:output_type -> proto.GetGMailTokenReply
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_gmail_token_proto_init() }
+func file_gmail_token_proto_init() {
+	if File_gmail_token_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gmail_token_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveGMailTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gmail_token_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveGMailTokenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gmail_token_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGMailTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gmail_token_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGMailTokenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gmail_token_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gmail_token_proto_goTypes,
+		DependencyIndexes: file_gmail_token_proto_depIdxs,
+		MessageInfos:      file_gmail_token_proto_msgTypes,
+	}.Build()
+	File_gmail_token_proto = out.File
+	file_gmail_token_proto_rawDesc = nil
+	file_gmail_token_proto_goTypes = nil
+	file_gmail_token_proto_depIdxs = nil
+}
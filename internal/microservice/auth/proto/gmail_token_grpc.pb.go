@@ -0,0 +1,143 @@
+//go:generate mockgen -source=./gmail_token_grpc.pb.go -destination=../mock/gmail_token_grpc_mock.go -package=mock proto GMailTokenServiceClient
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.1
+// source: gmail_token.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GMailTokenServiceClient is the client API for GMailTokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GMailTokenServiceClient interface {
+	SaveGMailToken(ctx context.Context, in *SaveGMailTokenRequest, opts ...grpc.CallOption) (*SaveGMailTokenReply, error)
+	GetGMailToken(ctx context.Context, in *GetGMailTokenRequest, opts ...grpc.CallOption) (*GetGMailTokenReply, error)
+}
+
+type gMailTokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGMailTokenServiceClient(cc grpc.ClientConnInterface) GMailTokenServiceClient {
+	return &gMailTokenServiceClient{cc}
+}
+
+func (c *gMailTokenServiceClient) SaveGMailToken(ctx context.Context, in *SaveGMailTokenRequest, opts ...grpc.CallOption) (*SaveGMailTokenReply, error) {
+	out := new(SaveGMailTokenReply)
+	err := c.cc.Invoke(ctx, "/proto.GMailTokenService/SaveGMailToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gMailTokenServiceClient) GetGMailToken(ctx context.Context, in *GetGMailTokenRequest, opts ...grpc.CallOption) (*GetGMailTokenReply, error) {
+	out := new(GetGMailTokenReply)
+	err := c.cc.Invoke(ctx, "/proto.GMailTokenService/GetGMailToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GMailTokenServiceServer is the server API for GMailTokenService service.
+// All implementations must embed UnimplementedGMailTokenServiceServer
+// for forward compatibility
+type GMailTokenServiceServer interface {
+	SaveGMailToken(context.Context, *SaveGMailTokenRequest) (*SaveGMailTokenReply, error)
+	GetGMailToken(context.Context, *GetGMailTokenRequest) (*GetGMailTokenReply, error)
+	mustEmbedUnimplementedGMailTokenServiceServer()
+}
+
+// UnimplementedGMailTokenServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGMailTokenServiceServer struct {
+}
+
+func (UnimplementedGMailTokenServiceServer) SaveGMailToken(context.Context, *SaveGMailTokenRequest) (*SaveGMailTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveGMailToken not implemented")
+}
+func (UnimplementedGMailTokenServiceServer) GetGMailToken(context.Context, *GetGMailTokenRequest) (*GetGMailTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGMailToken not implemented")
+}
+func (UnimplementedGMailTokenServiceServer) mustEmbedUnimplementedGMailTokenServiceServer() {}
+
+// UnsafeGMailTokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GMailTokenServiceServer will
+// result in compilation errors.
+type UnsafeGMailTokenServiceServer interface {
+	mustEmbedUnimplementedGMailTokenServiceServer()
+}
+
+func RegisterGMailTokenServiceServer(s grpc.ServiceRegistrar, srv GMailTokenServiceServer) {
+	s.RegisterService(&GMailTokenService_ServiceDesc, srv)
+}
+
+func _GMailTokenService_SaveGMailToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveGMailTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GMailTokenServiceServer).SaveGMailToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.GMailTokenService/SaveGMailToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GMailTokenServiceServer).SaveGMailToken(ctx, req.(*SaveGMailTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GMailTokenService_GetGMailToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGMailTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GMailTokenServiceServer).GetGMailToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.GMailTokenService/GetGMailToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GMailTokenServiceServer).GetGMailToken(ctx, req.(*GetGMailTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GMailTokenService_ServiceDesc is the grpc.ServiceDesc for GMailTokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GMailTokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.GMailTokenService",
+	HandlerType: (*GMailTokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveGMailToken",
+			Handler:    _GMailTokenService_SaveGMailToken_Handler,
+		},
+		{
+			MethodName: "GetGMailToken",
+			Handler:    _GMailTokenService_GetGMailToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gmail_token.proto",
+}
@@ -0,0 +1,323 @@
+//go:generate mockgen -source=./oidc_grpc.pb.go -destination=../mock/oidc_grpc_mock.go -package=mock proto OIDCServiceClient
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.1
+// source: oidc.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OIDCServiceClient is the client API for OIDCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OIDCServiceClient interface {
+	AuthorizeOIDC(ctx context.Context, in *AuthorizeOIDCRequest, opts ...grpc.CallOption) (*AuthorizeOIDCReply, error)
+	GetOIDCConsent(ctx context.Context, in *GetOIDCConsentRequest, opts ...grpc.CallOption) (*GetOIDCConsentReply, error)
+	GrantOIDCConsent(ctx context.Context, in *GrantOIDCConsentRequest, opts ...grpc.CallOption) (*GrantOIDCConsentReply, error)
+	ExchangeOIDCCode(ctx context.Context, in *ExchangeOIDCCodeRequest, opts ...grpc.CallOption) (*ExchangeOIDCCodeReply, error)
+	GetOIDCUserInfo(ctx context.Context, in *GetOIDCUserInfoRequest, opts ...grpc.CallOption) (*GetOIDCUserInfoReply, error)
+	GetOIDCKeys(ctx context.Context, in *GetOIDCKeysRequest, opts ...grpc.CallOption) (*GetOIDCKeysReply, error)
+	RegisterOIDCClient(ctx context.Context, in *RegisterOIDCClientRequest, opts ...grpc.CallOption) (*RegisterOIDCClientReply, error)
+}
+
+type oIDCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOIDCServiceClient(cc grpc.ClientConnInterface) OIDCServiceClient {
+	return &oIDCServiceClient{cc}
+}
+
+func (c *oIDCServiceClient) AuthorizeOIDC(ctx context.Context, in *AuthorizeOIDCRequest, opts ...grpc.CallOption) (*AuthorizeOIDCReply, error) {
+	out := new(AuthorizeOIDCReply)
+	err := c.cc.Invoke(ctx, "/proto.OIDCService/AuthorizeOIDC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) GetOIDCConsent(ctx context.Context, in *GetOIDCConsentRequest, opts ...grpc.CallOption) (*GetOIDCConsentReply, error) {
+	out := new(GetOIDCConsentReply)
+	err := c.cc.Invoke(ctx, "/proto.OIDCService/GetOIDCConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) GrantOIDCConsent(ctx context.Context, in *GrantOIDCConsentRequest, opts ...grpc.CallOption) (*GrantOIDCConsentReply, error) {
+	out := new(GrantOIDCConsentReply)
+	err := c.cc.Invoke(ctx, "/proto.OIDCService/GrantOIDCConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) ExchangeOIDCCode(ctx context.Context, in *ExchangeOIDCCodeRequest, opts ...grpc.CallOption) (*ExchangeOIDCCodeReply, error) {
+	out := new(ExchangeOIDCCodeReply)
+	err := c.cc.Invoke(ctx, "/proto.OIDCService/ExchangeOIDCCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) GetOIDCUserInfo(ctx context.Context, in *GetOIDCUserInfoRequest, opts ...grpc.CallOption) (*GetOIDCUserInfoReply, error) {
+	out := new(GetOIDCUserInfoReply)
+	err := c.cc.Invoke(ctx, "/proto.OIDCService/GetOIDCUserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) GetOIDCKeys(ctx context.Context, in *GetOIDCKeysRequest, opts ...grpc.CallOption) (*GetOIDCKeysReply, error) {
+	out := new(GetOIDCKeysReply)
+	err := c.cc.Invoke(ctx, "/proto.OIDCService/GetOIDCKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) RegisterOIDCClient(ctx context.Context, in *RegisterOIDCClientRequest, opts ...grpc.CallOption) (*RegisterOIDCClientReply, error) {
+	out := new(RegisterOIDCClientReply)
+	err := c.cc.Invoke(ctx, "/proto.OIDCService/RegisterOIDCClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OIDCServiceServer is the server API for OIDCService service.
+// All implementations must embed UnimplementedOIDCServiceServer
+// for forward compatibility
+type OIDCServiceServer interface {
+	AuthorizeOIDC(context.Context, *AuthorizeOIDCRequest) (*AuthorizeOIDCReply, error)
+	GetOIDCConsent(context.Context, *GetOIDCConsentRequest) (*GetOIDCConsentReply, error)
+	GrantOIDCConsent(context.Context, *GrantOIDCConsentRequest) (*GrantOIDCConsentReply, error)
+	ExchangeOIDCCode(context.Context, *ExchangeOIDCCodeRequest) (*ExchangeOIDCCodeReply, error)
+	GetOIDCUserInfo(context.Context, *GetOIDCUserInfoRequest) (*GetOIDCUserInfoReply, error)
+	GetOIDCKeys(context.Context, *GetOIDCKeysRequest) (*GetOIDCKeysReply, error)
+	RegisterOIDCClient(context.Context, *RegisterOIDCClientRequest) (*RegisterOIDCClientReply, error)
+	mustEmbedUnimplementedOIDCServiceServer()
+}
+
+// UnimplementedOIDCServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOIDCServiceServer struct {
+}
+
+func (UnimplementedOIDCServiceServer) AuthorizeOIDC(context.Context, *AuthorizeOIDCRequest) (*AuthorizeOIDCReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOIDC not implemented")
+}
+func (UnimplementedOIDCServiceServer) GetOIDCConsent(context.Context, *GetOIDCConsentRequest) (*GetOIDCConsentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCConsent not implemented")
+}
+func (UnimplementedOIDCServiceServer) GrantOIDCConsent(context.Context, *GrantOIDCConsentRequest) (*GrantOIDCConsentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantOIDCConsent not implemented")
+}
+func (UnimplementedOIDCServiceServer) ExchangeOIDCCode(context.Context, *ExchangeOIDCCodeRequest) (*ExchangeOIDCCodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeOIDCCode not implemented")
+}
+func (UnimplementedOIDCServiceServer) GetOIDCUserInfo(context.Context, *GetOIDCUserInfoRequest) (*GetOIDCUserInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCUserInfo not implemented")
+}
+func (UnimplementedOIDCServiceServer) GetOIDCKeys(context.Context, *GetOIDCKeysRequest) (*GetOIDCKeysReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCKeys not implemented")
+}
+func (UnimplementedOIDCServiceServer) RegisterOIDCClient(context.Context, *RegisterOIDCClientRequest) (*RegisterOIDCClientReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOIDCClient not implemented")
+}
+func (UnimplementedOIDCServiceServer) mustEmbedUnimplementedOIDCServiceServer() {}
+
+// UnsafeOIDCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OIDCServiceServer will
+// result in compilation errors.
+type UnsafeOIDCServiceServer interface {
+	mustEmbedUnimplementedOIDCServiceServer()
+}
+
+func RegisterOIDCServiceServer(s grpc.ServiceRegistrar, srv OIDCServiceServer) {
+	s.RegisterService(&OIDCService_ServiceDesc, srv)
+}
+
+func _OIDCService_AuthorizeOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeOIDCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).AuthorizeOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OIDCService/AuthorizeOIDC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).AuthorizeOIDC(ctx, req.(*AuthorizeOIDCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_GetOIDCConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).GetOIDCConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OIDCService/GetOIDCConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).GetOIDCConsent(ctx, req.(*GetOIDCConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_GrantOIDCConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantOIDCConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).GrantOIDCConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OIDCService/GrantOIDCConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).GrantOIDCConsent(ctx, req.(*GrantOIDCConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_ExchangeOIDCCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeOIDCCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).ExchangeOIDCCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OIDCService/ExchangeOIDCCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).ExchangeOIDCCode(ctx, req.(*ExchangeOIDCCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_GetOIDCUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).GetOIDCUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OIDCService/GetOIDCUserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).GetOIDCUserInfo(ctx, req.(*GetOIDCUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_GetOIDCKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).GetOIDCKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OIDCService/GetOIDCKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).GetOIDCKeys(ctx, req.(*GetOIDCKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_RegisterOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterOIDCClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).RegisterOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OIDCService/RegisterOIDCClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).RegisterOIDCClient(ctx, req.(*RegisterOIDCClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OIDCService_ServiceDesc is the grpc.ServiceDesc for OIDCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OIDCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.OIDCService",
+	HandlerType: (*OIDCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AuthorizeOIDC",
+			Handler:    _OIDCService_AuthorizeOIDC_Handler,
+		},
+		{
+			MethodName: "GetOIDCConsent",
+			Handler:    _OIDCService_GetOIDCConsent_Handler,
+		},
+		{
+			MethodName: "GrantOIDCConsent",
+			Handler:    _OIDCService_GrantOIDCConsent_Handler,
+		},
+		{
+			MethodName: "ExchangeOIDCCode",
+			Handler:    _OIDCService_ExchangeOIDCCode_Handler,
+		},
+		{
+			MethodName: "GetOIDCUserInfo",
+			Handler:    _OIDCService_GetOIDCUserInfo_Handler,
+		},
+		{
+			MethodName: "GetOIDCKeys",
+			Handler:    _OIDCService_GetOIDCKeys_Handler,
+		},
+		{
+			MethodName: "RegisterOIDCClient",
+			Handler:    _OIDCService_RegisterOIDCClient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oidc.proto",
+}
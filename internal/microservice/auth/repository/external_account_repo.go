@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// ExternalAccountRepository represents a PostgreSQL implementation of the ExternalAccountRepository interface.
+type ExternalAccountRepository struct {
+	DB *sqlx.DB
+}
+
+// NewExternalAccountRepository creates a new instance of ExternalAccountRepository.
+func NewExternalAccountRepository(db *sqlx.DB) *ExternalAccountRepository {
+	return &ExternalAccountRepository{
+		DB: db,
+	}
+}
+
+// AddAccount stores the mailbox with the encrypted password and returns its unique identifier.
+// It fails if the user has already linked the maximum number of mailboxes.
+func (repo *ExternalAccountRepository) AddAccount(account *domain.ExternalAccount, encryptedPassword []byte, ctx context.Context) (uint32, error) {
+	query := `
+		INSERT INTO external_account (profile_id, email, imap_host, imap_port, imap_tls_mode, smtp_host, smtp_port, smtp_tls_mode, username, password, creation_date)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		WHERE (SELECT COUNT(*) FROM external_account WHERE profile_id = $1) < $12
+		RETURNING id
+	`
+
+	accountModelDb := converters.ExternalAccountConvertCoreInDb(account, encryptedPassword)
+
+	var id uint32
+
+	start := time.Now()
+	err := repo.DB.Get(&id, query, accountModelDb.ProfileID, accountModelDb.Email, accountModelDb.IMAPHost, accountModelDb.IMAPPort,
+		accountModelDb.IMAPTLSMode, accountModelDb.SMTPHost, accountModelDb.SMTPPort, accountModelDb.SMTPTLSMode, accountModelDb.Username,
+		accountModelDb.Password, accountModelDb.CreationDate, domain.ExternalAccountMaxCount)
+
+	args := []interface{}{accountModelDb.ProfileID, accountModelDb.Email, accountModelDb.IMAPHost, accountModelDb.IMAPPort, accountModelDb.IMAPTLSMode,
+		accountModelDb.SMTPHost, accountModelDb.SMTPPort, accountModelDb.SMTPTLSMode, accountModelDb.Username, accountModelDb.CreationDate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("user with id %d already has %d external accounts", account.ProfileID, domain.ExternalAccountMaxCount)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to add external account: %v", err)
+	}
+
+	return id, nil
+}
+
+// GetAccounts returns the mailboxes linked by the user without their passwords, the oldest first.
+func (repo *ExternalAccountRepository) GetAccounts(profileID uint32, ctx context.Context) ([]*domain.ExternalAccount, error) {
+	query := `
+		SELECT id, profile_id, email, imap_host, imap_port, imap_tls_mode, smtp_host, smtp_port, smtp_tls_mode, username, creation_date
+		FROM external_account WHERE profile_id = $1 ORDER BY id
+	`
+
+	var accountsModelDb []database.ExternalAccount
+
+	start := time.Now()
+	err := repo.DB.Select(&accountsModelDb, query, profileID)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external accounts: %v", err)
+	}
+
+	accounts := make([]*domain.ExternalAccount, 0, len(accountsModelDb))
+	for i := range accountsModelDb {
+		accounts = append(accounts, converters.ExternalAccountConvertDbInCore(&accountsModelDb[i]))
+	}
+
+	return accounts, nil
+}
+
+// GetAccount returns the mailbox of the user and its encrypted password, or nil if the user has no such mailbox.
+func (repo *ExternalAccountRepository) GetAccount(profileID, accountID uint32, ctx context.Context) (*domain.ExternalAccount, []byte, error) {
+	query := `
+		SELECT id, profile_id, email, imap_host, imap_port, imap_tls_mode, smtp_host, smtp_port, smtp_tls_mode, username, password, creation_date
+		FROM external_account WHERE id = $1 AND profile_id = $2
+	`
+
+	var accountModelDb database.ExternalAccount
+
+	start := time.Now()
+	err := repo.DB.Get(&accountModelDb, query, accountID, profileID)
+
+	args := []interface{}{accountID, profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil, nil
+		}
+
+		return nil, nil, fmt.Errorf("failed to get external account: %v", err)
+	}
+
+	return converters.ExternalAccountConvertDbInCore(&accountModelDb), accountModelDb.Password, nil
+}
+
+// DeleteAccount removes the mailbox of the user, returns false if the user has no such mailbox.
+func (repo *ExternalAccountRepository) DeleteAccount(profileID, accountID uint32, ctx context.Context) (bool, error) {
+	query := "DELETE FROM external_account WHERE id = $1 AND profile_id = $2"
+
+	start := time.Now()
+	result, err := repo.DB.Exec(query, accountID, profileID)
+
+	args := []interface{}{accountID, profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete external account: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	return rowsAffected > 0, nil
+}
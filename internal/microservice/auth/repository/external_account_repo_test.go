@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+var externalAccountColumns = []string{"id", "profile_id", "email", "imap_host", "imap_port", "imap_tls_mode",
+	"smtp_host", "smtp_port", "smtp_tls_mode", "username", "creation_date"}
+
+func newTestExternalAccount(creationDate time.Time) *domain.ExternalAccount {
+	return &domain.ExternalAccount{
+		ID:           1,
+		ProfileID:    2,
+		Email:        "user@example.com",
+		IMAPHost:     "imap.example.com",
+		IMAPPort:     993,
+		IMAPTLSMode:  domain.ExternalAccountTLSModeTLS,
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		SMTPTLSMode:  domain.ExternalAccountTLSModeSTARTTLS,
+		Username:     "user",
+		CreationDate: creationDate,
+	}
+}
+
+func TestExternalAccountRepository_AddAccount(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewExternalAccountRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	account := newTestExternalAccount(time.Now())
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO external_account (.+) WHERE \(SELECT COUNT\(\*\) FROM external_account WHERE profile_id = \$1\) < \$12`).
+			WithArgs(uint32(2), "user@example.com", "imap.example.com", uint32(993), "tls", "smtp.example.com", uint32(587), "starttls", "user",
+				[]byte("encrypted"), account.CreationDate, domain.ExternalAccountMaxCount).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+		id, err := repo.AddAccount(account, []byte("encrypted"), ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), id)
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO external_account`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.AddAccount(account, []byte("encrypted"), ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO external_account`).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.AddAccount(account, []byte("encrypted"), ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExternalAccountRepository_GetAccounts(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewExternalAccountRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	creationDate := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows(externalAccountColumns).
+			AddRow(1, 2, "user@example.com", "imap.example.com", 993, "tls", "smtp.example.com", 587, "starttls", "user", creationDate)
+		mock.ExpectQuery(`SELECT (.+) FROM external_account WHERE profile_id = \$1 ORDER BY id`).WithArgs(uint32(2)).WillReturnRows(rows)
+
+		accounts, err := repo.GetAccounts(2, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.ExternalAccount{newTestExternalAccount(creationDate)}, accounts)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM external_account`).WithArgs(uint32(2)).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetAccounts(2, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExternalAccountRepository_GetAccount(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewExternalAccountRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	creationDate := time.Now()
+
+	t.Run("Found", func(t *testing.T) {
+		rows := sqlmock.NewRows(append(externalAccountColumns[:10:10], "password", "creation_date")).
+			AddRow(1, 2, "user@example.com", "imap.example.com", 993, "tls", "smtp.example.com", 587, "starttls", "user", []byte("encrypted"), creationDate)
+		mock.ExpectQuery(`SELECT (.+) FROM external_account WHERE id = \$1 AND profile_id = \$2`).WithArgs(uint32(1), uint32(2)).WillReturnRows(rows)
+
+		account, password, err := repo.GetAccount(2, 1, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, newTestExternalAccount(creationDate), account)
+		assert.Equal(t, []byte("encrypted"), password)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM external_account`).WithArgs(uint32(5), uint32(2)).
+			WillReturnRows(sqlmock.NewRows(externalAccountColumns))
+
+		account, password, err := repo.GetAccount(2, 5, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, account)
+		assert.Nil(t, password)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM external_account`).WithArgs(uint32(1), uint32(2)).WillReturnError(fmt.Errorf("db error"))
+
+		_, _, err := repo.GetAccount(2, 1, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExternalAccountRepository_DeleteAccount(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewExternalAccountRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+
+	t.Run("Deleted", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM external_account WHERE id = \$1 AND profile_id = \$2`).WithArgs(uint32(1), uint32(2)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		deleted, err := repo.DeleteAccount(2, 1, ctx)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM external_account`).WithArgs(uint32(5), uint32(2)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		deleted, err := repo.DeleteAccount(2, 5, ctx)
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM external_account`).WithArgs(uint32(1), uint32(2)).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.DeleteAccount(2, 1, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
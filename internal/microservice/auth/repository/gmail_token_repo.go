@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/pkg/logger"
+)
+
+// GMailTokenRepository represents a PostgreSQL implementation of the GMailTokenRepository interface.
+type GMailTokenRepository struct {
+	DB *sqlx.DB
+}
+
+// NewGMailTokenRepository creates a new instance of GMailTokenRepository.
+func NewGMailTokenRepository(db *sqlx.DB) *GMailTokenRepository {
+	return &GMailTokenRepository{
+		DB: db,
+	}
+}
+
+// SaveToken stores the encrypted token of the Gmail address, replacing the previous one.
+func (repo *GMailTokenRepository) SaveToken(login string, token []byte, ctx context.Context) error {
+	query := `
+		INSERT INTO gmail_token (login, token)
+		VALUES ($1, $2)
+		ON CONFLICT (login) DO UPDATE
+		SET token = EXCLUDED.token, update_date = CURRENT_TIMESTAMP
+	`
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, login, token)
+
+	args := []interface{}{login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to save gmail token: %v", err)
+	}
+
+	return nil
+}
+
+// GetToken returns the encrypted token of the Gmail address, or nil if there is none.
+func (repo *GMailTokenRepository) GetToken(login string, ctx context.Context) ([]byte, error) {
+	query := "SELECT token FROM gmail_token WHERE login = $1"
+
+	var token []byte
+
+	start := time.Now()
+	err := repo.DB.Get(&token, query, login)
+
+	args := []interface{}{login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get gmail token: %v", err)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGMailTokenRepository_SaveToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewGMailTokenRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO gmail_token \(login, token\)(.+)ON CONFLICT \(login\) DO UPDATE`).
+			WithArgs("user@gmail.com", []byte("encrypted")).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.SaveToken("user@gmail.com", []byte("encrypted"), ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO gmail_token`).WithArgs("user@gmail.com", []byte("encrypted")).
+			WillReturnError(fmt.Errorf("db error"))
+
+		err := repo.SaveToken("user@gmail.com", []byte("encrypted"), ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGMailTokenRepository_GetToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewGMailTokenRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+
+	t.Run("Found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT token FROM gmail_token WHERE login = \$1`).WithArgs("user@gmail.com").
+			WillReturnRows(sqlmock.NewRows([]string{"token"}).AddRow([]byte("encrypted")))
+
+		token, err := repo.GetToken("user@gmail.com", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("encrypted"), token)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT token FROM gmail_token`).WithArgs("unknown@gmail.com").
+			WillReturnRows(sqlmock.NewRows([]string{"token"}))
+
+		token, err := repo.GetToken("unknown@gmail.com", ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT token FROM gmail_token`).WithArgs("user@gmail.com").
+			WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetToken("user@gmail.com", ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
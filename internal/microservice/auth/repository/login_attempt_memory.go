@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// memoryPruneSize is the number of stored keys after which the stale ones are removed.
+const memoryPruneSize = 10000
+
+// MemoryLoginAttemptRepository represents an in-memory implementation of the LoginAttemptRepository interface.
+// The attempts are not shared between processes, it is suitable only for a single replica of the auth service.
+type MemoryLoginAttemptRepository struct {
+	mu       sync.Mutex
+	attempts map[string]*domain.LoginAttempts
+}
+
+// NewMemoryLoginAttemptRepository creates a new instance of MemoryLoginAttemptRepository.
+func NewMemoryLoginAttemptRepository() *MemoryLoginAttemptRepository {
+	return &MemoryLoginAttemptRepository{
+		attempts: make(map[string]*domain.LoginAttempts),
+	}
+}
+
+// Get returns the failed attempts of the key, or nil if there are none.
+func (repo *MemoryLoginAttemptRepository) Get(key string, ctx context.Context) (*domain.LoginAttempts, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	attempts, ok := repo.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	attemptsCopy := *attempts
+
+	return &attemptsCopy, nil
+}
+
+// AddAttempt counts an attempt of the key made at now before it is checked and returns the updated attempts.
+// The attempts made before since are forgotten.
+func (repo *MemoryLoginAttemptRepository) AddAttempt(key string, now, since time.Time, ctx context.Context) (*domain.LoginAttempts, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if len(repo.attempts) >= memoryPruneSize {
+		repo.prune(now, since)
+	}
+
+	attempts, ok := repo.attempts[key]
+	if !ok {
+		attempts = &domain.LoginAttempts{Key: key}
+		repo.attempts[key] = attempts
+	}
+
+	if attempts.LastFailureDate.Before(since) {
+		attempts.Failures = 0
+		attempts.PreviousFailureDate = time.Time{}
+	} else {
+		attempts.PreviousFailureDate = attempts.LastFailureDate
+	}
+	attempts.Failures++
+	attempts.LastFailureDate = now
+
+	attemptsCopy := *attempts
+
+	return &attemptsCopy, nil
+}
+
+// RemoveAttempt uncounts an attempt of the key that has turned out to be successful.
+func (repo *MemoryLoginAttemptRepository) RemoveAttempt(key string, ctx context.Context) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if attempts, ok := repo.attempts[key]; ok && attempts.Failures > 0 {
+		attempts.Failures--
+	}
+
+	return nil
+}
+
+// Lock forbids the attempts of the key until the given time, unless it is already locked at now.
+// It returns whether this call has locked the key.
+func (repo *MemoryLoginAttemptRepository) Lock(key string, until, now time.Time, ctx context.Context) (bool, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	attempts, ok := repo.attempts[key]
+	if !ok || now.Before(attempts.LockedUntil) {
+		return false, nil
+	}
+	attempts.LockedUntil = until
+
+	return true, nil
+}
+
+// Reset forgets the failed attempts of the key and unlocks it.
+func (repo *MemoryLoginAttemptRepository) Reset(key string, ctx context.Context) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	delete(repo.attempts, key)
+
+	return nil
+}
+
+// prune removes the keys with only forgotten attempts and no lock.
+func (repo *MemoryLoginAttemptRepository) prune(now, since time.Time) {
+	for key, attempts := range repo.attempts {
+		if attempts.LastFailureDate.Before(since) && !now.Before(attempts.LockedUntil) {
+			delete(repo.attempts, key)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestMemoryLoginAttemptRepository(t *testing.T) {
+	repo := NewMemoryLoginAttemptRepository()
+	ctx := context.Background()
+	now := time.Now()
+	since := now.Add(-time.Minute)
+
+	attempts, err := repo.Get("login:user@mailhub.su", ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, attempts)
+
+	for i := 1; i <= 3; i++ {
+		attempts, err = repo.AddAttempt("login:user@mailhub.su", now.Add(time.Duration(i)*time.Second), since, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, i, attempts.Failures)
+	}
+	assert.Equal(t, now.Add(2*time.Second), attempts.PreviousFailureDate)
+
+	until := now.Add(time.Minute)
+	locked, err := repo.Lock("login:user@mailhub.su", until, now, ctx)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+
+	locked, err = repo.Lock("login:user@mailhub.su", until.Add(time.Minute), now, ctx)
+	assert.NoError(t, err)
+	assert.False(t, locked)
+
+	assert.NoError(t, repo.RemoveAttempt("login:user@mailhub.su", ctx))
+
+	attempts, err = repo.Get("login:user@mailhub.su", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts.Failures)
+	assert.Equal(t, until, attempts.LockedUntil)
+
+	later := now.Add(2 * time.Minute)
+	attempts, err = repo.AddAttempt("login:user@mailhub.su", later, later.Add(-time.Minute), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, attempts.Failures)
+	assert.True(t, attempts.PreviousFailureDate.IsZero())
+
+	assert.NoError(t, repo.Reset("login:user@mailhub.su", ctx))
+	attempts, err = repo.Get("login:user@mailhub.su", ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, attempts)
+}
+
+func TestMemoryLoginAttemptRepository_Prune(t *testing.T) {
+	repo := NewMemoryLoginAttemptRepository()
+	now := time.Now()
+	since := now.Add(-time.Minute)
+
+	repo.attempts["login:stale@mailhub.su"] = &domain.LoginAttempts{Failures: 1, LastFailureDate: now.Add(-time.Hour)}
+	repo.attempts["login:locked@mailhub.su"] = &domain.LoginAttempts{Failures: 10, LastFailureDate: now.Add(-time.Hour), LockedUntil: now.Add(time.Minute)}
+	repo.attempts["login:recent@mailhub.su"] = &domain.LoginAttempts{Failures: 1, LastFailureDate: now}
+
+	repo.prune(now, since)
+
+	assert.NotContains(t, repo.attempts, "login:stale@mailhub.su")
+	assert.Contains(t, repo.attempts, "login:locked@mailhub.su")
+	assert.Contains(t, repo.attempts, "login:recent@mailhub.su")
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// requestIDContextKey is the context key for the request ID.
+var requestIDContextKey interface{} = "requestID"
+
+// LoginAttemptRepository represents a PostgreSQL implementation of the LoginAttemptRepository interface.
+type LoginAttemptRepository struct {
+	DB *sqlx.DB
+}
+
+// NewLoginAttemptRepository creates a new instance of LoginAttemptRepository.
+func NewLoginAttemptRepository(db *sqlx.DB) *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		DB: db,
+	}
+}
+
+// Get returns the failed attempts of the key, or nil if there are none.
+func (repo *LoginAttemptRepository) Get(key string, ctx context.Context) (*domain.LoginAttempts, error) {
+	query := "SELECT key, failures, last_failure_date, previous_failure_date, locked_until FROM login_attempt WHERE key = $1"
+
+	var attemptsModelDb database.LoginAttempts
+
+	start := time.Now()
+	err := repo.DB.Get(&attemptsModelDb, query, key)
+
+	args := []interface{}{key}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get login attempts: %v", err)
+	}
+
+	return converters.LoginAttemptsConvertDbInCore(&attemptsModelDb), nil
+}
+
+// AddAttempt counts an attempt of the key made at now before it is checked and returns the updated attempts.
+// The counter is incremented in one statement, so concurrent attempts on different replicas are all counted
+// and each of them gets its own number. The attempts made before since are forgotten,
+// the stale rows of other keys are removed on the way.
+func (repo *LoginAttemptRepository) AddAttempt(key string, now, since time.Time, ctx context.Context) (*domain.LoginAttempts, error) {
+	query := `
+		WITH deleted AS (
+			DELETE FROM login_attempt
+			WHERE key <> $1 AND last_failure_date < $3 AND (locked_until IS NULL OR locked_until < $2)
+		)
+		INSERT INTO login_attempt (key, failures, last_failure_date)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE WHEN login_attempt.last_failure_date < $3 THEN 1 ELSE login_attempt.failures + 1 END,
+			previous_failure_date = CASE WHEN login_attempt.last_failure_date < $3 THEN NULL ELSE login_attempt.last_failure_date END,
+			last_failure_date = $2
+		RETURNING key, failures, last_failure_date, previous_failure_date, locked_until
+	`
+
+	var attemptsModelDb database.LoginAttempts
+
+	start := time.Now()
+	err := repo.DB.Get(&attemptsModelDb, query, key, now, since)
+
+	args := []interface{}{key, now, since}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to add login attempt: %v", err)
+	}
+
+	return converters.LoginAttemptsConvertDbInCore(&attemptsModelDb), nil
+}
+
+// RemoveAttempt uncounts an attempt of the key that has turned out to be successful.
+func (repo *LoginAttemptRepository) RemoveAttempt(key string, ctx context.Context) error {
+	query := "UPDATE login_attempt SET failures = failures - 1 WHERE key = $1 AND failures > 0"
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, key)
+
+	args := []interface{}{key}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove login attempt: %v", err)
+	}
+
+	return nil
+}
+
+// Lock forbids the attempts of the key until the given time, unless it is already locked at now.
+// The condition is checked in the same statement, so only one of the concurrent attempts locks the key.
+func (repo *LoginAttemptRepository) Lock(key string, until, now time.Time, ctx context.Context) (bool, error) {
+	query := "UPDATE login_attempt SET locked_until = $2 WHERE key = $1 AND (locked_until IS NULL OR locked_until <= $3)"
+
+	start := time.Now()
+	result, err := repo.DB.Exec(query, key, until, now)
+
+	args := []interface{}{key, until, now}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to lock login attempts: %v", err)
+	}
+
+	locked, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to lock login attempts: %v", err)
+	}
+
+	return locked > 0, nil
+}
+
+// Reset forgets the failed attempts of the key and unlocks it.
+func (repo *LoginAttemptRepository) Reset(key string, ctx context.Context) error {
+	query := "DELETE FROM login_attempt WHERE key = $1"
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, key)
+
+	args := []interface{}{key}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to reset login attempts: %v", err)
+	}
+
+	return nil
+}
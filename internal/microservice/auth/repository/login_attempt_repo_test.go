@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/logger"
+	"mail/internal/pkg/utils/constants"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func GetCTX() context.Context {
+	ctx := context.WithValue(context.Background(), interface{}(string(constants.LoggerKey)), logger.InitializationBdLog(nil))
+	ctx2 := context.WithValue(ctx, interface{}(string(constants.RequestIDKey)), []string{"testID"})
+
+	return ctx2
+}
+
+func TestLoginAttemptRepository_Get(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewLoginAttemptRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	lastFailureDate := time.Now()
+
+	t.Run("Found", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"key", "failures", "last_failure_date", "previous_failure_date", "locked_until"}).
+			AddRow("login:user@mailhub.su", 4, lastFailureDate, nil, nil)
+		mock.ExpectQuery(`SELECT key, failures, last_failure_date, previous_failure_date, locked_until FROM login_attempt WHERE key = \$1`).
+			WithArgs("login:user@mailhub.su").WillReturnRows(rows)
+
+		attempts, err := repo.Get("login:user@mailhub.su", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.LoginAttempts{Key: "login:user@mailhub.su", Failures: 4, LastFailureDate: lastFailureDate}, attempts)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM login_attempt`).WithArgs("ip:127.0.0.1").
+			WillReturnRows(sqlmock.NewRows([]string{"key"}))
+
+		attempts, err := repo.Get("ip:127.0.0.1", ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, attempts)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM login_attempt`).WithArgs("ip:127.0.0.1").WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.Get("ip:127.0.0.1", ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLoginAttemptRepository_AddAttempt(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewLoginAttemptRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	now := time.Now()
+	since := now.Add(-domain.LoginAttemptWindow)
+	previous := now.Add(-time.Second)
+
+	t.Run("Counted", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"key", "failures", "last_failure_date", "previous_failure_date", "locked_until"}).
+			AddRow("login:user@mailhub.su", 5, now, previous, nil)
+		mock.ExpectQuery(`INSERT INTO login_attempt (.+) ON CONFLICT \(key\) DO UPDATE (.+) RETURNING key, failures, last_failure_date, previous_failure_date, locked_until`).
+			WithArgs("login:user@mailhub.su", now, since).WillReturnRows(rows)
+
+		attempts, err := repo.AddAttempt("login:user@mailhub.su", now, since, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, attempts.Failures)
+		assert.Equal(t, previous, attempts.PreviousFailureDate)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO login_attempt`).WithArgs("login:user@mailhub.su", now, since).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.AddAttempt("login:user@mailhub.su", now, since, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLoginAttemptRepository_LockRemoveAndReset(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewLoginAttemptRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	now := time.Now()
+	until := now.Add(domain.LoginLockoutDuration)
+
+	mock.ExpectExec(`UPDATE login_attempt SET locked_until = \$2 WHERE key = \$1 AND \(locked_until IS NULL OR locked_until <= \$3\)`).
+		WithArgs("login:user@mailhub.su", until, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE login_attempt SET locked_until`).
+		WithArgs("login:user@mailhub.su", until, now).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE login_attempt SET failures = failures - 1 WHERE key = \$1 AND failures > 0`).
+		WithArgs("ip:127.0.0.1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM login_attempt WHERE key = \$1`).
+		WithArgs("login:user@mailhub.su").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	locked, err := repo.Lock("login:user@mailhub.su", until, now, ctx)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+
+	locked, err = repo.Lock("login:user@mailhub.su", until, now, ctx)
+	assert.NoError(t, err)
+	assert.False(t, locked)
+
+	assert.NoError(t, repo.RemoveAttempt("ip:127.0.0.1", ctx))
+	assert.NoError(t, repo.Reset("login:user@mailhub.su", ctx))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// OIDCRepository represents a PostgreSQL implementation of the OIDCRepository interface.
+type OIDCRepository struct {
+	DB *sqlx.DB
+}
+
+// NewOIDCRepository creates a new instance of OIDCRepository.
+func NewOIDCRepository(db *sqlx.DB) *OIDCRepository {
+	return &OIDCRepository{
+		DB: db,
+	}
+}
+
+// GetClient returns the client with the unique identifier, or nil if there is none.
+func (repo *OIDCRepository) GetClient(clientID string, ctx context.Context) (*domain.OIDCClient, error) {
+	query := "SELECT client_id, secret_hash, name, redirect_uris, creation_date FROM oidc_client WHERE client_id = $1"
+
+	var clientModelDb database.OIDCClient
+
+	start := time.Now()
+	err := repo.DB.Get(&clientModelDb, query, clientID)
+
+	args := []interface{}{clientID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get oidc client: %v", err)
+	}
+
+	return converters.OIDCClientConvertDbInCore(&clientModelDb), nil
+}
+
+// CreateClient registers the client.
+func (repo *OIDCRepository) CreateClient(client *domain.OIDCClient, ctx context.Context) error {
+	query := "INSERT INTO oidc_client (client_id, secret_hash, name, redirect_uris, creation_date) VALUES ($1, $2, $3, $4, $5)"
+
+	clientModelDb := converters.OIDCClientConvertCoreInDb(client)
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, clientModelDb.ClientID, clientModelDb.SecretHash, clientModelDb.Name, clientModelDb.RedirectURIs, clientModelDb.CreationDate)
+
+	args := []interface{}{clientModelDb.ClientID, clientModelDb.Name, clientModelDb.RedirectURIs, clientModelDb.CreationDate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to create oidc client: %v", err)
+	}
+
+	return nil
+}
+
+// GetConsent returns the scopes the user has allowed the client to read, or nil if the user has not given consent.
+func (repo *OIDCRepository) GetConsent(profileID uint32, clientID string, ctx context.Context) ([]string, error) {
+	query := "SELECT scopes FROM oidc_consent WHERE profile_id = $1 AND client_id = $2"
+
+	var scopes string
+
+	start := time.Now()
+	err := repo.DB.Get(&scopes, query, profileID, clientID)
+
+	args := []interface{}{profileID, clientID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get oidc consent: %v", err)
+	}
+
+	return converters.OIDCScopesConvertDbInCore(scopes), nil
+}
+
+// SaveConsent records the scopes the user has allowed the client to read, replacing the previous consent.
+func (repo *OIDCRepository) SaveConsent(profileID uint32, clientID string, scopes []string, ctx context.Context) error {
+	query := `
+		INSERT INTO oidc_consent (profile_id, client_id, scopes)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (profile_id, client_id) DO UPDATE
+		SET scopes = EXCLUDED.scopes, creation_date = CURRENT_TIMESTAMP
+	`
+
+	scopesDb := converters.OIDCScopesConvertCoreInDb(scopes)
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, profileID, clientID, scopesDb)
+
+	args := []interface{}{profileID, clientID, scopesDb}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to save oidc consent: %v", err)
+	}
+
+	return nil
+}
+
+// CreateAuthorizationCode stores the code, the expired codes are removed on the way.
+func (repo *OIDCRepository) CreateAuthorizationCode(code *domain.OIDCAuthorizationCode, ctx context.Context) error {
+	query := `
+		WITH deleted AS (
+			DELETE FROM oidc_authorization_code WHERE expiration_date < CURRENT_TIMESTAMP
+		)
+		INSERT INTO oidc_authorization_code (code_hash, client_id, profile_id, redirect_uri, scopes, nonce, code_challenge, expiration_date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	codeModelDb := converters.OIDCAuthorizationCodeConvertCoreInDb(code)
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, codeModelDb.CodeHash, codeModelDb.ClientID, codeModelDb.ProfileID, codeModelDb.RedirectURI,
+		codeModelDb.Scopes, codeModelDb.Nonce, codeModelDb.CodeChallenge, codeModelDb.ExpirationDate)
+
+	args := []interface{}{codeModelDb.ClientID, codeModelDb.ProfileID, codeModelDb.RedirectURI, codeModelDb.Scopes, codeModelDb.ExpirationDate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to create oidc authorization code: %v", err)
+	}
+
+	return nil
+}
+
+// TakeAuthorizationCode removes the code with the hash and returns it, or nil if there is none.
+func (repo *OIDCRepository) TakeAuthorizationCode(codeHash string, ctx context.Context) (*domain.OIDCAuthorizationCode, error) {
+	query := `
+		DELETE FROM oidc_authorization_code WHERE code_hash = $1
+		RETURNING code_hash, client_id, profile_id, redirect_uri, scopes, nonce, code_challenge, expiration_date
+	`
+
+	var codeModelDb database.OIDCAuthorizationCode
+
+	start := time.Now()
+	err := repo.DB.Get(&codeModelDb, query, codeHash)
+
+	args := []interface{}{}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to take oidc authorization code: %v", err)
+	}
+
+	return converters.OIDCAuthorizationCodeConvertDbInCore(&codeModelDb), nil
+}
+
+// GetSigningKeys returns the signing keys, the newest first.
+func (repo *OIDCRepository) GetSigningKeys(ctx context.Context) ([]*domain.OIDCSigningKey, error) {
+	query := "SELECT kid, private_key, creation_date FROM oidc_signing_key ORDER BY creation_date DESC"
+
+	var keysModelDb []database.OIDCSigningKey
+
+	start := time.Now()
+	err := repo.DB.Select(&keysModelDb, query)
+
+	args := []interface{}{}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get oidc signing keys: %v", err)
+	}
+
+	keysModelCore := make([]*domain.OIDCSigningKey, 0, len(keysModelDb))
+	for i := range keysModelDb {
+		keysModelCore = append(keysModelCore, converters.OIDCSigningKeyConvertDbInCore(&keysModelDb[i]))
+	}
+
+	return keysModelCore, nil
+}
+
+// AddSigningKey stores a new signing key.
+func (repo *OIDCRepository) AddSigningKey(key *domain.OIDCSigningKey, ctx context.Context) error {
+	query := "INSERT INTO oidc_signing_key (kid, private_key, creation_date) VALUES ($1, $2, $3)"
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, key.KeyID, key.PrivateKey, key.CreationDate)
+
+	args := []interface{}{key.KeyID, key.CreationDate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add oidc signing key: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteSigningKeys removes the signing keys generated before the date.
+func (repo *OIDCRepository) DeleteSigningKeys(createdBefore time.Time, ctx context.Context) error {
+	query := "DELETE FROM oidc_signing_key WHERE creation_date < $1"
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, createdBefore)
+
+	args := []interface{}{createdBefore}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete oidc signing keys: %v", err)
+	}
+
+	return nil
+}
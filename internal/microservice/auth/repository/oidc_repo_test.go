@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestOIDCRepository_GetClient(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewOIDCRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	creationDate := time.Now()
+
+	t.Run("Found", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"client_id", "secret_hash", "name", "redirect_uris", "creation_date"}).
+			AddRow("client", nil, "App", "https://app.example.com/callback", creationDate)
+		mock.ExpectQuery(`SELECT client_id, secret_hash, name, redirect_uris, creation_date FROM oidc_client WHERE client_id = \$1`).
+			WithArgs("client").WillReturnRows(rows)
+
+		client, err := repo.GetClient("client", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.OIDCClient{
+			ClientID:     "client",
+			Name:         "App",
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			CreationDate: creationDate,
+		}, client)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM oidc_client`).WithArgs("unknown").
+			WillReturnRows(sqlmock.NewRows([]string{"client_id"}))
+
+		client, err := repo.GetClient("unknown", ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, client)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM oidc_client`).WithArgs("client").WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetClient("client", ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOIDCRepository_CreateClient(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewOIDCRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	client := &domain.OIDCClient{
+		ClientID:     "client",
+		SecretHash:   "hash",
+		Name:         "App",
+		RedirectURIs: []string{"https://app.example.com/callback", "https://app.example.com/other"},
+		CreationDate: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO oidc_client \(client_id, secret_hash, name, redirect_uris, creation_date\)`).
+		WithArgs("client", "hash", "App", "https://app.example.com/callback https://app.example.com/other", client.CreationDate).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(t, repo.CreateClient(client, ctx))
+
+	mock.ExpectExec(`INSERT INTO oidc_client`).WillReturnError(fmt.Errorf("db error"))
+	assert.Error(t, repo.CreateClient(client, ctx))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOIDCRepository_Consent(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewOIDCRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+
+	t.Run("Get", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT scopes FROM oidc_consent WHERE profile_id = \$1 AND client_id = \$2`).
+			WithArgs(uint32(1), "client").WillReturnRows(sqlmock.NewRows([]string{"scopes"}).AddRow("openid email"))
+
+		scopes, err := repo.GetConsent(1, "client", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{domain.OIDCScopeOpenID, domain.OIDCScopeEmail}, scopes)
+	})
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT scopes FROM oidc_consent`).WithArgs(uint32(1), "client").
+			WillReturnRows(sqlmock.NewRows([]string{"scopes"}))
+
+		scopes, err := repo.GetConsent(1, "client", ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, scopes)
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO oidc_consent (.+) ON CONFLICT \(profile_id, client_id\) DO UPDATE`).
+			WithArgs(uint32(1), "client", "openid profile").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.SaveConsent(1, "client", []string{domain.OIDCScopeOpenID, domain.OIDCScopeProfile}, ctx))
+	})
+
+	t.Run("SaveError", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO oidc_consent`).WillReturnError(fmt.Errorf("db error"))
+
+		assert.Error(t, repo.SaveConsent(1, "client", []string{domain.OIDCScopeOpenID}, ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOIDCRepository_AuthorizationCode(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewOIDCRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	code := &domain.OIDCAuthorizationCode{
+		CodeHash:       "hash",
+		ClientID:       "client",
+		ProfileID:      1,
+		RedirectURI:    "https://app.example.com/callback",
+		Scopes:         []string{domain.OIDCScopeOpenID},
+		Nonce:          "nonce",
+		CodeChallenge:  "challenge",
+		ExpirationDate: time.Now(),
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM oidc_authorization_code WHERE expiration_date < CURRENT_TIMESTAMP (.+) INSERT INTO oidc_authorization_code`).
+			WithArgs("hash", "client", uint32(1), "https://app.example.com/callback", "openid", "nonce", "challenge", code.ExpirationDate).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.CreateAuthorizationCode(code, ctx))
+	})
+
+	t.Run("Take", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"code_hash", "client_id", "profile_id", "redirect_uri", "scopes", "nonce", "code_challenge", "expiration_date"}).
+			AddRow("hash", "client", 1, "https://app.example.com/callback", "openid", "nonce", "challenge", code.ExpirationDate)
+		mock.ExpectQuery(`DELETE FROM oidc_authorization_code WHERE code_hash = \$1 RETURNING (.+)`).
+			WithArgs("hash").WillReturnRows(rows)
+
+		taken, err := repo.TakeAuthorizationCode("hash", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, code, taken)
+	})
+
+	t.Run("TakeNotFound", func(t *testing.T) {
+		mock.ExpectQuery(`DELETE FROM oidc_authorization_code`).WithArgs("hash").
+			WillReturnRows(sqlmock.NewRows([]string{"code_hash"}))
+
+		taken, err := repo.TakeAuthorizationCode("hash", ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, taken)
+	})
+
+	t.Run("TakeError", func(t *testing.T) {
+		mock.ExpectQuery(`DELETE FROM oidc_authorization_code`).WithArgs("hash").WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.TakeAuthorizationCode("hash", ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOIDCRepository_SigningKeys(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := NewOIDCRepository(sqlx.NewDb(mockDB, "sqlmock"))
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("Get", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"kid", "private_key", "creation_date"}).
+			AddRow("new", "pem new", now).
+			AddRow("old", "pem old", now.Add(-time.Hour))
+		mock.ExpectQuery(`SELECT kid, private_key, creation_date FROM oidc_signing_key ORDER BY creation_date DESC`).
+			WillReturnRows(rows)
+
+		keys, err := repo.GetSigningKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.OIDCSigningKey{
+			{KeyID: "new", PrivateKey: "pem new", CreationDate: now},
+			{KeyID: "old", PrivateKey: "pem old", CreationDate: now.Add(-time.Hour)},
+		}, keys)
+	})
+
+	t.Run("GetError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM oidc_signing_key`).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetSigningKeys(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO oidc_signing_key \(kid, private_key, creation_date\)`).
+			WithArgs("new", "pem", now).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.AddSigningKey(&domain.OIDCSigningKey{KeyID: "new", PrivateKey: "pem", CreationDate: now}, ctx))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM oidc_signing_key WHERE creation_date < \$1`).
+			WithArgs(now).WillReturnResult(sqlmock.NewResult(0, 2))
+
+		assert.NoError(t, repo.DeleteSigningKeys(now, ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
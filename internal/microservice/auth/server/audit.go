@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+
+	"mail/internal/microservice/auth/proto"
+	"mail/internal/microservice/interceptors"
+
+	auditInterface "mail/internal/microservice/audit/interface"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// AuditRules returns the rules recording the logins in the security audit log.
+// A login which requires the second factor is recorded once it is completed with LoginTwoFactor.
+// Password changes and resets are recorded by the user service the calls are forwarded to.
+func AuditRules(repo auditInterface.SecurityEventRepository) map[string]interceptors.AuditRule {
+	return map[string]interceptors.AuditRule{
+		"/proto.AuthService/Login": {
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				return loginEvent(0, req.(*proto.LoginRequest).Login, domain.SecurityEventMethodPassword, reply, err)
+			},
+		},
+		"/proto.AuthService/LoginTwoFactor": {
+			Subject: func(ctx context.Context, req interface{}) uint32 {
+				profileID, err := repo.GetProfileIDByTwoFactorChallenge(req.(*proto.LoginTwoFactorRequest).ChallengeId, ctx)
+				if err != nil {
+					return 0
+				}
+				return profileID
+			},
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				return loginEvent(subject, "", domain.SecurityEventMethodTwoFactor, reply, err)
+			},
+		},
+		"/proto.AuthService/LoginVK": {
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				return loginEvent(0, "", domain.SecurityEventMethodVK, reply, err)
+			},
+		},
+		"/proto.AuthService/LoginOtherMail": {
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				return loginEvent(req.(*proto.LoginOtherMailRequest).Id, "", domain.SecurityEventMethodGmail, reply, err)
+			},
+		},
+	}
+}
+
+// loginEvent returns the event of a login of the account with the unique identifier or the login,
+// the account of a successful login is taken from the reply. It returns nil if the second factor is still required.
+func loginEvent(profileID uint32, login, method string, reply interface{}, err error) *domain.SecurityEvent {
+	loginReply, _ := reply.(*proto.LoginReply)
+	if loginReply.GetTwoFactorRequired() {
+		return nil
+	}
+
+	event := &domain.SecurityEvent{
+		ProfileID: profileID,
+		Login:     login,
+		Type:      domain.SecurityEventLogin,
+		Method:    method,
+		Success:   err == nil && loginReply.GetLoginStatus(),
+	}
+	if event.Success {
+		event.ProfileID = loginReply.GetUserId()
+	}
+	if loginReply.GetRetryAfter() > 0 {
+		event.Details = "login is locked"
+	}
+
+	return event
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/auth/proto"
+
+	audit_mock "mail/internal/microservice/audit/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestAuditRules_Login(t *testing.T) {
+	rule := AuditRules(nil)["/proto.AuthService/Login"]
+	req := &proto.LoginRequest{Login: "user@mailhub.su", Password: "password"}
+
+	t.Run("Success", func(t *testing.T) {
+		event := rule.Event(req, &proto.LoginReply{LoginStatus: true, UserId: 2}, nil, 0)
+		assert.Equal(t, &domain.SecurityEvent{
+			ProfileID: 2,
+			Login:     "user@mailhub.su",
+			Type:      domain.SecurityEventLogin,
+			Method:    domain.SecurityEventMethodPassword,
+			Success:   true,
+		}, event)
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		event := rule.Event(req, (*proto.LoginReply)(nil), fmt.Errorf("login failed"), 0)
+		assert.False(t, event.Success)
+		assert.Equal(t, uint32(0), event.ProfileID)
+		assert.Equal(t, "user@mailhub.su", event.Login)
+	})
+
+	t.Run("Locked", func(t *testing.T) {
+		event := rule.Event(req, &proto.LoginReply{RetryAfter: 60}, nil, 0)
+		assert.False(t, event.Success)
+		assert.Equal(t, "login is locked", event.Details)
+	})
+
+	t.Run("TwoFactorRequired", func(t *testing.T) {
+		assert.Nil(t, rule.Event(req, &proto.LoginReply{TwoFactorRequired: true, ChallengeId: "challenge"}, nil, 0))
+	})
+}
+
+func TestAuditRules_LoginTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := audit_mock.NewMockSecurityEventRepository(ctrl)
+	rule := AuditRules(repo)["/proto.AuthService/LoginTwoFactor"]
+	req := &proto.LoginTwoFactorRequest{ChallengeId: "challenge", Code: "123456"}
+
+	t.Run("Subject", func(t *testing.T) {
+		repo.EXPECT().GetProfileIDByTwoFactorChallenge("challenge", gomock.Any()).Return(uint32(2), nil)
+		assert.Equal(t, uint32(2), rule.Subject(context.Background(), req))
+
+		repo.EXPECT().GetProfileIDByTwoFactorChallenge("challenge", gomock.Any()).Return(uint32(0), fmt.Errorf("not found"))
+		assert.Equal(t, uint32(0), rule.Subject(context.Background(), req))
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		event := rule.Event(req, (*proto.LoginReply)(nil), fmt.Errorf("two-factor verification failed"), 2)
+		assert.Equal(t, &domain.SecurityEvent{
+			ProfileID: 2,
+			Type:      domain.SecurityEventLogin,
+			Method:    domain.SecurityEventMethodTwoFactor,
+		}, event)
+	})
+}
+
+func TestAuditRules_ExternalLogins(t *testing.T) {
+	rules := AuditRules(nil)
+
+	event := rules["/proto.AuthService/LoginVK"].Event(&proto.LoginVKRequest{VkId: 5}, &proto.LoginReply{LoginStatus: true, UserId: 2}, nil, 0)
+	assert.Equal(t, uint32(2), event.ProfileID)
+	assert.Equal(t, domain.SecurityEventMethodVK, event.Method)
+
+	event = rules["/proto.AuthService/LoginOtherMail"].Event(&proto.LoginOtherMailRequest{Id: 3}, (*proto.LoginReply)(nil), fmt.Errorf("create session failed"), 0)
+	assert.Equal(t, uint32(3), event.ProfileID)
+	assert.Equal(t, domain.SecurityEventMethodGmail, event.Method)
+	assert.False(t, event.Success)
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"google.golang.org/grpc/metadata"
+	"log"
+	"math"
+	"time"
 
 	"mail/internal/microservice/auth/proto"
 	"mail/internal/models/microservice_ports"
 	"mail/internal/pkg/utils/connect_microservice"
 	"mail/internal/pkg/utils/sanitize"
 
+	_interface "mail/internal/microservice/auth/interface"
 	domain "mail/internal/microservice/models/domain_models"
 	session_proto "mail/internal/microservice/session/proto"
 	user_proto "mail/internal/microservice/user/proto"
@@ -21,13 +25,25 @@ type AuthServer struct {
 	proto.UnimplementedAuthServiceServer
 	sessionServiceClient session_proto.SessionServiceClient
 	userServiceClient    user_proto.UserServiceClient
+	loginLimiter         _interface.LoginLimiter
+	notifier             _interface.Notifier
+	resetURL             string
+	verifyURL            string
+	verificationKey      []byte
 }
 
 // NewAuthServer creates a new instance of AuthServer.
-func NewAuthServer(sessionClient session_proto.SessionServiceClient, userClient user_proto.UserServiceClient) *AuthServer {
+// Password reset links are resetURL followed by the token, they and login lockout alerts are delivered with notifier.
+// Recovery email verification links are verifyURL followed by a token signed with verificationKey.
+func NewAuthServer(sessionClient session_proto.SessionServiceClient, userClient user_proto.UserServiceClient, loginLimiter _interface.LoginLimiter, notifier _interface.Notifier, resetURL, verifyURL string, verificationKey []byte) *AuthServer {
 	return &AuthServer{
 		sessionServiceClient: sessionClient,
 		userServiceClient:    userClient,
+		loginLimiter:         loginLimiter,
+		notifier:             notifier,
+		resetURL:             resetURL,
+		verifyURL:            verifyURL,
+		verificationKey:      verificationKey,
 	}
 }
 
@@ -50,6 +66,22 @@ func (as *AuthServer) Login(ctx context.Context, input *proto.LoginRequest) (*pr
 	}
 	value := md.Get("requestID")
 
+	var ipAddress string
+	if values := md.Get("ipAddress"); len(values) > 0 {
+		ipAddress = values[0]
+	}
+
+	retryAfter, lockedUntil, errAttempt := as.loginLimiter.Attempt(input.Login, ipAddress, ctx)
+	if errAttempt != nil {
+		return &proto.LoginReply{LoginStatus: false}, fmt.Errorf("login failed")
+	}
+	if !lockedUntil.IsZero() {
+		as.sendLoginLockout(ctx, value[0], input.Login, ipAddress, lockedUntil)
+	}
+	if retryAfter > 0 {
+		return &proto.LoginReply{LoginStatus: false, RetryAfter: int64(math.Ceil(retryAfter.Seconds()))}, nil
+	}
+
 	user, errLogin := as.userServiceClient.GetUserByLogin(
 		metadata.NewOutgoingContext(ctx,
 			metadata.New(map[string]string{"requestID": value[0]})),
@@ -59,19 +91,45 @@ func (as *AuthServer) Login(ctx context.Context, input *proto.LoginRequest) (*pr
 		return &proto.LoginReply{LoginStatus: false}, fmt.Errorf("login failed")
 	}
 
-	session, errStatus := as.sessionServiceClient.CreateSession(
+	if err := as.loginLimiter.RegisterSuccess(input.Login, ipAddress, ctx); err != nil {
+		log.Printf("failed to reset login attempts: %v", err)
+	}
+
+	return as.startSession(ctx, value[0], user.User.Id, as.userServiceClient, as.sessionServiceClient)
+}
+
+// sendLoginLockout warns the owner of the login locked by an attempt, if the login belongs to an existing user.
+// The lockout alert goes to the mailbox of the login itself.
+func (as *AuthServer) sendLoginLockout(ctx context.Context, requestID, login, ipAddress string, lockedUntil time.Time) {
+	unique, errUnique := as.userServiceClient.IsLoginUnique(
 		metadata.NewOutgoingContext(ctx,
-			metadata.New(map[string]string{"requestID": value[0]})),
-		&session_proto.CreateSessionRequest{Session: &session_proto.Session{UserId: user.User.Id,
-			Device:   "",
-			LifeTime: 60 * 60 * 24},
-		},
+			metadata.New(map[string]string{"requestID": requestID})),
+		&user_proto.IsLoginUniqueRequest{Login: login},
 	)
-	if errStatus != nil {
-		return &proto.LoginReply{LoginStatus: false}, fmt.Errorf("create session failed")
+	if errUnique != nil || unique.Status {
+		return
 	}
 
-	return &proto.LoginReply{LoginStatus: true, SessionId: session.SessionId}, nil
+	if err := as.notifier.SendLoginLockout(login, ipAddress, lockedUntil); err != nil {
+		log.Printf("failed to send login lockout alert: %v", err)
+	}
+}
+
+// UnlockLogin forgets the failed login attempts on a login and from an IP address, lifting their lockout.
+// It is meant for the administrators and is not exposed through the HTTP API.
+func (as *AuthServer) UnlockLogin(ctx context.Context, input *proto.UnlockLoginRequest) (*proto.UnlockLoginReply, error) {
+	input.Login = sanitize.SanitizeString(input.Login)
+	input.IpAddress = sanitize.SanitizeString(input.IpAddress)
+
+	if validUtil.IsEmpty(input.Login) && validUtil.IsEmpty(input.IpAddress) {
+		return nil, fmt.Errorf("login or ip address must be filled in")
+	}
+
+	if err := as.loginLimiter.Unlock(input.Login, input.IpAddress, ctx); err != nil {
+		return nil, fmt.Errorf("failed to unlock login")
+	}
+
+	return &proto.UnlockLoginReply{}, nil
 }
 
 // LoginVK handles user login.
@@ -115,20 +173,8 @@ func (as *AuthServer) LoginVK(ctx context.Context, input *proto.LoginVKRequest)
 	defer conn2.Close()
 
 	sessionServiceClient := session_proto.NewSessionServiceClient(conn2)
-	session, errStatus := sessionServiceClient.CreateSession(
-		metadata.NewOutgoingContext(ctx,
-			metadata.New(map[string]string{"requestID": value[0]})),
-		&session_proto.CreateSessionRequest{Session: &session_proto.Session{UserId: user.User.Id,
-			Device:   "",
-			LifeTime: 60 * 60 * 24},
-		},
-	)
-	if errStatus != nil {
-		fmt.Println("create session failed\"")
-		return &proto.LoginReply{LoginStatus: false}, fmt.Errorf("create session failed")
-	}
 
-	return &proto.LoginReply{LoginStatus: true, SessionId: session.SessionId}, nil
+	return as.startSession(ctx, value[0], user.User.Id, userServiceClient, sessionServiceClient)
 }
 
 // Signup handles user signup.
@@ -141,6 +187,7 @@ func (as *AuthServer) Signup(ctx context.Context, input *proto.SignupRequest) (*
 	input.PhoneNumber = sanitize.SanitizeString(input.PhoneNumber)
 	input.Description = sanitize.SanitizeString(input.Description)
 	input.Avatar = sanitize.SanitizeString(input.Avatar)
+	input.RecoveryEmail = sanitize.SanitizeString(input.RecoveryEmail)
 
 	if validUtil.IsEmpty(input.Login) || validUtil.IsEmpty(input.Password) || validUtil.IsEmpty(input.Firstname) || validUtil.IsEmpty(input.Surname) || !domain.IsValidGender(domain.GetGenderType(input.Gender)) {
 		return nil, fmt.Errorf("all fields must be filled in")
@@ -150,6 +197,10 @@ func (as *AuthServer) Signup(ctx context.Context, input *proto.SignupRequest) (*
 		return nil, fmt.Errorf("domain in the login is not suitable")
 	}
 
+	if input.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(input.RecoveryEmail) {
+		return nil, fmt.Errorf("invalid recovery email")
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("metadata error")
@@ -165,7 +216,7 @@ func (as *AuthServer) Signup(ctx context.Context, input *proto.SignupRequest) (*
 		return nil, fmt.Errorf("such a login already exists")
 	}
 
-	_, errCreate := as.userServiceClient.CreateUser(
+	user, errCreate := as.userServiceClient.CreateUser(
 		metadata.NewOutgoingContext(ctx,
 			metadata.New(map[string]string{"requestID": value[0]})),
 		&user_proto.CreateUserRequest{User: &user_proto.User{
@@ -179,12 +230,16 @@ func (as *AuthServer) Signup(ctx context.Context, input *proto.SignupRequest) (*
 			Avatar:      input.Avatar,
 			PhoneNumber: input.PhoneNumber,
 			Description: input.Description,
-		}},
+		}, RecoveryEmail: input.RecoveryEmail},
 	)
 	if errCreate != nil {
 		return nil, fmt.Errorf("failed to add user")
 	}
 
+	if input.RecoveryEmail != "" {
+		as.sendRecoveryEmailVerification(user.GetUser().GetId(), input.RecoveryEmail)
+	}
+
 	return &proto.SignupReply{SignupStatus: true}, nil
 }
 
@@ -193,6 +248,7 @@ func (as *AuthServer) SignupVK(ctx context.Context, input *proto.SignupVKRequest
 	input.Login = sanitize.SanitizeString(input.Login)
 	input.Firstname = sanitize.SanitizeString(input.Firstname)
 	input.Surname = sanitize.SanitizeString(input.Surname)
+	input.RecoveryEmail = sanitize.SanitizeString(input.RecoveryEmail)
 
 	if validUtil.IsEmpty(input.Login) || validUtil.IsEmpty(input.Firstname) || validUtil.IsEmpty(input.Surname) || !domain.IsValidGender(domain.GetGenderType(input.Gender)) {
 		return nil, fmt.Errorf("all fields must be filled in")
@@ -202,6 +258,10 @@ func (as *AuthServer) SignupVK(ctx context.Context, input *proto.SignupVKRequest
 		return nil, fmt.Errorf("domain in the login is not suitable")
 	}
 
+	if input.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(input.RecoveryEmail) {
+		return nil, fmt.Errorf("invalid recovery email")
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("metadata error")
@@ -233,7 +293,7 @@ func (as *AuthServer) SignupVK(ctx context.Context, input *proto.SignupVKRequest
 		return nil, fmt.Errorf("A user with this VKId has already been registered")
 	}
 
-	_, errCreate := userServiceClient.CreateUserOtherMail(
+	user, errCreate := userServiceClient.CreateUserOtherMail(
 		metadata.NewOutgoingContext(ctx,
 			metadata.New(map[string]string{"requestID": value[0]})),
 		&user_proto.CreateUserRequest{User: &user_proto.User{
@@ -243,12 +303,17 @@ func (as *AuthServer) SignupVK(ctx context.Context, input *proto.SignupVKRequest
 			Birthday:  input.Birthday,
 			Gender:    input.Gender,
 			VkId:      input.VkId,
-		}},
+		}, RecoveryEmail: input.RecoveryEmail},
 	)
 	if errCreate != nil {
 		return nil, fmt.Errorf("failed to add user")
 	}
 
+	// The account has no password, the verified recovery email is the only way to restore it without VK.
+	if input.RecoveryEmail != "" {
+		as.sendRecoveryEmailVerification(user.GetUser().GetId(), input.RecoveryEmail)
+	}
+
 	return &proto.SignupReply{SignupStatus: true}, nil
 }
 
@@ -349,17 +414,204 @@ func (as *AuthServer) LoginOtherMail(ctx context.Context, input *proto.LoginOthe
 	value := md.Get("requestID")
 
 	sessionServiceClient := session_proto.NewSessionServiceClient(conn2)
+
+	return as.startSession(ctx, value[0], input.Id, as.userServiceClient, sessionServiceClient)
+}
+
+// LoginTwoFactor completes a login of a user with two-factor authentication.
+// The session is created only after the code of the login challenge is valid.
+func (as *AuthServer) LoginTwoFactor(ctx context.Context, input *proto.LoginTwoFactorRequest) (*proto.LoginReply, error) {
+	input.ChallengeId = sanitize.SanitizeString(input.ChallengeId)
+	input.Code = sanitize.SanitizeString(input.Code)
+
+	if validUtil.IsEmpty(input.ChallengeId) || validUtil.IsEmpty(input.Code) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	user, errVerify := as.userServiceClient.VerifyTwoFactorChallenge(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.VerifyTwoFactorChallengeRequest{ChallengeId: input.ChallengeId, Code: input.Code},
+	)
+	if errVerify != nil {
+		return &proto.LoginReply{LoginStatus: false}, fmt.Errorf("two-factor verification failed")
+	}
+
+	return as.createSession(ctx, value[0], user.Id, as.sessionServiceClient)
+}
+
+// ChangePassword changes the password of the user of the session and signs out all the other sessions of the user.
+func (as *AuthServer) ChangePassword(ctx context.Context, input *proto.PasswordChangeRequest) (*proto.PasswordChangeReply, error) {
+	input.SessionId = sanitize.SanitizeString(input.SessionId)
+	input.OldPassword = sanitize.SanitizeString(input.OldPassword)
+	input.NewPassword = sanitize.SanitizeString(input.NewPassword)
+
+	if validUtil.IsEmpty(input.SessionId) || validUtil.IsEmpty(input.OldPassword) || validUtil.IsEmpty(input.NewPassword) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	profile, errSession := as.sessionServiceClient.GetProfileIDBySession(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&session_proto.GetLoginBySessionRequest{SessionId: input.SessionId},
+	)
+	if errSession != nil {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	_, errChange := as.userServiceClient.ChangePassword(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.ChangePasswordRequest{Id: profile.Id, OldPassword: input.OldPassword, NewPassword: input.NewPassword},
+	)
+	if errChange != nil {
+		return nil, fmt.Errorf("failed to change password")
+	}
+
+	_, errDelete := as.sessionServiceClient.DeleteOtherSessions(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&session_proto.DeleteOtherSessionsRequest{SessionId: input.SessionId},
+	)
+	if errDelete != nil {
+		return nil, fmt.Errorf("failed to sign out other sessions")
+	}
+
+	return &proto.PasswordChangeReply{Status: true}, nil
+}
+
+// RequestPasswordReset sends a password reset link to the recovery email of the user with the login.
+// The reply is the same whether the user exists or not, so logins can not be enumerated with it.
+func (as *AuthServer) RequestPasswordReset(ctx context.Context, input *proto.PasswordResetLinkRequest) (*proto.PasswordResetLinkReply, error) {
+	input.Login = sanitize.SanitizeString(input.Login)
+
+	if validUtil.IsEmpty(input.Login) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if !validUtil.IsValidEmailFormat(input.Login) {
+		return nil, fmt.Errorf("domain in the login is not suitable")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	token, errToken := as.userServiceClient.CreatePasswordResetToken(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.CreatePasswordResetTokenRequest{Login: input.Login},
+	)
+	if errToken != nil || token.Token == "" {
+		return &proto.PasswordResetLinkReply{}, nil
+	}
+
+	if err := as.notifier.SendPasswordReset(token.RecoveryEmail, as.resetURL+token.Token); err != nil {
+		log.Printf("failed to send password reset link: %v", err)
+	}
+
+	return &proto.PasswordResetLinkReply{}, nil
+}
+
+// ResetPassword sets a new password with a password reset token and signs out all the sessions of the user.
+func (as *AuthServer) ResetPassword(ctx context.Context, input *proto.PasswordResetRequest) (*proto.PasswordResetReply, error) {
+	input.Token = sanitize.SanitizeString(input.Token)
+	input.NewPassword = sanitize.SanitizeString(input.NewPassword)
+
+	if validUtil.IsEmpty(input.Token) || validUtil.IsEmpty(input.NewPassword) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	user, errReset := as.userServiceClient.ResetPassword(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.ResetPasswordRequest{Token: input.Token, NewPassword: input.NewPassword},
+	)
+	if errReset != nil {
+		return nil, fmt.Errorf("password reset token is invalid or expired")
+	}
+
+	_, errDelete := as.sessionServiceClient.DeleteUserSessions(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&session_proto.DeleteUserSessionsRequest{UserId: user.Id},
+	)
+	if errDelete != nil {
+		return nil, fmt.Errorf("failed to sign out sessions")
+	}
+
+	return &proto.PasswordResetReply{Status: true}, nil
+}
+
+// startSession creates the session of the user who passed the first login step.
+// If the user has enabled two-factor authentication, no session is created and
+// the reply carries the challenge the code has to be sent with to LoginTwoFactor.
+func (as *AuthServer) startSession(ctx context.Context, requestID string, userID uint32, userServiceClient user_proto.UserServiceClient, sessionServiceClient session_proto.SessionServiceClient) (*proto.LoginReply, error) {
+	challenge, errChallenge := userServiceClient.CreateTwoFactorChallenge(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": requestID})),
+		&user_proto.TwoFactorUserRequest{Id: userID},
+	)
+	if errChallenge != nil {
+		return &proto.LoginReply{LoginStatus: false}, fmt.Errorf("two-factor check failed")
+	}
+
+	if challenge.ChallengeId != "" {
+		return &proto.LoginReply{LoginStatus: false, TwoFactorRequired: true, ChallengeId: challenge.ChallengeId}, nil
+	}
+
+	return as.createSession(ctx, requestID, userID, sessionServiceClient)
+}
+
+// createSession creates the session of the authenticated user.
+// The user agent, the IP address of the client and the "remember me" choice are taken from the incoming metadata.
+func (as *AuthServer) createSession(ctx context.Context, requestID string, userID uint32, sessionServiceClient session_proto.SessionServiceClient) (*proto.LoginReply, error) {
+	var device, ipAddress string
+	var rememberMe bool
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("rememberMe"); len(values) > 0 {
+			rememberMe = values[0] == "true"
+		}
+		if values := md.Get("userAgent"); len(values) > 0 {
+			device = values[0]
+		}
+		if values := md.Get("ipAddress"); len(values) > 0 {
+			ipAddress = values[0]
+		}
+	}
+
 	session, errStatus := sessionServiceClient.CreateSession(
-		metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"requestID": value[0]})),
-		&session_proto.CreateSessionRequest{Session: &session_proto.Session{UserId: input.Id,
-			Device:   "",
-			LifeTime: 60 * 60 * 24},
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": requestID})),
+		&session_proto.CreateSessionRequest{Session: &session_proto.Session{UserId: userID,
+			Device:    device,
+			IpAddress: ipAddress,
+			LifeTime:  int32(domain.SessionLifeTimeSeconds(rememberMe))},
 		},
 	)
 	if errStatus != nil {
-		fmt.Println("create session failed\"")
 		return &proto.LoginReply{LoginStatus: false}, fmt.Errorf("create session failed")
 	}
 
-	return &proto.LoginReply{LoginStatus: true, SessionId: session.SessionId}, nil
+	return &proto.LoginReply{LoginStatus: true, SessionId: session.SessionId, UserId: userID}, nil
 }
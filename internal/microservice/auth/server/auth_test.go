@@ -5,18 +5,30 @@ import (
 	"fmt"
 	"google.golang.org/grpc/metadata"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"mail/internal/microservice/auth/proto"
 
+	auth_mock "mail/internal/microservice/auth/mock"
+	domain "mail/internal/microservice/models/domain_models"
 	session_mock "mail/internal/microservice/session/mock"
 	session_proto "mail/internal/microservice/session/proto"
 	user_mock "mail/internal/microservice/user/mock"
 	user_proto "mail/internal/microservice/user/proto"
 )
 
+// newAllowingLoginLimiter returns a login limiter that allows every attempt.
+func newAllowingLoginLimiter(ctrl *gomock.Controller) *auth_mock.MockLoginLimiter {
+	limiter := auth_mock.NewMockLoginLimiter(ctrl)
+	limiter.EXPECT().Attempt(gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Duration(0), time.Time{}, nil).AnyTimes()
+	limiter.EXPECT().RegisterSuccess(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return limiter
+}
+
 func TestAuthServer_Login_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -26,13 +38,14 @@ func TestAuthServer_Login_Success(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, newAllowingLoginLimiter(ctrl), nil, "", "", nil)
 
 	loginRequest := &proto.LoginRequest{Login: "user@mailhub.su", Password: "password123"}
 
 	mockUser := &user_proto.User{Id: 123}
 
 	mockUserServiceClient.EXPECT().GetUserByLogin(gomock.Any(), gomock.Any()).Return(&user_proto.GetUserByLoginReply{User: mockUser}, nil)
+	mockUserServiceClient.EXPECT().CreateTwoFactorChallenge(gomock.Any(), &user_proto.TwoFactorUserRequest{Id: 123}).Return(&user_proto.CreateTwoFactorChallengeReply{}, nil)
 	mockSessionServiceClient.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(&session_proto.CreateSessionReply{SessionId: "10101010"}, nil)
 
 	reply, err := server.Login(ctx, loginRequest)
@@ -51,7 +64,7 @@ func TestAuthServer_Login_InvalidLogin(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, newAllowingLoginLimiter(ctrl), nil, "", "", nil)
 
 	loginRequest := &proto.LoginRequest{Login: "user", Password: "password123"}
 
@@ -71,7 +84,7 @@ func TestAuthServer_Login_EmptyFields(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, newAllowingLoginLimiter(ctrl), nil, "", "", nil)
 
 	loginRequest := &proto.LoginRequest{Login: "", Password: ""}
 
@@ -91,7 +104,7 @@ func TestAuthServer_Login_UserServiceError(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, newAllowingLoginLimiter(ctrl), nil, "", "", nil)
 
 	loginRequest := &proto.LoginRequest{Login: "user@mailhub.su", Password: "password123"}
 
@@ -113,13 +126,14 @@ func TestAuthServer_Login_SessionServiceError(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, newAllowingLoginLimiter(ctrl), nil, "", "", nil)
 
 	loginRequest := &proto.LoginRequest{Login: "user@mailhub.su", Password: "password123"}
 
 	mockUser := &user_proto.User{Id: 123}
 
 	mockUserServiceClient.EXPECT().GetUserByLogin(gomock.Any(), gomock.Any()).Return(&user_proto.GetUserByLoginReply{User: mockUser}, nil)
+	mockUserServiceClient.EXPECT().CreateTwoFactorChallenge(gomock.Any(), &user_proto.TwoFactorUserRequest{Id: 123}).Return(&user_proto.CreateTwoFactorChallengeReply{}, nil)
 	mockSessionServiceClient.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("session service error"))
 
 	reply, err := server.Login(ctx, loginRequest)
@@ -129,6 +143,134 @@ func TestAuthServer_Login_SessionServiceError(t *testing.T) {
 	assert.False(t, reply.LoginStatus)
 }
 
+func TestAuthServer_Login_TwoFactorRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, newAllowingLoginLimiter(ctrl), nil, "", "", nil)
+
+	loginRequest := &proto.LoginRequest{Login: "user@mailhub.su", Password: "password123"}
+
+	mockUser := &user_proto.User{Id: 123}
+
+	mockUserServiceClient.EXPECT().GetUserByLogin(gomock.Any(), gomock.Any()).Return(&user_proto.GetUserByLoginReply{User: mockUser}, nil)
+	mockUserServiceClient.EXPECT().CreateTwoFactorChallenge(gomock.Any(), &user_proto.TwoFactorUserRequest{Id: 123}).Return(&user_proto.CreateTwoFactorChallengeReply{ChallengeId: "challenge"}, nil)
+
+	reply, err := server.Login(ctx, loginRequest)
+
+	assert.NoError(t, err)
+	assert.False(t, reply.LoginStatus)
+	assert.True(t, reply.TwoFactorRequired)
+	assert.Equal(t, "challenge", reply.ChallengeId)
+	assert.Empty(t, reply.SessionId)
+}
+
+func TestAuthServer_Login_TwoFactorCheckError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, newAllowingLoginLimiter(ctrl), nil, "", "", nil)
+
+	loginRequest := &proto.LoginRequest{Login: "user@mailhub.su", Password: "password123"}
+
+	mockUserServiceClient.EXPECT().GetUserByLogin(gomock.Any(), gomock.Any()).Return(&user_proto.GetUserByLoginReply{User: &user_proto.User{Id: 123}}, nil)
+	mockUserServiceClient.EXPECT().CreateTwoFactorChallenge(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("user service error"))
+
+	reply, err := server.Login(ctx, loginRequest)
+
+	assert.Error(t, err)
+	assert.False(t, reply.LoginStatus)
+}
+
+func TestAuthServer_LoginTwoFactor_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
+
+	mockUserServiceClient.EXPECT().VerifyTwoFactorChallenge(gomock.Any(), &user_proto.VerifyTwoFactorChallengeRequest{ChallengeId: "challenge", Code: "123456"}).
+		Return(&user_proto.VerifyTwoFactorChallengeReply{Id: 123}, nil)
+	mockSessionServiceClient.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(&session_proto.CreateSessionReply{SessionId: "10101010"}, nil)
+
+	reply, err := server.LoginTwoFactor(ctx, &proto.LoginTwoFactorRequest{ChallengeId: "challenge", Code: "123456"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.LoginStatus)
+	assert.Equal(t, "10101010", reply.SessionId)
+	assert.Equal(t, uint32(123), reply.UserId)
+}
+
+func TestAuthServer_LoginTwoFactor_RememberMe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID", "rememberMe": "true"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
+
+	mockUserServiceClient.EXPECT().VerifyTwoFactorChallenge(gomock.Any(), gomock.Any()).
+		Return(&user_proto.VerifyTwoFactorChallengeReply{Id: 123}, nil)
+	mockSessionServiceClient.EXPECT().CreateSession(gomock.Any(), &session_proto.CreateSessionRequest{
+		Session: &session_proto.Session{UserId: 123, LifeTime: int32(domain.SessionLifeTimeSeconds(true))},
+	}).Return(&session_proto.CreateSessionReply{SessionId: "10101010"}, nil)
+
+	reply, err := server.LoginTwoFactor(ctx, &proto.LoginTwoFactorRequest{ChallengeId: "challenge", Code: "123456"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.LoginStatus)
+}
+
+func TestAuthServer_LoginTwoFactor_InvalidCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
+
+	mockUserServiceClient.EXPECT().VerifyTwoFactorChallenge(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("invalid two-factor code"))
+
+	reply, err := server.LoginTwoFactor(ctx, &proto.LoginTwoFactorRequest{ChallengeId: "challenge", Code: "000000"})
+
+	assert.Error(t, err)
+	assert.False(t, reply.LoginStatus)
+}
+
+func TestAuthServer_LoginTwoFactor_EmptyFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	server := NewAuthServer(session_mock.NewMockSessionServiceClient(ctrl), user_mock.NewMockUserServiceClient(ctrl), nil, nil, "", "", nil)
+
+	reply, err := server.LoginTwoFactor(ctx, &proto.LoginTwoFactorRequest{ChallengeId: "challenge"})
+
+	assert.Nil(t, reply)
+	assert.EqualError(t, err, "all fields must be filled in")
+}
+
 func TestAuthServer_Signup_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -138,7 +280,7 @@ func TestAuthServer_Signup_Success(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
 
 	signupRequest := &proto.SignupRequest{
 		Login:       "user@mailhub.su",
@@ -169,7 +311,7 @@ func TestAuthServer_Signup_InvalidLogin(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
 
 	signupRequest := &proto.SignupRequest{
 		Login:       "invalid_email",
@@ -197,7 +339,7 @@ func TestAuthServer_Signup_EmptyFields(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
 
 	signupRequest := &proto.SignupRequest{}
 
@@ -217,7 +359,7 @@ func TestAuthServer_Signup_DuplicateLogin(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
 
 	signupRequest := &proto.SignupRequest{
 		Login:       "user@mailhub.su",
@@ -247,7 +389,7 @@ func TestAuthServer_Signup_UserServiceError(t *testing.T) {
 	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient)
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
 
 	signupRequest := &proto.SignupRequest{
 		Login:       "user@mailhub.su",
@@ -277,7 +419,7 @@ func TestAuthServer_Logout_Success(t *testing.T) {
 
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, nil)
+	server := NewAuthServer(mockSessionServiceClient, nil, nil, nil, "", "", nil)
 
 	logoutRequest := &proto.LogoutRequest{
 		SessionId: "10101010",
@@ -299,7 +441,7 @@ func TestAuthServer_Logout_EmptySessionId(t *testing.T) {
 
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, nil)
+	server := NewAuthServer(mockSessionServiceClient, nil, nil, nil, "", "", nil)
 
 	logoutRequest := &proto.LogoutRequest{}
 
@@ -318,7 +460,7 @@ func TestAuthServer_Logout_SessionServiceError(t *testing.T) {
 
 	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
 
-	server := NewAuthServer(mockSessionServiceClient, nil)
+	server := NewAuthServer(mockSessionServiceClient, nil, nil, nil, "", "", nil)
 
 	logoutRequest := &proto.LogoutRequest{
 		SessionId: "10101010",
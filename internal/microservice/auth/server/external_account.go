@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/auth/proto"
+	"mail/internal/pkg/utils/sanitize"
+
+	_interface "mail/internal/microservice/auth/interface"
+	converters "mail/internal/microservice/models/proto_converters"
+)
+
+// ExternalAccountServer handles RPC calls for the ExternalAccountService, the storage of the IMAP/SMTP mailboxes
+// of other mail providers linked by the users. The passwords are passed to the mail servers as is, so they are not sanitized.
+type ExternalAccountServer struct {
+	proto.UnimplementedExternalAccountServiceServer
+	store _interface.ExternalAccountStore
+}
+
+// NewExternalAccountServer creates a new instance of ExternalAccountServer.
+func NewExternalAccountServer(store _interface.ExternalAccountStore) *ExternalAccountServer {
+	return &ExternalAccountServer{
+		store: store,
+	}
+}
+
+// AddExternalAccount links the mailbox to the user, the gateway checks the connection settings beforehand.
+func (s *ExternalAccountServer) AddExternalAccount(ctx context.Context, input *proto.AddExternalAccountRequest) (*proto.AddExternalAccountReply, error) {
+	if input.Id <= 0 || input.Account == nil {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	account := converters.ExternalAccountConvertProtoInCore(input.Account)
+	account.Email = sanitize.SanitizeString(account.Email)
+	account.IMAPHost = sanitize.SanitizeString(account.IMAPHost)
+	account.SMTPHost = sanitize.SanitizeString(account.SMTPHost)
+	account.Username = sanitize.SanitizeString(account.Username)
+
+	added, err := s.store.Add(input.Id, account, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add external account: %v", err)
+	}
+
+	return &proto.AddExternalAccountReply{Account: converters.ExternalAccountConvertCoreInProto(added)}, nil
+}
+
+// GetExternalAccounts returns the mailboxes linked by the user without their passwords.
+func (s *ExternalAccountServer) GetExternalAccounts(ctx context.Context, input *proto.GetExternalAccountsRequest) (*proto.GetExternalAccountsReply, error) {
+	if input.Id <= 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	accounts, err := s.store.GetAll(input.Id, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external accounts")
+	}
+
+	accountsProto := make([]*proto.ExternalAccount, 0, len(accounts))
+	for _, account := range accounts {
+		accountsProto = append(accountsProto, converters.ExternalAccountConvertCoreInProto(account))
+	}
+
+	return &proto.GetExternalAccountsReply{Accounts: accountsProto}, nil
+}
+
+// GetExternalAccount returns the mailbox of the user with the password, Found is false if the user has no such mailbox.
+// The gateway uses it to connect to the mail servers, the password is never sent to the clients.
+func (s *ExternalAccountServer) GetExternalAccount(ctx context.Context, input *proto.GetExternalAccountRequest) (*proto.GetExternalAccountReply, error) {
+	if input.Id <= 0 || input.AccountId <= 0 {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	account, err := s.store.Get(input.Id, input.AccountId, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external account")
+	}
+	if account == nil {
+		return &proto.GetExternalAccountReply{Found: false}, nil
+	}
+
+	return &proto.GetExternalAccountReply{Account: converters.ExternalAccountConvertCoreInProto(account), Found: true}, nil
+}
+
+// DeleteExternalAccount unlinks the mailbox from the user.
+func (s *ExternalAccountServer) DeleteExternalAccount(ctx context.Context, input *proto.DeleteExternalAccountRequest) (*proto.DeleteExternalAccountReply, error) {
+	if input.Id <= 0 || input.AccountId <= 0 {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if err := s.store.Delete(input.Id, input.AccountId, ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete external account")
+	}
+
+	return &proto.DeleteExternalAccountReply{Status: true}, nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/auth/mock"
+	"mail/internal/microservice/auth/proto"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestExternalAccountServer_AddExternalAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockExternalAccountStore(ctrl)
+	server := NewExternalAccountServer(mockStore)
+	ctx := context.Background()
+	creationDate := time.Now()
+
+	accountProto := &proto.ExternalAccount{Email: "user@example.com", ImapHost: "imap.example.com", ImapPort: 993, ImapTlsMode: "tls",
+		SmtpHost: "smtp.example.com", SmtpPort: 465, SmtpTlsMode: "tls", Username: "user", Password: "p<a&ss"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockStore.EXPECT().Add(uint32(2), gomock.Any(), ctx).DoAndReturn(
+			func(profileID uint32, account *domain.ExternalAccount, ctx context.Context) (*domain.ExternalAccount, error) {
+				assert.Equal(t, "p<a&ss", account.Password, "the password must not be sanitized")
+				added := *account
+				added.ID, added.ProfileID, added.Password, added.CreationDate = 4, profileID, "", creationDate
+				return &added, nil
+			})
+
+		reply, err := server.AddExternalAccount(ctx, &proto.AddExternalAccountRequest{Id: 2, Account: accountProto})
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(4), reply.Account.Id)
+		assert.Empty(t, reply.Account.Password)
+	})
+
+	t.Run("NoAccount", func(t *testing.T) {
+		_, err := server.AddExternalAccount(ctx, &proto.AddExternalAccountRequest{Id: 2})
+		assert.Error(t, err)
+	})
+
+	t.Run("StoreError", func(t *testing.T) {
+		mockStore.EXPECT().Add(uint32(2), gomock.Any(), ctx).Return(nil, errors.New("already linked"))
+
+		_, err := server.AddExternalAccount(ctx, &proto.AddExternalAccountRequest{Id: 2, Account: accountProto})
+		assert.Error(t, err)
+	})
+}
+
+func TestExternalAccountServer_GetExternalAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockExternalAccountStore(ctrl)
+	server := NewExternalAccountServer(mockStore)
+	ctx := context.Background()
+
+	mockStore.EXPECT().GetAll(uint32(2), ctx).Return([]*domain.ExternalAccount{{ID: 4, Email: "user@example.com"}}, nil)
+	reply, err := server.GetExternalAccounts(ctx, &proto.GetExternalAccountsRequest{Id: 2})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Accounts, 1)
+	assert.Equal(t, "user@example.com", reply.Accounts[0].Email)
+
+	_, err = server.GetExternalAccounts(ctx, &proto.GetExternalAccountsRequest{})
+	assert.Error(t, err)
+
+	mockStore.EXPECT().GetAll(uint32(2), ctx).Return(nil, errors.New("db error"))
+	_, err = server.GetExternalAccounts(ctx, &proto.GetExternalAccountsRequest{Id: 2})
+	assert.Error(t, err)
+}
+
+func TestExternalAccountServer_GetExternalAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockExternalAccountStore(ctrl)
+	server := NewExternalAccountServer(mockStore)
+	ctx := context.Background()
+
+	mockStore.EXPECT().Get(uint32(2), uint32(4), ctx).Return(&domain.ExternalAccount{ID: 4, Password: "password"}, nil)
+	reply, err := server.GetExternalAccount(ctx, &proto.GetExternalAccountRequest{Id: 2, AccountId: 4})
+	assert.NoError(t, err)
+	assert.True(t, reply.Found)
+	assert.Equal(t, "password", reply.Account.Password)
+
+	mockStore.EXPECT().Get(uint32(2), uint32(5), ctx).Return(nil, nil)
+	reply, err = server.GetExternalAccount(ctx, &proto.GetExternalAccountRequest{Id: 2, AccountId: 5})
+	assert.NoError(t, err)
+	assert.False(t, reply.Found)
+
+	_, err = server.GetExternalAccount(ctx, &proto.GetExternalAccountRequest{Id: 2})
+	assert.Error(t, err)
+
+	mockStore.EXPECT().Get(uint32(2), uint32(4), ctx).Return(nil, errors.New("db error"))
+	_, err = server.GetExternalAccount(ctx, &proto.GetExternalAccountRequest{Id: 2, AccountId: 4})
+	assert.Error(t, err)
+}
+
+func TestExternalAccountServer_DeleteExternalAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockExternalAccountStore(ctrl)
+	server := NewExternalAccountServer(mockStore)
+	ctx := context.Background()
+
+	mockStore.EXPECT().Delete(uint32(2), uint32(4), ctx).Return(nil)
+	reply, err := server.DeleteExternalAccount(ctx, &proto.DeleteExternalAccountRequest{Id: 2, AccountId: 4})
+	assert.NoError(t, err)
+	assert.True(t, reply.Status)
+
+	mockStore.EXPECT().Delete(uint32(2), uint32(5), ctx).Return(errors.New("not found"))
+	_, err = server.DeleteExternalAccount(ctx, &proto.DeleteExternalAccountRequest{Id: 2, AccountId: 5})
+	assert.Error(t, err)
+
+	_, err = server.DeleteExternalAccount(ctx, &proto.DeleteExternalAccountRequest{Id: 2})
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/auth/proto"
+	"mail/internal/pkg/utils/sanitize"
+
+	_interface "mail/internal/microservice/auth/interface"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// GMailTokenServer handles RPC calls for the GMailTokenService, the storage of the OAuth tokens the gateway
+// uses to reach the Gmail mailboxes. The tokens are opaque to the service and are not sanitized.
+type GMailTokenServer struct {
+	proto.UnimplementedGMailTokenServiceServer
+	store _interface.GMailTokenStore
+}
+
+// NewGMailTokenServer creates a new instance of GMailTokenServer.
+func NewGMailTokenServer(store _interface.GMailTokenStore) *GMailTokenServer {
+	return &GMailTokenServer{
+		store: store,
+	}
+}
+
+// SaveGMailToken stores the token of the Gmail address, it is called after the sign in and whenever the token is refreshed.
+func (s *GMailTokenServer) SaveGMailToken(ctx context.Context, input *proto.SaveGMailTokenRequest) (*proto.SaveGMailTokenReply, error) {
+	input.Login = sanitize.SanitizeString(input.Login)
+
+	if validUtil.IsEmpty(input.Login) || len(input.Token) == 0 {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	err := s.store.Save(input.Login, input.Token, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save gmail token: %v", err)
+	}
+
+	return &proto.SaveGMailTokenReply{Status: true}, nil
+}
+
+// GetGMailToken returns the token of the Gmail address, Found is false if the user has not signed in with Gmail.
+func (s *GMailTokenServer) GetGMailToken(ctx context.Context, input *proto.GetGMailTokenRequest) (*proto.GetGMailTokenReply, error) {
+	input.Login = sanitize.SanitizeString(input.Login)
+
+	if validUtil.IsEmpty(input.Login) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	token, err := s.store.Get(input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gmail token: %v", err)
+	}
+	if token == nil {
+		return &proto.GetGMailTokenReply{Found: false}, nil
+	}
+
+	return &proto.GetGMailTokenReply{Token: token, Found: true}, nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/auth/mock"
+	"mail/internal/microservice/auth/proto"
+)
+
+func TestGMailTokenServer_SaveGMailToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockGMailTokenStore(ctrl)
+	server := NewGMailTokenServer(mockStore)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockStore.EXPECT().Save("user@gmail.com", []byte("token"), ctx).Return(nil)
+
+		reply, err := server.SaveGMailToken(ctx, &proto.SaveGMailTokenRequest{Login: "user@gmail.com", Token: []byte("token")})
+		assert.NoError(t, err)
+		assert.True(t, reply.Status)
+	})
+
+	t.Run("EmptyToken", func(t *testing.T) {
+		_, err := server.SaveGMailToken(ctx, &proto.SaveGMailTokenRequest{Login: "user@gmail.com"})
+		assert.Error(t, err)
+	})
+
+	t.Run("StoreError", func(t *testing.T) {
+		mockStore.EXPECT().Save("user@gmail.com", []byte("token"), ctx).Return(errors.New("db error"))
+
+		_, err := server.SaveGMailToken(ctx, &proto.SaveGMailTokenRequest{Login: "user@gmail.com", Token: []byte("token")})
+		assert.Error(t, err)
+	})
+}
+
+func TestGMailTokenServer_GetGMailToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockGMailTokenStore(ctrl)
+	server := NewGMailTokenServer(mockStore)
+	ctx := context.Background()
+
+	t.Run("Found", func(t *testing.T) {
+		mockStore.EXPECT().Get("user@gmail.com", ctx).Return([]byte("token"), nil)
+
+		reply, err := server.GetGMailToken(ctx, &proto.GetGMailTokenRequest{Login: "user@gmail.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, &proto.GetGMailTokenReply{Token: []byte("token"), Found: true}, reply)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockStore.EXPECT().Get("unknown@gmail.com", ctx).Return(nil, nil)
+
+		reply, err := server.GetGMailToken(ctx, &proto.GetGMailTokenRequest{Login: "unknown@gmail.com"})
+		assert.NoError(t, err)
+		assert.False(t, reply.Found)
+	})
+
+	t.Run("EmptyLogin", func(t *testing.T) {
+		_, err := server.GetGMailToken(ctx, &proto.GetGMailTokenRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("StoreError", func(t *testing.T) {
+		mockStore.EXPECT().Get("user@gmail.com", ctx).Return(nil, errors.New("db error"))
+
+		_, err := server.GetGMailToken(ctx, &proto.GetGMailTokenRequest{Login: "user@gmail.com"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/auth/proto"
+
+	auth_mock "mail/internal/microservice/auth/mock"
+	user_mock "mail/internal/microservice/user/mock"
+	user_proto "mail/internal/microservice/user/proto"
+)
+
+func loginLimitCTX() context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.New(map[string]string{"requestID": "testID", "ipAddress": "10.0.0.1"}))
+}
+
+func TestAuthServer_Login_Throttled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := loginLimitCTX()
+
+	mockLimiter := auth_mock.NewMockLoginLimiter(ctrl)
+
+	server := NewAuthServer(nil, nil, mockLimiter, nil, "", "", nil)
+
+	mockLimiter.EXPECT().Attempt("user@mailhub.su", "10.0.0.1", ctx).Return(1500*time.Millisecond, time.Time{}, nil)
+
+	reply, err := server.Login(ctx, &proto.LoginRequest{Login: "user@mailhub.su", Password: "password123"})
+
+	assert.NoError(t, err)
+	assert.False(t, reply.LoginStatus)
+	assert.Equal(t, int64(2), reply.RetryAfter)
+}
+
+func TestAuthServer_Login_LockAlerts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := loginLimitCTX()
+	lockedUntil := time.Now().Add(15 * time.Minute)
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockLimiter := auth_mock.NewMockLoginLimiter(ctrl)
+	mockNotifier := auth_mock.NewMockNotifier(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, mockLimiter, mockNotifier, "", "", nil)
+
+	mockLimiter.EXPECT().Attempt("user@mailhub.su", "10.0.0.1", ctx).Return(15*time.Minute, lockedUntil, nil)
+	mockUserServiceClient.EXPECT().IsLoginUnique(gomock.Any(), &user_proto.IsLoginUniqueRequest{Login: "user@mailhub.su"}).
+		Return(&user_proto.IsLoginUniqueReply{Status: false}, nil)
+	mockNotifier.EXPECT().SendLoginLockout("user@mailhub.su", "10.0.0.1", lockedUntil).Return(nil)
+
+	reply, err := server.Login(ctx, &proto.LoginRequest{Login: "user@mailhub.su", Password: "wrong"})
+
+	assert.NoError(t, err)
+	assert.False(t, reply.LoginStatus)
+	assert.Equal(t, int64(900), reply.RetryAfter)
+}
+
+func TestAuthServer_Login_LockUnknownLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := loginLimitCTX()
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockLimiter := auth_mock.NewMockLoginLimiter(ctrl)
+	mockNotifier := auth_mock.NewMockNotifier(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, mockLimiter, mockNotifier, "", "", nil)
+
+	mockLimiter.EXPECT().Attempt("nobody@mailhub.su", "10.0.0.1", ctx).Return(time.Minute, time.Now().Add(time.Minute), nil)
+	mockUserServiceClient.EXPECT().IsLoginUnique(gomock.Any(), gomock.Any()).Return(&user_proto.IsLoginUniqueReply{Status: true}, nil)
+
+	reply, err := server.Login(ctx, &proto.LoginRequest{Login: "nobody@mailhub.su", Password: "guess"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), reply.RetryAfter)
+}
+
+func TestAuthServer_Login_FailureNotRegisteredTwice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := loginLimitCTX()
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockLimiter := auth_mock.NewMockLoginLimiter(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, mockLimiter, nil, "", "", nil)
+
+	mockLimiter.EXPECT().Attempt("user@mailhub.su", "10.0.0.1", ctx).Return(time.Duration(0), time.Time{}, nil)
+	mockUserServiceClient.EXPECT().GetUserByLogin(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("wrong password"))
+	mockLimiter.EXPECT().RegisterSuccess(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := server.Login(ctx, &proto.LoginRequest{Login: "user@mailhub.su", Password: "wrong"})
+
+	assert.EqualError(t, err, "login failed")
+}
+
+func TestAuthServer_UnlockLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := loginLimitCTX()
+
+	mockLimiter := auth_mock.NewMockLoginLimiter(ctrl)
+
+	server := NewAuthServer(nil, nil, mockLimiter, nil, "", "", nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockLimiter.EXPECT().Unlock("user@mailhub.su", "", ctx).Return(nil)
+
+		reply, err := server.UnlockLogin(ctx, &proto.UnlockLoginRequest{Login: "user@mailhub.su"})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, reply)
+	})
+
+	t.Run("EmptyFields", func(t *testing.T) {
+		_, err := server.UnlockLogin(ctx, &proto.UnlockLoginRequest{})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,331 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/auth/proto"
+	"mail/internal/pkg/utils/sanitize"
+
+	_interface "mail/internal/microservice/auth/interface"
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/proto_converters"
+	session_proto "mail/internal/microservice/session/proto"
+	user_proto "mail/internal/microservice/user/proto"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// OIDCServer handles RPC calls for the OIDCService, the OpenID Connect provider which signs users in to other applications.
+// The redirect addresses and the state are compared and passed back to the clients as is, so they are not sanitized.
+type OIDCServer struct {
+	proto.UnimplementedOIDCServiceServer
+	sessionServiceClient session_proto.SessionServiceClient
+	userServiceClient    user_proto.UserServiceClient
+	provider             _interface.OIDCProvider
+}
+
+// NewOIDCServer creates a new instance of OIDCServer.
+func NewOIDCServer(sessionClient session_proto.SessionServiceClient, userClient user_proto.UserServiceClient, provider _interface.OIDCProvider) *OIDCServer {
+	return &OIDCServer{
+		sessionServiceClient: sessionClient,
+		userServiceClient:    userClient,
+		provider:             provider,
+	}
+}
+
+// AuthorizeOIDC handles the authorization request of a client for the signed-in user.
+// It returns the redirect address with the code if the user has already allowed the client to read the requested scopes,
+// and the signed request to show on the consent page otherwise. The errors found before the client and the redirect
+// address are checked are returned as is, the later ones are reported to the client through the redirect address.
+func (s *OIDCServer) AuthorizeOIDC(ctx context.Context, input *proto.AuthorizeOIDCRequest) (*proto.AuthorizeOIDCReply, error) {
+	input.SessionId = sanitize.SanitizeString(input.SessionId)
+	input.ClientId = sanitize.SanitizeString(input.ClientId)
+
+	if validUtil.IsEmpty(input.SessionId) || validUtil.IsEmpty(input.ClientId) || validUtil.IsEmpty(input.RedirectUri) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	profileID, err := s.getProfileIDBySession(input.SessionId, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.provider.GetClient(input.ClientId, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unknown client")
+	}
+	if !client.AllowsRedirectURI(input.RedirectUri) {
+		return nil, fmt.Errorf("redirect uri is not registered")
+	}
+
+	if input.ResponseType != "code" {
+		return &proto.AuthorizeOIDCReply{
+			RedirectUri: errorRedirect(input.RedirectUri, input.State, "unsupported_response_type", "only the authorization code flow is supported"),
+		}, nil
+	}
+
+	scopes, err := domain.ParseOIDCScopes(input.Scope)
+	if err != nil {
+		return &proto.AuthorizeOIDCReply{
+			RedirectUri: errorRedirect(input.RedirectUri, input.State, "invalid_scope", err.Error()),
+		}, nil
+	}
+
+	if validUtil.IsEmpty(input.CodeChallenge) || input.CodeChallengeMethod != domain.OIDCCodeChallengeMethodS256 {
+		return &proto.AuthorizeOIDCReply{
+			RedirectUri: errorRedirect(input.RedirectUri, input.State, "invalid_request", "PKCE with the S256 method is required"),
+		}, nil
+	}
+
+	request := &domain.OIDCAuthorizationRequest{
+		ProfileID:     profileID,
+		ClientID:      client.ClientID,
+		RedirectURI:   input.RedirectUri,
+		Scopes:        scopes,
+		State:         input.State,
+		Nonce:         input.Nonce,
+		CodeChallenge: input.CodeChallenge,
+	}
+
+	consented, err := s.provider.HasConsent(request, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check consent")
+	}
+	if !consented {
+		return &proto.AuthorizeOIDCReply{ConsentRequired: true, ConsentRequest: s.provider.SignConsentRequest(request)}, nil
+	}
+
+	redirectURI, err := s.codeRedirect(request, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AuthorizeOIDCReply{RedirectUri: redirectURI}, nil
+}
+
+// GetOIDCConsent returns the client and the scopes of the authorization request the signed-in user is asked to allow.
+func (s *OIDCServer) GetOIDCConsent(ctx context.Context, input *proto.GetOIDCConsentRequest) (*proto.GetOIDCConsentReply, error) {
+	request, client, err := s.consentRequest(input.SessionId, input.ConsentRequest, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetOIDCConsentReply{ClientId: client.ClientID, ClientName: client.Name, Scopes: request.Scopes}, nil
+}
+
+// GrantOIDCConsent records the decision of the signed-in user on the authorization request.
+// It returns the redirect address with the code if the user has approved the request and with an error otherwise.
+func (s *OIDCServer) GrantOIDCConsent(ctx context.Context, input *proto.GrantOIDCConsentRequest) (*proto.GrantOIDCConsentReply, error) {
+	request, _, err := s.consentRequest(input.SessionId, input.ConsentRequest, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.Approve {
+		return &proto.GrantOIDCConsentReply{
+			RedirectUri: errorRedirect(request.RedirectURI, request.State, "access_denied", "the user has denied the request"),
+		}, nil
+	}
+
+	if err = s.provider.GrantConsent(request, ctx); err != nil {
+		return nil, fmt.Errorf("failed to grant consent")
+	}
+
+	redirectURI, err := s.codeRedirect(request, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GrantOIDCConsentReply{RedirectUri: redirectURI}, nil
+}
+
+// ExchangeOIDCCode exchanges the authorization code for the ID token and the access token.
+// The mistakes of the client are returned in the error field of the reply with the codes of the OAuth 2.0 specification.
+func (s *OIDCServer) ExchangeOIDCCode(ctx context.Context, input *proto.ExchangeOIDCCodeRequest) (*proto.ExchangeOIDCCodeReply, error) {
+	if input.GrantType != "authorization_code" {
+		return &proto.ExchangeOIDCCodeReply{Error: "unsupported_grant_type"}, nil
+	}
+
+	if validUtil.IsEmpty(input.Code) || validUtil.IsEmpty(input.ClientId) || validUtil.IsEmpty(input.RedirectUri) || validUtil.IsEmpty(input.CodeVerifier) {
+		return &proto.ExchangeOIDCCodeReply{Error: "invalid_request"}, nil
+	}
+
+	client, err := s.provider.GetClient(input.ClientId, ctx)
+	if err != nil || !client.CheckSecret(input.ClientSecret) {
+		return &proto.ExchangeOIDCCodeReply{Error: "invalid_client"}, nil
+	}
+
+	code, err := s.provider.ExchangeAuthorizationCode(input.Code, client, input.RedirectUri, input.CodeVerifier, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code")
+	}
+	if code == nil {
+		return &proto.ExchangeOIDCCodeReply{Error: "invalid_grant"}, nil
+	}
+
+	idToken, accessToken, err := s.provider.IssueTokens(code, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue tokens")
+	}
+
+	return &proto.ExchangeOIDCCodeReply{
+		IdToken:     idToken,
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(domain.OIDCTokenLifeTime.Seconds()),
+		Scope:       strings.Join(code.Scopes, " "),
+	}, nil
+}
+
+// GetOIDCUserInfo returns the JSON encoded claims about the user the access token allows the client to read.
+func (s *OIDCServer) GetOIDCUserInfo(ctx context.Context, input *proto.GetOIDCUserInfoRequest) (*proto.GetOIDCUserInfoReply, error) {
+	if validUtil.IsEmpty(input.AccessToken) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	claims, err := s.provider.VerifyAccessToken(input.AccessToken, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token")
+	}
+
+	profileID, err := strconv.ParseUint(claims.Subject, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	user, err := s.userServiceClient.GetUser(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.GetUserRequest{Id: uint32(profileID)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	userInfo, err := json.Marshal(domain.OIDCUserInfo(converters.UserConvertProtoInCore(user.GetUser()), strings.Fields(claims.Scope)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode user info: %v", err)
+	}
+
+	return &proto.GetOIDCUserInfoReply{Claims: userInfo}, nil
+}
+
+// GetOIDCKeys returns the public keys the tokens can be verified with.
+func (s *OIDCServer) GetOIDCKeys(ctx context.Context, input *proto.GetOIDCKeysRequest) (*proto.GetOIDCKeysReply, error) {
+	keys, err := s.provider.PublicKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keys")
+	}
+
+	keysProto := make([]*proto.OIDCKey, 0, len(keys))
+	for _, key := range keys {
+		keysProto = append(keysProto, &proto.OIDCKey{Kid: key.KeyID, N: key.Modulus, E: key.Exponent})
+	}
+
+	return &proto.GetOIDCKeysReply{Keys: keysProto}, nil
+}
+
+// RegisterOIDCClient registers an application which signs users in with their MailHub accounts.
+// The secret is returned once, it is empty for public clients.
+// It is meant for the administrators and is not exposed through the HTTP API.
+func (s *OIDCServer) RegisterOIDCClient(ctx context.Context, input *proto.RegisterOIDCClientRequest) (*proto.RegisterOIDCClientReply, error) {
+	input.Name = sanitize.SanitizeString(input.Name)
+
+	if validUtil.IsEmpty(input.Name) || len(input.RedirectUris) == 0 {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	client, secret, err := s.provider.RegisterClient(input.Name, input.RedirectUris, input.Public, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register client: %v", err)
+	}
+
+	return &proto.RegisterOIDCClientReply{ClientId: client.ClientID, ClientSecret: secret}, nil
+}
+
+// getProfileIDBySession returns the unique identifier of the user signed in with the session.
+func (s *OIDCServer) getProfileIDBySession(sessionID string, ctx context.Context) (uint32, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	profile, err := s.sessionServiceClient.GetProfileIDBySession(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&session_proto.GetLoginBySessionRequest{SessionId: sessionID},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("session not found")
+	}
+
+	return profile.Id, nil
+}
+
+// consentRequest checks the signed authorization request the user signed in with the session is asked to allow
+// and returns it with its client.
+func (s *OIDCServer) consentRequest(sessionID, consentRequest string, ctx context.Context) (*domain.OIDCAuthorizationRequest, *domain.OIDCClient, error) {
+	sessionID = sanitize.SanitizeString(sessionID)
+
+	if validUtil.IsEmpty(sessionID) || validUtil.IsEmpty(consentRequest) {
+		return nil, nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	profileID, err := s.getProfileIDBySession(sessionID, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := s.provider.VerifyConsentRequest(consentRequest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid consent request")
+	}
+	if request.ProfileID != profileID {
+		return nil, nil, fmt.Errorf("consent request belongs to another user")
+	}
+
+	client, err := s.provider.GetClient(request.ClientID, ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown client")
+	}
+
+	return request, client, nil
+}
+
+// codeRedirect issues the code for the request and returns the redirect address which passes it to the client.
+func (s *OIDCServer) codeRedirect(request *domain.OIDCAuthorizationRequest, ctx context.Context) (string, error) {
+	code, err := s.provider.IssueAuthorizationCode(request, ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to issue authorization code")
+	}
+
+	params := url.Values{"code": {code}}
+	if request.State != "" {
+		params.Set("state", request.State)
+	}
+
+	return domain.OIDCRedirect(request.RedirectURI, params), nil
+}
+
+// errorRedirect returns the redirect address which reports the error to the client.
+func errorRedirect(redirectURI, state, code, description string) string {
+	params := url.Values{"error": {code}, "error_description": {description}}
+	if state != "" {
+		params.Set("state", state)
+	}
+
+	return domain.OIDCRedirect(redirectURI, params)
+}
@@ -0,0 +1,365 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/auth/mock"
+	"mail/internal/microservice/auth/proto"
+	"mail/internal/pkg/utils/jwt"
+
+	domain "mail/internal/microservice/models/domain_models"
+	session_mock "mail/internal/microservice/session/mock"
+	session_proto "mail/internal/microservice/session/proto"
+	user_mock "mail/internal/microservice/user/mock"
+	user_proto "mail/internal/microservice/user/proto"
+)
+
+const testRedirectURI = "https://app.example.com/callback"
+
+var testOIDCClient = &domain.OIDCClient{ClientID: "client", Name: "App", RedirectURIs: []string{testRedirectURI}}
+
+func newAuthorizeRequest() *proto.AuthorizeOIDCRequest {
+	return &proto.AuthorizeOIDCRequest{
+		SessionId:           "session",
+		ResponseType:        "code",
+		ClientId:            "client",
+		RedirectUri:         testRedirectURI,
+		Scope:               "openid email",
+		State:               "state",
+		Nonce:               "nonce",
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: "S256",
+	}
+}
+
+func TestOIDCServer_AuthorizeOIDC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+	mockProvider := mock.NewMockOIDCProvider(ctrl)
+	server := NewOIDCServer(mockSessionServiceClient, nil, mockProvider)
+
+	expectedRequest := &domain.OIDCAuthorizationRequest{
+		ProfileID:     1,
+		ClientID:      "client",
+		RedirectURI:   testRedirectURI,
+		Scopes:        []string{"openid", "email"},
+		State:         "state",
+		Nonce:         "nonce",
+		CodeChallenge: "challenge",
+	}
+
+	t.Run("Consented", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), &session_proto.GetLoginBySessionRequest{SessionId: "session"}).
+			Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(testOIDCClient, nil)
+		mockProvider.EXPECT().HasConsent(expectedRequest, gomock.Any()).Return(true, nil)
+		mockProvider.EXPECT().IssueAuthorizationCode(expectedRequest, gomock.Any()).Return("code", nil)
+
+		reply, err := server.AuthorizeOIDC(ctx, newAuthorizeRequest())
+		assert.NoError(t, err)
+		assert.False(t, reply.ConsentRequired)
+		assert.Equal(t, testRedirectURI+"?code=code&state=state", reply.RedirectUri)
+	})
+
+	t.Run("ConsentRequired", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+			Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(testOIDCClient, nil)
+		mockProvider.EXPECT().HasConsent(expectedRequest, gomock.Any()).Return(false, nil)
+		mockProvider.EXPECT().SignConsentRequest(expectedRequest).Return("signed")
+
+		reply, err := server.AuthorizeOIDC(ctx, newAuthorizeRequest())
+		assert.NoError(t, err)
+		assert.True(t, reply.ConsentRequired)
+		assert.Equal(t, "signed", reply.ConsentRequest)
+		assert.Empty(t, reply.RedirectUri)
+	})
+
+	t.Run("UnknownClient", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+			Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(nil, errors.New("unknown client"))
+
+		_, err := server.AuthorizeOIDC(ctx, newAuthorizeRequest())
+		assert.Error(t, err)
+	})
+
+	t.Run("UnregisteredRedirectURI", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+			Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(testOIDCClient, nil)
+
+		request := newAuthorizeRequest()
+		request.RedirectUri = "https://evil.example.com/callback"
+		_, err := server.AuthorizeOIDC(ctx, request)
+		assert.Error(t, err)
+	})
+
+	t.Run("ErrorsThroughRedirect", func(t *testing.T) {
+		tests := map[string]struct {
+			change   func(request *proto.AuthorizeOIDCRequest)
+			expected string
+		}{
+			"ResponseType": {
+				change:   func(request *proto.AuthorizeOIDCRequest) { request.ResponseType = "token" },
+				expected: "unsupported_response_type",
+			},
+			"NoOpenIDScope": {
+				change:   func(request *proto.AuthorizeOIDCRequest) { request.Scope = "email" },
+				expected: "invalid_scope",
+			},
+			"NoPKCE": {
+				change:   func(request *proto.AuthorizeOIDCRequest) { request.CodeChallenge = "" },
+				expected: "invalid_request",
+			},
+			"PlainPKCE": {
+				change:   func(request *proto.AuthorizeOIDCRequest) { request.CodeChallengeMethod = "plain" },
+				expected: "invalid_request",
+			},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+					Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+				mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(testOIDCClient, nil)
+
+				request := newAuthorizeRequest()
+				tt.change(request)
+				reply, err := server.AuthorizeOIDC(ctx, request)
+				assert.NoError(t, err)
+				assert.Contains(t, reply.RedirectUri, testRedirectURI+"?error="+tt.expected)
+				assert.Contains(t, reply.RedirectUri, "state=state")
+			})
+		}
+	})
+
+	t.Run("SessionNotFound", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).Return(nil, errors.New("not found"))
+
+		_, err := server.AuthorizeOIDC(ctx, newAuthorizeRequest())
+		assert.Error(t, err)
+	})
+
+	t.Run("EmptyFields", func(t *testing.T) {
+		_, err := server.AuthorizeOIDC(ctx, &proto.AuthorizeOIDCRequest{SessionId: "session"})
+		assert.Error(t, err)
+	})
+}
+
+func TestOIDCServer_Consent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+	mockProvider := mock.NewMockOIDCProvider(ctrl)
+	server := NewOIDCServer(mockSessionServiceClient, nil, mockProvider)
+
+	request := &domain.OIDCAuthorizationRequest{
+		ProfileID:   1,
+		ClientID:    "client",
+		RedirectURI: testRedirectURI,
+		Scopes:      []string{"openid", "email"},
+		State:       "state",
+	}
+
+	expectConsentRequest := func(profileID uint32) {
+		mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+			Return(&session_proto.GetProfileIDBySessionReply{Id: profileID}, nil)
+		mockProvider.EXPECT().VerifyConsentRequest("signed").Return(request, nil)
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		expectConsentRequest(1)
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(testOIDCClient, nil)
+
+		reply, err := server.GetOIDCConsent(ctx, &proto.GetOIDCConsentRequest{SessionId: "session", ConsentRequest: "signed"})
+		assert.NoError(t, err)
+		assert.Equal(t, &proto.GetOIDCConsentReply{ClientId: "client", ClientName: "App", Scopes: []string{"openid", "email"}}, reply)
+	})
+
+	t.Run("AnotherUser", func(t *testing.T) {
+		expectConsentRequest(2)
+
+		_, err := server.GetOIDCConsent(ctx, &proto.GetOIDCConsentRequest{SessionId: "session", ConsentRequest: "signed"})
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+			Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+		mockProvider.EXPECT().VerifyConsentRequest("expired").Return(nil, errors.New("token has expired"))
+
+		_, err := server.GrantOIDCConsent(ctx, &proto.GrantOIDCConsentRequest{SessionId: "session", ConsentRequest: "expired", Approve: true})
+		assert.Error(t, err)
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		expectConsentRequest(1)
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(testOIDCClient, nil)
+		mockProvider.EXPECT().GrantConsent(request, gomock.Any()).Return(nil)
+		mockProvider.EXPECT().IssueAuthorizationCode(request, gomock.Any()).Return("code", nil)
+
+		reply, err := server.GrantOIDCConsent(ctx, &proto.GrantOIDCConsentRequest{SessionId: "session", ConsentRequest: "signed", Approve: true})
+		assert.NoError(t, err)
+		assert.Equal(t, testRedirectURI+"?code=code&state=state", reply.RedirectUri)
+	})
+
+	t.Run("Deny", func(t *testing.T) {
+		expectConsentRequest(1)
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(testOIDCClient, nil)
+
+		reply, err := server.GrantOIDCConsent(ctx, &proto.GrantOIDCConsentRequest{SessionId: "session", ConsentRequest: "signed"})
+		assert.NoError(t, err)
+		assert.Contains(t, reply.RedirectUri, testRedirectURI+"?error=access_denied")
+	})
+}
+
+func TestOIDCServer_ExchangeOIDCCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockProvider := mock.NewMockOIDCProvider(ctrl)
+	server := NewOIDCServer(nil, nil, mockProvider)
+	confidentialClient := &domain.OIDCClient{ClientID: "client", SecretHash: domain.HashOIDCSecret("secret"), RedirectURIs: []string{testRedirectURI}}
+	newExchangeRequest := func() *proto.ExchangeOIDCCodeRequest {
+		return &proto.ExchangeOIDCCodeRequest{
+			GrantType:    "authorization_code",
+			Code:         "code",
+			RedirectUri:  testRedirectURI,
+			ClientId:     "client",
+			ClientSecret: "secret",
+			CodeVerifier: "verifier",
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		code := &domain.OIDCAuthorizationCode{ClientID: "client", ProfileID: 1, Scopes: []string{"openid", "email"}}
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(confidentialClient, nil)
+		mockProvider.EXPECT().ExchangeAuthorizationCode("code", confidentialClient, testRedirectURI, "verifier", gomock.Any()).Return(code, nil)
+		mockProvider.EXPECT().IssueTokens(code, gomock.Any()).Return("id token", "access token", nil)
+
+		reply, err := server.ExchangeOIDCCode(ctx, newExchangeRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, &proto.ExchangeOIDCCodeReply{
+			IdToken:     "id token",
+			AccessToken: "access token",
+			TokenType:   "Bearer",
+			ExpiresIn:   3600,
+			Scope:       "openid email",
+		}, reply)
+	})
+
+	t.Run("InvalidGrant", func(t *testing.T) {
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(confidentialClient, nil)
+		mockProvider.EXPECT().ExchangeAuthorizationCode("code", confidentialClient, testRedirectURI, "verifier", gomock.Any()).Return(nil, nil)
+
+		reply, err := server.ExchangeOIDCCode(ctx, newExchangeRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, "invalid_grant", reply.Error)
+	})
+
+	t.Run("WrongSecret", func(t *testing.T) {
+		mockProvider.EXPECT().GetClient("client", gomock.Any()).Return(confidentialClient, nil)
+
+		request := newExchangeRequest()
+		request.ClientSecret = "other"
+		reply, err := server.ExchangeOIDCCode(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, "invalid_client", reply.Error)
+	})
+
+	t.Run("UnsupportedGrantType", func(t *testing.T) {
+		request := newExchangeRequest()
+		request.GrantType = "password"
+		reply, err := server.ExchangeOIDCCode(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, "unsupported_grant_type", reply.Error)
+	})
+
+	t.Run("NoVerifier", func(t *testing.T) {
+		request := newExchangeRequest()
+		request.CodeVerifier = ""
+		reply, err := server.ExchangeOIDCCode(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, "invalid_request", reply.Error)
+	})
+}
+
+func TestOIDCServer_GetOIDCUserInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockProvider := mock.NewMockOIDCProvider(ctrl)
+	server := NewOIDCServer(nil, mockUserServiceClient, mockProvider)
+
+	t.Run("Success", func(t *testing.T) {
+		mockProvider.EXPECT().VerifyAccessToken("token", gomock.Any()).
+			Return(&domain.OIDCAccessTokenClaims{Subject: "7", Scope: "openid email"}, nil)
+		mockUserServiceClient.EXPECT().GetUser(gomock.Any(), &user_proto.GetUserRequest{Id: 7}).
+			Return(&user_proto.GetUserReply{User: &user_proto.User{Id: 7, Login: "user@mailhub.su", Firstname: "John"}}, nil)
+
+		reply, err := server.GetOIDCUserInfo(ctx, &proto.GetOIDCUserInfoRequest{AccessToken: "token"})
+		assert.NoError(t, err)
+
+		var claims map[string]interface{}
+		assert.NoError(t, json.Unmarshal(reply.Claims, &claims))
+		assert.Equal(t, map[string]interface{}{"sub": "7", "email": "user@mailhub.su", "email_verified": true}, claims)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		mockProvider.EXPECT().VerifyAccessToken("token", gomock.Any()).Return(nil, errors.New("invalid token signature"))
+
+		_, err := server.GetOIDCUserInfo(ctx, &proto.GetOIDCUserInfoRequest{AccessToken: "token"})
+		assert.Error(t, err)
+	})
+}
+
+func TestOIDCServer_GetOIDCKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := mock.NewMockOIDCProvider(ctrl)
+	server := NewOIDCServer(nil, nil, mockProvider)
+
+	mockProvider.EXPECT().PublicKeys(gomock.Any()).Return([]jwt.JWK{{KeyID: "kid", Modulus: "n", Exponent: "AQAB"}}, nil)
+
+	reply, err := server.GetOIDCKeys(context.Background(), &proto.GetOIDCKeysRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*proto.OIDCKey{{Kid: "kid", N: "n", E: "AQAB"}}, reply.Keys)
+}
+
+func TestOIDCServer_RegisterOIDCClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := mock.NewMockOIDCProvider(ctrl)
+	server := NewOIDCServer(nil, nil, mockProvider)
+	ctx := context.Background()
+
+	mockProvider.EXPECT().RegisterClient("App", []string{testRedirectURI}, false, ctx).Return(testOIDCClient, "secret", nil)
+
+	reply, err := server.RegisterOIDCClient(ctx, &proto.RegisterOIDCClientRequest{Name: "App", RedirectUris: []string{testRedirectURI}})
+	assert.NoError(t, err)
+	assert.Equal(t, &proto.RegisterOIDCClientReply{ClientId: "client", ClientSecret: "secret"}, reply)
+
+	_, err = server.RegisterOIDCClient(ctx, &proto.RegisterOIDCClientRequest{Name: "App"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/auth/mock"
+	"mail/internal/microservice/auth/proto"
+
+	session_mock "mail/internal/microservice/session/mock"
+	session_proto "mail/internal/microservice/session/proto"
+	user_mock "mail/internal/microservice/user/mock"
+	user_proto "mail/internal/microservice/user/proto"
+)
+
+func TestAuthServer_ChangePassword_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
+
+	mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), &session_proto.GetLoginBySessionRequest{SessionId: "current"}).
+		Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+	mockUserServiceClient.EXPECT().ChangePassword(gomock.Any(), &user_proto.ChangePasswordRequest{Id: 1, OldPassword: "old", NewPassword: "new"}).
+		Return(&user_proto.ChangePasswordReply{Status: true}, nil)
+	mockSessionServiceClient.EXPECT().DeleteOtherSessions(gomock.Any(), &session_proto.DeleteOtherSessionsRequest{SessionId: "current"}).
+		Return(&session_proto.DeleteOtherSessionsReply{Count: 2}, nil)
+
+	reply, err := server.ChangePassword(ctx, &proto.PasswordChangeRequest{SessionId: "current", OldPassword: "old", NewPassword: "new"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.Status)
+}
+
+func TestAuthServer_ChangePassword_WrongPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
+
+	mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+	mockUserServiceClient.EXPECT().ChangePassword(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("invalid password"))
+
+	reply, err := server.ChangePassword(ctx, &proto.PasswordChangeRequest{SessionId: "current", OldPassword: "wrong", NewPassword: "new"})
+
+	assert.Nil(t, reply)
+	assert.EqualError(t, err, "failed to change password")
+}
+
+func TestAuthServer_RequestPasswordReset_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockNotifier := mock.NewMockNotifier(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, nil, mockNotifier, "https://mailhub.su/reset-password?token=", "", nil)
+
+	mockUserServiceClient.EXPECT().CreatePasswordResetToken(gomock.Any(), &user_proto.CreatePasswordResetTokenRequest{Login: "user@mailhub.su"}).
+		Return(&user_proto.CreatePasswordResetTokenReply{Token: "abc", RecoveryEmail: "user@example.com"}, nil)
+	mockNotifier.EXPECT().SendPasswordReset("user@example.com", "https://mailhub.su/reset-password?token=abc").Return(nil)
+
+	reply, err := server.RequestPasswordReset(ctx, &proto.PasswordResetLinkRequest{Login: "user@mailhub.su"})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, reply)
+}
+
+func TestAuthServer_RequestPasswordReset_UnknownLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockNotifier := mock.NewMockNotifier(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, nil, mockNotifier, "", "", nil)
+
+	mockUserServiceClient.EXPECT().CreatePasswordResetToken(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("user not found"))
+
+	reply, err := server.RequestPasswordReset(ctx, &proto.PasswordResetLinkRequest{Login: "nobody@mailhub.su"})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, reply)
+}
+
+func TestAuthServer_ResetPassword_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", "", nil)
+
+	mockUserServiceClient.EXPECT().ResetPassword(gomock.Any(), &user_proto.ResetPasswordRequest{Token: "abc", NewPassword: "new"}).
+		Return(&user_proto.ResetPasswordReply{Id: 1}, nil)
+	mockSessionServiceClient.EXPECT().DeleteUserSessions(gomock.Any(), &session_proto.DeleteUserSessionsRequest{UserId: 1}).
+		Return(&session_proto.DeleteOtherSessionsReply{Count: 3}, nil)
+
+	reply, err := server.ResetPassword(ctx, &proto.PasswordResetRequest{Token: "abc", NewPassword: "new"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.Status)
+}
+
+func TestAuthServer_ResetPassword_InvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, nil, nil, "", "", nil)
+
+	mockUserServiceClient.EXPECT().ResetPassword(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("failed to reset password"))
+
+	reply, err := server.ResetPassword(ctx, &proto.PasswordResetRequest{Token: "used", NewPassword: "new"})
+
+	assert.Nil(t, reply)
+	assert.EqualError(t, err, "password reset token is invalid or expired")
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/auth/proto"
+	"mail/internal/pkg/utils/sanitize"
+	"mail/internal/pkg/utils/signed_token"
+
+	domain "mail/internal/microservice/models/domain_models"
+	session_proto "mail/internal/microservice/session/proto"
+	user_proto "mail/internal/microservice/user/proto"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// SetRecoveryEmail sets the recovery email of the user after checking the password
+// and sends the verification link to the new address.
+func (as *AuthServer) SetRecoveryEmail(ctx context.Context, input *proto.RecoveryEmailChangeRequest) (*proto.RecoveryEmailChangeReply, error) {
+	input.SessionId = sanitize.SanitizeString(input.SessionId)
+	input.Password = sanitize.SanitizeString(input.Password)
+	input.RecoveryEmail = sanitize.SanitizeString(input.RecoveryEmail)
+
+	if validUtil.IsEmpty(input.SessionId) || validUtil.IsEmpty(input.Password) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if input.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(input.RecoveryEmail) {
+		return nil, fmt.Errorf("invalid recovery email")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	profile, errSession := as.sessionServiceClient.GetProfileIDBySession(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&session_proto.GetLoginBySessionRequest{SessionId: input.SessionId},
+	)
+	if errSession != nil {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	_, errSet := as.userServiceClient.SetRecoveryEmail(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.SetRecoveryEmailRequest{Id: profile.Id, Password: input.Password, RecoveryEmail: input.RecoveryEmail},
+	)
+	if errSet != nil {
+		return nil, fmt.Errorf("failed to set recovery email")
+	}
+
+	if input.RecoveryEmail != "" {
+		as.sendRecoveryEmailVerification(profile.Id, input.RecoveryEmail)
+	}
+
+	return &proto.RecoveryEmailChangeReply{Status: true}, nil
+}
+
+// SendRecoveryEmailVerification sends the verification link to the recovery email of the user once more,
+// e.g. after the previous link has expired.
+func (as *AuthServer) SendRecoveryEmailVerification(ctx context.Context, input *proto.RecoveryEmailVerificationRequest) (*proto.RecoveryEmailVerificationReply, error) {
+	input.SessionId = sanitize.SanitizeString(input.SessionId)
+
+	if validUtil.IsEmpty(input.SessionId) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	profile, errSession := as.sessionServiceClient.GetProfileIDBySession(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&session_proto.GetLoginBySessionRequest{SessionId: input.SessionId},
+	)
+	if errSession != nil {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	recoveryEmail, errGet := as.userServiceClient.GetRecoveryEmail(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.TwoFactorUserRequest{Id: profile.Id},
+	)
+	if errGet != nil {
+		return nil, fmt.Errorf("failed to get recovery email")
+	}
+	if recoveryEmail.RecoveryEmail == "" {
+		return nil, fmt.Errorf("no recovery email")
+	}
+	if recoveryEmail.Verified {
+		return nil, fmt.Errorf("recovery email is already verified")
+	}
+
+	as.sendRecoveryEmailVerification(profile.Id, recoveryEmail.RecoveryEmail)
+
+	return &proto.RecoveryEmailVerificationReply{}, nil
+}
+
+// VerifyRecoveryEmail marks the recovery email as verified with the token from the verification link.
+// The token only verifies the address it was sent to, it is rejected once the user has set another one.
+func (as *AuthServer) VerifyRecoveryEmail(ctx context.Context, input *proto.VerifyRecoveryEmailRequest) (*proto.VerifyRecoveryEmailReply, error) {
+	input.Token = sanitize.SanitizeString(input.Token)
+
+	if validUtil.IsEmpty(input.Token) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	payload, err := signed_token.Verify(input.Token, as.verificationKey, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid verification token: %v", err)
+	}
+
+	profileID, recoveryEmail, err := domain.ParseRecoveryEmailVerificationPayload(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid verification token: %v", err)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("metadata error")
+	}
+	value := md.Get("requestID")
+
+	_, errConfirm := as.userServiceClient.ConfirmRecoveryEmail(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": value[0]})),
+		&user_proto.ConfirmRecoveryEmailRequest{Id: profileID, RecoveryEmail: recoveryEmail},
+	)
+	if errConfirm != nil {
+		return nil, fmt.Errorf("failed to verify recovery email")
+	}
+
+	return &proto.VerifyRecoveryEmailReply{Status: true}, nil
+}
+
+// sendRecoveryEmailVerification sends the signed link verifying the recovery email of the user.
+// A failure is only logged, the user can ask for the link again.
+func (as *AuthServer) sendRecoveryEmailVerification(profileID uint32, recoveryEmail string) {
+	token := signed_token.Sign(domain.RecoveryEmailVerificationPayload(profileID, recoveryEmail),
+		time.Now().Add(domain.RecoveryEmailVerificationLifeTime), as.verificationKey)
+
+	if err := as.notifier.SendRecoveryEmailVerification(recoveryEmail, as.verifyURL+token); err != nil {
+		log.Printf("failed to send recovery email verification link: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/auth/mock"
+	"mail/internal/microservice/auth/proto"
+	"mail/internal/pkg/utils/signed_token"
+
+	domain "mail/internal/microservice/models/domain_models"
+	session_mock "mail/internal/microservice/session/mock"
+	session_proto "mail/internal/microservice/session/proto"
+	user_mock "mail/internal/microservice/user/mock"
+	user_proto "mail/internal/microservice/user/proto"
+)
+
+const testVerifyURL = "https://mailhub.su/verify-recovery-email?token="
+
+var testVerificationKey = []byte("test key")
+
+func TestAuthServer_Signup_WithRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockNotifier := mock.NewMockNotifier(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, nil, mockNotifier, "", testVerifyURL, testVerificationKey)
+
+	var link string
+
+	mockUserServiceClient.EXPECT().IsLoginUnique(gomock.Any(), gomock.Any()).Return(&user_proto.IsLoginUniqueReply{}, nil)
+	mockUserServiceClient.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *user_proto.CreateUserRequest, _ ...interface{}) (*user_proto.CreateUserReply, error) {
+			assert.Equal(t, "user@example.com", req.RecoveryEmail)
+			return &user_proto.CreateUserReply{User: &user_proto.User{Id: 7}}, nil
+		})
+	mockNotifier.EXPECT().SendRecoveryEmailVerification("user@example.com", gomock.Any()).
+		DoAndReturn(func(_, l string) error {
+			link = l
+			return nil
+		})
+
+	reply, err := server.Signup(ctx, &proto.SignupRequest{
+		Login:         "user@mailhub.su",
+		Password:      "password123",
+		Firstname:     "John",
+		Surname:       "Doe",
+		Gender:        "male",
+		RecoveryEmail: "user@example.com",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.SignupStatus)
+	assert.True(t, strings.HasPrefix(link, testVerifyURL))
+
+	payload, err := signed_token.Verify(strings.TrimPrefix(link, testVerifyURL), testVerificationKey, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, domain.RecoveryEmailVerificationPayload(7, "user@example.com"), payload)
+}
+
+func TestAuthServer_Signup_InvalidRecoveryEmail(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	server := NewAuthServer(nil, nil, nil, nil, "", testVerifyURL, testVerificationKey)
+
+	reply, err := server.Signup(ctx, &proto.SignupRequest{
+		Login:         "user@mailhub.su",
+		Password:      "password123",
+		Firstname:     "John",
+		Surname:       "Doe",
+		Gender:        "male",
+		RecoveryEmail: "other@mailhub.su",
+	})
+
+	assert.Nil(t, reply)
+	assert.EqualError(t, err, "invalid recovery email")
+}
+
+func TestAuthServer_SetRecoveryEmail_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+	mockNotifier := mock.NewMockNotifier(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, mockNotifier, "", testVerifyURL, testVerificationKey)
+
+	mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), &session_proto.GetLoginBySessionRequest{SessionId: "current"}).
+		Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+	mockUserServiceClient.EXPECT().SetRecoveryEmail(gomock.Any(), &user_proto.SetRecoveryEmailRequest{Id: 1, Password: "pass", RecoveryEmail: "user@example.com"}).
+		Return(&user_proto.SetRecoveryEmailReply{Status: true}, nil)
+	mockNotifier.EXPECT().SendRecoveryEmailVerification("user@example.com", gomock.Any()).Return(nil)
+
+	reply, err := server.SetRecoveryEmail(ctx, &proto.RecoveryEmailChangeRequest{SessionId: "current", Password: "pass", RecoveryEmail: "user@example.com"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.Status)
+}
+
+func TestAuthServer_SetRecoveryEmail_Remove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, nil, "", testVerifyURL, testVerificationKey)
+
+	mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil)
+	mockUserServiceClient.EXPECT().SetRecoveryEmail(gomock.Any(), &user_proto.SetRecoveryEmailRequest{Id: 1, Password: "pass"}).
+		Return(&user_proto.SetRecoveryEmailReply{Status: true}, nil)
+
+	reply, err := server.SetRecoveryEmail(ctx, &proto.RecoveryEmailChangeRequest{SessionId: "current", Password: "pass"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.Status)
+}
+
+func TestAuthServer_SendRecoveryEmailVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+	mockSessionServiceClient := session_mock.NewMockSessionServiceClient(ctrl)
+	mockNotifier := mock.NewMockNotifier(ctrl)
+
+	server := NewAuthServer(mockSessionServiceClient, mockUserServiceClient, nil, mockNotifier, "", testVerifyURL, testVerificationKey)
+
+	mockSessionServiceClient.EXPECT().GetProfileIDBySession(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetProfileIDBySessionReply{Id: 1}, nil).Times(2)
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserServiceClient.EXPECT().GetRecoveryEmail(gomock.Any(), &user_proto.TwoFactorUserRequest{Id: 1}).
+			Return(&user_proto.GetRecoveryEmailReply{RecoveryEmail: "user@example.com"}, nil)
+		mockNotifier.EXPECT().SendRecoveryEmailVerification("user@example.com", gomock.Any()).Return(nil)
+
+		_, err := server.SendRecoveryEmailVerification(ctx, &proto.RecoveryEmailVerificationRequest{SessionId: "current"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyVerified", func(t *testing.T) {
+		mockUserServiceClient.EXPECT().GetRecoveryEmail(gomock.Any(), &user_proto.TwoFactorUserRequest{Id: 1}).
+			Return(&user_proto.GetRecoveryEmailReply{RecoveryEmail: "user@example.com", Verified: true}, nil)
+
+		_, err := server.SendRecoveryEmailVerification(ctx, &proto.RecoveryEmailVerificationRequest{SessionId: "current"})
+
+		assert.EqualError(t, err, "recovery email is already verified")
+	})
+}
+
+func TestAuthServer_VerifyRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"requestID": "testID"}))
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+
+	server := NewAuthServer(nil, mockUserServiceClient, nil, nil, "", testVerifyURL, testVerificationKey)
+
+	payload := domain.RecoveryEmailVerificationPayload(1, "user@example.com")
+
+	t.Run("Success", func(t *testing.T) {
+		token := signed_token.Sign(payload, time.Now().Add(time.Hour), testVerificationKey)
+
+		mockUserServiceClient.EXPECT().ConfirmRecoveryEmail(gomock.Any(), &user_proto.ConfirmRecoveryEmailRequest{Id: 1, RecoveryEmail: "user@example.com"}).
+			Return(&user_proto.ConfirmRecoveryEmailReply{Status: true}, nil)
+
+		reply, err := server.VerifyRecoveryEmail(ctx, &proto.VerifyRecoveryEmailRequest{Token: token})
+
+		assert.NoError(t, err)
+		assert.True(t, reply.Status)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		token := signed_token.Sign(payload, time.Now().Add(-time.Minute), testVerificationKey)
+
+		_, err := server.VerifyRecoveryEmail(ctx, &proto.VerifyRecoveryEmailRequest{Token: token})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("OtherKey", func(t *testing.T) {
+		token := signed_token.Sign(payload, time.Now().Add(time.Hour), []byte("other key"))
+
+		_, err := server.VerifyRecoveryEmail(ctx, &proto.VerifyRecoveryEmailRequest{Token: token})
+
+		assert.Error(t, err)
+	})
+}
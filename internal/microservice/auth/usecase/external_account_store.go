@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	repository "mail/internal/microservice/auth/interface"
+	domain "mail/internal/microservice/models/domain_models"
+	"mail/internal/pkg/utils/encryption"
+)
+
+// ExternalAccountStore is a concrete implementation of the ExternalAccountStore interface.
+// The passwords of the mail servers are encrypted before they reach the database and are decrypted only for the gateway.
+type ExternalAccountStore struct {
+	accountRepo repository.ExternalAccountRepository
+	key         []byte
+}
+
+// NewExternalAccountStore creates a new instance of the linked mailboxes storage, the passwords are encrypted with the key derived from secret.
+func NewExternalAccountStore(repo repository.ExternalAccountRepository, secret string) *ExternalAccountStore {
+	return &ExternalAccountStore{
+		accountRepo: repo,
+		key:         encryption.Key(secret),
+	}
+}
+
+// Add checks and links the mailbox to the user, the password is encrypted before it is stored.
+// The returned mailbox has no password.
+func (s *ExternalAccountStore) Add(profileID uint32, account *domain.ExternalAccount, ctx context.Context) (*domain.ExternalAccount, error) {
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.accountRepo.GetAccounts(profileID, ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, linked := range accounts {
+		if strings.EqualFold(linked.Email, account.Email) {
+			return nil, fmt.Errorf("mailbox %s is already linked", account.Email)
+		}
+	}
+
+	encryptedPassword, err := encryption.Encrypt([]byte(account.Password), s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	added := *account
+	added.ProfileID = profileID
+	added.Password = ""
+	added.CreationDate = time.Now()
+
+	added.ID, err = s.accountRepo.AddAccount(&added, encryptedPassword, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &added, nil
+}
+
+// GetAll returns the mailboxes linked by the user without their passwords.
+func (s *ExternalAccountStore) GetAll(profileID uint32, ctx context.Context) ([]*domain.ExternalAccount, error) {
+	return s.accountRepo.GetAccounts(profileID, ctx)
+}
+
+// Get returns the mailbox of the user with the decrypted password, or nil if the user has no such mailbox.
+func (s *ExternalAccountStore) Get(profileID, accountID uint32, ctx context.Context) (*domain.ExternalAccount, error) {
+	account, encryptedPassword, err := s.accountRepo.GetAccount(profileID, accountID, ctx)
+	if err != nil || account == nil {
+		return nil, err
+	}
+
+	password, err := encryption.Decrypt(encryptedPassword, s.key)
+	if err != nil {
+		return nil, err
+	}
+	account.Password = string(password)
+
+	return account, nil
+}
+
+// Delete unlinks the mailbox from the user, it fails if the user has no such mailbox.
+func (s *ExternalAccountStore) Delete(profileID, accountID uint32, ctx context.Context) error {
+	deleted, err := s.accountRepo.DeleteAccount(profileID, accountID, ctx)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("external account %d not found", accountID)
+	}
+
+	return nil
+}
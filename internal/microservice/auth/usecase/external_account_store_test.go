@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/auth/mock"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func newTestExternalAccount() *domain.ExternalAccount {
+	return &domain.ExternalAccount{
+		Email:       "user@example.com",
+		IMAPHost:    "imap.example.com",
+		IMAPPort:    993,
+		IMAPTLSMode: domain.ExternalAccountTLSModeTLS,
+		SMTPHost:    "smtp.example.com",
+		SMTPPort:    465,
+		SMTPTLSMode: domain.ExternalAccountTLSModeTLS,
+		Username:    "user",
+		Password:    "password",
+	}
+}
+
+func TestExternalAccountStore_AddGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExternalAccountRepository(ctrl)
+	store := NewExternalAccountStore(mockRepo, "key")
+	ctx := context.Background()
+
+	var stored *domain.ExternalAccount
+	var storedPassword []byte
+	mockRepo.EXPECT().GetAccounts(uint32(2), ctx).Return([]*domain.ExternalAccount{{ID: 3, Email: "other@example.com"}}, nil)
+	mockRepo.EXPECT().AddAccount(gomock.Any(), gomock.Any(), ctx).DoAndReturn(
+		func(account *domain.ExternalAccount, encryptedPassword []byte, ctx context.Context) (uint32, error) {
+			stored, storedPassword = account, encryptedPassword
+			return 4, nil
+		})
+
+	added, err := store.Add(2, newTestExternalAccount(), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(4), added.ID)
+	assert.Equal(t, uint32(2), added.ProfileID)
+	assert.Empty(t, added.Password)
+	assert.Empty(t, stored.Password)
+	assert.False(t, added.CreationDate.IsZero())
+	assert.False(t, bytes.Contains(storedPassword, []byte("password")), "the password must be stored encrypted")
+
+	account := *stored
+	mockRepo.EXPECT().GetAccount(uint32(2), uint32(4), ctx).Return(&account, storedPassword, nil)
+
+	got, err := store.Get(2, 4, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "password", got.Password)
+}
+
+func TestExternalAccountStore_Add(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExternalAccountRepository(ctrl)
+	store := NewExternalAccountStore(mockRepo, "key")
+	ctx := context.Background()
+
+	t.Run("Invalid", func(t *testing.T) {
+		account := newTestExternalAccount()
+		account.IMAPTLSMode = "ssl"
+
+		_, err := store.Add(2, account, ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("AlreadyLinked", func(t *testing.T) {
+		mockRepo.EXPECT().GetAccounts(uint32(2), ctx).Return([]*domain.ExternalAccount{{ID: 3, Email: "User@Example.com"}}, nil)
+
+		_, err := store.Add(2, newTestExternalAccount(), ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().GetAccounts(uint32(2), ctx).Return(nil, nil)
+		mockRepo.EXPECT().AddAccount(gomock.Any(), gomock.Any(), ctx).Return(uint32(0), errors.New("too many"))
+
+		_, err := store.Add(2, newTestExternalAccount(), ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestExternalAccountStore_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExternalAccountRepository(ctrl)
+	store := NewExternalAccountStore(mockRepo, "key")
+	ctx := context.Background()
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetAccount(uint32(2), uint32(5), ctx).Return(nil, nil, nil)
+
+		account, err := store.Get(2, 5, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, account)
+	})
+
+	t.Run("Undecryptable", func(t *testing.T) {
+		mockRepo.EXPECT().GetAccount(uint32(2), uint32(4), ctx).Return(&domain.ExternalAccount{ID: 4}, []byte("not encrypted"), nil)
+
+		_, err := store.Get(2, 4, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestExternalAccountStore_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockExternalAccountRepository(ctrl)
+	store := NewExternalAccountStore(mockRepo, "key")
+	ctx := context.Background()
+
+	mockRepo.EXPECT().DeleteAccount(uint32(2), uint32(4), ctx).Return(true, nil)
+	assert.NoError(t, store.Delete(2, 4, ctx))
+
+	mockRepo.EXPECT().DeleteAccount(uint32(2), uint32(5), ctx).Return(false, nil)
+	assert.Error(t, store.Delete(2, 5, ctx))
+
+	mockRepo.EXPECT().DeleteAccount(uint32(2), uint32(4), ctx).Return(false, errors.New("db error"))
+	assert.Error(t, store.Delete(2, 4, ctx))
+}
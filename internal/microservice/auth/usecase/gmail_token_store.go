@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	repository "mail/internal/microservice/auth/interface"
+	"mail/internal/pkg/utils/encryption"
+)
+
+// GMailTokenStore is a concrete implementation of the GMailTokenStore interface.
+// The tokens are encrypted before they reach the database, so a leaked dump does not give access to the mailboxes.
+type GMailTokenStore struct {
+	tokenRepo repository.GMailTokenRepository
+	key       []byte
+}
+
+// NewGMailTokenStore creates a new instance of the Gmail token storage, the tokens are encrypted with the key derived from secret.
+func NewGMailTokenStore(repo repository.GMailTokenRepository, secret string) *GMailTokenStore {
+	return &GMailTokenStore{
+		tokenRepo: repo,
+		key:       encryption.Key(secret),
+	}
+}
+
+// Save encrypts and stores the token of the Gmail address.
+func (s *GMailTokenStore) Save(login string, token []byte, ctx context.Context) error {
+	encrypted, err := encryption.Encrypt(token, s.key)
+	if err != nil {
+		return err
+	}
+
+	return s.tokenRepo.SaveToken(login, encrypted, ctx)
+}
+
+// Get returns the decrypted token of the Gmail address, or nil if there is none.
+func (s *GMailTokenStore) Get(login string, ctx context.Context) ([]byte, error) {
+	encrypted, err := s.tokenRepo.GetToken(login, ctx)
+	if err != nil || encrypted == nil {
+		return nil, err
+	}
+
+	return encryption.Decrypt(encrypted, s.key)
+}
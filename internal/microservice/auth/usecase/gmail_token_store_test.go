@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/auth/mock"
+)
+
+func TestGMailTokenStore_SaveGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockGMailTokenRepository(ctrl)
+	store := NewGMailTokenStore(mockRepo, "key")
+	ctx := context.Background()
+	token := []byte(`{"access_token":"access","refresh_token":"refresh"}`)
+
+	var stored []byte
+	mockRepo.EXPECT().SaveToken("user@gmail.com", gomock.Any(), ctx).DoAndReturn(
+		func(login string, encrypted []byte, ctx context.Context) error {
+			stored = encrypted
+			return nil
+		})
+	assert.NoError(t, store.Save("user@gmail.com", token, ctx))
+	assert.False(t, bytes.Contains(stored, []byte("refresh")), "the token must be stored encrypted")
+
+	mockRepo.EXPECT().GetToken("user@gmail.com", ctx).Return(stored, nil)
+	got, err := store.Get("user@gmail.com", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, token, got)
+}
+
+func TestGMailTokenStore_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockGMailTokenRepository(ctrl)
+	store := NewGMailTokenStore(mockRepo, "key")
+	ctx := context.Background()
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetToken("unknown@gmail.com", ctx).Return(nil, nil)
+
+		token, err := store.Get("unknown@gmail.com", ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().GetToken("user@gmail.com", ctx).Return(nil, errors.New("db error"))
+
+		_, err := store.Get("user@gmail.com", ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("OtherKey", func(t *testing.T) {
+		mockOtherRepo := mock.NewMockGMailTokenRepository(ctrl)
+		other := NewGMailTokenStore(mockOtherRepo, "other key")
+
+		var stored []byte
+		mockOtherRepo.EXPECT().SaveToken("user@gmail.com", gomock.Any(), ctx).DoAndReturn(
+			func(login string, encrypted []byte, ctx context.Context) error {
+				stored = encrypted
+				return nil
+			})
+		assert.NoError(t, other.Save("user@gmail.com", []byte("token"), ctx))
+
+		mockRepo.EXPECT().GetToken("user@gmail.com", ctx).Return(stored, nil)
+		_, err := store.Get("user@gmail.com", ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestGMailTokenStore_SaveError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockGMailTokenRepository(ctrl)
+	store := NewGMailTokenStore(mockRepo, "key")
+	ctx := context.Background()
+
+	mockRepo.EXPECT().SaveToken("user@gmail.com", gomock.Any(), ctx).Return(errors.New("db error"))
+	assert.Error(t, store.Save("user@gmail.com", []byte("token"), ctx))
+}
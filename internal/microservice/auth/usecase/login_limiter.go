@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	repository "mail/internal/microservice/auth/interface"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// LoginLimiter is a concrete implementation of the LoginLimiter interface.
+// Attempts are counted per login and per IP address: after a few failed ones every next attempt
+// has to wait for a doubling delay, and too many of them lock the login or the address for a while.
+type LoginLimiter struct {
+	attemptRepo repository.LoginAttemptRepository
+}
+
+// NewLoginLimiter creates a new instance of a login limiter with necessary dependencies.
+func NewLoginLimiter(repo repository.LoginAttemptRepository) *LoginLimiter {
+	return &LoginLimiter{
+		attemptRepo: repo,
+	}
+}
+
+// Attempt counts an attempt on the login from the IP address before the password is checked and returns
+// the time it has to wait for, zero if it is allowed now. The attempt is counted first and the decision is made
+// from the counter it returns, so the concurrent attempts can't all pass a check made before any of them is counted.
+// It also returns the time the login is locked until if the attempt has just locked it, zero otherwise.
+func (l *LoginLimiter) Attempt(login, ipAddress string, ctx context.Context) (time.Duration, time.Time, error) {
+	now := time.Now()
+
+	wait, locked, err := l.addAttempt(domain.LoginAttemptLoginKey(login), domain.LoginLockoutFailures, now, ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	var loginLockedUntil time.Time
+	if locked {
+		loginLockedUntil = now.Add(domain.LoginLockoutDuration)
+	}
+
+	if ipAddress == "" {
+		return wait, loginLockedUntil, nil
+	}
+
+	ipWait, _, err := l.addAttempt(domain.LoginAttemptIPKey(ipAddress), domain.IPLockoutFailures, now, ctx)
+	if err != nil {
+		return 0, loginLockedUntil, err
+	}
+	if ipWait > wait {
+		wait = ipWait
+	}
+
+	return wait, loginLockedUntil, nil
+}
+
+// addAttempt counts an attempt of the key and locks it once the attempts exceed the limit.
+// It returns the time the attempt has to wait for and whether it has locked the key.
+func (l *LoginLimiter) addAttempt(key string, limit int, now time.Time, ctx context.Context) (time.Duration, bool, error) {
+	attempts, err := l.attemptRepo.AddAttempt(key, now, now.Add(-domain.LoginAttemptWindow), ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	wait := attempts.LastAttemptRetryAfter(now)
+	if attempts.Failures <= limit {
+		return wait, false, nil
+	}
+
+	// The counter is shared by the replicas, only the attempt that has set the lock reports it.
+	locked, err := l.attemptRepo.Lock(key, now.Add(domain.LoginLockoutDuration), now, ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if locked {
+		wait = domain.LoginLockoutDuration
+	}
+
+	return wait, locked, nil
+}
+
+// RegisterSuccess forgets the failed attempts on the login after a successful one and uncounts it from the IP address.
+// The other attempts from the IP address are kept, one valid account must not clear the guesses made on the others.
+func (l *LoginLimiter) RegisterSuccess(login, ipAddress string, ctx context.Context) error {
+	if err := l.attemptRepo.Reset(domain.LoginAttemptLoginKey(login), ctx); err != nil {
+		return err
+	}
+
+	if ipAddress == "" {
+		return nil
+	}
+
+	return l.attemptRepo.RemoveAttempt(domain.LoginAttemptIPKey(ipAddress), ctx)
+}
+
+// Unlock forgets the failed attempts on the login and from the IP address, either of them can be empty.
+func (l *LoginLimiter) Unlock(login, ipAddress string, ctx context.Context) error {
+	keys := attemptKeys(login, ipAddress)
+	if len(keys) == 0 {
+		return fmt.Errorf("login or ip address must be filled in")
+	}
+
+	for _, key := range keys {
+		if err := l.attemptRepo.Reset(key, ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attemptKeys returns the keys the attempts on the login and from the IP address are stored with.
+func attemptKeys(login, ipAddress string) []string {
+	keys := make([]string, 0, 2)
+	if login != "" {
+		keys = append(keys, domain.LoginAttemptLoginKey(login))
+	}
+	if ipAddress != "" {
+		keys = append(keys, domain.LoginAttemptIPKey(ipAddress))
+	}
+
+	return keys
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/auth/mock"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestLoginLimiter_Attempt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	limiter := NewLoginLimiter(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Allowed", func(t *testing.T) {
+		mockRepo.EXPECT().AddAttempt("login:user@mailhub.su", gomock.Any(), gomock.Any(), ctx).
+			Return(&domain.LoginAttempts{Key: "login:user@mailhub.su", Failures: 1, LastFailureDate: time.Now()}, nil)
+		mockRepo.EXPECT().AddAttempt("ip:127.0.0.1", gomock.Any(), gomock.Any(), ctx).
+			Return(&domain.LoginAttempts{Key: "ip:127.0.0.1", Failures: 2, LastFailureDate: time.Now(), PreviousFailureDate: time.Now()}, nil)
+
+		wait, lockedUntil, err := limiter.Attempt("user@mailhub.su", "127.0.0.1", ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, wait)
+		assert.True(t, lockedUntil.IsZero())
+	})
+
+	t.Run("ConcurrentAttemptDelayed", func(t *testing.T) {
+		mockRepo.EXPECT().AddAttempt("login:user@mailhub.su", gomock.Any(), gomock.Any(), ctx).
+			Return(&domain.LoginAttempts{Key: "login:user@mailhub.su", Failures: domain.LoginAttemptFreeFailures + 2,
+				LastFailureDate: time.Now(), PreviousFailureDate: time.Now()}, nil)
+
+		wait, _, err := limiter.Attempt("user@mailhub.su", "", ctx)
+		assert.NoError(t, err)
+		assert.InDelta(t, domain.LoginAttemptBaseDelay, wait, float64(100*time.Millisecond))
+	})
+
+	t.Run("LocksLogin", func(t *testing.T) {
+		gomock.InOrder(
+			mockRepo.EXPECT().AddAttempt("login:user@mailhub.su", gomock.Any(), gomock.Any(), ctx).
+				Return(&domain.LoginAttempts{Key: "login:user@mailhub.su", Failures: domain.LoginLockoutFailures + 1, LastFailureDate: time.Now()}, nil),
+			mockRepo.EXPECT().Lock("login:user@mailhub.su", gomock.Any(), gomock.Any(), ctx).Return(true, nil),
+		)
+		mockRepo.EXPECT().AddAttempt("ip:127.0.0.1", gomock.Any(), gomock.Any(), ctx).
+			Return(&domain.LoginAttempts{Key: "ip:127.0.0.1", Failures: 1, LastFailureDate: time.Now()}, nil)
+
+		wait, lockedUntil, err := limiter.Attempt("user@mailhub.su", "127.0.0.1", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LoginLockoutDuration, wait)
+		assert.WithinDuration(t, time.Now().Add(domain.LoginLockoutDuration), lockedUntil, time.Second)
+	})
+
+	t.Run("AlreadyLocked", func(t *testing.T) {
+		mockRepo.EXPECT().AddAttempt("login:user@mailhub.su", gomock.Any(), gomock.Any(), ctx).
+			Return(&domain.LoginAttempts{Key: "login:user@mailhub.su", Failures: domain.LoginLockoutFailures + 2,
+				LastFailureDate: time.Now(), LockedUntil: time.Now().Add(time.Minute)}, nil)
+		mockRepo.EXPECT().Lock("login:user@mailhub.su", gomock.Any(), gomock.Any(), ctx).Return(false, nil)
+
+		wait, lockedUntil, err := limiter.Attempt("user@mailhub.su", "", ctx)
+		assert.NoError(t, err)
+		assert.InDelta(t, time.Minute, wait, float64(time.Second))
+		assert.True(t, lockedUntil.IsZero())
+	})
+
+	t.Run("LocksIPAddress", func(t *testing.T) {
+		mockRepo.EXPECT().AddAttempt("login:other@mailhub.su", gomock.Any(), gomock.Any(), ctx).
+			Return(&domain.LoginAttempts{Key: "login:other@mailhub.su", Failures: 1, LastFailureDate: time.Now()}, nil)
+		mockRepo.EXPECT().AddAttempt("ip:127.0.0.1", gomock.Any(), gomock.Any(), ctx).
+			Return(&domain.LoginAttempts{Key: "ip:127.0.0.1", Failures: domain.IPLockoutFailures + 1, LastFailureDate: time.Now()}, nil)
+		mockRepo.EXPECT().Lock("ip:127.0.0.1", gomock.Any(), gomock.Any(), ctx).Return(true, nil)
+
+		wait, lockedUntil, err := limiter.Attempt("other@mailhub.su", "127.0.0.1", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LoginLockoutDuration, wait)
+		assert.True(t, lockedUntil.IsZero())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockRepo.EXPECT().AddAttempt("login:user@mailhub.su", gomock.Any(), gomock.Any(), ctx).Return(nil, errors.New("db error"))
+
+		_, _, err := limiter.Attempt("user@mailhub.su", "127.0.0.1", ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestLoginLimiter_RegisterSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	limiter := NewLoginLimiter(mockRepo)
+	ctx := context.Background()
+
+	t.Run("WithIPAddress", func(t *testing.T) {
+		mockRepo.EXPECT().Reset("login:user@mailhub.su", ctx).Return(nil)
+		mockRepo.EXPECT().RemoveAttempt("ip:127.0.0.1", ctx).Return(nil)
+
+		assert.NoError(t, limiter.RegisterSuccess("User@mailhub.su", "127.0.0.1", ctx))
+	})
+
+	t.Run("NoIPAddress", func(t *testing.T) {
+		mockRepo.EXPECT().Reset("login:user@mailhub.su", ctx).Return(nil)
+
+		assert.NoError(t, limiter.RegisterSuccess("User@mailhub.su", "", ctx))
+	})
+}
+
+func TestLoginLimiter_Unlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	limiter := NewLoginLimiter(mockRepo)
+	ctx := context.Background()
+
+	t.Run("Both", func(t *testing.T) {
+		mockRepo.EXPECT().Reset("login:user@mailhub.su", ctx).Return(nil)
+		mockRepo.EXPECT().Reset("ip:127.0.0.1", ctx).Return(nil)
+
+		assert.NoError(t, limiter.Unlock("user@mailhub.su", "127.0.0.1", ctx))
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.Error(t, limiter.Unlock("", "", ctx))
+	})
+}
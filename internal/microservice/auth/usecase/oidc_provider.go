@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	repository "mail/internal/microservice/auth/interface"
+	domain "mail/internal/microservice/models/domain_models"
+	"mail/internal/pkg/utils/jwt"
+	"mail/internal/pkg/utils/signed_token"
+)
+
+// OIDCProvider is a concrete implementation of the OIDCProvider interface.
+// The tokens are signed with RSA keys stored in the database: a new key is generated once the newest one
+// is older than the rotation period, and the previous keys are still published until the tokens signed with them expire.
+type OIDCProvider struct {
+	oidcRepo   repository.OIDCRepository
+	issuer     string
+	consentKey []byte
+}
+
+// NewOIDCProvider creates a new instance of an OpenID Connect provider with necessary dependencies.
+// The issuer is the address of the provider, the requests waiting for consent are signed with consentKey.
+func NewOIDCProvider(repo repository.OIDCRepository, issuer string, consentKey []byte) *OIDCProvider {
+	return &OIDCProvider{
+		oidcRepo:   repo,
+		issuer:     issuer,
+		consentKey: consentKey,
+	}
+}
+
+// GetClient returns the client with the unique identifier, it fails if there is none.
+func (p *OIDCProvider) GetClient(clientID string, ctx context.Context) (*domain.OIDCClient, error) {
+	client, err := p.oidcRepo.GetClient(clientID, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, fmt.Errorf("unknown client")
+	}
+
+	return client, nil
+}
+
+// RegisterClient registers a client with the name and the redirect addresses.
+// It returns the client and its secret, which is shown once, the secret is empty for public clients.
+func (p *OIDCProvider) RegisterClient(name string, redirectURIs []string, public bool, ctx context.Context) (*domain.OIDCClient, string, error) {
+	if name == "" || len(name) > domain.OIDCClientNameMaxLength {
+		return nil, "", fmt.Errorf("invalid client name")
+	}
+	if len(redirectURIs) == 0 || len(redirectURIs) > domain.OIDCClientMaxRedirectURIs {
+		return nil, "", fmt.Errorf("client must have from 1 to %d redirect uris", domain.OIDCClientMaxRedirectURIs)
+	}
+	for _, uri := range redirectURIs {
+		if !domain.IsValidOIDCRedirectURI(uri) {
+			return nil, "", fmt.Errorf("invalid redirect uri %s", uri)
+		}
+	}
+
+	clientID, err := randomHex(16)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate client id: %v", err)
+	}
+
+	client := &domain.OIDCClient{
+		ClientID:     clientID,
+		Name:         name,
+		RedirectURIs: redirectURIs,
+		CreationDate: time.Now(),
+	}
+
+	var secret string
+	if !public {
+		secret, err = randomHex(32)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to generate client secret: %v", err)
+		}
+		client.SecretHash = domain.HashOIDCSecret(secret)
+	}
+
+	if err = p.oidcRepo.CreateClient(client, ctx); err != nil {
+		return nil, "", err
+	}
+
+	return client, secret, nil
+}
+
+// HasConsent checks if the user has allowed the client of the request to read the requested scopes.
+func (p *OIDCProvider) HasConsent(request *domain.OIDCAuthorizationRequest, ctx context.Context) (bool, error) {
+	scopes, err := p.oidcRepo.GetConsent(request.ProfileID, request.ClientID, ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return scopes != nil && domain.OIDCScopesInclude(scopes, request.Scopes), nil
+}
+
+// GrantConsent records that the user has allowed the client of the request to read the requested scopes.
+// The scopes allowed before are kept, so asking for less does not take them away.
+func (p *OIDCProvider) GrantConsent(request *domain.OIDCAuthorizationRequest, ctx context.Context) error {
+	scopes, err := p.oidcRepo.GetConsent(request.ProfileID, request.ClientID, ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range request.Scopes {
+		if !domain.OIDCScopesInclude(scopes, []string{scope}) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return p.oidcRepo.SaveConsent(request.ProfileID, request.ClientID, scopes, ctx)
+}
+
+// SignConsentRequest returns the request in the signed form it is passed in while the user is asked for consent.
+func (p *OIDCProvider) SignConsentRequest(request *domain.OIDCAuthorizationRequest) string {
+	return signed_token.Sign(request.Payload(), time.Now().Add(domain.OIDCConsentRequestLifeTime), p.consentKey)
+}
+
+// VerifyConsentRequest checks the signed request and returns it.
+func (p *OIDCProvider) VerifyConsentRequest(consentRequest string) (*domain.OIDCAuthorizationRequest, error) {
+	payload, err := signed_token.Verify(consentRequest, p.consentKey, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ParseOIDCAuthorizationRequest(payload)
+}
+
+// IssueAuthorizationCode returns a new code the client of the request exchanges for the tokens.
+func (p *OIDCProvider) IssueAuthorizationCode(request *domain.OIDCAuthorizationRequest, ctx context.Context) (string, error) {
+	code, err := randomHex(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate authorization code: %v", err)
+	}
+
+	err = p.oidcRepo.CreateAuthorizationCode(&domain.OIDCAuthorizationCode{
+		CodeHash:       domain.HashOIDCSecret(code),
+		ClientID:       request.ClientID,
+		ProfileID:      request.ProfileID,
+		RedirectURI:    request.RedirectURI,
+		Scopes:         request.Scopes,
+		Nonce:          request.Nonce,
+		CodeChallenge:  request.CodeChallenge,
+		ExpirationDate: time.Now().Add(domain.OIDCAuthorizationCodeLifeTime),
+	}, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// ExchangeAuthorizationCode returns the code issued to the authenticated client after checking the redirect address
+// and the PKCE verifier, or nil if the code is unknown, expired or does not pass the check.
+// The code is taken before the check, so a stolen code cannot be tried with different verifiers.
+func (p *OIDCProvider) ExchangeAuthorizationCode(code string, client *domain.OIDCClient, redirectURI, codeVerifier string, ctx context.Context) (*domain.OIDCAuthorizationCode, error) {
+	authorizationCode, err := p.oidcRepo.TakeAuthorizationCode(domain.HashOIDCSecret(code), ctx)
+	if err != nil || authorizationCode == nil {
+		return nil, err
+	}
+
+	if authorizationCode.ClientID != client.ClientID || authorizationCode.RedirectURI != redirectURI ||
+		!time.Now().Before(authorizationCode.ExpirationDate) ||
+		!domain.VerifyOIDCCodeChallenge(codeVerifier, authorizationCode.CodeChallenge) {
+		return nil, nil
+	}
+
+	return authorizationCode, nil
+}
+
+// IssueTokens returns the ID token and the access token for the exchanged code.
+func (p *OIDCProvider) IssueTokens(code *domain.OIDCAuthorizationCode, ctx context.Context) (string, string, error) {
+	key, err := p.currentSigningKey(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateKey, err := jwt.DecodePrivateKey(key.PrivateKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	subject := domain.OIDCSubject(code.ProfileID)
+
+	idToken, err := jwt.Sign(domain.OIDCIDTokenClaims{
+		Issuer:    p.issuer,
+		Subject:   subject,
+		Audience:  code.ClientID,
+		ExpiresAt: now.Add(domain.OIDCTokenLifeTime).Unix(),
+		IssuedAt:  now.Unix(),
+		Nonce:     code.Nonce,
+	}, domain.OIDCIDTokenType, key.KeyID, privateKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	accessToken, err := jwt.Sign(domain.OIDCAccessTokenClaims{
+		Issuer:    p.issuer,
+		Subject:   subject,
+		ClientID:  code.ClientID,
+		Scope:     strings.Join(code.Scopes, " "),
+		ExpiresAt: now.Add(domain.OIDCTokenLifeTime).Unix(),
+		IssuedAt:  now.Unix(),
+	}, domain.OIDCAccessTokenType, key.KeyID, privateKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return idToken, accessToken, nil
+}
+
+// VerifyAccessToken checks the access token and returns its claims.
+func (p *OIDCProvider) VerifyAccessToken(accessToken string, ctx context.Context) (*domain.OIDCAccessTokenClaims, error) {
+	keys, err := p.publishedSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims domain.OIDCAccessTokenClaims
+	header, err := jwt.Verify(accessToken, func(keyID string) *rsa.PublicKey {
+		return keys[keyID]
+	}, &claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Type != domain.OIDCAccessTokenType || claims.Issuer != p.issuer {
+		return nil, fmt.Errorf("not an access token")
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, fmt.Errorf("access token has expired")
+	}
+
+	return &claims, nil
+}
+
+// PublicKeys returns the keys the tokens can be verified with.
+func (p *OIDCProvider) PublicKeys(ctx context.Context) ([]jwt.JWK, error) {
+	keys, err := p.oidcRepo.GetSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]jwt.JWK, 0, len(keys))
+	for _, key := range publishedKeys(keys, time.Now()) {
+		privateKey, err := jwt.DecodePrivateKey(key.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, jwt.PublicJWK(key.KeyID, &privateKey.PublicKey))
+	}
+
+	return publicKeys, nil
+}
+
+// currentSigningKey returns the key new tokens are signed with, a new key is generated if the newest one is too old.
+// The keys which are no longer published are removed along the way.
+func (p *OIDCProvider) currentSigningKey(ctx context.Context) (*domain.OIDCSigningKey, error) {
+	keys, err := p.oidcRepo.GetSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if len(keys) > 0 && now.Sub(keys[0].CreationDate) < domain.OIDCSigningKeyRotationPeriod {
+		return keys[0], nil
+	}
+
+	privateKey, err := jwt.GenerateKey(domain.OIDCSigningKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := randomHex(8)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %v", err)
+	}
+
+	key := &domain.OIDCSigningKey{
+		KeyID:        keyID,
+		PrivateKey:   jwt.EncodePrivateKey(privateKey),
+		CreationDate: now,
+	}
+	if err = p.oidcRepo.AddSigningKey(key, ctx); err != nil {
+		return nil, err
+	}
+
+	if err = p.oidcRepo.DeleteSigningKeys(now.Add(-domain.OIDCSigningKeyLifeTime), ctx); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// publishedSigningKeys returns the public parts of the published keys by their identifiers.
+func (p *OIDCProvider) publishedSigningKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	keys, err := p.oidcRepo.GetSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make(map[string]*rsa.PublicKey, len(keys))
+	for _, key := range publishedKeys(keys, time.Now()) {
+		privateKey, err := jwt.DecodePrivateKey(key.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys[key.KeyID] = &privateKey.PublicKey
+	}
+
+	return publicKeys, nil
+}
+
+// publishedKeys returns the keys which are not older than the key lifetime.
+func publishedKeys(keys []*domain.OIDCSigningKey, now time.Time) []*domain.OIDCSigningKey {
+	published := make([]*domain.OIDCSigningKey, 0, len(keys))
+	for _, key := range keys {
+		if now.Sub(key.CreationDate) < domain.OIDCSigningKeyLifeTime {
+			published = append(published, key)
+		}
+	}
+
+	return published
+}
+
+// randomHex returns a hex encoded random value of the given size in bytes.
+func randomHex(size int) (string, error) {
+	randBytes := make([]byte, size)
+	if _, err := rand.Read(randBytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(randBytes), nil
+}
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/auth/mock"
+	"mail/internal/pkg/utils/jwt"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+const testIssuer = "https://mailhub.su"
+
+// testVerifier and testChallenge are the PKCE example of RFC 7636, appendix B.
+const (
+	testVerifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	testChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+)
+
+func newTestSigningKey(t *testing.T, keyID string, creationDate time.Time) *domain.OIDCSigningKey {
+	privateKey, err := jwt.GenerateKey(1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return &domain.OIDCSigningKey{KeyID: keyID, PrivateKey: jwt.EncodePrivateKey(privateKey), CreationDate: creationDate}
+}
+
+func TestOIDCProvider_GetClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockOIDCRepository(ctrl)
+	provider := NewOIDCProvider(mockRepo, testIssuer, []byte("key"))
+	ctx := context.Background()
+
+	mockRepo.EXPECT().GetClient("client", ctx).Return(&domain.OIDCClient{ClientID: "client"}, nil)
+	client, err := provider.GetClient("client", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "client", client.ClientID)
+
+	mockRepo.EXPECT().GetClient("unknown", ctx).Return(nil, nil)
+	_, err = provider.GetClient("unknown", ctx)
+	assert.Error(t, err)
+}
+
+func TestOIDCProvider_RegisterClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockOIDCRepository(ctrl)
+	provider := NewOIDCProvider(mockRepo, testIssuer, []byte("key"))
+	ctx := context.Background()
+	redirectURIs := []string{"https://app.example.com/callback"}
+
+	t.Run("Confidential", func(t *testing.T) {
+		mockRepo.EXPECT().CreateClient(gomock.Any(), ctx).Return(nil)
+
+		client, secret, err := provider.RegisterClient("App", redirectURIs, false, ctx)
+		assert.NoError(t, err)
+		assert.Len(t, client.ClientID, 32)
+		assert.NotEmpty(t, secret)
+		assert.True(t, client.CheckSecret(secret))
+	})
+
+	t.Run("Public", func(t *testing.T) {
+		mockRepo.EXPECT().CreateClient(gomock.Any(), ctx).Return(nil)
+
+		client, secret, err := provider.RegisterClient("App", redirectURIs, true, ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, secret)
+		assert.True(t, client.IsPublic())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, err := provider.RegisterClient("", redirectURIs, false, ctx)
+		assert.Error(t, err)
+
+		_, _, err = provider.RegisterClient("App", nil, false, ctx)
+		assert.Error(t, err)
+
+		_, _, err = provider.RegisterClient("App", []string{"http://app.example.com/callback"}, false, ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo.EXPECT().CreateClient(gomock.Any(), ctx).Return(errors.New("db error"))
+
+		_, _, err := provider.RegisterClient("App", redirectURIs, false, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestOIDCProvider_Consent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockOIDCRepository(ctrl)
+	provider := NewOIDCProvider(mockRepo, testIssuer, []byte("key"))
+	ctx := context.Background()
+	request := &domain.OIDCAuthorizationRequest{ProfileID: 1, ClientID: "client", Scopes: []string{"openid", "email"}}
+
+	t.Run("HasConsent", func(t *testing.T) {
+		mockRepo.EXPECT().GetConsent(uint32(1), "client", ctx).Return([]string{"openid", "email", "profile"}, nil)
+
+		consented, err := provider.HasConsent(request, ctx)
+		assert.NoError(t, err)
+		assert.True(t, consented)
+	})
+
+	t.Run("MoreScopesRequested", func(t *testing.T) {
+		mockRepo.EXPECT().GetConsent(uint32(1), "client", ctx).Return([]string{"openid"}, nil)
+
+		consented, err := provider.HasConsent(request, ctx)
+		assert.NoError(t, err)
+		assert.False(t, consented)
+	})
+
+	t.Run("GrantKeepsPreviousScopes", func(t *testing.T) {
+		mockRepo.EXPECT().GetConsent(uint32(1), "client", ctx).Return([]string{"openid", "phone"}, nil)
+		mockRepo.EXPECT().SaveConsent(uint32(1), "client", []string{"openid", "phone", "email"}, ctx).Return(nil)
+
+		assert.NoError(t, provider.GrantConsent(request, ctx))
+	})
+}
+
+func TestOIDCProvider_ConsentRequest(t *testing.T) {
+	provider := NewOIDCProvider(nil, testIssuer, []byte("key"))
+	request := &domain.OIDCAuthorizationRequest{ProfileID: 1, ClientID: "client", Scopes: []string{"openid"}, State: "state"}
+
+	verified, err := provider.VerifyConsentRequest(provider.SignConsentRequest(request))
+	assert.NoError(t, err)
+	assert.Equal(t, request, verified)
+
+	other := NewOIDCProvider(nil, testIssuer, []byte("other key"))
+	_, err = other.VerifyConsentRequest(provider.SignConsentRequest(request))
+	assert.Error(t, err)
+}
+
+func TestOIDCProvider_AuthorizationCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockOIDCRepository(ctrl)
+	provider := NewOIDCProvider(mockRepo, testIssuer, []byte("key"))
+	ctx := context.Background()
+	client := &domain.OIDCClient{ClientID: "client"}
+
+	var stored *domain.OIDCAuthorizationCode
+	mockRepo.EXPECT().CreateAuthorizationCode(gomock.Any(), ctx).DoAndReturn(
+		func(code *domain.OIDCAuthorizationCode, ctx context.Context) error {
+			stored = code
+			return nil
+		})
+
+	code, err := provider.IssueAuthorizationCode(&domain.OIDCAuthorizationRequest{
+		ProfileID:     1,
+		ClientID:      "client",
+		RedirectURI:   "https://app.example.com/callback",
+		Scopes:        []string{"openid"},
+		CodeChallenge: testChallenge,
+	}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.HashOIDCSecret(code), stored.CodeHash)
+
+	t.Run("Exchanged", func(t *testing.T) {
+		mockRepo.EXPECT().TakeAuthorizationCode(stored.CodeHash, ctx).Return(stored, nil)
+
+		exchanged, err := provider.ExchangeAuthorizationCode(code, client, "https://app.example.com/callback", testVerifier, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, stored, exchanged)
+	})
+
+	t.Run("WrongVerifier", func(t *testing.T) {
+		mockRepo.EXPECT().TakeAuthorizationCode(stored.CodeHash, ctx).Return(stored, nil)
+
+		exchanged, err := provider.ExchangeAuthorizationCode(code, client, "https://app.example.com/callback", testVerifier[1:]+"x", ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, exchanged)
+	})
+
+	t.Run("WrongRedirectURI", func(t *testing.T) {
+		mockRepo.EXPECT().TakeAuthorizationCode(stored.CodeHash, ctx).Return(stored, nil)
+
+		exchanged, err := provider.ExchangeAuthorizationCode(code, client, "https://app.example.com/other", testVerifier, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, exchanged)
+	})
+
+	t.Run("OtherClient", func(t *testing.T) {
+		mockRepo.EXPECT().TakeAuthorizationCode(stored.CodeHash, ctx).Return(stored, nil)
+
+		exchanged, err := provider.ExchangeAuthorizationCode(code, &domain.OIDCClient{ClientID: "other"}, "https://app.example.com/callback", testVerifier, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, exchanged)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		expired := *stored
+		expired.ExpirationDate = time.Now().Add(-time.Second)
+		mockRepo.EXPECT().TakeAuthorizationCode(stored.CodeHash, ctx).Return(&expired, nil)
+
+		exchanged, err := provider.ExchangeAuthorizationCode(code, client, "https://app.example.com/callback", testVerifier, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, exchanged)
+	})
+
+	t.Run("AlreadyExchanged", func(t *testing.T) {
+		mockRepo.EXPECT().TakeAuthorizationCode(stored.CodeHash, ctx).Return(nil, nil)
+
+		exchanged, err := provider.ExchangeAuthorizationCode(code, client, "https://app.example.com/callback", testVerifier, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, exchanged)
+	})
+}
+
+func TestOIDCProvider_Tokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockOIDCRepository(ctrl)
+	provider := NewOIDCProvider(mockRepo, testIssuer, []byte("key"))
+	ctx := context.Background()
+	now := time.Now()
+
+	current := newTestSigningKey(t, "current", now.Add(-time.Hour))
+	previous := newTestSigningKey(t, "previous", now.Add(-domain.OIDCSigningKeyRotationPeriod))
+	retired := newTestSigningKey(t, "retired", now.Add(-domain.OIDCSigningKeyLifeTime))
+	keys := []*domain.OIDCSigningKey{current, previous, retired}
+	code := &domain.OIDCAuthorizationCode{ClientID: "client", ProfileID: 7, Scopes: []string{"openid", "email"}, Nonce: "nonce"}
+
+	mockRepo.EXPECT().GetSigningKeys(ctx).Return(keys, nil).AnyTimes()
+
+	idToken, accessToken, err := provider.IssueTokens(code, ctx)
+	assert.NoError(t, err)
+
+	t.Run("IDToken", func(t *testing.T) {
+		privateKey, _ := jwt.DecodePrivateKey(current.PrivateKey)
+		var claims domain.OIDCIDTokenClaims
+		header, err := jwt.Verify(idToken, func(string) *rsa.PublicKey { return &privateKey.PublicKey }, &claims)
+		assert.NoError(t, err)
+		assert.Equal(t, "current", header.KeyID)
+		assert.Equal(t, domain.OIDCIDTokenType, header.Type)
+		assert.Equal(t, testIssuer, claims.Issuer)
+		assert.Equal(t, "7", claims.Subject)
+		assert.Equal(t, "client", claims.Audience)
+		assert.Equal(t, "nonce", claims.Nonce)
+	})
+
+	t.Run("AccessToken", func(t *testing.T) {
+		claims, err := provider.VerifyAccessToken(accessToken, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "7", claims.Subject)
+		assert.Equal(t, "client", claims.ClientID)
+		assert.Equal(t, "openid email", claims.Scope)
+	})
+
+	t.Run("IDTokenIsNotAccessToken", func(t *testing.T) {
+		_, err := provider.VerifyAccessToken(idToken, ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("RetiredKey", func(t *testing.T) {
+		privateKey, _ := jwt.DecodePrivateKey(retired.PrivateKey)
+		token, err := jwt.Sign(domain.OIDCAccessTokenClaims{Issuer: testIssuer, Subject: "7", ExpiresAt: now.Add(time.Hour).Unix()},
+			domain.OIDCAccessTokenType, "retired", privateKey)
+		assert.NoError(t, err)
+
+		_, err = provider.VerifyAccessToken(token, ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		privateKey, _ := jwt.DecodePrivateKey(previous.PrivateKey)
+		token, err := jwt.Sign(domain.OIDCAccessTokenClaims{Issuer: testIssuer, Subject: "7", ExpiresAt: now.Add(-time.Second).Unix()},
+			domain.OIDCAccessTokenType, "previous", privateKey)
+		assert.NoError(t, err)
+
+		_, err = provider.VerifyAccessToken(token, ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("PublicKeys", func(t *testing.T) {
+		publicKeys, err := provider.PublicKeys(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, publicKeys, 2)
+		assert.Equal(t, "current", publicKeys[0].KeyID)
+		assert.Equal(t, "previous", publicKeys[1].KeyID)
+	})
+}
+
+func TestOIDCProvider_KeyRotation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockOIDCRepository(ctrl)
+	provider := NewOIDCProvider(mockRepo, testIssuer, []byte("key"))
+	ctx := context.Background()
+	old := newTestSigningKey(t, "old", time.Now().Add(-domain.OIDCSigningKeyRotationPeriod))
+
+	var added *domain.OIDCSigningKey
+	mockRepo.EXPECT().GetSigningKeys(ctx).Return([]*domain.OIDCSigningKey{old}, nil)
+	mockRepo.EXPECT().AddSigningKey(gomock.Any(), ctx).DoAndReturn(
+		func(key *domain.OIDCSigningKey, ctx context.Context) error {
+			added = key
+			return nil
+		})
+	mockRepo.EXPECT().DeleteSigningKeys(gomock.Any(), ctx).Return(nil)
+
+	idToken, _, err := provider.IssueTokens(&domain.OIDCAuthorizationCode{ClientID: "client", ProfileID: 1}, ctx)
+	assert.NoError(t, err)
+
+	privateKey, err := jwt.DecodePrivateKey(added.PrivateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OIDCSigningKeyBits, privateKey.N.BitLen())
+
+	var claims domain.OIDCIDTokenClaims
+	header, err := jwt.Verify(idToken, func(string) *rsa.PublicKey { return &privateKey.PublicKey }, &claims)
+	assert.NoError(t, err)
+	assert.Equal(t, added.KeyID, header.KeyID)
+}
@@ -4,6 +4,7 @@ package _interface
 
 import (
 	"context"
+	"time"
 
 	domain "mail/internal/microservice/models/domain_models"
 )
@@ -13,6 +14,9 @@ type EmailRepository interface {
 	// GetAllIncoming returns all emails incoming from the storage.
 	GetAllIncoming(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error)
 
+	// CountUnreadIncoming returns the number of the incoming emails which have not been read.
+	CountUnreadIncoming(login string, ctx context.Context) (int64, error)
+
 	// GetAllSent returns all emails sent from the storage.
 	GetAllSent(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error)
 
@@ -63,4 +67,126 @@ type EmailRepository interface {
 
 	// UpdateFileByID updates the file ID, file type, file name and file size of a file entry in the database based on the provided file ID.
 	UpdateFileByID(fileID uint64, newFileID string, newFileType string, newFileName string, newFileSize string, ctx context.Context) error
+
+	// CreateMailingList creates a new mailing list and makes the given profile its owner.
+	CreateMailingList(list *domain.MailingList, ownerLogin string, ctx context.Context) (*domain.MailingList, error)
+
+	// GetMailingListByID returns the mailing list by its unique identifier.
+	GetMailingListByID(id uint32, ctx context.Context) (*domain.MailingList, error)
+
+	// GetMailingListByAddress returns the mailing list by its group address.
+	GetMailingListByAddress(address string, ctx context.Context) (*domain.MailingList, error)
+
+	// GetMailingListsByLogin returns all mailing lists the profile is a member of.
+	GetMailingListsByLogin(login string, offset, limit int64, ctx context.Context) ([]*domain.MailingList, error)
+
+	// UpdateMailingList updates the name, description, post policy and moderation of the mailing list.
+	UpdateMailingList(list *domain.MailingList, ctx context.Context) (bool, error)
+
+	// DeleteMailingList removes the mailing list by its unique identifier.
+	DeleteMailingList(id uint32, ctx context.Context) (bool, error)
+
+	// GetMailingListMemberRole returns the role of the profile in the mailing list, or an empty string for non-members.
+	GetMailingListMemberRole(listID uint32, login string, ctx context.Context) (string, error)
+
+	// GetMailingListMembers returns all members of the mailing list.
+	GetMailingListMembers(listID uint32, ctx context.Context) ([]*domain.MailingListMember, error)
+
+	// AddMailingListMember adds the profile to the mailing list or changes its role.
+	AddMailingListMember(listID uint32, login, role string, ctx context.Context) error
+
+	// DeleteMailingListMember removes the profile from the mailing list.
+	DeleteMailingListMember(listID uint32, login string, ctx context.Context) (bool, error)
+
+	// AddProfileEmailList links an email to the sender and to every member of the mailing list.
+	AddProfileEmailList(emailID uint64, sender string, listID uint32, ctx context.Context) error
+
+	// AddMailingListModeration holds an email sent to the mailing list until an owner approves it.
+	AddMailingListModeration(listID uint32, emailID uint64, ctx context.Context) error
+
+	// GetMailingListModeration returns all emails of the mailing list waiting for moderation.
+	GetMailingListModeration(listID uint32, ctx context.Context) ([]*domain.Email, error)
+
+	// DeleteMailingListModeration removes an email from the moderation queue of the mailing list.
+	DeleteMailingListModeration(listID uint32, emailID uint64, ctx context.Context) (bool, error)
+
+	// CreateLabel creates a new label owned by the profile with the given login.
+	CreateLabel(label *domain.Label, login string, ctx context.Context) (*domain.Label, error)
+
+	// GetLabelsByLogin returns all labels of the profile.
+	GetLabelsByLogin(login string, offset, limit int64, ctx context.Context) ([]*domain.Label, error)
+
+	// GetLabelByID returns the label of the profile by its unique identifier.
+	GetLabelByID(id uint32, login string, ctx context.Context) (*domain.Label, error)
+
+	// GetLabelByName returns the label of the profile by its name.
+	GetLabelByName(name, login string, ctx context.Context) (*domain.Label, error)
+
+	// UpdateLabel updates the name and color of the label of the profile.
+	UpdateLabel(label *domain.Label, login string, ctx context.Context) (bool, error)
+
+	// DeleteLabel deletes the label of the profile and removes it from all emails.
+	DeleteLabel(id uint32, login string, ctx context.Context) (bool, error)
+
+	// CheckEmailsProfile checks that all the emails belong to the profile; the email IDs must not repeat.
+	CheckEmailsProfile(emailIDs []uint64, login string, ctx context.Context) (bool, error)
+
+	// AddEmailsInLabel puts the label on all the emails; emails that already have the label are skipped.
+	AddEmailsInLabel(labelID uint32, emailIDs []uint64, ctx context.Context) error
+
+	// DeleteEmailsInLabel removes the label from all the emails.
+	DeleteEmailsInLabel(labelID uint32, emailIDs []uint64, ctx context.Context) (bool, error)
+
+	// GetLabelsByEmails returns the labels the profile has put on each of the emails.
+	GetLabelsByEmails(emailIDs []uint64, login string, ctx context.Context) (map[uint64][]*domain.Label, error)
+
+	// GetAllEmailsInLabel returns all emails of the profile marked with the label.
+	GetAllEmailsInLabel(labelID uint32, login string, ctx context.Context) ([]*domain.Email, error)
+
+	// BulkEmails applies the action to the emails of the profile in one transaction
+	// and returns the IDs of the emails it was applied to.
+	BulkEmails(request *domain.BulkRequest, login string, ctx context.Context) ([]uint64, error)
+
+	// GetMailboxDelegateRole returns the role of the delegate in the shared mailbox, or an empty string if it has no access.
+	GetMailboxDelegateRole(mailbox, delegate string, ctx context.Context) (string, error)
+
+	// GetMailboxDelegates returns all delegates of the shared mailbox.
+	GetMailboxDelegates(mailbox string, ctx context.Context) ([]*domain.MailboxDelegate, error)
+
+	// GetDelegatedMailboxes returns all shared mailboxes the profile is a delegate of.
+	GetDelegatedMailboxes(delegate string, offset, limit int64, ctx context.Context) ([]*domain.MailboxDelegate, error)
+
+	// AddMailboxDelegate gives the profile access to the shared mailbox or changes its role.
+	AddMailboxDelegate(mailbox, delegate, role string, ctx context.Context) error
+
+	// DeleteMailboxDelegate takes away the access of the profile to the shared mailbox.
+	DeleteMailboxDelegate(mailbox, delegate string, ctx context.Context) (bool, error)
+
+	// AddDelegateAction records an action the delegate performed in the shared mailbox.
+	AddDelegateAction(action *domain.DelegateAction, ctx context.Context) error
+
+	// GetDelegateActions returns the actions the delegates performed in the shared mailbox.
+	GetDelegateActions(mailbox string, offset, limit int64, ctx context.Context) ([]*domain.DelegateAction, error)
+
+	// GetGMailHistoryID returns the identifier of the latest change of the Gmail mailbox copied locally, found is false if it has no copy.
+	GetGMailHistoryID(login string, ctx context.Context) (uint64, bool, error)
+
+	// GetGMailLogins returns the Gmail mailboxes which have a local copy.
+	GetGMailLogins(ctx context.Context) ([]string, error)
+
+	// ApplyGMailSync applies the changes of the Gmail mailbox to its local copy in one transaction.
+	ApplyGMailSync(sync *domain.GMailSync, ctx context.Context) error
+
+	// GetGMailMessages returns the newest emails of the local copy of the Gmail mailbox with the label,
+// older than the email with beforeDate and beforeID unless beforeDate is zero.
+	GetGMailMessages(login, labelID string, beforeDate time.Time, beforeID string, limit int64, ctx context.Context) ([]*domain.GMailMessage, error)
+
+	// GetGMailLabels returns the labels of the local copy of the Gmail mailbox.
+	GetGMailLabels(login string, ctx context.Context) ([]*domain.GMailLabel, error)
+
+	// UpdateGMailMessageLabels adds and removes the labels of an email of the local copy.
+	UpdateGMailMessageLabels(login, id string, addLabelIDs, removeLabelIDs []string, ctx context.Context) (bool, error)
+
+	// DeleteGMailMessage removes an email from the local copy.
+	DeleteGMailMessage(login, id string, ctx context.Context) (bool, error)
 }
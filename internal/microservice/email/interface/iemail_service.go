@@ -4,6 +4,7 @@ package _interface
 
 import (
 	"context"
+	"time"
 
 	emailCore "mail/internal/microservice/models/domain_models"
 )
@@ -13,6 +14,9 @@ type EmailUseCase interface {
 	// GetAllEmailsIncoming returns all incoming emails for the specified user.
 	GetAllEmailsIncoming(login string, offset, limit int64, ctx context.Context) ([]*emailCore.Email, error)
 
+	// GetUnreadCount returns the number of the incoming emails of the user which have not been read.
+	GetUnreadCount(login string, ctx context.Context) (int64, error)
+
 	// GetAllEmailsSent returns all sent emails for the specified user.
 	GetAllEmailsSent(login string, offset, limit int64, ctx context.Context) ([]*emailCore.Email, error)
 
@@ -37,8 +41,8 @@ type EmailUseCase interface {
 	// DeleteEmail deletes the email with the specified ID for the specified user.
 	DeleteEmail(id uint64, login string, ctx context.Context) (bool, error)
 
-	// CheckRecipientEmail checks if the recipient email address is valid.
-	CheckRecipientEmail(recipient string, ctx context.Context) error
+	// CheckRecipientEmail checks if the recipient email address is valid and the sender may write to it.
+	CheckRecipientEmail(recipient, sender string, ctx context.Context) error
 
 	// AddAttachment adds an attachment to the specified email.
 	AddAttachment(fileID, fileType, fileName, fileSize string, emailID uint64, ctx context.Context) (uint64, error)
@@ -60,4 +64,104 @@ type EmailUseCase interface {
 
 	// AddFileToEmail add a file to an email.
 	AddFileToEmail(emailID uint64, fileID uint64, ctx context.Context) error
+
+	// CreateMailingList creates a new mailing list owned by the specified user.
+	CreateMailingList(list *emailCore.MailingList, ownerLogin string, ctx context.Context) (*emailCore.MailingList, error)
+
+	// GetMailingLists returns all mailing lists the specified user is a member of.
+	GetMailingLists(login string, offset, limit int64, ctx context.Context) ([]*emailCore.MailingList, error)
+
+	// GetMailingListByID returns the mailing list with the specified ID if the user is its member.
+	GetMailingListByID(id uint32, login string, ctx context.Context) (*emailCore.MailingList, error)
+
+	// UpdateMailingList updates the settings of the mailing list on behalf of one of its owners.
+	UpdateMailingList(list *emailCore.MailingList, login string, ctx context.Context) (bool, error)
+
+	// DeleteMailingList deletes the mailing list on behalf of one of its owners.
+	DeleteMailingList(id uint32, login string, ctx context.Context) (bool, error)
+
+	// GetMailingListMembers returns the members of the mailing list if the user is its member.
+	GetMailingListMembers(id uint32, login string, ctx context.Context) ([]*emailCore.MailingListMember, error)
+
+	// AddMailingListMember adds a member to the mailing list on behalf of one of its owners.
+	AddMailingListMember(id uint32, login, memberLogin, role string, ctx context.Context) (bool, error)
+
+	// DeleteMailingListMember removes a member from the mailing list; members may remove themselves.
+	DeleteMailingListMember(id uint32, login, memberLogin string, ctx context.Context) (bool, error)
+
+	// UnsubscribeMailingList removes the member named by the signed token of a List-Unsubscribe link from the list.
+	UnsubscribeMailingList(token string, ctx context.Context) (bool, error)
+
+	// GetMailingListModeration returns the emails waiting for moderation on behalf of one of the list owners.
+	GetMailingListModeration(id uint32, login string, ctx context.Context) ([]*emailCore.Email, error)
+
+	// ModerateMailingListEmail approves or rejects an email waiting for moderation.
+	ModerateMailingListEmail(id uint32, emailID uint64, login string, approve bool, ctx context.Context) (bool, error)
+
+	// CreateLabel creates a new label of the user.
+	CreateLabel(label *emailCore.Label, login string, ctx context.Context) (*emailCore.Label, error)
+
+	// GetLabels returns all labels of the user.
+	GetLabels(login string, offset, limit int64, ctx context.Context) ([]*emailCore.Label, error)
+
+	// UpdateLabel changes the name and color of the user label.
+	UpdateLabel(label *emailCore.Label, login string, ctx context.Context) (bool, error)
+
+	// DeleteLabel deletes the user label.
+	DeleteLabel(id uint32, login string, ctx context.Context) (bool, error)
+
+	// GetEmailLabels returns the labels the user has put on the email.
+	GetEmailLabels(emailID uint64, login string, ctx context.Context) ([]*emailCore.Label, error)
+
+	// GetAllEmailsInLabel returns all emails of the user marked with the label with the specified name.
+	GetAllEmailsInLabel(name, login string, ctx context.Context) ([]*emailCore.Email, error)
+
+	// AddEmailsInLabel puts the user label on a batch of the user emails.
+	AddEmailsInLabel(labelID uint32, emailIDs []uint64, login string, ctx context.Context) (bool, error)
+
+	// DeleteEmailsInLabel removes the user label from a batch of the user emails.
+	DeleteEmailsInLabel(labelID uint32, emailIDs []uint64, login string, ctx context.Context) (bool, error)
+
+	// BulkEmails applies the action to a batch of the user emails and reports the outcome for each email.
+	BulkEmails(request *emailCore.BulkRequest, login string, ctx context.Context) ([]*emailCore.BulkResult, error)
+
+	// GetMailboxDelegates returns the delegates of the shared mailbox; the user must be allowed to manage it.
+	GetMailboxDelegates(mailbox, login string, ctx context.Context) ([]*emailCore.MailboxDelegate, error)
+
+	// GetDelegatedMailboxes returns the shared mailboxes the user is a delegate of.
+	GetDelegatedMailboxes(login string, offset, limit int64, ctx context.Context) ([]*emailCore.MailboxDelegate, error)
+
+	// AddMailboxDelegate gives a profile access to the shared mailbox on behalf of a user allowed to manage it.
+	AddMailboxDelegate(mailbox, login, delegate, role string, ctx context.Context) (bool, error)
+
+	// DeleteMailboxDelegate takes away the access of a profile to the shared mailbox; delegates may remove themselves.
+	DeleteMailboxDelegate(mailbox, login, delegate string, ctx context.Context) (bool, error)
+
+	// AddDelegateAction records an action the delegate performed in the shared mailbox.
+	AddDelegateAction(action *emailCore.DelegateAction, ctx context.Context) error
+
+	// GetDelegateActions returns the actions the delegates performed in the shared mailbox; the user must be allowed to manage it.
+	GetDelegateActions(mailbox, login string, offset, limit int64, ctx context.Context) ([]*emailCore.DelegateAction, error)
+
+	// GetGMailHistoryID returns the identifier of the latest change of the Gmail mailbox copied locally, found is false if it has no copy.
+	GetGMailHistoryID(login string, ctx context.Context) (uint64, bool, error)
+
+	// GetGMailLogins returns the Gmail mailboxes which have a local copy.
+	GetGMailLogins(ctx context.Context) ([]string, error)
+
+	// ApplyGMailSync applies the changes of the Gmail mailbox to its local copy.
+	ApplyGMailSync(sync *emailCore.GMailSync, ctx context.Context) error
+
+	// GetGMailMessages returns the newest emails of the local copy of the Gmail mailbox with the label,
+// older than the email with beforeDate and beforeID unless beforeDate is zero.
+	GetGMailMessages(login, labelID string, beforeDate time.Time, beforeID string, limit int64, ctx context.Context) ([]*emailCore.GMailMessage, error)
+
+	// GetGMailLabels returns the labels of the local copy of the Gmail mailbox.
+	GetGMailLabels(login string, ctx context.Context) ([]*emailCore.GMailLabel, error)
+
+	// UpdateGMailMessageLabels adds and removes the labels of an email of the local copy.
+	UpdateGMailMessageLabels(login, id string, addLabelIDs, removeLabelIDs []string, ctx context.Context) (bool, error)
+
+	// DeleteGMailMessage removes an email from the local copy.
+	DeleteGMailMessage(login, id string, ctx context.Context) (bool, error)
 }
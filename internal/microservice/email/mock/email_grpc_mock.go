@@ -56,6 +56,26 @@ func (mr *MockEmailServiceClientMockRecorder) AddAttachment(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttachment", reflect.TypeOf((*MockEmailServiceClient)(nil).AddAttachment), varargs...)
 }
 
+// AddDelegateAction mocks base method.
+func (m *MockEmailServiceClient) AddDelegateAction(ctx context.Context, in *proto.DelegateAction, opts ...grpc.CallOption) (*proto.EmptyEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDelegateAction", varargs...)
+	ret0, _ := ret[0].(*proto.EmptyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDelegateAction indicates an expected call of AddDelegateAction.
+func (mr *MockEmailServiceClientMockRecorder) AddDelegateAction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDelegateAction", reflect.TypeOf((*MockEmailServiceClient)(nil).AddDelegateAction), varargs...)
+}
+
 // AddEmailDraft mocks base method.
 func (m *MockEmailServiceClient) AddEmailDraft(ctx context.Context, in *proto.Email, opts ...grpc.CallOption) (*proto.EmailWithID, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +96,26 @@ func (mr *MockEmailServiceClientMockRecorder) AddEmailDraft(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailDraft", reflect.TypeOf((*MockEmailServiceClient)(nil).AddEmailDraft), varargs...)
 }
 
+// AddEmailsInLabel mocks base method.
+func (m *MockEmailServiceClient) AddEmailsInLabel(ctx context.Context, in *proto.LabelEmailsRequest, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddEmailsInLabel", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEmailsInLabel indicates an expected call of AddEmailsInLabel.
+func (mr *MockEmailServiceClientMockRecorder) AddEmailsInLabel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailsInLabel", reflect.TypeOf((*MockEmailServiceClient)(nil).AddEmailsInLabel), varargs...)
+}
+
 // AddFile mocks base method.
 func (m *MockEmailServiceClient) AddFile(ctx context.Context, in *proto.AddFileRequest, opts ...grpc.CallOption) (*proto.AddFileReply, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +156,86 @@ func (mr *MockEmailServiceClientMockRecorder) AddFileToEmail(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileToEmail", reflect.TypeOf((*MockEmailServiceClient)(nil).AddFileToEmail), varargs...)
 }
 
+// AddMailboxDelegate mocks base method.
+func (m *MockEmailServiceClient) AddMailboxDelegate(ctx context.Context, in *proto.MailboxDelegateRequest, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddMailboxDelegate", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMailboxDelegate indicates an expected call of AddMailboxDelegate.
+func (mr *MockEmailServiceClientMockRecorder) AddMailboxDelegate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailboxDelegate", reflect.TypeOf((*MockEmailServiceClient)(nil).AddMailboxDelegate), varargs...)
+}
+
+// AddMailingListMember mocks base method.
+func (m *MockEmailServiceClient) AddMailingListMember(ctx context.Context, in *proto.MailingListMemberRequest, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddMailingListMember", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMailingListMember indicates an expected call of AddMailingListMember.
+func (mr *MockEmailServiceClientMockRecorder) AddMailingListMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailingListMember", reflect.TypeOf((*MockEmailServiceClient)(nil).AddMailingListMember), varargs...)
+}
+
+// ApplyGMailSync mocks base method.
+func (m *MockEmailServiceClient) ApplyGMailSync(ctx context.Context, in *proto.GMailSync, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyGMailSync", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyGMailSync indicates an expected call of ApplyGMailSync.
+func (mr *MockEmailServiceClientMockRecorder) ApplyGMailSync(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyGMailSync", reflect.TypeOf((*MockEmailServiceClient)(nil).ApplyGMailSync), varargs...)
+}
+
+// BulkEmails mocks base method.
+func (m *MockEmailServiceClient) BulkEmails(ctx context.Context, in *proto.BulkEmailsRequest, opts ...grpc.CallOption) (*proto.BulkEmailsResults, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BulkEmails", varargs...)
+	ret0, _ := ret[0].(*proto.BulkEmailsResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkEmails indicates an expected call of BulkEmails.
+func (mr *MockEmailServiceClientMockRecorder) BulkEmails(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkEmails", reflect.TypeOf((*MockEmailServiceClient)(nil).BulkEmails), varargs...)
+}
+
 // CheckRecipientEmail mocks base method.
 func (m *MockEmailServiceClient) CheckRecipientEmail(ctx context.Context, in *proto.Recipient, opts ...grpc.CallOption) (*proto.EmptyEmail, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +276,46 @@ func (mr *MockEmailServiceClientMockRecorder) CreateEmail(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmail", reflect.TypeOf((*MockEmailServiceClient)(nil).CreateEmail), varargs...)
 }
 
+// CreateLabel mocks base method.
+func (m *MockEmailServiceClient) CreateLabel(ctx context.Context, in *proto.LabelWithLogin, opts ...grpc.CallOption) (*proto.Label, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateLabel", varargs...)
+	ret0, _ := ret[0].(*proto.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockEmailServiceClientMockRecorder) CreateLabel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockEmailServiceClient)(nil).CreateLabel), varargs...)
+}
+
+// CreateMailingList mocks base method.
+func (m *MockEmailServiceClient) CreateMailingList(ctx context.Context, in *proto.MailingListWithLogin, opts ...grpc.CallOption) (*proto.MailingList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateMailingList", varargs...)
+	ret0, _ := ret[0].(*proto.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMailingList indicates an expected call of CreateMailingList.
+func (mr *MockEmailServiceClientMockRecorder) CreateMailingList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMailingList", reflect.TypeOf((*MockEmailServiceClient)(nil).CreateMailingList), varargs...)
+}
+
 // CreateProfileEmail mocks base method.
 func (m *MockEmailServiceClient) CreateProfileEmail(ctx context.Context, in *proto.IdSenderRecipient, opts ...grpc.CallOption) (*proto.EmptyEmail, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +356,26 @@ func (mr *MockEmailServiceClientMockRecorder) DeleteEmail(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmail", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteEmail), varargs...)
 }
 
+// DeleteEmailsInLabel mocks base method.
+func (m *MockEmailServiceClient) DeleteEmailsInLabel(ctx context.Context, in *proto.LabelEmailsRequest, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEmailsInLabel", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEmailsInLabel indicates an expected call of DeleteEmailsInLabel.
+func (mr *MockEmailServiceClientMockRecorder) DeleteEmailsInLabel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailsInLabel", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteEmailsInLabel), varargs...)
+}
+
 // DeleteFileByID mocks base method.
 func (m *MockEmailServiceClient) DeleteFileByID(ctx context.Context, in *proto.DeleteFileByIDRequest, opts ...grpc.CallOption) (*proto.DeleteFileByIDReply, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +396,126 @@ func (mr *MockEmailServiceClientMockRecorder) DeleteFileByID(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileByID", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteFileByID), varargs...)
 }
 
+// DeleteGMailMessage mocks base method.
+func (m *MockEmailServiceClient) DeleteGMailMessage(ctx context.Context, in *proto.GMailMessageIdAndLogin, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteGMailMessage", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGMailMessage indicates an expected call of DeleteGMailMessage.
+func (mr *MockEmailServiceClientMockRecorder) DeleteGMailMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGMailMessage", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteGMailMessage), varargs...)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockEmailServiceClient) DeleteLabel(ctx context.Context, in *proto.LabelIdAndLogin, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLabel", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockEmailServiceClientMockRecorder) DeleteLabel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteLabel), varargs...)
+}
+
+// DeleteMailboxDelegate mocks base method.
+func (m *MockEmailServiceClient) DeleteMailboxDelegate(ctx context.Context, in *proto.MailboxDelegateRequest, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMailboxDelegate", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailboxDelegate indicates an expected call of DeleteMailboxDelegate.
+func (mr *MockEmailServiceClientMockRecorder) DeleteMailboxDelegate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailboxDelegate", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteMailboxDelegate), varargs...)
+}
+
+// DeleteMailingList mocks base method.
+func (m *MockEmailServiceClient) DeleteMailingList(ctx context.Context, in *proto.MailingListIdAndLogin, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMailingList", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingList indicates an expected call of DeleteMailingList.
+func (mr *MockEmailServiceClientMockRecorder) DeleteMailingList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingList", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteMailingList), varargs...)
+}
+
+// DeleteMailingListMember mocks base method.
+func (m *MockEmailServiceClient) DeleteMailingListMember(ctx context.Context, in *proto.MailingListMemberRequest, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMailingListMember", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingListMember indicates an expected call of DeleteMailingListMember.
+func (mr *MockEmailServiceClientMockRecorder) DeleteMailingListMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingListMember", reflect.TypeOf((*MockEmailServiceClient)(nil).DeleteMailingListMember), varargs...)
+}
+
+// GetAllEmailsInLabel mocks base method.
+func (m *MockEmailServiceClient) GetAllEmailsInLabel(ctx context.Context, in *proto.LabelNameAndLogin, opts ...grpc.CallOption) (*proto.Emails, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllEmailsInLabel", varargs...)
+	ret0, _ := ret[0].(*proto.Emails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllEmailsInLabel indicates an expected call of GetAllEmailsInLabel.
+func (mr *MockEmailServiceClientMockRecorder) GetAllEmailsInLabel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmailsInLabel", reflect.TypeOf((*MockEmailServiceClient)(nil).GetAllEmailsInLabel), varargs...)
+}
+
 // GetAllIncoming mocks base method.
 func (m *MockEmailServiceClient) GetAllIncoming(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.Emails, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +556,46 @@ func (mr *MockEmailServiceClientMockRecorder) GetAllSent(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSent", reflect.TypeOf((*MockEmailServiceClient)(nil).GetAllSent), varargs...)
 }
 
+// GetDelegateActions mocks base method.
+func (m *MockEmailServiceClient) GetDelegateActions(ctx context.Context, in *proto.MailboxAndLogin, opts ...grpc.CallOption) (*proto.DelegateActions, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDelegateActions", varargs...)
+	ret0, _ := ret[0].(*proto.DelegateActions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegateActions indicates an expected call of GetDelegateActions.
+func (mr *MockEmailServiceClientMockRecorder) GetDelegateActions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateActions", reflect.TypeOf((*MockEmailServiceClient)(nil).GetDelegateActions), varargs...)
+}
+
+// GetDelegatedMailboxes mocks base method.
+func (m *MockEmailServiceClient) GetDelegatedMailboxes(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.MailboxDelegates, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDelegatedMailboxes", varargs...)
+	ret0, _ := ret[0].(*proto.MailboxDelegates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegatedMailboxes indicates an expected call of GetDelegatedMailboxes.
+func (mr *MockEmailServiceClientMockRecorder) GetDelegatedMailboxes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatedMailboxes", reflect.TypeOf((*MockEmailServiceClient)(nil).GetDelegatedMailboxes), varargs...)
+}
+
 // GetDraftEmails mocks base method.
 func (m *MockEmailServiceClient) GetDraftEmails(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.Emails, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +636,26 @@ func (mr *MockEmailServiceClientMockRecorder) GetEmailByID(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailByID", reflect.TypeOf((*MockEmailServiceClient)(nil).GetEmailByID), varargs...)
 }
 
+// GetEmailLabels mocks base method.
+func (m *MockEmailServiceClient) GetEmailLabels(ctx context.Context, in *proto.EmailIdAndLogin, opts ...grpc.CallOption) (*proto.Labels, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEmailLabels", varargs...)
+	ret0, _ := ret[0].(*proto.Labels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailLabels indicates an expected call of GetEmailLabels.
+func (mr *MockEmailServiceClientMockRecorder) GetEmailLabels(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailLabels", reflect.TypeOf((*MockEmailServiceClient)(nil).GetEmailLabels), varargs...)
+}
+
 // GetFileByID mocks base method.
 func (m *MockEmailServiceClient) GetFileByID(ctx context.Context, in *proto.GetFileByIDRequest, opts ...grpc.CallOption) (*proto.GetFileByIDReply, error) {
 	m.ctrl.T.Helper()
@@ -336,34 +696,294 @@ func (mr *MockEmailServiceClientMockRecorder) GetFilesByEmailID(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesByEmailID", reflect.TypeOf((*MockEmailServiceClient)(nil).GetFilesByEmailID), varargs...)
 }
 
-// GetSpamEmails mocks base method.
-func (m *MockEmailServiceClient) GetSpamEmails(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.Emails, error) {
+// GetGMailLabels mocks base method.
+func (m *MockEmailServiceClient) GetGMailLabels(ctx context.Context, in *proto.GMailLogin, opts ...grpc.CallOption) (*proto.GMailLabels, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetSpamEmails", varargs...)
-	ret0, _ := ret[0].(*proto.Emails)
+	ret := m.ctrl.Call(m, "GetGMailLabels", varargs...)
+	ret0, _ := ret[0].(*proto.GMailLabels)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSpamEmails indicates an expected call of GetSpamEmails.
-func (mr *MockEmailServiceClientMockRecorder) GetSpamEmails(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetGMailLabels indicates an expected call of GetGMailLabels.
+func (mr *MockEmailServiceClientMockRecorder) GetGMailLabels(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpamEmails", reflect.TypeOf((*MockEmailServiceClient)(nil).GetSpamEmails), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLabels", reflect.TypeOf((*MockEmailServiceClient)(nil).GetGMailLabels), varargs...)
 }
 
-// UpdateEmail mocks base method.
-func (m *MockEmailServiceClient) UpdateEmail(ctx context.Context, in *proto.Email, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+// GetGMailLogins mocks base method.
+func (m *MockEmailServiceClient) GetGMailLogins(ctx context.Context, in *proto.EmptyEmail, opts ...grpc.CallOption) (*proto.GMailLogins, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateEmail", varargs...)
+	ret := m.ctrl.Call(m, "GetGMailLogins", varargs...)
+	ret0, _ := ret[0].(*proto.GMailLogins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailLogins indicates an expected call of GetGMailLogins.
+func (mr *MockEmailServiceClientMockRecorder) GetGMailLogins(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLogins", reflect.TypeOf((*MockEmailServiceClient)(nil).GetGMailLogins), varargs...)
+}
+
+// GetGMailMessages mocks base method.
+func (m *MockEmailServiceClient) GetGMailMessages(ctx context.Context, in *proto.GMailLabelAndLogin, opts ...grpc.CallOption) (*proto.GMailMessages, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGMailMessages", varargs...)
+	ret0, _ := ret[0].(*proto.GMailMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailMessages indicates an expected call of GetGMailMessages.
+func (mr *MockEmailServiceClientMockRecorder) GetGMailMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailMessages", reflect.TypeOf((*MockEmailServiceClient)(nil).GetGMailMessages), varargs...)
+}
+
+// GetGMailSyncState mocks base method.
+func (m *MockEmailServiceClient) GetGMailSyncState(ctx context.Context, in *proto.GMailLogin, opts ...grpc.CallOption) (*proto.GMailSyncState, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGMailSyncState", varargs...)
+	ret0, _ := ret[0].(*proto.GMailSyncState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailSyncState indicates an expected call of GetGMailSyncState.
+func (mr *MockEmailServiceClientMockRecorder) GetGMailSyncState(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailSyncState", reflect.TypeOf((*MockEmailServiceClient)(nil).GetGMailSyncState), varargs...)
+}
+
+// GetLabels mocks base method.
+func (m *MockEmailServiceClient) GetLabels(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.Labels, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLabels", varargs...)
+	ret0, _ := ret[0].(*proto.Labels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabels indicates an expected call of GetLabels.
+func (mr *MockEmailServiceClientMockRecorder) GetLabels(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabels", reflect.TypeOf((*MockEmailServiceClient)(nil).GetLabels), varargs...)
+}
+
+// GetMailboxDelegates mocks base method.
+func (m *MockEmailServiceClient) GetMailboxDelegates(ctx context.Context, in *proto.MailboxAndLogin, opts ...grpc.CallOption) (*proto.MailboxDelegates, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMailboxDelegates", varargs...)
+	ret0, _ := ret[0].(*proto.MailboxDelegates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailboxDelegates indicates an expected call of GetMailboxDelegates.
+func (mr *MockEmailServiceClientMockRecorder) GetMailboxDelegates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxDelegates", reflect.TypeOf((*MockEmailServiceClient)(nil).GetMailboxDelegates), varargs...)
+}
+
+// GetMailingListByID mocks base method.
+func (m *MockEmailServiceClient) GetMailingListByID(ctx context.Context, in *proto.MailingListIdAndLogin, opts ...grpc.CallOption) (*proto.MailingList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMailingListByID", varargs...)
+	ret0, _ := ret[0].(*proto.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListByID indicates an expected call of GetMailingListByID.
+func (mr *MockEmailServiceClientMockRecorder) GetMailingListByID(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListByID", reflect.TypeOf((*MockEmailServiceClient)(nil).GetMailingListByID), varargs...)
+}
+
+// GetMailingListMembers mocks base method.
+func (m *MockEmailServiceClient) GetMailingListMembers(ctx context.Context, in *proto.MailingListIdAndLogin, opts ...grpc.CallOption) (*proto.MailingListMembers, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMailingListMembers", varargs...)
+	ret0, _ := ret[0].(*proto.MailingListMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListMembers indicates an expected call of GetMailingListMembers.
+func (mr *MockEmailServiceClientMockRecorder) GetMailingListMembers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListMembers", reflect.TypeOf((*MockEmailServiceClient)(nil).GetMailingListMembers), varargs...)
+}
+
+// GetMailingListModeration mocks base method.
+func (m *MockEmailServiceClient) GetMailingListModeration(ctx context.Context, in *proto.MailingListIdAndLogin, opts ...grpc.CallOption) (*proto.Emails, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMailingListModeration", varargs...)
+	ret0, _ := ret[0].(*proto.Emails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListModeration indicates an expected call of GetMailingListModeration.
+func (mr *MockEmailServiceClientMockRecorder) GetMailingListModeration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListModeration", reflect.TypeOf((*MockEmailServiceClient)(nil).GetMailingListModeration), varargs...)
+}
+
+// GetMailingLists mocks base method.
+func (m *MockEmailServiceClient) GetMailingLists(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.MailingLists, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMailingLists", varargs...)
+	ret0, _ := ret[0].(*proto.MailingLists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingLists indicates an expected call of GetMailingLists.
+func (mr *MockEmailServiceClientMockRecorder) GetMailingLists(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingLists", reflect.TypeOf((*MockEmailServiceClient)(nil).GetMailingLists), varargs...)
+}
+
+// GetSpamEmails mocks base method.
+func (m *MockEmailServiceClient) GetSpamEmails(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.Emails, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSpamEmails", varargs...)
+	ret0, _ := ret[0].(*proto.Emails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpamEmails indicates an expected call of GetSpamEmails.
+func (mr *MockEmailServiceClientMockRecorder) GetSpamEmails(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpamEmails", reflect.TypeOf((*MockEmailServiceClient)(nil).GetSpamEmails), varargs...)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockEmailServiceClient) GetUnreadCount(ctx context.Context, in *proto.LoginOffsetLimit, opts ...grpc.CallOption) (*proto.UnreadCount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUnreadCount", varargs...)
+	ret0, _ := ret[0].(*proto.UnreadCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockEmailServiceClientMockRecorder) GetUnreadCount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockEmailServiceClient)(nil).GetUnreadCount), varargs...)
+}
+
+// ModerateMailingListEmail mocks base method.
+func (m *MockEmailServiceClient) ModerateMailingListEmail(ctx context.Context, in *proto.ModerateEmailRequest, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModerateMailingListEmail", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateMailingListEmail indicates an expected call of ModerateMailingListEmail.
+func (mr *MockEmailServiceClientMockRecorder) ModerateMailingListEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateMailingListEmail", reflect.TypeOf((*MockEmailServiceClient)(nil).ModerateMailingListEmail), varargs...)
+}
+
+// UnsubscribeMailingList mocks base method.
+func (m *MockEmailServiceClient) UnsubscribeMailingList(ctx context.Context, in *proto.ListUnsubscribeToken, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnsubscribeMailingList", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeMailingList indicates an expected call of UnsubscribeMailingList.
+func (mr *MockEmailServiceClientMockRecorder) UnsubscribeMailingList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeMailingList", reflect.TypeOf((*MockEmailServiceClient)(nil).UnsubscribeMailingList), varargs...)
+}
+
+// UpdateEmail mocks base method.
+func (m *MockEmailServiceClient) UpdateEmail(ctx context.Context, in *proto.Email, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEmail", varargs...)
 	ret0, _ := ret[0].(*proto.StatusEmail)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
@@ -396,6 +1016,66 @@ func (mr *MockEmailServiceClientMockRecorder) UpdateFileByID(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileByID", reflect.TypeOf((*MockEmailServiceClient)(nil).UpdateFileByID), varargs...)
 }
 
+// UpdateGMailMessageLabels mocks base method.
+func (m *MockEmailServiceClient) UpdateGMailMessageLabels(ctx context.Context, in *proto.GMailMessageLabels, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGMailMessageLabels", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGMailMessageLabels indicates an expected call of UpdateGMailMessageLabels.
+func (mr *MockEmailServiceClientMockRecorder) UpdateGMailMessageLabels(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGMailMessageLabels", reflect.TypeOf((*MockEmailServiceClient)(nil).UpdateGMailMessageLabels), varargs...)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockEmailServiceClient) UpdateLabel(ctx context.Context, in *proto.LabelWithLogin, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLabel", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockEmailServiceClientMockRecorder) UpdateLabel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockEmailServiceClient)(nil).UpdateLabel), varargs...)
+}
+
+// UpdateMailingList mocks base method.
+func (m *MockEmailServiceClient) UpdateMailingList(ctx context.Context, in *proto.MailingListWithLogin, opts ...grpc.CallOption) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMailingList", varargs...)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMailingList indicates an expected call of UpdateMailingList.
+func (mr *MockEmailServiceClientMockRecorder) UpdateMailingList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMailingList", reflect.TypeOf((*MockEmailServiceClient)(nil).UpdateMailingList), varargs...)
+}
+
 // MockEmailServiceServer is a mock of EmailServiceServer interface.
 type MockEmailServiceServer struct {
 	ctrl     *gomock.Controller
@@ -434,6 +1114,21 @@ func (mr *MockEmailServiceServerMockRecorder) AddAttachment(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttachment", reflect.TypeOf((*MockEmailServiceServer)(nil).AddAttachment), arg0, arg1)
 }
 
+// AddDelegateAction mocks base method.
+func (m *MockEmailServiceServer) AddDelegateAction(arg0 context.Context, arg1 *proto.DelegateAction) (*proto.EmptyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDelegateAction", arg0, arg1)
+	ret0, _ := ret[0].(*proto.EmptyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDelegateAction indicates an expected call of AddDelegateAction.
+func (mr *MockEmailServiceServerMockRecorder) AddDelegateAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDelegateAction", reflect.TypeOf((*MockEmailServiceServer)(nil).AddDelegateAction), arg0, arg1)
+}
+
 // AddEmailDraft mocks base method.
 func (m *MockEmailServiceServer) AddEmailDraft(arg0 context.Context, arg1 *proto.Email) (*proto.EmailWithID, error) {
 	m.ctrl.T.Helper()
@@ -443,40 +1138,115 @@ func (m *MockEmailServiceServer) AddEmailDraft(arg0 context.Context, arg1 *proto
 	return ret0, ret1
 }
 
-// AddEmailDraft indicates an expected call of AddEmailDraft.
-func (mr *MockEmailServiceServerMockRecorder) AddEmailDraft(arg0, arg1 interface{}) *gomock.Call {
+// AddEmailDraft indicates an expected call of AddEmailDraft.
+func (mr *MockEmailServiceServerMockRecorder) AddEmailDraft(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailDraft", reflect.TypeOf((*MockEmailServiceServer)(nil).AddEmailDraft), arg0, arg1)
+}
+
+// AddEmailsInLabel mocks base method.
+func (m *MockEmailServiceServer) AddEmailsInLabel(arg0 context.Context, arg1 *proto.LabelEmailsRequest) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEmailsInLabel", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEmailsInLabel indicates an expected call of AddEmailsInLabel.
+func (mr *MockEmailServiceServerMockRecorder) AddEmailsInLabel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailsInLabel", reflect.TypeOf((*MockEmailServiceServer)(nil).AddEmailsInLabel), arg0, arg1)
+}
+
+// AddFile mocks base method.
+func (m *MockEmailServiceServer) AddFile(arg0 context.Context, arg1 *proto.AddFileRequest) (*proto.AddFileReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFile", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddFileReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFile indicates an expected call of AddFile.
+func (mr *MockEmailServiceServerMockRecorder) AddFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFile", reflect.TypeOf((*MockEmailServiceServer)(nil).AddFile), arg0, arg1)
+}
+
+// AddFileToEmail mocks base method.
+func (m *MockEmailServiceServer) AddFileToEmail(arg0 context.Context, arg1 *proto.AddFileToEmailRequest) (*proto.AddFileToEmailReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFileToEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddFileToEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFileToEmail indicates an expected call of AddFileToEmail.
+func (mr *MockEmailServiceServerMockRecorder) AddFileToEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileToEmail", reflect.TypeOf((*MockEmailServiceServer)(nil).AddFileToEmail), arg0, arg1)
+}
+
+// AddMailboxDelegate mocks base method.
+func (m *MockEmailServiceServer) AddMailboxDelegate(arg0 context.Context, arg1 *proto.MailboxDelegateRequest) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMailboxDelegate", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMailboxDelegate indicates an expected call of AddMailboxDelegate.
+func (mr *MockEmailServiceServerMockRecorder) AddMailboxDelegate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailboxDelegate", reflect.TypeOf((*MockEmailServiceServer)(nil).AddMailboxDelegate), arg0, arg1)
+}
+
+// AddMailingListMember mocks base method.
+func (m *MockEmailServiceServer) AddMailingListMember(arg0 context.Context, arg1 *proto.MailingListMemberRequest) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMailingListMember", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMailingListMember indicates an expected call of AddMailingListMember.
+func (mr *MockEmailServiceServerMockRecorder) AddMailingListMember(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailDraft", reflect.TypeOf((*MockEmailServiceServer)(nil).AddEmailDraft), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailingListMember", reflect.TypeOf((*MockEmailServiceServer)(nil).AddMailingListMember), arg0, arg1)
 }
 
-// AddFile mocks base method.
-func (m *MockEmailServiceServer) AddFile(arg0 context.Context, arg1 *proto.AddFileRequest) (*proto.AddFileReply, error) {
+// ApplyGMailSync mocks base method.
+func (m *MockEmailServiceServer) ApplyGMailSync(arg0 context.Context, arg1 *proto.GMailSync) (*proto.StatusEmail, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddFile", arg0, arg1)
-	ret0, _ := ret[0].(*proto.AddFileReply)
+	ret := m.ctrl.Call(m, "ApplyGMailSync", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddFile indicates an expected call of AddFile.
-func (mr *MockEmailServiceServerMockRecorder) AddFile(arg0, arg1 interface{}) *gomock.Call {
+// ApplyGMailSync indicates an expected call of ApplyGMailSync.
+func (mr *MockEmailServiceServerMockRecorder) ApplyGMailSync(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFile", reflect.TypeOf((*MockEmailServiceServer)(nil).AddFile), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyGMailSync", reflect.TypeOf((*MockEmailServiceServer)(nil).ApplyGMailSync), arg0, arg1)
 }
 
-// AddFileToEmail mocks base method.
-func (m *MockEmailServiceServer) AddFileToEmail(arg0 context.Context, arg1 *proto.AddFileToEmailRequest) (*proto.AddFileToEmailReply, error) {
+// BulkEmails mocks base method.
+func (m *MockEmailServiceServer) BulkEmails(arg0 context.Context, arg1 *proto.BulkEmailsRequest) (*proto.BulkEmailsResults, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddFileToEmail", arg0, arg1)
-	ret0, _ := ret[0].(*proto.AddFileToEmailReply)
+	ret := m.ctrl.Call(m, "BulkEmails", arg0, arg1)
+	ret0, _ := ret[0].(*proto.BulkEmailsResults)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddFileToEmail indicates an expected call of AddFileToEmail.
-func (mr *MockEmailServiceServerMockRecorder) AddFileToEmail(arg0, arg1 interface{}) *gomock.Call {
+// BulkEmails indicates an expected call of BulkEmails.
+func (mr *MockEmailServiceServerMockRecorder) BulkEmails(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileToEmail", reflect.TypeOf((*MockEmailServiceServer)(nil).AddFileToEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkEmails", reflect.TypeOf((*MockEmailServiceServer)(nil).BulkEmails), arg0, arg1)
 }
 
 // CheckRecipientEmail mocks base method.
@@ -509,6 +1279,36 @@ func (mr *MockEmailServiceServerMockRecorder) CreateEmail(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmail", reflect.TypeOf((*MockEmailServiceServer)(nil).CreateEmail), arg0, arg1)
 }
 
+// CreateLabel mocks base method.
+func (m *MockEmailServiceServer) CreateLabel(arg0 context.Context, arg1 *proto.LabelWithLogin) (*proto.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockEmailServiceServerMockRecorder) CreateLabel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockEmailServiceServer)(nil).CreateLabel), arg0, arg1)
+}
+
+// CreateMailingList mocks base method.
+func (m *MockEmailServiceServer) CreateMailingList(arg0 context.Context, arg1 *proto.MailingListWithLogin) (*proto.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMailingList", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMailingList indicates an expected call of CreateMailingList.
+func (mr *MockEmailServiceServerMockRecorder) CreateMailingList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMailingList", reflect.TypeOf((*MockEmailServiceServer)(nil).CreateMailingList), arg0, arg1)
+}
+
 // CreateProfileEmail mocks base method.
 func (m *MockEmailServiceServer) CreateProfileEmail(arg0 context.Context, arg1 *proto.IdSenderRecipient) (*proto.EmptyEmail, error) {
 	m.ctrl.T.Helper()
@@ -539,6 +1339,21 @@ func (mr *MockEmailServiceServerMockRecorder) DeleteEmail(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmail", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteEmail), arg0, arg1)
 }
 
+// DeleteEmailsInLabel mocks base method.
+func (m *MockEmailServiceServer) DeleteEmailsInLabel(arg0 context.Context, arg1 *proto.LabelEmailsRequest) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailsInLabel", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEmailsInLabel indicates an expected call of DeleteEmailsInLabel.
+func (mr *MockEmailServiceServerMockRecorder) DeleteEmailsInLabel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailsInLabel", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteEmailsInLabel), arg0, arg1)
+}
+
 // DeleteFileByID mocks base method.
 func (m *MockEmailServiceServer) DeleteFileByID(arg0 context.Context, arg1 *proto.DeleteFileByIDRequest) (*proto.DeleteFileByIDReply, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +1369,96 @@ func (mr *MockEmailServiceServerMockRecorder) DeleteFileByID(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileByID", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteFileByID), arg0, arg1)
 }
 
+// DeleteGMailMessage mocks base method.
+func (m *MockEmailServiceServer) DeleteGMailMessage(arg0 context.Context, arg1 *proto.GMailMessageIdAndLogin) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGMailMessage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGMailMessage indicates an expected call of DeleteGMailMessage.
+func (mr *MockEmailServiceServerMockRecorder) DeleteGMailMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGMailMessage", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteGMailMessage), arg0, arg1)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockEmailServiceServer) DeleteLabel(arg0 context.Context, arg1 *proto.LabelIdAndLogin) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockEmailServiceServerMockRecorder) DeleteLabel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteLabel), arg0, arg1)
+}
+
+// DeleteMailboxDelegate mocks base method.
+func (m *MockEmailServiceServer) DeleteMailboxDelegate(arg0 context.Context, arg1 *proto.MailboxDelegateRequest) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailboxDelegate", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailboxDelegate indicates an expected call of DeleteMailboxDelegate.
+func (mr *MockEmailServiceServerMockRecorder) DeleteMailboxDelegate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailboxDelegate", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteMailboxDelegate), arg0, arg1)
+}
+
+// DeleteMailingList mocks base method.
+func (m *MockEmailServiceServer) DeleteMailingList(arg0 context.Context, arg1 *proto.MailingListIdAndLogin) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailingList", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingList indicates an expected call of DeleteMailingList.
+func (mr *MockEmailServiceServerMockRecorder) DeleteMailingList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingList", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteMailingList), arg0, arg1)
+}
+
+// DeleteMailingListMember mocks base method.
+func (m *MockEmailServiceServer) DeleteMailingListMember(arg0 context.Context, arg1 *proto.MailingListMemberRequest) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailingListMember", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingListMember indicates an expected call of DeleteMailingListMember.
+func (mr *MockEmailServiceServerMockRecorder) DeleteMailingListMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingListMember", reflect.TypeOf((*MockEmailServiceServer)(nil).DeleteMailingListMember), arg0, arg1)
+}
+
+// GetAllEmailsInLabel mocks base method.
+func (m *MockEmailServiceServer) GetAllEmailsInLabel(arg0 context.Context, arg1 *proto.LabelNameAndLogin) (*proto.Emails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllEmailsInLabel", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Emails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllEmailsInLabel indicates an expected call of GetAllEmailsInLabel.
+func (mr *MockEmailServiceServerMockRecorder) GetAllEmailsInLabel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmailsInLabel", reflect.TypeOf((*MockEmailServiceServer)(nil).GetAllEmailsInLabel), arg0, arg1)
+}
+
 // GetAllIncoming mocks base method.
 func (m *MockEmailServiceServer) GetAllIncoming(arg0 context.Context, arg1 *proto.LoginOffsetLimit) (*proto.Emails, error) {
 	m.ctrl.T.Helper()
@@ -584,6 +1489,36 @@ func (mr *MockEmailServiceServerMockRecorder) GetAllSent(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSent", reflect.TypeOf((*MockEmailServiceServer)(nil).GetAllSent), arg0, arg1)
 }
 
+// GetDelegateActions mocks base method.
+func (m *MockEmailServiceServer) GetDelegateActions(arg0 context.Context, arg1 *proto.MailboxAndLogin) (*proto.DelegateActions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegateActions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DelegateActions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegateActions indicates an expected call of GetDelegateActions.
+func (mr *MockEmailServiceServerMockRecorder) GetDelegateActions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateActions", reflect.TypeOf((*MockEmailServiceServer)(nil).GetDelegateActions), arg0, arg1)
+}
+
+// GetDelegatedMailboxes mocks base method.
+func (m *MockEmailServiceServer) GetDelegatedMailboxes(arg0 context.Context, arg1 *proto.LoginOffsetLimit) (*proto.MailboxDelegates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatedMailboxes", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MailboxDelegates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegatedMailboxes indicates an expected call of GetDelegatedMailboxes.
+func (mr *MockEmailServiceServerMockRecorder) GetDelegatedMailboxes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatedMailboxes", reflect.TypeOf((*MockEmailServiceServer)(nil).GetDelegatedMailboxes), arg0, arg1)
+}
+
 // GetDraftEmails mocks base method.
 func (m *MockEmailServiceServer) GetDraftEmails(arg0 context.Context, arg1 *proto.LoginOffsetLimit) (*proto.Emails, error) {
 	m.ctrl.T.Helper()
@@ -614,6 +1549,21 @@ func (mr *MockEmailServiceServerMockRecorder) GetEmailByID(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailByID", reflect.TypeOf((*MockEmailServiceServer)(nil).GetEmailByID), arg0, arg1)
 }
 
+// GetEmailLabels mocks base method.
+func (m *MockEmailServiceServer) GetEmailLabels(arg0 context.Context, arg1 *proto.EmailIdAndLogin) (*proto.Labels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailLabels", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Labels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailLabels indicates an expected call of GetEmailLabels.
+func (mr *MockEmailServiceServerMockRecorder) GetEmailLabels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailLabels", reflect.TypeOf((*MockEmailServiceServer)(nil).GetEmailLabels), arg0, arg1)
+}
+
 // GetFileByID mocks base method.
 func (m *MockEmailServiceServer) GetFileByID(arg0 context.Context, arg1 *proto.GetFileByIDRequest) (*proto.GetFileByIDReply, error) {
 	m.ctrl.T.Helper()
@@ -644,6 +1594,156 @@ func (mr *MockEmailServiceServerMockRecorder) GetFilesByEmailID(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesByEmailID", reflect.TypeOf((*MockEmailServiceServer)(nil).GetFilesByEmailID), arg0, arg1)
 }
 
+// GetGMailLabels mocks base method.
+func (m *MockEmailServiceServer) GetGMailLabels(arg0 context.Context, arg1 *proto.GMailLogin) (*proto.GMailLabels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailLabels", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GMailLabels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailLabels indicates an expected call of GetGMailLabels.
+func (mr *MockEmailServiceServerMockRecorder) GetGMailLabels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLabels", reflect.TypeOf((*MockEmailServiceServer)(nil).GetGMailLabels), arg0, arg1)
+}
+
+// GetGMailLogins mocks base method.
+func (m *MockEmailServiceServer) GetGMailLogins(arg0 context.Context, arg1 *proto.EmptyEmail) (*proto.GMailLogins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailLogins", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GMailLogins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailLogins indicates an expected call of GetGMailLogins.
+func (mr *MockEmailServiceServerMockRecorder) GetGMailLogins(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLogins", reflect.TypeOf((*MockEmailServiceServer)(nil).GetGMailLogins), arg0, arg1)
+}
+
+// GetGMailMessages mocks base method.
+func (m *MockEmailServiceServer) GetGMailMessages(arg0 context.Context, arg1 *proto.GMailLabelAndLogin) (*proto.GMailMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailMessages", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GMailMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailMessages indicates an expected call of GetGMailMessages.
+func (mr *MockEmailServiceServerMockRecorder) GetGMailMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailMessages", reflect.TypeOf((*MockEmailServiceServer)(nil).GetGMailMessages), arg0, arg1)
+}
+
+// GetGMailSyncState mocks base method.
+func (m *MockEmailServiceServer) GetGMailSyncState(arg0 context.Context, arg1 *proto.GMailLogin) (*proto.GMailSyncState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailSyncState", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GMailSyncState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailSyncState indicates an expected call of GetGMailSyncState.
+func (mr *MockEmailServiceServerMockRecorder) GetGMailSyncState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailSyncState", reflect.TypeOf((*MockEmailServiceServer)(nil).GetGMailSyncState), arg0, arg1)
+}
+
+// GetLabels mocks base method.
+func (m *MockEmailServiceServer) GetLabels(arg0 context.Context, arg1 *proto.LoginOffsetLimit) (*proto.Labels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabels", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Labels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabels indicates an expected call of GetLabels.
+func (mr *MockEmailServiceServerMockRecorder) GetLabels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabels", reflect.TypeOf((*MockEmailServiceServer)(nil).GetLabels), arg0, arg1)
+}
+
+// GetMailboxDelegates mocks base method.
+func (m *MockEmailServiceServer) GetMailboxDelegates(arg0 context.Context, arg1 *proto.MailboxAndLogin) (*proto.MailboxDelegates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailboxDelegates", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MailboxDelegates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailboxDelegates indicates an expected call of GetMailboxDelegates.
+func (mr *MockEmailServiceServerMockRecorder) GetMailboxDelegates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxDelegates", reflect.TypeOf((*MockEmailServiceServer)(nil).GetMailboxDelegates), arg0, arg1)
+}
+
+// GetMailingListByID mocks base method.
+func (m *MockEmailServiceServer) GetMailingListByID(arg0 context.Context, arg1 *proto.MailingListIdAndLogin) (*proto.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListByID", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListByID indicates an expected call of GetMailingListByID.
+func (mr *MockEmailServiceServerMockRecorder) GetMailingListByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListByID", reflect.TypeOf((*MockEmailServiceServer)(nil).GetMailingListByID), arg0, arg1)
+}
+
+// GetMailingListMembers mocks base method.
+func (m *MockEmailServiceServer) GetMailingListMembers(arg0 context.Context, arg1 *proto.MailingListIdAndLogin) (*proto.MailingListMembers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListMembers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MailingListMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListMembers indicates an expected call of GetMailingListMembers.
+func (mr *MockEmailServiceServerMockRecorder) GetMailingListMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListMembers", reflect.TypeOf((*MockEmailServiceServer)(nil).GetMailingListMembers), arg0, arg1)
+}
+
+// GetMailingListModeration mocks base method.
+func (m *MockEmailServiceServer) GetMailingListModeration(arg0 context.Context, arg1 *proto.MailingListIdAndLogin) (*proto.Emails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListModeration", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Emails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListModeration indicates an expected call of GetMailingListModeration.
+func (mr *MockEmailServiceServerMockRecorder) GetMailingListModeration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListModeration", reflect.TypeOf((*MockEmailServiceServer)(nil).GetMailingListModeration), arg0, arg1)
+}
+
+// GetMailingLists mocks base method.
+func (m *MockEmailServiceServer) GetMailingLists(arg0 context.Context, arg1 *proto.LoginOffsetLimit) (*proto.MailingLists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingLists", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MailingLists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingLists indicates an expected call of GetMailingLists.
+func (mr *MockEmailServiceServerMockRecorder) GetMailingLists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingLists", reflect.TypeOf((*MockEmailServiceServer)(nil).GetMailingLists), arg0, arg1)
+}
+
 // GetSpamEmails mocks base method.
 func (m *MockEmailServiceServer) GetSpamEmails(arg0 context.Context, arg1 *proto.LoginOffsetLimit) (*proto.Emails, error) {
 	m.ctrl.T.Helper()
@@ -659,6 +1759,51 @@ func (mr *MockEmailServiceServerMockRecorder) GetSpamEmails(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpamEmails", reflect.TypeOf((*MockEmailServiceServer)(nil).GetSpamEmails), arg0, arg1)
 }
 
+// GetUnreadCount mocks base method.
+func (m *MockEmailServiceServer) GetUnreadCount(arg0 context.Context, arg1 *proto.LoginOffsetLimit) (*proto.UnreadCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UnreadCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockEmailServiceServerMockRecorder) GetUnreadCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockEmailServiceServer)(nil).GetUnreadCount), arg0, arg1)
+}
+
+// ModerateMailingListEmail mocks base method.
+func (m *MockEmailServiceServer) ModerateMailingListEmail(arg0 context.Context, arg1 *proto.ModerateEmailRequest) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateMailingListEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateMailingListEmail indicates an expected call of ModerateMailingListEmail.
+func (mr *MockEmailServiceServerMockRecorder) ModerateMailingListEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateMailingListEmail", reflect.TypeOf((*MockEmailServiceServer)(nil).ModerateMailingListEmail), arg0, arg1)
+}
+
+// UnsubscribeMailingList mocks base method.
+func (m *MockEmailServiceServer) UnsubscribeMailingList(arg0 context.Context, arg1 *proto.ListUnsubscribeToken) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeMailingList", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeMailingList indicates an expected call of UnsubscribeMailingList.
+func (mr *MockEmailServiceServerMockRecorder) UnsubscribeMailingList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeMailingList", reflect.TypeOf((*MockEmailServiceServer)(nil).UnsubscribeMailingList), arg0, arg1)
+}
+
 // UpdateEmail mocks base method.
 func (m *MockEmailServiceServer) UpdateEmail(arg0 context.Context, arg1 *proto.Email) (*proto.StatusEmail, error) {
 	m.ctrl.T.Helper()
@@ -689,6 +1834,51 @@ func (mr *MockEmailServiceServerMockRecorder) UpdateFileByID(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileByID", reflect.TypeOf((*MockEmailServiceServer)(nil).UpdateFileByID), arg0, arg1)
 }
 
+// UpdateGMailMessageLabels mocks base method.
+func (m *MockEmailServiceServer) UpdateGMailMessageLabels(arg0 context.Context, arg1 *proto.GMailMessageLabels) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGMailMessageLabels", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGMailMessageLabels indicates an expected call of UpdateGMailMessageLabels.
+func (mr *MockEmailServiceServerMockRecorder) UpdateGMailMessageLabels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGMailMessageLabels", reflect.TypeOf((*MockEmailServiceServer)(nil).UpdateGMailMessageLabels), arg0, arg1)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockEmailServiceServer) UpdateLabel(arg0 context.Context, arg1 *proto.LabelWithLogin) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockEmailServiceServerMockRecorder) UpdateLabel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockEmailServiceServer)(nil).UpdateLabel), arg0, arg1)
+}
+
+// UpdateMailingList mocks base method.
+func (m *MockEmailServiceServer) UpdateMailingList(arg0 context.Context, arg1 *proto.MailingListWithLogin) (*proto.StatusEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMailingList", arg0, arg1)
+	ret0, _ := ret[0].(*proto.StatusEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMailingList indicates an expected call of UpdateMailingList.
+func (mr *MockEmailServiceServerMockRecorder) UpdateMailingList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMailingList", reflect.TypeOf((*MockEmailServiceServer)(nil).UpdateMailingList), arg0, arg1)
+}
+
 // mustEmbedUnimplementedEmailServiceServer mocks base method.
 func (m *MockEmailServiceServer) mustEmbedUnimplementedEmailServiceServer() {
 	m.ctrl.T.Helper()
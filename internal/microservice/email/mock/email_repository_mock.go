@@ -8,6 +8,7 @@ import (
 	context "context"
 	domain_models "mail/internal/microservice/models/domain_models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -65,6 +66,34 @@ func (mr *MockEmailRepositoryMockRecorder) AddAttachment(emailID, fileID, ctx in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttachment", reflect.TypeOf((*MockEmailRepository)(nil).AddAttachment), emailID, fileID, ctx)
 }
 
+// AddDelegateAction mocks base method.
+func (m *MockEmailRepository) AddDelegateAction(action *domain_models.DelegateAction, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDelegateAction", action, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDelegateAction indicates an expected call of AddDelegateAction.
+func (mr *MockEmailRepositoryMockRecorder) AddDelegateAction(action, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDelegateAction", reflect.TypeOf((*MockEmailRepository)(nil).AddDelegateAction), action, ctx)
+}
+
+// AddEmailsInLabel mocks base method.
+func (m *MockEmailRepository) AddEmailsInLabel(labelID uint32, emailIDs []uint64, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEmailsInLabel", labelID, emailIDs, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEmailsInLabel indicates an expected call of AddEmailsInLabel.
+func (mr *MockEmailRepositoryMockRecorder) AddEmailsInLabel(labelID, emailIDs, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailsInLabel", reflect.TypeOf((*MockEmailRepository)(nil).AddEmailsInLabel), labelID, emailIDs, ctx)
+}
+
 // AddFile mocks base method.
 func (m *MockEmailRepository) AddFile(fileID, fileType, fileName, fileSize string, ctx context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +109,48 @@ func (mr *MockEmailRepositoryMockRecorder) AddFile(fileID, fileType, fileName, f
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFile", reflect.TypeOf((*MockEmailRepository)(nil).AddFile), fileID, fileType, fileName, fileSize, ctx)
 }
 
+// AddMailboxDelegate mocks base method.
+func (m *MockEmailRepository) AddMailboxDelegate(mailbox, delegate, role string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMailboxDelegate", mailbox, delegate, role, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMailboxDelegate indicates an expected call of AddMailboxDelegate.
+func (mr *MockEmailRepositoryMockRecorder) AddMailboxDelegate(mailbox, delegate, role, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailboxDelegate", reflect.TypeOf((*MockEmailRepository)(nil).AddMailboxDelegate), mailbox, delegate, role, ctx)
+}
+
+// AddMailingListMember mocks base method.
+func (m *MockEmailRepository) AddMailingListMember(listID uint32, login, role string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMailingListMember", listID, login, role, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMailingListMember indicates an expected call of AddMailingListMember.
+func (mr *MockEmailRepositoryMockRecorder) AddMailingListMember(listID, login, role, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailingListMember", reflect.TypeOf((*MockEmailRepository)(nil).AddMailingListMember), listID, login, role, ctx)
+}
+
+// AddMailingListModeration mocks base method.
+func (m *MockEmailRepository) AddMailingListModeration(listID uint32, emailID uint64, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMailingListModeration", listID, emailID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMailingListModeration indicates an expected call of AddMailingListModeration.
+func (mr *MockEmailRepositoryMockRecorder) AddMailingListModeration(listID, emailID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailingListModeration", reflect.TypeOf((*MockEmailRepository)(nil).AddMailingListModeration), listID, emailID, ctx)
+}
+
 // AddProfileEmail mocks base method.
 func (m *MockEmailRepository) AddProfileEmail(email_id uint64, sender, recipient string, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -94,6 +165,20 @@ func (mr *MockEmailRepositoryMockRecorder) AddProfileEmail(email_id, sender, rec
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProfileEmail", reflect.TypeOf((*MockEmailRepository)(nil).AddProfileEmail), email_id, sender, recipient, ctx)
 }
 
+// AddProfileEmailList mocks base method.
+func (m *MockEmailRepository) AddProfileEmailList(emailID uint64, sender string, listID uint32, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProfileEmailList", emailID, sender, listID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProfileEmailList indicates an expected call of AddProfileEmailList.
+func (mr *MockEmailRepositoryMockRecorder) AddProfileEmailList(emailID, sender, listID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProfileEmailList", reflect.TypeOf((*MockEmailRepository)(nil).AddProfileEmailList), emailID, sender, listID, ctx)
+}
+
 // AddProfileEmailMyself mocks base method.
 func (m *MockEmailRepository) AddProfileEmailMyself(email_id uint64, login string, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -108,6 +193,95 @@ func (mr *MockEmailRepositoryMockRecorder) AddProfileEmailMyself(email_id, login
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProfileEmailMyself", reflect.TypeOf((*MockEmailRepository)(nil).AddProfileEmailMyself), email_id, login, ctx)
 }
 
+// ApplyGMailSync mocks base method.
+func (m *MockEmailRepository) ApplyGMailSync(sync *domain_models.GMailSync, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyGMailSync", sync, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyGMailSync indicates an expected call of ApplyGMailSync.
+func (mr *MockEmailRepositoryMockRecorder) ApplyGMailSync(sync, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyGMailSync", reflect.TypeOf((*MockEmailRepository)(nil).ApplyGMailSync), sync, ctx)
+}
+
+// BulkEmails mocks base method.
+func (m *MockEmailRepository) BulkEmails(request *domain_models.BulkRequest, login string, ctx context.Context) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkEmails", request, login, ctx)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkEmails indicates an expected call of BulkEmails.
+func (mr *MockEmailRepositoryMockRecorder) BulkEmails(request, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkEmails", reflect.TypeOf((*MockEmailRepository)(nil).BulkEmails), request, login, ctx)
+}
+
+// CheckEmailsProfile mocks base method.
+func (m *MockEmailRepository) CheckEmailsProfile(emailIDs []uint64, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEmailsProfile", emailIDs, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckEmailsProfile indicates an expected call of CheckEmailsProfile.
+func (mr *MockEmailRepositoryMockRecorder) CheckEmailsProfile(emailIDs, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEmailsProfile", reflect.TypeOf((*MockEmailRepository)(nil).CheckEmailsProfile), emailIDs, login, ctx)
+}
+
+// CountUnreadIncoming mocks base method.
+func (m *MockEmailRepository) CountUnreadIncoming(login string, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadIncoming", login, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadIncoming indicates an expected call of CountUnreadIncoming.
+func (mr *MockEmailRepositoryMockRecorder) CountUnreadIncoming(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadIncoming", reflect.TypeOf((*MockEmailRepository)(nil).CountUnreadIncoming), login, ctx)
+}
+
+// CreateLabel mocks base method.
+func (m *MockEmailRepository) CreateLabel(label *domain_models.Label, login string, ctx context.Context) (*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", label, login, ctx)
+	ret0, _ := ret[0].(*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockEmailRepositoryMockRecorder) CreateLabel(label, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockEmailRepository)(nil).CreateLabel), label, login, ctx)
+}
+
+// CreateMailingList mocks base method.
+func (m *MockEmailRepository) CreateMailingList(list *domain_models.MailingList, ownerLogin string, ctx context.Context) (*domain_models.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMailingList", list, ownerLogin, ctx)
+	ret0, _ := ret[0].(*domain_models.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMailingList indicates an expected call of CreateMailingList.
+func (mr *MockEmailRepositoryMockRecorder) CreateMailingList(list, ownerLogin, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMailingList", reflect.TypeOf((*MockEmailRepository)(nil).CreateMailingList), list, ownerLogin, ctx)
+}
+
 // Delete mocks base method.
 func (m *MockEmailRepository) Delete(id uint64, login string, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +297,21 @@ func (mr *MockEmailRepositoryMockRecorder) Delete(id, login, ctx interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmailRepository)(nil).Delete), id, login, ctx)
 }
 
+// DeleteEmailsInLabel mocks base method.
+func (m *MockEmailRepository) DeleteEmailsInLabel(labelID uint32, emailIDs []uint64, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailsInLabel", labelID, emailIDs, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEmailsInLabel indicates an expected call of DeleteEmailsInLabel.
+func (mr *MockEmailRepositoryMockRecorder) DeleteEmailsInLabel(labelID, emailIDs, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailsInLabel", reflect.TypeOf((*MockEmailRepository)(nil).DeleteEmailsInLabel), labelID, emailIDs, ctx)
+}
+
 // DeleteFileByID mocks base method.
 func (m *MockEmailRepository) DeleteFileByID(fileID uint64, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -137,6 +326,96 @@ func (mr *MockEmailRepositoryMockRecorder) DeleteFileByID(fileID, ctx interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileByID", reflect.TypeOf((*MockEmailRepository)(nil).DeleteFileByID), fileID, ctx)
 }
 
+// DeleteGMailMessage mocks base method.
+func (m *MockEmailRepository) DeleteGMailMessage(login, id string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGMailMessage", login, id, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGMailMessage indicates an expected call of DeleteGMailMessage.
+func (mr *MockEmailRepositoryMockRecorder) DeleteGMailMessage(login, id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGMailMessage", reflect.TypeOf((*MockEmailRepository)(nil).DeleteGMailMessage), login, id, ctx)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockEmailRepository) DeleteLabel(id uint32, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", id, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockEmailRepositoryMockRecorder) DeleteLabel(id, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockEmailRepository)(nil).DeleteLabel), id, login, ctx)
+}
+
+// DeleteMailboxDelegate mocks base method.
+func (m *MockEmailRepository) DeleteMailboxDelegate(mailbox, delegate string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailboxDelegate", mailbox, delegate, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailboxDelegate indicates an expected call of DeleteMailboxDelegate.
+func (mr *MockEmailRepositoryMockRecorder) DeleteMailboxDelegate(mailbox, delegate, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailboxDelegate", reflect.TypeOf((*MockEmailRepository)(nil).DeleteMailboxDelegate), mailbox, delegate, ctx)
+}
+
+// DeleteMailingList mocks base method.
+func (m *MockEmailRepository) DeleteMailingList(id uint32, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailingList", id, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingList indicates an expected call of DeleteMailingList.
+func (mr *MockEmailRepositoryMockRecorder) DeleteMailingList(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingList", reflect.TypeOf((*MockEmailRepository)(nil).DeleteMailingList), id, ctx)
+}
+
+// DeleteMailingListMember mocks base method.
+func (m *MockEmailRepository) DeleteMailingListMember(listID uint32, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailingListMember", listID, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingListMember indicates an expected call of DeleteMailingListMember.
+func (mr *MockEmailRepositoryMockRecorder) DeleteMailingListMember(listID, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingListMember", reflect.TypeOf((*MockEmailRepository)(nil).DeleteMailingListMember), listID, login, ctx)
+}
+
+// DeleteMailingListModeration mocks base method.
+func (m *MockEmailRepository) DeleteMailingListModeration(listID uint32, emailID uint64, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailingListModeration", listID, emailID, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingListModeration indicates an expected call of DeleteMailingListModeration.
+func (mr *MockEmailRepositoryMockRecorder) DeleteMailingListModeration(listID, emailID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingListModeration", reflect.TypeOf((*MockEmailRepository)(nil).DeleteMailingListModeration), listID, emailID, ctx)
+}
+
 // FindEmail mocks base method.
 func (m *MockEmailRepository) FindEmail(login string, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -166,6 +445,21 @@ func (mr *MockEmailRepositoryMockRecorder) GetAllDraft(login, offset, limit, ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDraft", reflect.TypeOf((*MockEmailRepository)(nil).GetAllDraft), login, offset, limit, ctx)
 }
 
+// GetAllEmailsInLabel mocks base method.
+func (m *MockEmailRepository) GetAllEmailsInLabel(labelID uint32, login string, ctx context.Context) ([]*domain_models.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllEmailsInLabel", labelID, login, ctx)
+	ret0, _ := ret[0].([]*domain_models.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllEmailsInLabel indicates an expected call of GetAllEmailsInLabel.
+func (mr *MockEmailRepositoryMockRecorder) GetAllEmailsInLabel(labelID, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmailsInLabel", reflect.TypeOf((*MockEmailRepository)(nil).GetAllEmailsInLabel), labelID, login, ctx)
+}
+
 // GetAllIncoming mocks base method.
 func (m *MockEmailRepository) GetAllIncoming(login string, offset, limit int64, ctx context.Context) ([]*domain_models.Email, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +535,36 @@ func (mr *MockEmailRepositoryMockRecorder) GetByID(id, login, ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockEmailRepository)(nil).GetByID), id, login, ctx)
 }
 
+// GetDelegateActions mocks base method.
+func (m *MockEmailRepository) GetDelegateActions(mailbox string, offset, limit int64, ctx context.Context) ([]*domain_models.DelegateAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegateActions", mailbox, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.DelegateAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegateActions indicates an expected call of GetDelegateActions.
+func (mr *MockEmailRepositoryMockRecorder) GetDelegateActions(mailbox, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateActions", reflect.TypeOf((*MockEmailRepository)(nil).GetDelegateActions), mailbox, offset, limit, ctx)
+}
+
+// GetDelegatedMailboxes mocks base method.
+func (m *MockEmailRepository) GetDelegatedMailboxes(delegate string, offset, limit int64, ctx context.Context) ([]*domain_models.MailboxDelegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatedMailboxes", delegate, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailboxDelegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegatedMailboxes indicates an expected call of GetDelegatedMailboxes.
+func (mr *MockEmailRepositoryMockRecorder) GetDelegatedMailboxes(delegate, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatedMailboxes", reflect.TypeOf((*MockEmailRepository)(nil).GetDelegatedMailboxes), delegate, offset, limit, ctx)
+}
+
 // GetFileByID mocks base method.
 func (m *MockEmailRepository) GetFileByID(id uint64, ctx context.Context) (*domain_models.File, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +595,247 @@ func (mr *MockEmailRepositoryMockRecorder) GetFilesByEmailID(emailID, ctx interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesByEmailID", reflect.TypeOf((*MockEmailRepository)(nil).GetFilesByEmailID), emailID, ctx)
 }
 
+// GetGMailHistoryID mocks base method.
+func (m *MockEmailRepository) GetGMailHistoryID(login string, ctx context.Context) (uint64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailHistoryID", login, ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGMailHistoryID indicates an expected call of GetGMailHistoryID.
+func (mr *MockEmailRepositoryMockRecorder) GetGMailHistoryID(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailHistoryID", reflect.TypeOf((*MockEmailRepository)(nil).GetGMailHistoryID), login, ctx)
+}
+
+// GetGMailLabels mocks base method.
+func (m *MockEmailRepository) GetGMailLabels(login string, ctx context.Context) ([]*domain_models.GMailLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailLabels", login, ctx)
+	ret0, _ := ret[0].([]*domain_models.GMailLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailLabels indicates an expected call of GetGMailLabels.
+func (mr *MockEmailRepositoryMockRecorder) GetGMailLabels(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLabels", reflect.TypeOf((*MockEmailRepository)(nil).GetGMailLabels), login, ctx)
+}
+
+// GetGMailLogins mocks base method.
+func (m *MockEmailRepository) GetGMailLogins(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailLogins", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailLogins indicates an expected call of GetGMailLogins.
+func (mr *MockEmailRepositoryMockRecorder) GetGMailLogins(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLogins", reflect.TypeOf((*MockEmailRepository)(nil).GetGMailLogins), ctx)
+}
+
+// GetGMailMessages mocks base method.
+func (m *MockEmailRepository) GetGMailMessages(login, labelID string, beforeDate time.Time, beforeID string, limit int64, ctx context.Context) ([]*domain_models.GMailMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailMessages", login, labelID, beforeDate, beforeID, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.GMailMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailMessages indicates an expected call of GetGMailMessages.
+func (mr *MockEmailRepositoryMockRecorder) GetGMailMessages(login, labelID, beforeDate, beforeID, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailMessages", reflect.TypeOf((*MockEmailRepository)(nil).GetGMailMessages), login, labelID, beforeDate, beforeID, limit, ctx)
+}
+
+// GetLabelByID mocks base method.
+func (m *MockEmailRepository) GetLabelByID(id uint32, login string, ctx context.Context) (*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelByID", id, login, ctx)
+	ret0, _ := ret[0].(*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelByID indicates an expected call of GetLabelByID.
+func (mr *MockEmailRepositoryMockRecorder) GetLabelByID(id, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelByID", reflect.TypeOf((*MockEmailRepository)(nil).GetLabelByID), id, login, ctx)
+}
+
+// GetLabelByName mocks base method.
+func (m *MockEmailRepository) GetLabelByName(name, login string, ctx context.Context) (*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelByName", name, login, ctx)
+	ret0, _ := ret[0].(*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelByName indicates an expected call of GetLabelByName.
+func (mr *MockEmailRepositoryMockRecorder) GetLabelByName(name, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelByName", reflect.TypeOf((*MockEmailRepository)(nil).GetLabelByName), name, login, ctx)
+}
+
+// GetLabelsByEmails mocks base method.
+func (m *MockEmailRepository) GetLabelsByEmails(emailIDs []uint64, login string, ctx context.Context) (map[uint64][]*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelsByEmails", emailIDs, login, ctx)
+	ret0, _ := ret[0].(map[uint64][]*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelsByEmails indicates an expected call of GetLabelsByEmails.
+func (mr *MockEmailRepositoryMockRecorder) GetLabelsByEmails(emailIDs, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelsByEmails", reflect.TypeOf((*MockEmailRepository)(nil).GetLabelsByEmails), emailIDs, login, ctx)
+}
+
+// GetLabelsByLogin mocks base method.
+func (m *MockEmailRepository) GetLabelsByLogin(login string, offset, limit int64, ctx context.Context) ([]*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelsByLogin", login, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelsByLogin indicates an expected call of GetLabelsByLogin.
+func (mr *MockEmailRepositoryMockRecorder) GetLabelsByLogin(login, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelsByLogin", reflect.TypeOf((*MockEmailRepository)(nil).GetLabelsByLogin), login, offset, limit, ctx)
+}
+
+// GetMailboxDelegateRole mocks base method.
+func (m *MockEmailRepository) GetMailboxDelegateRole(mailbox, delegate string, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailboxDelegateRole", mailbox, delegate, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailboxDelegateRole indicates an expected call of GetMailboxDelegateRole.
+func (mr *MockEmailRepositoryMockRecorder) GetMailboxDelegateRole(mailbox, delegate, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxDelegateRole", reflect.TypeOf((*MockEmailRepository)(nil).GetMailboxDelegateRole), mailbox, delegate, ctx)
+}
+
+// GetMailboxDelegates mocks base method.
+func (m *MockEmailRepository) GetMailboxDelegates(mailbox string, ctx context.Context) ([]*domain_models.MailboxDelegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailboxDelegates", mailbox, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailboxDelegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailboxDelegates indicates an expected call of GetMailboxDelegates.
+func (mr *MockEmailRepositoryMockRecorder) GetMailboxDelegates(mailbox, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxDelegates", reflect.TypeOf((*MockEmailRepository)(nil).GetMailboxDelegates), mailbox, ctx)
+}
+
+// GetMailingListByAddress mocks base method.
+func (m *MockEmailRepository) GetMailingListByAddress(address string, ctx context.Context) (*domain_models.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListByAddress", address, ctx)
+	ret0, _ := ret[0].(*domain_models.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListByAddress indicates an expected call of GetMailingListByAddress.
+func (mr *MockEmailRepositoryMockRecorder) GetMailingListByAddress(address, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListByAddress", reflect.TypeOf((*MockEmailRepository)(nil).GetMailingListByAddress), address, ctx)
+}
+
+// GetMailingListByID mocks base method.
+func (m *MockEmailRepository) GetMailingListByID(id uint32, ctx context.Context) (*domain_models.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListByID", id, ctx)
+	ret0, _ := ret[0].(*domain_models.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListByID indicates an expected call of GetMailingListByID.
+func (mr *MockEmailRepositoryMockRecorder) GetMailingListByID(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListByID", reflect.TypeOf((*MockEmailRepository)(nil).GetMailingListByID), id, ctx)
+}
+
+// GetMailingListMemberRole mocks base method.
+func (m *MockEmailRepository) GetMailingListMemberRole(listID uint32, login string, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListMemberRole", listID, login, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListMemberRole indicates an expected call of GetMailingListMemberRole.
+func (mr *MockEmailRepositoryMockRecorder) GetMailingListMemberRole(listID, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListMemberRole", reflect.TypeOf((*MockEmailRepository)(nil).GetMailingListMemberRole), listID, login, ctx)
+}
+
+// GetMailingListMembers mocks base method.
+func (m *MockEmailRepository) GetMailingListMembers(listID uint32, ctx context.Context) ([]*domain_models.MailingListMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListMembers", listID, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailingListMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListMembers indicates an expected call of GetMailingListMembers.
+func (mr *MockEmailRepositoryMockRecorder) GetMailingListMembers(listID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListMembers", reflect.TypeOf((*MockEmailRepository)(nil).GetMailingListMembers), listID, ctx)
+}
+
+// GetMailingListModeration mocks base method.
+func (m *MockEmailRepository) GetMailingListModeration(listID uint32, ctx context.Context) ([]*domain_models.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListModeration", listID, ctx)
+	ret0, _ := ret[0].([]*domain_models.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListModeration indicates an expected call of GetMailingListModeration.
+func (mr *MockEmailRepositoryMockRecorder) GetMailingListModeration(listID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListModeration", reflect.TypeOf((*MockEmailRepository)(nil).GetMailingListModeration), listID, ctx)
+}
+
+// GetMailingListsByLogin mocks base method.
+func (m *MockEmailRepository) GetMailingListsByLogin(login string, offset, limit int64, ctx context.Context) ([]*domain_models.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListsByLogin", login, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListsByLogin indicates an expected call of GetMailingListsByLogin.
+func (mr *MockEmailRepositoryMockRecorder) GetMailingListsByLogin(login, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListsByLogin", reflect.TypeOf((*MockEmailRepository)(nil).GetMailingListsByLogin), login, offset, limit, ctx)
+}
+
 // Update mocks base method.
 func (m *MockEmailRepository) Update(newEmail *domain_models.Email, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -299,3 +864,48 @@ func (mr *MockEmailRepositoryMockRecorder) UpdateFileByID(fileID, newFileID, new
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileByID", reflect.TypeOf((*MockEmailRepository)(nil).UpdateFileByID), fileID, newFileID, newFileType, newFileName, newFileSize, ctx)
 }
+
+// UpdateGMailMessageLabels mocks base method.
+func (m *MockEmailRepository) UpdateGMailMessageLabels(login, id string, addLabelIDs, removeLabelIDs []string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGMailMessageLabels", login, id, addLabelIDs, removeLabelIDs, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGMailMessageLabels indicates an expected call of UpdateGMailMessageLabels.
+func (mr *MockEmailRepositoryMockRecorder) UpdateGMailMessageLabels(login, id, addLabelIDs, removeLabelIDs, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGMailMessageLabels", reflect.TypeOf((*MockEmailRepository)(nil).UpdateGMailMessageLabels), login, id, addLabelIDs, removeLabelIDs, ctx)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockEmailRepository) UpdateLabel(label *domain_models.Label, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", label, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockEmailRepositoryMockRecorder) UpdateLabel(label, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockEmailRepository)(nil).UpdateLabel), label, login, ctx)
+}
+
+// UpdateMailingList mocks base method.
+func (m *MockEmailRepository) UpdateMailingList(list *domain_models.MailingList, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMailingList", list, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMailingList indicates an expected call of UpdateMailingList.
+func (mr *MockEmailRepositoryMockRecorder) UpdateMailingList(list, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMailingList", reflect.TypeOf((*MockEmailRepository)(nil).UpdateMailingList), list, ctx)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	domain_models "mail/internal/microservice/models/domain_models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -50,6 +51,35 @@ func (mr *MockEmailUseCaseMockRecorder) AddAttachment(fileID, fileType, fileName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttachment", reflect.TypeOf((*MockEmailUseCase)(nil).AddAttachment), fileID, fileType, fileName, fileSize, emailID, ctx)
 }
 
+// AddDelegateAction mocks base method.
+func (m *MockEmailUseCase) AddDelegateAction(action *domain_models.DelegateAction, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDelegateAction", action, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDelegateAction indicates an expected call of AddDelegateAction.
+func (mr *MockEmailUseCaseMockRecorder) AddDelegateAction(action, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDelegateAction", reflect.TypeOf((*MockEmailUseCase)(nil).AddDelegateAction), action, ctx)
+}
+
+// AddEmailsInLabel mocks base method.
+func (m *MockEmailUseCase) AddEmailsInLabel(labelID uint32, emailIDs []uint64, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEmailsInLabel", labelID, emailIDs, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEmailsInLabel indicates an expected call of AddEmailsInLabel.
+func (mr *MockEmailUseCaseMockRecorder) AddEmailsInLabel(labelID, emailIDs, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmailsInLabel", reflect.TypeOf((*MockEmailUseCase)(nil).AddEmailsInLabel), labelID, emailIDs, login, ctx)
+}
+
 // AddFile mocks base method.
 func (m *MockEmailUseCase) AddFile(fileID, fileType, fileName, fileSize string, ctx context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -79,18 +109,77 @@ func (mr *MockEmailUseCaseMockRecorder) AddFileToEmail(emailID, fileID, ctx inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileToEmail", reflect.TypeOf((*MockEmailUseCase)(nil).AddFileToEmail), emailID, fileID, ctx)
 }
 
+// AddMailboxDelegate mocks base method.
+func (m *MockEmailUseCase) AddMailboxDelegate(mailbox, login, delegate, role string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMailboxDelegate", mailbox, login, delegate, role, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMailboxDelegate indicates an expected call of AddMailboxDelegate.
+func (mr *MockEmailUseCaseMockRecorder) AddMailboxDelegate(mailbox, login, delegate, role, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailboxDelegate", reflect.TypeOf((*MockEmailUseCase)(nil).AddMailboxDelegate), mailbox, login, delegate, role, ctx)
+}
+
+// AddMailingListMember mocks base method.
+func (m *MockEmailUseCase) AddMailingListMember(id uint32, login, memberLogin, role string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMailingListMember", id, login, memberLogin, role, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMailingListMember indicates an expected call of AddMailingListMember.
+func (mr *MockEmailUseCaseMockRecorder) AddMailingListMember(id, login, memberLogin, role, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMailingListMember", reflect.TypeOf((*MockEmailUseCase)(nil).AddMailingListMember), id, login, memberLogin, role, ctx)
+}
+
+// ApplyGMailSync mocks base method.
+func (m *MockEmailUseCase) ApplyGMailSync(sync *domain_models.GMailSync, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyGMailSync", sync, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyGMailSync indicates an expected call of ApplyGMailSync.
+func (mr *MockEmailUseCaseMockRecorder) ApplyGMailSync(sync, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyGMailSync", reflect.TypeOf((*MockEmailUseCase)(nil).ApplyGMailSync), sync, ctx)
+}
+
+// BulkEmails mocks base method.
+func (m *MockEmailUseCase) BulkEmails(request *domain_models.BulkRequest, login string, ctx context.Context) ([]*domain_models.BulkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkEmails", request, login, ctx)
+	ret0, _ := ret[0].([]*domain_models.BulkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkEmails indicates an expected call of BulkEmails.
+func (mr *MockEmailUseCaseMockRecorder) BulkEmails(request, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkEmails", reflect.TypeOf((*MockEmailUseCase)(nil).BulkEmails), request, login, ctx)
+}
+
 // CheckRecipientEmail mocks base method.
-func (m *MockEmailUseCase) CheckRecipientEmail(recipient string, ctx context.Context) error {
+func (m *MockEmailUseCase) CheckRecipientEmail(recipient, sender string, ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckRecipientEmail", recipient, ctx)
+	ret := m.ctrl.Call(m, "CheckRecipientEmail", recipient, sender, ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CheckRecipientEmail indicates an expected call of CheckRecipientEmail.
-func (mr *MockEmailUseCaseMockRecorder) CheckRecipientEmail(recipient, ctx interface{}) *gomock.Call {
+func (mr *MockEmailUseCaseMockRecorder) CheckRecipientEmail(recipient, sender, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRecipientEmail", reflect.TypeOf((*MockEmailUseCase)(nil).CheckRecipientEmail), recipient, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRecipientEmail", reflect.TypeOf((*MockEmailUseCase)(nil).CheckRecipientEmail), recipient, sender, ctx)
 }
 
 // CreateEmail mocks base method.
@@ -109,6 +198,36 @@ func (mr *MockEmailUseCaseMockRecorder) CreateEmail(newEmail, ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmail", reflect.TypeOf((*MockEmailUseCase)(nil).CreateEmail), newEmail, ctx)
 }
 
+// CreateLabel mocks base method.
+func (m *MockEmailUseCase) CreateLabel(label *domain_models.Label, login string, ctx context.Context) (*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", label, login, ctx)
+	ret0, _ := ret[0].(*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockEmailUseCaseMockRecorder) CreateLabel(label, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockEmailUseCase)(nil).CreateLabel), label, login, ctx)
+}
+
+// CreateMailingList mocks base method.
+func (m *MockEmailUseCase) CreateMailingList(list *domain_models.MailingList, ownerLogin string, ctx context.Context) (*domain_models.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMailingList", list, ownerLogin, ctx)
+	ret0, _ := ret[0].(*domain_models.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMailingList indicates an expected call of CreateMailingList.
+func (mr *MockEmailUseCaseMockRecorder) CreateMailingList(list, ownerLogin, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMailingList", reflect.TypeOf((*MockEmailUseCase)(nil).CreateMailingList), list, ownerLogin, ctx)
+}
+
 // CreateProfileEmail mocks base method.
 func (m *MockEmailUseCase) CreateProfileEmail(emailId uint64, sender, recipient string, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -138,6 +257,21 @@ func (mr *MockEmailUseCaseMockRecorder) DeleteEmail(id, login, ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmail", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteEmail), id, login, ctx)
 }
 
+// DeleteEmailsInLabel mocks base method.
+func (m *MockEmailUseCase) DeleteEmailsInLabel(labelID uint32, emailIDs []uint64, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailsInLabel", labelID, emailIDs, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEmailsInLabel indicates an expected call of DeleteEmailsInLabel.
+func (mr *MockEmailUseCaseMockRecorder) DeleteEmailsInLabel(labelID, emailIDs, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailsInLabel", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteEmailsInLabel), labelID, emailIDs, login, ctx)
+}
+
 // DeleteFileByID mocks base method.
 func (m *MockEmailUseCase) DeleteFileByID(fileID uint64, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +287,81 @@ func (mr *MockEmailUseCaseMockRecorder) DeleteFileByID(fileID, ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileByID", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteFileByID), fileID, ctx)
 }
 
+// DeleteGMailMessage mocks base method.
+func (m *MockEmailUseCase) DeleteGMailMessage(login, id string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGMailMessage", login, id, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGMailMessage indicates an expected call of DeleteGMailMessage.
+func (mr *MockEmailUseCaseMockRecorder) DeleteGMailMessage(login, id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGMailMessage", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteGMailMessage), login, id, ctx)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockEmailUseCase) DeleteLabel(id uint32, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", id, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockEmailUseCaseMockRecorder) DeleteLabel(id, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteLabel), id, login, ctx)
+}
+
+// DeleteMailboxDelegate mocks base method.
+func (m *MockEmailUseCase) DeleteMailboxDelegate(mailbox, login, delegate string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailboxDelegate", mailbox, login, delegate, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailboxDelegate indicates an expected call of DeleteMailboxDelegate.
+func (mr *MockEmailUseCaseMockRecorder) DeleteMailboxDelegate(mailbox, login, delegate, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailboxDelegate", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteMailboxDelegate), mailbox, login, delegate, ctx)
+}
+
+// DeleteMailingList mocks base method.
+func (m *MockEmailUseCase) DeleteMailingList(id uint32, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailingList", id, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingList indicates an expected call of DeleteMailingList.
+func (mr *MockEmailUseCaseMockRecorder) DeleteMailingList(id, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingList", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteMailingList), id, login, ctx)
+}
+
+// DeleteMailingListMember mocks base method.
+func (m *MockEmailUseCase) DeleteMailingListMember(id uint32, login, memberLogin string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailingListMember", id, login, memberLogin, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMailingListMember indicates an expected call of DeleteMailingListMember.
+func (mr *MockEmailUseCaseMockRecorder) DeleteMailingListMember(id, login, memberLogin, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailingListMember", reflect.TypeOf((*MockEmailUseCase)(nil).DeleteMailingListMember), id, login, memberLogin, ctx)
+}
+
 // GetAllDraftEmails mocks base method.
 func (m *MockEmailUseCase) GetAllDraftEmails(login string, offset, limit int64, ctx context.Context) ([]*domain_models.Email, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +377,21 @@ func (mr *MockEmailUseCaseMockRecorder) GetAllDraftEmails(login, offset, limit,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDraftEmails", reflect.TypeOf((*MockEmailUseCase)(nil).GetAllDraftEmails), login, offset, limit, ctx)
 }
 
+// GetAllEmailsInLabel mocks base method.
+func (m *MockEmailUseCase) GetAllEmailsInLabel(name, login string, ctx context.Context) ([]*domain_models.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllEmailsInLabel", name, login, ctx)
+	ret0, _ := ret[0].([]*domain_models.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllEmailsInLabel indicates an expected call of GetAllEmailsInLabel.
+func (mr *MockEmailUseCaseMockRecorder) GetAllEmailsInLabel(name, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmailsInLabel", reflect.TypeOf((*MockEmailUseCase)(nil).GetAllEmailsInLabel), name, login, ctx)
+}
+
 // GetAllEmailsIncoming mocks base method.
 func (m *MockEmailUseCase) GetAllEmailsIncoming(login string, offset, limit int64, ctx context.Context) ([]*domain_models.Email, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +437,36 @@ func (mr *MockEmailUseCaseMockRecorder) GetAllSpamEmails(login, offset, limit, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSpamEmails", reflect.TypeOf((*MockEmailUseCase)(nil).GetAllSpamEmails), login, offset, limit, ctx)
 }
 
+// GetDelegateActions mocks base method.
+func (m *MockEmailUseCase) GetDelegateActions(mailbox, login string, offset, limit int64, ctx context.Context) ([]*domain_models.DelegateAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegateActions", mailbox, login, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.DelegateAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegateActions indicates an expected call of GetDelegateActions.
+func (mr *MockEmailUseCaseMockRecorder) GetDelegateActions(mailbox, login, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateActions", reflect.TypeOf((*MockEmailUseCase)(nil).GetDelegateActions), mailbox, login, offset, limit, ctx)
+}
+
+// GetDelegatedMailboxes mocks base method.
+func (m *MockEmailUseCase) GetDelegatedMailboxes(login string, offset, limit int64, ctx context.Context) ([]*domain_models.MailboxDelegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatedMailboxes", login, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailboxDelegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegatedMailboxes indicates an expected call of GetDelegatedMailboxes.
+func (mr *MockEmailUseCaseMockRecorder) GetDelegatedMailboxes(login, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatedMailboxes", reflect.TypeOf((*MockEmailUseCase)(nil).GetDelegatedMailboxes), login, offset, limit, ctx)
+}
+
 // GetEmailByID mocks base method.
 func (m *MockEmailUseCase) GetEmailByID(id uint64, login string, ctx context.Context) (*domain_models.Email, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +482,21 @@ func (mr *MockEmailUseCaseMockRecorder) GetEmailByID(id, login, ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailByID", reflect.TypeOf((*MockEmailUseCase)(nil).GetEmailByID), id, login, ctx)
 }
 
+// GetEmailLabels mocks base method.
+func (m *MockEmailUseCase) GetEmailLabels(emailID uint64, login string, ctx context.Context) ([]*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailLabels", emailID, login, ctx)
+	ret0, _ := ret[0].([]*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailLabels indicates an expected call of GetEmailLabels.
+func (mr *MockEmailUseCaseMockRecorder) GetEmailLabels(emailID, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailLabels", reflect.TypeOf((*MockEmailUseCase)(nil).GetEmailLabels), emailID, login, ctx)
+}
+
 // GetFileByID mocks base method.
 func (m *MockEmailUseCase) GetFileByID(fileID uint64, ctx context.Context) (*domain_models.File, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +527,202 @@ func (mr *MockEmailUseCaseMockRecorder) GetFilesByEmailID(emailID, ctx interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesByEmailID", reflect.TypeOf((*MockEmailUseCase)(nil).GetFilesByEmailID), emailID, ctx)
 }
 
+// GetGMailHistoryID mocks base method.
+func (m *MockEmailUseCase) GetGMailHistoryID(login string, ctx context.Context) (uint64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailHistoryID", login, ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGMailHistoryID indicates an expected call of GetGMailHistoryID.
+func (mr *MockEmailUseCaseMockRecorder) GetGMailHistoryID(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailHistoryID", reflect.TypeOf((*MockEmailUseCase)(nil).GetGMailHistoryID), login, ctx)
+}
+
+// GetGMailLabels mocks base method.
+func (m *MockEmailUseCase) GetGMailLabels(login string, ctx context.Context) ([]*domain_models.GMailLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailLabels", login, ctx)
+	ret0, _ := ret[0].([]*domain_models.GMailLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailLabels indicates an expected call of GetGMailLabels.
+func (mr *MockEmailUseCaseMockRecorder) GetGMailLabels(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLabels", reflect.TypeOf((*MockEmailUseCase)(nil).GetGMailLabels), login, ctx)
+}
+
+// GetGMailLogins mocks base method.
+func (m *MockEmailUseCase) GetGMailLogins(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailLogins", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailLogins indicates an expected call of GetGMailLogins.
+func (mr *MockEmailUseCaseMockRecorder) GetGMailLogins(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailLogins", reflect.TypeOf((*MockEmailUseCase)(nil).GetGMailLogins), ctx)
+}
+
+// GetGMailMessages mocks base method.
+func (m *MockEmailUseCase) GetGMailMessages(login, labelID string, beforeDate time.Time, beforeID string, limit int64, ctx context.Context) ([]*domain_models.GMailMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGMailMessages", login, labelID, beforeDate, beforeID, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.GMailMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGMailMessages indicates an expected call of GetGMailMessages.
+func (mr *MockEmailUseCaseMockRecorder) GetGMailMessages(login, labelID, beforeDate, beforeID, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGMailMessages", reflect.TypeOf((*MockEmailUseCase)(nil).GetGMailMessages), login, labelID, beforeDate, beforeID, limit, ctx)
+}
+
+// GetLabels mocks base method.
+func (m *MockEmailUseCase) GetLabels(login string, offset, limit int64, ctx context.Context) ([]*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabels", login, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabels indicates an expected call of GetLabels.
+func (mr *MockEmailUseCaseMockRecorder) GetLabels(login, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabels", reflect.TypeOf((*MockEmailUseCase)(nil).GetLabels), login, offset, limit, ctx)
+}
+
+// GetMailboxDelegates mocks base method.
+func (m *MockEmailUseCase) GetMailboxDelegates(mailbox, login string, ctx context.Context) ([]*domain_models.MailboxDelegate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailboxDelegates", mailbox, login, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailboxDelegate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailboxDelegates indicates an expected call of GetMailboxDelegates.
+func (mr *MockEmailUseCaseMockRecorder) GetMailboxDelegates(mailbox, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxDelegates", reflect.TypeOf((*MockEmailUseCase)(nil).GetMailboxDelegates), mailbox, login, ctx)
+}
+
+// GetMailingListByID mocks base method.
+func (m *MockEmailUseCase) GetMailingListByID(id uint32, login string, ctx context.Context) (*domain_models.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListByID", id, login, ctx)
+	ret0, _ := ret[0].(*domain_models.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListByID indicates an expected call of GetMailingListByID.
+func (mr *MockEmailUseCaseMockRecorder) GetMailingListByID(id, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListByID", reflect.TypeOf((*MockEmailUseCase)(nil).GetMailingListByID), id, login, ctx)
+}
+
+// GetMailingListMembers mocks base method.
+func (m *MockEmailUseCase) GetMailingListMembers(id uint32, login string, ctx context.Context) ([]*domain_models.MailingListMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListMembers", id, login, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailingListMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListMembers indicates an expected call of GetMailingListMembers.
+func (mr *MockEmailUseCaseMockRecorder) GetMailingListMembers(id, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListMembers", reflect.TypeOf((*MockEmailUseCase)(nil).GetMailingListMembers), id, login, ctx)
+}
+
+// GetMailingListModeration mocks base method.
+func (m *MockEmailUseCase) GetMailingListModeration(id uint32, login string, ctx context.Context) ([]*domain_models.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingListModeration", id, login, ctx)
+	ret0, _ := ret[0].([]*domain_models.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingListModeration indicates an expected call of GetMailingListModeration.
+func (mr *MockEmailUseCaseMockRecorder) GetMailingListModeration(id, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingListModeration", reflect.TypeOf((*MockEmailUseCase)(nil).GetMailingListModeration), id, login, ctx)
+}
+
+// GetMailingLists mocks base method.
+func (m *MockEmailUseCase) GetMailingLists(login string, offset, limit int64, ctx context.Context) ([]*domain_models.MailingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailingLists", login, offset, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.MailingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailingLists indicates an expected call of GetMailingLists.
+func (mr *MockEmailUseCaseMockRecorder) GetMailingLists(login, offset, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailingLists", reflect.TypeOf((*MockEmailUseCase)(nil).GetMailingLists), login, offset, limit, ctx)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockEmailUseCase) GetUnreadCount(login string, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", login, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockEmailUseCaseMockRecorder) GetUnreadCount(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockEmailUseCase)(nil).GetUnreadCount), login, ctx)
+}
+
+// ModerateMailingListEmail mocks base method.
+func (m *MockEmailUseCase) ModerateMailingListEmail(id uint32, emailID uint64, login string, approve bool, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateMailingListEmail", id, emailID, login, approve, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateMailingListEmail indicates an expected call of ModerateMailingListEmail.
+func (mr *MockEmailUseCaseMockRecorder) ModerateMailingListEmail(id, emailID, login, approve, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateMailingListEmail", reflect.TypeOf((*MockEmailUseCase)(nil).ModerateMailingListEmail), id, emailID, login, approve, ctx)
+}
+
+// UnsubscribeMailingList mocks base method.
+func (m *MockEmailUseCase) UnsubscribeMailingList(token string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeMailingList", token, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeMailingList indicates an expected call of UnsubscribeMailingList.
+func (mr *MockEmailUseCaseMockRecorder) UnsubscribeMailingList(token, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeMailingList", reflect.TypeOf((*MockEmailUseCase)(nil).UnsubscribeMailingList), token, ctx)
+}
+
 // UpdateEmail mocks base method.
 func (m *MockEmailUseCase) UpdateEmail(updatedEmail *domain_models.Email, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -287,3 +752,48 @@ func (mr *MockEmailUseCaseMockRecorder) UpdateFileByID(fileID, newFileID, newFil
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileByID", reflect.TypeOf((*MockEmailUseCase)(nil).UpdateFileByID), fileID, newFileID, newFileType, newFileName, newFileSize, ctx)
 }
+
+// UpdateGMailMessageLabels mocks base method.
+func (m *MockEmailUseCase) UpdateGMailMessageLabels(login, id string, addLabelIDs, removeLabelIDs []string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGMailMessageLabels", login, id, addLabelIDs, removeLabelIDs, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGMailMessageLabels indicates an expected call of UpdateGMailMessageLabels.
+func (mr *MockEmailUseCaseMockRecorder) UpdateGMailMessageLabels(login, id, addLabelIDs, removeLabelIDs, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGMailMessageLabels", reflect.TypeOf((*MockEmailUseCase)(nil).UpdateGMailMessageLabels), login, id, addLabelIDs, removeLabelIDs, ctx)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockEmailUseCase) UpdateLabel(label *domain_models.Label, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", label, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockEmailUseCaseMockRecorder) UpdateLabel(label, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockEmailUseCase)(nil).UpdateLabel), label, login, ctx)
+}
+
+// UpdateMailingList mocks base method.
+func (m *MockEmailUseCase) UpdateMailingList(list *domain_models.MailingList, login string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMailingList", list, login, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMailingList indicates an expected call of UpdateMailingList.
+func (mr *MockEmailUseCaseMockRecorder) UpdateMailingList(list, login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMailingList", reflect.TypeOf((*MockEmailUseCase)(nil).UpdateMailingList), list, login, ctx)
+}
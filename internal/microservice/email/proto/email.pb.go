@@ -191,19 +191,23 @@ type Email struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Topic          string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	Text           string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
-	PhotoID        string                 `protobuf:"bytes,4,opt,name=photoID,proto3" json:"photoID,omitempty"`
-	ReadStatus     bool                   `protobuf:"varint,5,opt,name=readStatus,proto3" json:"readStatus,omitempty"`
-	Flag           bool                   `protobuf:"varint,6,opt,name=flag,proto3" json:"flag,omitempty"`
-	Deleted        bool                   `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	DateOfDispatch *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=dateOfDispatch,proto3" json:"dateOfDispatch,omitempty"`
-	ReplyToEmailID uint64                 `protobuf:"varint,9,opt,name=replyToEmailID,proto3" json:"replyToEmailID,omitempty"`
-	DraftStatus    bool                   `protobuf:"varint,10,opt,name=draftStatus,proto3" json:"draftStatus,omitempty"`
-	SpamStatus     bool                   `protobuf:"varint,11,opt,name=spamStatus,proto3" json:"spamStatus,omitempty"`
-	SenderEmail    string                 `protobuf:"bytes,12,opt,name=senderEmail,proto3" json:"senderEmail,omitempty"`
-	RecipientEmail string                 `protobuf:"bytes,13,opt,name=recipientEmail,proto3" json:"recipientEmail,omitempty"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic           string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Text            string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	PhotoID         string                 `protobuf:"bytes,4,opt,name=photoID,proto3" json:"photoID,omitempty"`
+	ReadStatus      bool                   `protobuf:"varint,5,opt,name=readStatus,proto3" json:"readStatus,omitempty"`
+	Flag            bool                   `protobuf:"varint,6,opt,name=flag,proto3" json:"flag,omitempty"`
+	Deleted         bool                   `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	DateOfDispatch  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=dateOfDispatch,proto3" json:"dateOfDispatch,omitempty"`
+	ReplyToEmailID  uint64                 `protobuf:"varint,9,opt,name=replyToEmailID,proto3" json:"replyToEmailID,omitempty"`
+	DraftStatus     bool                   `protobuf:"varint,10,opt,name=draftStatus,proto3" json:"draftStatus,omitempty"`
+	SpamStatus      bool                   `protobuf:"varint,11,opt,name=spamStatus,proto3" json:"spamStatus,omitempty"`
+	SenderEmail     string                 `protobuf:"bytes,12,opt,name=senderEmail,proto3" json:"senderEmail,omitempty"`
+	RecipientEmail  string                 `protobuf:"bytes,13,opt,name=recipientEmail,proto3" json:"recipientEmail,omitempty"`
+	ListId          string                 `protobuf:"bytes,14,opt,name=listId,proto3" json:"listId,omitempty"`
+	ListUnsubscribe string                 `protobuf:"bytes,15,opt,name=listUnsubscribe,proto3" json:"listUnsubscribe,omitempty"`
+	Labels          []*Label               `protobuf:"bytes,16,rep,name=labels,proto3" json:"labels,omitempty"`
+	SentByEmail     string                 `protobuf:"bytes,17,opt,name=sentByEmail,proto3" json:"sentByEmail,omitempty"`
 }
 
 func (x *Email) Reset() {
@@ -329,6 +333,34 @@ func (x *Email) GetRecipientEmail() string {
 	return ""
 }
 
+func (x *Email) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *Email) GetListUnsubscribe() string {
+	if x != nil {
+		return x.ListUnsubscribe
+	}
+	return ""
+}
+
+func (x *Email) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Email) GetSentByEmail() string {
+	if x != nil {
+		return x.SentByEmail
+	}
+	return ""
+}
+
 type EmailWithID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -502,18 +534,66 @@ func (x *IdSenderRecipient) GetRecipient() string {
 	return ""
 }
 
+type UnreadCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *UnreadCount) Reset() {
+	*x = UnreadCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreadCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCount) ProtoMessage() {}
+
+func (x *UnreadCount) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCount.ProtoReflect.Descriptor instead.
+func (*UnreadCount) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnreadCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type Recipient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (x *Recipient) Reset() {
 	*x = Recipient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[7]
+		mi := &file_email_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -526,7 +606,7 @@ func (x *Recipient) String() string {
 func (*Recipient) ProtoMessage() {}
 
 func (x *Recipient) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[7]
+	mi := &file_email_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +619,7 @@ func (x *Recipient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Recipient.ProtoReflect.Descriptor instead.
 func (*Recipient) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{7}
+	return file_email_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Recipient) GetRecipient() string {
@@ -549,6 +629,13 @@ func (x *Recipient) GetRecipient() string {
 	return ""
 }
 
+func (x *Recipient) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
 type StatusEmail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -560,7 +647,7 @@ type StatusEmail struct {
 func (x *StatusEmail) Reset() {
 	*x = StatusEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[8]
+		mi := &file_email_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -573,7 +660,7 @@ func (x *StatusEmail) String() string {
 func (*StatusEmail) ProtoMessage() {}
 
 func (x *StatusEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[8]
+	mi := &file_email_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +673,7 @@ func (x *StatusEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusEmail.ProtoReflect.Descriptor instead.
 func (*StatusEmail) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{8}
+	return file_email_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StatusEmail) GetStatus() bool {
@@ -605,7 +692,7 @@ type EmptyEmail struct {
 func (x *EmptyEmail) Reset() {
 	*x = EmptyEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[9]
+		mi := &file_email_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -618,7 +705,7 @@ func (x *EmptyEmail) String() string {
 func (*EmptyEmail) ProtoMessage() {}
 
 func (x *EmptyEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[9]
+	mi := &file_email_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +718,7 @@ func (x *EmptyEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyEmail.ProtoReflect.Descriptor instead.
 func (*EmptyEmail) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{9}
+	return file_email_proto_rawDescGZIP(), []int{10}
 }
 
 type File struct {
@@ -649,7 +736,7 @@ type File struct {
 func (x *File) Reset() {
 	*x = File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[10]
+		mi := &file_email_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -662,7 +749,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[10]
+	mi := &file_email_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +762,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{10}
+	return file_email_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *File) GetId() uint64 {
@@ -728,7 +815,7 @@ type AddAttachmentRequest struct {
 func (x *AddAttachmentRequest) Reset() {
 	*x = AddAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[11]
+		mi := &file_email_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -741,7 +828,7 @@ func (x *AddAttachmentRequest) String() string {
 func (*AddAttachmentRequest) ProtoMessage() {}
 
 func (x *AddAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[11]
+	mi := &file_email_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +841,7 @@ func (x *AddAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*AddAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{11}
+	return file_email_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddAttachmentRequest) GetEmailId() uint64 {
@@ -803,7 +890,7 @@ type AddAttachmentReply struct {
 func (x *AddAttachmentReply) Reset() {
 	*x = AddAttachmentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[12]
+		mi := &file_email_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -816,7 +903,7 @@ func (x *AddAttachmentReply) String() string {
 func (*AddAttachmentReply) ProtoMessage() {}
 
 func (x *AddAttachmentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[12]
+	mi := &file_email_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +916,7 @@ func (x *AddAttachmentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAttachmentReply.ProtoReflect.Descriptor instead.
 func (*AddAttachmentReply) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{12}
+	return file_email_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AddAttachmentReply) GetFileId() uint64 {
@@ -850,7 +937,7 @@ type GetFileByIDRequest struct {
 func (x *GetFileByIDRequest) Reset() {
 	*x = GetFileByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[13]
+		mi := &file_email_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -863,7 +950,7 @@ func (x *GetFileByIDRequest) String() string {
 func (*GetFileByIDRequest) ProtoMessage() {}
 
 func (x *GetFileByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[13]
+	mi := &file_email_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +963,7 @@ func (x *GetFileByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetFileByIDRequest) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{13}
+	return file_email_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetFileByIDRequest) GetFileId() uint64 {
@@ -897,7 +984,7 @@ type GetFileByIDReply struct {
 func (x *GetFileByIDReply) Reset() {
 	*x = GetFileByIDReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[14]
+		mi := &file_email_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -910,7 +997,7 @@ func (x *GetFileByIDReply) String() string {
 func (*GetFileByIDReply) ProtoMessage() {}
 
 func (x *GetFileByIDReply) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[14]
+	mi := &file_email_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +1010,7 @@ func (x *GetFileByIDReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileByIDReply.ProtoReflect.Descriptor instead.
 func (*GetFileByIDReply) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{14}
+	return file_email_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetFileByIDReply) GetFile() *File {
@@ -944,7 +1031,7 @@ type GetFilesByEmailIDRequest struct {
 func (x *GetFilesByEmailIDRequest) Reset() {
 	*x = GetFilesByEmailIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[15]
+		mi := &file_email_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -957,7 +1044,7 @@ func (x *GetFilesByEmailIDRequest) String() string {
 func (*GetFilesByEmailIDRequest) ProtoMessage() {}
 
 func (x *GetFilesByEmailIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[15]
+	mi := &file_email_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,7 +1057,7 @@ func (x *GetFilesByEmailIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFilesByEmailIDRequest.ProtoReflect.Descriptor instead.
 func (*GetFilesByEmailIDRequest) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{15}
+	return file_email_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetFilesByEmailIDRequest) GetEmailId() uint64 {
@@ -991,7 +1078,7 @@ type GetFilesByEmailIDReply struct {
 func (x *GetFilesByEmailIDReply) Reset() {
 	*x = GetFilesByEmailIDReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[16]
+		mi := &file_email_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1004,7 +1091,7 @@ func (x *GetFilesByEmailIDReply) String() string {
 func (*GetFilesByEmailIDReply) ProtoMessage() {}
 
 func (x *GetFilesByEmailIDReply) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[16]
+	mi := &file_email_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1104,7 @@ func (x *GetFilesByEmailIDReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFilesByEmailIDReply.ProtoReflect.Descriptor instead.
 func (*GetFilesByEmailIDReply) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{16}
+	return file_email_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetFilesByEmailIDReply) GetFiles() []*File {
@@ -1038,7 +1125,7 @@ type DeleteFileByIDRequest struct {
 func (x *DeleteFileByIDRequest) Reset() {
 	*x = DeleteFileByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[17]
+		mi := &file_email_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1051,7 +1138,7 @@ func (x *DeleteFileByIDRequest) String() string {
 func (*DeleteFileByIDRequest) ProtoMessage() {}
 
 func (x *DeleteFileByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[17]
+	mi := &file_email_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1151,7 @@ func (x *DeleteFileByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileByIDRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFileByIDRequest) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{17}
+	return file_email_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteFileByIDRequest) GetFileId() uint64 {
@@ -1085,7 +1172,7 @@ type DeleteFileByIDReply struct {
 func (x *DeleteFileByIDReply) Reset() {
 	*x = DeleteFileByIDReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[18]
+		mi := &file_email_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1098,7 +1185,7 @@ func (x *DeleteFileByIDReply) String() string {
 func (*DeleteFileByIDReply) ProtoMessage() {}
 
 func (x *DeleteFileByIDReply) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[18]
+	mi := &file_email_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1198,7 @@ func (x *DeleteFileByIDReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileByIDReply.ProtoReflect.Descriptor instead.
 func (*DeleteFileByIDReply) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{18}
+	return file_email_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteFileByIDReply) GetStatus() bool {
@@ -1136,7 +1223,7 @@ type UpdateFileByIDRequest struct {
 func (x *UpdateFileByIDRequest) Reset() {
 	*x = UpdateFileByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[19]
+		mi := &file_email_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1149,7 +1236,7 @@ func (x *UpdateFileByIDRequest) String() string {
 func (*UpdateFileByIDRequest) ProtoMessage() {}
 
 func (x *UpdateFileByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[19]
+	mi := &file_email_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1249,7 @@ func (x *UpdateFileByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFileByIDRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFileByIDRequest) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{19}
+	return file_email_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateFileByIDRequest) GetId() uint64 {
@@ -1211,7 +1298,7 @@ type UpdateFileByIDReply struct {
 func (x *UpdateFileByIDReply) Reset() {
 	*x = UpdateFileByIDReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[20]
+		mi := &file_email_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1224,7 +1311,7 @@ func (x *UpdateFileByIDReply) String() string {
 func (*UpdateFileByIDReply) ProtoMessage() {}
 
 func (x *UpdateFileByIDReply) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[20]
+	mi := &file_email_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,7 +1324,7 @@ func (x *UpdateFileByIDReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFileByIDReply.ProtoReflect.Descriptor instead.
 func (*UpdateFileByIDReply) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{20}
+	return file_email_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UpdateFileByIDReply) GetStatus() bool {
@@ -1261,7 +1348,7 @@ type AddFileRequest struct {
 func (x *AddFileRequest) Reset() {
 	*x = AddFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[21]
+		mi := &file_email_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1274,7 +1361,7 @@ func (x *AddFileRequest) String() string {
 func (*AddFileRequest) ProtoMessage() {}
 
 func (x *AddFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[21]
+	mi := &file_email_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1374,7 @@ func (x *AddFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFileRequest.ProtoReflect.Descriptor instead.
 func (*AddFileRequest) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{21}
+	return file_email_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AddFileRequest) GetFileId() string {
@@ -1329,7 +1416,7 @@ type AddFileReply struct {
 func (x *AddFileReply) Reset() {
 	*x = AddFileReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[22]
+		mi := &file_email_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1342,7 +1429,7 @@ func (x *AddFileReply) String() string {
 func (*AddFileReply) ProtoMessage() {}
 
 func (x *AddFileReply) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[22]
+	mi := &file_email_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,7 +1442,7 @@ func (x *AddFileReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFileReply.ProtoReflect.Descriptor instead.
 func (*AddFileReply) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{22}
+	return file_email_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddFileReply) GetFileId() uint64 {
@@ -1377,7 +1464,7 @@ type AddFileToEmailRequest struct {
 func (x *AddFileToEmailRequest) Reset() {
 	*x = AddFileToEmailRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[23]
+		mi := &file_email_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1390,7 +1477,7 @@ func (x *AddFileToEmailRequest) String() string {
 func (*AddFileToEmailRequest) ProtoMessage() {}
 
 func (x *AddFileToEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[23]
+	mi := &file_email_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,7 +1490,7 @@ func (x *AddFileToEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFileToEmailRequest.ProtoReflect.Descriptor instead.
 func (*AddFileToEmailRequest) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{23}
+	return file_email_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AddFileToEmailRequest) GetEmailId() uint64 {
@@ -1431,7 +1518,7 @@ type AddFileToEmailReply struct {
 func (x *AddFileToEmailReply) Reset() {
 	*x = AddFileToEmailReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_email_proto_msgTypes[24]
+		mi := &file_email_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1444,7 +1531,7 @@ func (x *AddFileToEmailReply) String() string {
 func (*AddFileToEmailReply) ProtoMessage() {}
 
 func (x *AddFileToEmailReply) ProtoReflect() protoreflect.Message {
-	mi := &file_email_proto_msgTypes[24]
+	mi := &file_email_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1544,7 @@ func (x *AddFileToEmailReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFileToEmailReply.ProtoReflect.Descriptor instead.
 func (*AddFileToEmailReply) Descriptor() ([]byte, []int) {
-	return file_email_proto_rawDescGZIP(), []int{24}
+	return file_email_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AddFileToEmailReply) GetStatus() bool {
@@ -1467,317 +1554,3257 @@ func (x *AddFileToEmailReply) GetStatus() bool {
 	return false
 }
 
-var File_email_proto protoreflect.FileDescriptor
+type MailingList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_email_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x0f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64,
-	0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x56,
-	0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2e, 0x0a, 0x06, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x24, 0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x06,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xa1, 0x03, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x68,
-	0x6f, 0x74, 0x6f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x68, 0x6f,
-	0x74, 0x6f, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x69, 0x73, 0x70,
-	0x61, 0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x69,
-	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
-	0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x70, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x41, 0x0a, 0x0b, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a,
-	0x0b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x59, 0x0a, 0x11, 0x49, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a,
-	0x09, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x0c, 0x0a, 0x0a, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x82, 0x01,
-	0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x2c, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22,
-	0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x1f, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69,
-	0x6c, 0x65, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x7c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x22, 0x26, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x69, 0x6c,
-	0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x32, 0x92, 0x09, 0x0a, 0x0c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x0d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12,
-	0x36, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x6d, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x0d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x36,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x12, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x41, 0x6e,
-	0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x3c,
-	0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x0c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x49, 0x44, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0d, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x49, 0x44, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69,
-	0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x42, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x37, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x41, 0x64, 0x64,
-	0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x3b, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address         string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	PostPolicy      string                 `protobuf:"bytes,5,opt,name=postPolicy,proto3" json:"postPolicy,omitempty"`
+	Moderated       bool                   `protobuf:"varint,6,opt,name=moderated,proto3" json:"moderated,omitempty"`
+	CreationDate    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+	ListId          string                 `protobuf:"bytes,8,opt,name=listId,proto3" json:"listId,omitempty"`
+	ListUnsubscribe string                 `protobuf:"bytes,9,opt,name=listUnsubscribe,proto3" json:"listUnsubscribe,omitempty"`
 }
 
-var (
-	file_email_proto_rawDescOnce sync.Once
-	file_email_proto_rawDescData = file_email_proto_rawDesc
-)
-
-func file_email_proto_rawDescGZIP() []byte {
-	file_email_proto_rawDescOnce.Do(func() {
-		file_email_proto_rawDescData = protoimpl.X.CompressGZIP(file_email_proto_rawDescData)
-	})
-	return file_email_proto_rawDescData
+func (x *MailingList) Reset() {
+	*x = MailingList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_email_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_email_proto_goTypes = []interface{}{
-	(*EmailIdAndLogin)(nil),          // 0: proto.EmailIdAndLogin
-	(*LoginOffsetLimit)(nil),         // 1: proto.LoginOffsetLimit
-	(*Emails)(nil),                   // 2: proto.Emails
-	(*Email)(nil),                    // 3: proto.Email
-	(*EmailWithID)(nil),              // 4: proto.EmailWithID
-	(*LoginWithID)(nil),              // 5: proto.LoginWithID
-	(*IdSenderRecipient)(nil),        // 6: proto.IdSenderRecipient
-	(*Recipient)(nil),                // 7: proto.Recipient
-	(*StatusEmail)(nil),              // 8: proto.StatusEmail
-	(*EmptyEmail)(nil),               // 9: proto.EmptyEmail
-	(*File)(nil),                     // 10: proto.File
-	(*AddAttachmentRequest)(nil),     // 11: proto.AddAttachmentRequest
-	(*AddAttachmentReply)(nil),       // 12: proto.AddAttachmentReply
-	(*GetFileByIDRequest)(nil),       // 13: proto.GetFileByIDRequest
-	(*GetFileByIDReply)(nil),         // 14: proto.GetFileByIDReply
-	(*GetFilesByEmailIDRequest)(nil), // 15: proto.GetFilesByEmailIDRequest
-	(*GetFilesByEmailIDReply)(nil),   // 16: proto.GetFilesByEmailIDReply
-	(*DeleteFileByIDRequest)(nil),    // 17: proto.DeleteFileByIDRequest
-	(*DeleteFileByIDReply)(nil),      // 18: proto.DeleteFileByIDReply
-	(*UpdateFileByIDRequest)(nil),    // 19: proto.UpdateFileByIDRequest
-	(*UpdateFileByIDReply)(nil),      // 20: proto.UpdateFileByIDReply
-	(*AddFileRequest)(nil),           // 21: proto.AddFileRequest
-	(*AddFileReply)(nil),             // 22: proto.AddFileReply
-	(*AddFileToEmailRequest)(nil),    // 23: proto.AddFileToEmailRequest
-	(*AddFileToEmailReply)(nil),      // 24: proto.AddFileToEmailReply
-	(*timestamppb.Timestamp)(nil),    // 25: google.protobuf.Timestamp
-}
-var file_email_proto_depIdxs = []int32{
-	3,  // 0: proto.Emails.emails:type_name -> proto.Email
-	25, // 1: proto.Email.dateOfDispatch:type_name -> google.protobuf.Timestamp
-	3,  // 2: proto.EmailWithID.email:type_name -> proto.Email
-	10, // 3: proto.GetFileByIDReply.file:type_name -> proto.File
-	10, // 4: proto.GetFilesByEmailIDReply.files:type_name -> proto.File
-	1,  // 5: proto.EmailService.GetAllIncoming:input_type -> proto.LoginOffsetLimit
-	1,  // 6: proto.EmailService.GetAllSent:input_type -> proto.LoginOffsetLimit
-	1,  // 7: proto.EmailService.GetDraftEmails:input_type -> proto.LoginOffsetLimit
-	1,  // 8: proto.EmailService.GetSpamEmails:input_type -> proto.LoginOffsetLimit
-	0,  // 9: proto.EmailService.GetEmailByID:input_type -> proto.EmailIdAndLogin
-	3,  // 10: proto.EmailService.CreateEmail:input_type -> proto.Email
-	6,  // 11: proto.EmailService.CreateProfileEmail:input_type -> proto.IdSenderRecipient
-	7,  // 12: proto.EmailService.CheckRecipientEmail:input_type -> proto.Recipient
-	3,  // 13: proto.EmailService.UpdateEmail:input_type -> proto.Email
-	5,  // 14: proto.EmailService.DeleteEmail:input_type -> proto.LoginWithID
-	3,  // 15: proto.EmailService.AddEmailDraft:input_type -> proto.Email
-	11, // 16: proto.EmailService.AddAttachment:input_type -> proto.AddAttachmentRequest
-	13, // 17: proto.EmailService.GetFileByID:input_type -> proto.GetFileByIDRequest
-	15, // 18: proto.EmailService.GetFilesByEmailID:input_type -> proto.GetFilesByEmailIDRequest
-	17, // 19: proto.EmailService.DeleteFileByID:input_type -> proto.DeleteFileByIDRequest
-	19, // 20: proto.EmailService.UpdateFileByID:input_type -> proto.UpdateFileByIDRequest
-	21, // 21: proto.EmailService.AddFile:input_type -> proto.AddFileRequest
-	23, // 22: proto.EmailService.AddFileToEmail:input_type -> proto.AddFileToEmailRequest
-	2,  // 23: proto.EmailService.GetAllIncoming:output_type -> proto.Emails
-	2,  // 24: proto.EmailService.GetAllSent:output_type -> proto.Emails
-	2,  // 25: proto.EmailService.GetDraftEmails:output_type -> proto.Emails
-	2,  // 26: proto.EmailService.GetSpamEmails:output_type -> proto.Emails
-	3,  // 27: proto.EmailService.GetEmailByID:output_type -> proto.Email
-	4,  // 28: proto.EmailService.CreateEmail:output_type -> proto.EmailWithID
-	9,  // 29: proto.EmailService.CreateProfileEmail:output_type -> proto.EmptyEmail
-	9,  // 30: proto.EmailService.CheckRecipientEmail:output_type -> proto.EmptyEmail
-	8,  // 31: proto.EmailService.UpdateEmail:output_type -> proto.StatusEmail
-	8,  // 32: proto.EmailService.DeleteEmail:output_type -> proto.StatusEmail
-	4,  // 33: proto.EmailService.AddEmailDraft:output_type -> proto.EmailWithID
-	12, // 34: proto.EmailService.AddAttachment:output_type -> proto.AddAttachmentReply
-	14, // 35: proto.EmailService.GetFileByID:output_type -> proto.GetFileByIDReply
-	16, // 36: proto.EmailService.GetFilesByEmailID:output_type -> proto.GetFilesByEmailIDReply
-	18, // 37: proto.EmailService.DeleteFileByID:output_type -> proto.DeleteFileByIDReply
-	20, // 38: proto.EmailService.UpdateFileByID:output_type -> proto.UpdateFileByIDReply
-	22, // 39: proto.EmailService.AddFile:output_type -> proto.AddFileReply
-	24, // 40: proto.EmailService.AddFileToEmail:output_type -> proto.AddFileToEmailReply
-	23, // [23:41] is the sub-list for method output_type
-	5,  // [5:23] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+func (x *MailingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_email_proto_init() }
-func file_email_proto_init() {
-	if File_email_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_email_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmailIdAndLogin); i {
+func (*MailingList) ProtoMessage() {}
+
+func (x *MailingList) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailingList.ProtoReflect.Descriptor instead.
+func (*MailingList) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MailingList) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MailingList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MailingList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MailingList) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MailingList) GetPostPolicy() string {
+	if x != nil {
+		return x.PostPolicy
+	}
+	return ""
+}
+
+func (x *MailingList) GetModerated() bool {
+	if x != nil {
+		return x.Moderated
+	}
+	return false
+}
+
+func (x *MailingList) GetCreationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+func (x *MailingList) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *MailingList) GetListUnsubscribe() string {
+	if x != nil {
+		return x.ListUnsubscribe
+	}
+	return ""
+}
+
+type MailingLists struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lists []*MailingList `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+}
+
+func (x *MailingLists) Reset() {
+	*x = MailingLists{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailingLists) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailingLists) ProtoMessage() {}
+
+func (x *MailingLists) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailingLists.ProtoReflect.Descriptor instead.
+func (*MailingLists) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MailingLists) GetLists() []*MailingList {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type MailingListWithLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  *MailingList `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Login string       `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *MailingListWithLogin) Reset() {
+	*x = MailingListWithLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailingListWithLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailingListWithLogin) ProtoMessage() {}
+
+func (x *MailingListWithLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailingListWithLogin.ProtoReflect.Descriptor instead.
+func (*MailingListWithLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MailingListWithLogin) GetList() *MailingList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *MailingListWithLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type MailingListIdAndLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *MailingListIdAndLogin) Reset() {
+	*x = MailingListIdAndLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailingListIdAndLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailingListIdAndLogin) ProtoMessage() {}
+
+func (x *MailingListIdAndLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailingListIdAndLogin.ProtoReflect.Descriptor instead.
+func (*MailingListIdAndLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MailingListIdAndLogin) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MailingListIdAndLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type MailingListMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListId    uint32 `protobuf:"varint,1,opt,name=listId,proto3" json:"listId,omitempty"`
+	ProfileId uint32 `protobuf:"varint,2,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	Login     string `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	Role      string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *MailingListMember) Reset() {
+	*x = MailingListMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailingListMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailingListMember) ProtoMessage() {}
+
+func (x *MailingListMember) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailingListMember.ProtoReflect.Descriptor instead.
+func (*MailingListMember) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MailingListMember) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *MailingListMember) GetProfileId() uint32 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *MailingListMember) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *MailingListMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type MailingListMembers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*MailingListMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *MailingListMembers) Reset() {
+	*x = MailingListMembers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailingListMembers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailingListMembers) ProtoMessage() {}
+
+func (x *MailingListMembers) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailingListMembers.ProtoReflect.Descriptor instead.
+func (*MailingListMembers) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MailingListMembers) GetMembers() []*MailingListMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type MailingListMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListId      uint32 `protobuf:"varint,1,opt,name=listId,proto3" json:"listId,omitempty"`
+	Login       string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	MemberLogin string `protobuf:"bytes,3,opt,name=memberLogin,proto3" json:"memberLogin,omitempty"`
+	Role        string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *MailingListMemberRequest) Reset() {
+	*x = MailingListMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailingListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailingListMemberRequest) ProtoMessage() {}
+
+func (x *MailingListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailingListMemberRequest.ProtoReflect.Descriptor instead.
+func (*MailingListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MailingListMemberRequest) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *MailingListMemberRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *MailingListMemberRequest) GetMemberLogin() string {
+	if x != nil {
+		return x.MemberLogin
+	}
+	return ""
+}
+
+func (x *MailingListMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ListUnsubscribeToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ListUnsubscribeToken) Reset() {
+	*x = ListUnsubscribeToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUnsubscribeToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnsubscribeToken) ProtoMessage() {}
+
+func (x *ListUnsubscribeToken) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnsubscribeToken.ProtoReflect.Descriptor instead.
+func (*ListUnsubscribeToken) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListUnsubscribeToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ModerateEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListId  uint32 `protobuf:"varint,1,opt,name=listId,proto3" json:"listId,omitempty"`
+	EmailId uint64 `protobuf:"varint,2,opt,name=emailId,proto3" json:"emailId,omitempty"`
+	Login   string `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	Approve bool   `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *ModerateEmailRequest) Reset() {
+	*x = ModerateEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerateEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateEmailRequest) ProtoMessage() {}
+
+func (x *ModerateEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateEmailRequest.ProtoReflect.Descriptor instead.
+func (*ModerateEmailRequest) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ModerateEmailRequest) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *ModerateEmailRequest) GetEmailId() uint64 {
+	if x != nil {
+		return x.EmailId
+	}
+	return 0
+}
+
+func (x *ModerateEmailRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ModerateEmailRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type Label struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProfileId uint32 `protobuf:"varint,2,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color     string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Label) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Label) GetProfileId() uint32 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+func (x *Label) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Label) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type Labels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labels []*Label `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *Labels) Reset() {
+	*x = Labels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Labels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Labels) ProtoMessage() {}
+
+func (x *Labels) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Labels.ProtoReflect.Descriptor instead.
+func (*Labels) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Labels) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type LabelWithLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label *Label `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *LabelWithLogin) Reset() {
+	*x = LabelWithLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelWithLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelWithLogin) ProtoMessage() {}
+
+func (x *LabelWithLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelWithLogin.ProtoReflect.Descriptor instead.
+func (*LabelWithLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LabelWithLogin) GetLabel() *Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *LabelWithLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type LabelIdAndLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *LabelIdAndLogin) Reset() {
+	*x = LabelIdAndLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelIdAndLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelIdAndLogin) ProtoMessage() {}
+
+func (x *LabelIdAndLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelIdAndLogin.ProtoReflect.Descriptor instead.
+func (*LabelIdAndLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LabelIdAndLogin) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LabelIdAndLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type LabelNameAndLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *LabelNameAndLogin) Reset() {
+	*x = LabelNameAndLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelNameAndLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelNameAndLogin) ProtoMessage() {}
+
+func (x *LabelNameAndLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelNameAndLogin.ProtoReflect.Descriptor instead.
+func (*LabelNameAndLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LabelNameAndLogin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LabelNameAndLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type LabelEmailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabelId  uint32   `protobuf:"varint,1,opt,name=labelId,proto3" json:"labelId,omitempty"`
+	EmailIds []uint64 `protobuf:"varint,2,rep,packed,name=emailIds,proto3" json:"emailIds,omitempty"`
+	Login    string   `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *LabelEmailsRequest) Reset() {
+	*x = LabelEmailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelEmailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelEmailsRequest) ProtoMessage() {}
+
+func (x *LabelEmailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelEmailsRequest.ProtoReflect.Descriptor instead.
+func (*LabelEmailsRequest) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LabelEmailsRequest) GetLabelId() uint32 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *LabelEmailsRequest) GetEmailIds() []uint64 {
+	if x != nil {
+		return x.EmailIds
+	}
+	return nil
+}
+
+func (x *LabelEmailsRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type BulkEmailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action   string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	EmailIds []uint64 `protobuf:"varint,2,rep,packed,name=emailIds,proto3" json:"emailIds,omitempty"`
+	FolderId uint32   `protobuf:"varint,3,opt,name=folderId,proto3" json:"folderId,omitempty"`
+	LabelId  uint32   `protobuf:"varint,4,opt,name=labelId,proto3" json:"labelId,omitempty"`
+	Login    string   `protobuf:"bytes,5,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *BulkEmailsRequest) Reset() {
+	*x = BulkEmailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkEmailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEmailsRequest) ProtoMessage() {}
+
+func (x *BulkEmailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEmailsRequest.ProtoReflect.Descriptor instead.
+func (*BulkEmailsRequest) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BulkEmailsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BulkEmailsRequest) GetEmailIds() []uint64 {
+	if x != nil {
+		return x.EmailIds
+	}
+	return nil
+}
+
+func (x *BulkEmailsRequest) GetFolderId() uint32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *BulkEmailsRequest) GetLabelId() uint32 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *BulkEmailsRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type BulkEmailResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmailId uint64 `protobuf:"varint,1,opt,name=emailId,proto3" json:"emailId,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BulkEmailResult) Reset() {
+	*x = BulkEmailResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkEmailResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEmailResult) ProtoMessage() {}
+
+func (x *BulkEmailResult) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEmailResult.ProtoReflect.Descriptor instead.
+func (*BulkEmailResult) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BulkEmailResult) GetEmailId() uint64 {
+	if x != nil {
+		return x.EmailId
+	}
+	return 0
+}
+
+func (x *BulkEmailResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkEmailResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkEmailsResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BulkEmailResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BulkEmailsResults) Reset() {
+	*x = BulkEmailsResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkEmailsResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEmailsResults) ProtoMessage() {}
+
+func (x *BulkEmailsResults) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEmailsResults.ProtoReflect.Descriptor instead.
+func (*BulkEmailsResults) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BulkEmailsResults) GetResults() []*BulkEmailResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MailboxAndLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mailbox string `protobuf:"bytes,1,opt,name=mailbox,proto3" json:"mailbox,omitempty"`
+	Login   string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Offset  int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *MailboxAndLogin) Reset() {
+	*x = MailboxAndLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailboxAndLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailboxAndLogin) ProtoMessage() {}
+
+func (x *MailboxAndLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailboxAndLogin.ProtoReflect.Descriptor instead.
+func (*MailboxAndLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MailboxAndLogin) GetMailbox() string {
+	if x != nil {
+		return x.Mailbox
+	}
+	return ""
+}
+
+func (x *MailboxAndLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *MailboxAndLogin) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *MailboxAndLogin) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MailboxDelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MailboxId    uint32                 `protobuf:"varint,1,opt,name=mailboxId,proto3" json:"mailboxId,omitempty"`
+	DelegateId   uint32                 `protobuf:"varint,2,opt,name=delegateId,proto3" json:"delegateId,omitempty"`
+	Mailbox      string                 `protobuf:"bytes,3,opt,name=mailbox,proto3" json:"mailbox,omitempty"`
+	Delegate     string                 `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Role         string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	CreationDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+}
+
+func (x *MailboxDelegate) Reset() {
+	*x = MailboxDelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailboxDelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailboxDelegate) ProtoMessage() {}
+
+func (x *MailboxDelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailboxDelegate.ProtoReflect.Descriptor instead.
+func (*MailboxDelegate) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MailboxDelegate) GetMailboxId() uint32 {
+	if x != nil {
+		return x.MailboxId
+	}
+	return 0
+}
+
+func (x *MailboxDelegate) GetDelegateId() uint32 {
+	if x != nil {
+		return x.DelegateId
+	}
+	return 0
+}
+
+func (x *MailboxDelegate) GetMailbox() string {
+	if x != nil {
+		return x.Mailbox
+	}
+	return ""
+}
+
+func (x *MailboxDelegate) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *MailboxDelegate) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MailboxDelegate) GetCreationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+type MailboxDelegates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegates []*MailboxDelegate `protobuf:"bytes,1,rep,name=delegates,proto3" json:"delegates,omitempty"`
+}
+
+func (x *MailboxDelegates) Reset() {
+	*x = MailboxDelegates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailboxDelegates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailboxDelegates) ProtoMessage() {}
+
+func (x *MailboxDelegates) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailboxDelegates.ProtoReflect.Descriptor instead.
+func (*MailboxDelegates) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MailboxDelegates) GetDelegates() []*MailboxDelegate {
+	if x != nil {
+		return x.Delegates
+	}
+	return nil
+}
+
+type MailboxDelegateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mailbox  string `protobuf:"bytes,1,opt,name=mailbox,proto3" json:"mailbox,omitempty"`
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Login    string `protobuf:"bytes,4,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *MailboxDelegateRequest) Reset() {
+	*x = MailboxDelegateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailboxDelegateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailboxDelegateRequest) ProtoMessage() {}
+
+func (x *MailboxDelegateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailboxDelegateRequest.ProtoReflect.Descriptor instead.
+func (*MailboxDelegateRequest) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MailboxDelegateRequest) GetMailbox() string {
+	if x != nil {
+		return x.Mailbox
+	}
+	return ""
+}
+
+func (x *MailboxDelegateRequest) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *MailboxDelegateRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MailboxDelegateRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type DelegateAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mailbox      string                 `protobuf:"bytes,2,opt,name=mailbox,proto3" json:"mailbox,omitempty"`
+	Delegate     string                 `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Action       string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	EmailId      uint64                 `protobuf:"varint,5,opt,name=emailId,proto3" json:"emailId,omitempty"`
+	CreationDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+}
+
+func (x *DelegateAction) Reset() {
+	*x = DelegateAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateAction) ProtoMessage() {}
+
+func (x *DelegateAction) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateAction.ProtoReflect.Descriptor instead.
+func (*DelegateAction) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DelegateAction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DelegateAction) GetMailbox() string {
+	if x != nil {
+		return x.Mailbox
+	}
+	return ""
+}
+
+func (x *DelegateAction) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *DelegateAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DelegateAction) GetEmailId() uint64 {
+	if x != nil {
+		return x.EmailId
+	}
+	return 0
+}
+
+func (x *DelegateAction) GetCreationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+type DelegateActions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*DelegateAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *DelegateActions) Reset() {
+	*x = DelegateActions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateActions) ProtoMessage() {}
+
+func (x *DelegateActions) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateActions.ProtoReflect.Descriptor instead.
+func (*DelegateActions) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DelegateActions) GetActions() []*DelegateAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type GMailLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *GMailLogin) Reset() {
+	*x = GMailLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailLogin) ProtoMessage() {}
+
+func (x *GMailLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailLogin.ProtoReflect.Descriptor instead.
+func (*GMailLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GMailLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type GMailLogins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logins []string `protobuf:"bytes,1,rep,name=logins,proto3" json:"logins,omitempty"`
+}
+
+func (x *GMailLogins) Reset() {
+	*x = GMailLogins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailLogins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailLogins) ProtoMessage() {}
+
+func (x *GMailLogins) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailLogins.ProtoReflect.Descriptor instead.
+func (*GMailLogins) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GMailLogins) GetLogins() []string {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
+type GMailSyncState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HistoryId uint64 `protobuf:"varint,1,opt,name=historyId,proto3" json:"historyId,omitempty"`
+	Found     bool   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *GMailSyncState) Reset() {
+	*x = GMailSyncState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailSyncState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailSyncState) ProtoMessage() {}
+
+func (x *GMailSyncState) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailSyncState.ProtoReflect.Descriptor instead.
+func (*GMailSyncState) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GMailSyncState) GetHistoryId() uint64 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *GMailSyncState) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type GMailMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThreadId  string                 `protobuf:"bytes,2,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	LabelIds  []string               `protobuf:"bytes,3,rep,name=labelIds,proto3" json:"labelIds,omitempty"`
+	Subject   string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Sender    string                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string                 `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Text      string                 `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	Date      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *GMailMessage) Reset() {
+	*x = GMailMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailMessage) ProtoMessage() {}
+
+func (x *GMailMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailMessage.ProtoReflect.Descriptor instead.
+func (*GMailMessage) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GMailMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GMailMessage) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *GMailMessage) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *GMailMessage) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *GMailMessage) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *GMailMessage) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *GMailMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *GMailMessage) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GMailMessages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*GMailMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *GMailMessages) Reset() {
+	*x = GMailMessages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailMessages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailMessages) ProtoMessage() {}
+
+func (x *GMailMessages) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailMessages.ProtoReflect.Descriptor instead.
+func (*GMailMessages) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GMailMessages) GetMessages() []*GMailMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type GMailLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *GMailLabel) Reset() {
+	*x = GMailLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailLabel) ProtoMessage() {}
+
+func (x *GMailLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailLabel.ProtoReflect.Descriptor instead.
+func (*GMailLabel) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GMailLabel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GMailLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GMailLabel) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GMailLabels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labels []*GMailLabel `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *GMailLabels) Reset() {
+	*x = GMailLabels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailLabels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailLabels) ProtoMessage() {}
+
+func (x *GMailLabels) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailLabels.ProtoReflect.Descriptor instead.
+func (*GMailLabels) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GMailLabels) GetLabels() []*GMailLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type GMailSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login      string          `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	HistoryId  uint64          `protobuf:"varint,2,opt,name=historyId,proto3" json:"historyId,omitempty"`
+	Full       bool            `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	Messages   []*GMailMessage `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	DeletedIds []string        `protobuf:"bytes,5,rep,name=deletedIds,proto3" json:"deletedIds,omitempty"`
+	Labels     []*GMailLabel   `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *GMailSync) Reset() {
+	*x = GMailSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailSync) ProtoMessage() {}
+
+func (x *GMailSync) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailSync.ProtoReflect.Descriptor instead.
+func (*GMailSync) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GMailSync) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GMailSync) GetHistoryId() uint64 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *GMailSync) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *GMailSync) GetMessages() []*GMailMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GMailSync) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+func (x *GMailSync) GetLabels() []*GMailLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type GMailLabelAndLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login      string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	LabelId    string                 `protobuf:"bytes,2,opt,name=labelId,proto3" json:"labelId,omitempty"`
+	Limit      int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	BeforeDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=beforeDate,proto3" json:"beforeDate,omitempty"`
+	BeforeId   string                 `protobuf:"bytes,5,opt,name=beforeId,proto3" json:"beforeId,omitempty"`
+}
+
+func (x *GMailLabelAndLogin) Reset() {
+	*x = GMailLabelAndLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailLabelAndLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailLabelAndLogin) ProtoMessage() {}
+
+func (x *GMailLabelAndLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailLabelAndLogin.ProtoReflect.Descriptor instead.
+func (*GMailLabelAndLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GMailLabelAndLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GMailLabelAndLogin) GetLabelId() string {
+	if x != nil {
+		return x.LabelId
+	}
+	return ""
+}
+
+func (x *GMailLabelAndLogin) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GMailLabelAndLogin) GetBeforeDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BeforeDate
+	}
+	return nil
+}
+
+func (x *GMailLabelAndLogin) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+type GMailMessageLabels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login          string   `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Id             string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	AddLabelIds    []string `protobuf:"bytes,3,rep,name=addLabelIds,proto3" json:"addLabelIds,omitempty"`
+	RemoveLabelIds []string `protobuf:"bytes,4,rep,name=removeLabelIds,proto3" json:"removeLabelIds,omitempty"`
+}
+
+func (x *GMailMessageLabels) Reset() {
+	*x = GMailMessageLabels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailMessageLabels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailMessageLabels) ProtoMessage() {}
+
+func (x *GMailMessageLabels) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailMessageLabels.ProtoReflect.Descriptor instead.
+func (*GMailMessageLabels) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GMailMessageLabels) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GMailMessageLabels) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GMailMessageLabels) GetAddLabelIds() []string {
+	if x != nil {
+		return x.AddLabelIds
+	}
+	return nil
+}
+
+func (x *GMailMessageLabels) GetRemoveLabelIds() []string {
+	if x != nil {
+		return x.RemoveLabelIds
+	}
+	return nil
+}
+
+type GMailMessageIdAndLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GMailMessageIdAndLogin) Reset() {
+	*x = GMailMessageIdAndLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_email_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GMailMessageIdAndLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GMailMessageIdAndLogin) ProtoMessage() {}
+
+func (x *GMailMessageIdAndLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_email_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GMailMessageIdAndLogin.ProtoReflect.Descriptor instead.
+func (*GMailMessageIdAndLogin) Descriptor() ([]byte, []int) {
+	return file_email_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GMailMessageIdAndLogin) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GMailMessageIdAndLogin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_email_proto protoreflect.FileDescriptor
+
+var file_email_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x0f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64,
+	0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x56,
+	0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2e, 0x0a, 0x06, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x24, 0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x06,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xab, 0x04, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x70, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x24, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x41, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x57, 0x69, 0x74,
+	0x68, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x11,
+	0x49, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x09,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0x25, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x41, 0x64,
+	0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x22,
+	0x3b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xab, 0x01, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69,
+	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77,
+	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7c, 0x0a, 0x0e, 0x41, 0x64, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x26, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22,
+	0x49, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x41, 0x64,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xad, 0x02, 0x0a, 0x0b, 0x4d, 0x61,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x73,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x22, 0x38, 0x0a, 0x0c, 0x4d, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x22, 0x54, 0x0a, 0x14, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x73, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x48, 0x0a,
+	0x12, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x7e, 0x0a, 0x18, 0x4d, 0x61, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22,
+	0x5f, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x22, 0x2e, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x22, 0x4a, 0x0a, 0x0e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x37, 0x0a, 0x0f,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x3d, 0x0a, 0x11, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x22, 0x60, 0x0a, 0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x6c, 0x6b, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x5b, 0x0a, 0x0f,
+	0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x11, 0x42, 0x75, 0x6c,
+	0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x30,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x6f, 0x0a, 0x0f, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x41, 0x6e, 0x64, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f,
+	0x78, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x48, 0x0a,
+	0x10, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69,
+	0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x16, 0x4d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x42, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2f, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x22, 0x0a, 0x0a, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x22, 0x25, 0x0a, 0x0b, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x47,
+	0x4d, 0x61, 0x69, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0xea, 0x01, 0x0a, 0x0c, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2e,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x40,
+	0x0a, 0x0d, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x44, 0x0a, 0x0a, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x38, 0x0a, 0x0b, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d,
+	0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x22, 0xcf, 0x01, 0x0a, 0x09, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x3a, 0x0a, 0x0a, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x47, 0x4d, 0x61, 0x69,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x3e,
+	0x0a, 0x16, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x32, 0xd2,
+	0x1b, 0x0a, 0x0c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x73, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a,
+	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x6d, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x57, 0x69,
+	0x74, 0x68, 0x49, 0x44, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x13, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x1a,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d, 0x41, 0x64,
+	0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x42, 0x79, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42,
+	0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x64, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x14, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x16, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x61,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x41, 0x6e,
+	0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x0c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x57, 0x69, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x41, 0x6e,
+	0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x41, 0x6e,
+	0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x10, 0x41, 0x64, 0x64,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x49, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x49, 0x6e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c,
+	0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f,
+	0x78, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61,
+	0x69, 0x6c, 0x62, 0x6f, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f,
+	0x78, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x4d, 0x61, 0x69, 0x6c,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x53,
+	0x79, 0x6e, 0x63, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d,
+	0x61, 0x69, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x4d, 0x61, 0x69, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_email_proto_rawDescOnce sync.Once
+	file_email_proto_rawDescData = file_email_proto_rawDesc
+)
+
+func file_email_proto_rawDescGZIP() []byte {
+	file_email_proto_rawDescOnce.Do(func() {
+		file_email_proto_rawDescData = protoimpl.X.CompressGZIP(file_email_proto_rawDescData)
+	})
+	return file_email_proto_rawDescData
+}
+
+var file_email_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_email_proto_goTypes = []interface{}{
+	(*EmailIdAndLogin)(nil),          // 0: proto.EmailIdAndLogin
+	(*LoginOffsetLimit)(nil),         // 1: proto.LoginOffsetLimit
+	(*Emails)(nil),                   // 2: proto.Emails
+	(*Email)(nil),                    // 3: proto.Email
+	(*EmailWithID)(nil),              // 4: proto.EmailWithID
+	(*LoginWithID)(nil),              // 5: proto.LoginWithID
+	(*IdSenderRecipient)(nil),        // 6: proto.IdSenderRecipient
+	(*UnreadCount)(nil),              // 7: proto.UnreadCount
+	(*Recipient)(nil),                // 8: proto.Recipient
+	(*StatusEmail)(nil),              // 9: proto.StatusEmail
+	(*EmptyEmail)(nil),               // 10: proto.EmptyEmail
+	(*File)(nil),                     // 11: proto.File
+	(*AddAttachmentRequest)(nil),     // 12: proto.AddAttachmentRequest
+	(*AddAttachmentReply)(nil),       // 13: proto.AddAttachmentReply
+	(*GetFileByIDRequest)(nil),       // 14: proto.GetFileByIDRequest
+	(*GetFileByIDReply)(nil),         // 15: proto.GetFileByIDReply
+	(*GetFilesByEmailIDRequest)(nil), // 16: proto.GetFilesByEmailIDRequest
+	(*GetFilesByEmailIDReply)(nil),   // 17: proto.GetFilesByEmailIDReply
+	(*DeleteFileByIDRequest)(nil),    // 18: proto.DeleteFileByIDRequest
+	(*DeleteFileByIDReply)(nil),      // 19: proto.DeleteFileByIDReply
+	(*UpdateFileByIDRequest)(nil),    // 20: proto.UpdateFileByIDRequest
+	(*UpdateFileByIDReply)(nil),      // 21: proto.UpdateFileByIDReply
+	(*AddFileRequest)(nil),           // 22: proto.AddFileRequest
+	(*AddFileReply)(nil),             // 23: proto.AddFileReply
+	(*AddFileToEmailRequest)(nil),    // 24: proto.AddFileToEmailRequest
+	(*AddFileToEmailReply)(nil),      // 25: proto.AddFileToEmailReply
+	(*MailingList)(nil),              // 26: proto.MailingList
+	(*MailingLists)(nil),             // 27: proto.MailingLists
+	(*MailingListWithLogin)(nil),     // 28: proto.MailingListWithLogin
+	(*MailingListIdAndLogin)(nil),    // 29: proto.MailingListIdAndLogin
+	(*MailingListMember)(nil),        // 30: proto.MailingListMember
+	(*MailingListMembers)(nil),       // 31: proto.MailingListMembers
+	(*MailingListMemberRequest)(nil), // 32: proto.MailingListMemberRequest
+	(*ListUnsubscribeToken)(nil),     // 33: proto.ListUnsubscribeToken
+	(*ModerateEmailRequest)(nil),     // 34: proto.ModerateEmailRequest
+	(*Label)(nil),                    // 35: proto.Label
+	(*Labels)(nil),                   // 36: proto.Labels
+	(*LabelWithLogin)(nil),           // 37: proto.LabelWithLogin
+	(*LabelIdAndLogin)(nil),          // 38: proto.LabelIdAndLogin
+	(*LabelNameAndLogin)(nil),        // 39: proto.LabelNameAndLogin
+	(*LabelEmailsRequest)(nil),       // 40: proto.LabelEmailsRequest
+	(*BulkEmailsRequest)(nil),        // 41: proto.BulkEmailsRequest
+	(*BulkEmailResult)(nil),          // 42: proto.BulkEmailResult
+	(*BulkEmailsResults)(nil),        // 43: proto.BulkEmailsResults
+	(*MailboxAndLogin)(nil),          // 44: proto.MailboxAndLogin
+	(*MailboxDelegate)(nil),          // 45: proto.MailboxDelegate
+	(*MailboxDelegates)(nil),         // 46: proto.MailboxDelegates
+	(*MailboxDelegateRequest)(nil),   // 47: proto.MailboxDelegateRequest
+	(*DelegateAction)(nil),           // 48: proto.DelegateAction
+	(*DelegateActions)(nil),          // 49: proto.DelegateActions
+	(*GMailLogin)(nil),               // 50: proto.GMailLogin
+	(*GMailLogins)(nil),              // 51: proto.GMailLogins
+	(*GMailSyncState)(nil),           // 52: proto.GMailSyncState
+	(*GMailMessage)(nil),             // 53: proto.GMailMessage
+	(*GMailMessages)(nil),            // 54: proto.GMailMessages
+	(*GMailLabel)(nil),               // 55: proto.GMailLabel
+	(*GMailLabels)(nil),              // 56: proto.GMailLabels
+	(*GMailSync)(nil),                // 57: proto.GMailSync
+	(*GMailLabelAndLogin)(nil),       // 58: proto.GMailLabelAndLogin
+	(*GMailMessageLabels)(nil),       // 59: proto.GMailMessageLabels
+	(*GMailMessageIdAndLogin)(nil),   // 60: proto.GMailMessageIdAndLogin
+	(*timestamppb.Timestamp)(nil),    // 61: google.protobuf.Timestamp
+}
+var file_email_proto_depIdxs = []int32{
+	3,  // 0: proto.Emails.emails:type_name -> proto.Email
+	61, // 1: proto.Email.dateOfDispatch:type_name -> google.protobuf.Timestamp
+	35, // 2: proto.Email.labels:type_name -> proto.Label
+	3,  // 3: proto.EmailWithID.email:type_name -> proto.Email
+	11, // 4: proto.GetFileByIDReply.file:type_name -> proto.File
+	11, // 5: proto.GetFilesByEmailIDReply.files:type_name -> proto.File
+	61, // 6: proto.MailingList.creationDate:type_name -> google.protobuf.Timestamp
+	26, // 7: proto.MailingLists.lists:type_name -> proto.MailingList
+	26, // 8: proto.MailingListWithLogin.list:type_name -> proto.MailingList
+	30, // 9: proto.MailingListMembers.members:type_name -> proto.MailingListMember
+	35, // 10: proto.Labels.labels:type_name -> proto.Label
+	35, // 11: proto.LabelWithLogin.label:type_name -> proto.Label
+	42, // 12: proto.BulkEmailsResults.results:type_name -> proto.BulkEmailResult
+	61, // 13: proto.MailboxDelegate.creationDate:type_name -> google.protobuf.Timestamp
+	45, // 14: proto.MailboxDelegates.delegates:type_name -> proto.MailboxDelegate
+	61, // 15: proto.DelegateAction.creationDate:type_name -> google.protobuf.Timestamp
+	48, // 16: proto.DelegateActions.actions:type_name -> proto.DelegateAction
+	61, // 17: proto.GMailMessage.date:type_name -> google.protobuf.Timestamp
+	53, // 18: proto.GMailMessages.messages:type_name -> proto.GMailMessage
+	55, // 19: proto.GMailLabels.labels:type_name -> proto.GMailLabel
+	53, // 20: proto.GMailSync.messages:type_name -> proto.GMailMessage
+	55, // 21: proto.GMailSync.labels:type_name -> proto.GMailLabel
+	61, // 22: proto.GMailLabelAndLogin.beforeDate:type_name -> google.protobuf.Timestamp
+	1,  // 23: proto.EmailService.GetAllIncoming:input_type -> proto.LoginOffsetLimit
+	1,  // 24: proto.EmailService.GetAllSent:input_type -> proto.LoginOffsetLimit
+	1,  // 25: proto.EmailService.GetUnreadCount:input_type -> proto.LoginOffsetLimit
+	1,  // 26: proto.EmailService.GetDraftEmails:input_type -> proto.LoginOffsetLimit
+	1,  // 27: proto.EmailService.GetSpamEmails:input_type -> proto.LoginOffsetLimit
+	0,  // 28: proto.EmailService.GetEmailByID:input_type -> proto.EmailIdAndLogin
+	3,  // 29: proto.EmailService.CreateEmail:input_type -> proto.Email
+	6,  // 30: proto.EmailService.CreateProfileEmail:input_type -> proto.IdSenderRecipient
+	8,  // 31: proto.EmailService.CheckRecipientEmail:input_type -> proto.Recipient
+	3,  // 32: proto.EmailService.UpdateEmail:input_type -> proto.Email
+	5,  // 33: proto.EmailService.DeleteEmail:input_type -> proto.LoginWithID
+	3,  // 34: proto.EmailService.AddEmailDraft:input_type -> proto.Email
+	12, // 35: proto.EmailService.AddAttachment:input_type -> proto.AddAttachmentRequest
+	14, // 36: proto.EmailService.GetFileByID:input_type -> proto.GetFileByIDRequest
+	16, // 37: proto.EmailService.GetFilesByEmailID:input_type -> proto.GetFilesByEmailIDRequest
+	18, // 38: proto.EmailService.DeleteFileByID:input_type -> proto.DeleteFileByIDRequest
+	20, // 39: proto.EmailService.UpdateFileByID:input_type -> proto.UpdateFileByIDRequest
+	22, // 40: proto.EmailService.AddFile:input_type -> proto.AddFileRequest
+	24, // 41: proto.EmailService.AddFileToEmail:input_type -> proto.AddFileToEmailRequest
+	28, // 42: proto.EmailService.CreateMailingList:input_type -> proto.MailingListWithLogin
+	1,  // 43: proto.EmailService.GetMailingLists:input_type -> proto.LoginOffsetLimit
+	29, // 44: proto.EmailService.GetMailingListByID:input_type -> proto.MailingListIdAndLogin
+	28, // 45: proto.EmailService.UpdateMailingList:input_type -> proto.MailingListWithLogin
+	29, // 46: proto.EmailService.DeleteMailingList:input_type -> proto.MailingListIdAndLogin
+	29, // 47: proto.EmailService.GetMailingListMembers:input_type -> proto.MailingListIdAndLogin
+	32, // 48: proto.EmailService.AddMailingListMember:input_type -> proto.MailingListMemberRequest
+	32, // 49: proto.EmailService.DeleteMailingListMember:input_type -> proto.MailingListMemberRequest
+	33, // 50: proto.EmailService.UnsubscribeMailingList:input_type -> proto.ListUnsubscribeToken
+	29, // 51: proto.EmailService.GetMailingListModeration:input_type -> proto.MailingListIdAndLogin
+	34, // 52: proto.EmailService.ModerateMailingListEmail:input_type -> proto.ModerateEmailRequest
+	37, // 53: proto.EmailService.CreateLabel:input_type -> proto.LabelWithLogin
+	1,  // 54: proto.EmailService.GetLabels:input_type -> proto.LoginOffsetLimit
+	37, // 55: proto.EmailService.UpdateLabel:input_type -> proto.LabelWithLogin
+	38, // 56: proto.EmailService.DeleteLabel:input_type -> proto.LabelIdAndLogin
+	0,  // 57: proto.EmailService.GetEmailLabels:input_type -> proto.EmailIdAndLogin
+	39, // 58: proto.EmailService.GetAllEmailsInLabel:input_type -> proto.LabelNameAndLogin
+	40, // 59: proto.EmailService.AddEmailsInLabel:input_type -> proto.LabelEmailsRequest
+	40, // 60: proto.EmailService.DeleteEmailsInLabel:input_type -> proto.LabelEmailsRequest
+	41, // 61: proto.EmailService.BulkEmails:input_type -> proto.BulkEmailsRequest
+	44, // 62: proto.EmailService.GetMailboxDelegates:input_type -> proto.MailboxAndLogin
+	1,  // 63: proto.EmailService.GetDelegatedMailboxes:input_type -> proto.LoginOffsetLimit
+	47, // 64: proto.EmailService.AddMailboxDelegate:input_type -> proto.MailboxDelegateRequest
+	47, // 65: proto.EmailService.DeleteMailboxDelegate:input_type -> proto.MailboxDelegateRequest
+	48, // 66: proto.EmailService.AddDelegateAction:input_type -> proto.DelegateAction
+	44, // 67: proto.EmailService.GetDelegateActions:input_type -> proto.MailboxAndLogin
+	50, // 68: proto.EmailService.GetGMailSyncState:input_type -> proto.GMailLogin
+	10, // 69: proto.EmailService.GetGMailLogins:input_type -> proto.EmptyEmail
+	57, // 70: proto.EmailService.ApplyGMailSync:input_type -> proto.GMailSync
+	58, // 71: proto.EmailService.GetGMailMessages:input_type -> proto.GMailLabelAndLogin
+	50, // 72: proto.EmailService.GetGMailLabels:input_type -> proto.GMailLogin
+	59, // 73: proto.EmailService.UpdateGMailMessageLabels:input_type -> proto.GMailMessageLabels
+	60, // 74: proto.EmailService.DeleteGMailMessage:input_type -> proto.GMailMessageIdAndLogin
+	2,  // 75: proto.EmailService.GetAllIncoming:output_type -> proto.Emails
+	2,  // 76: proto.EmailService.GetAllSent:output_type -> proto.Emails
+	7,  // 77: proto.EmailService.GetUnreadCount:output_type -> proto.UnreadCount
+	2,  // 78: proto.EmailService.GetDraftEmails:output_type -> proto.Emails
+	2,  // 79: proto.EmailService.GetSpamEmails:output_type -> proto.Emails
+	3,  // 80: proto.EmailService.GetEmailByID:output_type -> proto.Email
+	4,  // 81: proto.EmailService.CreateEmail:output_type -> proto.EmailWithID
+	10, // 82: proto.EmailService.CreateProfileEmail:output_type -> proto.EmptyEmail
+	10, // 83: proto.EmailService.CheckRecipientEmail:output_type -> proto.EmptyEmail
+	9,  // 84: proto.EmailService.UpdateEmail:output_type -> proto.StatusEmail
+	9,  // 85: proto.EmailService.DeleteEmail:output_type -> proto.StatusEmail
+	4,  // 86: proto.EmailService.AddEmailDraft:output_type -> proto.EmailWithID
+	13, // 87: proto.EmailService.AddAttachment:output_type -> proto.AddAttachmentReply
+	15, // 88: proto.EmailService.GetFileByID:output_type -> proto.GetFileByIDReply
+	17, // 89: proto.EmailService.GetFilesByEmailID:output_type -> proto.GetFilesByEmailIDReply
+	19, // 90: proto.EmailService.DeleteFileByID:output_type -> proto.DeleteFileByIDReply
+	21, // 91: proto.EmailService.UpdateFileByID:output_type -> proto.UpdateFileByIDReply
+	23, // 92: proto.EmailService.AddFile:output_type -> proto.AddFileReply
+	25, // 93: proto.EmailService.AddFileToEmail:output_type -> proto.AddFileToEmailReply
+	26, // 94: proto.EmailService.CreateMailingList:output_type -> proto.MailingList
+	27, // 95: proto.EmailService.GetMailingLists:output_type -> proto.MailingLists
+	26, // 96: proto.EmailService.GetMailingListByID:output_type -> proto.MailingList
+	9,  // 97: proto.EmailService.UpdateMailingList:output_type -> proto.StatusEmail
+	9,  // 98: proto.EmailService.DeleteMailingList:output_type -> proto.StatusEmail
+	31, // 99: proto.EmailService.GetMailingListMembers:output_type -> proto.MailingListMembers
+	9,  // 100: proto.EmailService.AddMailingListMember:output_type -> proto.StatusEmail
+	9,  // 101: proto.EmailService.DeleteMailingListMember:output_type -> proto.StatusEmail
+	9,  // 102: proto.EmailService.UnsubscribeMailingList:output_type -> proto.StatusEmail
+	2,  // 103: proto.EmailService.GetMailingListModeration:output_type -> proto.Emails
+	9,  // 104: proto.EmailService.ModerateMailingListEmail:output_type -> proto.StatusEmail
+	35, // 105: proto.EmailService.CreateLabel:output_type -> proto.Label
+	36, // 106: proto.EmailService.GetLabels:output_type -> proto.Labels
+	9,  // 107: proto.EmailService.UpdateLabel:output_type -> proto.StatusEmail
+	9,  // 108: proto.EmailService.DeleteLabel:output_type -> proto.StatusEmail
+	36, // 109: proto.EmailService.GetEmailLabels:output_type -> proto.Labels
+	2,  // 110: proto.EmailService.GetAllEmailsInLabel:output_type -> proto.Emails
+	9,  // 111: proto.EmailService.AddEmailsInLabel:output_type -> proto.StatusEmail
+	9,  // 112: proto.EmailService.DeleteEmailsInLabel:output_type -> proto.StatusEmail
+	43, // 113: proto.EmailService.BulkEmails:output_type -> proto.BulkEmailsResults
+	46, // 114: proto.EmailService.GetMailboxDelegates:output_type -> proto.MailboxDelegates
+	46, // 115: proto.EmailService.GetDelegatedMailboxes:output_type -> proto.MailboxDelegates
+	9,  // 116: proto.EmailService.AddMailboxDelegate:output_type -> proto.StatusEmail
+	9,  // 117: proto.EmailService.DeleteMailboxDelegate:output_type -> proto.StatusEmail
+	10, // 118: proto.EmailService.AddDelegateAction:output_type -> proto.EmptyEmail
+	49, // 119: proto.EmailService.GetDelegateActions:output_type -> proto.DelegateActions
+	52, // 120: proto.EmailService.GetGMailSyncState:output_type -> proto.GMailSyncState
+	51, // 121: proto.EmailService.GetGMailLogins:output_type -> proto.GMailLogins
+	9,  // 122: proto.EmailService.ApplyGMailSync:output_type -> proto.StatusEmail
+	54, // 123: proto.EmailService.GetGMailMessages:output_type -> proto.GMailMessages
+	56, // 124: proto.EmailService.GetGMailLabels:output_type -> proto.GMailLabels
+	9,  // 125: proto.EmailService.UpdateGMailMessageLabels:output_type -> proto.StatusEmail
+	9,  // 126: proto.EmailService.DeleteGMailMessage:output_type -> proto.StatusEmail
+	75, // [75:127] is the sub-list for method output_type
+	23, // [23:75] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_email_proto_init() }
+func file_email_proto_init() {
+	if File_email_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_email_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailIdAndLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginOffsetLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Emails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Email); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailWithID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginWithID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdSenderRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreadCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAttachmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileByIDReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilesByEmailIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilesByEmailIDReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1788,8 +4815,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginOffsetLimit); i {
+		file_email_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFileByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1800,8 +4827,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Emails); i {
+		file_email_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFileByIDReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1812,8 +4839,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Email); i {
+		file_email_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFileByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1824,8 +4851,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmailWithID); i {
+		file_email_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFileByIDReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1836,8 +4863,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginWithID); i {
+		file_email_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1848,8 +4875,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IdSenderRecipient); i {
+		file_email_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1860,8 +4887,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Recipient); i {
+		file_email_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileToEmailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1872,8 +4899,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusEmail); i {
+		file_email_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFileToEmailReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1884,8 +4911,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyEmail); i {
+		file_email_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailingList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1896,8 +4923,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*File); i {
+		file_email_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailingLists); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1908,8 +4935,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddAttachmentRequest); i {
+		file_email_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailingListWithLogin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1920,8 +4947,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddAttachmentReply); i {
+		file_email_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailingListIdAndLogin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1932,8 +4959,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileByIDRequest); i {
+		file_email_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailingListMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1944,8 +4971,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileByIDReply); i {
+		file_email_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailingListMembers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1956,8 +4983,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFilesByEmailIDRequest); i {
+		file_email_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailingListMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1968,8 +4995,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFilesByEmailIDReply); i {
+		file_email_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUnsubscribeToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1980,8 +5007,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFileByIDRequest); i {
+		file_email_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateEmailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1992,8 +5019,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFileByIDReply); i {
+		file_email_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Label); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2004,8 +5031,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFileByIDRequest); i {
+		file_email_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Labels); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2016,8 +5043,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFileByIDReply); i {
+		file_email_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelWithLogin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2028,8 +5055,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileRequest); i {
+		file_email_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelIdAndLogin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2040,8 +5067,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileReply); i {
+		file_email_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelNameAndLogin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2052,8 +5079,8 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileToEmailRequest); i {
+		file_email_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelEmailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2064,8 +5091,236 @@ func file_email_proto_init() {
 				return nil
 			}
 		}
-		file_email_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFileToEmailReply); i {
+		file_email_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkEmailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkEmailResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkEmailsResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailboxAndLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailboxDelegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailboxDelegates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailboxDelegateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegateAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegateActions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailLogins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailSyncState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailMessages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailLabels); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailSync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailLabelAndLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailMessageLabels); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_email_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GMailMessageIdAndLogin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2083,7 +5338,7 @@ func file_email_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_email_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,24 +21,58 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EmailService_GetAllIncoming_FullMethodName      = "/proto.EmailService/GetAllIncoming"
-	EmailService_GetAllSent_FullMethodName          = "/proto.EmailService/GetAllSent"
-	EmailService_GetDraftEmails_FullMethodName      = "/proto.EmailService/GetDraftEmails"
-	EmailService_GetSpamEmails_FullMethodName       = "/proto.EmailService/GetSpamEmails"
-	EmailService_GetEmailByID_FullMethodName        = "/proto.EmailService/GetEmailByID"
-	EmailService_CreateEmail_FullMethodName         = "/proto.EmailService/CreateEmail"
-	EmailService_CreateProfileEmail_FullMethodName  = "/proto.EmailService/CreateProfileEmail"
-	EmailService_CheckRecipientEmail_FullMethodName = "/proto.EmailService/CheckRecipientEmail"
-	EmailService_UpdateEmail_FullMethodName         = "/proto.EmailService/UpdateEmail"
-	EmailService_DeleteEmail_FullMethodName         = "/proto.EmailService/DeleteEmail"
-	EmailService_AddEmailDraft_FullMethodName       = "/proto.EmailService/AddEmailDraft"
-	EmailService_AddAttachment_FullMethodName       = "/proto.EmailService/AddAttachment"
-	EmailService_GetFileByID_FullMethodName         = "/proto.EmailService/GetFileByID"
-	EmailService_GetFilesByEmailID_FullMethodName   = "/proto.EmailService/GetFilesByEmailID"
-	EmailService_DeleteFileByID_FullMethodName      = "/proto.EmailService/DeleteFileByID"
-	EmailService_UpdateFileByID_FullMethodName      = "/proto.EmailService/UpdateFileByID"
-	EmailService_AddFile_FullMethodName             = "/proto.EmailService/AddFile"
-	EmailService_AddFileToEmail_FullMethodName      = "/proto.EmailService/AddFileToEmail"
+	EmailService_GetAllIncoming_FullMethodName           = "/proto.EmailService/GetAllIncoming"
+	EmailService_GetAllSent_FullMethodName               = "/proto.EmailService/GetAllSent"
+	EmailService_GetUnreadCount_FullMethodName           = "/proto.EmailService/GetUnreadCount"
+	EmailService_GetDraftEmails_FullMethodName           = "/proto.EmailService/GetDraftEmails"
+	EmailService_GetSpamEmails_FullMethodName            = "/proto.EmailService/GetSpamEmails"
+	EmailService_GetEmailByID_FullMethodName             = "/proto.EmailService/GetEmailByID"
+	EmailService_CreateEmail_FullMethodName              = "/proto.EmailService/CreateEmail"
+	EmailService_CreateProfileEmail_FullMethodName       = "/proto.EmailService/CreateProfileEmail"
+	EmailService_CheckRecipientEmail_FullMethodName      = "/proto.EmailService/CheckRecipientEmail"
+	EmailService_UpdateEmail_FullMethodName              = "/proto.EmailService/UpdateEmail"
+	EmailService_DeleteEmail_FullMethodName              = "/proto.EmailService/DeleteEmail"
+	EmailService_AddEmailDraft_FullMethodName            = "/proto.EmailService/AddEmailDraft"
+	EmailService_AddAttachment_FullMethodName            = "/proto.EmailService/AddAttachment"
+	EmailService_GetFileByID_FullMethodName              = "/proto.EmailService/GetFileByID"
+	EmailService_GetFilesByEmailID_FullMethodName        = "/proto.EmailService/GetFilesByEmailID"
+	EmailService_DeleteFileByID_FullMethodName           = "/proto.EmailService/DeleteFileByID"
+	EmailService_UpdateFileByID_FullMethodName           = "/proto.EmailService/UpdateFileByID"
+	EmailService_AddFile_FullMethodName                  = "/proto.EmailService/AddFile"
+	EmailService_AddFileToEmail_FullMethodName           = "/proto.EmailService/AddFileToEmail"
+	EmailService_CreateMailingList_FullMethodName        = "/proto.EmailService/CreateMailingList"
+	EmailService_GetMailingLists_FullMethodName          = "/proto.EmailService/GetMailingLists"
+	EmailService_GetMailingListByID_FullMethodName       = "/proto.EmailService/GetMailingListByID"
+	EmailService_UpdateMailingList_FullMethodName        = "/proto.EmailService/UpdateMailingList"
+	EmailService_DeleteMailingList_FullMethodName        = "/proto.EmailService/DeleteMailingList"
+	EmailService_GetMailingListMembers_FullMethodName    = "/proto.EmailService/GetMailingListMembers"
+	EmailService_AddMailingListMember_FullMethodName     = "/proto.EmailService/AddMailingListMember"
+	EmailService_DeleteMailingListMember_FullMethodName  = "/proto.EmailService/DeleteMailingListMember"
+	EmailService_UnsubscribeMailingList_FullMethodName   = "/proto.EmailService/UnsubscribeMailingList"
+	EmailService_GetMailingListModeration_FullMethodName = "/proto.EmailService/GetMailingListModeration"
+	EmailService_ModerateMailingListEmail_FullMethodName = "/proto.EmailService/ModerateMailingListEmail"
+	EmailService_CreateLabel_FullMethodName              = "/proto.EmailService/CreateLabel"
+	EmailService_GetLabels_FullMethodName                = "/proto.EmailService/GetLabels"
+	EmailService_UpdateLabel_FullMethodName              = "/proto.EmailService/UpdateLabel"
+	EmailService_DeleteLabel_FullMethodName              = "/proto.EmailService/DeleteLabel"
+	EmailService_GetEmailLabels_FullMethodName           = "/proto.EmailService/GetEmailLabels"
+	EmailService_GetAllEmailsInLabel_FullMethodName      = "/proto.EmailService/GetAllEmailsInLabel"
+	EmailService_AddEmailsInLabel_FullMethodName         = "/proto.EmailService/AddEmailsInLabel"
+	EmailService_DeleteEmailsInLabel_FullMethodName      = "/proto.EmailService/DeleteEmailsInLabel"
+	EmailService_BulkEmails_FullMethodName               = "/proto.EmailService/BulkEmails"
+	EmailService_GetMailboxDelegates_FullMethodName      = "/proto.EmailService/GetMailboxDelegates"
+	EmailService_GetDelegatedMailboxes_FullMethodName    = "/proto.EmailService/GetDelegatedMailboxes"
+	EmailService_AddMailboxDelegate_FullMethodName       = "/proto.EmailService/AddMailboxDelegate"
+	EmailService_DeleteMailboxDelegate_FullMethodName    = "/proto.EmailService/DeleteMailboxDelegate"
+	EmailService_AddDelegateAction_FullMethodName        = "/proto.EmailService/AddDelegateAction"
+	EmailService_GetDelegateActions_FullMethodName       = "/proto.EmailService/GetDelegateActions"
+	EmailService_GetGMailSyncState_FullMethodName        = "/proto.EmailService/GetGMailSyncState"
+	EmailService_GetGMailLogins_FullMethodName           = "/proto.EmailService/GetGMailLogins"
+	EmailService_ApplyGMailSync_FullMethodName           = "/proto.EmailService/ApplyGMailSync"
+	EmailService_GetGMailMessages_FullMethodName         = "/proto.EmailService/GetGMailMessages"
+	EmailService_GetGMailLabels_FullMethodName           = "/proto.EmailService/GetGMailLabels"
+	EmailService_UpdateGMailMessageLabels_FullMethodName = "/proto.EmailService/UpdateGMailMessageLabels"
+	EmailService_DeleteGMailMessage_FullMethodName       = "/proto.EmailService/DeleteGMailMessage"
 )
 
 // EmailServiceClient is the client API for EmailService service.
@@ -47,6 +81,7 @@ const (
 type EmailServiceClient interface {
 	GetAllIncoming(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*Emails, error)
 	GetAllSent(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*Emails, error)
+	GetUnreadCount(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*UnreadCount, error)
 	GetDraftEmails(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*Emails, error)
 	GetSpamEmails(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*Emails, error)
 	GetEmailByID(ctx context.Context, in *EmailIdAndLogin, opts ...grpc.CallOption) (*Email, error)
@@ -63,6 +98,39 @@ type EmailServiceClient interface {
 	UpdateFileByID(ctx context.Context, in *UpdateFileByIDRequest, opts ...grpc.CallOption) (*UpdateFileByIDReply, error)
 	AddFile(ctx context.Context, in *AddFileRequest, opts ...grpc.CallOption) (*AddFileReply, error)
 	AddFileToEmail(ctx context.Context, in *AddFileToEmailRequest, opts ...grpc.CallOption) (*AddFileToEmailReply, error)
+	CreateMailingList(ctx context.Context, in *MailingListWithLogin, opts ...grpc.CallOption) (*MailingList, error)
+	GetMailingLists(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*MailingLists, error)
+	GetMailingListByID(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*MailingList, error)
+	UpdateMailingList(ctx context.Context, in *MailingListWithLogin, opts ...grpc.CallOption) (*StatusEmail, error)
+	DeleteMailingList(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*StatusEmail, error)
+	GetMailingListMembers(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*MailingListMembers, error)
+	AddMailingListMember(ctx context.Context, in *MailingListMemberRequest, opts ...grpc.CallOption) (*StatusEmail, error)
+	DeleteMailingListMember(ctx context.Context, in *MailingListMemberRequest, opts ...grpc.CallOption) (*StatusEmail, error)
+	UnsubscribeMailingList(ctx context.Context, in *ListUnsubscribeToken, opts ...grpc.CallOption) (*StatusEmail, error)
+	GetMailingListModeration(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*Emails, error)
+	ModerateMailingListEmail(ctx context.Context, in *ModerateEmailRequest, opts ...grpc.CallOption) (*StatusEmail, error)
+	CreateLabel(ctx context.Context, in *LabelWithLogin, opts ...grpc.CallOption) (*Label, error)
+	GetLabels(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*Labels, error)
+	UpdateLabel(ctx context.Context, in *LabelWithLogin, opts ...grpc.CallOption) (*StatusEmail, error)
+	DeleteLabel(ctx context.Context, in *LabelIdAndLogin, opts ...grpc.CallOption) (*StatusEmail, error)
+	GetEmailLabels(ctx context.Context, in *EmailIdAndLogin, opts ...grpc.CallOption) (*Labels, error)
+	GetAllEmailsInLabel(ctx context.Context, in *LabelNameAndLogin, opts ...grpc.CallOption) (*Emails, error)
+	AddEmailsInLabel(ctx context.Context, in *LabelEmailsRequest, opts ...grpc.CallOption) (*StatusEmail, error)
+	DeleteEmailsInLabel(ctx context.Context, in *LabelEmailsRequest, opts ...grpc.CallOption) (*StatusEmail, error)
+	BulkEmails(ctx context.Context, in *BulkEmailsRequest, opts ...grpc.CallOption) (*BulkEmailsResults, error)
+	GetMailboxDelegates(ctx context.Context, in *MailboxAndLogin, opts ...grpc.CallOption) (*MailboxDelegates, error)
+	GetDelegatedMailboxes(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*MailboxDelegates, error)
+	AddMailboxDelegate(ctx context.Context, in *MailboxDelegateRequest, opts ...grpc.CallOption) (*StatusEmail, error)
+	DeleteMailboxDelegate(ctx context.Context, in *MailboxDelegateRequest, opts ...grpc.CallOption) (*StatusEmail, error)
+	AddDelegateAction(ctx context.Context, in *DelegateAction, opts ...grpc.CallOption) (*EmptyEmail, error)
+	GetDelegateActions(ctx context.Context, in *MailboxAndLogin, opts ...grpc.CallOption) (*DelegateActions, error)
+	GetGMailSyncState(ctx context.Context, in *GMailLogin, opts ...grpc.CallOption) (*GMailSyncState, error)
+	GetGMailLogins(ctx context.Context, in *EmptyEmail, opts ...grpc.CallOption) (*GMailLogins, error)
+	ApplyGMailSync(ctx context.Context, in *GMailSync, opts ...grpc.CallOption) (*StatusEmail, error)
+	GetGMailMessages(ctx context.Context, in *GMailLabelAndLogin, opts ...grpc.CallOption) (*GMailMessages, error)
+	GetGMailLabels(ctx context.Context, in *GMailLogin, opts ...grpc.CallOption) (*GMailLabels, error)
+	UpdateGMailMessageLabels(ctx context.Context, in *GMailMessageLabels, opts ...grpc.CallOption) (*StatusEmail, error)
+	DeleteGMailMessage(ctx context.Context, in *GMailMessageIdAndLogin, opts ...grpc.CallOption) (*StatusEmail, error)
 }
 
 type emailServiceClient struct {
@@ -91,6 +159,15 @@ func (c *emailServiceClient) GetAllSent(ctx context.Context, in *LoginOffsetLimi
 	return out, nil
 }
 
+func (c *emailServiceClient) GetUnreadCount(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*UnreadCount, error) {
+	out := new(UnreadCount)
+	err := c.cc.Invoke(ctx, EmailService_GetUnreadCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *emailServiceClient) GetDraftEmails(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*Emails, error) {
 	out := new(Emails)
 	err := c.cc.Invoke(ctx, EmailService_GetDraftEmails_FullMethodName, in, out, opts...)
@@ -235,12 +312,310 @@ func (c *emailServiceClient) AddFileToEmail(ctx context.Context, in *AddFileToEm
 	return out, nil
 }
 
+func (c *emailServiceClient) CreateMailingList(ctx context.Context, in *MailingListWithLogin, opts ...grpc.CallOption) (*MailingList, error) {
+	out := new(MailingList)
+	err := c.cc.Invoke(ctx, EmailService_CreateMailingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetMailingLists(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*MailingLists, error) {
+	out := new(MailingLists)
+	err := c.cc.Invoke(ctx, EmailService_GetMailingLists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetMailingListByID(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*MailingList, error) {
+	out := new(MailingList)
+	err := c.cc.Invoke(ctx, EmailService_GetMailingListByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) UpdateMailingList(ctx context.Context, in *MailingListWithLogin, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_UpdateMailingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) DeleteMailingList(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_DeleteMailingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetMailingListMembers(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*MailingListMembers, error) {
+	out := new(MailingListMembers)
+	err := c.cc.Invoke(ctx, EmailService_GetMailingListMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) AddMailingListMember(ctx context.Context, in *MailingListMemberRequest, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_AddMailingListMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) DeleteMailingListMember(ctx context.Context, in *MailingListMemberRequest, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_DeleteMailingListMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) UnsubscribeMailingList(ctx context.Context, in *ListUnsubscribeToken, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_UnsubscribeMailingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetMailingListModeration(ctx context.Context, in *MailingListIdAndLogin, opts ...grpc.CallOption) (*Emails, error) {
+	out := new(Emails)
+	err := c.cc.Invoke(ctx, EmailService_GetMailingListModeration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) ModerateMailingListEmail(ctx context.Context, in *ModerateEmailRequest, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_ModerateMailingListEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) CreateLabel(ctx context.Context, in *LabelWithLogin, opts ...grpc.CallOption) (*Label, error) {
+	out := new(Label)
+	err := c.cc.Invoke(ctx, EmailService_CreateLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetLabels(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*Labels, error) {
+	out := new(Labels)
+	err := c.cc.Invoke(ctx, EmailService_GetLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) UpdateLabel(ctx context.Context, in *LabelWithLogin, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_UpdateLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) DeleteLabel(ctx context.Context, in *LabelIdAndLogin, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_DeleteLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetEmailLabels(ctx context.Context, in *EmailIdAndLogin, opts ...grpc.CallOption) (*Labels, error) {
+	out := new(Labels)
+	err := c.cc.Invoke(ctx, EmailService_GetEmailLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetAllEmailsInLabel(ctx context.Context, in *LabelNameAndLogin, opts ...grpc.CallOption) (*Emails, error) {
+	out := new(Emails)
+	err := c.cc.Invoke(ctx, EmailService_GetAllEmailsInLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) AddEmailsInLabel(ctx context.Context, in *LabelEmailsRequest, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_AddEmailsInLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) DeleteEmailsInLabel(ctx context.Context, in *LabelEmailsRequest, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_DeleteEmailsInLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) BulkEmails(ctx context.Context, in *BulkEmailsRequest, opts ...grpc.CallOption) (*BulkEmailsResults, error) {
+	out := new(BulkEmailsResults)
+	err := c.cc.Invoke(ctx, EmailService_BulkEmails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetMailboxDelegates(ctx context.Context, in *MailboxAndLogin, opts ...grpc.CallOption) (*MailboxDelegates, error) {
+	out := new(MailboxDelegates)
+	err := c.cc.Invoke(ctx, EmailService_GetMailboxDelegates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetDelegatedMailboxes(ctx context.Context, in *LoginOffsetLimit, opts ...grpc.CallOption) (*MailboxDelegates, error) {
+	out := new(MailboxDelegates)
+	err := c.cc.Invoke(ctx, EmailService_GetDelegatedMailboxes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) AddMailboxDelegate(ctx context.Context, in *MailboxDelegateRequest, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_AddMailboxDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) DeleteMailboxDelegate(ctx context.Context, in *MailboxDelegateRequest, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_DeleteMailboxDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) AddDelegateAction(ctx context.Context, in *DelegateAction, opts ...grpc.CallOption) (*EmptyEmail, error) {
+	out := new(EmptyEmail)
+	err := c.cc.Invoke(ctx, EmailService_AddDelegateAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetDelegateActions(ctx context.Context, in *MailboxAndLogin, opts ...grpc.CallOption) (*DelegateActions, error) {
+	out := new(DelegateActions)
+	err := c.cc.Invoke(ctx, EmailService_GetDelegateActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetGMailSyncState(ctx context.Context, in *GMailLogin, opts ...grpc.CallOption) (*GMailSyncState, error) {
+	out := new(GMailSyncState)
+	err := c.cc.Invoke(ctx, EmailService_GetGMailSyncState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetGMailLogins(ctx context.Context, in *EmptyEmail, opts ...grpc.CallOption) (*GMailLogins, error) {
+	out := new(GMailLogins)
+	err := c.cc.Invoke(ctx, EmailService_GetGMailLogins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) ApplyGMailSync(ctx context.Context, in *GMailSync, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_ApplyGMailSync_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetGMailMessages(ctx context.Context, in *GMailLabelAndLogin, opts ...grpc.CallOption) (*GMailMessages, error) {
+	out := new(GMailMessages)
+	err := c.cc.Invoke(ctx, EmailService_GetGMailMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetGMailLabels(ctx context.Context, in *GMailLogin, opts ...grpc.CallOption) (*GMailLabels, error) {
+	out := new(GMailLabels)
+	err := c.cc.Invoke(ctx, EmailService_GetGMailLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) UpdateGMailMessageLabels(ctx context.Context, in *GMailMessageLabels, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_UpdateGMailMessageLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) DeleteGMailMessage(ctx context.Context, in *GMailMessageIdAndLogin, opts ...grpc.CallOption) (*StatusEmail, error) {
+	out := new(StatusEmail)
+	err := c.cc.Invoke(ctx, EmailService_DeleteGMailMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmailServiceServer is the server API for EmailService service.
 // All implementations must embed UnimplementedEmailServiceServer
 // for forward compatibility
 type EmailServiceServer interface {
 	GetAllIncoming(context.Context, *LoginOffsetLimit) (*Emails, error)
 	GetAllSent(context.Context, *LoginOffsetLimit) (*Emails, error)
+	GetUnreadCount(context.Context, *LoginOffsetLimit) (*UnreadCount, error)
 	GetDraftEmails(context.Context, *LoginOffsetLimit) (*Emails, error)
 	GetSpamEmails(context.Context, *LoginOffsetLimit) (*Emails, error)
 	GetEmailByID(context.Context, *EmailIdAndLogin) (*Email, error)
@@ -257,6 +632,39 @@ type EmailServiceServer interface {
 	UpdateFileByID(context.Context, *UpdateFileByIDRequest) (*UpdateFileByIDReply, error)
 	AddFile(context.Context, *AddFileRequest) (*AddFileReply, error)
 	AddFileToEmail(context.Context, *AddFileToEmailRequest) (*AddFileToEmailReply, error)
+	CreateMailingList(context.Context, *MailingListWithLogin) (*MailingList, error)
+	GetMailingLists(context.Context, *LoginOffsetLimit) (*MailingLists, error)
+	GetMailingListByID(context.Context, *MailingListIdAndLogin) (*MailingList, error)
+	UpdateMailingList(context.Context, *MailingListWithLogin) (*StatusEmail, error)
+	DeleteMailingList(context.Context, *MailingListIdAndLogin) (*StatusEmail, error)
+	GetMailingListMembers(context.Context, *MailingListIdAndLogin) (*MailingListMembers, error)
+	AddMailingListMember(context.Context, *MailingListMemberRequest) (*StatusEmail, error)
+	DeleteMailingListMember(context.Context, *MailingListMemberRequest) (*StatusEmail, error)
+	UnsubscribeMailingList(context.Context, *ListUnsubscribeToken) (*StatusEmail, error)
+	GetMailingListModeration(context.Context, *MailingListIdAndLogin) (*Emails, error)
+	ModerateMailingListEmail(context.Context, *ModerateEmailRequest) (*StatusEmail, error)
+	CreateLabel(context.Context, *LabelWithLogin) (*Label, error)
+	GetLabels(context.Context, *LoginOffsetLimit) (*Labels, error)
+	UpdateLabel(context.Context, *LabelWithLogin) (*StatusEmail, error)
+	DeleteLabel(context.Context, *LabelIdAndLogin) (*StatusEmail, error)
+	GetEmailLabels(context.Context, *EmailIdAndLogin) (*Labels, error)
+	GetAllEmailsInLabel(context.Context, *LabelNameAndLogin) (*Emails, error)
+	AddEmailsInLabel(context.Context, *LabelEmailsRequest) (*StatusEmail, error)
+	DeleteEmailsInLabel(context.Context, *LabelEmailsRequest) (*StatusEmail, error)
+	BulkEmails(context.Context, *BulkEmailsRequest) (*BulkEmailsResults, error)
+	GetMailboxDelegates(context.Context, *MailboxAndLogin) (*MailboxDelegates, error)
+	GetDelegatedMailboxes(context.Context, *LoginOffsetLimit) (*MailboxDelegates, error)
+	AddMailboxDelegate(context.Context, *MailboxDelegateRequest) (*StatusEmail, error)
+	DeleteMailboxDelegate(context.Context, *MailboxDelegateRequest) (*StatusEmail, error)
+	AddDelegateAction(context.Context, *DelegateAction) (*EmptyEmail, error)
+	GetDelegateActions(context.Context, *MailboxAndLogin) (*DelegateActions, error)
+	GetGMailSyncState(context.Context, *GMailLogin) (*GMailSyncState, error)
+	GetGMailLogins(context.Context, *EmptyEmail) (*GMailLogins, error)
+	ApplyGMailSync(context.Context, *GMailSync) (*StatusEmail, error)
+	GetGMailMessages(context.Context, *GMailLabelAndLogin) (*GMailMessages, error)
+	GetGMailLabels(context.Context, *GMailLogin) (*GMailLabels, error)
+	UpdateGMailMessageLabels(context.Context, *GMailMessageLabels) (*StatusEmail, error)
+	DeleteGMailMessage(context.Context, *GMailMessageIdAndLogin) (*StatusEmail, error)
 	mustEmbedUnimplementedEmailServiceServer()
 }
 
@@ -270,6 +678,9 @@ func (UnimplementedEmailServiceServer) GetAllIncoming(context.Context, *LoginOff
 func (UnimplementedEmailServiceServer) GetAllSent(context.Context, *LoginOffsetLimit) (*Emails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllSent not implemented")
 }
+func (UnimplementedEmailServiceServer) GetUnreadCount(context.Context, *LoginOffsetLimit) (*UnreadCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
 func (UnimplementedEmailServiceServer) GetDraftEmails(context.Context, *LoginOffsetLimit) (*Emails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDraftEmails not implemented")
 }
@@ -306,351 +717,1062 @@ func (UnimplementedEmailServiceServer) GetFileByID(context.Context, *GetFileByID
 func (UnimplementedEmailServiceServer) GetFilesByEmailID(context.Context, *GetFilesByEmailIDRequest) (*GetFilesByEmailIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFilesByEmailID not implemented")
 }
-func (UnimplementedEmailServiceServer) DeleteFileByID(context.Context, *DeleteFileByIDRequest) (*DeleteFileByIDReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileByID not implemented")
+func (UnimplementedEmailServiceServer) DeleteFileByID(context.Context, *DeleteFileByIDRequest) (*DeleteFileByIDReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileByID not implemented")
+}
+func (UnimplementedEmailServiceServer) UpdateFileByID(context.Context, *UpdateFileByIDRequest) (*UpdateFileByIDReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFileByID not implemented")
+}
+func (UnimplementedEmailServiceServer) AddFile(context.Context, *AddFileRequest) (*AddFileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFile not implemented")
+}
+func (UnimplementedEmailServiceServer) AddFileToEmail(context.Context, *AddFileToEmailRequest) (*AddFileToEmailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFileToEmail not implemented")
+}
+func (UnimplementedEmailServiceServer) CreateMailingList(context.Context, *MailingListWithLogin) (*MailingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMailingList not implemented")
+}
+func (UnimplementedEmailServiceServer) GetMailingLists(context.Context, *LoginOffsetLimit) (*MailingLists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailingLists not implemented")
+}
+func (UnimplementedEmailServiceServer) GetMailingListByID(context.Context, *MailingListIdAndLogin) (*MailingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailingListByID not implemented")
+}
+func (UnimplementedEmailServiceServer) UpdateMailingList(context.Context, *MailingListWithLogin) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMailingList not implemented")
+}
+func (UnimplementedEmailServiceServer) DeleteMailingList(context.Context, *MailingListIdAndLogin) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMailingList not implemented")
+}
+func (UnimplementedEmailServiceServer) GetMailingListMembers(context.Context, *MailingListIdAndLogin) (*MailingListMembers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailingListMembers not implemented")
+}
+func (UnimplementedEmailServiceServer) AddMailingListMember(context.Context, *MailingListMemberRequest) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMailingListMember not implemented")
+}
+func (UnimplementedEmailServiceServer) DeleteMailingListMember(context.Context, *MailingListMemberRequest) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMailingListMember not implemented")
+}
+func (UnimplementedEmailServiceServer) UnsubscribeMailingList(context.Context, *ListUnsubscribeToken) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeMailingList not implemented")
+}
+func (UnimplementedEmailServiceServer) GetMailingListModeration(context.Context, *MailingListIdAndLogin) (*Emails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailingListModeration not implemented")
+}
+func (UnimplementedEmailServiceServer) ModerateMailingListEmail(context.Context, *ModerateEmailRequest) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateMailingListEmail not implemented")
+}
+func (UnimplementedEmailServiceServer) CreateLabel(context.Context, *LabelWithLogin) (*Label, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLabel not implemented")
+}
+func (UnimplementedEmailServiceServer) GetLabels(context.Context, *LoginOffsetLimit) (*Labels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabels not implemented")
+}
+func (UnimplementedEmailServiceServer) UpdateLabel(context.Context, *LabelWithLogin) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabel not implemented")
+}
+func (UnimplementedEmailServiceServer) DeleteLabel(context.Context, *LabelIdAndLogin) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLabel not implemented")
+}
+func (UnimplementedEmailServiceServer) GetEmailLabels(context.Context, *EmailIdAndLogin) (*Labels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailLabels not implemented")
+}
+func (UnimplementedEmailServiceServer) GetAllEmailsInLabel(context.Context, *LabelNameAndLogin) (*Emails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllEmailsInLabel not implemented")
+}
+func (UnimplementedEmailServiceServer) AddEmailsInLabel(context.Context, *LabelEmailsRequest) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEmailsInLabel not implemented")
+}
+func (UnimplementedEmailServiceServer) DeleteEmailsInLabel(context.Context, *LabelEmailsRequest) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmailsInLabel not implemented")
+}
+func (UnimplementedEmailServiceServer) BulkEmails(context.Context, *BulkEmailsRequest) (*BulkEmailsResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkEmails not implemented")
+}
+func (UnimplementedEmailServiceServer) GetMailboxDelegates(context.Context, *MailboxAndLogin) (*MailboxDelegates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailboxDelegates not implemented")
+}
+func (UnimplementedEmailServiceServer) GetDelegatedMailboxes(context.Context, *LoginOffsetLimit) (*MailboxDelegates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatedMailboxes not implemented")
+}
+func (UnimplementedEmailServiceServer) AddMailboxDelegate(context.Context, *MailboxDelegateRequest) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMailboxDelegate not implemented")
+}
+func (UnimplementedEmailServiceServer) DeleteMailboxDelegate(context.Context, *MailboxDelegateRequest) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMailboxDelegate not implemented")
+}
+func (UnimplementedEmailServiceServer) AddDelegateAction(context.Context, *DelegateAction) (*EmptyEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDelegateAction not implemented")
+}
+func (UnimplementedEmailServiceServer) GetDelegateActions(context.Context, *MailboxAndLogin) (*DelegateActions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateActions not implemented")
+}
+func (UnimplementedEmailServiceServer) GetGMailSyncState(context.Context, *GMailLogin) (*GMailSyncState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGMailSyncState not implemented")
+}
+func (UnimplementedEmailServiceServer) GetGMailLogins(context.Context, *EmptyEmail) (*GMailLogins, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGMailLogins not implemented")
+}
+func (UnimplementedEmailServiceServer) ApplyGMailSync(context.Context, *GMailSync) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyGMailSync not implemented")
+}
+func (UnimplementedEmailServiceServer) GetGMailMessages(context.Context, *GMailLabelAndLogin) (*GMailMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGMailMessages not implemented")
+}
+func (UnimplementedEmailServiceServer) GetGMailLabels(context.Context, *GMailLogin) (*GMailLabels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGMailLabels not implemented")
+}
+func (UnimplementedEmailServiceServer) UpdateGMailMessageLabels(context.Context, *GMailMessageLabels) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGMailMessageLabels not implemented")
+}
+func (UnimplementedEmailServiceServer) DeleteGMailMessage(context.Context, *GMailMessageIdAndLogin) (*StatusEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGMailMessage not implemented")
+}
+func (UnimplementedEmailServiceServer) mustEmbedUnimplementedEmailServiceServer() {}
+
+// UnsafeEmailServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmailServiceServer will
+// result in compilation errors.
+type UnsafeEmailServiceServer interface {
+	mustEmbedUnimplementedEmailServiceServer()
+}
+
+func RegisterEmailServiceServer(s grpc.ServiceRegistrar, srv EmailServiceServer) {
+	s.RegisterService(&EmailService_ServiceDesc, srv)
+}
+
+func _EmailService_GetAllIncoming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetAllIncoming(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetAllIncoming_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetAllIncoming(ctx, req.(*LoginOffsetLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetAllSent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetAllSent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetAllSent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetAllSent(ctx, req.(*LoginOffsetLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetUnreadCount(ctx, req.(*LoginOffsetLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetDraftEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetDraftEmails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetDraftEmails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetDraftEmails(ctx, req.(*LoginOffsetLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetSpamEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetSpamEmails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetSpamEmails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetSpamEmails(ctx, req.(*LoginOffsetLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetEmailByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailIdAndLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetEmailByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetEmailByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetEmailByID(ctx, req.(*EmailIdAndLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_CreateEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Email)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).CreateEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_CreateEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).CreateEmail(ctx, req.(*Email))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_CreateProfileEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdSenderRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).CreateProfileEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_CreateProfileEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).CreateProfileEmail(ctx, req.(*IdSenderRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_CheckRecipientEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Recipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).CheckRecipientEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_CheckRecipientEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).CheckRecipientEmail(ctx, req.(*Recipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_UpdateEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Email)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).UpdateEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_UpdateEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).UpdateEmail(ctx, req.(*Email))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_DeleteEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).DeleteEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_DeleteEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).DeleteEmail(ctx, req.(*LoginWithID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_AddEmailDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Email)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).AddEmailDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_AddEmailDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).AddEmailDraft(ctx, req.(*Email))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_AddAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).AddAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_AddAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).AddAttachment(ctx, req.(*AddAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetFileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetFileByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetFileByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetFileByID(ctx, req.(*GetFileByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetFilesByEmailID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilesByEmailIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetFilesByEmailID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetFilesByEmailID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetFilesByEmailID(ctx, req.(*GetFilesByEmailIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_DeleteFileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).DeleteFileByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_DeleteFileByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).DeleteFileByID(ctx, req.(*DeleteFileByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_UpdateFileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFileByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).UpdateFileByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_UpdateFileByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).UpdateFileByID(ctx, req.(*UpdateFileByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_AddFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).AddFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_AddFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).AddFile(ctx, req.(*AddFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_AddFileToEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFileToEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).AddFileToEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_AddFileToEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).AddFileToEmail(ctx, req.(*AddFileToEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_CreateMailingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListWithLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).CreateMailingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_CreateMailingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).CreateMailingList(ctx, req.(*MailingListWithLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetMailingLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetMailingLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetMailingLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetMailingLists(ctx, req.(*LoginOffsetLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetMailingListByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListIdAndLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetMailingListByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetMailingListByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetMailingListByID(ctx, req.(*MailingListIdAndLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_UpdateMailingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListWithLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).UpdateMailingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_UpdateMailingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).UpdateMailingList(ctx, req.(*MailingListWithLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_DeleteMailingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListIdAndLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).DeleteMailingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_DeleteMailingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).DeleteMailingList(ctx, req.(*MailingListIdAndLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetMailingListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListIdAndLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetMailingListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetMailingListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetMailingListMembers(ctx, req.(*MailingListIdAndLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_AddMailingListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).AddMailingListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_AddMailingListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).AddMailingListMember(ctx, req.(*MailingListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_DeleteMailingListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).DeleteMailingListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_DeleteMailingListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).DeleteMailingListMember(ctx, req.(*MailingListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_UnsubscribeMailingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnsubscribeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).UnsubscribeMailingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_UnsubscribeMailingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).UnsubscribeMailingList(ctx, req.(*ListUnsubscribeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetMailingListModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailingListIdAndLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetMailingListModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetMailingListModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetMailingListModeration(ctx, req.(*MailingListIdAndLogin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmailServiceServer) UpdateFileByID(context.Context, *UpdateFileByIDRequest) (*UpdateFileByIDReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFileByID not implemented")
+
+func _EmailService_ModerateMailingListEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).ModerateMailingListEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_ModerateMailingListEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).ModerateMailingListEmail(ctx, req.(*ModerateEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmailServiceServer) AddFile(context.Context, *AddFileRequest) (*AddFileReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFile not implemented")
+
+func _EmailService_CreateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelWithLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).CreateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_CreateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).CreateLabel(ctx, req.(*LabelWithLogin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmailServiceServer) AddFileToEmail(context.Context, *AddFileToEmailRequest) (*AddFileToEmailReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFileToEmail not implemented")
+
+func _EmailService_GetLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetLabels(ctx, req.(*LoginOffsetLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmailServiceServer) mustEmbedUnimplementedEmailServiceServer() {}
 
-// UnsafeEmailServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EmailServiceServer will
-// result in compilation errors.
-type UnsafeEmailServiceServer interface {
-	mustEmbedUnimplementedEmailServiceServer()
+func _EmailService_UpdateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelWithLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).UpdateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_UpdateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).UpdateLabel(ctx, req.(*LabelWithLogin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterEmailServiceServer(s grpc.ServiceRegistrar, srv EmailServiceServer) {
-	s.RegisterService(&EmailService_ServiceDesc, srv)
+func _EmailService_DeleteLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelIdAndLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).DeleteLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_DeleteLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).DeleteLabel(ctx, req.(*LabelIdAndLogin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_GetAllIncoming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginOffsetLimit)
+func _EmailService_GetEmailLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailIdAndLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).GetAllIncoming(ctx, in)
+		return srv.(EmailServiceServer).GetEmailLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_GetAllIncoming_FullMethodName,
+		FullMethod: EmailService_GetEmailLabels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).GetAllIncoming(ctx, req.(*LoginOffsetLimit))
+		return srv.(EmailServiceServer).GetEmailLabels(ctx, req.(*EmailIdAndLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_GetAllSent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginOffsetLimit)
+func _EmailService_GetAllEmailsInLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelNameAndLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).GetAllSent(ctx, in)
+		return srv.(EmailServiceServer).GetAllEmailsInLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_GetAllSent_FullMethodName,
+		FullMethod: EmailService_GetAllEmailsInLabel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).GetAllSent(ctx, req.(*LoginOffsetLimit))
+		return srv.(EmailServiceServer).GetAllEmailsInLabel(ctx, req.(*LabelNameAndLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_GetDraftEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginOffsetLimit)
+func _EmailService_AddEmailsInLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelEmailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).GetDraftEmails(ctx, in)
+		return srv.(EmailServiceServer).AddEmailsInLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_GetDraftEmails_FullMethodName,
+		FullMethod: EmailService_AddEmailsInLabel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).GetDraftEmails(ctx, req.(*LoginOffsetLimit))
+		return srv.(EmailServiceServer).AddEmailsInLabel(ctx, req.(*LabelEmailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_GetSpamEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginOffsetLimit)
+func _EmailService_DeleteEmailsInLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelEmailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).GetSpamEmails(ctx, in)
+		return srv.(EmailServiceServer).DeleteEmailsInLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_GetSpamEmails_FullMethodName,
+		FullMethod: EmailService_DeleteEmailsInLabel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).GetSpamEmails(ctx, req.(*LoginOffsetLimit))
+		return srv.(EmailServiceServer).DeleteEmailsInLabel(ctx, req.(*LabelEmailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_GetEmailByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmailIdAndLogin)
+func _EmailService_BulkEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkEmailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).GetEmailByID(ctx, in)
+		return srv.(EmailServiceServer).BulkEmails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_GetEmailByID_FullMethodName,
+		FullMethod: EmailService_BulkEmails_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).GetEmailByID(ctx, req.(*EmailIdAndLogin))
+		return srv.(EmailServiceServer).BulkEmails(ctx, req.(*BulkEmailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_CreateEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Email)
+func _EmailService_GetMailboxDelegates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailboxAndLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).CreateEmail(ctx, in)
+		return srv.(EmailServiceServer).GetMailboxDelegates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_CreateEmail_FullMethodName,
+		FullMethod: EmailService_GetMailboxDelegates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).CreateEmail(ctx, req.(*Email))
+		return srv.(EmailServiceServer).GetMailboxDelegates(ctx, req.(*MailboxAndLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_CreateProfileEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IdSenderRecipient)
+func _EmailService_GetDelegatedMailboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOffsetLimit)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).CreateProfileEmail(ctx, in)
+		return srv.(EmailServiceServer).GetDelegatedMailboxes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_CreateProfileEmail_FullMethodName,
+		FullMethod: EmailService_GetDelegatedMailboxes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).CreateProfileEmail(ctx, req.(*IdSenderRecipient))
+		return srv.(EmailServiceServer).GetDelegatedMailboxes(ctx, req.(*LoginOffsetLimit))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_CheckRecipientEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Recipient)
+func _EmailService_AddMailboxDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailboxDelegateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).CheckRecipientEmail(ctx, in)
+		return srv.(EmailServiceServer).AddMailboxDelegate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_CheckRecipientEmail_FullMethodName,
+		FullMethod: EmailService_AddMailboxDelegate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).CheckRecipientEmail(ctx, req.(*Recipient))
+		return srv.(EmailServiceServer).AddMailboxDelegate(ctx, req.(*MailboxDelegateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_UpdateEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Email)
+func _EmailService_DeleteMailboxDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailboxDelegateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).UpdateEmail(ctx, in)
+		return srv.(EmailServiceServer).DeleteMailboxDelegate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_UpdateEmail_FullMethodName,
+		FullMethod: EmailService_DeleteMailboxDelegate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).UpdateEmail(ctx, req.(*Email))
+		return srv.(EmailServiceServer).DeleteMailboxDelegate(ctx, req.(*MailboxDelegateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_DeleteEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginWithID)
+func _EmailService_AddDelegateAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateAction)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).DeleteEmail(ctx, in)
+		return srv.(EmailServiceServer).AddDelegateAction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_DeleteEmail_FullMethodName,
+		FullMethod: EmailService_AddDelegateAction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).DeleteEmail(ctx, req.(*LoginWithID))
+		return srv.(EmailServiceServer).AddDelegateAction(ctx, req.(*DelegateAction))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_AddEmailDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Email)
+func _EmailService_GetDelegateActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MailboxAndLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).AddEmailDraft(ctx, in)
+		return srv.(EmailServiceServer).GetDelegateActions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_AddEmailDraft_FullMethodName,
+		FullMethod: EmailService_GetDelegateActions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).AddEmailDraft(ctx, req.(*Email))
+		return srv.(EmailServiceServer).GetDelegateActions(ctx, req.(*MailboxAndLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_AddAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAttachmentRequest)
+func _EmailService_GetGMailSyncState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GMailLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).AddAttachment(ctx, in)
+		return srv.(EmailServiceServer).GetGMailSyncState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_AddAttachment_FullMethodName,
+		FullMethod: EmailService_GetGMailSyncState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).AddAttachment(ctx, req.(*AddAttachmentRequest))
+		return srv.(EmailServiceServer).GetGMailSyncState(ctx, req.(*GMailLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_GetFileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileByIDRequest)
+func _EmailService_GetGMailLogins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyEmail)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).GetFileByID(ctx, in)
+		return srv.(EmailServiceServer).GetGMailLogins(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_GetFileByID_FullMethodName,
+		FullMethod: EmailService_GetGMailLogins_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).GetFileByID(ctx, req.(*GetFileByIDRequest))
+		return srv.(EmailServiceServer).GetGMailLogins(ctx, req.(*EmptyEmail))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_GetFilesByEmailID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFilesByEmailIDRequest)
+func _EmailService_ApplyGMailSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GMailSync)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).GetFilesByEmailID(ctx, in)
+		return srv.(EmailServiceServer).ApplyGMailSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_GetFilesByEmailID_FullMethodName,
+		FullMethod: EmailService_ApplyGMailSync_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).GetFilesByEmailID(ctx, req.(*GetFilesByEmailIDRequest))
+		return srv.(EmailServiceServer).ApplyGMailSync(ctx, req.(*GMailSync))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_DeleteFileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFileByIDRequest)
+func _EmailService_GetGMailMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GMailLabelAndLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).DeleteFileByID(ctx, in)
+		return srv.(EmailServiceServer).GetGMailMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_DeleteFileByID_FullMethodName,
+		FullMethod: EmailService_GetGMailMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).DeleteFileByID(ctx, req.(*DeleteFileByIDRequest))
+		return srv.(EmailServiceServer).GetGMailMessages(ctx, req.(*GMailLabelAndLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_UpdateFileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateFileByIDRequest)
+func _EmailService_GetGMailLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GMailLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).UpdateFileByID(ctx, in)
+		return srv.(EmailServiceServer).GetGMailLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_UpdateFileByID_FullMethodName,
+		FullMethod: EmailService_GetGMailLabels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).UpdateFileByID(ctx, req.(*UpdateFileByIDRequest))
+		return srv.(EmailServiceServer).GetGMailLabels(ctx, req.(*GMailLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_AddFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFileRequest)
+func _EmailService_UpdateGMailMessageLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GMailMessageLabels)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).AddFile(ctx, in)
+		return srv.(EmailServiceServer).UpdateGMailMessageLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_AddFile_FullMethodName,
+		FullMethod: EmailService_UpdateGMailMessageLabels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).AddFile(ctx, req.(*AddFileRequest))
+		return srv.(EmailServiceServer).UpdateGMailMessageLabels(ctx, req.(*GMailMessageLabels))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmailService_AddFileToEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFileToEmailRequest)
+func _EmailService_DeleteGMailMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GMailMessageIdAndLogin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmailServiceServer).AddFileToEmail(ctx, in)
+		return srv.(EmailServiceServer).DeleteGMailMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmailService_AddFileToEmail_FullMethodName,
+		FullMethod: EmailService_DeleteGMailMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmailServiceServer).AddFileToEmail(ctx, req.(*AddFileToEmailRequest))
+		return srv.(EmailServiceServer).DeleteGMailMessage(ctx, req.(*GMailMessageIdAndLogin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -670,6 +1792,10 @@ var EmailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllSent",
 			Handler:    _EmailService_GetAllSent_Handler,
 		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _EmailService_GetUnreadCount_Handler,
+		},
 		{
 			MethodName: "GetDraftEmails",
 			Handler:    _EmailService_GetDraftEmails_Handler,
@@ -734,6 +1860,138 @@ var EmailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddFileToEmail",
 			Handler:    _EmailService_AddFileToEmail_Handler,
 		},
+		{
+			MethodName: "CreateMailingList",
+			Handler:    _EmailService_CreateMailingList_Handler,
+		},
+		{
+			MethodName: "GetMailingLists",
+			Handler:    _EmailService_GetMailingLists_Handler,
+		},
+		{
+			MethodName: "GetMailingListByID",
+			Handler:    _EmailService_GetMailingListByID_Handler,
+		},
+		{
+			MethodName: "UpdateMailingList",
+			Handler:    _EmailService_UpdateMailingList_Handler,
+		},
+		{
+			MethodName: "DeleteMailingList",
+			Handler:    _EmailService_DeleteMailingList_Handler,
+		},
+		{
+			MethodName: "GetMailingListMembers",
+			Handler:    _EmailService_GetMailingListMembers_Handler,
+		},
+		{
+			MethodName: "AddMailingListMember",
+			Handler:    _EmailService_AddMailingListMember_Handler,
+		},
+		{
+			MethodName: "DeleteMailingListMember",
+			Handler:    _EmailService_DeleteMailingListMember_Handler,
+		},
+		{
+			MethodName: "UnsubscribeMailingList",
+			Handler:    _EmailService_UnsubscribeMailingList_Handler,
+		},
+		{
+			MethodName: "GetMailingListModeration",
+			Handler:    _EmailService_GetMailingListModeration_Handler,
+		},
+		{
+			MethodName: "ModerateMailingListEmail",
+			Handler:    _EmailService_ModerateMailingListEmail_Handler,
+		},
+		{
+			MethodName: "CreateLabel",
+			Handler:    _EmailService_CreateLabel_Handler,
+		},
+		{
+			MethodName: "GetLabels",
+			Handler:    _EmailService_GetLabels_Handler,
+		},
+		{
+			MethodName: "UpdateLabel",
+			Handler:    _EmailService_UpdateLabel_Handler,
+		},
+		{
+			MethodName: "DeleteLabel",
+			Handler:    _EmailService_DeleteLabel_Handler,
+		},
+		{
+			MethodName: "GetEmailLabels",
+			Handler:    _EmailService_GetEmailLabels_Handler,
+		},
+		{
+			MethodName: "GetAllEmailsInLabel",
+			Handler:    _EmailService_GetAllEmailsInLabel_Handler,
+		},
+		{
+			MethodName: "AddEmailsInLabel",
+			Handler:    _EmailService_AddEmailsInLabel_Handler,
+		},
+		{
+			MethodName: "DeleteEmailsInLabel",
+			Handler:    _EmailService_DeleteEmailsInLabel_Handler,
+		},
+		{
+			MethodName: "BulkEmails",
+			Handler:    _EmailService_BulkEmails_Handler,
+		},
+		{
+			MethodName: "GetMailboxDelegates",
+			Handler:    _EmailService_GetMailboxDelegates_Handler,
+		},
+		{
+			MethodName: "GetDelegatedMailboxes",
+			Handler:    _EmailService_GetDelegatedMailboxes_Handler,
+		},
+		{
+			MethodName: "AddMailboxDelegate",
+			Handler:    _EmailService_AddMailboxDelegate_Handler,
+		},
+		{
+			MethodName: "DeleteMailboxDelegate",
+			Handler:    _EmailService_DeleteMailboxDelegate_Handler,
+		},
+		{
+			MethodName: "AddDelegateAction",
+			Handler:    _EmailService_AddDelegateAction_Handler,
+		},
+		{
+			MethodName: "GetDelegateActions",
+			Handler:    _EmailService_GetDelegateActions_Handler,
+		},
+		{
+			MethodName: "GetGMailSyncState",
+			Handler:    _EmailService_GetGMailSyncState_Handler,
+		},
+		{
+			MethodName: "GetGMailLogins",
+			Handler:    _EmailService_GetGMailLogins_Handler,
+		},
+		{
+			MethodName: "ApplyGMailSync",
+			Handler:    _EmailService_ApplyGMailSync_Handler,
+		},
+		{
+			MethodName: "GetGMailMessages",
+			Handler:    _EmailService_GetGMailMessages_Handler,
+		},
+		{
+			MethodName: "GetGMailLabels",
+			Handler:    _EmailService_GetGMailLabels_Handler,
+		},
+		{
+			MethodName: "UpdateGMailMessageLabels",
+			Handler:    _EmailService_UpdateGMailMessageLabels_Handler,
+		},
+		{
+			MethodName: "DeleteGMailMessage",
+			Handler:    _EmailService_DeleteGMailMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "email.proto",
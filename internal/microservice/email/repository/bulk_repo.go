@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// bulkActionQueries are the statements that apply simple bulk actions to the emails of the profile.
+// The arguments of each statement are the profile ID and the email IDs.
+var bulkActionQueries = map[string]string{
+	domain.BulkActionRead:    `UPDATE email SET isRead = TRUE WHERE id IN (SELECT email_id FROM profile_email WHERE profile_id = ? AND email_id IN (?))`,
+	domain.BulkActionUnread:  `UPDATE email SET isRead = FALSE WHERE id IN (SELECT email_id FROM profile_email WHERE profile_id = ? AND email_id IN (?))`,
+	domain.BulkActionFlag:    `UPDATE email SET is_important = TRUE WHERE id IN (SELECT email_id FROM profile_email WHERE profile_id = ? AND email_id IN (?))`,
+	domain.BulkActionUnflag:  `UPDATE email SET is_important = FALSE WHERE id IN (SELECT email_id FROM profile_email WHERE profile_id = ? AND email_id IN (?))`,
+	domain.BulkActionSpam:    `UPDATE email SET isSpam = TRUE WHERE id IN (SELECT email_id FROM profile_email WHERE profile_id = ? AND email_id IN (?))`,
+	domain.BulkActionNotSpam: `UPDATE email SET isSpam = FALSE WHERE id IN (SELECT email_id FROM profile_email WHERE profile_id = ? AND email_id IN (?))`,
+	domain.BulkActionDelete:  `DELETE FROM profile_email WHERE profile_id = ? AND email_id IN (?)`,
+}
+
+// bulkGet runs one query of the bulk operation inside the transaction and scans a single result.
+func (r *EmailRepository) bulkGet(tx *sqlx.Tx, dest interface{}, query string, args []interface{}, ctx context.Context) (err error) {
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+	query = tx.Rebind(query)
+
+	start := time.Now()
+	err = tx.Get(dest, query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	return err
+}
+
+// bulkSelect runs one query of the bulk operation inside the transaction and scans all the rows.
+func (r *EmailRepository) bulkSelect(tx *sqlx.Tx, dest interface{}, query string, args []interface{}, ctx context.Context) (err error) {
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+	query = tx.Rebind(query)
+
+	start := time.Now()
+	err = tx.Select(dest, query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	return err
+}
+
+// bulkExec runs one statement of the bulk operation inside the transaction.
+func (r *EmailRepository) bulkExec(tx *sqlx.Tx, query string, args []interface{}, ctx context.Context) (err error) {
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+	query = tx.Rebind(query)
+
+	start := time.Now()
+	_, err = tx.Exec(query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	return err
+}
+
+// BulkEmails applies the action to the emails of the profile in one transaction.
+// Ownership is checked once for the whole batch; the IDs of the emails the action
+// was applied to are returned, emails of other users are skipped.
+func (r *EmailRepository) BulkEmails(request *domain.BulkRequest, login string, ctx context.Context) ([]uint64, error) {
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var profileID uint32
+	err = r.bulkGet(tx, &profileID, `SELECT id FROM profile WHERE login = ?`, []interface{}{login}, ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("user %s not found", login)
+		}
+		return nil, fmt.Errorf("failed to get profile: %v", err)
+	}
+
+	var ownedIDs []uint64
+	err = r.bulkSelect(tx, &ownedIDs, `
+		SELECT email_id FROM profile_email
+		WHERE profile_id = ? AND email_id IN (?)
+		ORDER BY email_id
+		FOR UPDATE
+	`, []interface{}{profileID, request.EmailIDs}, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check emails: %v", err)
+	}
+
+	if len(ownedIDs) == 0 {
+		return ownedIDs, nil
+	}
+
+	switch request.Action {
+	case domain.BulkActionMove:
+		err = r.bulkMove(tx, profileID, request.FolderID, ownedIDs, ctx)
+	case domain.BulkActionLabel:
+		err = r.bulkLabel(tx, profileID, request.LabelID, ownedIDs, ctx)
+	default:
+		query, ok := bulkActionQueries[request.Action]
+		if !ok {
+			return nil, fmt.Errorf("invalid bulk action: %s", request.Action)
+		}
+		err = r.bulkExec(tx, query, []interface{}{profileID, ownedIDs}, ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply action %s: %v", request.Action, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return ownedIDs, nil
+}
+
+// bulkMove removes the emails from all folders of the profile and puts them in the target folder.
+func (r *EmailRepository) bulkMove(tx *sqlx.Tx, profileID, folderID uint32, emailIDs []uint64, ctx context.Context) error {
+	var count int
+	err := r.bulkGet(tx, &count, `SELECT COUNT(*) FROM folder WHERE id = ? AND profile_id = ?`, []interface{}{folderID, profileID}, ctx)
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return fmt.Errorf("folder with id %d not found", folderID)
+	}
+
+	err = r.bulkExec(tx, `
+		DELETE FROM folder_email
+		WHERE email_id IN (?) AND folder_id IN (SELECT id FROM folder WHERE profile_id = ?)
+	`, []interface{}{emailIDs, profileID}, ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.bulkExec(tx, `
+		INSERT INTO folder_email (folder_id, email_id)
+		SELECT ?, e.id FROM email e WHERE e.id IN (?)
+	`, []interface{}{folderID, emailIDs}, ctx)
+}
+
+// bulkLabel puts the label of the profile on the emails; emails that already have the label are skipped.
+func (r *EmailRepository) bulkLabel(tx *sqlx.Tx, profileID, labelID uint32, emailIDs []uint64, ctx context.Context) error {
+	var count int
+	err := r.bulkGet(tx, &count, `SELECT COUNT(*) FROM label WHERE id = ? AND profile_id = ?`, []interface{}{labelID, profileID}, ctx)
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return fmt.Errorf("label with id %d not found", labelID)
+	}
+
+	return r.bulkExec(tx, `
+		INSERT INTO email_label (label_id, email_id)
+		SELECT ?, e.id FROM email e WHERE e.id IN (?)
+		ON CONFLICT DO NOTHING
+	`, []interface{}{labelID, emailIDs}, ctx)
+}
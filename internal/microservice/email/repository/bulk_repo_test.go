@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestBulkEmails(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("MarkRead", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM profile WHERE login = \?`).
+			WithArgs(login).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectQuery(`SELECT email_id FROM profile_email`).
+			WithArgs(uint32(2), uint64(1), uint64(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"email_id"}).AddRow(1))
+		mock.ExpectExec(`UPDATE email SET isRead = TRUE`).
+			WithArgs(uint32(2), uint64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ids, err := repo.BulkEmails(&domain.BulkRequest{Action: domain.BulkActionRead, EmailIDs: []uint64{1, 3}}, login, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM profile WHERE login = \?`).
+			WithArgs(login).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectQuery(`SELECT email_id FROM profile_email`).
+			WithArgs(uint32(2), uint64(1), uint64(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"email_id"}).AddRow(1).AddRow(3))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM folder`).
+			WithArgs(uint32(5), uint32(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectExec(`DELETE FROM folder_email`).
+			WithArgs(uint64(1), uint64(3), uint32(2)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`INSERT INTO folder_email \(folder_id, email_id\)`).
+			WithArgs(uint32(5), uint64(1), uint64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		ids, err := repo.BulkEmails(&domain.BulkRequest{Action: domain.BulkActionMove, EmailIDs: []uint64{1, 3}, FolderID: 5}, login, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{1, 3}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ForeignLabelRollsBack", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM profile WHERE login = \?`).
+			WithArgs(login).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectQuery(`SELECT email_id FROM profile_email`).
+			WithArgs(uint32(2), uint64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"email_id"}).AddRow(1))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM label`).
+			WithArgs(uint32(9), uint32(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectRollback()
+
+		ids, err := repo.BulkEmails(&domain.BulkRequest{Action: domain.BulkActionLabel, EmailIDs: []uint64{1}, LabelID: 9}, login, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FailedStatementRollsBack", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM profile WHERE login = \?`).
+			WithArgs(login).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectQuery(`SELECT email_id FROM profile_email`).
+			WithArgs(uint32(2), uint64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"email_id"}).AddRow(1))
+		mock.ExpectExec(`DELETE FROM profile_email`).
+			WithArgs(uint32(2), uint64(1)).
+			WillReturnError(fmt.Errorf("db error"))
+		mock.ExpectRollback()
+
+		ids, err := repo.BulkEmails(&domain.BulkRequest{Action: domain.BulkActionDelete, EmailIDs: []uint64{1}}, login, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoOwnedEmails", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM profile WHERE login = \?`).
+			WithArgs(login).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectQuery(`SELECT email_id FROM profile_email`).
+			WithArgs(uint32(2), uint64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"email_id"}))
+		mock.ExpectRollback()
+
+		ids, err := repo.BulkEmails(&domain.BulkRequest{Action: domain.BulkActionSpam, EmailIDs: []uint64{7}}, login, ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -32,8 +32,8 @@ func NewEmailRepository(db *sqlx.DB) *EmailRepository {
 // Add adds a new email to the storage and returns its assigned unique identifier.
 func (r *EmailRepository) Add(emailModelCore *domain.Email, ctx context.Context) (uint64, *domain.Email, error) {
 	insertEmailQuery := `
-		INSERT INTO email (topic, text, date_of_dispatch, sender_email, recipient_email, isRead, isDeleted, isDraft, isSpam, reply_to_email_id, is_important)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO email (topic, text, date_of_dispatch, sender_email, recipient_email, isRead, isDeleted, isDraft, isSpam, reply_to_email_id, is_important, sent_by_email)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id
 	`
 
@@ -50,9 +50,9 @@ func (r *EmailRepository) Add(emailModelCore *domain.Email, ctx context.Context)
 	emailModelDb := converters.EmailConvertCoreInDb(emailModelCore)
 	format := "2006/01/02 15:04:05"
 
-	err = r.DB.QueryRow(insertEmailQuery, emailModelDb.Topic, emailModelDb.Text, time.Now().Format(format), emailModelDb.SenderEmail, emailModelDb.RecipientEmail, emailModelDb.ReadStatus, emailModelDb.Deleted, emailModelDb.DraftStatus, emailModelCore.SpamStatus, emailModelDb.ReplyToEmailID, emailModelDb.Flag).Scan(&id)
+	err = r.DB.QueryRow(insertEmailQuery, emailModelDb.Topic, emailModelDb.Text, time.Now().Format(format), emailModelDb.SenderEmail, emailModelDb.RecipientEmail, emailModelDb.ReadStatus, emailModelDb.Deleted, emailModelDb.DraftStatus, emailModelCore.SpamStatus, emailModelDb.ReplyToEmailID, emailModelDb.Flag, emailModelDb.SentByEmail).Scan(&id)
 
-	args := []interface{}{emailModelDb.Topic, emailModelDb.Text, time.Now().Format(format), emailModelDb.SenderEmail, emailModelDb.RecipientEmail, emailModelDb.ReadStatus, emailModelDb.Deleted, emailModelDb.DraftStatus, emailModelDb.ReplyToEmailID, emailModelDb.Flag, emailModelDb.SenderEmail}
+	args := []interface{}{emailModelDb.Topic, emailModelDb.Text, time.Now().Format(format), emailModelDb.SenderEmail, emailModelDb.RecipientEmail, emailModelDb.ReadStatus, emailModelDb.Deleted, emailModelDb.DraftStatus, emailModelDb.ReplyToEmailID, emailModelDb.Flag, emailModelDb.SentByEmail}
 	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(insertEmailQuery, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
 
 	if err != nil {
@@ -141,13 +141,18 @@ func (r *EmailRepository) FindEmail(login string, ctx context.Context) error {
 // GetAllIncoming returns all emails incoming from the storage.
 func (r *EmailRepository) GetAllIncoming(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error) {
 	query := `
-		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 		FROM email e
 		JOIN profile_email pe ON e.id = pe.email_id
 		JOIN profile p ON pe.profile_id = (
 			SELECT id FROM profile WHERE login = $1
 		)
-		WHERE e.recipient_email = $1 AND e.isSpam = false AND e.isDraft = false
+		WHERE (e.recipient_email = $1 OR e.recipient_email IN (
+			SELECT ml.address FROM mailing_list ml
+			JOIN mailing_list_member mlm ON mlm.list_id = ml.id
+			JOIN profile lp ON lp.id = mlm.profile_id
+			WHERE lp.login = $1
+		)) AND e.isSpam = false AND e.isDraft = false
 		ORDER BY e.date_of_dispatch DESC
 	`
 
@@ -183,10 +188,41 @@ func (r *EmailRepository) GetAllIncoming(login string, offset, limit int64, ctx
 	return emailsModelCore, nil
 }
 
+// CountUnreadIncoming returns the number of the incoming emails which have not been read.
+func (r *EmailRepository) CountUnreadIncoming(login string, ctx context.Context) (int64, error) {
+	query := `
+		SELECT COUNT(DISTINCT e.id)
+		FROM email e
+		JOIN profile_email pe ON e.id = pe.email_id
+		JOIN profile p ON pe.profile_id = (
+			SELECT id FROM profile WHERE login = $1
+		)
+		WHERE (e.recipient_email = $1 OR e.recipient_email IN (
+			SELECT ml.address FROM mailing_list ml
+			JOIN mailing_list_member mlm ON mlm.list_id = ml.id
+			JOIN profile lp ON lp.id = mlm.profile_id
+			WHERE lp.login = $1
+		)) AND e.isRead = false AND e.isSpam = false AND e.isDraft = false
+	`
+
+	var count int64
+	start := time.Now()
+	err := r.DB.Get(&count, query, login)
+
+	args := []interface{}{login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread emails: %v", err)
+	}
+
+	return count, nil
+}
+
 // GetAllSent returns all emails sent from the storage.
 func (r *EmailRepository) GetAllSent(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error) {
 	query := `
-		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 		FROM email e
 		JOIN profile_email pe ON e.id = pe.email_id
 		JOIN profile p ON pe.profile_id = (
@@ -232,7 +268,7 @@ func (r *EmailRepository) GetAllSent(login string, offset, limit int64, ctx cont
 // GetAllDraft returns all draft emails from the storage.
 func (r *EmailRepository) GetAllDraft(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error) {
 	query := `
-		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 		FROM email e
 		JOIN profile_email pe ON e.id = pe.email_id
 		JOIN profile p ON pe.profile_id = (
@@ -277,7 +313,7 @@ func (r *EmailRepository) GetAllDraft(login string, offset, limit int64, ctx con
 // GetAllSpam returns all draft emails from the storage.
 func (r *EmailRepository) GetAllSpam(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error) {
 	query := `
-		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 		FROM email e
 		JOIN profile_email pe ON e.id = pe.email_id
 		JOIN profile p ON pe.profile_id = (
@@ -322,7 +358,7 @@ func (r *EmailRepository) GetAllSpam(login string, offset, limit int64, ctx cont
 // GetByID returns the email by its unique identifier.
 func (r *EmailRepository) GetByID(id uint64, login string, ctx context.Context) (*domain.Email, error) {
 	query := `
-		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 		FROM email e
 		JOIN profile_email pe ON e.id = pe.email_id
 		JOIN profile p ON pe.profile_id = (
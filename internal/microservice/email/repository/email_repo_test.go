@@ -79,11 +79,11 @@ func TestAddEmail(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 		mock.ExpectQuery(`
-			INSERT INTO email \(topic, text, date_of_dispatch, sender_email, recipient_email, isRead, isDeleted, isDraft, isSpam, reply_to_email_id, is_important\)
-			VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)
+			INSERT INTO email \(topic, text, date_of_dispatch, sender_email, recipient_email, isRead, isDeleted, isDraft, isSpam, reply_to_email_id, is_important, sent_by_email\)
+			VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12\)
 			RETURNING id
 		`).
-			WithArgs(email.Topic, email.Text, sqlmock.AnyArg(), email.SenderEmail, email.RecipientEmail, email.ReadStatus, email.Deleted, email.DraftStatus, email.SpamStatus, nil, email.Flag).
+			WithArgs(email.Topic, email.Text, sqlmock.AnyArg(), email.SenderEmail, email.RecipientEmail, email.ReadStatus, email.Deleted, email.DraftStatus, email.SpamStatus, nil, email.Flag, nil).
 			WillReturnRows(rows)
 
 		mock.ExpectExec(`
@@ -116,10 +116,10 @@ func TestAddEmail(t *testing.T) {
 		}
 
 		mock.ExpectQuery(`
-			INSERT INTO email \(topic, text, date_of_dispatch, sender_email, recipient_email, isRead, isDeleted, isDraft, isSpam, reply_to_email_id, is_important\)
-			VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)
+			INSERT INTO email \(topic, text, date_of_dispatch, sender_email, recipient_email, isRead, isDeleted, isDraft, isSpam, reply_to_email_id, is_important, sent_by_email\)
+			VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12\)
 			RETURNING id
-		`).WithArgs(email.Topic, email.Text, sqlmock.AnyArg(), email.SenderEmail, email.RecipientEmail, email.ReadStatus, email.Deleted, email.DraftStatus, email.SpamStatus, nil, email.Flag).
+		`).WithArgs(email.Topic, email.Text, sqlmock.AnyArg(), email.SenderEmail, email.RecipientEmail, email.ReadStatus, email.Deleted, email.DraftStatus, email.SpamStatus, nil, email.Flag, nil).
 			WillReturnError(fmt.Errorf("failed to insert email"))
 
 		mock.ExpectExec(`
@@ -317,13 +317,18 @@ func TestGetAllIncoming(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su")
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
 				SELECT id FROM profile WHERE login = \$1
 			\)
-			WHERE e.recipient_email = \$1 AND e.isSpam = false AND e.isDraft = false
+			WHERE \(e.recipient_email = \$1 OR e.recipient_email IN \(
+				SELECT ml.address FROM mailing_list ml
+				JOIN mailing_list_member mlm ON mlm.list_id = ml.id
+				JOIN profile lp ON lp.id = mlm.profile_id
+				WHERE lp.login = \$1
+			\)\) AND e.isSpam = false AND e.isDraft = false
 			ORDER BY e.date_of_dispatch DESC
 		`).WillReturnRows(rows)
 
@@ -344,13 +349,18 @@ func TestGetAllIncoming(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su")
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
 				SELECT id FROM profile WHERE login = \$1
 			\)
-			WHERE e.recipient_email = \$1 AND e.isSpam = false AND e.isDraft = false
+			WHERE \(e.recipient_email = \$1 OR e.recipient_email IN \(
+				SELECT ml.address FROM mailing_list ml
+				JOIN mailing_list_member mlm ON mlm.list_id = ml.id
+				JOIN profile lp ON lp.id = mlm.profile_id
+				WHERE lp.login = \$1
+			\)\) AND e.isSpam = false AND e.isDraft = false
 			ORDER BY e.date_of_dispatch DESC
 			OFFSET \$2 LIMIT \$3
 		`).WillReturnRows(rows)
@@ -362,13 +372,18 @@ func TestGetAllIncoming(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
 				SELECT id FROM profile WHERE login = \$1
 			\)
-			WHERE e.recipient_email = \$1 AND e.isSpam = false AND e.isDraft = false
+			WHERE \(e.recipient_email = \$1 OR e.recipient_email IN \(
+				SELECT ml.address FROM mailing_list ml
+				JOIN mailing_list_member mlm ON mlm.list_id = ml.id
+				JOIN profile lp ON lp.id = mlm.profile_id
+				WHERE lp.login = \$1
+			\)\) AND e.isSpam = false AND e.isDraft = false
 			ORDER BY e.date_of_dispatch DESC
 		`).WillReturnError(sql.ErrNoRows)
 
@@ -408,7 +423,7 @@ func TestGetAllSent(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su")
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -434,7 +449,7 @@ func TestGetAllSent(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su")
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -452,7 +467,7 @@ func TestGetAllSent(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -498,7 +513,7 @@ func TestGetAllDraft(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su", true)
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -524,7 +539,7 @@ func TestGetAllDraft(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su", true)
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -542,7 +557,7 @@ func TestGetAllDraft(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -588,7 +603,7 @@ func TestGetAllSpam(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su", true)
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -614,7 +629,7 @@ func TestGetAllSpam(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3", "test@mailhub.su", true)
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -632,7 +647,7 @@ func TestGetAllSpam(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -669,7 +684,7 @@ func TestGetByID(t *testing.T) {
 		expectedEmail := &domain.Email{ID: 1, Topic: "Topic 1", Text: "Text 1", SenderEmail: login}
 		rows := sqlmock.NewRows([]string{"id", "topic", "text", "sender_email"}).AddRow(expectedEmail.ID, expectedEmail.Topic, expectedEmail.Text, login)
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -685,7 +700,7 @@ func TestGetByID(t *testing.T) {
 
 	t.Run("EmailNotFound", func(t *testing.T) {
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
 			JOIN profile p ON pe.profile_id = \(
@@ -1231,6 +1246,42 @@ func TestUpdateFileByID(t *testing.T) {
 	})
 }
 
+func TestCountUnreadIncoming(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+	login := "test@mailhub.su"
+
+	t.Run("CountUnreadIncoming_Success", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COUNT\(DISTINCT e\.id\)`).
+			WithArgs(login).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+		count, err := repo.CountUnreadIncoming(login, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), count)
+	})
+
+	t.Run("CountUnreadIncoming_Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COUNT\(DISTINCT e\.id\)`).
+			WithArgs(login).
+			WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.CountUnreadIncoming(login, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetAvatarFileIDByLogin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
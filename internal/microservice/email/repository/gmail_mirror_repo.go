@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"mail/internal/microservice/models/repository_models"
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+)
+
+// GetGMailHistoryID returns the identifier of the latest change of the Gmail mailbox copied locally,
+// found is false if the mailbox has never been synchronized.
+func (r *EmailRepository) GetGMailHistoryID(login string, ctx context.Context) (uint64, bool, error) {
+	query := `SELECT history_id FROM gmail_sync WHERE login = $1`
+
+	var historyID uint64
+	start := time.Now()
+	err := r.DB.Get(&historyID, query, login)
+
+	args := []interface{}{login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to get gmail history id: %v", err)
+	}
+
+	return historyID, true, nil
+}
+
+// GetGMailLogins returns the Gmail mailboxes which have a local copy, the least recently synchronized first.
+func (r *EmailRepository) GetGMailLogins(ctx context.Context) ([]string, error) {
+	query := `SELECT login FROM gmail_sync ORDER BY sync_date`
+
+	var logins []string
+	start := time.Now()
+	err := r.DB.Select(&logins, query)
+
+	args := []interface{}{}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gmail logins: %v", err)
+	}
+
+	return logins, nil
+}
+
+// ApplyGMailSync applies the changes of the Gmail mailbox to its local copy in one transaction,
+// so that the history identifier always matches the copied emails.
+func (r *EmailRepository) ApplyGMailSync(sync *domain.GMailSync, ctx context.Context) error {
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	err = r.bulkExec(tx, `
+		INSERT INTO gmail_sync (login, history_id, sync_date) VALUES (?, ?, ?)
+		ON CONFLICT (login) DO UPDATE SET history_id = EXCLUDED.history_id, sync_date = EXCLUDED.sync_date
+	`, []interface{}{sync.Login, sync.HistoryID, time.Now()}, ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save gmail history id: %v", err)
+	}
+
+	if sync.Full {
+		if err = r.bulkExec(tx, `DELETE FROM gmail_message WHERE login = ?`, []interface{}{sync.Login}, ctx); err != nil {
+			return fmt.Errorf("failed to clear gmail messages: %v", err)
+		}
+	}
+
+	if err = r.bulkExec(tx, `DELETE FROM gmail_label WHERE login = ?`, []interface{}{sync.Login}, ctx); err != nil {
+		return fmt.Errorf("failed to clear gmail labels: %v", err)
+	}
+	for _, label := range sync.Labels {
+		err = r.bulkExec(tx, `INSERT INTO gmail_label (login, label_id, name, type) VALUES (?, ?, ?, ?)`,
+			[]interface{}{sync.Login, label.ID, label.Name, label.Type}, ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save gmail label: %v", err)
+		}
+	}
+
+	if len(sync.DeletedIDs) > 0 {
+		err = r.bulkExec(tx, `DELETE FROM gmail_message WHERE login = ? AND message_id IN (?)`, []interface{}{sync.Login, sync.DeletedIDs}, ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete gmail messages: %v", err)
+		}
+	}
+
+	for _, message := range sync.Messages {
+		m := converters.GMailMessageConvertCoreInDb(sync.Login, message)
+		err = r.bulkExec(tx, `
+			INSERT INTO gmail_message (login, message_id, thread_id, label_ids, subject, sender, recipient, text, date)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (login, message_id) DO UPDATE SET
+				thread_id = EXCLUDED.thread_id, label_ids = EXCLUDED.label_ids, subject = EXCLUDED.subject,
+				sender = EXCLUDED.sender, recipient = EXCLUDED.recipient, text = EXCLUDED.text, date = EXCLUDED.date
+		`, []interface{}{m.Login, m.ID, m.ThreadID, m.LabelIDs, m.Subject, m.Sender, m.Recipient, m.Text, m.Date}, ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save gmail message: %v", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// GetGMailMessages returns the newest emails of the local copy of the Gmail mailbox with the label,
+// older than the email with beforeDate and beforeID unless beforeDate is zero.
+func (r *EmailRepository) GetGMailMessages(login, labelID string, beforeDate time.Time, beforeID string, limit int64, ctx context.Context) ([]*domain.GMailMessage, error) {
+	query := `
+		SELECT login, message_id, thread_id, label_ids, subject, sender, recipient, text, date
+		FROM gmail_message
+		WHERE login = $1 AND $2 = ANY(string_to_array(label_ids, ','))
+	`
+	args := []interface{}{login, labelID}
+	if !beforeDate.IsZero() {
+		query += ` AND (date, message_id) < ($3, $4)`
+		args = append(args, beforeDate, beforeID)
+	}
+	query += fmt.Sprintf(` ORDER BY date DESC, message_id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, limit)
+
+	var messagesModelDb []repository_models.GMailMessage
+	start := time.Now()
+	err := r.DB.Select(&messagesModelDb, query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gmail messages: %v", err)
+	}
+
+	messagesModelCore := make([]*domain.GMailMessage, 0, len(messagesModelDb))
+	for _, m := range messagesModelDb {
+		messagesModelCore = append(messagesModelCore, converters.GMailMessageConvertDbInCore(&m))
+	}
+
+	return messagesModelCore, nil
+}
+
+// GetGMailLabels returns the labels of the local copy of the Gmail mailbox.
+func (r *EmailRepository) GetGMailLabels(login string, ctx context.Context) ([]*domain.GMailLabel, error) {
+	query := `SELECT login, label_id, name, type FROM gmail_label WHERE login = $1 ORDER BY name`
+
+	var labelsModelDb []repository_models.GMailLabel
+	start := time.Now()
+	err := r.DB.Select(&labelsModelDb, query, login)
+
+	args := []interface{}{login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gmail labels: %v", err)
+	}
+
+	labelsModelCore := make([]*domain.GMailLabel, 0, len(labelsModelDb))
+	for _, l := range labelsModelDb {
+		labelsModelCore = append(labelsModelCore, converters.GMailLabelConvertDbInCore(&l))
+	}
+
+	return labelsModelCore, nil
+}
+
+// UpdateGMailMessageLabels adds and removes the labels of an email of the local copy, after they were changed in Gmail.
+func (r *EmailRepository) UpdateGMailMessageLabels(login, id string, addLabelIDs, removeLabelIDs []string, ctx context.Context) (bool, error) {
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var labelIDs string
+	err = r.bulkGet(tx, &labelIDs, `SELECT label_ids FROM gmail_message WHERE login = ? AND message_id = ? FOR UPDATE`, []interface{}{login, id}, ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("gmail message %s not found", id)
+		}
+		return false, fmt.Errorf("failed to get gmail message labels: %v", err)
+	}
+
+	labels := []string{}
+	removed := make(map[string]bool, len(removeLabelIDs))
+	for _, l := range removeLabelIDs {
+		removed[l] = true
+	}
+	for _, l := range append(converters.GMailLabelIDsConvertDbInCore(labelIDs), addLabelIDs...) {
+		if !removed[l] {
+			labels = append(labels, l)
+			removed[l] = true
+		}
+	}
+
+	err = r.bulkExec(tx, `UPDATE gmail_message SET label_ids = ? WHERE login = ? AND message_id = ?`,
+		[]interface{}{converters.GMailLabelIDsConvertCoreInDb(labels), login, id}, ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update gmail message labels: %v", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return true, nil
+}
+
+// DeleteGMailMessage removes an email from the local copy, after it was deleted in Gmail.
+func (r *EmailRepository) DeleteGMailMessage(login, id string, ctx context.Context) (bool, error) {
+	query := `DELETE FROM gmail_message WHERE login = $1 AND message_id = $2`
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, login, id)
+
+	args := []interface{}{login, id}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete gmail message: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("gmail message %s not found", id)
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestGetGMailHistoryID(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	mock.ExpectQuery(`SELECT history_id FROM gmail_sync WHERE login = \$1`).
+		WithArgs("user@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"history_id"}).AddRow(1024))
+
+	historyID, found, err := repo.GetGMailHistoryID("user@gmail.com", ctx)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(1024), historyID)
+
+	mock.ExpectQuery(`SELECT history_id FROM gmail_sync WHERE login = \$1`).
+		WithArgs("new@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"history_id"}))
+
+	_, found, err = repo.GetGMailHistoryID("new@gmail.com", ctx)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplyGMailSync(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+	date := time.Now()
+
+	sync := &domain.GMailSync{
+		Login:      "user@gmail.com",
+		HistoryID:  1024,
+		Full:       true,
+		Messages:   []*domain.GMailMessage{{ID: "18c1", ThreadID: "18c0", LabelIDs: []string{"INBOX", "UNREAD"}, Subject: "Hello", Date: date}},
+		DeletedIDs: []string{"18b9"},
+		Labels:     []*domain.GMailLabel{{ID: "Label_1", Name: "Work", Type: "user"}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO gmail_sync`).
+			WithArgs("user@gmail.com", uint64(1024), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM gmail_message WHERE login = \?`).
+			WithArgs("user@gmail.com").
+			WillReturnResult(sqlmock.NewResult(0, 5))
+		mock.ExpectExec(`DELETE FROM gmail_label WHERE login = \?`).
+			WithArgs("user@gmail.com").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`INSERT INTO gmail_label`).
+			WithArgs("user@gmail.com", "Label_1", "Work", "user").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM gmail_message WHERE login = \? AND message_id IN \(\?\)`).
+			WithArgs("user@gmail.com", "18b9").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO gmail_message`).
+			WithArgs("user@gmail.com", "18c1", "18c0", "INBOX,UNREAD", "Hello", "", "", "", date).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.ApplyGMailSync(sync, ctx))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RollbackOnError", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO gmail_sync`).
+			WillReturnError(fmt.Errorf("database error"))
+		mock.ExpectRollback()
+
+		assert.Error(t, repo.ApplyGMailSync(sync, ctx))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetGMailMessages(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+	date := time.Now()
+
+	mock.ExpectQuery(`FROM gmail_message`).
+		WithArgs("user@gmail.com", "INBOX", int64(100)).
+		WillReturnRows(sqlmock.NewRows([]string{"login", "message_id", "thread_id", "label_ids", "subject", "sender", "recipient", "text", "date"}).
+			AddRow("user@gmail.com", "18c1", "18c0", "INBOX,UNREAD", "Hello", "friend@gmail.com", "user@gmail.com", "hi", date))
+
+	messages, err := repo.GetGMailMessages("user@gmail.com", "INBOX", time.Time{}, "", 100, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.GMailMessage{{
+		ID: "18c1", ThreadID: "18c0", LabelIDs: []string{"INBOX", "UNREAD"}, Subject: "Hello",
+		Sender: "friend@gmail.com", Recipient: "user@gmail.com", Text: "hi", Date: date,
+	}}, messages)
+
+	mock.ExpectQuery(`FROM gmail_message .* AND \(date, message_id\) < \(\$3, \$4\) ORDER BY date DESC, message_id DESC LIMIT \$5`).
+		WithArgs("user@gmail.com", "INBOX", date, "18c1", int64(20)).
+		WillReturnRows(sqlmock.NewRows([]string{"login", "message_id", "thread_id", "label_ids", "subject", "sender", "recipient", "text", "date"}))
+
+	messages, err = repo.GetGMailMessages("user@gmail.com", "INBOX", date, "18c1", 20, ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateGMailMessageLabels(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT label_ids FROM gmail_message`).
+			WithArgs("user@gmail.com", "18c1").
+			WillReturnRows(sqlmock.NewRows([]string{"label_ids"}).AddRow("INBOX,UNREAD"))
+		mock.ExpectExec(`UPDATE gmail_message SET label_ids = \?`).
+			WithArgs("INBOX,Label_1", "user@gmail.com", "18c1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ok, err := repo.UpdateGMailMessageLabels("user@gmail.com", "18c1", []string{"Label_1", "INBOX"}, []string{"UNREAD"}, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT label_ids FROM gmail_message`).
+			WithArgs("user@gmail.com", "18c9").
+			WillReturnRows(sqlmock.NewRows([]string{"label_ids"}))
+		mock.ExpectRollback()
+
+		ok, err := repo.UpdateGMailMessageLabels("user@gmail.com", "18c9", nil, []string{"UNREAD"}, ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeleteGMailMessage(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	mock.ExpectExec(`DELETE FROM gmail_message WHERE login = \$1 AND message_id = \$2`).
+		WithArgs("user@gmail.com", "18c1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ok, err := repo.DeleteGMailMessage("user@gmail.com", "18c1", ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	mock.ExpectExec(`DELETE FROM gmail_message WHERE login = \$1 AND message_id = \$2`).
+		WithArgs("user@gmail.com", "18c1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	ok, err = repo.DeleteGMailMessage("user@gmail.com", "18c1", ctx)
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,346 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/microservice/models/repository_models"
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+)
+
+// CreateLabel creates a new label owned by the profile with the given login.
+func (r *EmailRepository) CreateLabel(label *domain.Label, login string, ctx context.Context) (*domain.Label, error) {
+	query := `
+		INSERT INTO label (profile_id, name, color)
+		SELECT id, $2, $3 FROM profile WHERE login = $1
+		RETURNING id, profile_id, name, color
+	`
+
+	labelModelDb := converters.LabelConvertCoreInDb(label)
+
+	var createdLabelDb repository_models.Label
+	start := time.Now()
+	err := r.DB.Get(&createdLabelDb, query, login, labelModelDb.Name, labelModelDb.Color)
+
+	args := []interface{}{login, labelModelDb.Name, labelModelDb.Color}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create label: %v", err)
+	}
+
+	return converters.LabelConvertDbInCore(&createdLabelDb), nil
+}
+
+// GetLabelsByLogin returns all labels of the profile.
+func (r *EmailRepository) GetLabelsByLogin(login string, offset, limit int64, ctx context.Context) ([]*domain.Label, error) {
+	query := `
+		SELECT l.id, l.profile_id, l.name, l.color
+		FROM label l
+		JOIN profile p ON p.id = l.profile_id
+		WHERE p.login = $1
+		ORDER BY l.name
+	`
+
+	var labelsModelDb []repository_models.Label
+
+	var err error
+	var args []interface{}
+	start := time.Now()
+
+	if offset >= 0 && limit > 0 {
+		query += " OFFSET $2 LIMIT $3"
+		args = []interface{}{login, offset, limit}
+		err = r.DB.Select(&labelsModelDb, query, login, offset, limit)
+	} else {
+		args = []interface{}{login}
+		err = r.DB.Select(&labelsModelDb, query, login)
+	}
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %v", err)
+	}
+
+	labelsModelCore := make([]*domain.Label, 0, len(labelsModelDb))
+	for _, l := range labelsModelDb {
+		labelsModelCore = append(labelsModelCore, converters.LabelConvertDbInCore(&l))
+	}
+
+	return labelsModelCore, nil
+}
+
+// GetLabelByID returns the label of the profile by its unique identifier.
+func (r *EmailRepository) GetLabelByID(id uint32, login string, ctx context.Context) (*domain.Label, error) {
+	query := `
+		SELECT l.id, l.profile_id, l.name, l.color
+		FROM label l
+		JOIN profile p ON p.id = l.profile_id
+		WHERE l.id = $1 AND p.login = $2
+	`
+
+	var labelModelDb repository_models.Label
+	start := time.Now()
+	err := r.DB.Get(&labelModelDb, query, id, login)
+
+	args := []interface{}{id, login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("label with id %d not found", id)
+		}
+		return nil, err
+	}
+
+	return converters.LabelConvertDbInCore(&labelModelDb), nil
+}
+
+// GetLabelByName returns the label of the profile by its name.
+func (r *EmailRepository) GetLabelByName(name, login string, ctx context.Context) (*domain.Label, error) {
+	query := `
+		SELECT l.id, l.profile_id, l.name, l.color
+		FROM label l
+		JOIN profile p ON p.id = l.profile_id
+		WHERE l.name = $1 AND p.login = $2
+	`
+
+	var labelModelDb repository_models.Label
+	start := time.Now()
+	err := r.DB.Get(&labelModelDb, query, name, login)
+
+	args := []interface{}{name, login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("label %s not found", name)
+		}
+		return nil, err
+	}
+
+	return converters.LabelConvertDbInCore(&labelModelDb), nil
+}
+
+// UpdateLabel updates the name and color of the label of the profile.
+func (r *EmailRepository) UpdateLabel(label *domain.Label, login string, ctx context.Context) (bool, error) {
+	query := `
+		UPDATE label
+		SET name = $1, color = $2
+		WHERE id = $3 AND profile_id = (SELECT id FROM profile WHERE login = $4)
+	`
+
+	labelModelDb := converters.LabelConvertCoreInDb(label)
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, labelModelDb.Name, labelModelDb.Color, labelModelDb.ID, login)
+
+	args := []interface{}{labelModelDb.Name, labelModelDb.Color, labelModelDb.ID, login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to update label: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("label with id %d not found", labelModelDb.ID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteLabel deletes the label of the profile and removes it from all emails.
+func (r *EmailRepository) DeleteLabel(id uint32, login string, ctx context.Context) (bool, error) {
+	query := `
+		DELETE FROM label
+		WHERE id = $1 AND profile_id = (SELECT id FROM profile WHERE login = $2)
+	`
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, id, login)
+
+	args := []interface{}{id, login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete label: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("label with id %d not found", id)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CheckEmailsProfile checks that all the emails belong to the profile; the email IDs must not repeat.
+func (r *EmailRepository) CheckEmailsProfile(emailIDs []uint64, login string, ctx context.Context) (bool, error) {
+	query, args, err := sqlx.In(`
+		SELECT COUNT(DISTINCT pe.email_id)
+		FROM profile_email pe
+		JOIN profile p ON p.id = pe.profile_id
+		WHERE p.login = ? AND pe.email_id IN (?)
+	`, login, emailIDs)
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %v", err)
+	}
+	query = r.DB.Rebind(query)
+
+	var count int
+	start := time.Now()
+	err = r.DB.Get(&count, query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check emails: %v", err)
+	}
+
+	return count == len(emailIDs), nil
+}
+
+// AddEmailsInLabel puts the label on all the emails; emails that already have the label are skipped.
+func (r *EmailRepository) AddEmailsInLabel(labelID uint32, emailIDs []uint64, ctx context.Context) error {
+	query, args, err := sqlx.In(`
+		INSERT INTO email_label (label_id, email_id)
+		SELECT ?, e.id FROM email e WHERE e.id IN (?)
+		ON CONFLICT DO NOTHING
+	`, labelID, emailIDs)
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+	query = r.DB.Rebind(query)
+
+	start := time.Now()
+	_, err = r.DB.Exec(query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add emails in label: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteEmailsInLabel removes the label from all the emails.
+func (r *EmailRepository) DeleteEmailsInLabel(labelID uint32, emailIDs []uint64, ctx context.Context) (bool, error) {
+	query, args, err := sqlx.In(`
+		DELETE FROM email_label
+		WHERE label_id = ? AND email_id IN (?)
+	`, labelID, emailIDs)
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %v", err)
+	}
+	query = r.DB.Rebind(query)
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete emails in label: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("emails not found in label with id %d", labelID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetLabelsByEmails returns the labels the profile has put on each of the emails.
+func (r *EmailRepository) GetLabelsByEmails(emailIDs []uint64, login string, ctx context.Context) (map[uint64][]*domain.Label, error) {
+	labels := make(map[uint64][]*domain.Label)
+	if len(emailIDs) == 0 {
+		return labels, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT el.email_id, l.id, l.profile_id, l.name, l.color
+		FROM email_label el
+		JOIN label l ON l.id = el.label_id
+		JOIN profile p ON p.id = l.profile_id
+		WHERE p.login = ? AND el.email_id IN (?)
+		ORDER BY l.name
+	`, login, emailIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+	query = r.DB.Rebind(query)
+
+	var emailLabelsDb []repository_models.EmailLabel
+	start := time.Now()
+	err = r.DB.Select(&emailLabelsDb, query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels of emails: %v", err)
+	}
+
+	for _, el := range emailLabelsDb {
+		labels[el.EmailID] = append(labels[el.EmailID], converters.LabelConvertDbInCore(&el.Label))
+	}
+
+	return labels, nil
+}
+
+// GetAllEmailsInLabel returns all emails of the profile marked with the label.
+func (r *EmailRepository) GetAllEmailsInLabel(labelID uint32, login string, ctx context.Context) ([]*domain.Email, error) {
+	query := `
+		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
+		FROM email e
+		JOIN email_label el ON el.email_id = e.id
+		JOIN profile_email pe ON pe.email_id = e.id
+		JOIN profile p ON p.id = pe.profile_id
+		WHERE el.label_id = $1 AND p.login = $2
+		ORDER BY e.date_of_dispatch DESC
+	`
+
+	var emailsModelDb []repository_models.Email
+	start := time.Now()
+	err := r.DB.Select(&emailsModelDb, query, labelID, login)
+
+	args := []interface{}{labelID, login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get emails in label: %v", err)
+	}
+
+	emailsModelCore := make([]*domain.Email, 0, len(emailsModelDb))
+	for _, e := range emailsModelDb {
+		emailsModelCore = append(emailsModelCore, converters.EmailConvertDbInCore(&e))
+	}
+
+	return emailsModelCore, nil
+}
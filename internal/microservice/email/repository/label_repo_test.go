@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestCreateLabel(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+	label := &domain.Label{Name: "Work", Color: "#ff0000"}
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "profile_id", "name", "color"}).AddRow(1, 2, "Work", "#ff0000")
+		mock.ExpectQuery(`INSERT INTO label \(profile_id, name, color\)`).
+			WithArgs(login, "Work", "#ff0000").
+			WillReturnRows(rows)
+
+		created, err := repo.CreateLabel(label, login, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Label{ID: 1, ProfileID: 2, Name: "Work", Color: "#ff0000"}, created)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO label \(profile_id, name, color\)`).
+			WithArgs(login, "Work", "#ff0000").
+			WillReturnError(fmt.Errorf("duplicate key value"))
+
+		created, err := repo.CreateLabel(label, login, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, created)
+	})
+}
+
+func TestGetLabelByName(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Found", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "profile_id", "name", "color"}).AddRow(1, 2, "Work", "#ff0000")
+		mock.ExpectQuery(`SELECT l.id, l.profile_id, l.name, l.color FROM label l`).
+			WithArgs("Work", login).
+			WillReturnRows(rows)
+
+		label, err := repo.GetLabelByName("Work", login, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), label.ID)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT l.id, l.profile_id, l.name, l.color FROM label l`).
+			WithArgs("Work", login).
+			WillReturnError(sql.ErrNoRows)
+
+		label, err := repo.GetLabelByName("Work", login, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, label)
+	})
+}
+
+func TestDeleteLabel(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM label`).
+			WithArgs(uint32(1), login).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ok, err := repo.DeleteLabel(1, login, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM label`).
+			WithArgs(uint32(1), login).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ok, err := repo.DeleteLabel(1, login, ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestCheckEmailsProfile(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("AllOwned", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COUNT\(DISTINCT pe.email_id\)`).
+			WithArgs(login, uint64(1), uint64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		ok, err := repo.CheckEmailsProfile([]uint64{1, 2}, login, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("SomeForeign", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COUNT\(DISTINCT pe.email_id\)`).
+			WithArgs(login, uint64(1), uint64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		ok, err := repo.CheckEmailsProfile([]uint64{1, 2}, login, ctx)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestAddEmailsInLabel(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+
+	mock.ExpectExec(`INSERT INTO email_label \(label_id, email_id\)`).
+		WithArgs(uint32(1), uint64(1), uint64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.AddEmailsInLabel(1, []uint64{1, 2}, ctx)
+	assert.NoError(t, err)
+}
+
+func TestDeleteEmailsInLabel(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM email_label`).
+			WithArgs(uint32(1), uint64(1), uint64(2)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		ok, err := repo.DeleteEmailsInLabel(1, []uint64{1, 2}, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("NotLabeled", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM email_label`).
+			WithArgs(uint32(1), uint64(1), uint64(2)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ok, err := repo.DeleteEmailsInLabel(1, []uint64{1, 2}, ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestGetLabelsByEmails(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"email_id", "id", "profile_id", "name", "color"}).
+			AddRow(1, 1, 2, "Home", "#00ff00").
+			AddRow(1, 2, 2, "Work", "#ff0000").
+			AddRow(3, 2, 2, "Work", "#ff0000")
+		mock.ExpectQuery(`SELECT el.email_id, l.id, l.profile_id, l.name, l.color`).
+			WithArgs(login, uint64(1), uint64(2), uint64(3)).
+			WillReturnRows(rows)
+
+		labels, err := repo.GetLabelsByEmails([]uint64{1, 2, 3}, login, ctx)
+		assert.NoError(t, err)
+		assert.Len(t, labels[1], 2)
+		assert.Len(t, labels[2], 0)
+		assert.Equal(t, "Work", labels[3][0].Name)
+	})
+
+	t.Run("NoEmails", func(t *testing.T) {
+		labels, err := repo.GetLabelsByEmails(nil, login, ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, labels)
+	})
+}
@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"mail/internal/microservice/models/repository_models"
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+)
+
+// GetMailboxDelegateRole returns the role of the delegate in the shared mailbox,
+// or an empty string if the delegate has no access to the mailbox.
+func (r *EmailRepository) GetMailboxDelegateRole(mailbox, delegate string, ctx context.Context) (string, error) {
+	query := `
+		SELECT md.role
+		FROM mailbox_delegate md
+		JOIN profile m ON m.id = md.mailbox_id
+		JOIN profile d ON d.id = md.delegate_id
+		WHERE m.login = $1 AND d.login = $2
+	`
+
+	var role string
+	start := time.Now()
+	err := r.DB.Get(&role, query, mailbox, delegate)
+
+	args := []interface{}{mailbox, delegate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get mailbox delegate role: %v", err)
+	}
+
+	return role, nil
+}
+
+// GetMailboxDelegates returns all delegates of the shared mailbox.
+func (r *EmailRepository) GetMailboxDelegates(mailbox string, ctx context.Context) ([]*domain.MailboxDelegate, error) {
+	query := `
+		SELECT md.mailbox_id, md.delegate_id, m.login AS mailbox, d.login AS delegate, md.role, md.creation_date
+		FROM mailbox_delegate md
+		JOIN profile m ON m.id = md.mailbox_id
+		JOIN profile d ON d.id = md.delegate_id
+		WHERE m.login = $1
+		ORDER BY d.login
+	`
+
+	var delegatesModelDb []repository_models.MailboxDelegate
+	start := time.Now()
+	err := r.DB.Select(&delegatesModelDb, query, mailbox)
+
+	args := []interface{}{mailbox}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mailbox delegates: %v", err)
+	}
+
+	delegatesModelCore := make([]*domain.MailboxDelegate, 0, len(delegatesModelDb))
+	for _, d := range delegatesModelDb {
+		delegatesModelCore = append(delegatesModelCore, converters.MailboxDelegateConvertDbInCore(&d))
+	}
+
+	return delegatesModelCore, nil
+}
+
+// GetDelegatedMailboxes returns all shared mailboxes the profile is a delegate of.
+func (r *EmailRepository) GetDelegatedMailboxes(delegate string, offset, limit int64, ctx context.Context) ([]*domain.MailboxDelegate, error) {
+	query := `
+		SELECT md.mailbox_id, md.delegate_id, m.login AS mailbox, d.login AS delegate, md.role, md.creation_date
+		FROM mailbox_delegate md
+		JOIN profile m ON m.id = md.mailbox_id
+		JOIN profile d ON d.id = md.delegate_id
+		WHERE d.login = $1
+		ORDER BY m.login
+	`
+
+	var delegatesModelDb []repository_models.MailboxDelegate
+
+	var err error
+	var args []interface{}
+	start := time.Now()
+
+	if offset >= 0 && limit > 0 {
+		query += " OFFSET $2 LIMIT $3"
+		args = []interface{}{delegate, offset, limit}
+		err = r.DB.Select(&delegatesModelDb, query, delegate, offset, limit)
+	} else {
+		args = []interface{}{delegate}
+		err = r.DB.Select(&delegatesModelDb, query, delegate)
+	}
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegated mailboxes: %v", err)
+	}
+
+	delegatesModelCore := make([]*domain.MailboxDelegate, 0, len(delegatesModelDb))
+	for _, d := range delegatesModelDb {
+		delegatesModelCore = append(delegatesModelCore, converters.MailboxDelegateConvertDbInCore(&d))
+	}
+
+	return delegatesModelCore, nil
+}
+
+// AddMailboxDelegate gives the profile access to the shared mailbox or changes its role if it is already a delegate.
+func (r *EmailRepository) AddMailboxDelegate(mailbox, delegate, role string, ctx context.Context) error {
+	query := `
+		INSERT INTO mailbox_delegate (mailbox_id, delegate_id, role)
+		SELECT m.id, d.id, $3 FROM profile m, profile d WHERE m.login = $1 AND d.login = $2
+		ON CONFLICT (mailbox_id, delegate_id) DO UPDATE SET role = EXCLUDED.role
+	`
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, mailbox, delegate, role)
+
+	args := []interface{}{mailbox, delegate, role}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add mailbox delegate: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("user with login = %v not found", delegate)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMailboxDelegate takes away the access of the profile to the shared mailbox.
+func (r *EmailRepository) DeleteMailboxDelegate(mailbox, delegate string, ctx context.Context) (bool, error) {
+	query := `
+		DELETE FROM mailbox_delegate
+		WHERE mailbox_id = (SELECT id FROM profile WHERE login = $1)
+		AND delegate_id = (SELECT id FROM profile WHERE login = $2)
+	`
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, mailbox, delegate)
+
+	args := []interface{}{mailbox, delegate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete mailbox delegate: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("delegate %s of mailbox %s not found", delegate, mailbox)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AddDelegateAction records an action the delegate performed in the shared mailbox.
+func (r *EmailRepository) AddDelegateAction(action *domain.DelegateAction, ctx context.Context) error {
+	query := `
+		INSERT INTO mailbox_delegate_action (mailbox_id, delegate_id, action, email_id)
+		SELECT m.id, d.id, $3, NULLIF($4, 0) FROM profile m, profile d WHERE m.login = $1 AND d.login = $2
+	`
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, action.Mailbox, action.Delegate, action.Action, action.EmailID)
+
+	args := []interface{}{action.Mailbox, action.Delegate, action.Action, action.EmailID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add delegate action: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("mailbox %s or delegate %s not found", action.Mailbox, action.Delegate)
+		return err
+	}
+
+	return nil
+}
+
+// GetDelegateActions returns the actions the delegates performed in the shared mailbox, newest first.
+func (r *EmailRepository) GetDelegateActions(mailbox string, offset, limit int64, ctx context.Context) ([]*domain.DelegateAction, error) {
+	query := `
+		SELECT a.id, m.login AS mailbox, d.login AS delegate, a.action, a.email_id, a.creation_date
+		FROM mailbox_delegate_action a
+		JOIN profile m ON m.id = a.mailbox_id
+		JOIN profile d ON d.id = a.delegate_id
+		WHERE m.login = $1
+		ORDER BY a.creation_date DESC, a.id DESC
+	`
+
+	var actionsModelDb []repository_models.DelegateAction
+
+	var err error
+	var args []interface{}
+	start := time.Now()
+
+	if offset >= 0 && limit > 0 {
+		query += " OFFSET $2 LIMIT $3"
+		args = []interface{}{mailbox, offset, limit}
+		err = r.DB.Select(&actionsModelDb, query, mailbox, offset, limit)
+	} else {
+		args = []interface{}{mailbox}
+		err = r.DB.Select(&actionsModelDb, query, mailbox)
+	}
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegate actions: %v", err)
+	}
+
+	actionsModelCore := make([]*domain.DelegateAction, 0, len(actionsModelDb))
+	for _, a := range actionsModelDb {
+		actionsModelCore = append(actionsModelCore, converters.DelegateActionConvertDbInCore(&a))
+	}
+
+	return actionsModelCore, nil
+}
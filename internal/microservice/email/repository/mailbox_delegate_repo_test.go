@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestGetMailboxDelegateRole(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	mailbox := "support@mailhub.su"
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Delegate", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT md.role FROM mailbox_delegate md`).
+			WithArgs(mailbox, delegate).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("send_as"))
+
+		role, err := repo.GetMailboxDelegateRole(mailbox, delegate, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "send_as", role)
+	})
+
+	t.Run("NotDelegate", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT md.role FROM mailbox_delegate md`).
+			WithArgs(mailbox, delegate).
+			WillReturnError(sql.ErrNoRows)
+
+		role, err := repo.GetMailboxDelegateRole(mailbox, delegate, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "", role)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT md.role FROM mailbox_delegate md`).
+			WithArgs(mailbox, delegate).
+			WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetMailboxDelegateRole(mailbox, delegate, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetMailboxDelegates(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	mailbox := "support@mailhub.su"
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"mailbox_id", "delegate_id", "mailbox", "delegate", "role", "creation_date"}).
+			AddRow(1, 2, mailbox, "test@mailhub.su", "read", now)
+		mock.ExpectQuery(`SELECT md.mailbox_id, md.delegate_id, m.login AS mailbox, d.login AS delegate, md.role, md.creation_date FROM mailbox_delegate md`).
+			WithArgs(mailbox).
+			WillReturnRows(rows)
+
+		delegates, err := repo.GetMailboxDelegates(mailbox, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.MailboxDelegate{{MailboxID: 1, DelegateID: 2, Mailbox: mailbox, Delegate: "test@mailhub.su", Role: "read", CreationDate: now}}, delegates)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT md.mailbox_id, md.delegate_id`).
+			WithArgs(mailbox).
+			WillReturnError(fmt.Errorf("db error"))
+
+		delegates, err := repo.GetMailboxDelegates(mailbox, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, delegates)
+	})
+}
+
+func TestGetDelegatedMailboxes(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("WithOffsetAndLimit", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"mailbox_id", "delegate_id", "mailbox", "delegate", "role", "creation_date"}).
+			AddRow(1, 2, "support@mailhub.su", delegate, "manage", now)
+		mock.ExpectQuery(`SELECT md.mailbox_id, md.delegate_id(.+)WHERE d.login = \$1 ORDER BY m.login OFFSET \$2 LIMIT \$3`).
+			WithArgs(delegate, int64(0), int64(10)).
+			WillReturnRows(rows)
+
+		mailboxes, err := repo.GetDelegatedMailboxes(delegate, 0, 10, ctx)
+		assert.NoError(t, err)
+		assert.Len(t, mailboxes, 1)
+		assert.Equal(t, "support@mailhub.su", mailboxes[0].Mailbox)
+	})
+
+	t.Run("NoOffsetAndLimit", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT md.mailbox_id, md.delegate_id(.+)WHERE d.login = \$1 ORDER BY m.login`).
+			WithArgs(delegate).
+			WillReturnRows(sqlmock.NewRows([]string{"mailbox_id", "delegate_id", "mailbox", "delegate", "role", "creation_date"}))
+
+		mailboxes, err := repo.GetDelegatedMailboxes(delegate, 0, 0, ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, mailboxes)
+	})
+}
+
+func TestAddMailboxDelegate(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	mailbox := "support@mailhub.su"
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO mailbox_delegate \(mailbox_id, delegate_id, role\)`).
+			WithArgs(mailbox, delegate, "read").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.AddMailboxDelegate(mailbox, delegate, "read", ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO mailbox_delegate \(mailbox_id, delegate_id, role\)`).
+			WithArgs(mailbox, delegate, "read").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.AddMailboxDelegate(mailbox, delegate, "read", ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteMailboxDelegate(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	mailbox := "support@mailhub.su"
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM mailbox_delegate`).
+			WithArgs(mailbox, delegate).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		status, err := repo.DeleteMailboxDelegate(mailbox, delegate, ctx)
+		assert.NoError(t, err)
+		assert.True(t, status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM mailbox_delegate`).
+			WithArgs(mailbox, delegate).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		status, err := repo.DeleteMailboxDelegate(mailbox, delegate, ctx)
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+}
+
+func TestAddDelegateAction(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	action := &domain.DelegateAction{Mailbox: "support@mailhub.su", Delegate: "test@mailhub.su", Action: "send", EmailID: 5}
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO mailbox_delegate_action \(mailbox_id, delegate_id, action, email_id\)`).
+			WithArgs(action.Mailbox, action.Delegate, action.Action, action.EmailID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.AddDelegateAction(action, ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO mailbox_delegate_action \(mailbox_id, delegate_id, action, email_id\)`).
+			WithArgs(action.Mailbox, action.Delegate, action.Action, action.EmailID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.AddDelegateAction(action, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDelegateActions(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	mailbox := "support@mailhub.su"
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "mailbox", "delegate", "action", "email_id", "creation_date"}).
+			AddRow(1, mailbox, "test@mailhub.su", "send", 5, now).
+			AddRow(2, mailbox, "test@mailhub.su", "delete", nil, now)
+		mock.ExpectQuery(`SELECT a.id, m.login AS mailbox, d.login AS delegate, a.action, a.email_id, a.creation_date FROM mailbox_delegate_action a(.+)OFFSET \$2 LIMIT \$3`).
+			WithArgs(mailbox, int64(0), int64(20)).
+			WillReturnRows(rows)
+
+		actions, err := repo.GetDelegateActions(mailbox, 0, 20, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.DelegateAction{
+			{ID: 1, Mailbox: mailbox, Delegate: "test@mailhub.su", Action: "send", EmailID: 5, CreationDate: now},
+			{ID: 2, Mailbox: mailbox, Delegate: "test@mailhub.su", Action: "delete", CreationDate: now},
+		}, actions)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT a.id, m.login AS mailbox`).
+			WithArgs(mailbox).
+			WillReturnError(fmt.Errorf("db error"))
+
+		actions, err := repo.GetDelegateActions(mailbox, 0, 0, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, actions)
+	})
+}
@@ -0,0 +1,411 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"mail/internal/microservice/models/repository_models"
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+)
+
+// CreateMailingList creates a new mailing list and makes the given profile its owner.
+func (r *EmailRepository) CreateMailingList(list *domain.MailingList, ownerLogin string, ctx context.Context) (*domain.MailingList, error) {
+	query := `
+		WITH new_list AS (
+			INSERT INTO mailing_list (address, name, description, post_policy, is_moderated)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, address, name, description, post_policy, is_moderated, creation_date
+		), owner AS (
+			INSERT INTO mailing_list_member (list_id, profile_id, role)
+			SELECT new_list.id, profile.id, 'owner' FROM new_list, profile WHERE profile.login = $6
+		)
+		SELECT id, address, name, description, post_policy, is_moderated, creation_date FROM new_list
+	`
+
+	listModelDb := converters.MailingListConvertCoreInDb(list)
+
+	var createdListDb repository_models.MailingList
+	start := time.Now()
+	err := r.DB.Get(&createdListDb, query, listModelDb.Address, listModelDb.Name, listModelDb.Description, listModelDb.PostPolicy, listModelDb.Moderated, ownerLogin)
+
+	args := []interface{}{listModelDb.Address, listModelDb.Name, listModelDb.Description, listModelDb.PostPolicy, listModelDb.Moderated, ownerLogin}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mailing list: %v", err)
+	}
+
+	return converters.MailingListConvertDbInCore(&createdListDb), nil
+}
+
+// GetMailingListByID returns the mailing list by its unique identifier.
+func (r *EmailRepository) GetMailingListByID(id uint32, ctx context.Context) (*domain.MailingList, error) {
+	query := `
+		SELECT id, address, name, description, post_policy, is_moderated, creation_date
+		FROM mailing_list
+		WHERE id = $1
+	`
+
+	var listModelDb repository_models.MailingList
+	start := time.Now()
+	err := r.DB.Get(&listModelDb, query, id)
+
+	args := []interface{}{id}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("mailing list with id %d not found", id)
+		}
+		return nil, err
+	}
+
+	return converters.MailingListConvertDbInCore(&listModelDb), nil
+}
+
+// GetMailingListByAddress returns the mailing list by its group address.
+func (r *EmailRepository) GetMailingListByAddress(address string, ctx context.Context) (*domain.MailingList, error) {
+	query := `
+		SELECT id, address, name, description, post_policy, is_moderated, creation_date
+		FROM mailing_list
+		WHERE address = $1
+	`
+
+	var listModelDb repository_models.MailingList
+	start := time.Now()
+	err := r.DB.Get(&listModelDb, query, address)
+
+	args := []interface{}{address}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("mailing list with address %s not found", address)
+		}
+		return nil, err
+	}
+
+	return converters.MailingListConvertDbInCore(&listModelDb), nil
+}
+
+// GetMailingListsByLogin returns all mailing lists the profile is a member of.
+func (r *EmailRepository) GetMailingListsByLogin(login string, offset, limit int64, ctx context.Context) ([]*domain.MailingList, error) {
+	query := `
+		SELECT ml.id, ml.address, ml.name, ml.description, ml.post_policy, ml.is_moderated, ml.creation_date
+		FROM mailing_list ml
+		JOIN mailing_list_member mlm ON mlm.list_id = ml.id
+		JOIN profile p ON p.id = mlm.profile_id
+		WHERE p.login = $1
+		ORDER BY ml.name
+	`
+
+	var listsModelDb []repository_models.MailingList
+
+	var err error
+	var args []interface{}
+	start := time.Now()
+
+	if offset >= 0 && limit > 0 {
+		query += " OFFSET $2 LIMIT $3"
+		args = []interface{}{login, offset, limit}
+		err = r.DB.Select(&listsModelDb, query, login, offset, limit)
+	} else {
+		args = []interface{}{login}
+		err = r.DB.Select(&listsModelDb, query, login)
+	}
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mailing lists: %v", err)
+	}
+
+	listsModelCore := make([]*domain.MailingList, 0, len(listsModelDb))
+	for _, l := range listsModelDb {
+		listsModelCore = append(listsModelCore, converters.MailingListConvertDbInCore(&l))
+	}
+
+	return listsModelCore, nil
+}
+
+// UpdateMailingList updates the name, description, post policy and moderation of the mailing list.
+func (r *EmailRepository) UpdateMailingList(list *domain.MailingList, ctx context.Context) (bool, error) {
+	query := `
+		UPDATE mailing_list
+		SET name = $1, description = $2, post_policy = $3, is_moderated = $4
+		WHERE id = $5
+	`
+
+	listModelDb := converters.MailingListConvertCoreInDb(list)
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, listModelDb.Name, listModelDb.Description, listModelDb.PostPolicy, listModelDb.Moderated, listModelDb.ID)
+
+	args := []interface{}{listModelDb.Name, listModelDb.Description, listModelDb.PostPolicy, listModelDb.Moderated, listModelDb.ID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to update mailing list: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("mailing list with id %d not found", listModelDb.ID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteMailingList removes the mailing list by its unique identifier.
+func (r *EmailRepository) DeleteMailingList(id uint32, ctx context.Context) (bool, error) {
+	query := "DELETE FROM mailing_list WHERE id = $1"
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, id)
+
+	args := []interface{}{id}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete mailing list: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("mailing list with id %d not found", id)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetMailingListMemberRole returns the role of the profile in the mailing list,
+// or an empty string if the profile is not a member of the list.
+func (r *EmailRepository) GetMailingListMemberRole(listID uint32, login string, ctx context.Context) (string, error) {
+	query := `
+		SELECT mlm.role
+		FROM mailing_list_member mlm
+		JOIN profile p ON p.id = mlm.profile_id
+		WHERE mlm.list_id = $1 AND p.login = $2
+	`
+
+	var role string
+	start := time.Now()
+	err := r.DB.Get(&role, query, listID, login)
+
+	args := []interface{}{listID, login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get mailing list member role: %v", err)
+	}
+
+	return role, nil
+}
+
+// GetMailingListMembers returns all members of the mailing list.
+func (r *EmailRepository) GetMailingListMembers(listID uint32, ctx context.Context) ([]*domain.MailingListMember, error) {
+	query := `
+		SELECT mlm.list_id, mlm.profile_id, p.login, mlm.role
+		FROM mailing_list_member mlm
+		JOIN profile p ON p.id = mlm.profile_id
+		WHERE mlm.list_id = $1
+		ORDER BY mlm.role DESC, p.login
+	`
+
+	var membersModelDb []repository_models.MailingListMember
+	start := time.Now()
+	err := r.DB.Select(&membersModelDb, query, listID)
+
+	args := []interface{}{listID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mailing list members: %v", err)
+	}
+
+	membersModelCore := make([]*domain.MailingListMember, 0, len(membersModelDb))
+	for _, m := range membersModelDb {
+		membersModelCore = append(membersModelCore, converters.MailingListMemberConvertDbInCore(&m))
+	}
+
+	return membersModelCore, nil
+}
+
+// AddMailingListMember adds the profile to the mailing list or changes its role if it is already a member.
+func (r *EmailRepository) AddMailingListMember(listID uint32, login, role string, ctx context.Context) error {
+	query := `
+		INSERT INTO mailing_list_member (list_id, profile_id, role)
+		SELECT $1, id, $3 FROM profile WHERE login = $2
+		ON CONFLICT (list_id, profile_id) DO UPDATE SET role = EXCLUDED.role
+	`
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, listID, login, role)
+
+	args := []interface{}{listID, login, role}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add mailing list member: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("user with login = %v not found", login)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMailingListMember removes the profile from the mailing list.
+func (r *EmailRepository) DeleteMailingListMember(listID uint32, login string, ctx context.Context) (bool, error) {
+	query := `
+		DELETE FROM mailing_list_member
+		WHERE list_id = $1 AND profile_id = (SELECT id FROM profile WHERE login = $2)
+	`
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, listID, login)
+
+	args := []interface{}{listID, login}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete mailing list member: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("member %s of mailing list %d not found", login, listID)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AddProfileEmailList links an email to the sender and to every member of the mailing list.
+func (r *EmailRepository) AddProfileEmailList(emailID uint64, sender string, listID uint32, ctx context.Context) error {
+	query := `
+		INSERT INTO profile_email (profile_id, email_id)
+		SELECT profile_id, $1::INTEGER FROM mailing_list_member WHERE list_id = $2
+		UNION
+		SELECT id, $1::INTEGER FROM profile WHERE login = $3
+		ON CONFLICT (profile_id, email_id) DO NOTHING
+	`
+
+	start := time.Now()
+	_, err := r.DB.Exec(query, emailID, listID, sender)
+
+	args := []interface{}{emailID, listID, sender}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to deliver email %d to mailing list %d: %v", emailID, listID, err)
+	}
+
+	return nil
+}
+
+// AddMailingListModeration holds an email sent to the mailing list until an owner approves it.
+func (r *EmailRepository) AddMailingListModeration(listID uint32, emailID uint64, ctx context.Context) error {
+	query := `
+		INSERT INTO mailing_list_moderation (list_id, email_id)
+		VALUES ($1, $2)
+	`
+
+	start := time.Now()
+	_, err := r.DB.Exec(query, listID, emailID)
+
+	args := []interface{}{listID, emailID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to hold email %d for moderation: %v", emailID, err)
+	}
+
+	return nil
+}
+
+// GetMailingListModeration returns all emails of the mailing list waiting for moderation.
+func (r *EmailRepository) GetMailingListModeration(listID uint32, ctx context.Context) ([]*domain.Email, error) {
+	query := `
+		SELECT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.isSpam, e.reply_to_email_id, e.is_important, e.sent_by_email
+		FROM email e
+		JOIN mailing_list_moderation mlm ON mlm.email_id = e.id
+		WHERE mlm.list_id = $1
+		ORDER BY e.date_of_dispatch DESC
+	`
+
+	var emailsModelDb []repository_models.Email
+	start := time.Now()
+	err := r.DB.Select(&emailsModelDb, query, listID)
+
+	args := []interface{}{listID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get emails waiting for moderation: %v", err)
+	}
+
+	emailsModelCore := make([]*domain.Email, 0, len(emailsModelDb))
+	for _, e := range emailsModelDb {
+		emailsModelCore = append(emailsModelCore, converters.EmailConvertDbInCore(&e))
+	}
+
+	return emailsModelCore, nil
+}
+
+// DeleteMailingListModeration removes an email from the moderation queue of the mailing list.
+func (r *EmailRepository) DeleteMailingListModeration(listID uint32, emailID uint64, ctx context.Context) (bool, error) {
+	query := "DELETE FROM mailing_list_moderation WHERE list_id = $1 AND email_id = $2"
+
+	start := time.Now()
+	result, err := r.DB.Exec(query, listID, emailID)
+
+	args := []interface{}{listID, emailID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete email from moderation queue: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("email %d is not waiting for moderation in list %d", emailID, listID)
+		return false, err
+	}
+
+	return true, nil
+}
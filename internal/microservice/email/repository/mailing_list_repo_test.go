@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestGetMailingListByAddress(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	address := "team@mailhub.su"
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("Found", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "address", "name", "description", "post_policy", "is_moderated", "creation_date"}).
+			AddRow(1, address, "Team", nil, "members", true, now)
+		mock.ExpectQuery(`SELECT id, address, name, description, post_policy, is_moderated, creation_date FROM mailing_list WHERE address = \$1`).
+			WithArgs(address).
+			WillReturnRows(rows)
+
+		list, err := repo.GetMailingListByAddress(address, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.MailingList{ID: 1, Address: address, Name: "Team", PostPolicy: "members", Moderated: true, CreationDate: now}, list)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, address, name, description, post_policy, is_moderated, creation_date FROM mailing_list WHERE address = \$1`).
+			WithArgs(address).
+			WillReturnError(sql.ErrNoRows)
+
+		list, err := repo.GetMailingListByAddress(address, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, list)
+	})
+}
+
+func TestGetMailingListMemberRole(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Member", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT mlm.role FROM mailing_list_member mlm`).
+			WithArgs(uint32(1), login).
+			WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("owner"))
+
+		role, err := repo.GetMailingListMemberRole(1, login, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "owner", role)
+	})
+
+	t.Run("NotMember", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT mlm.role FROM mailing_list_member mlm`).
+			WithArgs(uint32(1), login).
+			WillReturnError(sql.ErrNoRows)
+
+		role, err := repo.GetMailingListMemberRole(1, login, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "", role)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT mlm.role FROM mailing_list_member mlm`).
+			WithArgs(uint32(1), login).
+			WillReturnError(fmt.Errorf("database error"))
+
+		_, err := repo.GetMailingListMemberRole(1, login, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestAddProfileEmailList(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	sender := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO profile_email \(profile_id, email_id\)`).
+			WithArgs(uint64(1), uint32(2), sender).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		err := repo.AddProfileEmailList(1, sender, 2, ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO profile_email \(profile_id, email_id\)`).
+			WithArgs(uint64(1), uint32(2), sender).
+			WillReturnError(fmt.Errorf("database error"))
+
+		err := repo.AddProfileEmailList(1, sender, 2, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteMailingListMember(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := EmailRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Deleted", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM mailing_list_member`).
+			WithArgs(uint32(1), login).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ok, err := repo.DeleteMailingListMember(1, login, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("NotMember", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM mailing_list_member`).
+			WithArgs(uint32(1), login).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ok, err := repo.DeleteMailingListMember(1, login, ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"fmt"
+
+	"mail/internal/microservice/email/proto"
+	"mail/internal/microservice/interceptors"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// AuditRules returns the rules recording the changes of the mailbox access in the security audit log.
+// The events are recorded for the owner of the mailbox.
+func AuditRules() map[string]interceptors.AuditRule {
+	return map[string]interceptors.AuditRule{
+		"/proto.EmailService/AddMailboxDelegate":    delegateEvent(domain.SecurityEventDelegateAdd),
+		"/proto.EmailService/DeleteMailboxDelegate": delegateEvent(domain.SecurityEventDelegateDelete),
+	}
+}
+
+// delegateEvent returns the rule recording the calls changing the access of a delegate to the mailbox.
+func delegateEvent(eventType string) interceptors.AuditRule {
+	return interceptors.AuditRule{
+		Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+			input := req.(*proto.MailboxDelegateRequest)
+
+			details := fmt.Sprintf("delegate: %s", input.Delegate)
+			if input.Role != "" {
+				details += fmt.Sprintf(", role: %s", input.Role)
+			}
+
+			return &domain.SecurityEvent{
+				Login:   input.Mailbox,
+				Type:    eventType,
+				Success: err == nil,
+				Details: details,
+			}
+		},
+	}
+}
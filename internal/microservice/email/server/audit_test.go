@@ -0,0 +1,33 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/email/proto"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestAuditRules(t *testing.T) {
+	rules := AuditRules()
+
+	event := rules["/proto.EmailService/AddMailboxDelegate"].Event(
+		&proto.MailboxDelegateRequest{Mailbox: "owner@mailhub.su", Delegate: "friend@mailhub.su", Role: "reader", Login: "owner@mailhub.su"},
+		&proto.StatusEmail{Status: true}, nil, 0)
+	assert.Equal(t, &domain.SecurityEvent{
+		Login:   "owner@mailhub.su",
+		Type:    domain.SecurityEventDelegateAdd,
+		Success: true,
+		Details: "delegate: friend@mailhub.su, role: reader",
+	}, event)
+
+	event = rules["/proto.EmailService/DeleteMailboxDelegate"].Event(
+		&proto.MailboxDelegateRequest{Mailbox: "owner@mailhub.su", Delegate: "friend@mailhub.su", Login: "owner@mailhub.su"},
+		(*proto.StatusEmail)(nil), fmt.Errorf("failed delete mailbox delegate"), 0)
+	assert.Equal(t, domain.SecurityEventDelegateDelete, event.Type)
+	assert.Equal(t, "delegate: friend@mailhub.su", event.Details)
+	assert.False(t, event.Success)
+}
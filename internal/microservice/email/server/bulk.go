@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/email/proto"
+
+	converters "mail/internal/microservice/models/proto_converters"
+)
+
+func (es *EmailServer) BulkEmails(ctx context.Context, input *proto.BulkEmailsRequest) (*proto.BulkEmailsResults, error) {
+	if input.Action == "" || len(input.EmailIds) == 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	resultsCore, err := es.EmailUseCase.BulkEmails(converters.BulkRequestConvertProtoInCore(input), input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed bulk operation")
+	}
+
+	resultsProto := make([]*proto.BulkEmailResult, len(resultsCore))
+	for i, r := range resultsCore {
+		resultsProto[i] = converters.BulkResultConvertCoreInProto(r)
+	}
+
+	bulkResultsProto := new(proto.BulkEmailsResults)
+	bulkResultsProto.Results = resultsProto
+	return bulkResultsProto, nil
+}
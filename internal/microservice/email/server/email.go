@@ -54,6 +54,19 @@ func (es *EmailServer) GetAllIncoming(ctx context.Context, input *proto.LoginOff
 	return emailProto, nil
 }
 
+func (es *EmailServer) GetUnreadCount(ctx context.Context, input *proto.LoginOffsetLimit) (*proto.UnreadCount, error) {
+	if input.Login == "" {
+		return nil, fmt.Errorf("invalid email login: %s", input.Login)
+	}
+
+	count, err := es.EmailUseCase.GetUnreadCount(input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread emails")
+	}
+
+	return &proto.UnreadCount{Count: count}, nil
+}
+
 func (es *EmailServer) GetAllSent(ctx context.Context, input *proto.LoginOffsetLimit) (*proto.Emails, error) {
 	if input.Login == "" {
 		return nil, fmt.Errorf("invalid email login: %s", input.Login)
@@ -188,7 +201,7 @@ func (es *EmailServer) CheckRecipientEmail(ctx context.Context, input *proto.Rec
 		return nil, fmt.Errorf("invalid recipient login: %s", input.Recipient)
 	}
 
-	err := es.EmailUseCase.CheckRecipientEmail(input.Recipient, ctx)
+	err := es.EmailUseCase.CheckRecipientEmail(input.Recipient, input.Sender, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("recipient login not found")
 	}
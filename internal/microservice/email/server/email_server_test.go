@@ -128,6 +128,40 @@ func TestGetAllIncoming(t *testing.T) {
 	})
 }
 
+func TestGetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailUseCase := mock.NewMockEmailUseCase(ctrl)
+
+	server := NewEmailServer(mockEmailUseCase)
+
+	ctx := GetCTX()
+
+	t.Run("GetUnreadCountSuccessfully", func(t *testing.T) {
+		mockEmailUseCase.EXPECT().GetUnreadCount("test@mailhub.su", ctx).Return(int64(3), nil)
+
+		count, err := server.GetUnreadCount(ctx, &proto.LoginOffsetLimit{Login: "test@mailhub.su"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count.Count)
+	})
+
+	t.Run("GetUnreadCountFail invalid login", func(t *testing.T) {
+		_, err := server.GetUnreadCount(ctx, &proto.LoginOffsetLimit{})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("GetUnreadCountFail repository error", func(t *testing.T) {
+		mockEmailUseCase.EXPECT().GetUnreadCount("test@mailhub.su", ctx).Return(int64(0), fmt.Errorf("db error"))
+
+		_, err := server.GetUnreadCount(ctx, &proto.LoginOffsetLimit{Login: "test@mailhub.su"})
+
+		assert.Error(t, err)
+	})
+}
+
 func TestGetAllSent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -447,10 +481,10 @@ func TestCheckRecipientEmail(t *testing.T) {
 
 	ctx := GetCTX()
 
-	recipient := &proto.Recipient{Recipient: "test@mailhub.su"}
+	recipient := &proto.Recipient{Recipient: "test@mailhub.su", Sender: "sender@mailhub.su"}
 
 	t.Run("CheckRecipientEmailSuccessfully", func(t *testing.T) {
-		mockEmailUseCase.EXPECT().CheckRecipientEmail(recipient.Recipient, ctx).Return(nil)
+		mockEmailUseCase.EXPECT().CheckRecipientEmail(recipient.Recipient, recipient.Sender, ctx).Return(nil)
 
 		_, err := server.CheckRecipientEmail(ctx, recipient)
 
@@ -464,7 +498,7 @@ func TestCheckRecipientEmail(t *testing.T) {
 	})
 
 	t.Run("CheckRecipientEmailFail Recipient login not found", func(t *testing.T) {
-		mockEmailUseCase.EXPECT().CheckRecipientEmail(recipient.Recipient, ctx).Return(fmt.Errorf("recipient login not found"))
+		mockEmailUseCase.EXPECT().CheckRecipientEmail(recipient.Recipient, recipient.Sender, ctx).Return(fmt.Errorf("recipient login not found"))
 
 		_, err := server.CheckRecipientEmail(ctx, recipient)
 
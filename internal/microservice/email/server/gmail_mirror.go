@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mail/internal/microservice/email/proto"
+
+	converters "mail/internal/microservice/models/proto_converters"
+)
+
+func (es *EmailServer) GetGMailSyncState(ctx context.Context, input *proto.GMailLogin) (*proto.GMailSyncState, error) {
+	if input.Login == "" {
+		return nil, fmt.Errorf("invalid email login: %s", input.Login)
+	}
+
+	historyID, found, err := es.EmailUseCase.GetGMailHistoryID(input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gmail sync state not found")
+	}
+
+	syncStateProto := new(proto.GMailSyncState)
+	syncStateProto.HistoryId = historyID
+	syncStateProto.Found = found
+	return syncStateProto, nil
+}
+
+func (es *EmailServer) GetGMailLogins(ctx context.Context, input *proto.EmptyEmail) (*proto.GMailLogins, error) {
+	logins, err := es.EmailUseCase.GetGMailLogins(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gmail logins not found")
+	}
+
+	loginsProto := new(proto.GMailLogins)
+	loginsProto.Logins = logins
+	return loginsProto, nil
+}
+
+func (es *EmailServer) ApplyGMailSync(ctx context.Context, input *proto.GMailSync) (*proto.StatusEmail, error) {
+	if input.Login == "" {
+		return nil, fmt.Errorf("invalid email login: %s", input.Login)
+	}
+
+	err := es.EmailUseCase.ApplyGMailSync(converters.GMailSyncConvertProtoInCore(input), ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed apply gmail sync")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = true
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) GetGMailMessages(ctx context.Context, input *proto.GMailLabelAndLogin) (*proto.GMailMessages, error) {
+	if input.Login == "" || input.LabelId == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	var beforeDate time.Time
+	if input.BeforeDate != nil {
+		beforeDate = input.BeforeDate.AsTime()
+	}
+
+	messagesCore, err := es.EmailUseCase.GetGMailMessages(input.Login, input.LabelId, beforeDate, input.BeforeId, input.Limit, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gmail messages not found")
+	}
+
+	messagesProto := make([]*proto.GMailMessage, len(messagesCore))
+	for i, m := range messagesCore {
+		messagesProto[i] = converters.GMailMessageConvertCoreInProto(m)
+	}
+
+	gmailMessagesProto := new(proto.GMailMessages)
+	gmailMessagesProto.Messages = messagesProto
+	return gmailMessagesProto, nil
+}
+
+func (es *EmailServer) GetGMailLabels(ctx context.Context, input *proto.GMailLogin) (*proto.GMailLabels, error) {
+	if input.Login == "" {
+		return nil, fmt.Errorf("invalid email login: %s", input.Login)
+	}
+
+	labelsCore, err := es.EmailUseCase.GetGMailLabels(input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gmail labels not found")
+	}
+
+	labelsProto := make([]*proto.GMailLabel, len(labelsCore))
+	for i, l := range labelsCore {
+		labelsProto[i] = converters.GMailLabelConvertCoreInProto(l)
+	}
+
+	gmailLabelsProto := new(proto.GMailLabels)
+	gmailLabelsProto.Labels = labelsProto
+	return gmailLabelsProto, nil
+}
+
+func (es *EmailServer) UpdateGMailMessageLabels(ctx context.Context, input *proto.GMailMessageLabels) (*proto.StatusEmail, error) {
+	if input.Login == "" || input.Id == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.UpdateGMailMessageLabels(input.Login, input.Id, input.AddLabelIds, input.RemoveLabelIds, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed update gmail message labels")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) DeleteGMailMessage(ctx context.Context, input *proto.GMailMessageIdAndLogin) (*proto.StatusEmail, error) {
+	if input.Login == "" || input.Id == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.DeleteGMailMessage(input.Login, input.Id, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed delete gmail message")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/email/mock"
+	"mail/internal/microservice/email/proto"
+	"mail/internal/microservice/models/domain_models"
+)
+
+func TestGetGMailSyncState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailUseCase := mock.NewMockEmailUseCase(ctrl)
+	server := NewEmailServer(mockEmailUseCase)
+	ctx := GetCTX()
+
+	mockEmailUseCase.EXPECT().GetGMailHistoryID("user@gmail.com", ctx).Return(uint64(1024), true, nil)
+	state, err := server.GetGMailSyncState(ctx, &proto.GMailLogin{Login: "user@gmail.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1024), state.HistoryId)
+	assert.True(t, state.Found)
+
+	_, err = server.GetGMailSyncState(ctx, &proto.GMailLogin{})
+	assert.Error(t, err)
+}
+
+func TestApplyGMailSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailUseCase := mock.NewMockEmailUseCase(ctrl)
+	server := NewEmailServer(mockEmailUseCase)
+	ctx := GetCTX()
+
+	input := &proto.GMailSync{
+		Login:     "user@gmail.com",
+		HistoryId: 1024,
+		Labels:    []*proto.GMailLabel{{Id: "INBOX", Name: "INBOX", Type: "system"}},
+	}
+
+	mockEmailUseCase.EXPECT().ApplyGMailSync(gomock.Any(), ctx).DoAndReturn(
+		func(sync *domain_models.GMailSync, _ interface{}) error {
+			assert.Equal(t, "user@gmail.com", sync.Login)
+			assert.Equal(t, uint64(1024), sync.HistoryID)
+			assert.Equal(t, []*domain_models.GMailLabel{{ID: "INBOX", Name: "INBOX", Type: "system"}}, sync.Labels)
+			return nil
+		})
+	status, err := server.ApplyGMailSync(ctx, input)
+	assert.NoError(t, err)
+	assert.True(t, status.Status)
+
+	mockEmailUseCase.EXPECT().ApplyGMailSync(gomock.Any(), ctx).Return(errors.New("database error"))
+	_, err = server.ApplyGMailSync(ctx, input)
+	assert.Error(t, err)
+}
+
+func TestGetGMailMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailUseCase := mock.NewMockEmailUseCase(ctrl)
+	server := NewEmailServer(mockEmailUseCase)
+	ctx := GetCTX()
+
+	mockEmailUseCase.EXPECT().GetGMailMessages("user@gmail.com", "INBOX", time.Time{}, "", int64(50), ctx).Return(
+		[]*domain_models.GMailMessage{{ID: "18c1", LabelIDs: []string{"INBOX"}, Subject: "Hello"}}, nil)
+	messages, err := server.GetGMailMessages(ctx, &proto.GMailLabelAndLogin{Login: "user@gmail.com", LabelId: "INBOX", Limit: 50})
+	assert.NoError(t, err)
+	assert.Len(t, messages.Messages, 1)
+	assert.Equal(t, "18c1", messages.Messages[0].Id)
+	assert.Equal(t, "Hello", messages.Messages[0].Subject)
+
+	_, err = server.GetGMailMessages(ctx, &proto.GMailLabelAndLogin{Login: "user@gmail.com"})
+	assert.Error(t, err)
+}
+
+func TestUpdateGMailMessageLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailUseCase := mock.NewMockEmailUseCase(ctrl)
+	server := NewEmailServer(mockEmailUseCase)
+	ctx := GetCTX()
+
+	mockEmailUseCase.EXPECT().UpdateGMailMessageLabels("user@gmail.com", "18c1", []string{"Label_1"}, []string{"UNREAD"}, ctx).Return(true, nil)
+	status, err := server.UpdateGMailMessageLabels(ctx, &proto.GMailMessageLabels{
+		Login: "user@gmail.com", Id: "18c1", AddLabelIds: []string{"Label_1"}, RemoveLabelIds: []string{"UNREAD"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, status.Status)
+
+	mockEmailUseCase.EXPECT().DeleteGMailMessage("user@gmail.com", "18c1", ctx).Return(false, errors.New("not found"))
+	_, err = server.DeleteGMailMessage(ctx, &proto.GMailMessageIdAndLogin{Login: "user@gmail.com", Id: "18c1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/email/proto"
+
+	converters "mail/internal/microservice/models/proto_converters"
+)
+
+func (es *EmailServer) CreateLabel(ctx context.Context, input *proto.LabelWithLogin) (*proto.Label, error) {
+	if input == nil || input.Label == nil || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	label, err := es.EmailUseCase.CreateLabel(converters.LabelConvertProtoInCore(input.Label), input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed create label")
+	}
+
+	return converters.LabelConvertCoreInProto(label), nil
+}
+
+func (es *EmailServer) GetLabels(ctx context.Context, input *proto.LoginOffsetLimit) (*proto.Labels, error) {
+	if input.Login == "" {
+		return nil, fmt.Errorf("invalid email login: %s", input.Login)
+	}
+
+	labelsCore, err := es.EmailUseCase.GetLabels(input.Login, input.Offset, input.Limit, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("labels not found")
+	}
+
+	labelsProto := new(proto.Labels)
+	labelsProto.Labels = converters.LabelsConvertCoreInProto(labelsCore)
+	return labelsProto, nil
+}
+
+func (es *EmailServer) UpdateLabel(ctx context.Context, input *proto.LabelWithLogin) (*proto.StatusEmail, error) {
+	if input == nil || input.Label == nil || input.Label.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.UpdateLabel(converters.LabelConvertProtoInCore(input.Label), input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed update label")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) DeleteLabel(ctx context.Context, input *proto.LabelIdAndLogin) (*proto.StatusEmail, error) {
+	if input.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.DeleteLabel(input.Id, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed delete label")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) GetEmailLabels(ctx context.Context, input *proto.EmailIdAndLogin) (*proto.Labels, error) {
+	if input.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	labelsCore, err := es.EmailUseCase.GetEmailLabels(input.Id, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("email labels not found")
+	}
+
+	labelsProto := new(proto.Labels)
+	labelsProto.Labels = converters.LabelsConvertCoreInProto(labelsCore)
+	return labelsProto, nil
+}
+
+func (es *EmailServer) GetAllEmailsInLabel(ctx context.Context, input *proto.LabelNameAndLogin) (*proto.Emails, error) {
+	if input.Name == "" || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	emailsCore, err := es.EmailUseCase.GetAllEmailsInLabel(input.Name, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("emails in label not found")
+	}
+
+	emailsProto := make([]*proto.Email, len(emailsCore))
+	for i, e := range emailsCore {
+		emailsProto[i] = converters.EmailConvertCoreInProto(e)
+	}
+
+	emailProto := new(proto.Emails)
+	emailProto.Emails = emailsProto
+	return emailProto, nil
+}
+
+func (es *EmailServer) AddEmailsInLabel(ctx context.Context, input *proto.LabelEmailsRequest) (*proto.StatusEmail, error) {
+	if input.LabelId <= 0 || len(input.EmailIds) == 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.AddEmailsInLabel(input.LabelId, input.EmailIds, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed add emails in label")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) DeleteEmailsInLabel(ctx context.Context, input *proto.LabelEmailsRequest) (*proto.StatusEmail, error) {
+	if input.LabelId <= 0 || len(input.EmailIds) == 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.DeleteEmailsInLabel(input.LabelId, input.EmailIds, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed delete emails from label")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
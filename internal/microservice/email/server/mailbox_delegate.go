@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/email/proto"
+
+	converters "mail/internal/microservice/models/proto_converters"
+)
+
+func (es *EmailServer) GetMailboxDelegates(ctx context.Context, input *proto.MailboxAndLogin) (*proto.MailboxDelegates, error) {
+	if input.Mailbox == "" || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	delegatesCore, err := es.EmailUseCase.GetMailboxDelegates(input.Mailbox, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("mailbox delegates not found")
+	}
+
+	delegatesProto := make([]*proto.MailboxDelegate, len(delegatesCore))
+	for i, d := range delegatesCore {
+		delegatesProto[i] = converters.MailboxDelegateConvertCoreInProto(d)
+	}
+
+	mailboxDelegatesProto := new(proto.MailboxDelegates)
+	mailboxDelegatesProto.Delegates = delegatesProto
+	return mailboxDelegatesProto, nil
+}
+
+func (es *EmailServer) GetDelegatedMailboxes(ctx context.Context, input *proto.LoginOffsetLimit) (*proto.MailboxDelegates, error) {
+	if input.Login == "" {
+		return nil, fmt.Errorf("invalid email login: %s", input.Login)
+	}
+
+	delegatesCore, err := es.EmailUseCase.GetDelegatedMailboxes(input.Login, input.Offset, input.Limit, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delegated mailboxes not found")
+	}
+
+	delegatesProto := make([]*proto.MailboxDelegate, len(delegatesCore))
+	for i, d := range delegatesCore {
+		delegatesProto[i] = converters.MailboxDelegateConvertCoreInProto(d)
+	}
+
+	mailboxDelegatesProto := new(proto.MailboxDelegates)
+	mailboxDelegatesProto.Delegates = delegatesProto
+	return mailboxDelegatesProto, nil
+}
+
+func (es *EmailServer) AddMailboxDelegate(ctx context.Context, input *proto.MailboxDelegateRequest) (*proto.StatusEmail, error) {
+	if input.Mailbox == "" || input.Login == "" || input.Delegate == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.AddMailboxDelegate(input.Mailbox, input.Login, input.Delegate, input.Role, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed add mailbox delegate")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) DeleteMailboxDelegate(ctx context.Context, input *proto.MailboxDelegateRequest) (*proto.StatusEmail, error) {
+	if input.Mailbox == "" || input.Login == "" || input.Delegate == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.DeleteMailboxDelegate(input.Mailbox, input.Login, input.Delegate, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed delete mailbox delegate")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) AddDelegateAction(ctx context.Context, input *proto.DelegateAction) (*proto.EmptyEmail, error) {
+	if input.Mailbox == "" || input.Delegate == "" || input.Action == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	err := es.EmailUseCase.AddDelegateAction(converters.DelegateActionConvertProtoInCore(input), ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed add delegate action")
+	}
+
+	return new(proto.EmptyEmail), nil
+}
+
+func (es *EmailServer) GetDelegateActions(ctx context.Context, input *proto.MailboxAndLogin) (*proto.DelegateActions, error) {
+	if input.Mailbox == "" || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	actionsCore, err := es.EmailUseCase.GetDelegateActions(input.Mailbox, input.Login, input.Offset, input.Limit, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delegate actions not found")
+	}
+
+	actionsProto := make([]*proto.DelegateAction, len(actionsCore))
+	for i, a := range actionsCore {
+		actionsProto[i] = converters.DelegateActionConvertCoreInProto(a)
+	}
+
+	delegateActionsProto := new(proto.DelegateActions)
+	delegateActionsProto.Actions = actionsProto
+	return delegateActionsProto, nil
+}
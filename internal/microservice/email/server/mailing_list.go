@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/email/proto"
+
+	converters "mail/internal/microservice/models/proto_converters"
+)
+
+func (es *EmailServer) CreateMailingList(ctx context.Context, input *proto.MailingListWithLogin) (*proto.MailingList, error) {
+	if input == nil || input.List == nil || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	list, err := es.EmailUseCase.CreateMailingList(converters.MailingListConvertProtoInCore(input.List), input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed create mailing list")
+	}
+
+	return converters.MailingListConvertCoreInProto(list), nil
+}
+
+func (es *EmailServer) GetMailingLists(ctx context.Context, input *proto.LoginOffsetLimit) (*proto.MailingLists, error) {
+	if input.Login == "" {
+		return nil, fmt.Errorf("invalid email login: %s", input.Login)
+	}
+
+	listsCore, err := es.EmailUseCase.GetMailingLists(input.Login, input.Offset, input.Limit, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("mailing lists not found")
+	}
+
+	listsProto := make([]*proto.MailingList, len(listsCore))
+	for i, l := range listsCore {
+		listsProto[i] = converters.MailingListConvertCoreInProto(l)
+	}
+
+	mailingListsProto := new(proto.MailingLists)
+	mailingListsProto.Lists = listsProto
+	return mailingListsProto, nil
+}
+
+func (es *EmailServer) GetMailingListByID(ctx context.Context, input *proto.MailingListIdAndLogin) (*proto.MailingList, error) {
+	if input.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	list, err := es.EmailUseCase.GetMailingListByID(input.Id, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("mailing list not found")
+	}
+
+	return converters.MailingListConvertCoreInProto(list), nil
+}
+
+func (es *EmailServer) UpdateMailingList(ctx context.Context, input *proto.MailingListWithLogin) (*proto.StatusEmail, error) {
+	if input == nil || input.List == nil || input.List.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.UpdateMailingList(converters.MailingListConvertProtoInCore(input.List), input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed update mailing list")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) DeleteMailingList(ctx context.Context, input *proto.MailingListIdAndLogin) (*proto.StatusEmail, error) {
+	if input.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.DeleteMailingList(input.Id, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed delete mailing list")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) GetMailingListMembers(ctx context.Context, input *proto.MailingListIdAndLogin) (*proto.MailingListMembers, error) {
+	if input.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	membersCore, err := es.EmailUseCase.GetMailingListMembers(input.Id, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("mailing list members not found")
+	}
+
+	membersProto := make([]*proto.MailingListMember, len(membersCore))
+	for i, m := range membersCore {
+		membersProto[i] = converters.MailingListMemberConvertCoreInProto(m)
+	}
+
+	mailingListMembersProto := new(proto.MailingListMembers)
+	mailingListMembersProto.Members = membersProto
+	return mailingListMembersProto, nil
+}
+
+func (es *EmailServer) AddMailingListMember(ctx context.Context, input *proto.MailingListMemberRequest) (*proto.StatusEmail, error) {
+	if input.ListId <= 0 || input.Login == "" || input.MemberLogin == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.AddMailingListMember(input.ListId, input.Login, input.MemberLogin, input.Role, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed add mailing list member")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) DeleteMailingListMember(ctx context.Context, input *proto.MailingListMemberRequest) (*proto.StatusEmail, error) {
+	if input.ListId <= 0 || input.Login == "" || input.MemberLogin == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.DeleteMailingListMember(input.ListId, input.Login, input.MemberLogin, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed delete mailing list member")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) UnsubscribeMailingList(ctx context.Context, input *proto.ListUnsubscribeToken) (*proto.StatusEmail, error) {
+	if input.Token == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.UnsubscribeMailingList(input.Token, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed unsubscribe from mailing list")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
+
+func (es *EmailServer) GetMailingListModeration(ctx context.Context, input *proto.MailingListIdAndLogin) (*proto.Emails, error) {
+	if input.Id <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	emailsCore, err := es.EmailUseCase.GetMailingListModeration(input.Id, input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("emails waiting for moderation not found")
+	}
+
+	emailsProto := make([]*proto.Email, len(emailsCore))
+	for i, e := range emailsCore {
+		emailsProto[i] = converters.EmailConvertCoreInProto(e)
+	}
+
+	emailProto := new(proto.Emails)
+	emailProto.Emails = emailsProto
+	return emailProto, nil
+}
+
+func (es *EmailServer) ModerateMailingListEmail(ctx context.Context, input *proto.ModerateEmailRequest) (*proto.StatusEmail, error) {
+	if input.ListId <= 0 || input.EmailId <= 0 || input.Login == "" {
+		return nil, fmt.Errorf("invalid input data")
+	}
+
+	okStatus, err := es.EmailUseCase.ModerateMailingListEmail(input.ListId, input.EmailId, input.Login, input.Approve, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed moderate email")
+	}
+
+	protoStatusEmail := new(proto.StatusEmail)
+	protoStatusEmail.Status = okStatus
+	return protoStatusEmail, nil
+}
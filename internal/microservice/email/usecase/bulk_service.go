@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// BulkEmails applies the action to a batch of the user emails in one transaction
+// and reports the outcome for each email; emails of other users are reported as not found.
+func (uc *EmailUseCase) BulkEmails(request *domain.BulkRequest, login string, ctx context.Context) ([]*domain.BulkResult, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	appliedIDs, err := uc.repo.BulkEmails(request, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[uint64]struct{}, len(appliedIDs))
+	for _, id := range appliedIDs {
+		applied[id] = struct{}{}
+	}
+
+	for _, id := range appliedIDs {
+		if event := request.Event(id); event != nil {
+			uc.publish(login, event, ctx)
+		}
+	}
+	if len(appliedIDs) > 0 && request.ChangesUnreadCount() {
+		uc.publishUnreadCount(login, ctx)
+	}
+
+	results := make([]*domain.BulkResult, 0, len(request.EmailIDs))
+	for _, id := range request.EmailIDs {
+		result := &domain.BulkResult{EmailID: id, Success: true}
+		if _, ok := applied[id]; !ok {
+			result.Success = false
+			result.Error = "email not found"
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
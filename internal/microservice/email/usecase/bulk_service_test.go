@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockRepository "mail/internal/microservice/email/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestBulkEmails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("PerEmailResults", func(t *testing.T) {
+		request := &domain.BulkRequest{Action: domain.BulkActionFlag, EmailIDs: []uint64{1, 2, 1}}
+		mockRepo.EXPECT().BulkEmails(&domain.BulkRequest{Action: domain.BulkActionFlag, EmailIDs: []uint64{1, 2}}, login, ctx).Return([]uint64{2}, nil)
+
+		results, err := useCase.BulkEmails(request, login, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.BulkResult{
+			{EmailID: 1, Success: false, Error: "email not found"},
+			{EmailID: 2, Success: true},
+		}, results)
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		results, err := useCase.BulkEmails(&domain.BulkRequest{Action: "archive", EmailIDs: []uint64{1}}, login, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, results)
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		request := &domain.BulkRequest{Action: domain.BulkActionDelete, EmailIDs: []uint64{1}}
+		mockRepo.EXPECT().BulkEmails(request, login, ctx).Return(nil, errors.New("transaction failed"))
+
+		results, err := useCase.BulkEmails(request, login, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, results)
+	})
+}
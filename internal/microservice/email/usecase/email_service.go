@@ -7,18 +7,24 @@ import (
 	"mail/internal/pkg/utils/validators"
 
 	repository "mail/internal/microservice/email/interface"
+	events "mail/internal/microservice/events/interface"
 	domain "mail/internal/microservice/models/domain_models"
 )
 
 // EmailUseCase represents the use case for working with emails.
 type EmailUseCase struct {
-	repo repository.EmailRepository
+	repo           repository.EmailRepository
+	events         events.EventsBus
+	unsubscribeKey []byte
 }
 
-// NewEmailUseCase creates a new instance of EmailUseCase.
-func NewEmailUseCase(repo repository.EmailRepository) *EmailUseCase {
+// NewEmailUseCase creates a new instance of EmailUseCase, the changes of the mailboxes are published to the events bus
+// and the unsubscribe links of the mailing lists are signed with unsubscribeKey.
+func NewEmailUseCase(repo repository.EmailRepository, events events.EventsBus, unsubscribeKey []byte) *EmailUseCase {
 	return &EmailUseCase{
-		repo: repo,
+		repo:           repo,
+		events:         events,
+		unsubscribeKey: unsubscribeKey,
 	}
 }
 
@@ -38,9 +44,18 @@ func (uc *EmailUseCase) GetAllEmailsIncoming(login string, offset, limit int64,
 		}
 	}
 
+	if err = uc.attachLabels(emails, login, ctx); err != nil {
+		return nil, err
+	}
+
 	return emails, nil
 }
 
+// GetUnreadCount returns the number of the incoming emails which have not been read.
+func (uc *EmailUseCase) GetUnreadCount(login string, ctx context.Context) (int64, error) {
+	return uc.repo.CountUnreadIncoming(login, ctx)
+}
+
 // GetAllEmailsSent returns all emails sent.
 func (uc *EmailUseCase) GetAllEmailsSent(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error) {
 	emails, err := uc.repo.GetAllSent(login, offset, limit, ctx)
@@ -50,16 +65,33 @@ func (uc *EmailUseCase) GetAllEmailsSent(login string, offset, limit int64, ctx
 
 	for _, email := range emails {
 		if validators.IsValidEmailFormat(email.RecipientEmail) {
-			email.PhotoID, err = uc.repo.GetAvatarFileIDByLogin(email.RecipientEmail, ctx)
+			email.PhotoID, err = uc.recipientAvatar(email.RecipientEmail, ctx)
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	if err = uc.attachLabels(emails, login, ctx); err != nil {
+		return nil, err
+	}
+
 	return emails, nil
 }
 
+// recipientAvatar returns the avatar of the recipient, which is empty for mailing lists.
+func (uc *EmailUseCase) recipientAvatar(recipient string, ctx context.Context) (string, error) {
+	photoID, err := uc.repo.GetAvatarFileIDByLogin(recipient, ctx)
+	if err != nil {
+		if _, errList := uc.repo.GetMailingListByAddress(recipient, ctx); errList == nil {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return photoID, nil
+}
+
 // GetAllDraftEmails returns all draft emails.
 func (uc *EmailUseCase) GetAllDraftEmails(login string, offset, limit int64, ctx context.Context) ([]*domain.Email, error) {
 	emails, err := uc.repo.GetAllDraft(login, offset, limit, ctx)
@@ -69,13 +101,17 @@ func (uc *EmailUseCase) GetAllDraftEmails(login string, offset, limit int64, ctx
 
 	for _, email := range emails {
 		if validators.IsValidEmailFormat(email.RecipientEmail) {
-			email.PhotoID, err = uc.repo.GetAvatarFileIDByLogin(email.RecipientEmail, ctx)
+			email.PhotoID, err = uc.recipientAvatar(email.RecipientEmail, ctx)
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	if err = uc.attachLabels(emails, login, ctx); err != nil {
+		return nil, err
+	}
+
 	return emails, nil
 }
 
@@ -95,6 +131,10 @@ func (uc *EmailUseCase) GetAllSpamEmails(login string, offset, limit int64, ctx
 		}
 	}
 
+	if err = uc.attachLabels(emails, login, ctx); err != nil {
+		return nil, err
+	}
+
 	return emails, nil
 }
 
@@ -105,6 +145,20 @@ func (uc *EmailUseCase) GetEmailByID(id uint64, login string, ctx context.Contex
 		return nil, err
 	}
 
+	if validators.IsValidEmailFormat(email.RecipientEmail) {
+		if list, err := uc.repo.GetMailingListByAddress(email.RecipientEmail, ctx); err == nil {
+			email.ListID = list.ListIDHeader()
+			email.ListUnsubscribe = list.ListUnsubscribeHeader(uc.listUnsubscribeToken(list.ID, login))
+			if email.SenderEmail != login && validators.IsValidEmailFormat(email.SenderEmail) {
+				email.PhotoID, err = uc.repo.GetAvatarFileIDByLogin(email.SenderEmail, ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return email, nil
+		}
+	}
+
 	if validators.IsValidEmailFormat(email.SenderEmail) && validators.IsValidEmailFormat(email.RecipientEmail) {
 		if email.SenderEmail == login {
 			email.PhotoID, err = uc.repo.GetAvatarFileIDByLogin(email.RecipientEmail, ctx)
@@ -123,41 +177,126 @@ func (uc *EmailUseCase) GetEmailByID(id uint64, login string, ctx context.Contex
 }
 
 // CreateEmail creates a new email.
+// A delegate sending from a shared mailbox is recorded as the actual sender only for the send_on_behalf role.
 func (uc *EmailUseCase) CreateEmail(newEmail *domain.Email, ctx context.Context) (uint64, *domain.Email, error) {
+	if newEmail.SentByEmail != "" && newEmail.SentByEmail != newEmail.SenderEmail {
+		role, err := uc.checkMailboxRole(newEmail.SenderEmail, newEmail.SentByEmail, domain.MailboxPermissionWrite, ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if role != domain.DelegateRoleSendOnBehalf {
+			newEmail.SentByEmail = ""
+		}
+	} else {
+		newEmail.SentByEmail = ""
+	}
+
 	return uc.repo.Add(newEmail, ctx)
 }
 
-// CreateProfileEmail creates a new profile_email
+// CreateProfileEmail creates a new profile_email, the recipients in this service are notified of the new email
+// and the sender of the saved draft.
 func (uc *EmailUseCase) CreateProfileEmail(emailId uint64, sender, recipient string, ctx context.Context) error {
 	if sender == recipient {
-		return uc.repo.AddProfileEmailMyself(emailId, sender, ctx)
-	} else if validators.IsValidEmailFormat(sender) && recipient == "" {
-		return uc.repo.AddProfileEmailMyself(emailId, sender, ctx)
+		if err := uc.repo.AddProfileEmailMyself(emailId, sender, ctx); err != nil {
+			return err
+		}
+		uc.publishNewMail(emailId, []string{recipient}, ctx)
+		return nil
+	}
+
+	if validators.IsValidEmailFormat(recipient) {
+		if list, err := uc.repo.GetMailingListByAddress(recipient, ctx); err == nil {
+			return uc.deliverToMailingList(emailId, sender, list, ctx)
+		}
+	}
+
+	if validators.IsValidEmailFormat(sender) && recipient == "" {
+		if err := uc.repo.AddProfileEmailMyself(emailId, sender, ctx); err != nil {
+			return err
+		}
+		uc.publishDraftSaved(emailId, sender, ctx)
+		return nil
 	} else if validators.IsValidEmailFormat(sender) && !validators.IsValidEmailFormat(recipient) {
 		return uc.repo.AddProfileEmailMyself(emailId, sender, ctx)
 	} else if !validators.IsValidEmailFormat(sender) && validators.IsValidEmailFormat(recipient) {
-		return uc.repo.AddProfileEmailMyself(emailId, recipient, ctx)
+		if err := uc.repo.AddProfileEmailMyself(emailId, recipient, ctx); err != nil {
+			return err
+		}
+		uc.publishNewMail(emailId, []string{recipient}, ctx)
+		return nil
 	}
 
-	return uc.repo.AddProfileEmail(emailId, sender, recipient, ctx)
+	if err := uc.repo.AddProfileEmail(emailId, sender, recipient, ctx); err != nil {
+		return err
+	}
+	uc.publishNewMail(emailId, []string{recipient}, ctx)
+	return nil
 }
 
-// CheckRecipientEmail checking recipient email
-func (uc *EmailUseCase) CheckRecipientEmail(recipient string, ctx context.Context) error {
-	if er := uc.repo.FindEmail(recipient, ctx); er != nil {
+// CheckRecipientEmail checking recipient email.
+// A mailing list is a valid recipient only if its post policy allows the sender to write to it.
+func (uc *EmailUseCase) CheckRecipientEmail(recipient, sender string, ctx context.Context) error {
+	er := uc.repo.FindEmail(recipient, ctx)
+	if er == nil {
+		return nil
+	}
+
+	list, err := uc.repo.GetMailingListByAddress(recipient, ctx)
+	if err != nil {
 		return er
 	}
+
+	role, err := uc.repo.GetMailingListMemberRole(list.ID, sender, ctx)
+	if err != nil {
+		return err
+	}
+
+	if !list.CanPost(role) {
+		return fmt.Errorf("sender %s is not allowed to post to %s", sender, recipient)
+	}
+
 	return nil
 }
 
 // UpdateEmail updates the information of an email.
+// The sender is notified of the saved draft, and the recipient of the read status of the email.
 func (uc *EmailUseCase) UpdateEmail(updatedEmail *domain.Email, ctx context.Context) (bool, error) {
-	return uc.repo.Update(updatedEmail, ctx)
+	readStatusChanged := uc.readStatusChanged(updatedEmail, ctx)
+
+	ok, err := uc.repo.Update(updatedEmail, ctx)
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	switch {
+	case updatedEmail.DraftStatus && validators.IsValidEmailFormat(updatedEmail.SenderEmail):
+		uc.publishDraftSaved(updatedEmail.ID, updatedEmail.SenderEmail, ctx)
+	case readStatusChanged:
+		readStatus := updatedEmail.ReadStatus
+		uc.publish(updatedEmail.RecipientEmail, &domain.MailboxEvent{
+			Type:       domain.MailboxEventReadStatus,
+			EmailID:    updatedEmail.ID,
+			ReadStatus: &readStatus,
+		}, ctx)
+		uc.publishUnreadCount(updatedEmail.RecipientEmail, ctx)
+	}
+
+	return ok, nil
 }
 
 // DeleteEmail deletes the email.
 func (uc *EmailUseCase) DeleteEmail(id uint64, login string, ctx context.Context) (bool, error) {
-	return uc.repo.Delete(id, login, ctx)
+	ok, err := uc.repo.Delete(id, login, ctx)
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	uc.publish(login, &domain.MailboxEvent{Type: domain.MailboxEventDeleted, EmailID: id}, ctx)
+	uc.publishUnreadCount(login, ctx)
+
+	return ok, nil
 }
 
 // AddAttachment adds an attachment to the specified email.
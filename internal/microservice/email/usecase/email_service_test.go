@@ -32,7 +32,7 @@ func TestNewEmailUseCase(t *testing.T) {
 		repo: mockRepo,
 	}
 
-	EmailUseCase := NewEmailUseCase(mockRepo)
+	EmailUseCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	assert.Equal(t, ExpectedEmailUseCase, *EmailUseCase)
 }
@@ -42,7 +42,7 @@ func TestGetAllEmailsIncoming_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	expectedEmails := []*domain.Email{
@@ -54,6 +54,7 @@ func TestGetAllEmailsIncoming_Success(t *testing.T) {
 	zero := int64(0)
 
 	mockRepo.EXPECT().GetAllIncoming(login, zero, zero, ctx).Return(expectedEmails, nil)
+	mockRepo.EXPECT().GetLabelsByEmails([]uint64{0, 0}, login, ctx).Return(map[uint64][]*domain.Label{}, nil)
 
 	emails, err := useCase.GetAllEmailsIncoming(login, zero, zero, ctx)
 
@@ -66,7 +67,7 @@ func TestAllEmailsIncoming_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	ctx := GetCTX()
@@ -79,12 +80,30 @@ func TestAllEmailsIncoming_ErrorFromRepository(t *testing.T) {
 	assert.Nil(t, emails)
 }
 
+func TestGetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().CountUnreadIncoming(login, ctx).Return(int64(5), nil)
+
+	count, err := useCase.GetUnreadCount(login, ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), count)
+}
+
 func TestGetAllEmailsSent_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	expectedEmails := []*domain.Email{
@@ -94,6 +113,7 @@ func TestGetAllEmailsSent_Success(t *testing.T) {
 	ctx := GetCTX()
 	zero := int64(0)
 	mockRepo.EXPECT().GetAllSent(login, zero, zero, ctx).Return(expectedEmails, nil)
+	mockRepo.EXPECT().GetLabelsByEmails([]uint64{0, 0}, login, ctx).Return(map[uint64][]*domain.Label{}, nil)
 
 	emails, err := useCase.GetAllEmailsSent(login, zero, zero, ctx)
 
@@ -106,7 +126,7 @@ func TestGetAllEmailsSent_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	ctx := GetCTX()
@@ -125,7 +145,7 @@ func TestGetAllEmailsDraft(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	expectedEmails := []*domain.Email{
@@ -136,6 +156,7 @@ func TestGetAllEmailsDraft(t *testing.T) {
 	zero := int64(0)
 
 	mockRepo.EXPECT().GetAllDraft(login, zero, zero, ctx).Return(expectedEmails, nil)
+	mockRepo.EXPECT().GetLabelsByEmails([]uint64{0, 0}, login, ctx).Return(map[uint64][]*domain.Label{}, nil)
 
 	emails, err := useCase.GetAllDraftEmails(login, zero, zero, ctx)
 
@@ -148,7 +169,7 @@ func TestGetAllEmailsSpam(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	expectedEmails := []*domain.Email{
@@ -159,6 +180,7 @@ func TestGetAllEmailsSpam(t *testing.T) {
 	zero := int64(0)
 
 	mockRepo.EXPECT().GetAllSpam(login, zero, zero, ctx).Return(expectedEmails, nil)
+	mockRepo.EXPECT().GetLabelsByEmails([]uint64{0, 0}, login, ctx).Return(map[uint64][]*domain.Label{}, nil)
 
 	emails, err := useCase.GetAllSpamEmails(login, zero, zero, ctx)
 
@@ -171,7 +193,7 @@ func TestGetEmailByID_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	ctx := GetCTX()
@@ -190,7 +212,7 @@ func TestGetEmailByID_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	ctx := GetCTX()
@@ -208,7 +230,7 @@ func TestCreateEmail_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	ctx := GetCTX()
 
@@ -227,7 +249,7 @@ func TestCreateEmail_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 	newEmail := &domain.Email{Topic: "Topic 1", Text: "Text 1"}
 
 	ctx := GetCTX()
@@ -246,13 +268,14 @@ func TestCreateProfileEmail_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	emailId := uint64(1)
 	sender := "test_sender@mailhub.su"
 	recipient := "test_recipient@mailhub.su"
 	ctx := GetCTX()
 
+	mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(nil, errors.New("mailing list not found"))
 	mockRepo.EXPECT().AddProfileEmail(emailId, sender, recipient, ctx).Return(nil)
 
 	err := useCase.CreateProfileEmail(emailId, sender, recipient, ctx)
@@ -265,13 +288,14 @@ func TestCreateProfileEmail_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	emailId := uint64(1)
 	sender := "test_sender@mailhub.su"
 	recipient := "test_recipient@mailhub.su"
 	ctx := GetCTX()
 
+	mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(nil, errors.New("mailing list not found"))
 	mockRepo.EXPECT().AddProfileEmail(emailId, sender, recipient, ctx).Return(errors.New("repository error"))
 
 	err := useCase.CreateProfileEmail(emailId, sender, recipient, ctx)
@@ -284,14 +308,15 @@ func TestCheckRecipientEmail_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	recipient := "test_recipient@mailhub.su"
+	sender := "test_sender@mailhub.su"
 	ctx := GetCTX()
 
 	mockRepo.EXPECT().FindEmail(recipient, ctx).Return(nil)
 
-	err := useCase.CheckRecipientEmail(recipient, ctx)
+	err := useCase.CheckRecipientEmail(recipient, sender, ctx)
 
 	assert.NoError(t, err)
 }
@@ -301,14 +326,16 @@ func TestCheckRecipientEmail_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	recipient := "test_recipient@mailhub.su"
+	sender := "test_sender@mailhub.su"
 	ctx := GetCTX()
 
 	mockRepo.EXPECT().FindEmail(recipient, ctx).Return(errors.New("repository error"))
+	mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(nil, errors.New("repository error"))
 
-	err := useCase.CheckRecipientEmail(recipient, ctx)
+	err := useCase.CheckRecipientEmail(recipient, sender, ctx)
 
 	assert.Error(t, err)
 }
@@ -318,7 +345,7 @@ func TestUpdateEmail_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	newEmail := &domain.Email{ID: 1, Topic: "Topic 1", Text: "Text 1"}
 	ctx := GetCTX()
@@ -336,7 +363,7 @@ func TestDeleteEmail_ErrorFromRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	login := "test@mailhub.su"
 	ctx := GetCTX()
@@ -354,7 +381,7 @@ func TestAddAttachment_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := "test_file_id"
 	fileType := "pdf"
@@ -377,7 +404,7 @@ func TestAddAttachment_EmptyFileID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := ""
 	fileType := "pdf"
@@ -397,7 +424,7 @@ func TestAddAttachment_EmptyFileType(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := "test_file_id"
 	fileType := ""
@@ -417,7 +444,7 @@ func TestAddAttachment_InvalidEmailID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := "test_file_id"
 	fileType := "pdf"
@@ -437,7 +464,7 @@ func TestAddAttachment_ErrorAddingFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := "test_file_id"
 	fileType := "pdf"
@@ -459,7 +486,7 @@ func TestAddAttachment_ErrorAddingAttachment(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := "test_file_id"
 	fileType := "pdf"
@@ -482,7 +509,7 @@ func TestGetFileByID_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	expectedFile := &domain.File{ID: fileID, FileId: "test_file"}
@@ -502,7 +529,7 @@ func TestGetFileByID_InvalidFileID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(0)
 	ctx := context.Background()
@@ -518,7 +545,7 @@ func TestGetFileByID_ErrorGettingFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	ctx := context.Background()
@@ -536,7 +563,7 @@ func TestGetFilesByEmailID_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	emailID := uint64(123)
 	expectedFiles := []*domain.File{
@@ -559,7 +586,7 @@ func TestGetFilesByEmailID_InvalidEmailID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	emailID := uint64(0)
 	ctx := context.Background()
@@ -575,7 +602,7 @@ func TestGetFilesByEmailID_ErrorGettingFiles(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	emailID := uint64(123)
 	ctx := context.Background()
@@ -593,7 +620,7 @@ func TestDeleteFileByID_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	ctx := context.Background()
@@ -611,7 +638,7 @@ func TestDeleteFileByID_InvalidFileID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(0)
 	ctx := context.Background()
@@ -627,7 +654,7 @@ func TestDeleteFileByID_ErrorDeletingFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	ctx := context.Background()
@@ -645,7 +672,7 @@ func TestUpdateFileByID_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	newFileID := "new_file_id"
@@ -678,7 +705,7 @@ func TestUpdateFileByID_InvalidFileID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(0)
 	newFileID := "new_file_id"
@@ -698,7 +725,7 @@ func TestUpdateFileByID_EmptyNewFileID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	newFileID := ""
@@ -718,7 +745,7 @@ func TestUpdateFileByID_EmptyNewFileType(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	newFileID := "new_file_id"
@@ -738,7 +765,7 @@ func TestUpdateFileByID_ErrorGettingFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	newFileID := "new_file_id"
@@ -760,7 +787,7 @@ func TestUpdateFileByID_ErrorUpdatingFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := uint64(123)
 	newFileID := "new_file_id"
@@ -791,7 +818,7 @@ func TestAddFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	fileID := "test_file_id"
 	fileType := "test_file_type"
@@ -843,7 +870,7 @@ func TestAddFileToEmail(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
-	useCase := NewEmailUseCase(mockRepo)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
 
 	emailID := uint64(123)
 	fileID := uint64(456)
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"mail/internal/pkg/utils/validators"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// publish sends the event to the connected clients of the user.
+// The change is already committed when it is published, so a failure is only logged.
+func (uc *EmailUseCase) publish(login string, event *domain.MailboxEvent, ctx context.Context) {
+	if uc.events == nil {
+		return
+	}
+
+	if err := uc.events.Publish(login, event, ctx); err != nil {
+		log.Printf("failed to publish %s event to %s: %v", event.Type, login, err)
+	}
+}
+
+// publishNewMail notifies the recipients of the delivered email and of the new number of their unread emails.
+func (uc *EmailUseCase) publishNewMail(emailID uint64, recipients []string, ctx context.Context) {
+	if uc.events == nil || len(recipients) == 0 {
+		return
+	}
+
+	for _, recipient := range recipients {
+		// The email is read as every recipient sees it rather than as the first one.
+		email, err := uc.repo.GetByID(emailID, recipient, ctx)
+		if err != nil {
+			log.Printf("failed to publish new_mail event of email %d to %s: %v", emailID, recipient, err)
+			continue
+		}
+
+		uc.publish(recipient, &domain.MailboxEvent{Type: domain.MailboxEventNewMail, EmailID: emailID, Email: email}, ctx)
+		uc.publishUnreadCount(recipient, ctx)
+	}
+}
+
+// readStatusChanged tells whether the update changes the read status of the incoming email stored before it.
+// An email which can't be read is taken as changed, so the clients are not left with a stale status.
+func (uc *EmailUseCase) readStatusChanged(updatedEmail *domain.Email, ctx context.Context) bool {
+	if uc.events == nil || updatedEmail.DraftStatus || !validators.IsValidEmailFormat(updatedEmail.RecipientEmail) {
+		return false
+	}
+
+	stored, err := uc.repo.GetByID(updatedEmail.ID, updatedEmail.RecipientEmail, ctx)
+	if err != nil {
+		log.Printf("failed to get email %d before the update: %v", updatedEmail.ID, err)
+		return true
+	}
+
+	return stored.ReadStatus != updatedEmail.ReadStatus
+}
+
+// publishDraftSaved notifies the sender of the saved draft.
+func (uc *EmailUseCase) publishDraftSaved(emailID uint64, sender string, ctx context.Context) {
+	if uc.events == nil {
+		return
+	}
+
+	draft, err := uc.repo.GetByID(emailID, sender, ctx)
+	if err != nil {
+		log.Printf("failed to publish draft_saved event of email %d: %v", emailID, err)
+		return
+	}
+
+	uc.publish(sender, &domain.MailboxEvent{Type: domain.MailboxEventDraftSaved, EmailID: emailID, Email: draft}, ctx)
+}
+
+// publishUnreadCount notifies the user of the number of the unread incoming emails.
+func (uc *EmailUseCase) publishUnreadCount(login string, ctx context.Context) {
+	if uc.events == nil {
+		return
+	}
+
+	unread, err := uc.repo.CountUnreadIncoming(login, ctx)
+	if err != nil {
+		log.Printf("failed to publish unread_count event to %s: %v", login, err)
+		return
+	}
+
+	uc.publish(login, &domain.MailboxEvent{Type: domain.MailboxEventUnreadCount, Unread: &unread}, ctx)
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockRepository "mail/internal/microservice/email/mock"
+	mockEvents "mail/internal/microservice/events/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func newEventsUseCase(t *testing.T) (*EmailUseCase, *mockRepository.MockEmailRepository, *mockEvents.MockEventsBus) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	mockBus := mockEvents.NewMockEventsBus(ctrl)
+
+	return NewEmailUseCase(mockRepo, mockBus, nil), mockRepo, mockBus
+}
+
+func TestCreateProfileEmail_PublishesNewMail(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+
+	emailId := uint64(1)
+	sender := "test_sender@mailhub.su"
+	recipient := "test_recipient@mailhub.su"
+	email := &domain.Email{ID: emailId, Topic: "Hi", SenderEmail: sender, RecipientEmail: recipient}
+	unread := int64(3)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(nil, errors.New("mailing list not found"))
+	mockRepo.EXPECT().AddProfileEmail(emailId, sender, recipient, ctx).Return(nil)
+	mockRepo.EXPECT().GetByID(emailId, recipient, ctx).Return(email, nil)
+	mockRepo.EXPECT().CountUnreadIncoming(recipient, ctx).Return(unread, nil)
+	gomock.InOrder(
+		mockBus.EXPECT().Publish(recipient, &domain.MailboxEvent{Type: domain.MailboxEventNewMail, EmailID: emailId, Email: email}, ctx).Return(nil),
+		mockBus.EXPECT().Publish(recipient, &domain.MailboxEvent{Type: domain.MailboxEventUnreadCount, Unread: &unread}, ctx).Return(nil),
+	)
+
+	assert.NoError(t, useCase.CreateProfileEmail(emailId, sender, recipient, ctx))
+}
+
+func TestCreateProfileEmail_PublishesDraftSaved(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+
+	emailId := uint64(1)
+	sender := "test_sender@mailhub.su"
+	draft := &domain.Email{ID: emailId, Topic: "Draft", SenderEmail: sender, DraftStatus: true}
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().AddProfileEmailMyself(emailId, sender, ctx).Return(nil)
+	mockRepo.EXPECT().GetByID(emailId, sender, ctx).Return(draft, nil)
+	mockBus.EXPECT().Publish(sender, &domain.MailboxEvent{Type: domain.MailboxEventDraftSaved, EmailID: emailId, Email: draft}, ctx).Return(nil)
+
+	assert.NoError(t, useCase.CreateProfileEmail(emailId, sender, "", ctx))
+}
+
+func TestCreateProfileEmail_ExternalRecipientNoEvents(t *testing.T) {
+	useCase, mockRepo, _ := newEventsUseCase(t)
+
+	ctx := GetCTX()
+	mockRepo.EXPECT().AddProfileEmailMyself(uint64(1), "test_sender@mailhub.su", ctx).Return(nil)
+
+	assert.NoError(t, useCase.CreateProfileEmail(1, "test_sender@mailhub.su", "friend@mail.ru", ctx))
+}
+
+func TestCreateProfileEmail_PublishesToListMembers(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+
+	emailId := uint64(1)
+	sender := "test_sender@mailhub.su"
+	list := &domain.MailingList{ID: 2, Address: "team@mailhub.su", PostPolicy: domain.PostPolicyAnyone}
+	email := &domain.Email{ID: emailId, SenderEmail: sender, RecipientEmail: list.Address}
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetMailingListByAddress(list.Address, ctx).Return(list, nil)
+	mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return(domain.ListRoleMember, nil)
+	mockRepo.EXPECT().AddProfileEmailList(emailId, sender, list.ID, ctx).Return(nil)
+	mockRepo.EXPECT().GetMailingListMembers(list.ID, ctx).Return([]*domain.MailingListMember{
+		{ListID: list.ID, Login: sender}, {ListID: list.ID, Login: "alice@mailhub.su"}, {ListID: list.ID, Login: "bob@mailhub.su"},
+	}, nil)
+	mockRepo.EXPECT().GetByID(emailId, "alice@mailhub.su", ctx).Return(email, nil)
+	mockRepo.EXPECT().GetByID(emailId, "bob@mailhub.su", ctx).Return(email, nil)
+	mockRepo.EXPECT().CountUnreadIncoming(gomock.Any(), ctx).Return(int64(1), nil).Times(2)
+	for _, member := range []string{"alice@mailhub.su", "bob@mailhub.su"} {
+		mockBus.EXPECT().Publish(member, &domain.MailboxEvent{Type: domain.MailboxEventNewMail, EmailID: emailId, Email: email}, ctx).Return(nil)
+		mockBus.EXPECT().Publish(member, gomock.Any(), ctx).Return(nil)
+	}
+
+	assert.NoError(t, useCase.CreateProfileEmail(emailId, sender, list.Address, ctx))
+}
+
+func TestUpdateEmail_PublishesReadStatus(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+
+	email := &domain.Email{ID: 1, ReadStatus: true, SenderEmail: "alice@mailhub.su", RecipientEmail: "bob@mailhub.su"}
+	readStatus := true
+	unread := int64(0)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetByID(uint64(1), "bob@mailhub.su", ctx).Return(&domain.Email{ID: 1, ReadStatus: false}, nil)
+	mockRepo.EXPECT().Update(email, ctx).Return(true, nil)
+	mockRepo.EXPECT().CountUnreadIncoming("bob@mailhub.su", ctx).Return(unread, nil)
+	gomock.InOrder(
+		mockBus.EXPECT().Publish("bob@mailhub.su", &domain.MailboxEvent{Type: domain.MailboxEventReadStatus, EmailID: 1, ReadStatus: &readStatus}, ctx).Return(nil),
+		mockBus.EXPECT().Publish("bob@mailhub.su", &domain.MailboxEvent{Type: domain.MailboxEventUnreadCount, Unread: &unread}, ctx).Return(nil),
+	)
+
+	ok, err := useCase.UpdateEmail(email, ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestUpdateEmail_ReadStatusUnchanged(t *testing.T) {
+	useCase, mockRepo, _ := newEventsUseCase(t)
+
+	email := &domain.Email{ID: 1, Topic: "Edited", ReadStatus: true, SenderEmail: "alice@mailhub.su", RecipientEmail: "bob@mailhub.su"}
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetByID(uint64(1), "bob@mailhub.su", ctx).Return(&domain.Email{ID: 1, ReadStatus: true}, nil)
+	mockRepo.EXPECT().Update(email, ctx).Return(true, nil)
+
+	ok, err := useCase.UpdateEmail(email, ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestDeleteEmail_PublishesDeleted(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Deleted", func(t *testing.T) {
+		mockRepo.EXPECT().Delete(uint64(1), login, ctx).Return(true, nil)
+		mockRepo.EXPECT().CountUnreadIncoming(login, ctx).Return(int64(2), nil)
+		mockBus.EXPECT().Publish(login, &domain.MailboxEvent{Type: domain.MailboxEventDeleted, EmailID: 1}, ctx).Return(nil)
+		mockBus.EXPECT().Publish(login, gomock.Any(), ctx).Return(errors.New("bus error"))
+
+		ok, err := useCase.DeleteEmail(1, login, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("NotDeleted", func(t *testing.T) {
+		mockRepo.EXPECT().Delete(uint64(2), login, ctx).Return(false, errors.New("not found"))
+
+		_, err := useCase.DeleteEmail(2, login, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestBulkEmails_PublishesEvents(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+
+	login := "test@mailhub.su"
+	request := &domain.BulkRequest{Action: domain.BulkActionMove, EmailIDs: []uint64{1, 2}, FolderID: 4}
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().BulkEmails(request, login, ctx).Return([]uint64{2}, nil)
+	mockBus.EXPECT().Publish(login, &domain.MailboxEvent{Type: domain.MailboxEventMoved, EmailID: 2, FolderID: 4}, ctx).Return(nil)
+
+	results, err := useCase.BulkEmails(request, login, ctx)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+}
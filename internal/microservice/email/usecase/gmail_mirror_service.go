@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// GetGMailHistoryID returns the identifier of the latest change of the Gmail mailbox copied locally.
+func (uc *EmailUseCase) GetGMailHistoryID(login string, ctx context.Context) (uint64, bool, error) {
+	return uc.repo.GetGMailHistoryID(login, ctx)
+}
+
+// GetGMailLogins returns the Gmail mailboxes which have a local copy.
+func (uc *EmailUseCase) GetGMailLogins(ctx context.Context) ([]string, error) {
+	return uc.repo.GetGMailLogins(ctx)
+}
+
+// ApplyGMailSync applies the changes of the Gmail mailbox to its local copy.
+// An email both changed and deleted in the same synchronization is deleted.
+func (uc *EmailUseCase) ApplyGMailSync(sync *domain.GMailSync, ctx context.Context) error {
+	if sync.HistoryID == 0 {
+		return fmt.Errorf("invalid gmail history id")
+	}
+
+	deleted := make(map[string]bool, len(sync.DeletedIDs))
+	for _, id := range sync.DeletedIDs {
+		deleted[id] = true
+	}
+
+	messages := make([]*domain.GMailMessage, 0, len(sync.Messages))
+	for _, m := range sync.Messages {
+		if m.ID == "" {
+			return fmt.Errorf("gmail message without id")
+		}
+		if !deleted[m.ID] {
+			messages = append(messages, m)
+		}
+	}
+	sync.Messages = messages
+
+	return uc.repo.ApplyGMailSync(sync, ctx)
+}
+
+// GetGMailMessages returns the newest emails of the local copy of the Gmail mailbox with the label,
+// older than the email with beforeDate and beforeID unless beforeDate is zero.
+func (uc *EmailUseCase) GetGMailMessages(login, labelID string, beforeDate time.Time, beforeID string, limit int64, ctx context.Context) ([]*domain.GMailMessage, error) {
+	if limit <= 0 || limit > domain.GMailMessagesLimit {
+		limit = domain.GMailMessagesLimit
+	}
+
+	return uc.repo.GetGMailMessages(login, labelID, beforeDate, beforeID, limit, ctx)
+}
+
+// GetGMailLabels returns the labels of the local copy of the Gmail mailbox.
+func (uc *EmailUseCase) GetGMailLabels(login string, ctx context.Context) ([]*domain.GMailLabel, error) {
+	return uc.repo.GetGMailLabels(login, ctx)
+}
+
+// UpdateGMailMessageLabels adds and removes the labels of an email of the local copy.
+func (uc *EmailUseCase) UpdateGMailMessageLabels(login, id string, addLabelIDs, removeLabelIDs []string, ctx context.Context) (bool, error) {
+	return uc.repo.UpdateGMailMessageLabels(login, id, addLabelIDs, removeLabelIDs, ctx)
+}
+
+// DeleteGMailMessage removes an email from the local copy.
+func (uc *EmailUseCase) DeleteGMailMessage(login, id string, ctx context.Context) (bool, error) {
+	return uc.repo.DeleteGMailMessage(login, id, ctx)
+}
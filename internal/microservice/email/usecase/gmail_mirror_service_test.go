@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockRepository "mail/internal/microservice/email/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestApplyGMailSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+	ctx := GetCTX()
+
+	t.Run("DeletedWins", func(t *testing.T) {
+		sync := &domain.GMailSync{
+			Login:      "user@gmail.com",
+			HistoryID:  10,
+			Messages:   []*domain.GMailMessage{{ID: "1"}, {ID: "2"}},
+			DeletedIDs: []string{"2"},
+		}
+		mockRepo.EXPECT().ApplyGMailSync(sync, ctx).DoAndReturn(func(sync *domain.GMailSync, ctx interface{}) error {
+			assert.Equal(t, []*domain.GMailMessage{{ID: "1"}}, sync.Messages)
+			return nil
+		})
+
+		assert.NoError(t, useCase.ApplyGMailSync(sync, ctx))
+	})
+
+	t.Run("InvalidHistoryID", func(t *testing.T) {
+		assert.Error(t, useCase.ApplyGMailSync(&domain.GMailSync{Login: "user@gmail.com"}, ctx))
+	})
+
+	t.Run("MessageWithoutID", func(t *testing.T) {
+		sync := &domain.GMailSync{Login: "user@gmail.com", HistoryID: 10, Messages: []*domain.GMailMessage{{}}}
+		assert.Error(t, useCase.ApplyGMailSync(sync, ctx))
+	})
+}
+
+func TestGetGMailMessages_Limit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetGMailMessages("user@gmail.com", domain.GMailLabelInbox, time.Time{}, "", int64(domain.GMailMessagesLimit), ctx).Return([]*domain.GMailMessage{}, nil).Times(2)
+	mockRepo.EXPECT().GetGMailMessages("user@gmail.com", domain.GMailLabelInbox, time.Time{}, "", int64(20), ctx).Return([]*domain.GMailMessage{}, nil)
+
+	for _, limit := range []int64{0, 1000, 20} {
+		_, err := useCase.GetGMailMessages("user@gmail.com", domain.GMailLabelInbox, time.Time{}, "", limit, ctx)
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/pkg/utils/validators"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// MaxLabelEmails is the maximum number of emails that can be labeled or unlabeled in one request.
+const MaxLabelEmails = 100
+
+// attachLabels fills the labels the user has put on each of the emails with a single query.
+func (uc *EmailUseCase) attachLabels(emails []*domain.Email, login string, ctx context.Context) error {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	emailIDs := make([]uint64, 0, len(emails))
+	for _, email := range emails {
+		emailIDs = append(emailIDs, email.ID)
+	}
+
+	labels, err := uc.repo.GetLabelsByEmails(emailIDs, login, ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, email := range emails {
+		email.Labels = labels[email.ID]
+	}
+
+	return nil
+}
+
+// validateLabel checks the name and color of the label, setting the default color if it is empty.
+func validateLabel(label *domain.Label) error {
+	if validators.IsEmpty(label.Name) {
+		return fmt.Errorf("label name is empty")
+	}
+
+	if label.Color == "" {
+		label.Color = domain.DefaultLabelColor
+	}
+
+	if !validators.IsValidColor(label.Color) {
+		return fmt.Errorf("invalid label color: %s", label.Color)
+	}
+
+	return nil
+}
+
+// checkLabelEmails removes duplicate email IDs and checks that the label and all the emails belong to the user.
+func (uc *EmailUseCase) checkLabelEmails(labelID uint32, emailIDs []uint64, login string, ctx context.Context) ([]uint64, error) {
+	seen := make(map[uint64]struct{}, len(emailIDs))
+	uniqueIDs := make([]uint64, 0, len(emailIDs))
+	for _, id := range emailIDs {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	if len(uniqueIDs) == 0 || len(uniqueIDs) > MaxLabelEmails {
+		return nil, fmt.Errorf("number of emails must be from 1 to %d", MaxLabelEmails)
+	}
+
+	if _, err := uc.repo.GetLabelByID(labelID, login, ctx); err != nil {
+		return nil, err
+	}
+
+	ok, err := uc.repo.CheckEmailsProfile(uniqueIDs, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("some emails do not belong to user %s", login)
+	}
+
+	return uniqueIDs, nil
+}
+
+// CreateLabel creates a new label of the user.
+func (uc *EmailUseCase) CreateLabel(label *domain.Label, login string, ctx context.Context) (*domain.Label, error) {
+	if err := validateLabel(label); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.CreateLabel(label, login, ctx)
+}
+
+// GetLabels returns all labels of the user.
+func (uc *EmailUseCase) GetLabels(login string, offset, limit int64, ctx context.Context) ([]*domain.Label, error) {
+	return uc.repo.GetLabelsByLogin(login, offset, limit, ctx)
+}
+
+// UpdateLabel changes the name and color of the user label.
+func (uc *EmailUseCase) UpdateLabel(label *domain.Label, login string, ctx context.Context) (bool, error) {
+	if err := validateLabel(label); err != nil {
+		return false, err
+	}
+
+	return uc.repo.UpdateLabel(label, login, ctx)
+}
+
+// DeleteLabel deletes the user label.
+func (uc *EmailUseCase) DeleteLabel(id uint32, login string, ctx context.Context) (bool, error) {
+	return uc.repo.DeleteLabel(id, login, ctx)
+}
+
+// GetEmailLabels returns the labels the user has put on the email.
+func (uc *EmailUseCase) GetEmailLabels(emailID uint64, login string, ctx context.Context) ([]*domain.Label, error) {
+	ok, err := uc.repo.CheckEmailsProfile([]uint64{emailID}, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("email %d does not belong to user %s", emailID, login)
+	}
+
+	labels, err := uc.repo.GetLabelsByEmails([]uint64{emailID}, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels[emailID], nil
+}
+
+// GetAllEmailsInLabel returns all emails of the user marked with the label with the specified name.
+func (uc *EmailUseCase) GetAllEmailsInLabel(name, login string, ctx context.Context) ([]*domain.Email, error) {
+	label, err := uc.repo.GetLabelByName(name, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emails, err := uc.repo.GetAllEmailsInLabel(label.ID, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.attachLabels(emails, login, ctx); err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+// AddEmailsInLabel puts the user label on a batch of the user emails.
+func (uc *EmailUseCase) AddEmailsInLabel(labelID uint32, emailIDs []uint64, login string, ctx context.Context) (bool, error) {
+	uniqueIDs, err := uc.checkLabelEmails(labelID, emailIDs, login, ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err = uc.repo.AddEmailsInLabel(labelID, uniqueIDs, ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteEmailsInLabel removes the user label from a batch of the user emails.
+func (uc *EmailUseCase) DeleteEmailsInLabel(labelID uint32, emailIDs []uint64, login string, ctx context.Context) (bool, error) {
+	uniqueIDs, err := uc.checkLabelEmails(labelID, emailIDs, login, ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return uc.repo.DeleteEmailsInLabel(labelID, uniqueIDs, ctx)
+}
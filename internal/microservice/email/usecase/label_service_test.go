@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockRepository "mail/internal/microservice/email/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestCreateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("DefaultColor", func(t *testing.T) {
+		expected := &domain.Label{ID: 1, Name: "Work", Color: domain.DefaultLabelColor}
+		mockRepo.EXPECT().CreateLabel(&domain.Label{Name: "Work", Color: domain.DefaultLabelColor}, login, ctx).Return(expected, nil)
+
+		label, err := useCase.CreateLabel(&domain.Label{Name: "Work"}, login, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, label)
+	})
+
+	t.Run("InvalidColor", func(t *testing.T) {
+		label, err := useCase.CreateLabel(&domain.Label{Name: "Work", Color: "red"}, login, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, label)
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		label, err := useCase.CreateLabel(&domain.Label{Name: " "}, login, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, label)
+	})
+}
+
+func TestGetEmailLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		labels := []*domain.Label{{ID: 1, Name: "Work", Color: "#ff0000"}}
+		mockRepo.EXPECT().CheckEmailsProfile([]uint64{5}, login, ctx).Return(true, nil)
+		mockRepo.EXPECT().GetLabelsByEmails([]uint64{5}, login, ctx).Return(map[uint64][]*domain.Label{5: labels}, nil)
+
+		result, err := useCase.GetEmailLabels(5, login, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, labels, result)
+	})
+
+	t.Run("ForeignEmail", func(t *testing.T) {
+		mockRepo.EXPECT().CheckEmailsProfile([]uint64{5}, login, ctx).Return(false, nil)
+
+		result, err := useCase.GetEmailLabels(5, login, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetAllEmailsInLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+	label := &domain.Label{ID: 1, Name: "Work", Color: "#ff0000"}
+
+	t.Run("Success", func(t *testing.T) {
+		emails := []*domain.Email{{ID: 1}, {ID: 2}}
+		mockRepo.EXPECT().GetLabelByName("Work", login, ctx).Return(label, nil)
+		mockRepo.EXPECT().GetAllEmailsInLabel(label.ID, login, ctx).Return(emails, nil)
+		mockRepo.EXPECT().GetLabelsByEmails([]uint64{1, 2}, login, ctx).Return(map[uint64][]*domain.Label{1: {label}, 2: {label}}, nil)
+
+		result, err := useCase.GetAllEmailsInLabel("Work", login, ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.True(t, result[0].HasLabel(label.ID))
+		assert.True(t, result[1].HasLabel(label.ID))
+	})
+
+	t.Run("LabelNotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetLabelByName("Work", login, ctx).Return(nil, errors.New("label not found"))
+
+		result, err := useCase.GetAllEmailsInLabel("Work", login, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAddEmailsInLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+	label := &domain.Label{ID: 1, Name: "Work", Color: "#ff0000"}
+
+	t.Run("DuplicatesRemoved", func(t *testing.T) {
+		mockRepo.EXPECT().GetLabelByID(label.ID, login, ctx).Return(label, nil)
+		mockRepo.EXPECT().CheckEmailsProfile([]uint64{1, 2}, login, ctx).Return(true, nil)
+		mockRepo.EXPECT().AddEmailsInLabel(label.ID, []uint64{1, 2}, ctx).Return(nil)
+
+		ok, err := useCase.AddEmailsInLabel(label.ID, []uint64{1, 2, 1}, login, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("ForeignEmails", func(t *testing.T) {
+		mockRepo.EXPECT().GetLabelByID(label.ID, login, ctx).Return(label, nil)
+		mockRepo.EXPECT().CheckEmailsProfile([]uint64{1, 2}, login, ctx).Return(false, nil)
+
+		ok, err := useCase.AddEmailsInLabel(label.ID, []uint64{1, 2}, login, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("TooManyEmails", func(t *testing.T) {
+		emailIDs := make([]uint64, MaxLabelEmails+1)
+		for i := range emailIDs {
+			emailIDs[i] = uint64(i + 1)
+		}
+
+		ok, err := useCase.AddEmailsInLabel(label.ID, emailIDs, login, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestDeleteEmailsInLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	login := "test@mailhub.su"
+	ctx := GetCTX()
+	label := &domain.Label{ID: 1, Name: "Work", Color: "#ff0000"}
+
+	mockRepo.EXPECT().GetLabelByID(label.ID, login, ctx).Return(label, nil)
+	mockRepo.EXPECT().CheckEmailsProfile([]uint64{3}, login, ctx).Return(true, nil)
+	mockRepo.EXPECT().DeleteEmailsInLabel(label.ID, []uint64{3}, ctx).Return(true, nil)
+
+	ok, err := useCase.DeleteEmailsInLabel(label.ID, []uint64{3}, login, ctx)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// checkMailboxRole returns the role of the user in the shared mailbox if it grants the permission.
+// The profile the mailbox belongs to is its owner and has every permission.
+func (uc *EmailUseCase) checkMailboxRole(mailbox, login, permission string, ctx context.Context) (string, error) {
+	role := domain.MailboxRoleOwner
+	if mailbox != login {
+		var err error
+		role, err = uc.repo.GetMailboxDelegateRole(mailbox, login, ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if !domain.MailboxRoleAllows(role, permission) {
+		return "", fmt.Errorf("user %s has no %s access to mailbox %s", login, permission, mailbox)
+	}
+
+	return role, nil
+}
+
+// GetMailboxDelegates returns the delegates of the shared mailbox; the user must be allowed to manage it.
+func (uc *EmailUseCase) GetMailboxDelegates(mailbox, login string, ctx context.Context) ([]*domain.MailboxDelegate, error) {
+	if _, err := uc.checkMailboxRole(mailbox, login, domain.MailboxPermissionManage, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetMailboxDelegates(mailbox, ctx)
+}
+
+// GetDelegatedMailboxes returns the shared mailboxes the user is a delegate of.
+func (uc *EmailUseCase) GetDelegatedMailboxes(login string, offset, limit int64, ctx context.Context) ([]*domain.MailboxDelegate, error) {
+	return uc.repo.GetDelegatedMailboxes(login, offset, limit, ctx)
+}
+
+// AddMailboxDelegate gives a profile access to the shared mailbox on behalf of a user allowed to manage it.
+func (uc *EmailUseCase) AddMailboxDelegate(mailbox, login, delegate, role string, ctx context.Context) (bool, error) {
+	if !domain.IsValidDelegateRole(role) {
+		return false, fmt.Errorf("invalid delegate role: %s", role)
+	}
+
+	if mailbox == delegate {
+		return false, fmt.Errorf("mailbox %s cannot be its own delegate", mailbox)
+	}
+
+	if _, err := uc.checkMailboxRole(mailbox, login, domain.MailboxPermissionManage, ctx); err != nil {
+		return false, err
+	}
+
+	if err := uc.repo.AddMailboxDelegate(mailbox, delegate, role, ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteMailboxDelegate takes away the access of a profile to the shared mailbox; delegates may remove themselves.
+func (uc *EmailUseCase) DeleteMailboxDelegate(mailbox, login, delegate string, ctx context.Context) (bool, error) {
+	if login != delegate {
+		if _, err := uc.checkMailboxRole(mailbox, login, domain.MailboxPermissionManage, ctx); err != nil {
+			return false, err
+		}
+	}
+
+	return uc.repo.DeleteMailboxDelegate(mailbox, delegate, ctx)
+}
+
+// AddDelegateAction records an action the delegate performed in the shared mailbox.
+func (uc *EmailUseCase) AddDelegateAction(action *domain.DelegateAction, ctx context.Context) error {
+	if action.Mailbox == action.Delegate {
+		return nil
+	}
+
+	return uc.repo.AddDelegateAction(action, ctx)
+}
+
+// GetDelegateActions returns the actions the delegates performed in the shared mailbox; the user must be allowed to manage it.
+func (uc *EmailUseCase) GetDelegateActions(mailbox, login string, offset, limit int64, ctx context.Context) ([]*domain.DelegateAction, error) {
+	if _, err := uc.checkMailboxRole(mailbox, login, domain.MailboxPermissionManage, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetDelegateActions(mailbox, offset, limit, ctx)
+}
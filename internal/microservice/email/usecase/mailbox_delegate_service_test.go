@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockRepository "mail/internal/microservice/email/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestCreateEmail_SharedMailbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	mailbox := "support@mailhub.su"
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("SendOnBehalfKeepsDelegate", func(t *testing.T) {
+		newEmail := &domain.Email{SenderEmail: mailbox, SentByEmail: delegate}
+		mockRepo.EXPECT().GetMailboxDelegateRole(mailbox, delegate, ctx).Return(domain.DelegateRoleSendOnBehalf, nil)
+		mockRepo.EXPECT().Add(newEmail, ctx).Return(uint64(1), newEmail, nil)
+
+		id, _, err := useCase.CreateEmail(newEmail, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), id)
+		assert.Equal(t, delegate, newEmail.SentByEmail)
+	})
+
+	t.Run("SendAsHidesDelegate", func(t *testing.T) {
+		newEmail := &domain.Email{SenderEmail: mailbox, SentByEmail: delegate}
+		mockRepo.EXPECT().GetMailboxDelegateRole(mailbox, delegate, ctx).Return(domain.DelegateRoleSendAs, nil)
+		mockRepo.EXPECT().Add(newEmail, ctx).Return(uint64(1), newEmail, nil)
+
+		_, _, err := useCase.CreateEmail(newEmail, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "", newEmail.SentByEmail)
+	})
+
+	t.Run("ReadOnlyCannotSend", func(t *testing.T) {
+		newEmail := &domain.Email{SenderEmail: mailbox, SentByEmail: delegate}
+		mockRepo.EXPECT().GetMailboxDelegateRole(mailbox, delegate, ctx).Return(domain.DelegateRoleRead, nil)
+
+		_, _, err := useCase.CreateEmail(newEmail, ctx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("OwnSender", func(t *testing.T) {
+		newEmail := &domain.Email{SenderEmail: delegate, SentByEmail: delegate}
+		mockRepo.EXPECT().Add(newEmail, ctx).Return(uint64(1), newEmail, nil)
+
+		_, _, err := useCase.CreateEmail(newEmail, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "", newEmail.SentByEmail)
+	})
+}
+
+func TestAddMailboxDelegate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	mailbox := "support@mailhub.su"
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("ByOwner", func(t *testing.T) {
+		mockRepo.EXPECT().AddMailboxDelegate(mailbox, delegate, domain.DelegateRoleRead, ctx).Return(nil)
+
+		status, err := useCase.AddMailboxDelegate(mailbox, mailbox, delegate, domain.DelegateRoleRead, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, status)
+	})
+
+	t.Run("ByManager", func(t *testing.T) {
+		manager := "manager@mailhub.su"
+		mockRepo.EXPECT().GetMailboxDelegateRole(mailbox, manager, ctx).Return(domain.DelegateRoleManage, nil)
+		mockRepo.EXPECT().AddMailboxDelegate(mailbox, delegate, domain.DelegateRoleSendAs, ctx).Return(nil)
+
+		status, err := useCase.AddMailboxDelegate(mailbox, manager, delegate, domain.DelegateRoleSendAs, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, status)
+	})
+
+	t.Run("BySender", func(t *testing.T) {
+		sender := "sender@mailhub.su"
+		mockRepo.EXPECT().GetMailboxDelegateRole(mailbox, sender, ctx).Return(domain.DelegateRoleSendAs, nil)
+
+		status, err := useCase.AddMailboxDelegate(mailbox, sender, delegate, domain.DelegateRoleRead, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+
+	t.Run("InvalidRole", func(t *testing.T) {
+		status, err := useCase.AddMailboxDelegate(mailbox, mailbox, delegate, domain.MailboxRoleOwner, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		status, err := useCase.AddMailboxDelegate(mailbox, mailbox, mailbox, domain.DelegateRoleRead, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+}
+
+func TestDeleteMailboxDelegate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	mailbox := "support@mailhub.su"
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("DelegateLeaves", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteMailboxDelegate(mailbox, delegate, ctx).Return(true, nil)
+
+		status, err := useCase.DeleteMailboxDelegate(mailbox, delegate, delegate, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, status)
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		other := "other@mailhub.su"
+		mockRepo.EXPECT().GetMailboxDelegateRole(mailbox, other, ctx).Return("", nil)
+
+		status, err := useCase.DeleteMailboxDelegate(mailbox, other, delegate, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+}
+
+func TestGetDelegateActions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	mailbox := "support@mailhub.su"
+	delegate := "test@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("ByOwner", func(t *testing.T) {
+		actions := []*domain.DelegateAction{{ID: 1, Mailbox: mailbox, Delegate: delegate, Action: "send"}}
+		mockRepo.EXPECT().GetDelegateActions(mailbox, int64(0), int64(10), ctx).Return(actions, nil)
+
+		res, err := useCase.GetDelegateActions(mailbox, mailbox, 0, 10, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, actions, res)
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		mockRepo.EXPECT().GetMailboxDelegateRole(mailbox, delegate, ctx).Return("", errors.New("db error"))
+
+		res, err := useCase.GetDelegateActions(mailbox, delegate, 0, 10, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestAddDelegateAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	ctx := GetCTX()
+
+	t.Run("Delegate", func(t *testing.T) {
+		action := &domain.DelegateAction{Mailbox: "support@mailhub.su", Delegate: "test@mailhub.su", Action: "send", EmailID: 1}
+		mockRepo.EXPECT().AddDelegateAction(action, ctx).Return(nil)
+
+		assert.NoError(t, useCase.AddDelegateAction(action, ctx))
+	})
+
+	t.Run("OwnerIsNotRecorded", func(t *testing.T) {
+		action := &domain.DelegateAction{Mailbox: "support@mailhub.su", Delegate: "support@mailhub.su", Action: "send"}
+
+		assert.NoError(t, useCase.AddDelegateAction(action, ctx))
+	})
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"mail/internal/pkg/utils/signed_token"
+	"mail/internal/pkg/utils/validators"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// deliverToMailingList expands the mailing list into one profile_email link per member,
+// or holds the email for moderation if the sender is not allowed to post without approval.
+// The post policy is checked again here, so the delivery doesn't rely on the callers checking the recipient first.
+func (uc *EmailUseCase) deliverToMailingList(emailId uint64, sender string, list *domain.MailingList, ctx context.Context) error {
+	role, err := uc.repo.GetMailingListMemberRole(list.ID, sender, ctx)
+	if err != nil {
+		return err
+	}
+
+	if !list.CanPost(role) {
+		return fmt.Errorf("sender %s is not allowed to post to %s", sender, list.Address)
+	}
+
+	if !list.NeedsModeration(role) {
+		if err = uc.repo.AddProfileEmailList(emailId, sender, list.ID, ctx); err != nil {
+			return err
+		}
+		uc.publishListMail(emailId, sender, list.ID, ctx)
+		return nil
+	}
+
+	if validators.IsValidEmailFormat(sender) {
+		if err = uc.repo.AddProfileEmailMyself(emailId, sender, ctx); err != nil {
+			return err
+		}
+	}
+
+	return uc.repo.AddMailingListModeration(list.ID, emailId, ctx)
+}
+
+// publishListMail notifies the members of the mailing list but the sender of the delivered email.
+func (uc *EmailUseCase) publishListMail(emailId uint64, sender string, listID uint32, ctx context.Context) {
+	if uc.events == nil {
+		return
+	}
+
+	members, err := uc.repo.GetMailingListMembers(listID, ctx)
+	if err != nil {
+		log.Printf("failed to publish new_mail event of email %d: %v", emailId, err)
+		return
+	}
+
+	recipients := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.Login != sender {
+			recipients = append(recipients, member.Login)
+		}
+	}
+	uc.publishNewMail(emailId, recipients, ctx)
+}
+
+// checkMailingListOwner returns the mailing list if the user is one of its owners.
+func (uc *EmailUseCase) checkMailingListOwner(id uint32, login string, ctx context.Context) (*domain.MailingList, error) {
+	list, err := uc.repo.GetMailingListByID(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := uc.repo.GetMailingListMemberRole(id, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != domain.ListRoleOwner {
+		return nil, fmt.Errorf("user %s is not an owner of mailing list %d", login, id)
+	}
+
+	return list, nil
+}
+
+// checkMailingListMember returns the mailing list if the user is its member or owner.
+func (uc *EmailUseCase) checkMailingListMember(id uint32, login string, ctx context.Context) (*domain.MailingList, error) {
+	list, err := uc.repo.GetMailingListByID(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := uc.repo.GetMailingListMemberRole(id, login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == "" {
+		return nil, fmt.Errorf("user %s is not a member of mailing list %d", login, id)
+	}
+
+	return list, nil
+}
+
+// validateMailingList checks the settings of the mailing list.
+func validateMailingList(list *domain.MailingList) error {
+	if validators.IsEmpty(list.Name) {
+		return fmt.Errorf("mailing list name is empty")
+	}
+
+	switch list.PostPolicy {
+	case domain.PostPolicyAnyone, domain.PostPolicyMembers, domain.PostPolicyOwners:
+		return nil
+	}
+
+	return fmt.Errorf("invalid post policy: %s", list.PostPolicy)
+}
+
+// CreateMailingList creates a new mailing list owned by the specified user.
+func (uc *EmailUseCase) CreateMailingList(list *domain.MailingList, ownerLogin string, ctx context.Context) (*domain.MailingList, error) {
+	if !validators.IsValidEmailFormat(list.Address) {
+		return nil, fmt.Errorf("invalid mailing list address: %s", list.Address)
+	}
+
+	if list.PostPolicy == "" {
+		list.PostPolicy = domain.PostPolicyMembers
+	}
+
+	if err := validateMailingList(list); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.FindEmail(list.Address, ctx); err == nil {
+		return nil, fmt.Errorf("address %s is already taken by a user", list.Address)
+	}
+
+	return uc.repo.CreateMailingList(list, ownerLogin, ctx)
+}
+
+// GetMailingLists returns all mailing lists the specified user is a member of.
+func (uc *EmailUseCase) GetMailingLists(login string, offset, limit int64, ctx context.Context) ([]*domain.MailingList, error) {
+	return uc.repo.GetMailingListsByLogin(login, offset, limit, ctx)
+}
+
+// GetMailingListByID returns the mailing list with the specified ID if the user is its member.
+func (uc *EmailUseCase) GetMailingListByID(id uint32, login string, ctx context.Context) (*domain.MailingList, error) {
+	return uc.checkMailingListMember(id, login, ctx)
+}
+
+// UpdateMailingList updates the settings of the mailing list on behalf of one of its owners.
+func (uc *EmailUseCase) UpdateMailingList(list *domain.MailingList, login string, ctx context.Context) (bool, error) {
+	if err := validateMailingList(list); err != nil {
+		return false, err
+	}
+
+	if _, err := uc.checkMailingListOwner(list.ID, login, ctx); err != nil {
+		return false, err
+	}
+
+	return uc.repo.UpdateMailingList(list, ctx)
+}
+
+// DeleteMailingList deletes the mailing list on behalf of one of its owners.
+func (uc *EmailUseCase) DeleteMailingList(id uint32, login string, ctx context.Context) (bool, error) {
+	if _, err := uc.checkMailingListOwner(id, login, ctx); err != nil {
+		return false, err
+	}
+
+	return uc.repo.DeleteMailingList(id, ctx)
+}
+
+// GetMailingListMembers returns the members of the mailing list if the user is its member.
+func (uc *EmailUseCase) GetMailingListMembers(id uint32, login string, ctx context.Context) ([]*domain.MailingListMember, error) {
+	if _, err := uc.checkMailingListMember(id, login, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetMailingListMembers(id, ctx)
+}
+
+// AddMailingListMember adds a member to the mailing list on behalf of one of its owners.
+func (uc *EmailUseCase) AddMailingListMember(id uint32, login, memberLogin, role string, ctx context.Context) (bool, error) {
+	if role == "" {
+		role = domain.ListRoleMember
+	}
+
+	if role != domain.ListRoleMember && role != domain.ListRoleOwner {
+		return false, fmt.Errorf("invalid mailing list role: %s", role)
+	}
+
+	if _, err := uc.checkMailingListOwner(id, login, ctx); err != nil {
+		return false, err
+	}
+
+	if err := uc.repo.AddMailingListMember(id, memberLogin, role, ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteMailingListMember removes a member from the mailing list; members may remove themselves.
+func (uc *EmailUseCase) DeleteMailingListMember(id uint32, login, memberLogin string, ctx context.Context) (bool, error) {
+	if login != memberLogin {
+		if _, err := uc.checkMailingListOwner(id, login, ctx); err != nil {
+			return false, err
+		}
+	}
+
+	return uc.repo.DeleteMailingListMember(id, memberLogin, ctx)
+}
+
+// UnsubscribeMailingList removes the member named by the signed token of a List-Unsubscribe link from the list.
+func (uc *EmailUseCase) UnsubscribeMailingList(token string, ctx context.Context) (bool, error) {
+	payload, err := signed_token.Verify(token, uc.unsubscribeKey, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	id, login, err := domain.ParseListUnsubscribePayload(payload)
+	if err != nil {
+		return false, err
+	}
+
+	return uc.repo.DeleteMailingListMember(id, login, ctx)
+}
+
+// listUnsubscribeToken returns the signed token of the List-Unsubscribe link of the list to the member.
+func (uc *EmailUseCase) listUnsubscribeToken(id uint32, login string) string {
+	return signed_token.Sign(domain.ListUnsubscribePayload(id, login), time.Now().Add(domain.ListUnsubscribeLifeTime), uc.unsubscribeKey)
+}
+
+// GetMailingListModeration returns the emails waiting for moderation on behalf of one of the list owners.
+func (uc *EmailUseCase) GetMailingListModeration(id uint32, login string, ctx context.Context) ([]*domain.Email, error) {
+	if _, err := uc.checkMailingListOwner(id, login, ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetMailingListModeration(id, ctx)
+}
+
+// ModerateMailingListEmail approves or rejects an email waiting for moderation.
+// An approved email is delivered to every member of the list.
+func (uc *EmailUseCase) ModerateMailingListEmail(id uint32, emailID uint64, login string, approve bool, ctx context.Context) (bool, error) {
+	if _, err := uc.checkMailingListOwner(id, login, ctx); err != nil {
+		return false, err
+	}
+
+	ok, err := uc.repo.DeleteMailingListModeration(id, emailID, ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if approve {
+		if err = uc.repo.AddProfileEmailList(emailID, "", id, ctx); err != nil {
+			return false, err
+		}
+		uc.publishListMail(emailID, "", id, ctx)
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockRepository "mail/internal/microservice/email/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestCheckRecipientEmail_MailingList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	recipient := "team@mailhub.su"
+	sender := "test_sender@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("MemberCanPost", func(t *testing.T) {
+		list := &domain.MailingList{ID: 1, Address: recipient, PostPolicy: domain.PostPolicyMembers}
+		mockRepo.EXPECT().FindEmail(recipient, ctx).Return(errors.New("user not found"))
+		mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(list, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return(domain.ListRoleMember, nil)
+
+		err := useCase.CheckRecipientEmail(recipient, sender, ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("NonMemberCannotPost", func(t *testing.T) {
+		list := &domain.MailingList{ID: 1, Address: recipient, PostPolicy: domain.PostPolicyMembers}
+		mockRepo.EXPECT().FindEmail(recipient, ctx).Return(errors.New("user not found"))
+		mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(list, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return("", nil)
+
+		err := useCase.CheckRecipientEmail(recipient, sender, ctx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("MemberCannotPostToOwnersOnlyList", func(t *testing.T) {
+		list := &domain.MailingList{ID: 1, Address: recipient, PostPolicy: domain.PostPolicyOwners}
+		mockRepo.EXPECT().FindEmail(recipient, ctx).Return(errors.New("user not found"))
+		mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(list, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return(domain.ListRoleMember, nil)
+
+		err := useCase.CheckRecipientEmail(recipient, sender, ctx)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateProfileEmail_MailingList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	emailId := uint64(1)
+	recipient := "team@mailhub.su"
+	sender := "test_sender@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("ExpandsToMembers", func(t *testing.T) {
+		list := &domain.MailingList{ID: 2, Address: recipient, PostPolicy: domain.PostPolicyAnyone}
+		mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(list, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return(domain.ListRoleMember, nil)
+		mockRepo.EXPECT().AddProfileEmailList(emailId, sender, list.ID, ctx).Return(nil)
+
+		err := useCase.CreateProfileEmail(emailId, sender, recipient, ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("HeldForModeration", func(t *testing.T) {
+		list := &domain.MailingList{ID: 2, Address: recipient, PostPolicy: domain.PostPolicyAnyone, Moderated: true}
+		mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(list, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return(domain.ListRoleMember, nil)
+		mockRepo.EXPECT().AddProfileEmailMyself(emailId, sender, ctx).Return(nil)
+		mockRepo.EXPECT().AddMailingListModeration(list.ID, emailId, ctx).Return(nil)
+
+		err := useCase.CreateProfileEmail(emailId, sender, recipient, ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("OwnerSkipsModeration", func(t *testing.T) {
+		list := &domain.MailingList{ID: 2, Address: recipient, PostPolicy: domain.PostPolicyAnyone, Moderated: true}
+		mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(list, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return(domain.ListRoleOwner, nil)
+		mockRepo.EXPECT().AddProfileEmailList(emailId, sender, list.ID, ctx).Return(nil)
+
+		err := useCase.CreateProfileEmail(emailId, sender, recipient, ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("NonMemberRejected", func(t *testing.T) {
+		list := &domain.MailingList{ID: 2, Address: recipient, PostPolicy: domain.PostPolicyMembers}
+		mockRepo.EXPECT().GetMailingListByAddress(recipient, ctx).Return(list, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(list.ID, sender, ctx).Return("", nil)
+		mockRepo.EXPECT().AddProfileEmailList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := useCase.CreateProfileEmail(emailId, sender, recipient, ctx)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateMailingList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	owner := "owner@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		list := &domain.MailingList{Address: "team@mailhub.su", Name: "Team"}
+		created := &domain.MailingList{ID: 1, Address: "team@mailhub.su", Name: "Team", PostPolicy: domain.PostPolicyMembers}
+		mockRepo.EXPECT().FindEmail(list.Address, ctx).Return(errors.New("user not found"))
+		mockRepo.EXPECT().CreateMailingList(list, owner, ctx).Return(created, nil)
+
+		res, err := useCase.CreateMailingList(list, owner, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, res)
+		assert.Equal(t, domain.PostPolicyMembers, list.PostPolicy)
+	})
+
+	t.Run("AddressTakenByUser", func(t *testing.T) {
+		list := &domain.MailingList{Address: "ivan@mailhub.su", Name: "Team"}
+		mockRepo.EXPECT().FindEmail(list.Address, ctx).Return(nil)
+
+		res, err := useCase.CreateMailingList(list, owner, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("InvalidPostPolicy", func(t *testing.T) {
+		list := &domain.MailingList{Address: "team@mailhub.su", Name: "Team", PostPolicy: "nobody"}
+
+		res, err := useCase.CreateMailingList(list, owner, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("InvalidAddress", func(t *testing.T) {
+		list := &domain.MailingList{Address: "team@gmail.com", Name: "Team"}
+
+		res, err := useCase.CreateMailingList(list, owner, ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestAddMailingListMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	listID := uint32(1)
+	owner := "owner@mailhub.su"
+	member := "member@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetMailingListByID(listID, ctx).Return(&domain.MailingList{ID: listID}, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(listID, owner, ctx).Return(domain.ListRoleOwner, nil)
+		mockRepo.EXPECT().AddMailingListMember(listID, member, domain.ListRoleMember, ctx).Return(nil)
+
+		ok, err := useCase.AddMailingListMember(listID, owner, member, "", ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		mockRepo.EXPECT().GetMailingListByID(listID, ctx).Return(&domain.MailingList{ID: listID}, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(listID, owner, ctx).Return(domain.ListRoleMember, nil)
+
+		ok, err := useCase.AddMailingListMember(listID, owner, member, domain.ListRoleMember, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestDeleteMailingListMember_Unsubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	listID := uint32(1)
+	member := "member@mailhub.su"
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().DeleteMailingListMember(listID, member, ctx).Return(true, nil)
+
+	ok, err := useCase.DeleteMailingListMember(listID, member, member, ctx)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestUnsubscribeMailingList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, []byte("unsubscribe-key"))
+
+	list := &domain.MailingList{ID: 3, Address: "team@mailhub.su", Name: "Team"}
+	member := "member@mailhub.su"
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetByID(uint64(5), member, ctx).
+		Return(&domain.Email{ID: 5, SenderEmail: member, RecipientEmail: list.Address}, nil)
+	mockRepo.EXPECT().GetMailingListByAddress(list.Address, ctx).Return(list, nil)
+
+	email, err := useCase.GetEmailByID(5, member, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, list.ListIDHeader(), email.ListID)
+
+	prefix := "<https://mailhub.su/api/v1/list/unsubscribe/"
+	assert.True(t, strings.HasPrefix(email.ListUnsubscribe, prefix))
+	token := strings.TrimSuffix(strings.TrimPrefix(email.ListUnsubscribe, prefix), ">")
+
+	mockRepo.EXPECT().DeleteMailingListMember(list.ID, member, ctx).Return(true, nil)
+	ok, err := useCase.UnsubscribeMailingList(token, ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// A token signed with another key, or altered, unsubscribes nobody.
+	_, err = NewEmailUseCase(mockRepo, nil, []byte("other-key")).UnsubscribeMailingList(token, ctx)
+	assert.Error(t, err)
+	_, err = useCase.UnsubscribeMailingList(token+"x", ctx)
+	assert.Error(t, err)
+}
+
+func TestModerateMailingListEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockRepository.NewMockEmailRepository(ctrl)
+	useCase := NewEmailUseCase(mockRepo, nil, nil)
+
+	listID := uint32(1)
+	emailID := uint64(10)
+	owner := "owner@mailhub.su"
+	ctx := GetCTX()
+
+	t.Run("Approve", func(t *testing.T) {
+		mockRepo.EXPECT().GetMailingListByID(listID, ctx).Return(&domain.MailingList{ID: listID}, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(listID, owner, ctx).Return(domain.ListRoleOwner, nil)
+		mockRepo.EXPECT().DeleteMailingListModeration(listID, emailID, ctx).Return(true, nil)
+		mockRepo.EXPECT().AddProfileEmailList(emailID, "", listID, ctx).Return(nil)
+
+		ok, err := useCase.ModerateMailingListEmail(listID, emailID, owner, true, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		mockRepo.EXPECT().GetMailingListByID(listID, ctx).Return(&domain.MailingList{ID: listID}, nil)
+		mockRepo.EXPECT().GetMailingListMemberRole(listID, owner, ctx).Return(domain.ListRoleOwner, nil)
+		mockRepo.EXPECT().DeleteMailingListModeration(listID, emailID, ctx).Return(true, nil)
+
+		ok, err := useCase.ModerateMailingListEmail(listID, emailID, owner, false, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,170 @@
+package bus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/jmoiron/sqlx"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+const (
+	// channel is the PostgreSQL notification channel the events are sent to.
+	channel = "mailbox_events"
+
+	// maxPayloadSize is the maximum size of the notification, PostgreSQL rejects payloads of 8000 bytes and more.
+	maxPayloadSize = 7900
+
+	// reconnectDelay is the time to wait before listening again after the connection is lost.
+	reconnectDelay = 5 * time.Second
+)
+
+// notification represents the payload of the PostgreSQL notification of an event.
+type notification struct {
+	Login string               `json:"login"`
+	Event *domain.MailboxEvent `json:"event"`
+}
+
+// PostgresBus represents a PostgreSQL LISTEN/NOTIFY implementation of the EventsBus interface.
+type PostgresBus struct {
+	DB  *sqlx.DB
+	DSN string
+}
+
+// NewPostgresBus creates a new instance of PostgresBus, the subscribers open their own connections with the DSN.
+func NewPostgresBus(db *sqlx.DB, dsn string) *PostgresBus {
+	return &PostgresBus{
+		DB:  db,
+		DSN: dsn,
+	}
+}
+
+// Publish numbers the event in the sequence of the user and sends it to all the subscribers.
+// The number and the notification are committed together, so the subscribers get the events of a user in order.
+func (b *PostgresBus) Publish(login string, event *domain.MailboxEvent, ctx context.Context) error {
+	tx, err := b.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO mailbox_event_seq (login, seq) VALUES ($1, 1)
+		ON CONFLICT (login) DO UPDATE SET seq = mailbox_event_seq.seq + 1
+		RETURNING seq
+	`
+	if err = tx.GetContext(ctx, &event.Seq, query, login); err != nil {
+		return fmt.Errorf("failed to number event: %v", err)
+	}
+	if event.Date.IsZero() {
+		event.Date = time.Now()
+	}
+
+	payload, err := encodeNotification(login, event)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", channel, payload); err != nil {
+		return fmt.Errorf("failed to notify event: %v", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// Subscribe calls deliver for every published event until the context is done.
+// The connection is opened again when it is lost, the events published meanwhile are not delivered,
+// so resync is called once the new connection listens and the subscriber has to resync its clients.
+func (b *PostgresBus) Subscribe(ctx context.Context, deliver func(login string, event *domain.MailboxEvent), resync func()) error {
+	listening := func() {}
+	for {
+		err := b.listen(ctx, deliver, listening)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("events bus: %v, listening again in %s", err, reconnectDelay)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(reconnectDelay):
+		}
+		listening = resync
+	}
+}
+
+// listen delivers the events received on a new connection until the connection is lost or the context is done,
+// listening is called once the connection listens for the events.
+func (b *PostgresBus) listen(ctx context.Context, deliver func(login string, event *domain.MailboxEvent), listening func()) error {
+	config, err := pgx.ParseConnectionString(b.DSN)
+	if err != nil {
+		return fmt.Errorf("failed to parse dsn: %v", err)
+	}
+
+	conn, err := pgx.Connect(config)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	if err = conn.Listen(channel); err != nil {
+		return fmt.Errorf("failed to listen: %v", err)
+	}
+	listening()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for notification: %v", err)
+		}
+
+		login, event, err := decodeNotification(n.Payload)
+		if err != nil {
+			log.Printf("events bus: %v", err)
+			continue
+		}
+		deliver(login, event)
+	}
+}
+
+// encodeNotification returns the payload of the notification of the event.
+// The email is left out of the events too large for a notification, the clients get it by its identifier.
+func encodeNotification(login string, event *domain.MailboxEvent) (string, error) {
+	payload, err := json.Marshal(&notification{Login: login, Event: event})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode event: %v", err)
+	}
+
+	if len(payload) > maxPayloadSize && event.Email != nil {
+		withoutEmail := *event
+		withoutEmail.Email = nil
+		return encodeNotification(login, &withoutEmail)
+	}
+	if len(payload) > maxPayloadSize {
+		return "", fmt.Errorf("event is too large: %d bytes", len(payload))
+	}
+
+	return string(payload), nil
+}
+
+// decodeNotification returns the user and the event of the notification.
+func decodeNotification(payload string) (string, *domain.MailboxEvent, error) {
+	var n notification
+	if err := json.Unmarshal([]byte(payload), &n); err != nil {
+		return "", nil, fmt.Errorf("failed to decode event: %v", err)
+	}
+	if n.Login == "" || n.Event == nil {
+		return "", nil, fmt.Errorf("invalid event: %s", payload)
+	}
+
+	return n.Login, n.Event, nil
+}
@@ -0,0 +1,83 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestPostgresBus_Publish(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	bus := NewPostgresBus(sqlx.NewDb(mockDB, "sqlmock"), "")
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO mailbox_event_seq`).WithArgs("bob@mailhub.su").
+			WillReturnRows(sqlmock.NewRows([]string{"seq"}).AddRow(5))
+		mock.ExpectExec(`SELECT pg_notify`).WithArgs(channel, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		event := &domain.MailboxEvent{Type: domain.MailboxEventDeleted, EmailID: 7}
+		assert.NoError(t, bus.Publish("bob@mailhub.su", event, ctx))
+		assert.Equal(t, uint64(5), event.Seq)
+		assert.False(t, event.Date.IsZero())
+	})
+
+	t.Run("NotifyError", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO mailbox_event_seq`).WithArgs("bob@mailhub.su").
+			WillReturnRows(sqlmock.NewRows([]string{"seq"}).AddRow(6))
+		mock.ExpectExec(`SELECT pg_notify`).WillReturnError(fmt.Errorf("db error"))
+		mock.ExpectRollback()
+
+		assert.Error(t, bus.Publish("bob@mailhub.su", &domain.MailboxEvent{Type: domain.MailboxEventDeleted}, ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEncodeNotification(t *testing.T) {
+	unread := int64(1)
+	event := &domain.MailboxEvent{Seq: 2, Type: domain.MailboxEventUnreadCount, Unread: &unread}
+
+	payload, err := encodeNotification("bob@mailhub.su", event)
+	assert.NoError(t, err)
+
+	login, decoded, err := decodeNotification(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob@mailhub.su", login)
+	assert.Equal(t, event.Seq, decoded.Seq)
+	assert.Equal(t, unread, *decoded.Unread)
+
+	large := &domain.MailboxEvent{
+		Seq:     3,
+		Type:    domain.MailboxEventNewMail,
+		EmailID: 7,
+		Email:   &domain.Email{ID: 7, Text: strings.Repeat("a", maxPayloadSize)},
+	}
+	payload, err = encodeNotification("bob@mailhub.su", large)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(payload), maxPayloadSize)
+
+	_, decoded, err = decodeNotification(payload)
+	assert.NoError(t, err)
+	assert.Nil(t, decoded.Email)
+	assert.Equal(t, uint64(7), decoded.EmailID)
+	assert.NotNil(t, large.Email)
+
+	_, _, err = decodeNotification(`{"login":""}`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source=./ievents_bus.go -destination=../mock/events_bus_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// EventsBus represents the interface for fanning the changes of the mailboxes out to every replica of the gateway.
+// The events are published by the services the changes are made in, after the changes are committed.
+type EventsBus interface {
+	// Publish numbers the event in the sequence of the user and sends it to all the subscribers.
+	Publish(login string, event *domain.MailboxEvent, ctx context.Context) error
+
+	// Subscribe calls deliver for every published event until the context is done,
+	// and resync when the events published meanwhile may have been missed.
+	Subscribe(ctx context.Context, deliver func(login string, event *domain.MailboxEvent), resync func()) error
+}
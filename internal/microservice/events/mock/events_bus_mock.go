@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./ievents_bus.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventsBus is a mock of EventsBus interface.
+type MockEventsBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsBusMockRecorder
+}
+
+// MockEventsBusMockRecorder is the mock recorder for MockEventsBus.
+type MockEventsBusMockRecorder struct {
+	mock *MockEventsBus
+}
+
+// NewMockEventsBus creates a new mock instance.
+func NewMockEventsBus(ctrl *gomock.Controller) *MockEventsBus {
+	mock := &MockEventsBus{ctrl: ctrl}
+	mock.recorder = &MockEventsBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsBus) EXPECT() *MockEventsBusMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventsBus) Publish(login string, event *domain_models.MailboxEvent, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", login, event, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventsBusMockRecorder) Publish(login, event, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventsBus)(nil).Publish), login, event, ctx)
+}
+
+// Subscribe mocks base method.
+func (m *MockEventsBus) Subscribe(ctx context.Context, deliver func(string, *domain_models.MailboxEvent), resync func()) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, deliver, resync)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventsBusMockRecorder) Subscribe(ctx, deliver, resync interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventsBus)(nil).Subscribe), ctx, deliver, resync)
+}
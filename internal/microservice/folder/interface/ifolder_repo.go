@@ -13,13 +13,13 @@ type FolderRepository interface {
 	// Create adds a new folder to the storage and returns its assigned unique identifier.
 	Create(folder *domain.Folder, ctx context.Context) (uint32, *domain.Folder, error)
 
-	// GetAll get list folder user.
+	// GetAll get list folder user with the full path of every folder and the number of total and unread emails in it.
 	GetAll(profileID uint32, offset, limit int64, ctx context.Context) ([]*domain.Folder, error)
 
 	// Delete delete folder as user.
 	Delete(folderID uint32, profileID uint32, ctx context.Context) (bool, error)
 
-	// Update folder as user.
+	// Update folder as user: rename it or move it to another parent folder.
 	Update(newUpFolder *domain.Folder, ctx context.Context) (bool, error)
 
 	// AddEmailFolder adds a new email in folder to the storage and returns its assigned unique identifier.
@@ -37,9 +37,24 @@ type FolderRepository interface {
 	// GetAllEmails get list emails folder user.
 	GetAllEmails(folderID, profileId, limit, offset uint32, ctx context.Context) ([]*domain.Email, error)
 
+	// GetLabelsByEmails returns the labels the profile has put on each of the emails.
+	GetLabelsByEmails(emailIDs []uint64, profileID uint32, ctx context.Context) (map[uint64][]*domain.Label, error)
+
 	// GetAvatarFileIDByLogin getting an avatar by login.
 	GetAvatarFileIDByLogin(login string, ctx context.Context) (string, error)
 
 	// GetAllFolderName retrieves the names of all folders associated with a given email ID.
 	GetAllFolderName(emailID uint32, ctx context.Context) ([]*domain.Folder, error)
+
+	// FindFolder returns the ID of the user folder with the specified name inside the parent folder, or 0 if there is none.
+	FindFolder(profileID, parentID uint32, name string, ctx context.Context) (uint32, error)
+
+	// CheckSubfolder checks whether the folder is the ancestor folder itself or is nested in it at any depth.
+	CheckSubfolder(folderID, ancestorID uint32, ctx context.Context) (bool, error)
+
+	// GetFolderLogin returns the login of the user the folder belongs to.
+	GetFolderLogin(folderID uint32, ctx context.Context) (string, error)
+
+	// MoveEmail atomically removes the email from the source folder and puts it in the target folder.
+	MoveEmail(emailID, fromFolderID, toFolderID uint32, ctx context.Context) (bool, error)
 }
@@ -39,4 +39,7 @@ type FolderUseCase interface {
 
 	// GetAllFolderName retrieves the names of all folders associated with a given email ID.
 	GetAllFolderName(emailID uint32, ctx context.Context) ([]*folderCore.Folder, error)
+
+	// MoveEmail moves the user email from one folder to another.
+	MoveEmail(emailID, fromFolderID, toFolderID, profileID uint32, ctx context.Context) (bool, error)
 }
@@ -216,6 +216,26 @@ func (mr *MockFolderServiceClientMockRecorder) GetAllNameFolders(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNameFolders", reflect.TypeOf((*MockFolderServiceClient)(nil).GetAllNameFolders), varargs...)
 }
 
+// MoveEmail mocks base method.
+func (m *MockFolderServiceClient) MoveEmail(ctx context.Context, in *proto.MoveEmailData, opts ...grpc.CallOption) (*proto.FolderEmailStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveEmail", varargs...)
+	ret0, _ := ret[0].(*proto.FolderEmailStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveEmail indicates an expected call of MoveEmail.
+func (mr *MockFolderServiceClientMockRecorder) MoveEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveEmail", reflect.TypeOf((*MockFolderServiceClient)(nil).MoveEmail), varargs...)
+}
+
 // UpdateFolder mocks base method.
 func (m *MockFolderServiceClient) UpdateFolder(ctx context.Context, in *proto.Folder, opts ...grpc.CallOption) (*proto.FolderStatus, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +414,21 @@ func (mr *MockFolderServiceServerMockRecorder) GetAllNameFolders(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNameFolders", reflect.TypeOf((*MockFolderServiceServer)(nil).GetAllNameFolders), arg0, arg1)
 }
 
+// MoveEmail mocks base method.
+func (m *MockFolderServiceServer) MoveEmail(arg0 context.Context, arg1 *proto.MoveEmailData) (*proto.FolderEmailStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.FolderEmailStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveEmail indicates an expected call of MoveEmail.
+func (mr *MockFolderServiceServerMockRecorder) MoveEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveEmail", reflect.TypeOf((*MockFolderServiceServer)(nil).MoveEmail), arg0, arg1)
+}
+
 // UpdateFolder mocks base method.
 func (m *MockFolderServiceServer) UpdateFolder(arg0 context.Context, arg1 *proto.Folder) (*proto.FolderStatus, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +80,21 @@ func (mr *MockFolderRepositoryMockRecorder) CheckFolder(folderID, profileID, ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckFolder", reflect.TypeOf((*MockFolderRepository)(nil).CheckFolder), folderID, profileID, ctx)
 }
 
+// CheckSubfolder mocks base method.
+func (m *MockFolderRepository) CheckSubfolder(folderID, ancestorID uint32, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSubfolder", folderID, ancestorID, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckSubfolder indicates an expected call of CheckSubfolder.
+func (mr *MockFolderRepositoryMockRecorder) CheckSubfolder(folderID, ancestorID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSubfolder", reflect.TypeOf((*MockFolderRepository)(nil).CheckSubfolder), folderID, ancestorID, ctx)
+}
+
 // Create mocks base method.
 func (m *MockFolderRepository) Create(folder *domain_models.Folder, ctx context.Context) (uint32, *domain_models.Folder, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +141,21 @@ func (mr *MockFolderRepositoryMockRecorder) DeleteEmailFolder(folderID, emailID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailFolder", reflect.TypeOf((*MockFolderRepository)(nil).DeleteEmailFolder), folderID, emailID, ctx)
 }
 
+// FindFolder mocks base method.
+func (m *MockFolderRepository) FindFolder(profileID, parentID uint32, name string, ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFolder", profileID, parentID, name, ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFolder indicates an expected call of FindFolder.
+func (mr *MockFolderRepositoryMockRecorder) FindFolder(profileID, parentID, name, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFolder", reflect.TypeOf((*MockFolderRepository)(nil).FindFolder), profileID, parentID, name, ctx)
+}
+
 // GetAll mocks base method.
 func (m *MockFolderRepository) GetAll(profileID uint32, offset, limit int64, ctx context.Context) ([]*domain_models.Folder, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +216,51 @@ func (mr *MockFolderRepositoryMockRecorder) GetAvatarFileIDByLogin(login, ctx in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvatarFileIDByLogin", reflect.TypeOf((*MockFolderRepository)(nil).GetAvatarFileIDByLogin), login, ctx)
 }
 
+// GetFolderLogin mocks base method.
+func (m *MockFolderRepository) GetFolderLogin(folderID uint32, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolderLogin", folderID, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolderLogin indicates an expected call of GetFolderLogin.
+func (mr *MockFolderRepositoryMockRecorder) GetFolderLogin(folderID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolderLogin", reflect.TypeOf((*MockFolderRepository)(nil).GetFolderLogin), folderID, ctx)
+}
+
+// GetLabelsByEmails mocks base method.
+func (m *MockFolderRepository) GetLabelsByEmails(emailIDs []uint64, profileID uint32, ctx context.Context) (map[uint64][]*domain_models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelsByEmails", emailIDs, profileID, ctx)
+	ret0, _ := ret[0].(map[uint64][]*domain_models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelsByEmails indicates an expected call of GetLabelsByEmails.
+func (mr *MockFolderRepositoryMockRecorder) GetLabelsByEmails(emailIDs, profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelsByEmails", reflect.TypeOf((*MockFolderRepository)(nil).GetLabelsByEmails), emailIDs, profileID, ctx)
+}
+
+// MoveEmail mocks base method.
+func (m *MockFolderRepository) MoveEmail(emailID, fromFolderID, toFolderID uint32, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveEmail", emailID, fromFolderID, toFolderID, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveEmail indicates an expected call of MoveEmail.
+func (mr *MockFolderRepositoryMockRecorder) MoveEmail(emailID, fromFolderID, toFolderID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveEmail", reflect.TypeOf((*MockFolderRepository)(nil).MoveEmail), emailID, fromFolderID, toFolderID, ctx)
+}
+
 // Update mocks base method.
 func (m *MockFolderRepository) Update(newUpFolder *domain_models.Folder, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +171,21 @@ func (mr *MockFolderUseCaseMockRecorder) GetAllFolders(profileID, offset, limit,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFolders", reflect.TypeOf((*MockFolderUseCase)(nil).GetAllFolders), profileID, offset, limit, ctx)
 }
 
+// MoveEmail mocks base method.
+func (m *MockFolderUseCase) MoveEmail(emailID, fromFolderID, toFolderID, profileID uint32, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveEmail", emailID, fromFolderID, toFolderID, profileID, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveEmail indicates an expected call of MoveEmail.
+func (mr *MockFolderUseCaseMockRecorder) MoveEmail(emailID, fromFolderID, toFolderID, profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveEmail", reflect.TypeOf((*MockFolderUseCase)(nil).MoveEmail), emailID, fromFolderID, toFolderID, profileID, ctx)
+}
+
 // UpdateFolder mocks base method.
 func (m *MockFolderUseCase) UpdateFolder(newUpFolder *domain_models.Folder, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ const (
 	FolderService_CheckFolderProfile_FullMethodName   = "/proto.FolderService/CheckFolderProfile"
 	FolderService_CheckEmailProfile_FullMethodName    = "/proto.FolderService/CheckEmailProfile"
 	FolderService_GetAllNameFolders_FullMethodName    = "/proto.FolderService/GetAllNameFolders"
+	FolderService_MoveEmail_FullMethodName            = "/proto.FolderService/MoveEmail"
 )
 
 // FolderServiceClient is the client API for FolderService service.
@@ -47,6 +48,7 @@ type FolderServiceClient interface {
 	CheckFolderProfile(ctx context.Context, in *FolderProfile, opts ...grpc.CallOption) (*FolderEmailStatus, error)
 	CheckEmailProfile(ctx context.Context, in *EmailProfile, opts ...grpc.CallOption) (*FolderEmailStatus, error)
 	GetAllNameFolders(ctx context.Context, in *GetAllNameFoldersRequest, opts ...grpc.CallOption) (*Folders, error)
+	MoveEmail(ctx context.Context, in *MoveEmailData, opts ...grpc.CallOption) (*FolderEmailStatus, error)
 }
 
 type folderServiceClient struct {
@@ -147,6 +149,15 @@ func (c *folderServiceClient) GetAllNameFolders(ctx context.Context, in *GetAllN
 	return out, nil
 }
 
+func (c *folderServiceClient) MoveEmail(ctx context.Context, in *MoveEmailData, opts ...grpc.CallOption) (*FolderEmailStatus, error) {
+	out := new(FolderEmailStatus)
+	err := c.cc.Invoke(ctx, FolderService_MoveEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FolderServiceServer is the server API for FolderService service.
 // All implementations must embed UnimplementedFolderServiceServer
 // for forward compatibility
@@ -161,6 +172,7 @@ type FolderServiceServer interface {
 	CheckFolderProfile(context.Context, *FolderProfile) (*FolderEmailStatus, error)
 	CheckEmailProfile(context.Context, *EmailProfile) (*FolderEmailStatus, error)
 	GetAllNameFolders(context.Context, *GetAllNameFoldersRequest) (*Folders, error)
+	MoveEmail(context.Context, *MoveEmailData) (*FolderEmailStatus, error)
 	mustEmbedUnimplementedFolderServiceServer()
 }
 
@@ -198,6 +210,9 @@ func (UnimplementedFolderServiceServer) CheckEmailProfile(context.Context, *Emai
 func (UnimplementedFolderServiceServer) GetAllNameFolders(context.Context, *GetAllNameFoldersRequest) (*Folders, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllNameFolders not implemented")
 }
+func (UnimplementedFolderServiceServer) MoveEmail(context.Context, *MoveEmailData) (*FolderEmailStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveEmail not implemented")
+}
 func (UnimplementedFolderServiceServer) mustEmbedUnimplementedFolderServiceServer() {}
 
 // UnsafeFolderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -391,6 +406,24 @@ func _FolderService_GetAllNameFolders_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FolderService_MoveEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveEmailData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderServiceServer).MoveEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FolderService_MoveEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderServiceServer).MoveEmail(ctx, req.(*MoveEmailData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FolderService_ServiceDesc is the grpc.ServiceDesc for FolderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -438,6 +471,10 @@ var FolderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllNameFolders",
 			Handler:    _FolderService_GetAllNameFolders_Handler,
 		},
+		{
+			MethodName: "MoveEmail",
+			Handler:    _FolderService_MoveEmail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "folder.proto",
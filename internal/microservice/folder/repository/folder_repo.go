@@ -29,8 +29,8 @@ func NewFolderRepository(db *sqlx.DB) *FolderRepository {
 // Create adds a new folder to the storage and returns its assigned unique identifier.
 func (r *FolderRepository) Create(folderModelCore *domain.Folder, ctx context.Context) (uint32, *domain.Folder, error) {
 	insertFolderQuery := `
-		INSERT INTO folder (profile_id, name)
-		VALUES ($1, $2)
+		INSERT INTO folder (profile_id, name, parent_id)
+		VALUES ($1, $2, $3)
 		RETURNING id
 	`
 
@@ -39,23 +39,39 @@ func (r *FolderRepository) Create(folderModelCore *domain.Folder, ctx context.Co
 	var id uint32
 
 	start := time.Now()
-	err := r.DB.QueryRow(insertFolderQuery, folderModelDb.ProfileId, folderModelDb.Name).Scan(&id)
+	err := r.DB.QueryRow(insertFolderQuery, folderModelDb.ProfileId, folderModelDb.Name, folderModelDb.ParentID).Scan(&id)
 	if err != nil {
 		return 0, &domain.Folder{}, fmt.Errorf("failed to add folder: %v", err)
 	}
 
-	args := []interface{}{folderModelDb.ProfileId, folderModelDb.Name}
+	args := []interface{}{folderModelDb.ProfileId, folderModelDb.Name, folderModelDb.ParentID}
 	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(insertFolderQuery, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
 
 	folderModelCore.ID = id
 	return id, folderModelCore, nil
 }
 
-// GetAll get list folder user.
+// GetAll get list folder user with the full path of every folder and the number of total and unread emails in it.
 func (r *FolderRepository) GetAll(profileID uint32, offset, limit int64, ctx context.Context) ([]*domain.Folder, error) {
 	query := `
-		SELECT folder.id, folder.name, folder.profile_id FROM folder
-		WHERE profile_id = $1
+		WITH RECURSIVE tree AS (
+			SELECT f.id, f.name::TEXT AS path
+			FROM folder f
+			WHERE f.profile_id = $1 AND f.parent_id IS NULL
+			UNION ALL
+			SELECT f.id, tree.path || '/' || f.name
+			FROM folder f
+			JOIN tree ON f.parent_id = tree.id
+		)
+		SELECT f.id, f.name, f.profile_id, f.parent_id, tree.path,
+			COUNT(e.id) AS total,
+			COUNT(e.id) FILTER (WHERE NOT e.isRead) AS unread
+		FROM folder f
+		JOIN tree ON tree.id = f.id
+		LEFT JOIN folder_email fe ON fe.folder_id = f.id
+		LEFT JOIN email e ON e.id = fe.email_id
+		GROUP BY f.id, tree.path
+		ORDER BY tree.path
 	`
 
 	var foldersModelDb []repository_models.Folder
@@ -120,22 +136,23 @@ func (r *FolderRepository) Delete(folderID uint32, profileID uint32, ctx context
 	return true, nil
 }
 
-// Update folder as user.
+// Update folder as user: rename it or move it to another parent folder.
 func (r *FolderRepository) Update(folderModelCore *domain.Folder, ctx context.Context) (bool, error) {
 	query := `
         UPDATE folder
         SET
-            name = $1
+            name = $1,
+            parent_id = $2
         WHERE
-            folder.id = $2 AND folder.profile_id = $3
+            folder.id = $3 AND folder.profile_id = $4
     `
 
 	newUdFolderDb := converters.FolderConvertCoreInDb(folderModelCore)
 
 	start := time.Now()
-	result, err := r.DB.Exec(query, newUdFolderDb.Name, newUdFolderDb.ID, newUdFolderDb.ProfileId)
+	result, err := r.DB.Exec(query, newUdFolderDb.Name, newUdFolderDb.ParentID, newUdFolderDb.ID, newUdFolderDb.ProfileId)
 
-	args := []interface{}{newUdFolderDb.Name, newUdFolderDb.ID, newUdFolderDb.ProfileId}
+	args := []interface{}{newUdFolderDb.Name, newUdFolderDb.ParentID, newUdFolderDb.ID, newUdFolderDb.ProfileId}
 	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
 
 	if err != nil {
@@ -204,12 +221,15 @@ func (r *FolderRepository) DeleteEmailFolder(folderID uint32, emailID uint32, ct
 	return true, nil
 }
 
-// CheckFolder checking that the folder belongs to the user.
+// CheckFolder checking that the folder belongs to the user
+// or to a shared mailbox the user is allowed to write to as a delegate.
 func (r *FolderRepository) CheckFolder(folderID uint32, profileID uint32, ctx context.Context) (bool, error) {
 	query := `
 		SELECT id, profile_id, name 
 		FROM folder 
-		WHERE folder.id = $1 AND folder.profile_id = $2
+		WHERE folder.id = $1 AND (folder.profile_id = $2 OR folder.profile_id IN (
+			SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = $2 AND role <> 'read'
+		))
 	`
 
 	folderModelDb := []repository_models.Folder{}
@@ -226,12 +246,15 @@ func (r *FolderRepository) CheckFolder(folderID uint32, profileID uint32, ctx co
 	return true, nil
 }
 
-// CheckEmail checking that the email belongs to the user.
+// CheckEmail checking that the email belongs to the user
+// or to a shared mailbox the user is allowed to write to as a delegate.
 func (r *FolderRepository) CheckEmail(emailID uint32, profileID uint32, ctx context.Context) (bool, error) {
 	query := `
 		SELECT profile_id, email_id 
 		FROM profile_email 
-		WHERE email_id = $1 AND profile_id = $2
+		WHERE email_id = $1 AND (profile_id = $2 OR profile_id IN (
+			SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = $2 AND role <> 'read'
+		))
 	`
 
 	folderProfileEmailModelDb := []repository_models.ProfileEmail{}
@@ -251,10 +274,12 @@ func (r *FolderRepository) CheckEmail(emailID uint32, profileID uint32, ctx cont
 // GetAllEmails get list emails folder user.
 func (r *FolderRepository) GetAllEmails(folderID, profileID, limit, offset uint32, ctx context.Context) ([]*domain.Email, error) {
 	query := `
-		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important
+		SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important, e.sent_by_email
 		FROM email e
 		JOIN profile_email pe ON e.id = pe.email_id
-		JOIN profile p ON pe.profile_id = $1 
+		JOIN profile p ON pe.profile_id = $1 OR pe.profile_id IN (
+			SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = $1 AND role <> 'read'
+		)
 		LEFT JOIN folder_email ON e.id = folder_email.email_id
 		WHERE folder_email.folder_id = $2
 		ORDER BY e.date_of_dispatch DESC
@@ -292,6 +317,42 @@ func (r *FolderRepository) GetAllEmails(folderID, profileID, limit, offset uint3
 	return emailsModelCore, nil
 }
 
+// GetLabelsByEmails returns the labels the profile has put on each of the emails.
+func (r *FolderRepository) GetLabelsByEmails(emailIDs []uint64, profileID uint32, ctx context.Context) (map[uint64][]*domain.Label, error) {
+	labels := make(map[uint64][]*domain.Label)
+	if len(emailIDs) == 0 {
+		return labels, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT el.email_id, l.id, l.profile_id, l.name, l.color
+		FROM email_label el
+		JOIN label l ON l.id = el.label_id
+		WHERE l.profile_id = ? AND el.email_id IN (?)
+		ORDER BY l.name
+	`, profileID, emailIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+	query = r.DB.Rebind(query)
+
+	var emailLabelsDb []repository_models.EmailLabel
+	start := time.Now()
+	err = r.DB.Select(&emailLabelsDb, query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels of emails: %v", err)
+	}
+
+	for _, el := range emailLabelsDb {
+		labels[el.EmailID] = append(labels[el.EmailID], converters.LabelConvertDbInCore(&el.Label))
+	}
+
+	return labels, nil
+}
+
 // GetAvatarFileIDByLogin getting an avatar by login.
 func (r *FolderRepository) GetAvatarFileIDByLogin(login string, ctx context.Context) (string, error) {
 	query := `
@@ -353,3 +414,111 @@ func (r *FolderRepository) GetAllFolderName(emailID uint32, ctx context.Context)
 
 	return foldersModelCore, nil
 }
+
+// FindFolder returns the ID of the user folder with the specified name inside the parent folder, or 0 if there is none.
+func (r *FolderRepository) FindFolder(profileID, parentID uint32, name string, ctx context.Context) (uint32, error) {
+	query := `
+		SELECT id
+		FROM folder
+		WHERE profile_id = $1 AND COALESCE(parent_id, 0) = $2 AND name = $3
+	`
+
+	var id uint32
+	start := time.Now()
+	err := r.DB.Get(&id, query, profileID, parentID, name)
+
+	args := []interface{}{profileID, parentID, name}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to find folder: %v", err)
+	}
+
+	return id, nil
+}
+
+// GetFolderLogin returns the login of the user the folder belongs to.
+func (r *FolderRepository) GetFolderLogin(folderID uint32, ctx context.Context) (string, error) {
+	query := `
+		SELECT p.login
+		FROM folder f
+		JOIN profile p ON p.id = f.profile_id
+		WHERE f.id = $1
+	`
+
+	var login string
+	start := time.Now()
+	err := r.DB.Get(&login, query, folderID)
+
+	args := []interface{}{folderID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get folder login: %v", err)
+	}
+
+	return login, nil
+}
+
+// CheckSubfolder checks whether the folder is the ancestor folder itself or is nested in it at any depth.
+func (r *FolderRepository) CheckSubfolder(folderID, ancestorID uint32, ctx context.Context) (bool, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM folder WHERE id = $1
+			UNION ALL
+			SELECT f.id FROM folder f JOIN subtree ON f.parent_id = subtree.id
+		)
+		SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)
+	`
+
+	var exists bool
+	start := time.Now()
+	err := r.DB.Get(&exists, query, ancestorID, folderID)
+
+	args := []interface{}{ancestorID, folderID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check subfolder: %v", err)
+	}
+
+	return exists, nil
+}
+
+// MoveEmail atomically removes the email from the source folder and puts it in the target folder.
+func (r *FolderRepository) MoveEmail(emailID, fromFolderID, toFolderID uint32, ctx context.Context) (bool, error) {
+	query := `
+		WITH moved AS (
+			DELETE FROM folder_email
+			WHERE folder_id = $1 AND email_id = $2
+			RETURNING email_id
+		), inserted AS (
+			INSERT INTO folder_email (folder_id, email_id)
+			SELECT $3, email_id FROM moved
+			ON CONFLICT DO NOTHING
+		)
+		SELECT COUNT(*) FROM moved
+	`
+
+	var moved int
+	start := time.Now()
+	err := r.DB.QueryRow(query, fromFolderID, emailID, toFolderID).Scan(&moved)
+
+	args := []interface{}{fromFolderID, emailID, toFolderID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to move email: %v", err)
+	}
+
+	if moved == 0 {
+		err = fmt.Errorf("folderID=%d and emailID=%d not found", fromFolderID, emailID)
+		return false, err
+	}
+
+	return true, nil
+}
@@ -67,11 +67,11 @@ func TestCreate(t *testing.T) {
 	t.Run("CreateSuccessfully", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 		mock.ExpectQuery(`
-			INSERT INTO folder \(profile_id, name\)
-			VALUES \(\$1, \$2\)
+			INSERT INTO folder \(profile_id, name, parent_id\)
+			VALUES \(\$1, \$2, \$3\)
 			RETURNING id
 		`).
-			WithArgs(folder.ProfileId, folder.Name).
+			WithArgs(folder.ProfileId, folder.Name, nil).
 			WillReturnRows(rows)
 
 		id, folderRes, err := repo.Create(folder, ctx)
@@ -82,11 +82,11 @@ func TestCreate(t *testing.T) {
 
 	t.Run("CreateFail", func(t *testing.T) {
 		mock.ExpectQuery(`
-			INSERT INTO folder \(profile_id, name\)
-			VALUES \(\$1, \$2\)
+			INSERT INTO folder \(profile_id, name, parent_id\)
+			VALUES \(\$1, \$2, \$3\)
 			RETURNING id
 		`).
-			WithArgs(folder.ProfileId, folder.Name).
+			WithArgs(folder.ProfileId, folder.Name, nil).
 			WillReturnError(fmt.Errorf("failed to insert folder"))
 
 		id, folderRes, err := repo.Create(folder, ctx)
@@ -117,21 +117,20 @@ func TestGetAll(t *testing.T) {
 	}
 
 	t.Run("GetAllSuccessfully", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "name", "profile_id"}).
-			AddRow(1, "Name 1", 1).
-			AddRow(2, "Name 2", 2).
-			AddRow(3, "Name 3", 3)
+		rows := sqlmock.NewRows([]string{"id", "name", "profile_id", "parent_id", "path", "total", "unread"}).
+			AddRow(1, "Name 1", 1, nil, "Name 1", 5, 2).
+			AddRow(2, "Name 2", 1, 1, "Name 1/Name 2", 3, 0).
+			AddRow(3, "Name 3", 1, nil, "Name 3", 0, 0)
 
 		expectedFolders := []*domain.Folder{
-			{ID: 1, Name: "Name 1", ProfileId: 1},
-			{ID: 2, Name: "Name 2", ProfileId: 2},
-			{ID: 3, Name: "Name 3", ProfileId: 3},
+			{ID: 1, Name: "Name 1", ProfileId: 1, Path: "Name 1", Total: 5, Unread: 2},
+			{ID: 2, Name: "Name 2", ProfileId: 1, ParentID: 1, Path: "Name 1/Name 2", Total: 3},
+			{ID: 3, Name: "Name 3", ProfileId: 1, Path: "Name 3"},
 		}
 
-		mock.ExpectQuery(`
-			SELECT folder.id, folder.name, folder.profile_id FROM folder
-			WHERE profile_id = \$1
-		`).WillReturnRows(rows)
+		mock.ExpectQuery(`SELECT f.id, f.name, f.profile_id, f.parent_id, tree.path, COUNT\(e.id\) AS total, ` +
+			`COUNT\(e.id\) FILTER \(WHERE NOT e.isRead\) AS unread FROM folder f JOIN tree ON tree.id = f.id`).
+			WithArgs(folder.ProfileId).WillReturnRows(rows)
 
 		folders, err := repo.GetAll(folder.ProfileId, 0, 0, ctx)
 		assert.NoError(t, err)
@@ -139,20 +138,17 @@ func TestGetAll(t *testing.T) {
 	})
 
 	t.Run("WithOffsetAndLimit", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "name", "profile_id"}).
-			AddRow(2, "Name 2", 2).
-			AddRow(3, "Name 3", 3)
+		rows := sqlmock.NewRows([]string{"id", "name", "profile_id", "parent_id", "path", "total", "unread"}).
+			AddRow(2, "Name 2", 1, 1, "Name 1/Name 2", 3, 0).
+			AddRow(3, "Name 3", 1, nil, "Name 3", 0, 0)
 
 		expectedFolders := []*domain.Folder{
-			{ID: 2, Name: "Name 2", ProfileId: 2},
-			{ID: 3, Name: "Name 3", ProfileId: 3},
+			{ID: 2, Name: "Name 2", ProfileId: 1, ParentID: 1, Path: "Name 1/Name 2", Total: 3},
+			{ID: 3, Name: "Name 3", ProfileId: 1, Path: "Name 3"},
 		}
 
-		mock.ExpectQuery(`
-			SELECT folder.id, folder.name, folder.profile_id FROM folder
-			WHERE profile_id = \$1
-			OFFSET \$2 LIMIT \$3
-		`).WillReturnRows(rows)
+		mock.ExpectQuery(`GROUP BY f.id, tree.path ORDER BY tree.path OFFSET \$2 LIMIT \$3`).
+			WithArgs(folder.ProfileId, 1, 2).WillReturnRows(rows)
 
 		folders, err := repo.GetAll(folder.ProfileId, 1, 2, ctx)
 		assert.NoError(t, err)
@@ -160,11 +156,8 @@ func TestGetAll(t *testing.T) {
 	})
 
 	t.Run("Error", func(t *testing.T) {
-		mock.ExpectQuery(`
-			SELECT folder.id, folder.name, folder.profile_id FROM folder
-			WHERE profile_id = \$1
-			OFFSET \$2 LIMIT \$3
-		`).WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery(`GROUP BY f.id, tree.path ORDER BY tree.path OFFSET \$2 LIMIT \$3`).
+			WillReturnError(sql.ErrNoRows)
 
 		folders, err := repo.GetAll(folder.ProfileId, 1, 2, ctx)
 		assert.Error(t, err)
@@ -252,10 +245,11 @@ func TestUpdate(t *testing.T) {
 		mock.ExpectExec(`
 			UPDATE folder
 			SET
-				name = \$1
+				name = \$1,
+				parent_id = \$2
 			WHERE
-				folder.id = \$2 AND folder.profile_id = \$3
-		`).WithArgs(folder.Name, folder.ID, folder.ProfileId).WillReturnResult(sqlmock.NewResult(0, 1))
+				folder.id = \$3 AND folder.profile_id = \$4
+		`).WithArgs(folder.Name, nil, folder.ID, folder.ProfileId).WillReturnResult(sqlmock.NewResult(0, 1))
 
 		folderStatus, err := repo.Update(folder, ctx)
 		assert.NoError(t, err)
@@ -266,10 +260,11 @@ func TestUpdate(t *testing.T) {
 		mock.ExpectExec(`
 			UPDATE folder
 			SET
-				name = \$1
+				name = \$1,
+				parent_id = \$2
 			WHERE
-				folder.id = \$2 AND folder.profile_id = \$3
-		`).WithArgs(folder.Name, folder.ID, folder.ProfileId).WillReturnResult(sqlmock.NewResult(0, 0))
+				folder.id = \$3 AND folder.profile_id = \$4
+		`).WithArgs(folder.Name, nil, folder.ID, folder.ProfileId).WillReturnResult(sqlmock.NewResult(0, 0))
 
 		folderStatus, err := repo.Update(folder, ctx)
 		assert.Error(t, err)
@@ -280,10 +275,11 @@ func TestUpdate(t *testing.T) {
 		mock.ExpectExec(`
 			UPDATE folder
 			SET
-				name = \$1
+				name = \$1,
+				parent_id = \$2
 			WHERE
-				folder.id = \$2 AND folder.profile_id = \$3
-		`).WithArgs(folder.Name, folder.ID, folder.ProfileId).WillReturnError(fmt.Errorf("database error"))
+				folder.id = \$3 AND folder.profile_id = \$4
+		`).WithArgs(folder.Name, nil, folder.ID, folder.ProfileId).WillReturnError(fmt.Errorf("database error"))
 
 		folderStatus, err := repo.Update(folder, ctx)
 		assert.Error(t, err)
@@ -414,7 +410,9 @@ func TestCheckFolder(t *testing.T) {
 		mock.ExpectQuery(`
 			SELECT id, profile_id, name 
 			FROM folder 
-			WHERE folder.id = \$1 AND folder.profile_id = \$2
+			WHERE folder.id = \$1 AND \(folder.profile_id = \$2 OR folder.profile_id IN \(
+				SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = \$2 AND role <> 'read'
+			\)\)
 		`).WillReturnRows(rows)
 
 		status, err := repo.CheckFolder(folder.ID, folder.ProfileId, ctx)
@@ -464,7 +462,9 @@ func TestCheckEmail(t *testing.T) {
 		mock.ExpectQuery(`
 			SELECT profile_id, email_id 
 			FROM profile_email 
-			WHERE email_id = \$1 AND profile_id = \$2
+			WHERE email_id = \$1 AND \(profile_id = \$2 OR profile_id IN \(
+				SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = \$2 AND role <> 'read'
+			\)\)
 		`).WillReturnRows(rows)
 
 		status, err := repo.CheckEmail(email_id, profile_id, ctx)
@@ -478,7 +478,9 @@ func TestCheckEmail(t *testing.T) {
 		mock.ExpectQuery(`
 			SELECT profile_id, email_id 
 			FROM profile_email 
-			WHERE email_id = \$1 AND profile_id = \$2
+			WHERE email_id = \$1 AND \(profile_id = \$2 OR profile_id IN \(
+				SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = \$2 AND role <> 'read'
+			\)\)
 		`).WillReturnRows(rows)
 
 		status, err := repo.CheckFolder(email_id, profile_id, ctx)
@@ -521,10 +523,12 @@ func TestGetAllEmails(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3")
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
-			JOIN profile p ON pe.profile_id = \$1 
+			JOIN profile p ON pe.profile_id = \$1 OR pe.profile_id IN \(
+				SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = \$1 AND role <> 'read'
+			\)
 			LEFT JOIN folder_email ON e.id = folder_email.email_id
 			WHERE folder_email.folder_id = \$2
 			ORDER BY e.date_of_dispatch DESC
@@ -546,10 +550,12 @@ func TestGetAllEmails(t *testing.T) {
 			AddRow(3, "Topic 3", "Text 3")
 
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
-			JOIN profile p ON pe.profile_id = \$1 
+			JOIN profile p ON pe.profile_id = \$1 OR pe.profile_id IN \(
+				SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = \$1 AND role <> 'read'
+			\)
 			LEFT JOIN folder_email ON e.id = folder_email.email_id
 			WHERE folder_email.folder_id = \$2
 			ORDER BY e.date_of_dispatch DESC
@@ -563,10 +569,12 @@ func TestGetAllEmails(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		mock.ExpectQuery(`
-			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important
+			SELECT DISTINCT e.id, e.topic, e.text, e.date_of_dispatch, e.sender_email, e.recipient_email, e.isRead, e.isDeleted, e.isDraft, e.reply_to_email_id, e.is_important, e.sent_by_email
 			FROM email e
 			JOIN profile_email pe ON e.id = pe.email_id
-			JOIN profile p ON pe.profile_id = \$1 
+			JOIN profile p ON pe.profile_id = \$1 OR pe.profile_id IN \(
+				SELECT mailbox_id FROM mailbox_delegate WHERE delegate_id = \$1 AND role <> 'read'
+			\)
 			LEFT JOIN folder_email ON e.id = folder_email.email_id
 			WHERE folder_email.folder_id = \$2
 			ORDER BY e.date_of_dispatch DESC
@@ -708,3 +716,195 @@ func TestGetAllFolderName(t *testing.T) {
 		assert.Nil(t, folders)
 	})
 }
+
+func TestFindFolder(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := FolderRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+	query := `SELECT id FROM folder WHERE profile_id = \$1 AND COALESCE\(parent_id, 0\) = \$2 AND name = \$3`
+
+	t.Run("Found", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 2, "Reports").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+
+		id, err := repo.FindFolder(1, 2, "Reports", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(5), id)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 0, "Reports").WillReturnError(sql.ErrNoRows)
+
+		id, err := repo.FindFolder(1, 0, "Reports", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(0), id)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 0, "Reports").WillReturnError(fmt.Errorf("database error"))
+
+		id, err := repo.FindFolder(1, 0, "Reports", ctx)
+		assert.Error(t, err)
+		assert.Equal(t, uint32(0), id)
+	})
+}
+
+func TestGetFolderLogin(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := FolderRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+	query := `SELECT p.login FROM folder f JOIN profile p ON p.id = f.profile_id WHERE f.id = \$1`
+
+	t.Run("Found", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(5).
+			WillReturnRows(sqlmock.NewRows([]string{"login"}).AddRow("test@mailhub.su"))
+
+		login, err := repo.GetFolderLogin(5, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "test@mailhub.su", login)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(5).WillReturnError(sql.ErrNoRows)
+
+		login, err := repo.GetFolderLogin(5, ctx)
+		assert.Error(t, err)
+		assert.Equal(t, "", login)
+	})
+}
+
+func TestCheckSubfolder(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := FolderRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+	query := `SELECT EXISTS \(SELECT 1 FROM subtree WHERE id = \$2\)`
+
+	t.Run("Subfolder", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		ok, err := repo.CheckSubfolder(3, 1, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("NotSubfolder", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 4).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		ok, err := repo.CheckSubfolder(4, 1, ctx)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 4).WillReturnError(fmt.Errorf("database error"))
+
+		ok, err := repo.CheckSubfolder(4, 1, ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestMoveEmail(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := FolderRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+	query := `WITH moved AS \( DELETE FROM folder_email WHERE folder_id = \$1 AND email_id = \$2 RETURNING email_id \)`
+
+	t.Run("MoveSuccessfully", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 10, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		ok, err := repo.MoveEmail(10, 1, 2, ctx)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("EmailNotInFolder", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 10, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		ok, err := repo.MoveEmail(10, 1, 2, ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 10, 2).WillReturnError(fmt.Errorf("database error"))
+
+		ok, err := repo.MoveEmail(10, 1, 2, ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestGetLabelsByEmails(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := FolderRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"email_id", "id", "profile_id", "name", "color"}).
+			AddRow(1, 1, 2, "Work", "#ff0000").
+			AddRow(2, 1, 2, "Work", "#ff0000")
+		mock.ExpectQuery(`SELECT el.email_id, l.id, l.profile_id, l.name, l.color`).
+			WithArgs(uint32(2), uint64(1), uint64(2)).
+			WillReturnRows(rows)
+
+		labels, err := repo.GetLabelsByEmails([]uint64{1, 2}, 2, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Label{{ID: 1, ProfileID: 2, Name: "Work", Color: "#ff0000"}}, labels[1])
+		assert.Len(t, labels[2], 1)
+	})
+
+	t.Run("DbError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT el.email_id, l.id, l.profile_id, l.name, l.color`).
+			WithArgs(uint32(2), uint64(1)).
+			WillReturnError(fmt.Errorf("db error"))
+
+		labels, err := repo.GetLabelsByEmails([]uint64{1}, 2, ctx)
+		assert.Error(t, err)
+		assert.Nil(t, labels)
+	})
+}
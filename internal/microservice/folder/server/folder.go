@@ -226,3 +226,22 @@ func (es *FolderServer) GetAllNameFolders(ctx context.Context, input *proto.GetA
 	folderProto.Folders = foldersProto
 	return folderProto, nil
 }
+
+func (es *FolderServer) MoveEmail(ctx context.Context, input *proto.MoveEmailData) (*proto.FolderEmailStatus, error) {
+	if input == nil {
+		return nil, fmt.Errorf("invalid folder format: %s", input)
+	}
+
+	if input.EmailID <= 0 || input.FromFolderID <= 0 || input.ToFolderID <= 0 || input.ProfileID <= 0 {
+		return nil, fmt.Errorf("invalid EmailID = %s or FromFolderID = %s or ToFolderID = %s or ProfileID = %s", strconv.Itoa(int(input.EmailID)), strconv.Itoa(int(input.FromFolderID)), strconv.Itoa(int(input.ToFolderID)), strconv.Itoa(int(input.ProfileID)))
+	}
+
+	status, err := es.FolderUseCase.MoveEmail(input.EmailID, input.FromFolderID, input.ToFolderID, input.ProfileID, ctx)
+	if err != nil || !status {
+		return nil, fmt.Errorf("folder or email not found")
+	}
+
+	folderStatus := new(proto.FolderEmailStatus)
+	folderStatus.Status = status
+	return folderStatus, nil
+}
@@ -478,3 +478,47 @@ func TestGetAllFolder(t *testing.T) {
 		assert.Nil(t, foldersProto)
 	})
 }
+
+func TestMoveEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFolderUseCase := mock.NewMockFolderUseCase(ctrl)
+
+	server := NewFolderServer(mockFolderUseCase)
+
+	ctx := GetCTX()
+
+	data := &proto.MoveEmailData{EmailID: 1, FromFolderID: 2, ToFolderID: 3, ProfileID: 4}
+
+	t.Run("MoveEmailSuccessfully", func(t *testing.T) {
+		mockFolderUseCase.EXPECT().MoveEmail(data.EmailID, data.FromFolderID, data.ToFolderID, data.ProfileID, ctx).Return(true, nil)
+
+		status, err := server.MoveEmail(ctx, data)
+
+		assert.NoError(t, err)
+		assert.True(t, status.Status)
+	})
+
+	t.Run("MoveEmail invalid folder format", func(t *testing.T) {
+		_, err := server.MoveEmail(ctx, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("MoveEmail invalid target folder", func(t *testing.T) {
+		_, err := server.MoveEmail(ctx, &proto.MoveEmailData{EmailID: 1, FromFolderID: 2, ProfileID: 4})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("MoveEmail failed", func(t *testing.T) {
+		mockFolderUseCase.EXPECT().MoveEmail(data.EmailID, data.FromFolderID, data.ToFolderID, data.ProfileID, ctx).Return(false, fmt.Errorf("folderID=2 and emailID=1 not found"))
+
+		status, err := server.MoveEmail(ctx, data)
+
+		assert.Error(t, err)
+		assert.Equal(t, fmt.Errorf("folder or email not found"), err)
+		assert.Nil(t, status)
+	})
+}
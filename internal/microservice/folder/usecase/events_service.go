@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// publish sends the event to the connected clients of the user the folder belongs to.
+// The change is already committed when it is published, so a failure is only logged.
+func (uc *FolderUseCase) publish(folderID uint32, event *domain.MailboxEvent, ctx context.Context) {
+	if uc.events == nil {
+		return
+	}
+
+	login, err := uc.repo.GetFolderLogin(folderID, ctx)
+	if err != nil {
+		log.Printf("failed to publish %s event of folder %d: %v", event.Type, folderID, err)
+		return
+	}
+
+	if err = uc.events.Publish(login, event, ctx); err != nil {
+		log.Printf("failed to publish %s event to %s: %v", event.Type, login, err)
+	}
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockEvents "mail/internal/microservice/events/mock"
+	mockRepository "mail/internal/microservice/folder/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func newEventsUseCase(t *testing.T) (*FolderUseCase, *mockRepository.MockFolderRepository, *mockEvents.MockEventsBus) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockRepository.NewMockFolderRepository(ctrl)
+	mockBus := mockEvents.NewMockEventsBus(ctrl)
+
+	return NewFolderUseCase(mockRepo, mockBus), mockRepo, mockBus
+}
+
+func TestCreateFolder_PublishesFolderCreated(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+
+	folder := &domain.Folder{Name: "Work", ProfileId: 2}
+	created := &domain.Folder{ID: 3, Name: "Work", ProfileId: 2}
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().FindFolder(uint32(2), uint32(0), "Work", ctx).Return(uint32(0), nil)
+	mockRepo.EXPECT().Create(folder, ctx).Return(uint32(3), created, nil)
+	mockRepo.EXPECT().GetFolderLogin(uint32(3), ctx).Return("test@mailhub.su", nil)
+	mockBus.EXPECT().Publish("test@mailhub.su", &domain.MailboxEvent{Type: domain.MailboxEventFolderCreated, FolderID: 3, FolderName: "Work"}, ctx).Return(nil)
+
+	id, _, err := useCase.CreateFolder(folder, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), id)
+}
+
+func TestMoveEmail_PublishesMoved(t *testing.T) {
+	useCase, mockRepo, mockBus := newEventsUseCase(t)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().CheckEmail(uint32(7), uint32(2), ctx).Return(true, nil)
+	mockRepo.EXPECT().CheckFolder(uint32(1), uint32(2), ctx).Return(true, nil)
+	mockRepo.EXPECT().CheckFolder(uint32(4), uint32(2), ctx).Return(true, nil)
+	mockRepo.EXPECT().MoveEmail(uint32(7), uint32(1), uint32(4), ctx).Return(true, nil)
+	mockRepo.EXPECT().GetFolderLogin(uint32(4), ctx).Return("test@mailhub.su", nil)
+	mockBus.EXPECT().Publish("test@mailhub.su", &domain.MailboxEvent{Type: domain.MailboxEventMoved, EmailID: 7, FolderID: 4}, ctx).Return(nil)
+
+	moved, err := useCase.MoveEmail(7, 1, 4, 2, ctx)
+	assert.NoError(t, err)
+	assert.True(t, moved)
+}
+
+func TestAddEmailInFolder_PublishFailureIgnored(t *testing.T) {
+	useCase, mockRepo, _ := newEventsUseCase(t)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().AddEmailFolder(uint32(4), uint32(7), ctx).Return(true, nil)
+	mockRepo.EXPECT().GetFolderLogin(uint32(4), ctx).Return("", errors.New("db error"))
+
+	added, err := useCase.AddEmailInFolder(4, 7, ctx)
+	assert.NoError(t, err)
+	assert.True(t, added)
+}
+
+func TestUpdateFolder_NotUpdatedNoEvent(t *testing.T) {
+	useCase, mockRepo, _ := newEventsUseCase(t)
+
+	folder := &domain.Folder{ID: 3, Name: "Work", ProfileId: 2}
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().FindFolder(uint32(2), uint32(0), "Work", ctx).Return(uint32(0), nil)
+	mockRepo.EXPECT().Update(folder, ctx).Return(false, nil)
+
+	updated, err := useCase.UpdateFolder(folder, ctx)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+}
@@ -2,27 +2,69 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
 	"mail/internal/pkg/utils/validators"
 
+	events "mail/internal/microservice/events/interface"
 	repository "mail/internal/microservice/folder/interface"
 	domain "mail/internal/microservice/models/domain_models"
 )
 
 // FolderUseCase represents the use case for working with folders.
 type FolderUseCase struct {
-	repo repository.FolderRepository
+	repo   repository.FolderRepository
+	events events.EventsBus
 }
 
-// NewFolderUseCase creates a new instance of FolderUseCase.
-func NewFolderUseCase(repo repository.FolderRepository) *FolderUseCase {
+// NewFolderUseCase creates a new instance of FolderUseCase, the changes of the folders are published to the events bus.
+func NewFolderUseCase(repo repository.FolderRepository, events events.EventsBus) *FolderUseCase {
 	return &FolderUseCase{
-		repo: repo,
+		repo:   repo,
+		events: events,
+	}
+}
+
+// checkFolderPath checks that the parent folder belongs to the user
+// and that no other folder with the same name exists inside it.
+func (uc *FolderUseCase) checkFolderPath(folder *domain.Folder, ctx context.Context) error {
+	if validators.IsEmpty(folder.Name) || strings.Contains(folder.Name, domain.FolderPathSeparator) {
+		return fmt.Errorf("invalid folder name: %s", folder.Name)
+	}
+
+	if folder.ParentID != 0 {
+		if _, err := uc.repo.CheckFolder(folder.ParentID, folder.ProfileId, ctx); err != nil {
+			return err
+		}
 	}
+
+	id, err := uc.repo.FindFolder(folder.ProfileId, folder.ParentID, folder.Name, ctx)
+	if err != nil {
+		return err
+	}
+
+	if id != 0 && id != folder.ID {
+		return fmt.Errorf("folder %s already exists", folder.Name)
+	}
+
+	return nil
 }
 
 // CreateFolder new folder.
 func (uc *FolderUseCase) CreateFolder(newFolder *domain.Folder, ctx context.Context) (uint32, *domain.Folder, error) {
-	return uc.repo.Create(newFolder, ctx)
+	if err := uc.checkFolderPath(newFolder, ctx); err != nil {
+		return 0, nil, err
+	}
+
+	id, folder, err := uc.repo.Create(newFolder, ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	uc.publish(id, &domain.MailboxEvent{Type: domain.MailboxEventFolderCreated, FolderID: id, FolderName: folder.Name}, ctx)
+
+	return id, folder, nil
 }
 
 // GetAllFolders list all folders.
@@ -35,14 +77,43 @@ func (uc *FolderUseCase) DeleteFolder(folderID uint32, profileID uint32, ctx con
 	return uc.repo.Delete(folderID, profileID, ctx)
 }
 
-// UpdateFolder update folder as user.
+// UpdateFolder update folder as user: rename it or move it to another parent folder.
 func (uc *FolderUseCase) UpdateFolder(newUpFolder *domain.Folder, ctx context.Context) (bool, error) {
-	return uc.repo.Update(newUpFolder, ctx)
+	if err := uc.checkFolderPath(newUpFolder, ctx); err != nil {
+		return false, err
+	}
+
+	if newUpFolder.ParentID != 0 {
+		nested, err := uc.repo.CheckSubfolder(newUpFolder.ParentID, newUpFolder.ID, ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if nested {
+			return false, fmt.Errorf("folder %d cannot be moved into itself", newUpFolder.ID)
+		}
+	}
+
+	updated, err := uc.repo.Update(newUpFolder, ctx)
+	if err != nil || !updated {
+		return updated, err
+	}
+
+	uc.publish(newUpFolder.ID, &domain.MailboxEvent{Type: domain.MailboxEventFolderRenamed, FolderID: newUpFolder.ID, FolderName: newUpFolder.Name}, ctx)
+
+	return true, nil
 }
 
 // AddEmailInFolder add email in folder.
 func (uc *FolderUseCase) AddEmailInFolder(folderID uint32, emailID uint32, ctx context.Context) (bool, error) {
-	return uc.repo.AddEmailFolder(folderID, emailID, ctx)
+	added, err := uc.repo.AddEmailFolder(folderID, emailID, ctx)
+	if err != nil || !added {
+		return added, err
+	}
+
+	uc.publish(folderID, &domain.MailboxEvent{Type: domain.MailboxEventMoved, EmailID: uint64(emailID), FolderID: folderID}, ctx)
+
+	return true, nil
 }
 
 // DeleteEmailInFolder delete email in folder.
@@ -50,6 +121,32 @@ func (uc *FolderUseCase) DeleteEmailInFolder(folderID uint32, emailID uint32, ct
 	return uc.repo.DeleteEmailFolder(folderID, emailID, ctx)
 }
 
+// MoveEmail moves the user email from one folder to another.
+func (uc *FolderUseCase) MoveEmail(emailID, fromFolderID, toFolderID, profileID uint32, ctx context.Context) (bool, error) {
+	if fromFolderID == toFolderID {
+		return false, fmt.Errorf("email %d is already in folder %d", emailID, toFolderID)
+	}
+
+	if _, err := uc.repo.CheckEmail(emailID, profileID, ctx); err != nil {
+		return false, err
+	}
+
+	for _, folderID := range []uint32{fromFolderID, toFolderID} {
+		if _, err := uc.repo.CheckFolder(folderID, profileID, ctx); err != nil {
+			return false, err
+		}
+	}
+
+	moved, err := uc.repo.MoveEmail(emailID, fromFolderID, toFolderID, ctx)
+	if err != nil || !moved {
+		return moved, err
+	}
+
+	uc.publish(toFolderID, &domain.MailboxEvent{Type: domain.MailboxEventMoved, EmailID: uint64(emailID), FolderID: toFolderID}, ctx)
+
+	return true, nil
+}
+
 // CheckFolderProfile checking that the folder belongs to the user.
 func (uc *FolderUseCase) CheckFolderProfile(folderID uint32, profileID uint32, ctx context.Context) (bool, error) {
 	return uc.repo.CheckFolder(folderID, profileID, ctx)
@@ -83,6 +180,20 @@ func (uc *FolderUseCase) GetAllEmailsInFolder(folderID, profileID, limit, offset
 		}
 	}
 
+	emailIDs := make([]uint64, 0, len(emails))
+	for _, email := range emails {
+		emailIDs = append(emailIDs, email.ID)
+	}
+
+	labels, err := uc.repo.GetLabelsByEmails(emailIDs, profileID, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, email := range emails {
+		email.Labels = labels[email.ID]
+	}
+
 	return emails, nil
 }
 
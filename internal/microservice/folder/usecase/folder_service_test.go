@@ -33,7 +33,7 @@ func TestNewFolderUseCase(t *testing.T) {
 		repo: mockRepo,
 	}
 
-	EmailUseCase := NewFolderUseCase(mockRepo)
+	EmailUseCase := NewFolderUseCase(mockRepo, nil)
 
 	assert.Equal(t, ExpextedFolderUseCase, *EmailUseCase)
 }
@@ -43,12 +43,13 @@ func TestCreateFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	expectedFolder := &domain.Folder{ID: 1, Name: "Test Folder", ProfileId: 1}
 	ctx := GetCTX()
 	id := uint32(1)
 
+	mockRepo.EXPECT().FindFolder(expectedFolder.ProfileId, uint32(0), expectedFolder.Name, ctx).Return(uint32(0), nil)
 	mockRepo.EXPECT().Create(expectedFolder, ctx).Return(id, expectedFolder, nil)
 
 	ID, folder, err := useCase.CreateFolder(expectedFolder, ctx)
@@ -58,12 +59,63 @@ func TestCreateFolder(t *testing.T) {
 	assert.Equal(t, id, ID)
 }
 
+func TestCreateNestedFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
+	useCase := NewFolderUseCase(mockRepo, nil)
+	ctx := GetCTX()
+
+	t.Run("CreateSuccessfully", func(t *testing.T) {
+		folder := &domain.Folder{Name: "Reports", ProfileId: 1, ParentID: 2}
+
+		mockRepo.EXPECT().CheckFolder(uint32(2), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().FindFolder(uint32(1), uint32(2), "Reports", ctx).Return(uint32(0), nil)
+		mockRepo.EXPECT().Create(folder, ctx).Return(uint32(3), folder, nil)
+
+		id, _, err := useCase.CreateFolder(folder, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(3), id)
+	})
+
+	t.Run("ForeignParent", func(t *testing.T) {
+		folder := &domain.Folder{Name: "Reports", ProfileId: 1, ParentID: 5}
+
+		mockRepo.EXPECT().CheckFolder(uint32(5), uint32(1), ctx).Return(false, fmt.Errorf("failed to check folder"))
+
+		_, _, err := useCase.CreateFolder(folder, ctx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("PathAlreadyExists", func(t *testing.T) {
+		folder := &domain.Folder{Name: "Reports", ProfileId: 1, ParentID: 2}
+
+		mockRepo.EXPECT().CheckFolder(uint32(2), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().FindFolder(uint32(1), uint32(2), "Reports", ctx).Return(uint32(4), nil)
+
+		_, _, err := useCase.CreateFolder(folder, ctx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		folder := &domain.Folder{Name: "Work/Reports", ProfileId: 1}
+
+		_, _, err := useCase.CreateFolder(folder, ctx)
+
+		assert.Error(t, err)
+	})
+}
+
 func TestGetAllFolders(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	expectedFolders := []*domain.Folder{
 		{ID: 1, Name: "Test Folder", ProfileId: 1},
@@ -86,7 +138,7 @@ func TestDeleteFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	folder_id := uint32(1)
@@ -105,11 +157,12 @@ func TestUpdateFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	newUpFolder := &domain.Folder{ID: 1, Name: "Test Folder", ProfileId: 1}
 
+	mockRepo.EXPECT().FindFolder(newUpFolder.ProfileId, uint32(0), newUpFolder.Name, ctx).Return(newUpFolder.ID, nil)
 	mockRepo.EXPECT().Update(newUpFolder, ctx).Return(true, nil)
 
 	status, err := useCase.UpdateFolder(newUpFolder, ctx)
@@ -118,12 +171,59 @@ func TestUpdateFolder(t *testing.T) {
 	assert.True(t, status)
 }
 
+func TestUpdateNestedFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
+	useCase := NewFolderUseCase(mockRepo, nil)
+	ctx := GetCTX()
+
+	t.Run("MoveSuccessfully", func(t *testing.T) {
+		folder := &domain.Folder{ID: 1, Name: "Reports", ProfileId: 1, ParentID: 2}
+
+		mockRepo.EXPECT().CheckFolder(uint32(2), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().FindFolder(uint32(1), uint32(2), "Reports", ctx).Return(uint32(0), nil)
+		mockRepo.EXPECT().CheckSubfolder(uint32(2), uint32(1), ctx).Return(false, nil)
+		mockRepo.EXPECT().Update(folder, ctx).Return(true, nil)
+
+		status, err := useCase.UpdateFolder(folder, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, status)
+	})
+
+	t.Run("MoveIntoSubfolder", func(t *testing.T) {
+		folder := &domain.Folder{ID: 1, Name: "Reports", ProfileId: 1, ParentID: 3}
+
+		mockRepo.EXPECT().CheckFolder(uint32(3), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().FindFolder(uint32(1), uint32(3), "Reports", ctx).Return(uint32(0), nil)
+		mockRepo.EXPECT().CheckSubfolder(uint32(3), uint32(1), ctx).Return(true, nil)
+
+		status, err := useCase.UpdateFolder(folder, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+
+	t.Run("RenameToExistingPath", func(t *testing.T) {
+		folder := &domain.Folder{ID: 1, Name: "Archive", ProfileId: 1}
+
+		mockRepo.EXPECT().FindFolder(uint32(1), uint32(0), "Archive", ctx).Return(uint32(7), nil)
+
+		status, err := useCase.UpdateFolder(folder, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+}
+
 func TestAddEmailInFolder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	folder_id := uint32(1)
@@ -142,7 +242,7 @@ func TestDeleteEmailInFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	folder_id := uint32(1)
@@ -161,7 +261,7 @@ func TestCheckFolderProfile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	folder_id := uint32(1)
@@ -180,7 +280,7 @@ func TestCheckEmailProfile(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	email_id := uint32(1)
@@ -199,7 +299,7 @@ func TestGetAllEmailsInFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	folder_id := uint32(1)
@@ -213,6 +313,7 @@ func TestGetAllEmailsInFolder(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().GetAllEmails(folder_id, profile_id, zero, zero, ctx).Return(expectedFolders, nil)
+	mockRepo.EXPECT().GetLabelsByEmails([]uint64{1, 2}, profile_id, ctx).Return(map[uint64][]*domain.Label{}, nil)
 
 	folders, err := useCase.GetAllEmailsInFolder(folder_id, profile_id, zero, zero, login, ctx)
 
@@ -225,7 +326,7 @@ func TestGetAllFolderName(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
-	useCase := NewFolderUseCase(mockRepo)
+	useCase := NewFolderUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 	emailID := uint32(123)
@@ -263,3 +364,42 @@ func TestGetAllFolderName(t *testing.T) {
 		assert.Nil(t, folders)
 	})
 }
+
+func TestMoveEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockFolderRepository(ctrl)
+	useCase := NewFolderUseCase(mockRepo, nil)
+	ctx := GetCTX()
+
+	t.Run("MoveSuccessfully", func(t *testing.T) {
+		mockRepo.EXPECT().CheckEmail(uint32(10), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().CheckFolder(uint32(2), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().CheckFolder(uint32(3), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().MoveEmail(uint32(10), uint32(2), uint32(3), ctx).Return(true, nil)
+
+		status, err := useCase.MoveEmail(10, 2, 3, 1, ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, status)
+	})
+
+	t.Run("SameFolder", func(t *testing.T) {
+		status, err := useCase.MoveEmail(10, 2, 2, 1, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+
+	t.Run("ForeignTargetFolder", func(t *testing.T) {
+		mockRepo.EXPECT().CheckEmail(uint32(10), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().CheckFolder(uint32(2), uint32(1), ctx).Return(true, nil)
+		mockRepo.EXPECT().CheckFolder(uint32(3), uint32(1), ctx).Return(false, fmt.Errorf("failed to check folder"))
+
+		status, err := useCase.MoveEmail(10, 2, 3, 1, ctx)
+
+		assert.Error(t, err)
+		assert.False(t, status)
+	})
+}
@@ -0,0 +1,67 @@
+package interceptors
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	auditInterface "mail/internal/microservice/audit/interface"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// AuditRule describes how the calls of a method are recorded in the security audit log.
+type AuditRule struct {
+	// Subject returns the account the call is made for, it is called before the call is handled
+	// for the methods whose request does not name the account, e.g. the owner of a session which is deleted by the call.
+	// It is optional.
+	Subject func(ctx context.Context, req interface{}) uint32
+
+	// Event returns the event recorded for the handled call, nil if the call is not recorded.
+	// The reply is a nil pointer if the call has failed.
+	Event func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent
+}
+
+// Auditor records the security relevant calls of a service in the audit log.
+type Auditor struct {
+	Repo  auditInterface.SecurityEventRepository
+	Rules map[string]AuditRule // Rules are the rules of the audited methods by full method name.
+}
+
+// AuditInterceptor records the calls of the audited methods after they are handled.
+// The IP address and the user agent of the client are taken from the incoming metadata.
+// It has to run after AccessLogInterceptor, a failure to record the event does not fail the call.
+func (a *Auditor) AuditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	rule, ok := a.Rules[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	var subject uint32
+	if rule.Subject != nil {
+		subject = rule.Subject(ctx, req)
+	}
+
+	reply, err := handler(ctx, req)
+
+	event := rule.Event(req, reply, err, subject)
+	if event == nil || (event.ProfileID == 0 && event.Login == "") {
+		return reply, err
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("ipAddress"); len(values) > 0 {
+			event.IPAddress = values[0]
+		}
+		if values := md.Get("userAgent"); len(values) > 0 {
+			event.UserAgent = values[0]
+		}
+	}
+
+	if errAdd := a.Repo.AddSecurityEvent(event, ctx); errAdd != nil {
+		log.Printf("failed to record security event %s: %v", event.Type, errAdd)
+	}
+
+	return reply, err
+}
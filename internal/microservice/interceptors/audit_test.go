@@ -0,0 +1,102 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	mockAudit "mail/internal/microservice/audit/mock"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+const auditTestMethod = "/proto.UserService/ChangePassword"
+
+// newAuditTestAuditor returns an auditor recording the calls of the test method for the subject.
+func newAuditTestAuditor(repo *mockAudit.MockSecurityEventRepository) *Auditor {
+	return &Auditor{
+		Repo: repo,
+		Rules: map[string]AuditRule{
+			auditTestMethod: {
+				Subject: func(ctx context.Context, req interface{}) uint32 {
+					return 2
+				},
+				Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+					if req == "skip" {
+						return nil
+					}
+					return &domain.SecurityEvent{ProfileID: subject, Type: domain.SecurityEventPasswordChange, Success: err == nil}
+				},
+			},
+		},
+	}
+}
+
+func TestAuditor_AuditInterceptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockAudit.NewMockSecurityEventRepository(ctrl)
+	auditor := newAuditTestAuditor(repo)
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.New(map[string]string{"ipAddress": "127.0.0.1", "userAgent": "Firefox"}))
+	info := &grpc.UnaryServerInfo{FullMethod: auditTestMethod}
+
+	t.Run("Success", func(t *testing.T) {
+		repo.EXPECT().AddSecurityEvent(&domain.SecurityEvent{
+			ProfileID: 2,
+			Type:      domain.SecurityEventPasswordChange,
+			Success:   true,
+			IPAddress: "127.0.0.1",
+			UserAgent: "Firefox",
+		}, gomock.Any()).Return(nil)
+
+		reply, err := auditor.AuditInterceptor(ctx, "req", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "reply", nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "reply", reply)
+	})
+
+	t.Run("HandlerError", func(t *testing.T) {
+		repo.EXPECT().AddSecurityEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(event *domain.SecurityEvent, ctx context.Context) error {
+				assert.False(t, event.Success)
+				return nil
+			})
+
+		_, err := auditor.AuditInterceptor(ctx, "req", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, fmt.Errorf("wrong password")
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("RecordError", func(t *testing.T) {
+		repo.EXPECT().AddSecurityEvent(gomock.Any(), gomock.Any()).Return(fmt.Errorf("db error"))
+
+		reply, err := auditor.AuditInterceptor(ctx, "req", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "reply", nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "reply", reply)
+	})
+
+	t.Run("NotRecorded", func(t *testing.T) {
+		_, err := auditor.AuditInterceptor(ctx, "skip", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "reply", nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotAudited", func(t *testing.T) {
+		_, err := auditor.AuditInterceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: "/proto.UserService/GetUser"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "reply", nil
+			})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,79 @@
+package domain_models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	// APITokenScopeMailRead allows reading emails, labels, mailing lists and folders.
+	APITokenScopeMailRead = "mail:read"
+	// APITokenScopeMailSend allows sending emails, saving drafts and attaching files.
+	APITokenScopeMailSend = "mail:send"
+	// APITokenScopeFoldersManage allows creating, changing and deleting folders and moving emails between them.
+	APITokenScopeFoldersManage = "folders:manage"
+)
+
+const (
+	// APITokenPrefix is the prefix of every personal access token, it makes leaked tokens easy to find.
+	APITokenPrefix = "mhp_"
+	// APITokenMaxLifeTimeDays is the longest time in days a personal access token can be valid.
+	APITokenMaxLifeTimeDays = 365
+	// APITokenMaxCount is the number of personal access tokens a user can have.
+	APITokenMaxCount = 20
+	// APITokenLastUsedPrecision is the time during which repeated uses of a token are not recorded again.
+	APITokenLastUsedPrecision = time.Minute
+)
+
+// APIToken represents a personal access token a user gives to scripts instead of signing in.
+// Only the hash of the token is stored, the token itself is shown to the user once when it is created.
+type APIToken struct {
+	ID             uint32    // ID is the unique identifier of the token.
+	TokenHash      string    // TokenHash is the SHA-256 hash of the token.
+	ProfileID      uint32    // ProfileID is the unique identifier of the user who owns the token.
+	Name           string    // Name is the description given by the user to tell tokens apart.
+	Scopes         []string  // Scopes are the actions allowed with the token.
+	CreationDate   time.Time // CreationDate is the date when the token was created.
+	ExpirationDate time.Time // ExpirationDate is the date after which the token is no longer valid.
+	LastUsedDate   time.Time // LastUsedDate is the date of the last request made with the token, zero if never used.
+}
+
+// IsValidAPITokenScope checks if the scope is one a personal access token can be given.
+func IsValidAPITokenScope(scope string) bool {
+	switch scope {
+	case APITokenScopeMailRead, APITokenScopeMailSend, APITokenScopeFoldersManage:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasScope checks if the token allows the actions of the scope.
+func (t *APIToken) HasScope(scope string) bool {
+	return APITokenScopesAllow(t.Scopes, scope)
+}
+
+// APITokenScopesAllow checks if the scopes of a token include the scope.
+func APITokenScopesAllow(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsAPIToken checks if the value looks like a personal access token rather than another kind of credential.
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, APITokenPrefix) && len(token) > len(APITokenPrefix)
+}
+
+// HashAPIToken returns the hash the personal access token is stored with.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
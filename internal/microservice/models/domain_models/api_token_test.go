@@ -0,0 +1,38 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidAPITokenScope(t *testing.T) {
+	assert.True(t, IsValidAPITokenScope(APITokenScopeMailRead))
+	assert.True(t, IsValidAPITokenScope(APITokenScopeMailSend))
+	assert.True(t, IsValidAPITokenScope(APITokenScopeFoldersManage))
+	assert.False(t, IsValidAPITokenScope("admin"))
+	assert.False(t, IsValidAPITokenScope(""))
+}
+
+func TestAPITokenHasScope(t *testing.T) {
+	token := APIToken{Scopes: []string{APITokenScopeMailRead, APITokenScopeFoldersManage}}
+
+	assert.True(t, token.HasScope(APITokenScopeMailRead))
+	assert.True(t, token.HasScope(APITokenScopeFoldersManage))
+	assert.False(t, token.HasScope(APITokenScopeMailSend))
+	assert.False(t, (&APIToken{}).HasScope(APITokenScopeMailRead))
+}
+
+func TestIsAPIToken(t *testing.T) {
+	assert.True(t, IsAPIToken(APITokenPrefix+"abc"))
+	assert.False(t, IsAPIToken(APITokenPrefix))
+	assert.False(t, IsAPIToken("abc"))
+}
+
+func TestHashAPIToken(t *testing.T) {
+	hash := HashAPIToken("token")
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashAPIToken("token"))
+	assert.NotEqual(t, hash, HashAPIToken("other"))
+}
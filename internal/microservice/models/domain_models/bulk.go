@@ -0,0 +1,99 @@
+package domain_models
+
+import "fmt"
+
+const (
+	// BulkActionRead marks the emails as read.
+	BulkActionRead = "read"
+	// BulkActionUnread marks the emails as unread.
+	BulkActionUnread = "unread"
+	// BulkActionFlag flags the emails as important.
+	BulkActionFlag = "flag"
+	// BulkActionUnflag removes the important flag from the emails.
+	BulkActionUnflag = "unflag"
+	// BulkActionSpam marks the emails as spam.
+	BulkActionSpam = "spam"
+	// BulkActionNotSpam removes the spam mark from the emails.
+	BulkActionNotSpam = "not_spam"
+	// BulkActionDelete deletes the emails from the mailbox of the user.
+	BulkActionDelete = "delete"
+	// BulkActionMove moves the emails from all folders of the user to the target folder.
+	BulkActionMove = "move"
+	// BulkActionLabel puts the label on the emails.
+	BulkActionLabel = "label"
+
+	// MaxBulkEmails is the maximum number of emails in one bulk operation.
+	MaxBulkEmails = 100
+)
+
+// BulkRequest represents an action applied to a batch of emails of the user.
+type BulkRequest struct {
+	Action   string   // Action is the operation applied to every email of the batch.
+	EmailIDs []uint64 // EmailIDs are the unique identifiers of the emails of the batch.
+	FolderID uint32   // FolderID is the target folder of the move action.
+	LabelID  uint32   // LabelID is the label put on the emails by the label action.
+}
+
+// BulkResult represents the outcome of a bulk operation for a single email.
+type BulkResult struct {
+	EmailID uint64 // EmailID is the unique identifier of the email.
+	Success bool   // Success indicates whether the action was applied to the email.
+	Error   string // Error describes why the action was not applied.
+}
+
+// Validate checks the action and its arguments and removes duplicate email IDs.
+func (r *BulkRequest) Validate() error {
+	switch r.Action {
+	case BulkActionRead, BulkActionUnread, BulkActionFlag, BulkActionUnflag,
+		BulkActionSpam, BulkActionNotSpam, BulkActionDelete:
+	case BulkActionMove:
+		if r.FolderID == 0 {
+			return fmt.Errorf("folder is required for action %s", r.Action)
+		}
+	case BulkActionLabel:
+		if r.LabelID == 0 {
+			return fmt.Errorf("label is required for action %s", r.Action)
+		}
+	default:
+		return fmt.Errorf("invalid bulk action: %s", r.Action)
+	}
+
+	seen := make(map[uint64]struct{}, len(r.EmailIDs))
+	uniqueIDs := make([]uint64, 0, len(r.EmailIDs))
+	for _, id := range r.EmailIDs {
+		if _, ok := seen[id]; !ok && id != 0 {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	if len(uniqueIDs) == 0 || len(uniqueIDs) > MaxBulkEmails {
+		return fmt.Errorf("number of emails must be from 1 to %d", MaxBulkEmails)
+	}
+
+	r.EmailIDs = uniqueIDs
+	return nil
+}
+
+// Event returns the mailbox event of the action applied to the email, nil for the actions without events.
+func (r *BulkRequest) Event(emailID uint64) *MailboxEvent {
+	switch r.Action {
+	case BulkActionRead, BulkActionUnread:
+		readStatus := r.Action == BulkActionRead
+		return &MailboxEvent{Type: MailboxEventReadStatus, EmailID: emailID, ReadStatus: &readStatus}
+	case BulkActionDelete:
+		return &MailboxEvent{Type: MailboxEventDeleted, EmailID: emailID}
+	case BulkActionMove:
+		return &MailboxEvent{Type: MailboxEventMoved, EmailID: emailID, FolderID: r.FolderID}
+	}
+	return nil
+}
+
+// ChangesUnreadCount reports whether the action can change the number of the unread incoming emails.
+func (r *BulkRequest) ChangesUnreadCount() bool {
+	switch r.Action {
+	case BulkActionRead, BulkActionUnread, BulkActionDelete, BulkActionSpam, BulkActionNotSpam:
+		return true
+	}
+	return false
+}
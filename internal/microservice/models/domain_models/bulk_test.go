@@ -0,0 +1,69 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkRequestValidate(t *testing.T) {
+	t.Run("RemovesDuplicates", func(t *testing.T) {
+		request := BulkRequest{Action: BulkActionRead, EmailIDs: []uint64{3, 1, 3, 0, 1}}
+
+		assert.NoError(t, request.Validate())
+		assert.Equal(t, []uint64{3, 1}, request.EmailIDs)
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		request := BulkRequest{Action: "archive", EmailIDs: []uint64{1}}
+
+		assert.Error(t, request.Validate())
+	})
+
+	t.Run("MoveWithoutFolder", func(t *testing.T) {
+		request := BulkRequest{Action: BulkActionMove, EmailIDs: []uint64{1}}
+
+		assert.Error(t, request.Validate())
+	})
+
+	t.Run("LabelWithoutLabel", func(t *testing.T) {
+		request := BulkRequest{Action: BulkActionLabel, EmailIDs: []uint64{1}}
+
+		assert.Error(t, request.Validate())
+	})
+
+	t.Run("TooManyEmails", func(t *testing.T) {
+		emailIDs := make([]uint64, MaxBulkEmails+1)
+		for i := range emailIDs {
+			emailIDs[i] = uint64(i + 1)
+		}
+		request := BulkRequest{Action: BulkActionDelete, EmailIDs: emailIDs}
+
+		assert.Error(t, request.Validate())
+	})
+
+	t.Run("NoEmails", func(t *testing.T) {
+		request := BulkRequest{Action: BulkActionDelete}
+
+		assert.Error(t, request.Validate())
+	})
+}
+
+func TestBulkRequestEvent(t *testing.T) {
+	read := BulkRequest{Action: BulkActionRead}
+	event := read.Event(3)
+	assert.Equal(t, MailboxEventReadStatus, event.Type)
+	assert.True(t, *event.ReadStatus)
+	assert.True(t, read.ChangesUnreadCount())
+
+	unread := BulkRequest{Action: BulkActionUnread}
+	assert.False(t, *unread.Event(3).ReadStatus)
+
+	move := BulkRequest{Action: BulkActionMove, FolderID: 5}
+	assert.Equal(t, &MailboxEvent{Type: MailboxEventMoved, EmailID: 3, FolderID: 5}, move.Event(3))
+	assert.False(t, move.ChangesUnreadCount())
+
+	flag := BulkRequest{Action: BulkActionFlag}
+	assert.Nil(t, flag.Event(3))
+	assert.False(t, flag.ChangesUnreadCount())
+}
@@ -0,0 +1,125 @@
+package domain_models
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+const (
+	// ExternalAccountTLSModeTLS connects over TLS from the start, usually on the ports 993 for IMAP and 465 for SMTP.
+	ExternalAccountTLSModeTLS = "tls"
+	// ExternalAccountTLSModeSTARTTLS connects in plain text and switches to TLS with the STARTTLS command, usually on the ports 143 and 587.
+	ExternalAccountTLSModeSTARTTLS = "starttls"
+	// ExternalAccountTLSModeNone never encrypts the connection, it is allowed only for the hosts trusted by the administrator.
+	ExternalAccountTLSModeNone = "none"
+)
+
+const (
+	// ExternalAccountMaxCount is the number of external mailboxes a user can link.
+	ExternalAccountMaxCount = 10
+	// ExternalAccountHostMaxLength is the longest host name of a mail server allowed.
+	ExternalAccountHostMaxLength = 253
+	// ExternalAccountEmailMaxLength is the longest address and user name allowed.
+	ExternalAccountEmailMaxLength = 254
+	// ExternalAccountMessagesLimit is the number of the newest emails shown from a folder of an external mailbox.
+	ExternalAccountMessagesLimit = 50
+)
+
+// ExternalAccountPorts are the standard ports of the IMAP and SMTP servers, the only ones connected to unless configured otherwise.
+var ExternalAccountPorts = []uint32{25, 143, 465, 587, 993}
+
+// ExternalAccount represents a mailbox of another mail provider linked by a user and reached over IMAP and SMTP.
+// The password is stored encrypted, it is decrypted only to connect to the mail servers.
+type ExternalAccount struct {
+	ID           uint32    // ID is the unique identifier of the linked mailbox.
+	ProfileID    uint32    // ProfileID is the unique identifier of the user who linked the mailbox.
+	Email        string    // Email is the address of the mailbox, the emails are sent from it.
+	IMAPHost     string    // IMAPHost is the host name of the IMAP server.
+	IMAPPort     uint32    // IMAPPort is the port of the IMAP server.
+	IMAPTLSMode  string    // IMAPTLSMode is how the connection to the IMAP server is secured.
+	SMTPHost     string    // SMTPHost is the host name of the SMTP server.
+	SMTPPort     uint32    // SMTPPort is the port of the SMTP server.
+	SMTPTLSMode  string    // SMTPTLSMode is how the connection to the SMTP server is secured.
+	Username     string    // Username is the name the user signs in to the mail servers with.
+	Password     string    // Password is the password of the mail servers, empty unless it is needed to connect.
+	CreationDate time.Time // CreationDate is the date when the mailbox was linked.
+}
+
+// IsValidExternalAccountTLSMode checks if the mode is one the connection to a mail server can be secured with.
+func IsValidExternalAccountTLSMode(mode string) bool {
+	switch mode {
+	case ExternalAccountTLSModeTLS, ExternalAccountTLSModeSTARTTLS, ExternalAccountTLSModeNone:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate checks the connection settings of the mailbox before it is linked.
+func (a *ExternalAccount) Validate() error {
+	address, err := mail.ParseAddress(a.Email)
+	if err != nil || address.Address != a.Email || len(a.Email) > ExternalAccountEmailMaxLength {
+		return fmt.Errorf("invalid email address")
+	}
+	if !isValidExternalAccountHost(a.IMAPHost) || !isValidExternalAccountHost(a.SMTPHost) {
+		return fmt.Errorf("invalid server host")
+	}
+	if !isValidExternalAccountPort(a.IMAPPort) || !isValidExternalAccountPort(a.SMTPPort) {
+		return fmt.Errorf("invalid server port")
+	}
+	if !IsValidExternalAccountTLSMode(a.IMAPTLSMode) || !IsValidExternalAccountTLSMode(a.SMTPTLSMode) {
+		return fmt.Errorf("invalid tls mode")
+	}
+	if a.Username == "" || len(a.Username) > ExternalAccountEmailMaxLength || strings.ContainsAny(a.Username, "\r\n") {
+		return fmt.Errorf("invalid user name")
+	}
+	if a.Password == "" || strings.ContainsAny(a.Password, "\r\n") {
+		return fmt.Errorf("invalid password")
+	}
+
+	return nil
+}
+
+// isValidExternalAccountHost checks if the host name can be dialed, the address and port are given separately.
+// The local host and the internal addresses are refused, the servers of the internal network are reached
+// only by the host names trusted by the administrator.
+func isValidExternalAccountHost(host string) bool {
+	if host == "" || len(host) > ExternalAccountHostMaxLength || strings.ContainsAny(host, " /:\r\n") {
+		return false
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(host, "."))
+	if name == "localhost" || strings.HasSuffix(name, ".localhost") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil && !IsPublicExternalAccountIP(ip) {
+		return false
+	}
+
+	return true
+}
+
+// IsPublicExternalAccountIP checks if the address may belong to a public mail server: the loopback, private,
+// link-local, multicast and unspecified addresses reach the host itself or the internal network.
+func IsPublicExternalAccountIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+
+	// 0.0.0.0/8 reaches the host itself on some systems, 100.64.0.0/10 is the shared address space of the carriers.
+	if ip4 := ip.To4(); ip4 != nil && (ip4[0] == 0 || (ip4[0] == 100 && ip4[1]&0xc0 == 64)) {
+		return false
+	}
+
+	return true
+}
+
+// isValidExternalAccountPort checks if the port is a TCP port.
+func isValidExternalAccountPort(port uint32) bool {
+	return port > 0 && port <= 65535
+}
@@ -0,0 +1,76 @@
+package domain_models
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestExternalAccount() *ExternalAccount {
+	return &ExternalAccount{
+		Email:       "user@example.com",
+		IMAPHost:    "imap.example.com",
+		IMAPPort:    993,
+		IMAPTLSMode: ExternalAccountTLSModeTLS,
+		SMTPHost:    "smtp.example.com",
+		SMTPPort:    587,
+		SMTPTLSMode: ExternalAccountTLSModeSTARTTLS,
+		Username:    "user@example.com",
+		Password:    "password",
+	}
+}
+
+func TestIsValidExternalAccountTLSMode(t *testing.T) {
+	assert.True(t, IsValidExternalAccountTLSMode(ExternalAccountTLSModeTLS))
+	assert.True(t, IsValidExternalAccountTLSMode(ExternalAccountTLSModeSTARTTLS))
+	assert.True(t, IsValidExternalAccountTLSMode(ExternalAccountTLSModeNone))
+	assert.False(t, IsValidExternalAccountTLSMode("ssl"))
+	assert.False(t, IsValidExternalAccountTLSMode(""))
+}
+
+func TestExternalAccountValidate(t *testing.T) {
+	assert.NoError(t, newTestExternalAccount().Validate())
+
+	tests := map[string]func(a *ExternalAccount){
+		"Email":        func(a *ExternalAccount) { a.Email = "User <user@example.com>" },
+		"EmptyEmail":   func(a *ExternalAccount) { a.Email = "" },
+		"IMAPHost":     func(a *ExternalAccount) { a.IMAPHost = "" },
+		"SMTPHostPort": func(a *ExternalAccount) { a.SMTPHost = "smtp.example.com:25" },
+		"Localhost":    func(a *ExternalAccount) { a.IMAPHost = "localhost" },
+		"SubLocalhost": func(a *ExternalAccount) { a.SMTPHost = "mail.LOCALHOST." },
+		"Loopback":     func(a *ExternalAccount) { a.IMAPHost = "127.0.0.1" },
+		"Private":      func(a *ExternalAccount) { a.SMTPHost = "10.0.0.1" },
+		"Metadata":     func(a *ExternalAccount) { a.IMAPHost = "169.254.169.254" },
+		"IMAPPort":     func(a *ExternalAccount) { a.IMAPPort = 0 },
+		"SMTPPort":     func(a *ExternalAccount) { a.SMTPPort = 70000 },
+		"IMAPTLSMode":  func(a *ExternalAccount) { a.IMAPTLSMode = "ssl" },
+		"SMTPTLSMode":  func(a *ExternalAccount) { a.SMTPTLSMode = "" },
+		"Username":     func(a *ExternalAccount) { a.Username = "" },
+		"UsernameCRLF": func(a *ExternalAccount) { a.Username = "user\r\nA1 LOGOUT" },
+		"Password":     func(a *ExternalAccount) { a.Password = "" },
+		"PasswordCRLF": func(a *ExternalAccount) { a.Password = "pass\nword" },
+	}
+	for name, change := range tests {
+		t.Run(name, func(t *testing.T) {
+			account := newTestExternalAccount()
+			change(account)
+			assert.Error(t, account.Validate())
+		})
+	}
+}
+
+func TestIsPublicExternalAccountIP(t *testing.T) {
+	for _, address := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"} {
+		assert.True(t, IsPublicExternalAccountIP(net.ParseIP(address)), address)
+	}
+
+	for _, address := range []string{
+		"127.0.0.1", "10.0.0.1", "172.16.5.4", "192.168.1.1", "169.254.169.254", "224.0.0.1",
+		"0.0.0.0", "0.1.2.3", "100.64.0.1", "::1", "::", "fe80::1", "fd00::1", "ff02::1", "::ffff:127.0.0.1",
+	} {
+		assert.False(t, IsPublicExternalAccountIP(net.ParseIP(address)), address)
+	}
+
+	assert.False(t, IsPublicExternalAccountIP(nil))
+}
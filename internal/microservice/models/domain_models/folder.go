@@ -1,8 +1,15 @@
 package domain_models
 
+// FolderPathSeparator separates the names of nested folders in the folder path.
+const FolderPathSeparator = "/"
+
 // Folder represents the information about a folder.
 type Folder struct {
 	ID        uint32 // ID unique id of the folder in the database.
 	ProfileId uint32 // ProfileId unique identifier of the user who owns the folder.
 	Name      string // Name of the folder.
+	ParentID  uint32 // ParentID unique id of the parent folder, 0 for the top-level folders.
+	Path      string // Path full path of the folder built from the names of its parents.
+	Total     uint32 // Total number of emails in the folder.
+	Unread    uint32 // Unread number of unread emails in the folder.
 }
@@ -0,0 +1,76 @@
+package domain_models
+
+import "time"
+
+const (
+	// GMailLabelInbox is the system label of the incoming emails.
+	GMailLabelInbox = "INBOX"
+	// GMailLabelSent is the system label of the sent emails.
+	GMailLabelSent = "SENT"
+	// GMailLabelSpam is the system label of the spam.
+	GMailLabelSpam = "SPAM"
+	// GMailLabelDraft is the system label of the drafts.
+	GMailLabelDraft = "DRAFT"
+	// GMailLabelUnread is the system label of the emails which have not been read.
+	GMailLabelUnread = "UNREAD"
+	// GMailLabelImportant is the system label of the emails marked as important.
+	GMailLabelImportant = "IMPORTANT"
+)
+
+const (
+	// GMailFullSyncMaxMessages is the number of the newest emails copied when a mailbox is synchronized from scratch.
+	GMailFullSyncMaxMessages = 200
+	// GMailMessagesLimit is the number of the newest emails of a label shown from the local copy.
+	GMailMessagesLimit = 100
+	// GMailPageLimit is the number of emails in a page of the Gmail lists by default.
+	GMailPageLimit = 20
+	// GMailPageMaxLimit is the maximum number of emails in a page of the Gmail lists.
+	GMailPageMaxLimit = 50
+)
+
+// GMailMessage represents the local copy of an email of a linked Gmail mailbox.
+type GMailMessage struct {
+	ID        string    // ID is the identifier of the email given by Gmail.
+	ThreadID  string    // ThreadID is the identifier of the conversation of the email given by Gmail.
+	LabelIDs  []string  // LabelIDs are the identifiers of the labels of the email, the read state is the UNREAD label.
+	Subject   string    // Subject is the subject of the email.
+	Sender    string    // Sender is the From header of the email.
+	Recipient string    // Recipient is the To header of the email.
+	Text      string    // Text is the HTML or plain text body of the email.
+	Date      time.Time // Date is the date when Gmail received the email.
+}
+
+// HasLabel checks if the email has the label.
+func (m *GMailMessage) HasLabel(labelID string) bool {
+	for _, l := range m.LabelIDs {
+		if l == labelID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GMailLabel represents a label of a linked Gmail mailbox.
+type GMailLabel struct {
+	ID   string // ID is the identifier of the label given by Gmail.
+	Name string // Name is the name of the label shown to the user.
+	Type string // Type is "system" for the labels of Gmail and "user" for the labels created by the user.
+}
+
+// GMailHistory represents the changes of a Gmail mailbox since a history identifier.
+type GMailHistory struct {
+	HistoryID  uint64   // HistoryID is the identifier of the latest change, the next synchronization starts from it.
+	ChangedIDs []string // ChangedIDs are the emails added to the mailbox or whose labels changed.
+	DeletedIDs []string // DeletedIDs are the emails deleted from the mailbox.
+}
+
+// GMailSync represents the changes applied to the local copy of a Gmail mailbox in one synchronization.
+type GMailSync struct {
+	Login      string          // Login is the Gmail address of the mailbox.
+	HistoryID  uint64          // HistoryID is the identifier of the latest change copied.
+	Full       bool            // Full indicates the local copy is replaced instead of updated.
+	Messages   []*GMailMessage // Messages are the emails added or changed.
+	DeletedIDs []string        // DeletedIDs are the emails removed from the mailbox.
+	Labels     []*GMailLabel   // Labels are all the labels of the mailbox.
+}
@@ -0,0 +1,16 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGMailMessage_HasLabel(t *testing.T) {
+	message := &GMailMessage{LabelIDs: []string{GMailLabelInbox, GMailLabelUnread}}
+
+	assert.True(t, message.HasLabel(GMailLabelInbox))
+	assert.True(t, message.HasLabel(GMailLabelUnread))
+	assert.False(t, message.HasLabel(GMailLabelSpam))
+	assert.False(t, message.HasLabel("inbox"))
+}
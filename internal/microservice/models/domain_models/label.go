@@ -0,0 +1,40 @@
+package domain_models
+
+// DefaultLabelColor is the color of a label created without an explicit color.
+const DefaultLabelColor = "#000000"
+
+// Label represents the information about a user label that can be put on any number of emails.
+type Label struct {
+	ID        uint32 // ID is the unique identifier of the label in the database.
+	ProfileID uint32 // ProfileID is the unique identifier of the user who owns the label.
+	Name      string // Name is the name of the label, unique for the user.
+	Color     string // Color is the color of the label in the #RRGGBB format.
+}
+
+// HasLabel reports whether the email is marked with the label with the specified ID.
+func (e *Email) HasLabel(labelID uint32) bool {
+	for _, label := range e.Labels {
+		if label.ID == labelID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterEmailsByLabel returns the emails marked with the label with the specified ID;
+// a zero ID means no filter and returns the emails as is.
+func FilterEmailsByLabel(emails []*Email, labelID uint32) []*Email {
+	if labelID == 0 {
+		return emails
+	}
+
+	filtered := make([]*Email, 0, len(emails))
+	for _, email := range emails {
+		if email.HasLabel(labelID) {
+			filtered = append(filtered, email)
+		}
+	}
+
+	return filtered
+}
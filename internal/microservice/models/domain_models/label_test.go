@@ -0,0 +1,29 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailHasLabel(t *testing.T) {
+	email := Email{Labels: []*Label{{ID: 1, Name: "Work"}, {ID: 3, Name: "Travel"}}}
+
+	assert.True(t, email.HasLabel(1))
+	assert.True(t, email.HasLabel(3))
+	assert.False(t, email.HasLabel(2))
+	assert.False(t, (&Email{}).HasLabel(1))
+}
+
+func TestFilterEmailsByLabel(t *testing.T) {
+	work := &Label{ID: 1, Name: "Work"}
+	emails := []*Email{
+		{ID: 1, Labels: []*Label{work}},
+		{ID: 2},
+		{ID: 3, Labels: []*Label{{ID: 2, Name: "Home"}, work}},
+	}
+
+	assert.Equal(t, emails, FilterEmailsByLabel(emails, 0))
+	assert.Equal(t, []*Email{emails[0], emails[2]}, FilterEmailsByLabel(emails, 1))
+	assert.Empty(t, FilterEmailsByLabel(emails, 5))
+}
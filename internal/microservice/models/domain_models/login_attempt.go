@@ -0,0 +1,97 @@
+package domain_models
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// LoginAttemptWindow is the time after which failed login attempts are forgotten.
+	LoginAttemptWindow = 15 * time.Minute
+
+	// LoginAttemptFreeFailures is the number of failed attempts allowed without a delay.
+	LoginAttemptFreeFailures = 3
+
+	// LoginAttemptBaseDelay is the delay after the first failure over the free ones, it doubles with every next failure.
+	LoginAttemptBaseDelay = time.Second
+
+	// LoginAttemptMaxDelay is the longest delay between two attempts.
+	LoginAttemptMaxDelay = time.Minute
+
+	// LoginLockoutFailures is the number of failed attempts on one login after which the next attempt locks it.
+	LoginLockoutFailures = 10
+
+	// IPLockoutFailures is the number of failed attempts from one IP address after which the next attempt locks it.
+	// It is higher than the one of a login, many users can share an address behind NAT.
+	IPLockoutFailures = 100
+
+	// LoginLockoutDuration is the time a login or an IP address stays locked.
+	LoginLockoutDuration = 15 * time.Minute
+)
+
+// LoginAttempts represents the recent failed login attempts on a login or from an IP address.
+// An attempt is counted before the password is checked, the successful ones are uncounted afterwards.
+type LoginAttempts struct {
+	Key                 string    // Key is the login or the IP address, see LoginAttemptLoginKey and LoginAttemptIPKey.
+	Failures            int       // Failures is the number of failed attempts in a row.
+	LastFailureDate     time.Time // LastFailureDate is the time of the last failed attempt.
+	PreviousFailureDate time.Time // PreviousFailureDate is the time of the attempt before the last one, zero if there was none.
+	LockedUntil         time.Time // LockedUntil is the time until which the key is locked, zero if it is not.
+}
+
+// LoginAttemptLoginKey returns the key the attempts on the login are stored with.
+func LoginAttemptLoginKey(login string) string {
+	return "login:" + strings.ToLower(login)
+}
+
+// LoginAttemptIPKey returns the key the attempts from the IP address are stored with.
+func LoginAttemptIPKey(ipAddress string) string {
+	return "ip:" + ipAddress
+}
+
+// LoginAttemptDelay returns the time to wait after the given number of failed attempts.
+func LoginAttemptDelay(failures int) time.Duration {
+	if failures <= LoginAttemptFreeFailures {
+		return 0
+	}
+
+	delay := LoginAttemptBaseDelay
+	for i := LoginAttemptFreeFailures + 1; i < failures && delay < LoginAttemptMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > LoginAttemptMaxDelay {
+		delay = LoginAttemptMaxDelay
+	}
+
+	return delay
+}
+
+// RetryAfter returns the time to wait before the next attempt is allowed, zero if it is allowed now.
+func (a *LoginAttempts) RetryAfter(now time.Time) time.Duration {
+	if now.Before(a.LockedUntil) {
+		return a.LockedUntil.Sub(now)
+	}
+
+	if now.Sub(a.LastFailureDate) > LoginAttemptWindow {
+		return 0
+	}
+
+	next := a.LastFailureDate.Add(LoginAttemptDelay(a.Failures))
+	if now.Before(next) {
+		return next.Sub(now)
+	}
+
+	return 0
+}
+
+// LastAttemptRetryAfter returns the time the last counted attempt had to wait for, zero if it was allowed.
+// It is decided from the attempts made before it, the concurrent attempts are counted one after another.
+func (a *LoginAttempts) LastAttemptRetryAfter(now time.Time) time.Duration {
+	before := &LoginAttempts{
+		Failures:        a.Failures - 1,
+		LastFailureDate: a.PreviousFailureDate,
+		LockedUntil:     a.LockedUntil,
+	}
+
+	return before.RetryAfter(now)
+}
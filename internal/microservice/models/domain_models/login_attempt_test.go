@@ -0,0 +1,87 @@
+package domain_models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginAttemptKeys(t *testing.T) {
+	assert.Equal(t, "login:user@mailhub.su", LoginAttemptLoginKey("User@MailHub.su"))
+	assert.Equal(t, "ip:127.0.0.1", LoginAttemptIPKey("127.0.0.1"))
+}
+
+func TestLoginAttemptDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{LoginAttemptFreeFailures, 0},
+		{LoginAttemptFreeFailures + 1, time.Second},
+		{LoginAttemptFreeFailures + 2, 2 * time.Second},
+		{LoginAttemptFreeFailures + 3, 4 * time.Second},
+		{LoginAttemptFreeFailures + 50, LoginAttemptMaxDelay},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, LoginAttemptDelay(tt.failures), "failures = %d", tt.failures)
+	}
+}
+
+func TestLoginAttemptsRetryAfter(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Locked", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: LoginLockoutFailures, LastFailureDate: now, LockedUntil: now.Add(time.Minute)}
+
+		assert.Equal(t, time.Minute, attempts.RetryAfter(now))
+	})
+
+	t.Run("Delayed", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: LoginAttemptFreeFailures + 2, LastFailureDate: now.Add(-time.Second)}
+
+		assert.Equal(t, time.Second, attempts.RetryAfter(now))
+	})
+
+	t.Run("DelayPassed", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: LoginAttemptFreeFailures + 1, LastFailureDate: now.Add(-2 * time.Second)}
+
+		assert.Zero(t, attempts.RetryAfter(now))
+	})
+
+	t.Run("Forgotten", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: LoginLockoutFailures - 1, LastFailureDate: now.Add(-LoginAttemptWindow - time.Second)}
+
+		assert.Zero(t, attempts.RetryAfter(now))
+	})
+}
+
+func TestLoginAttemptsLastAttemptRetryAfter(t *testing.T) {
+	now := time.Now()
+
+	t.Run("FirstAttempt", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: 1, LastFailureDate: now}
+
+		assert.Zero(t, attempts.LastAttemptRetryAfter(now))
+	})
+
+	t.Run("Delayed", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: LoginAttemptFreeFailures + 3, LastFailureDate: now, PreviousFailureDate: now.Add(-time.Second)}
+
+		assert.Equal(t, time.Second, attempts.LastAttemptRetryAfter(now))
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: LoginAttemptFreeFailures + 2, LastFailureDate: now, PreviousFailureDate: now}
+
+		assert.Equal(t, LoginAttemptBaseDelay, attempts.LastAttemptRetryAfter(now))
+	})
+
+	t.Run("Locked", func(t *testing.T) {
+		attempts := &LoginAttempts{Failures: 2, LastFailureDate: now, LockedUntil: now.Add(time.Minute)}
+
+		assert.Equal(t, time.Minute, attempts.LastAttemptRetryAfter(now))
+	})
+}
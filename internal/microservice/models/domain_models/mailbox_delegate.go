@@ -0,0 +1,78 @@
+package domain_models
+
+import "time"
+
+const (
+	// DelegateRoleRead allows the delegate to read the emails of the mailbox.
+	DelegateRoleRead = "read"
+	// DelegateRoleSendAs allows the delegate to read and send emails as the mailbox itself.
+	DelegateRoleSendAs = "send_as"
+	// DelegateRoleSendOnBehalf allows the delegate to read and send emails on behalf of the mailbox,
+	// the recipient sees who actually sent the email.
+	DelegateRoleSendOnBehalf = "send_on_behalf"
+	// DelegateRoleManage gives the delegate full access to the mailbox including its delegates.
+	DelegateRoleManage = "manage"
+	// MailboxRoleOwner is the role of the profile the mailbox belongs to.
+	MailboxRoleOwner = "owner"
+
+	// MailboxPermissionRead is required to read the emails of the mailbox.
+	MailboxPermissionRead = "read"
+	// MailboxPermissionWrite is required to send and modify the emails of the mailbox.
+	MailboxPermissionWrite = "write"
+	// MailboxPermissionManage is required to manage the delegates of the mailbox.
+	MailboxPermissionManage = "manage"
+
+	// DelegateActionSend is recorded when a delegate sends an email from the mailbox.
+	DelegateActionSend = "send"
+	// DelegateActionDraft is recorded when a delegate saves a draft in the mailbox.
+	DelegateActionDraft = "draft"
+	// DelegateActionUpdate is recorded when a delegate changes an email of the mailbox.
+	DelegateActionUpdate = "update"
+	// DelegateActionDelete is recorded when a delegate deletes an email of the mailbox.
+	DelegateActionDelete = "delete"
+	// DelegateActionBulk prefixes the bulk action a delegate applied to an email of the mailbox, e.g. bulk_read.
+	DelegateActionBulk = "bulk_"
+)
+
+// MailboxDelegate represents the information about a profile that has access to a shared mailbox.
+type MailboxDelegate struct {
+	MailboxID    uint32    // MailboxID is the unique identifier of the shared mailbox profile.
+	DelegateID   uint32    // DelegateID is the unique identifier of the delegate profile.
+	Mailbox      string    // Mailbox is the login of the shared mailbox, e.g. support@mailhub.su.
+	Delegate     string    // Delegate is the login of the delegate profile.
+	Role         string    // Role is the role of the delegate: read, send_as, send_on_behalf or manage.
+	CreationDate time.Time // CreationDate is the date when the access was granted.
+}
+
+// DelegateAction represents an action a delegate performed in a shared mailbox.
+type DelegateAction struct {
+	ID           uint64    // ID is the unique identifier of the action in the database.
+	Mailbox      string    // Mailbox is the login of the shared mailbox.
+	Delegate     string    // Delegate is the login of the delegate who performed the action.
+	Action       string    // Action is the performed action, e.g. send or delete.
+	EmailID      uint64    // EmailID is the identifier of the email the action was performed on, if any.
+	CreationDate time.Time // CreationDate is the date when the action was performed.
+}
+
+// IsValidDelegateRole reports whether the role can be given to a delegate.
+func IsValidDelegateRole(role string) bool {
+	switch role {
+	case DelegateRoleRead, DelegateRoleSendAs, DelegateRoleSendOnBehalf, DelegateRoleManage:
+		return true
+	}
+	return false
+}
+
+// MailboxRoleAllows reports whether a profile with the given role in the mailbox has the permission.
+// An empty role means the profile has no access to the mailbox.
+func MailboxRoleAllows(role, permission string) bool {
+	switch role {
+	case MailboxRoleOwner, DelegateRoleManage:
+		return permission == MailboxPermissionRead || permission == MailboxPermissionWrite || permission == MailboxPermissionManage
+	case DelegateRoleSendAs, DelegateRoleSendOnBehalf:
+		return permission == MailboxPermissionRead || permission == MailboxPermissionWrite
+	case DelegateRoleRead:
+		return permission == MailboxPermissionRead
+	}
+	return false
+}
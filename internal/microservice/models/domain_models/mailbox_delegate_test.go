@@ -0,0 +1,40 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidDelegateRole(t *testing.T) {
+	assert.True(t, IsValidDelegateRole(DelegateRoleRead))
+	assert.True(t, IsValidDelegateRole(DelegateRoleSendAs))
+	assert.True(t, IsValidDelegateRole(DelegateRoleSendOnBehalf))
+	assert.True(t, IsValidDelegateRole(DelegateRoleManage))
+	assert.False(t, IsValidDelegateRole(MailboxRoleOwner))
+	assert.False(t, IsValidDelegateRole(""))
+}
+
+func TestMailboxRoleAllows(t *testing.T) {
+	tests := []struct {
+		role       string
+		permission string
+		want       bool
+	}{
+		{MailboxRoleOwner, MailboxPermissionManage, true},
+		{DelegateRoleManage, MailboxPermissionManage, true},
+		{DelegateRoleManage, MailboxPermissionWrite, true},
+		{DelegateRoleSendAs, MailboxPermissionWrite, true},
+		{DelegateRoleSendAs, MailboxPermissionManage, false},
+		{DelegateRoleSendOnBehalf, MailboxPermissionRead, true},
+		{DelegateRoleSendOnBehalf, MailboxPermissionWrite, true},
+		{DelegateRoleRead, MailboxPermissionRead, true},
+		{DelegateRoleRead, MailboxPermissionWrite, false},
+		{"", MailboxPermissionRead, false},
+		{MailboxRoleOwner, "unknown", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MailboxRoleAllows(tt.role, tt.permission), "%s/%s", tt.role, tt.permission)
+	}
+}
@@ -0,0 +1,40 @@
+package domain_models
+
+import "time"
+
+const (
+	// MailboxEventNewMail is the delivery of an email to the mailbox.
+	MailboxEventNewMail = "new_mail"
+	// MailboxEventReadStatus is the change of the read status of an email.
+	MailboxEventReadStatus = "read_status"
+	// MailboxEventDeleted is the deletion of an email.
+	MailboxEventDeleted = "deleted"
+	// MailboxEventMoved is the move of an email to a folder.
+	MailboxEventMoved = "moved"
+	// MailboxEventFolderCreated is the creation of a folder.
+	MailboxEventFolderCreated = "folder_created"
+	// MailboxEventFolderRenamed is the rename of a folder.
+	MailboxEventFolderRenamed = "folder_renamed"
+	// MailboxEventDraftSaved is the creation or the update of a draft.
+	MailboxEventDraftSaved = "draft_saved"
+	// MailboxEventUnreadCount is the change of the number of the unread incoming emails.
+	MailboxEventUnreadCount = "unread_count"
+	// MailboxEventSessionRevoked is the sign-out of sessions of the user, the replicas of the gateway forget them.
+	MailboxEventSessionRevoked = "session_revoked"
+	// MailboxEventResync tells the client the missed events are no longer kept and the mailbox must be reloaded.
+	MailboxEventResync = "resync"
+)
+
+// MailboxEvent represents a change of the mailbox of a user delivered to the connected clients.
+type MailboxEvent struct {
+	Seq        uint64    // Seq is the sequence number of the event, later events of the user have greater numbers.
+	Type       string    // Type is the kind of the change, e.g. new_mail or unread_count.
+	Date       time.Time // Date is the date of the change.
+	EmailID    uint64    // EmailID is the unique identifier of the changed email.
+	Email      *Email    // Email is the new email or the saved draft.
+	ReadStatus *bool     // ReadStatus is the new read status of the email.
+	FolderID   uint32    // FolderID is the unique identifier of the created or renamed folder, or of the folder the email was moved to.
+	FolderName string    // FolderName is the name of the created or renamed folder.
+	Unread     *int64    // Unread is the number of the unread incoming emails.
+	SessionIDs []string  // SessionIDs are the public identifiers of the revoked sessions.
+}
@@ -0,0 +1,102 @@
+package domain_models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// PostPolicyAnyone allows anyone, including external senders, to post to the list.
+	PostPolicyAnyone = "anyone"
+	// PostPolicyMembers allows only members and owners of the list to post.
+	PostPolicyMembers = "members"
+	// PostPolicyOwners allows only owners of the list to post.
+	PostPolicyOwners = "owners"
+
+	// ListRoleOwner is the role of a list member who manages the list.
+	ListRoleOwner = "owner"
+	// ListRoleMember is the role of a regular list member.
+	ListRoleMember = "member"
+
+	// ListUnsubscribePost is the value of the List-Unsubscribe-Post header (RFC 8058),
+	// it tells the mail clients the List-Unsubscribe link unsubscribes with a single POST.
+	ListUnsubscribePost = "List-Unsubscribe=One-Click"
+	// ListUnsubscribeLifeTime is the time the unsubscribe link of an email stays valid.
+	ListUnsubscribeLifeTime = 365 * 24 * time.Hour
+)
+
+// MailingList represents the information about a distribution list.
+type MailingList struct {
+	ID           uint32    // ID is the unique identifier of the list in the database.
+	Address      string    // Address is the group address of the list, e.g. team@mailhub.su.
+	Name         string    // Name is the display name of the list.
+	Description  string    // Description is an optional description of the list.
+	PostPolicy   string    // PostPolicy determines who can post to the list: anyone, members or owners.
+	Moderated    bool      // Moderated indicates whether posts from non-owners are held for approval.
+	CreationDate time.Time // CreationDate is the date when the list was created.
+}
+
+// MailingListMember represents the information about a member of a distribution list.
+type MailingListMember struct {
+	ListID    uint32 // ListID is the unique identifier of the list.
+	ProfileID uint32 // ProfileID is the unique identifier of the member profile.
+	Login     string // Login is the login of the member profile.
+	Role      string // Role is the role of the member in the list: owner or member.
+}
+
+// ListIDHeader returns the value of the List-Id header (RFC 2919) for the list.
+func (l *MailingList) ListIDHeader() string {
+	return fmt.Sprintf("%s <%s>", l.Name, strings.Replace(l.Address, "@", ".", 1))
+}
+
+// ListUnsubscribeHeader returns the value of the List-Unsubscribe header (RFC 2369) of the emails of the list to a member.
+// The link is signed for the member, so it unsubscribes without a session as RFC 8058 requires.
+func (l *MailingList) ListUnsubscribeHeader(token string) string {
+	return fmt.Sprintf("<https://mailhub.su/api/v1/list/unsubscribe/%s>", token)
+}
+
+// UnsubscribeURL returns the endpoint the signed in members use to leave the list.
+func (l *MailingList) UnsubscribeURL() string {
+	return fmt.Sprintf("https://mailhub.su/api/v1/list/%d/unsubscribe", l.ID)
+}
+
+// ListUnsubscribePayload returns the payload of the signed token unsubscribing the member from the list.
+func ListUnsubscribePayload(listID uint32, login string) string {
+	return strconv.FormatUint(uint64(listID), 10) + ":" + login
+}
+
+// ParseListUnsubscribePayload returns the list and the member named by the payload of an unsubscribe token.
+func ParseListUnsubscribePayload(payload string) (uint32, string, error) {
+	id, login, ok := strings.Cut(payload, ":")
+	if !ok || login == "" {
+		return 0, "", fmt.Errorf("malformed list unsubscribe payload")
+	}
+
+	listID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil || listID == 0 {
+		return 0, "", fmt.Errorf("malformed list unsubscribe payload")
+	}
+
+	return uint32(listID), login, nil
+}
+
+// CanPost reports whether a sender with the given role in the list may post to it.
+// An empty role means the sender is not a member of the list.
+func (l *MailingList) CanPost(role string) bool {
+	switch l.PostPolicy {
+	case PostPolicyAnyone:
+		return true
+	case PostPolicyMembers:
+		return role == ListRoleMember || role == ListRoleOwner
+	case PostPolicyOwners:
+		return role == ListRoleOwner
+	}
+	return false
+}
+
+// NeedsModeration reports whether a post from a sender with the given role must be approved by an owner.
+func (l *MailingList) NeedsModeration(role string) bool {
+	return l.Moderated && role != ListRoleOwner
+}
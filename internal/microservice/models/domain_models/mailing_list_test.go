@@ -0,0 +1,58 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMailingListHeaders(t *testing.T) {
+	list := MailingList{ID: 7, Address: "team@mailhub.su", Name: "Team"}
+
+	assert.Equal(t, "Team <team.mailhub.su>", list.ListIDHeader())
+	assert.Equal(t, "<https://mailhub.su/api/v1/list/unsubscribe/token>", list.ListUnsubscribeHeader("token"))
+	assert.Equal(t, "https://mailhub.su/api/v1/list/7/unsubscribe", list.UnsubscribeURL())
+}
+
+func TestListUnsubscribePayload(t *testing.T) {
+	listID, login, err := ParseListUnsubscribePayload(ListUnsubscribePayload(7, "ivan@mailhub.su"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), listID)
+	assert.Equal(t, "ivan@mailhub.su", login)
+
+	for _, payload := range []string{"", "7", "7:", "0:ivan@mailhub.su", "x:ivan@mailhub.su"} {
+		_, _, err = ParseListUnsubscribePayload(payload)
+		assert.Error(t, err, payload)
+	}
+}
+
+func TestMailingListCanPost(t *testing.T) {
+	tests := []struct {
+		policy string
+		role   string
+		want   bool
+	}{
+		{PostPolicyAnyone, "", true},
+		{PostPolicyMembers, "", false},
+		{PostPolicyMembers, ListRoleMember, true},
+		{PostPolicyMembers, ListRoleOwner, true},
+		{PostPolicyOwners, ListRoleMember, false},
+		{PostPolicyOwners, ListRoleOwner, true},
+		{"unknown", ListRoleOwner, false},
+	}
+
+	for _, tt := range tests {
+		list := MailingList{PostPolicy: tt.policy}
+		assert.Equal(t, tt.want, list.CanPost(tt.role), "%s/%s", tt.policy, tt.role)
+	}
+}
+
+func TestMailingListNeedsModeration(t *testing.T) {
+	list := MailingList{Moderated: true}
+	assert.True(t, list.NeedsModeration(ListRoleMember))
+	assert.True(t, list.NeedsModeration(""))
+	assert.False(t, list.NeedsModeration(ListRoleOwner))
+
+	list.Moderated = false
+	assert.False(t, list.NeedsModeration(""))
+}
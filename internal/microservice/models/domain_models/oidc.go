@@ -0,0 +1,318 @@
+package domain_models
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// OIDCScopeOpenID is required in every authorization request, it gives the client the identifier of the user.
+	OIDCScopeOpenID = "openid"
+	// OIDCScopeProfile gives the client the name, gender, birthday and avatar of the user.
+	OIDCScopeProfile = "profile"
+	// OIDCScopeEmail gives the client the email address of the user.
+	OIDCScopeEmail = "email"
+	// OIDCScopePhone gives the client the phone number of the user.
+	OIDCScopePhone = "phone"
+)
+
+const (
+	// OIDCCodeChallengeMethodS256 is the only PKCE method supported, the challenge is the SHA-256 of the verifier.
+	OIDCCodeChallengeMethodS256 = "S256"
+	// OIDCAccessTokenType is the type in the header of the access tokens, it keeps ID tokens from being used as them.
+	OIDCAccessTokenType = "at+jwt"
+	// OIDCIDTokenType is the type in the header of the ID tokens.
+	OIDCIDTokenType = "JWT"
+)
+
+const (
+	// OIDCAuthorizationCodeLifeTime is the time during which an authorization code can be exchanged for tokens.
+	OIDCAuthorizationCodeLifeTime = 10 * time.Minute
+	// OIDCConsentRequestLifeTime is the time the user has to approve the access of a client.
+	OIDCConsentRequestLifeTime = 10 * time.Minute
+	// OIDCTokenLifeTime is the time during which the ID and access tokens are valid.
+	OIDCTokenLifeTime = time.Hour
+	// OIDCSigningKeyRotationPeriod is the age after which a new key is generated to sign the tokens.
+	OIDCSigningKeyRotationPeriod = 30 * 24 * time.Hour
+	// OIDCSigningKeyLifeTime is the age after which a key is no longer published, all the tokens signed with it have expired by then.
+	OIDCSigningKeyLifeTime = 2 * OIDCSigningKeyRotationPeriod
+	// OIDCSigningKeyBits is the size of the generated signing keys.
+	OIDCSigningKeyBits = 2048
+)
+
+const (
+	// OIDCClientMaxRedirectURIs is the number of redirect addresses a client can have.
+	OIDCClientMaxRedirectURIs = 4
+	// OIDCRedirectURIMaxLength is the longest redirect address allowed.
+	OIDCRedirectURIMaxLength = 500
+	// OIDCClientNameMaxLength is the longest name of a client allowed.
+	OIDCClientNameMaxLength = 100
+)
+
+// OIDCClient represents an application which signs users in with their MailHub accounts.
+type OIDCClient struct {
+	ClientID     string    // ClientID is the unique identifier of the client.
+	SecretHash   string    // SecretHash is the SHA-256 hash of the secret, empty for public clients which use PKCE only.
+	Name         string    // Name is shown to the user when they are asked for consent.
+	RedirectURIs []string  // RedirectURIs are the addresses the user can be sent back to with the authorization code.
+	CreationDate time.Time // CreationDate is the date when the client was registered.
+}
+
+// IsPublic checks if the client has no secret, e.g. a mobile or a single-page application.
+func (c *OIDCClient) IsPublic() bool {
+	return c.SecretHash == ""
+}
+
+// AllowsRedirectURI checks if the address is one of the registered redirect addresses of the client.
+// Only exact matches are allowed, so codes cannot be sent to other pages of the same site.
+func (c *OIDCClient) AllowsRedirectURI(redirectURI string) bool {
+	for _, uri := range c.RedirectURIs {
+		if uri == redirectURI {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckSecret checks the secret given by the client.
+func (c *OIDCClient) CheckSecret(secret string) bool {
+	if c.IsPublic() {
+		return secret == ""
+	}
+
+	return subtle.ConstantTimeCompare([]byte(HashOIDCSecret(secret)), []byte(c.SecretHash)) == 1
+}
+
+// IsValidOIDCRedirectURI checks if the address can be registered as a redirect address of a client.
+// It has to be an absolute https address without a fragment, plain http is only allowed for the local machine.
+func IsValidOIDCRedirectURI(redirectURI string) bool {
+	if len(redirectURI) > OIDCRedirectURIMaxLength {
+		return false
+	}
+
+	u, err := url.Parse(redirectURI)
+	if err != nil || u.Host == "" || u.Fragment != "" || u.User != nil {
+		return false
+	}
+
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		return u.Hostname() == "localhost" || u.Hostname() == "127.0.0.1"
+	default:
+		return false
+	}
+}
+
+// OIDCRedirect returns the redirect address with the parameters added to its query.
+func OIDCRedirect(redirectURI string, params url.Values) string {
+	separator := "?"
+	if strings.Contains(redirectURI, "?") {
+		separator = "&"
+	}
+
+	return redirectURI + separator + params.Encode()
+}
+
+// OIDCAuthorizationRequest represents the request of a client to sign a user in.
+type OIDCAuthorizationRequest struct {
+	ProfileID     uint32   // ProfileID is the unique identifier of the signed-in user the request is made for.
+	ClientID      string   // ClientID is the unique identifier of the client.
+	RedirectURI   string   // RedirectURI is the address the user is sent back to.
+	Scopes        []string // Scopes are the data of the user requested by the client.
+	State         string   // State is the value of the client returned back to it as is.
+	Nonce         string   // Nonce is the value of the client put into the ID token.
+	CodeChallenge string   // CodeChallenge is the PKCE challenge of the client.
+}
+
+// Payload returns the request in the form it is signed in while the user is asked for consent.
+func (r *OIDCAuthorizationRequest) Payload() string {
+	values := url.Values{}
+	values.Set("profile_id", strconv.FormatUint(uint64(r.ProfileID), 10))
+	values.Set("client_id", r.ClientID)
+	values.Set("redirect_uri", r.RedirectURI)
+	values.Set("scope", strings.Join(r.Scopes, " "))
+	values.Set("state", r.State)
+	values.Set("nonce", r.Nonce)
+	values.Set("code_challenge", r.CodeChallenge)
+
+	return values.Encode()
+}
+
+// ParseOIDCAuthorizationRequest parses the payload returned by Payload.
+func ParseOIDCAuthorizationRequest(payload string) (*OIDCAuthorizationRequest, error) {
+	values, err := url.ParseQuery(payload)
+	if err != nil {
+		return nil, fmt.Errorf("malformed authorization request: %v", err)
+	}
+
+	profileID, err := strconv.ParseUint(values.Get("profile_id"), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("malformed authorization request: %v", err)
+	}
+
+	return &OIDCAuthorizationRequest{
+		ProfileID:     uint32(profileID),
+		ClientID:      values.Get("client_id"),
+		RedirectURI:   values.Get("redirect_uri"),
+		Scopes:        strings.Fields(values.Get("scope")),
+		State:         values.Get("state"),
+		Nonce:         values.Get("nonce"),
+		CodeChallenge: values.Get("code_challenge"),
+	}, nil
+}
+
+// OIDCAuthorizationCode represents a code issued to a client, it is exchanged for the tokens once.
+type OIDCAuthorizationCode struct {
+	CodeHash       string    // CodeHash is the SHA-256 hash of the code.
+	ClientID       string    // ClientID is the unique identifier of the client the code is issued to.
+	ProfileID      uint32    // ProfileID is the unique identifier of the signed-in user.
+	RedirectURI    string    // RedirectURI is the address the code was sent to, the exchange has to name it again.
+	Scopes         []string  // Scopes are the data of the user the client is allowed to read.
+	Nonce          string    // Nonce is the value of the client put into the ID token.
+	CodeChallenge  string    // CodeChallenge is the PKCE challenge the verifier given in the exchange has to match.
+	ExpirationDate time.Time // ExpirationDate is the date after which the code can no longer be exchanged.
+}
+
+// OIDCSigningKey represents a key the tokens are signed with.
+type OIDCSigningKey struct {
+	KeyID        string    // KeyID is the unique identifier of the key, it is put into the header of the tokens.
+	PrivateKey   string    // PrivateKey is the RSA private key in the PEM format.
+	CreationDate time.Time // CreationDate is the date when the key was generated.
+}
+
+// OIDCIDTokenClaims represents the claims of an ID token.
+type OIDCIDTokenClaims struct {
+	Issuer    string `json:"iss"`             // Issuer is the address of the provider.
+	Subject   string `json:"sub"`             // Subject is the unique identifier of the user.
+	Audience  string `json:"aud"`             // Audience is the unique identifier of the client.
+	ExpiresAt int64  `json:"exp"`             // ExpiresAt is the Unix time after which the token is not valid.
+	IssuedAt  int64  `json:"iat"`             // IssuedAt is the Unix time when the token was issued.
+	Nonce     string `json:"nonce,omitempty"` // Nonce is the value given by the client in the authorization request.
+}
+
+// OIDCAccessTokenClaims represents the claims of an access token the user info is read with.
+type OIDCAccessTokenClaims struct {
+	Issuer    string `json:"iss"`       // Issuer is the address of the provider.
+	Subject   string `json:"sub"`       // Subject is the unique identifier of the user.
+	ClientID  string `json:"client_id"` // ClientID is the unique identifier of the client.
+	Scope     string `json:"scope"`     // Scope are the space-separated data of the user the client is allowed to read.
+	ExpiresAt int64  `json:"exp"`       // ExpiresAt is the Unix time after which the token is not valid.
+	IssuedAt  int64  `json:"iat"`       // IssuedAt is the Unix time when the token was issued.
+}
+
+// IsValidOIDCScope checks if the scope is one a client can request.
+func IsValidOIDCScope(scope string) bool {
+	switch scope {
+	case OIDCScopeOpenID, OIDCScopeProfile, OIDCScopeEmail, OIDCScopePhone:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseOIDCScopes returns the supported scopes of the space-separated scope parameter without repetitions.
+// Unknown scopes are ignored as the specification requires, the openid scope has to be present.
+func ParseOIDCScopes(scope string) ([]string, error) {
+	scopes := make([]string, 0)
+	for _, s := range strings.Fields(scope) {
+		if IsValidOIDCScope(s) && !OIDCScopesInclude(scopes, []string{s}) {
+			scopes = append(scopes, s)
+		}
+	}
+
+	if !OIDCScopesInclude(scopes, []string{OIDCScopeOpenID}) {
+		return nil, fmt.Errorf("openid scope is required")
+	}
+
+	return scopes, nil
+}
+
+// OIDCScopesInclude checks if all the requested scopes are among the granted ones.
+func OIDCScopesInclude(granted, requested []string) bool {
+	for _, r := range requested {
+		found := false
+		for _, g := range granted {
+			if g == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HashOIDCSecret returns the hash the client secrets and the authorization codes are stored with.
+func HashOIDCSecret(secret string) string {
+	return HashAPIToken(secret)
+}
+
+// VerifyOIDCCodeChallenge checks the PKCE verifier given in the exchange against the challenge of the authorization request.
+func VerifyOIDCCodeChallenge(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
+
+// OIDCSubject returns the identifier of the user given to the clients.
+func OIDCSubject(profileID uint32) string {
+	return strconv.FormatUint(uint64(profileID), 10)
+}
+
+// OIDCUserInfo returns the claims about the user the granted scopes allow the client to read.
+// Empty fields of the profile are left out.
+func OIDCUserInfo(user *User, scopes []string) map[string]interface{} {
+	claims := map[string]interface{}{
+		"sub": OIDCSubject(user.ID),
+	}
+
+	if OIDCScopesInclude(scopes, []string{OIDCScopeProfile}) {
+		name := strings.Join(strings.Fields(user.FirstName+" "+user.Surname), " ")
+		setOIDCClaim(claims, "name", name)
+		setOIDCClaim(claims, "given_name", user.FirstName)
+		setOIDCClaim(claims, "family_name", user.Surname)
+		setOIDCClaim(claims, "middle_name", user.Patronymic)
+		setOIDCClaim(claims, "preferred_username", user.Login)
+		setOIDCClaim(claims, "picture", user.AvatarID)
+		if IsValidGender(user.Gender) {
+			claims["gender"] = strings.ToLower(GetGender(user.Gender))
+		}
+		if !user.Birthday.IsZero() {
+			claims["birthdate"] = user.Birthday.Format("2006-01-02")
+		}
+	}
+
+	if OIDCScopesInclude(scopes, []string{OIDCScopeEmail}) {
+		claims["email"] = user.Login
+		claims["email_verified"] = true
+	}
+
+	if OIDCScopesInclude(scopes, []string{OIDCScopePhone}) {
+		setOIDCClaim(claims, "phone_number", user.PhoneNumber)
+	}
+
+	return claims
+}
+
+// setOIDCClaim sets the claim if the value is not empty.
+func setOIDCClaim(claims map[string]interface{}, name, value string) {
+	if value != "" {
+		claims[name] = value
+	}
+}
@@ -0,0 +1,123 @@
+package domain_models
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOIDCScopes(t *testing.T) {
+	scopes, err := ParseOIDCScopes("openid  email offline_access email profile")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"openid", "email", "profile"}, scopes)
+
+	_, err = ParseOIDCScopes("email profile")
+	assert.Error(t, err)
+}
+
+func TestOIDCScopesInclude(t *testing.T) {
+	assert.True(t, OIDCScopesInclude([]string{"openid", "email"}, []string{"email"}))
+	assert.True(t, OIDCScopesInclude([]string{"openid"}, nil))
+	assert.False(t, OIDCScopesInclude([]string{"openid"}, []string{"openid", "phone"}))
+}
+
+func TestOIDCClient(t *testing.T) {
+	client := &OIDCClient{
+		ClientID:     "client",
+		SecretHash:   HashOIDCSecret("secret"),
+		RedirectURIs: []string{"https://app.example.com/callback"},
+	}
+
+	assert.False(t, client.IsPublic())
+	assert.True(t, client.CheckSecret("secret"))
+	assert.False(t, client.CheckSecret("other"))
+	assert.False(t, client.CheckSecret(""))
+	assert.True(t, client.AllowsRedirectURI("https://app.example.com/callback"))
+	assert.False(t, client.AllowsRedirectURI("https://app.example.com/callback/other"))
+
+	public := &OIDCClient{ClientID: "public"}
+	assert.True(t, public.IsPublic())
+	assert.True(t, public.CheckSecret(""))
+	assert.False(t, public.CheckSecret("secret"))
+}
+
+func TestOIDCAuthorizationRequestPayload(t *testing.T) {
+	request := &OIDCAuthorizationRequest{
+		ProfileID:     42,
+		ClientID:      "client",
+		RedirectURI:   "https://app.example.com/callback?a=b&c=d",
+		Scopes:        []string{"openid", "email"},
+		State:         "state with spaces",
+		Nonce:         "nonce",
+		CodeChallenge: "challenge",
+	}
+
+	parsed, err := ParseOIDCAuthorizationRequest(request.Payload())
+	assert.NoError(t, err)
+	assert.Equal(t, request, parsed)
+
+	_, err = ParseOIDCAuthorizationRequest("client_id=client")
+	assert.Error(t, err)
+}
+
+func TestVerifyOIDCCodeChallenge(t *testing.T) {
+	// The example of RFC 7636, appendix B.
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	assert.True(t, VerifyOIDCCodeChallenge(verifier, challenge))
+	assert.False(t, VerifyOIDCCodeChallenge(verifier+"x", challenge))
+	assert.False(t, VerifyOIDCCodeChallenge("short", challenge))
+}
+
+func TestOIDCUserInfo(t *testing.T) {
+	user := &User{
+		ID:          7,
+		FirstName:   "Ivan",
+		Surname:     "Ivanov",
+		Gender:      Male,
+		Birthday:    time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		Login:       "ivan@mailhub.su",
+		AvatarID:    "https://mailhub.su/photos/avatar.png",
+		PhoneNumber: "+79990000000",
+	}
+
+	assert.Equal(t, map[string]interface{}{"sub": "7"}, OIDCUserInfo(user, []string{OIDCScopeOpenID}))
+
+	assert.Equal(t, map[string]interface{}{
+		"sub":                "7",
+		"name":               "Ivan Ivanov",
+		"given_name":         "Ivan",
+		"family_name":        "Ivanov",
+		"preferred_username": "ivan@mailhub.su",
+		"picture":            "https://mailhub.su/photos/avatar.png",
+		"gender":             "male",
+		"birthdate":          "1990-05-17",
+		"email":              "ivan@mailhub.su",
+		"email_verified":     true,
+		"phone_number":       "+79990000000",
+	}, OIDCUserInfo(user, []string{OIDCScopeOpenID, OIDCScopeProfile, OIDCScopeEmail, OIDCScopePhone}))
+}
+
+func TestIsValidOIDCRedirectURI(t *testing.T) {
+	valid := []string{"https://app.example.com/callback", "https://app.example.com/callback?a=b", "http://localhost:3000/callback"}
+	for _, uri := range valid {
+		assert.True(t, IsValidOIDCRedirectURI(uri), uri)
+	}
+
+	invalid := []string{"", "/callback", "http://app.example.com/callback", "https://app.example.com/callback#a",
+		"https://user@app.example.com/callback", "javascript:alert(1)", "https://" + strings.Repeat("a", OIDCRedirectURIMaxLength)}
+	for _, uri := range invalid {
+		assert.False(t, IsValidOIDCRedirectURI(uri), uri)
+	}
+}
+
+func TestOIDCRedirect(t *testing.T) {
+	params := url.Values{"code": {"abc"}, "state": {"x y"}}
+
+	assert.Equal(t, "https://app.example.com/callback?code=abc&state=x+y", OIDCRedirect("https://app.example.com/callback", params))
+	assert.Equal(t, "https://app.example.com/callback?a=b&code=abc&state=x+y", OIDCRedirect("https://app.example.com/callback?a=b", params))
+}
@@ -0,0 +1,25 @@
+package domain_models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// PasswordResetTokenLifeTime is the time the password reset link stays valid.
+const PasswordResetTokenLifeTime = time.Hour
+
+// PasswordResetToken represents a single-use token the password of a user can be reset with.
+// Only the hash of the token is stored, the token itself is sent to the user.
+type PasswordResetToken struct {
+	TokenHash      string    // TokenHash is the SHA-256 hash of the token.
+	ProfileID      uint32    // ProfileID is the unique identifier of the user whose password can be reset.
+	ExpirationDate time.Time // ExpirationDate is the date after which the token is no longer valid.
+}
+
+// HashPasswordResetToken returns the hash the password reset token is stored with.
+func HashPasswordResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
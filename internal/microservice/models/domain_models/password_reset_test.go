@@ -0,0 +1,15 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPasswordResetToken(t *testing.T) {
+	hash := HashPasswordResetToken("token")
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashPasswordResetToken("token"))
+	assert.NotEqual(t, hash, HashPasswordResetToken("other"))
+}
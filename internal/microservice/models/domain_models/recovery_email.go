@@ -0,0 +1,32 @@
+package domain_models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecoveryEmailVerificationLifeTime is the time the recovery email verification link stays valid.
+const RecoveryEmailVerificationLifeTime = 24 * time.Hour
+
+// RecoveryEmailVerificationPayload returns the payload of the signed token verifying the recovery email of the user.
+// The token names the address, so a link sent to a previous address does not verify the current one.
+func RecoveryEmailVerificationPayload(profileID uint32, recoveryEmail string) string {
+	return strconv.FormatUint(uint64(profileID), 10) + ":" + recoveryEmail
+}
+
+// ParseRecoveryEmailVerificationPayload returns the user and the recovery email named by the payload of a verification token.
+func ParseRecoveryEmailVerificationPayload(payload string) (uint32, string, error) {
+	id, recoveryEmail, ok := strings.Cut(payload, ":")
+	if !ok || recoveryEmail == "" {
+		return 0, "", fmt.Errorf("malformed recovery email verification payload")
+	}
+
+	profileID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil || profileID == 0 {
+		return 0, "", fmt.Errorf("malformed recovery email verification payload")
+	}
+
+	return uint32(profileID), recoveryEmail, nil
+}
@@ -0,0 +1,24 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoveryEmailVerificationPayload(t *testing.T) {
+	payload := RecoveryEmailVerificationPayload(42, "user@example.com")
+	assert.Equal(t, "42:user@example.com", payload)
+
+	profileID, recoveryEmail, err := ParseRecoveryEmailVerificationPayload(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(42), profileID)
+	assert.Equal(t, "user@example.com", recoveryEmail)
+}
+
+func TestParseRecoveryEmailVerificationPayload_Malformed(t *testing.T) {
+	for _, payload := range []string{"", "42", "42:", "0:user@example.com", "id:user@example.com"} {
+		_, _, err := ParseRecoveryEmailVerificationPayload(payload)
+		assert.Error(t, err, payload)
+	}
+}
@@ -0,0 +1,93 @@
+package domain_models
+
+import "time"
+
+const (
+	// SecurityEventLogin is a sign-in attempt.
+	SecurityEventLogin = "login"
+	// SecurityEventLogout is a sign-out of the current session.
+	SecurityEventLogout = "logout"
+	// SecurityEventSessionRevoke is a sign-out of other sessions of the user.
+	SecurityEventSessionRevoke = "session_revoke"
+	// SecurityEventPasswordChange is a change of the password by the signed-in user.
+	SecurityEventPasswordChange = "password_change"
+	// SecurityEventPasswordReset is a change of the password with a reset link.
+	SecurityEventPasswordReset = "password_reset"
+	// SecurityEventRecoveryEmailChange is a change of the recovery email.
+	SecurityEventRecoveryEmailChange = "recovery_email_change"
+	// SecurityEventRecoveryEmailVerify is the confirmation of the recovery email with the link sent to it.
+	SecurityEventRecoveryEmailVerify = "recovery_email_verify"
+	// SecurityEventTwoFactorEnable is the confirmation of two-factor authentication.
+	SecurityEventTwoFactorEnable = "two_factor_enable"
+	// SecurityEventTwoFactorDisable is the disabling of two-factor authentication.
+	SecurityEventTwoFactorDisable = "two_factor_disable"
+	// SecurityEventAPITokenCreate is the creation of a personal access token.
+	SecurityEventAPITokenCreate = "api_token_create"
+	// SecurityEventAPITokenDelete is the revocation of a personal access token.
+	SecurityEventAPITokenDelete = "api_token_delete"
+	// SecurityEventAvatarChange is the upload of a new avatar.
+	SecurityEventAvatarChange = "avatar_change"
+	// SecurityEventAvatarDelete is the removal of the avatar.
+	SecurityEventAvatarDelete = "avatar_delete"
+	// SecurityEventAccountDelete is the deletion of the account.
+	SecurityEventAccountDelete = "account_delete"
+	// SecurityEventDelegateAdd is granting another user access to the mailbox.
+	SecurityEventDelegateAdd = "delegate_add"
+	// SecurityEventDelegateDelete is revoking the access of another user to the mailbox.
+	SecurityEventDelegateDelete = "delegate_delete"
+)
+
+const (
+	// SecurityEventMethodPassword is a sign-in with the login and password.
+	SecurityEventMethodPassword = "password"
+	// SecurityEventMethodTwoFactor is a sign-in completed with the second factor.
+	SecurityEventMethodTwoFactor = "two_factor"
+	// SecurityEventMethodVK is a sign-in with a VK account.
+	SecurityEventMethodVK = "vk"
+	// SecurityEventMethodGmail is a sign-in with a Gmail account.
+	SecurityEventMethodGmail = "gmail"
+)
+
+const (
+	// SecurityEventsDefaultLimit is the number of security events returned when no limit is given.
+	SecurityEventsDefaultLimit = 50
+	// SecurityEventsMaxLimit is the largest number of security events returned at once.
+	SecurityEventsMaxLimit = 200
+)
+
+// SecurityEvent represents a security relevant action on an account, recorded in the append-only audit log.
+type SecurityEvent struct {
+	ID           uint64    // ID is the unique identifier of the event, later events have greater identifiers.
+	ProfileID    uint32    // ProfileID is the unique identifier of the account, zero if the account is unknown.
+	Login        string    // Login is the login given at sign-in, used to find the account if ProfileID is zero.
+	Type         string    // Type is the kind of the action, one of the SecurityEvent constants.
+	Method       string    // Method is the sign-in method for sign-in events.
+	Success      bool      // Success is whether the action succeeded.
+	Details      string    // Details describe the action, e.g. the delegate given access to the mailbox.
+	IPAddress    string    // IPAddress is the address of the client, if known.
+	UserAgent    string    // UserAgent is the device of the client, if known.
+	CreationDate time.Time // CreationDate is the date of the event.
+}
+
+// SecurityEventFilter selects security events across accounts, zero fields do not restrict the selection.
+type SecurityEventFilter struct {
+	ProfileID uint32    // ProfileID selects the events of the account.
+	Login     string    // Login selects the events with the login given at sign-in.
+	Type      string    // Type selects the events of the kind.
+	Since     time.Time // Since selects the events from the date on.
+	Until     time.Time // Until selects the events before the date.
+	BeforeID  uint64    // BeforeID selects the events recorded before the event, to read the log page by page.
+	Limit     int       // Limit is the largest number of events returned.
+}
+
+// SecurityEventsLimit returns the number of security events to return for the requested limit.
+func SecurityEventsLimit(limit int) int {
+	if limit <= 0 {
+		return SecurityEventsDefaultLimit
+	}
+	if limit > SecurityEventsMaxLimit {
+		return SecurityEventsMaxLimit
+	}
+
+	return limit
+}
@@ -0,0 +1,14 @@
+package domain_models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityEventsLimit(t *testing.T) {
+	assert.Equal(t, SecurityEventsDefaultLimit, SecurityEventsLimit(0))
+	assert.Equal(t, SecurityEventsDefaultLimit, SecurityEventsLimit(-5))
+	assert.Equal(t, 10, SecurityEventsLimit(10))
+	assert.Equal(t, SecurityEventsMaxLimit, SecurityEventsLimit(SecurityEventsMaxLimit+1))
+}
@@ -1,6 +1,27 @@
 package domain_models
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	// SessionDeviceMaxLength is the maximum length of the stored device description.
+	SessionDeviceMaxLength = 100
+	// SessionIPAddressMaxLength is the maximum length of the stored IP address.
+	SessionIPAddressMaxLength = 50
+	// SessionLastSeenInterval is how often the last seen date of an active session is updated.
+	SessionLastSeenInterval = time.Minute
+	// SessionLifeTime is the time a session stays valid without requests, every request extends it.
+	SessionLifeTime = 24 * time.Hour
+	// SessionRememberLifeTime is the time a session created with "remember me" stays valid without requests.
+	SessionRememberLifeTime = 14 * 24 * time.Hour
+	// SessionMaxLifeTime is the time after which a session expires however active it is.
+	SessionMaxLifeTime = 30 * 24 * time.Hour
+	// SessionCleanupInterval is how often the expired sessions are removed.
+	SessionCleanupInterval = 10 * time.Minute
+)
 
 // Session represents a user's session information.
 type Session struct {
@@ -8,6 +29,58 @@ type Session struct {
 	UserID       uint32    // UserID specifies the ID of the user this session belongs to.
 	CreationDate time.Time // CreationDate is the timestamp when the session was created.
 	Device       string    // Device describes the device used to initiate the session, e.g., 'web', 'mobile'.
-	LifeTime     int       // LifeTime indicates the duration (in seconds) for which the session is valid.
+	LifeTime     int       // LifeTime indicates the duration (in seconds) for which the session is valid after the last request.
 	CsrfToken    string    // CsrfToken represents the Cross-Site Request Forgery (CSRF) token associated with the session.
+	IPAddress    string    // IPAddress is the address of the client the session was created from.
+	LastSeenDate time.Time // LastSeenDate is the timestamp of the last request made with the session.
+}
+
+// SessionLifeTimeSeconds returns the lifetime in seconds a new session is created with.
+func SessionLifeTimeSeconds(rememberMe bool) int {
+	if rememberMe {
+		return int(SessionRememberLifeTime.Seconds())
+	}
+
+	return int(SessionLifeTime.Seconds())
+}
+
+// RememberMe checks if the session was created with "remember me", such sessions outlive the browser.
+func (s *Session) RememberMe() bool {
+	return s.LifeTime > int(SessionLifeTime.Seconds())
+}
+
+// ExpirationDate returns the date the session expires unless another request extends it.
+// The lifetime slides with the last seen date but never goes past SessionMaxLifeTime from the creation.
+func (s *Session) ExpirationDate() time.Time {
+	idleExpiration := s.LastSeenDate.Add(time.Duration(s.LifeTime) * time.Second)
+	maxExpiration := s.CreationDate.Add(SessionMaxLifeTime)
+
+	if idleExpiration.Before(maxExpiration) {
+		return idleExpiration
+	}
+
+	return maxExpiration
+}
+
+// Expired checks if the session is no longer valid at the given time.
+func (s *Session) Expired(now time.Time) bool {
+	return !now.Before(s.ExpirationDate())
+}
+
+// SessionPublicID returns the identifier the session is shown to the user with.
+// The session ID itself is the cookie value, so it is never sent back in session lists.
+func SessionPublicID(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+
+	return hex.EncodeToString(sum[:16])
+}
+
+// TruncateSessionField cuts a client provided value to the length stored in the database.
+func TruncateSessionField(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) > maxLength {
+		return string(runes[:maxLength])
+	}
+
+	return value
 }
@@ -0,0 +1,58 @@
+package domain_models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionPublicID(t *testing.T) {
+	publicID := SessionPublicID("0123456789abcdef")
+
+	assert.Len(t, publicID, 32)
+	assert.Equal(t, publicID, SessionPublicID("0123456789abcdef"))
+	assert.NotEqual(t, publicID, SessionPublicID("fedcba9876543210"))
+	assert.NotContains(t, publicID, "0123456789abcdef")
+}
+
+func TestTruncateSessionField(t *testing.T) {
+	assert.Equal(t, "Mozilla", TruncateSessionField("Mozilla", SessionDeviceMaxLength))
+	assert.Equal(t, strings.Repeat("я", 3), TruncateSessionField(strings.Repeat("я", 5), 3))
+}
+
+func TestSessionLifeTimeSeconds(t *testing.T) {
+	assert.Equal(t, 24*60*60, SessionLifeTimeSeconds(false))
+	assert.Equal(t, 14*24*60*60, SessionLifeTimeSeconds(true))
+
+	assert.False(t, (&Session{LifeTime: SessionLifeTimeSeconds(false)}).RememberMe())
+	assert.True(t, (&Session{LifeTime: SessionLifeTimeSeconds(true)}).RememberMe())
+}
+
+func TestSessionExpiration(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Sliding", func(t *testing.T) {
+		session := Session{CreationDate: now.Add(-72 * time.Hour), LastSeenDate: now.Add(-time.Hour), LifeTime: SessionLifeTimeSeconds(false)}
+
+		assert.Equal(t, now.Add(23*time.Hour), session.ExpirationDate())
+		assert.False(t, session.Expired(now))
+		assert.True(t, session.Expired(now.Add(23*time.Hour)))
+	})
+
+	t.Run("Idle", func(t *testing.T) {
+		session := Session{CreationDate: now.Add(-48 * time.Hour), LastSeenDate: now.Add(-25 * time.Hour), LifeTime: SessionLifeTimeSeconds(false)}
+
+		assert.True(t, session.Expired(now))
+	})
+
+	t.Run("MaxLifeTime", func(t *testing.T) {
+		creationDate := now.Add(-SessionMaxLifeTime + time.Hour)
+		session := Session{CreationDate: creationDate, LastSeenDate: now, LifeTime: SessionLifeTimeSeconds(true)}
+
+		assert.Equal(t, creationDate.Add(SessionMaxLifeTime), session.ExpirationDate())
+		assert.False(t, session.Expired(now))
+		assert.True(t, session.Expired(now.Add(time.Hour)))
+	})
+}
@@ -0,0 +1,38 @@
+package domain_models
+
+import "time"
+
+const (
+	// TwoFactorIssuer is the issuer shown in authenticator applications.
+	TwoFactorIssuer = "MailHub"
+	// RecoveryCodesCount is the number of one-time recovery codes issued when two-factor authentication is enabled.
+	RecoveryCodesCount = 10
+	// TwoFactorChallengeLifeTime is the time given to enter the code after the first login step.
+	TwoFactorChallengeLifeTime = 5 * time.Minute
+	// TwoFactorChallengeMaxAttempts is the number of wrong codes after which the login has to be started again.
+	TwoFactorChallengeMaxAttempts = 5
+)
+
+// TwoFactor represents the two-factor authentication settings of a user.
+type TwoFactor struct {
+	ProfileID        uint32    // ProfileID is the unique identifier of the user.
+	Secret           string    // Secret is the base32 encoded TOTP secret.
+	Enabled          bool      // Enabled is set once the user confirmed the secret with a valid code.
+	LastUsedStep     int64     // LastUsedStep is the last accepted TOTP time step, a code can not be used twice.
+	CreationDate     time.Time // CreationDate is the date when the setup was started.
+	ConfirmationDate time.Time // ConfirmationDate is the date when the setup was confirmed.
+}
+
+// TwoFactorSetup represents the data the user needs to add the account to an authenticator application.
+type TwoFactorSetup struct {
+	Secret string // Secret is the base32 encoded TOTP secret to enter manually.
+	URI    string // URI is the otpauth URI to show as a QR code.
+}
+
+// TwoFactorChallenge represents a login waiting for the second factor.
+type TwoFactorChallenge struct {
+	ID             string    // ID is the unique identifier of the challenge given to the client.
+	ProfileID      uint32    // ProfileID is the unique identifier of the user logging in.
+	Attempts       int       // Attempts is the number of wrong codes entered.
+	ExpirationDate time.Time // ExpirationDate is the date after which the login has to be started again.
+}
@@ -0,0 +1,13 @@
+package domain_models
+
+const (
+	// UnifiedSourceMailHub marks the emails of the MailHub mailbox of the user.
+	UnifiedSourceMailHub = "mailhub"
+	// UnifiedSourceGMail marks the emails of the Gmail mailbox of the user.
+	UnifiedSourceGMail = "gmail"
+
+	// UnifiedInboxLimit is the default number of emails on one page of the unified inbox.
+	UnifiedInboxLimit = 20
+	// UnifiedInboxMaxLimit is the maximum number of emails on one page of the unified inbox.
+	UnifiedInboxMaxLimit = 100
+)
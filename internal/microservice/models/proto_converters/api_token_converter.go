@@ -0,0 +1,41 @@
+package proto_converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	domain "mail/internal/microservice/models/domain_models"
+	grpc "mail/internal/microservice/user/proto"
+)
+
+// APITokenConvertCoreInProto converts a personal access token from the application core to the gRPC format.
+// The hash of the token is never sent, a token that was never used has no last used date.
+func APITokenConvertCoreInProto(tokenModelCore *domain.APIToken) *grpc.APIToken {
+	tokenModelProto := &grpc.APIToken{
+		Id:             tokenModelCore.ID,
+		Name:           tokenModelCore.Name,
+		Scopes:         tokenModelCore.Scopes,
+		CreationDate:   timestamppb.New(tokenModelCore.CreationDate),
+		ExpirationDate: timestamppb.New(tokenModelCore.ExpirationDate),
+	}
+	if !tokenModelCore.LastUsedDate.IsZero() {
+		tokenModelProto.LastUsedDate = timestamppb.New(tokenModelCore.LastUsedDate)
+	}
+
+	return tokenModelProto
+}
+
+// APITokenConvertProtoInCore converts a personal access token from the gRPC format to the application core.
+func APITokenConvertProtoInCore(tokenModelProto *grpc.APIToken) *domain.APIToken {
+	tokenModelCore := &domain.APIToken{
+		ID:             tokenModelProto.Id,
+		Name:           tokenModelProto.Name,
+		Scopes:         tokenModelProto.Scopes,
+		CreationDate:   tokenModelProto.CreationDate.AsTime(),
+		ExpirationDate: tokenModelProto.ExpirationDate.AsTime(),
+	}
+	if tokenModelProto.LastUsedDate != nil {
+		tokenModelCore.LastUsedDate = tokenModelProto.LastUsedDate.AsTime()
+	}
+
+	return tokenModelCore
+}
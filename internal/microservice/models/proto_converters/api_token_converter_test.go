@@ -0,0 +1,80 @@
+package proto_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	domain "mail/internal/microservice/models/domain_models"
+	grpc "mail/internal/microservice/user/proto"
+)
+
+func TestAPITokenConvertCoreInProto(t *testing.T) {
+	creationDate := time.Now()
+	expirationDate := creationDate.Add(24 * time.Hour)
+
+	t.Run("Used", func(t *testing.T) {
+		tokenModelCore := domain.APIToken{
+			ID:             1,
+			TokenHash:      "hash",
+			ProfileID:      2,
+			Name:           "backup",
+			Scopes:         []string{domain.APITokenScopeMailRead},
+			CreationDate:   creationDate,
+			ExpirationDate: expirationDate,
+			LastUsedDate:   creationDate,
+		}
+
+		expectedProto := &grpc.APIToken{
+			Id:             1,
+			Name:           "backup",
+			Scopes:         []string{domain.APITokenScopeMailRead},
+			CreationDate:   timestamppb.New(creationDate),
+			ExpirationDate: timestamppb.New(expirationDate),
+			LastUsedDate:   timestamppb.New(creationDate),
+		}
+
+		assert.Equal(t, expectedProto, APITokenConvertCoreInProto(&tokenModelCore))
+	})
+
+	t.Run("NeverUsed", func(t *testing.T) {
+		tokenModelCore := domain.APIToken{ID: 1, Name: "backup", CreationDate: creationDate, ExpirationDate: expirationDate}
+
+		assert.Nil(t, APITokenConvertCoreInProto(&tokenModelCore).LastUsedDate)
+	})
+}
+
+func TestAPITokenConvertProtoInCore(t *testing.T) {
+	creationDate := time.Now().UTC()
+	expirationDate := creationDate.Add(24 * time.Hour)
+
+	t.Run("Used", func(t *testing.T) {
+		tokenModelProto := grpc.APIToken{
+			Id:             1,
+			Name:           "backup",
+			Scopes:         []string{domain.APITokenScopeMailSend},
+			CreationDate:   timestamppb.New(creationDate),
+			ExpirationDate: timestamppb.New(expirationDate),
+			LastUsedDate:   timestamppb.New(creationDate),
+		}
+
+		expectedCore := &domain.APIToken{
+			ID:             1,
+			Name:           "backup",
+			Scopes:         []string{domain.APITokenScopeMailSend},
+			CreationDate:   creationDate,
+			ExpirationDate: expirationDate,
+			LastUsedDate:   creationDate,
+		}
+
+		assert.Equal(t, expectedCore, APITokenConvertProtoInCore(&tokenModelProto))
+	})
+
+	t.Run("NeverUsed", func(t *testing.T) {
+		tokenModelProto := grpc.APIToken{Id: 1, CreationDate: timestamppb.New(creationDate), ExpirationDate: timestamppb.New(expirationDate)}
+
+		assert.True(t, APITokenConvertProtoInCore(&tokenModelProto).LastUsedDate.IsZero())
+	})
+}
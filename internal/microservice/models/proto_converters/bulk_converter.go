@@ -0,0 +1,34 @@
+package proto_converters
+
+import (
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// BulkRequestConvertProtoInCore converts a bulk request from the gRPC format to the application core.
+func BulkRequestConvertProtoInCore(requestModelProto *grpc.BulkEmailsRequest) *domain.BulkRequest {
+	return &domain.BulkRequest{
+		Action:   requestModelProto.Action,
+		EmailIDs: requestModelProto.EmailIds,
+		FolderID: requestModelProto.FolderId,
+		LabelID:  requestModelProto.LabelId,
+	}
+}
+
+// BulkResultConvertCoreInProto converts a bulk result from the application core to the gRPC format.
+func BulkResultConvertCoreInProto(resultModelCore *domain.BulkResult) *grpc.BulkEmailResult {
+	return &grpc.BulkEmailResult{
+		EmailId: resultModelCore.EmailID,
+		Success: resultModelCore.Success,
+		Error:   resultModelCore.Error,
+	}
+}
+
+// BulkResultConvertProtoInCore converts a bulk result from the gRPC format to the application core.
+func BulkResultConvertProtoInCore(resultModelProto *grpc.BulkEmailResult) *domain.BulkResult {
+	return &domain.BulkResult{
+		EmailID: resultModelProto.EmailId,
+		Success: resultModelProto.Success,
+		Error:   resultModelProto.Error,
+	}
+}
@@ -0,0 +1,26 @@
+package proto_converters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestBulkRequestConvertProtoInCore(t *testing.T) {
+	requestProto := grpc.BulkEmailsRequest{Action: "move", EmailIds: []uint64{1, 2}, FolderId: 3, LabelId: 4, Login: "test@mailhub.su"}
+
+	expectedCore := &domain.BulkRequest{Action: "move", EmailIDs: []uint64{1, 2}, FolderID: 3, LabelID: 4}
+
+	assert.Equal(t, expectedCore, BulkRequestConvertProtoInCore(&requestProto))
+}
+
+func TestBulkResultConvert(t *testing.T) {
+	resultCore := &domain.BulkResult{EmailID: 1, Success: false, Error: "email not found"}
+
+	resultProto := BulkResultConvertCoreInProto(resultCore)
+	assert.Equal(t, &grpc.BulkEmailResult{EmailId: 1, Success: false, Error: "email not found"}, resultProto)
+	assert.Equal(t, resultCore, BulkResultConvertProtoInCore(resultProto))
+}
@@ -10,38 +10,46 @@ import (
 // EmailConvertCoreInProto converts an email model from the application core to the gRPC format.
 func EmailConvertCoreInProto(emailModelCore *domain.Email) *grpc.Email {
 	return &grpc.Email{
-		Id:             emailModelCore.ID,
-		Topic:          emailModelCore.Topic,
-		Text:           emailModelCore.Text,
-		PhotoID:        emailModelCore.PhotoID,
-		ReadStatus:     emailModelCore.ReadStatus,
-		Flag:           emailModelCore.Flag,
-		Deleted:        emailModelCore.Deleted,
-		DateOfDispatch: timestamppb.New(emailModelCore.DateOfDispatch),
-		ReplyToEmailID: emailModelCore.ReplyToEmailID,
-		DraftStatus:    emailModelCore.DraftStatus,
-		SpamStatus:     emailModelCore.SpamStatus,
-		SenderEmail:    emailModelCore.SenderEmail,
-		RecipientEmail: emailModelCore.RecipientEmail,
+		Id:              emailModelCore.ID,
+		Topic:           emailModelCore.Topic,
+		Text:            emailModelCore.Text,
+		PhotoID:         emailModelCore.PhotoID,
+		ReadStatus:      emailModelCore.ReadStatus,
+		Flag:            emailModelCore.Flag,
+		Deleted:         emailModelCore.Deleted,
+		DateOfDispatch:  timestamppb.New(emailModelCore.DateOfDispatch),
+		ReplyToEmailID:  emailModelCore.ReplyToEmailID,
+		DraftStatus:     emailModelCore.DraftStatus,
+		SpamStatus:      emailModelCore.SpamStatus,
+		SenderEmail:     emailModelCore.SenderEmail,
+		RecipientEmail:  emailModelCore.RecipientEmail,
+		ListId:          emailModelCore.ListID,
+		ListUnsubscribe: emailModelCore.ListUnsubscribe,
+		Labels:          LabelsConvertCoreInProto(emailModelCore.Labels),
+		SentByEmail:     emailModelCore.SentByEmail,
 	}
 }
 
 // EmailConvertProtoInCore converts an email model from the gRPC format to the application core.
 func EmailConvertProtoInCore(emailModelProto *grpc.Email) *domain.Email {
 	return &domain.Email{
-		ID:             emailModelProto.Id,
-		Topic:          emailModelProto.Topic,
-		Text:           emailModelProto.Text,
-		PhotoID:        emailModelProto.PhotoID,
-		ReadStatus:     emailModelProto.ReadStatus,
-		Flag:           emailModelProto.Flag,
-		Deleted:        emailModelProto.Deleted,
-		DateOfDispatch: emailModelProto.DateOfDispatch.AsTime(),
-		ReplyToEmailID: emailModelProto.ReplyToEmailID,
-		DraftStatus:    emailModelProto.DraftStatus,
-		SpamStatus:     emailModelProto.SpamStatus,
-		SenderEmail:    emailModelProto.SenderEmail,
-		RecipientEmail: emailModelProto.RecipientEmail,
+		ID:              emailModelProto.Id,
+		Topic:           emailModelProto.Topic,
+		Text:            emailModelProto.Text,
+		PhotoID:         emailModelProto.PhotoID,
+		ReadStatus:      emailModelProto.ReadStatus,
+		Flag:            emailModelProto.Flag,
+		Deleted:         emailModelProto.Deleted,
+		DateOfDispatch:  emailModelProto.DateOfDispatch.AsTime(),
+		ReplyToEmailID:  emailModelProto.ReplyToEmailID,
+		DraftStatus:     emailModelProto.DraftStatus,
+		SpamStatus:      emailModelProto.SpamStatus,
+		SenderEmail:     emailModelProto.SenderEmail,
+		RecipientEmail:  emailModelProto.RecipientEmail,
+		ListID:          emailModelProto.ListId,
+		ListUnsubscribe: emailModelProto.ListUnsubscribe,
+		Labels:          LabelsConvertProtoInCore(emailModelProto.Labels),
+		SentByEmail:     emailModelProto.SentByEmail,
 	}
 }
 
@@ -0,0 +1,43 @@
+package proto_converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "mail/internal/microservice/auth/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// ExternalAccountConvertCoreInProto converts a linked mailbox from the application core to the gRPC format.
+// The password is sent only when it is set, that is when the gateway needs it to connect to the mail servers.
+func ExternalAccountConvertCoreInProto(accountModelCore *domain.ExternalAccount) *grpc.ExternalAccount {
+	return &grpc.ExternalAccount{
+		Id:           accountModelCore.ID,
+		Email:        accountModelCore.Email,
+		ImapHost:     accountModelCore.IMAPHost,
+		ImapPort:     accountModelCore.IMAPPort,
+		ImapTlsMode:  accountModelCore.IMAPTLSMode,
+		SmtpHost:     accountModelCore.SMTPHost,
+		SmtpPort:     accountModelCore.SMTPPort,
+		SmtpTlsMode:  accountModelCore.SMTPTLSMode,
+		Username:     accountModelCore.Username,
+		Password:     accountModelCore.Password,
+		CreationDate: timestamppb.New(accountModelCore.CreationDate),
+	}
+}
+
+// ExternalAccountConvertProtoInCore converts a linked mailbox from the gRPC format to the application core.
+func ExternalAccountConvertProtoInCore(accountModelProto *grpc.ExternalAccount) *domain.ExternalAccount {
+	return &domain.ExternalAccount{
+		ID:           accountModelProto.Id,
+		Email:        accountModelProto.Email,
+		IMAPHost:     accountModelProto.ImapHost,
+		IMAPPort:     accountModelProto.ImapPort,
+		IMAPTLSMode:  accountModelProto.ImapTlsMode,
+		SMTPHost:     accountModelProto.SmtpHost,
+		SMTPPort:     accountModelProto.SmtpPort,
+		SMTPTLSMode:  accountModelProto.SmtpTlsMode,
+		Username:     accountModelProto.Username,
+		Password:     accountModelProto.Password,
+		CreationDate: accountModelProto.CreationDate.AsTime(),
+	}
+}
@@ -0,0 +1,32 @@
+package proto_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestExternalAccountConvert(t *testing.T) {
+	accountModelCore := &domain.ExternalAccount{
+		ID:           1,
+		Email:        "user@example.com",
+		IMAPHost:     "imap.example.com",
+		IMAPPort:     993,
+		IMAPTLSMode:  domain.ExternalAccountTLSModeTLS,
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     465,
+		SMTPTLSMode:  domain.ExternalAccountTLSModeTLS,
+		Username:     "user",
+		Password:     "password",
+		CreationDate: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	accountModelProto := ExternalAccountConvertCoreInProto(accountModelCore)
+	assert.Equal(t, "imap.example.com", accountModelProto.ImapHost)
+	assert.Equal(t, "password", accountModelProto.Password)
+
+	assert.Equal(t, accountModelCore, ExternalAccountConvertProtoInCore(accountModelProto))
+}
@@ -13,6 +13,10 @@ func FolderConvertCoreInProto(folderModelCore *domain.Folder) *grpc.Folder {
 		Id:        folderModelCore.ID,
 		Name:      folderModelCore.Name,
 		ProfileId: folderModelCore.ProfileId,
+		ParentId:  folderModelCore.ParentID,
+		Path:      folderModelCore.Path,
+		Total:     folderModelCore.Total,
+		Unread:    folderModelCore.Unread,
 	}
 }
 
@@ -22,6 +26,10 @@ func FolderConvertProtoInCore(folderModelProto *grpc.Folder) *domain.Folder {
 		ID:        folderModelProto.Id,
 		Name:      folderModelProto.Name,
 		ProfileId: folderModelProto.ProfileId,
+		ParentID:  folderModelProto.ParentId,
+		Path:      folderModelProto.Path,
+		Total:     folderModelProto.Total,
+		Unread:    folderModelProto.Unread,
 	}
 }
 
@@ -50,6 +58,7 @@ func ObjectEmailConvertProtoInCore(folderEmailModelProto *grpc.ObjectEmail) *dom
 		SpamStatus:     folderEmailModelProto.SpamStatus,
 		SenderEmail:    folderEmailModelProto.SenderEmail,
 		RecipientEmail: folderEmailModelProto.RecipientEmail,
+		Labels:         FolderLabelsConvertProtoInCore(folderEmailModelProto.Labels),
 	}
 }
 
@@ -69,6 +78,7 @@ func ObjectEmailConvertCoreInProto(folderEmailModelCore *domain.Email) *grpc.Obj
 		SpamStatus:     folderEmailModelCore.SpamStatus,
 		SenderEmail:    folderEmailModelCore.SenderEmail,
 		RecipientEmail: folderEmailModelCore.RecipientEmail,
+		Labels:         FolderLabelsConvertCoreInProto(folderEmailModelCore.Labels),
 	}
 }
 
@@ -14,14 +14,22 @@ import (
 func TestFolderConvertCoreInProto(t *testing.T) {
 	folderModelCore := domain.Folder{
 		ID:        1,
-		Name:      "Inbox",
+		Name:      "Reports",
 		ProfileId: 123,
+		ParentID:  2,
+		Path:      "Work/Reports",
+		Total:     5,
+		Unread:    1,
 	}
 
 	expectedProto := &grpc.Folder{
 		Id:        1,
-		Name:      "Inbox",
+		Name:      "Reports",
 		ProfileId: 123,
+		ParentId:  2,
+		Path:      "Work/Reports",
+		Total:     5,
+		Unread:    1,
 	}
 
 	actualProto := FolderConvertCoreInProto(&folderModelCore)
@@ -31,14 +39,22 @@ func TestFolderConvertCoreInProto(t *testing.T) {
 func TestFolderConvertProtoInCore(t *testing.T) {
 	folderModelProto := grpc.Folder{
 		Id:        1,
-		Name:      "Inbox",
+		Name:      "Reports",
 		ProfileId: 123,
+		ParentId:  2,
+		Path:      "Work/Reports",
+		Total:     5,
+		Unread:    1,
 	}
 
 	expectedCore := &domain.Folder{
 		ID:        1,
-		Name:      "Inbox",
+		Name:      "Reports",
 		ProfileId: 123,
+		ParentID:  2,
+		Path:      "Work/Reports",
+		Total:     5,
+		Unread:    1,
 	}
 
 	actualCore := FolderConvertProtoInCore(&folderModelProto)
@@ -0,0 +1,99 @@
+package proto_converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// GMailMessageConvertCoreInProto converts the local copy of a Gmail email from the application core to the gRPC format.
+func GMailMessageConvertCoreInProto(messageModelCore *domain.GMailMessage) *grpc.GMailMessage {
+	return &grpc.GMailMessage{
+		Id:        messageModelCore.ID,
+		ThreadId:  messageModelCore.ThreadID,
+		LabelIds:  messageModelCore.LabelIDs,
+		Subject:   messageModelCore.Subject,
+		Sender:    messageModelCore.Sender,
+		Recipient: messageModelCore.Recipient,
+		Text:      messageModelCore.Text,
+		Date:      timestamppb.New(messageModelCore.Date),
+	}
+}
+
+// GMailMessageConvertProtoInCore converts the local copy of a Gmail email from the gRPC format to the application core.
+func GMailMessageConvertProtoInCore(messageModelProto *grpc.GMailMessage) *domain.GMailMessage {
+	labelIDs := messageModelProto.LabelIds
+	if labelIDs == nil {
+		labelIDs = []string{}
+	}
+
+	return &domain.GMailMessage{
+		ID:        messageModelProto.Id,
+		ThreadID:  messageModelProto.ThreadId,
+		LabelIDs:  labelIDs,
+		Subject:   messageModelProto.Subject,
+		Sender:    messageModelProto.Sender,
+		Recipient: messageModelProto.Recipient,
+		Text:      messageModelProto.Text,
+		Date:      messageModelProto.Date.AsTime(),
+	}
+}
+
+// GMailLabelConvertCoreInProto converts a Gmail label from the application core to the gRPC format.
+func GMailLabelConvertCoreInProto(labelModelCore *domain.GMailLabel) *grpc.GMailLabel {
+	return &grpc.GMailLabel{
+		Id:   labelModelCore.ID,
+		Name: labelModelCore.Name,
+		Type: labelModelCore.Type,
+	}
+}
+
+// GMailLabelConvertProtoInCore converts a Gmail label from the gRPC format to the application core.
+func GMailLabelConvertProtoInCore(labelModelProto *grpc.GMailLabel) *domain.GMailLabel {
+	return &domain.GMailLabel{
+		ID:   labelModelProto.Id,
+		Name: labelModelProto.Name,
+		Type: labelModelProto.Type,
+	}
+}
+
+// GMailSyncConvertCoreInProto converts the changes of a Gmail mailbox from the application core to the gRPC format.
+func GMailSyncConvertCoreInProto(syncModelCore *domain.GMailSync) *grpc.GMailSync {
+	syncModelProto := &grpc.GMailSync{
+		Login:      syncModelCore.Login,
+		HistoryId:  syncModelCore.HistoryID,
+		Full:       syncModelCore.Full,
+		Messages:   make([]*grpc.GMailMessage, len(syncModelCore.Messages)),
+		DeletedIds: syncModelCore.DeletedIDs,
+		Labels:     make([]*grpc.GMailLabel, len(syncModelCore.Labels)),
+	}
+	for i, m := range syncModelCore.Messages {
+		syncModelProto.Messages[i] = GMailMessageConvertCoreInProto(m)
+	}
+	for i, l := range syncModelCore.Labels {
+		syncModelProto.Labels[i] = GMailLabelConvertCoreInProto(l)
+	}
+
+	return syncModelProto
+}
+
+// GMailSyncConvertProtoInCore converts the changes of a Gmail mailbox from the gRPC format to the application core.
+func GMailSyncConvertProtoInCore(syncModelProto *grpc.GMailSync) *domain.GMailSync {
+	syncModelCore := &domain.GMailSync{
+		Login:      syncModelProto.Login,
+		HistoryID:  syncModelProto.HistoryId,
+		Full:       syncModelProto.Full,
+		Messages:   make([]*domain.GMailMessage, len(syncModelProto.Messages)),
+		DeletedIDs: syncModelProto.DeletedIds,
+		Labels:     make([]*domain.GMailLabel, len(syncModelProto.Labels)),
+	}
+	for i, m := range syncModelProto.Messages {
+		syncModelCore.Messages[i] = GMailMessageConvertProtoInCore(m)
+	}
+	for i, l := range syncModelProto.Labels {
+		syncModelCore.Labels[i] = GMailLabelConvertProtoInCore(l)
+	}
+
+	return syncModelCore
+}
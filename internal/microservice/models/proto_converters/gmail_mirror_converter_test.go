@@ -0,0 +1,44 @@
+package proto_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestGMailMessageConvert(t *testing.T) {
+	messageModelCore := &domain.GMailMessage{
+		ID:        "18c1",
+		ThreadID:  "18c0",
+		LabelIDs:  []string{domain.GMailLabelInbox},
+		Subject:   "Hello",
+		Sender:    "friend@gmail.com",
+		Recipient: "user@gmail.com",
+		Text:      "<p>hi</p>",
+		Date:      time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, messageModelCore, GMailMessageConvertProtoInCore(GMailMessageConvertCoreInProto(messageModelCore)))
+	assert.Equal(t, []string{}, GMailMessageConvertProtoInCore(&grpc.GMailMessage{Id: "18c2"}).LabelIDs)
+}
+
+func TestGMailSyncConvert(t *testing.T) {
+	syncModelCore := &domain.GMailSync{
+		Login:     "user@gmail.com",
+		HistoryID: 1024,
+		Full:      true,
+		Messages: []*domain.GMailMessage{{
+			ID:       "18c1",
+			LabelIDs: []string{domain.GMailLabelSent},
+			Date:     time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		}},
+		DeletedIDs: []string{"18b9"},
+		Labels:     []*domain.GMailLabel{{ID: "Label_1", Name: "Work", Type: "user"}},
+	}
+
+	assert.Equal(t, syncModelCore, GMailSyncConvertProtoInCore(GMailSyncConvertCoreInProto(syncModelCore)))
+}
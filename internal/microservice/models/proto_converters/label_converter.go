@@ -0,0 +1,73 @@
+package proto_converters
+
+import (
+	grpc "mail/internal/microservice/email/proto"
+	folderGrpc "mail/internal/microservice/folder/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// LabelConvertCoreInProto converts a label model from the application core to the gRPC format.
+func LabelConvertCoreInProto(labelModelCore *domain.Label) *grpc.Label {
+	return &grpc.Label{
+		Id:        labelModelCore.ID,
+		ProfileId: labelModelCore.ProfileID,
+		Name:      labelModelCore.Name,
+		Color:     labelModelCore.Color,
+	}
+}
+
+// LabelConvertProtoInCore converts a label model from the gRPC format to the application core.
+func LabelConvertProtoInCore(labelModelProto *grpc.Label) *domain.Label {
+	return &domain.Label{
+		ID:        labelModelProto.Id,
+		ProfileID: labelModelProto.ProfileId,
+		Name:      labelModelProto.Name,
+		Color:     labelModelProto.Color,
+	}
+}
+
+// LabelsConvertCoreInProto converts a list of label models from the application core to the gRPC format.
+func LabelsConvertCoreInProto(labelsModelCore []*domain.Label) []*grpc.Label {
+	var labelsProto []*grpc.Label
+	for _, label := range labelsModelCore {
+		labelsProto = append(labelsProto, LabelConvertCoreInProto(label))
+	}
+	return labelsProto
+}
+
+// LabelsConvertProtoInCore converts a list of label models from the gRPC format to the application core.
+func LabelsConvertProtoInCore(labelsModelProto []*grpc.Label) []*domain.Label {
+	var labelsCore []*domain.Label
+	for _, label := range labelsModelProto {
+		labelsCore = append(labelsCore, LabelConvertProtoInCore(label))
+	}
+	return labelsCore
+}
+
+// FolderLabelsConvertCoreInProto converts a list of label models from the application core to the gRPC format of the folder service.
+func FolderLabelsConvertCoreInProto(labelsModelCore []*domain.Label) []*folderGrpc.ObjectLabel {
+	var labelsProto []*folderGrpc.ObjectLabel
+	for _, label := range labelsModelCore {
+		labelsProto = append(labelsProto, &folderGrpc.ObjectLabel{
+			Id:        label.ID,
+			ProfileId: label.ProfileID,
+			Name:      label.Name,
+			Color:     label.Color,
+		})
+	}
+	return labelsProto
+}
+
+// FolderLabelsConvertProtoInCore converts a list of label models from the gRPC format of the folder service to the application core.
+func FolderLabelsConvertProtoInCore(labelsModelProto []*folderGrpc.ObjectLabel) []*domain.Label {
+	var labelsCore []*domain.Label
+	for _, label := range labelsModelProto {
+		labelsCore = append(labelsCore, &domain.Label{
+			ID:        label.Id,
+			ProfileID: label.ProfileId,
+			Name:      label.Name,
+			Color:     label.Color,
+		})
+	}
+	return labelsCore
+}
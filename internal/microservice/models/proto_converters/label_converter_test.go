@@ -0,0 +1,47 @@
+package proto_converters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	grpc "mail/internal/microservice/email/proto"
+	folderGrpc "mail/internal/microservice/folder/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestLabelConvertCoreInProto(t *testing.T) {
+	labelModelCore := domain.Label{ID: 1, ProfileID: 2, Name: "Work", Color: "#ff0000"}
+
+	expectedProto := &grpc.Label{Id: 1, ProfileId: 2, Name: "Work", Color: "#ff0000"}
+
+	actualProto := LabelConvertCoreInProto(&labelModelCore)
+	assert.Equal(t, expectedProto, actualProto)
+}
+
+func TestLabelConvertProtoInCore(t *testing.T) {
+	labelModelProto := grpc.Label{Id: 1, ProfileId: 2, Name: "Work", Color: "#ff0000"}
+
+	expectedCore := &domain.Label{ID: 1, ProfileID: 2, Name: "Work", Color: "#ff0000"}
+
+	actualCore := LabelConvertProtoInCore(&labelModelProto)
+	assert.Equal(t, expectedCore, actualCore)
+}
+
+func TestLabelsConvert(t *testing.T) {
+	labelsCore := []*domain.Label{
+		{ID: 1, ProfileID: 2, Name: "Work", Color: "#ff0000"},
+		{ID: 3, ProfileID: 2, Name: "Travel", Color: "#00ff00"},
+	}
+
+	labelsProto := LabelsConvertCoreInProto(labelsCore)
+	assert.Len(t, labelsProto, 2)
+	assert.Equal(t, labelsCore, LabelsConvertProtoInCore(labelsProto))
+
+	folderLabelsProto := FolderLabelsConvertCoreInProto(labelsCore)
+	assert.Equal(t, &folderGrpc.ObjectLabel{Id: 3, ProfileId: 2, Name: "Travel", Color: "#00ff00"}, folderLabelsProto[1])
+	assert.Equal(t, labelsCore, FolderLabelsConvertProtoInCore(folderLabelsProto))
+
+	assert.Nil(t, LabelsConvertCoreInProto(nil))
+	assert.Nil(t, FolderLabelsConvertProtoInCore(nil))
+}
@@ -0,0 +1,74 @@
+package proto_converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// MailboxDelegateConvertCoreInProto converts a mailbox delegate model from the application core to the gRPC format.
+func MailboxDelegateConvertCoreInProto(delegateModelCore *domain.MailboxDelegate) *grpc.MailboxDelegate {
+	return &grpc.MailboxDelegate{
+		MailboxId:    delegateModelCore.MailboxID,
+		DelegateId:   delegateModelCore.DelegateID,
+		Mailbox:      delegateModelCore.Mailbox,
+		Delegate:     delegateModelCore.Delegate,
+		Role:         delegateModelCore.Role,
+		CreationDate: timestamppb.New(delegateModelCore.CreationDate),
+	}
+}
+
+// MailboxDelegateConvertProtoInCore converts a mailbox delegate model from the gRPC format to the application core.
+func MailboxDelegateConvertProtoInCore(delegateModelProto *grpc.MailboxDelegate) *domain.MailboxDelegate {
+	return &domain.MailboxDelegate{
+		MailboxID:    delegateModelProto.MailboxId,
+		DelegateID:   delegateModelProto.DelegateId,
+		Mailbox:      delegateModelProto.Mailbox,
+		Delegate:     delegateModelProto.Delegate,
+		Role:         delegateModelProto.Role,
+		CreationDate: delegateModelProto.CreationDate.AsTime(),
+	}
+}
+
+// MailboxDelegatesConvertProtoInCore converts a list of mailbox delegate models from the gRPC format to the application core.
+func MailboxDelegatesConvertProtoInCore(delegatesModelProto *grpc.MailboxDelegates) []*domain.MailboxDelegate {
+	delegatesCore := make([]*domain.MailboxDelegate, 0, len(delegatesModelProto.Delegates))
+	for _, delegate := range delegatesModelProto.Delegates {
+		delegatesCore = append(delegatesCore, MailboxDelegateConvertProtoInCore(delegate))
+	}
+	return delegatesCore
+}
+
+// DelegateActionConvertCoreInProto converts a delegate action model from the application core to the gRPC format.
+func DelegateActionConvertCoreInProto(actionModelCore *domain.DelegateAction) *grpc.DelegateAction {
+	return &grpc.DelegateAction{
+		Id:           actionModelCore.ID,
+		Mailbox:      actionModelCore.Mailbox,
+		Delegate:     actionModelCore.Delegate,
+		Action:       actionModelCore.Action,
+		EmailId:      actionModelCore.EmailID,
+		CreationDate: timestamppb.New(actionModelCore.CreationDate),
+	}
+}
+
+// DelegateActionConvertProtoInCore converts a delegate action model from the gRPC format to the application core.
+func DelegateActionConvertProtoInCore(actionModelProto *grpc.DelegateAction) *domain.DelegateAction {
+	return &domain.DelegateAction{
+		ID:           actionModelProto.Id,
+		Mailbox:      actionModelProto.Mailbox,
+		Delegate:     actionModelProto.Delegate,
+		Action:       actionModelProto.Action,
+		EmailID:      actionModelProto.EmailId,
+		CreationDate: actionModelProto.CreationDate.AsTime(),
+	}
+}
+
+// DelegateActionsConvertProtoInCore converts a list of delegate action models from the gRPC format to the application core.
+func DelegateActionsConvertProtoInCore(actionsModelProto *grpc.DelegateActions) []*domain.DelegateAction {
+	actionsCore := make([]*domain.DelegateAction, 0, len(actionsModelProto.Actions))
+	for _, action := range actionsModelProto.Actions {
+		actionsCore = append(actionsCore, DelegateActionConvertProtoInCore(action))
+	}
+	return actionsCore
+}
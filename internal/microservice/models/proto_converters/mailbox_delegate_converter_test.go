@@ -0,0 +1,57 @@
+package proto_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestMailboxDelegateConvert(t *testing.T) {
+	delegateModelCore := &domain.MailboxDelegate{
+		MailboxID:    1,
+		DelegateID:   2,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "user@mailhub.su",
+		Role:         domain.DelegateRoleSendOnBehalf,
+		CreationDate: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, delegateModelCore, MailboxDelegateConvertProtoInCore(MailboxDelegateConvertCoreInProto(delegateModelCore)))
+}
+
+func TestMailboxDelegatesConvertProtoInCore(t *testing.T) {
+	creationDate := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	delegatesModelProto := grpc.MailboxDelegates{
+		Delegates: []*grpc.MailboxDelegate{
+			{MailboxId: 1, DelegateId: 2, Mailbox: "support@mailhub.su", Delegate: "user@mailhub.su", Role: "read", CreationDate: timestamppb.New(creationDate)},
+		},
+	}
+
+	expected := []*domain.MailboxDelegate{
+		{MailboxID: 1, DelegateID: 2, Mailbox: "support@mailhub.su", Delegate: "user@mailhub.su", Role: "read", CreationDate: creationDate},
+	}
+
+	assert.Equal(t, expected, MailboxDelegatesConvertProtoInCore(&delegatesModelProto))
+}
+
+func TestDelegateActionsConvert(t *testing.T) {
+	actionModelCore := &domain.DelegateAction{
+		ID:           1,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "user@mailhub.su",
+		Action:       "send",
+		EmailID:      5,
+		CreationDate: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	actionsModelProto := grpc.DelegateActions{
+		Actions: []*grpc.DelegateAction{DelegateActionConvertCoreInProto(actionModelCore)},
+	}
+
+	assert.Equal(t, []*domain.DelegateAction{actionModelCore}, DelegateActionsConvertProtoInCore(&actionsModelProto))
+}
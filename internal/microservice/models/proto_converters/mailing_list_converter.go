@@ -0,0 +1,65 @@
+package proto_converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// MailingListConvertCoreInProto converts a mailing list model from the application core to the gRPC format.
+func MailingListConvertCoreInProto(listModelCore *domain.MailingList) *grpc.MailingList {
+	return &grpc.MailingList{
+		Id:              listModelCore.ID,
+		Address:         listModelCore.Address,
+		Name:            listModelCore.Name,
+		Description:     listModelCore.Description,
+		PostPolicy:      listModelCore.PostPolicy,
+		Moderated:       listModelCore.Moderated,
+		CreationDate:    timestamppb.New(listModelCore.CreationDate),
+		ListId:          listModelCore.ListIDHeader(),
+		ListUnsubscribe: listModelCore.UnsubscribeURL(),
+	}
+}
+
+// MailingListConvertProtoInCore converts a mailing list model from the gRPC format to the application core.
+func MailingListConvertProtoInCore(listModelProto *grpc.MailingList) *domain.MailingList {
+	return &domain.MailingList{
+		ID:           listModelProto.Id,
+		Address:      listModelProto.Address,
+		Name:         listModelProto.Name,
+		Description:  listModelProto.Description,
+		PostPolicy:   listModelProto.PostPolicy,
+		Moderated:    listModelProto.Moderated,
+		CreationDate: listModelProto.CreationDate.AsTime(),
+	}
+}
+
+// MailingListsConvertProtoInCore converts a list of mailing list models from the gRPC format to the application core.
+func MailingListsConvertProtoInCore(listsModelProto *grpc.MailingLists) []*domain.MailingList {
+	listsCore := make([]*domain.MailingList, 0, len(listsModelProto.Lists))
+	for _, list := range listsModelProto.Lists {
+		listsCore = append(listsCore, MailingListConvertProtoInCore(list))
+	}
+	return listsCore
+}
+
+// MailingListMemberConvertCoreInProto converts a mailing list member model from the application core to the gRPC format.
+func MailingListMemberConvertCoreInProto(memberModelCore *domain.MailingListMember) *grpc.MailingListMember {
+	return &grpc.MailingListMember{
+		ListId:    memberModelCore.ListID,
+		ProfileId: memberModelCore.ProfileID,
+		Login:     memberModelCore.Login,
+		Role:      memberModelCore.Role,
+	}
+}
+
+// MailingListMemberConvertProtoInCore converts a mailing list member model from the gRPC format to the application core.
+func MailingListMemberConvertProtoInCore(memberModelProto *grpc.MailingListMember) *domain.MailingListMember {
+	return &domain.MailingListMember{
+		ListID:    memberModelProto.ListId,
+		ProfileID: memberModelProto.ProfileId,
+		Login:     memberModelProto.Login,
+		Role:      memberModelProto.Role,
+	}
+}
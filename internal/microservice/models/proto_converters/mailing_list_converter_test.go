@@ -0,0 +1,62 @@
+package proto_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestMailingListConvertCoreInProto(t *testing.T) {
+	listModelCore := domain.MailingList{
+		ID:           3,
+		Address:      "team@mailhub.su",
+		Name:         "Team",
+		Description:  "Our team",
+		PostPolicy:   domain.PostPolicyMembers,
+		Moderated:    true,
+		CreationDate: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	listModelProto := MailingListConvertCoreInProto(&listModelCore)
+
+	assert.Equal(t, listModelCore.ID, listModelProto.Id)
+	assert.Equal(t, listModelCore.Address, listModelProto.Address)
+	assert.Equal(t, listModelCore.Name, listModelProto.Name)
+	assert.Equal(t, listModelCore.Description, listModelProto.Description)
+	assert.Equal(t, listModelCore.PostPolicy, listModelProto.PostPolicy)
+	assert.Equal(t, listModelCore.Moderated, listModelProto.Moderated)
+	assert.Equal(t, listModelCore.CreationDate, listModelProto.CreationDate.AsTime())
+	assert.Equal(t, listModelCore.ListIDHeader(), listModelProto.ListId)
+	assert.Equal(t, listModelCore.UnsubscribeURL(), listModelProto.ListUnsubscribe)
+}
+
+func TestMailingListsConvertProtoInCore(t *testing.T) {
+	creationDate := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	listsModelProto := grpc.MailingLists{
+		Lists: []*grpc.MailingList{
+			{Id: 1, Address: "team@mailhub.su", Name: "Team", PostPolicy: "anyone", CreationDate: timestamppb.New(creationDate)},
+			{Id: 2, Address: "dev@mailhub.su", Name: "Dev", PostPolicy: "owners", Moderated: true, CreationDate: timestamppb.New(creationDate)},
+		},
+	}
+
+	expected := []*domain.MailingList{
+		{ID: 1, Address: "team@mailhub.su", Name: "Team", PostPolicy: "anyone", CreationDate: creationDate},
+		{ID: 2, Address: "dev@mailhub.su", Name: "Dev", PostPolicy: "owners", Moderated: true, CreationDate: creationDate},
+	}
+
+	assert.Equal(t, expected, MailingListsConvertProtoInCore(&listsModelProto))
+}
+
+func TestMailingListMemberConvert(t *testing.T) {
+	memberModelCore := domain.MailingListMember{ListID: 1, ProfileID: 2, Login: "ivan@mailhub.su", Role: domain.ListRoleOwner}
+
+	memberModelProto := MailingListMemberConvertCoreInProto(&memberModelCore)
+
+	assert.Equal(t, &grpc.MailingListMember{ListId: 1, ProfileId: 2, Login: "ivan@mailhub.su", Role: "owner"}, memberModelProto)
+	assert.Equal(t, &memberModelCore, MailingListMemberConvertProtoInCore(memberModelProto))
+}
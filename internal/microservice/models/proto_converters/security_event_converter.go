@@ -0,0 +1,60 @@
+package proto_converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	domain "mail/internal/microservice/models/domain_models"
+	grpc "mail/internal/microservice/user/proto"
+)
+
+// SecurityEventConvertCoreInProto converts a security event from the application core to the gRPC format.
+func SecurityEventConvertCoreInProto(eventModelCore *domain.SecurityEvent) *grpc.SecurityEvent {
+	return &grpc.SecurityEvent{
+		Id:           eventModelCore.ID,
+		ProfileId:    eventModelCore.ProfileID,
+		Login:        eventModelCore.Login,
+		Type:         eventModelCore.Type,
+		Method:       eventModelCore.Method,
+		Success:      eventModelCore.Success,
+		Details:      eventModelCore.Details,
+		IpAddress:    eventModelCore.IPAddress,
+		UserAgent:    eventModelCore.UserAgent,
+		CreationDate: timestamppb.New(eventModelCore.CreationDate),
+	}
+}
+
+// SecurityEventConvertProtoInCore converts a security event from the gRPC format to the application core.
+func SecurityEventConvertProtoInCore(eventModelProto *grpc.SecurityEvent) *domain.SecurityEvent {
+	return &domain.SecurityEvent{
+		ID:           eventModelProto.Id,
+		ProfileID:    eventModelProto.ProfileId,
+		Login:        eventModelProto.Login,
+		Type:         eventModelProto.Type,
+		Method:       eventModelProto.Method,
+		Success:      eventModelProto.Success,
+		Details:      eventModelProto.Details,
+		IPAddress:    eventModelProto.IpAddress,
+		UserAgent:    eventModelProto.UserAgent,
+		CreationDate: eventModelProto.CreationDate.AsTime(),
+	}
+}
+
+// SecurityEventFilterConvertProtoInCore converts a security event query from the gRPC format to the application core.
+// Missing dates do not restrict the selection.
+func SecurityEventFilterConvertProtoInCore(filterModelProto *grpc.QuerySecurityEventsRequest) *domain.SecurityEventFilter {
+	filterModelCore := &domain.SecurityEventFilter{
+		ProfileID: filterModelProto.ProfileId,
+		Login:     filterModelProto.Login,
+		Type:      filterModelProto.Type,
+		BeforeID:  filterModelProto.BeforeId,
+		Limit:     int(filterModelProto.Limit),
+	}
+	if filterModelProto.Since != nil {
+		filterModelCore.Since = filterModelProto.Since.AsTime()
+	}
+	if filterModelProto.Until != nil {
+		filterModelCore.Until = filterModelProto.Until.AsTime()
+	}
+
+	return filterModelCore
+}
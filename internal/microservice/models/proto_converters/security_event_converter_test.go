@@ -0,0 +1,98 @@
+package proto_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	domain "mail/internal/microservice/models/domain_models"
+	grpc "mail/internal/microservice/user/proto"
+)
+
+func TestSecurityEventConvertCoreInProto(t *testing.T) {
+	creationDate := time.Now()
+
+	eventModelCore := domain.SecurityEvent{
+		ID:           1,
+		ProfileID:    2,
+		Login:        "user@mailhub.su",
+		Type:         domain.SecurityEventLogin,
+		Method:       domain.SecurityEventMethodVK,
+		Success:      true,
+		IPAddress:    "127.0.0.1",
+		UserAgent:    "Firefox",
+		CreationDate: creationDate,
+	}
+
+	expectedProto := &grpc.SecurityEvent{
+		Id:           1,
+		ProfileId:    2,
+		Login:        "user@mailhub.su",
+		Type:         domain.SecurityEventLogin,
+		Method:       domain.SecurityEventMethodVK,
+		Success:      true,
+		IpAddress:    "127.0.0.1",
+		UserAgent:    "Firefox",
+		CreationDate: timestamppb.New(creationDate),
+	}
+
+	assert.Equal(t, expectedProto, SecurityEventConvertCoreInProto(&eventModelCore))
+}
+
+func TestSecurityEventConvertProtoInCore(t *testing.T) {
+	creationDate := time.Now().UTC()
+
+	eventModelProto := grpc.SecurityEvent{
+		Id:           1,
+		ProfileId:    2,
+		Type:         domain.SecurityEventDelegateAdd,
+		Success:      true,
+		Details:      "delegate: friend@mailhub.su, role: reader",
+		CreationDate: timestamppb.New(creationDate),
+	}
+
+	expectedCore := &domain.SecurityEvent{
+		ID:           1,
+		ProfileID:    2,
+		Type:         domain.SecurityEventDelegateAdd,
+		Success:      true,
+		Details:      "delegate: friend@mailhub.su, role: reader",
+		CreationDate: creationDate,
+	}
+
+	assert.Equal(t, expectedCore, SecurityEventConvertProtoInCore(&eventModelProto))
+}
+
+func TestSecurityEventFilterConvertProtoInCore(t *testing.T) {
+	since := time.Now().UTC()
+
+	t.Run("WithDates", func(t *testing.T) {
+		filterModelProto := grpc.QuerySecurityEventsRequest{
+			Login:    "user@mailhub.su",
+			Type:     domain.SecurityEventLogin,
+			Since:    timestamppb.New(since),
+			Until:    timestamppb.New(since.Add(time.Hour)),
+			BeforeId: 10,
+			Limit:    20,
+		}
+
+		expectedCore := &domain.SecurityEventFilter{
+			Login:    "user@mailhub.su",
+			Type:     domain.SecurityEventLogin,
+			Since:    since,
+			Until:    since.Add(time.Hour),
+			BeforeID: 10,
+			Limit:    20,
+		}
+
+		assert.Equal(t, expectedCore, SecurityEventFilterConvertProtoInCore(&filterModelProto))
+	})
+
+	t.Run("WithoutDates", func(t *testing.T) {
+		filterModelCore := SecurityEventFilterConvertProtoInCore(&grpc.QuerySecurityEventsRequest{ProfileId: 2})
+
+		assert.Equal(t, &domain.SecurityEventFilter{ProfileID: 2}, filterModelCore)
+	})
+}
@@ -16,6 +16,8 @@ func SessionConvertCoreInProto(sessionModelCore *domain.Session) *grpc.Session {
 		Device:       sessionModelCore.Device,
 		LifeTime:     int32(sessionModelCore.LifeTime),
 		CsrfToken:    sessionModelCore.CsrfToken,
+		IpAddress:    sessionModelCore.IPAddress,
+		LastSeenDate: timestamppb.New(sessionModelCore.LastSeenDate),
 	}
 }
 
@@ -28,5 +30,19 @@ func SessionConvertProtoInCore(sessionModelProto *grpc.Session) *domain.Session
 		Device:       sessionModelProto.Device,
 		LifeTime:     int(sessionModelProto.LifeTime),
 		CsrfToken:    sessionModelProto.CsrfToken,
+		IPAddress:    sessionModelProto.IpAddress,
+		LastSeenDate: sessionModelProto.LastSeenDate.AsTime(),
+	}
+}
+
+// ActiveSessionConvertCoreInProto converts a session model from the application core to the gRPC format
+// of a session shown to its owner, identified by the public identifier instead of the session ID.
+func ActiveSessionConvertCoreInProto(sessionModelCore *domain.Session) *grpc.ActiveSession {
+	return &grpc.ActiveSession{
+		Id:           domain.SessionPublicID(sessionModelCore.ID),
+		Device:       sessionModelCore.Device,
+		IpAddress:    sessionModelCore.IPAddress,
+		CreationDate: timestamppb.New(sessionModelCore.CreationDate),
+		LastSeenDate: timestamppb.New(sessionModelCore.LastSeenDate),
 	}
 }
@@ -20,6 +20,8 @@ func TestSessionConvertCoreInProto(t *testing.T) {
 		Device:       "mobile",
 		LifeTime:     3600,
 		CsrfToken:    "csrf_token",
+		IPAddress:    "127.0.0.1",
+		LastSeenDate: creationDate,
 	}
 
 	expectedProto := &grpc.Session{
@@ -29,6 +31,8 @@ func TestSessionConvertCoreInProto(t *testing.T) {
 		Device:       "mobile",
 		LifeTime:     3600,
 		CsrfToken:    "csrf_token",
+		IpAddress:    "127.0.0.1",
+		LastSeenDate: timestamppb.New(creationDate),
 	}
 
 	actualProto := SessionConvertCoreInProto(&sessionModelCore)
@@ -47,3 +51,26 @@ func TestSessionConvertProtoInCore(t *testing.T) {
 	actualCore := SessionConvertProtoInCore(&sessionModelProto)
 	assert.NotNil(t, actualCore)
 }
+
+func TestActiveSessionConvertCoreInProto(t *testing.T) {
+	creationDate := time.Now()
+	sessionModelCore := domain.Session{
+		ID:           "session123",
+		UserID:       42,
+		CreationDate: creationDate,
+		Device:       "Mozilla/5.0",
+		CsrfToken:    "csrf_token",
+		IPAddress:    "127.0.0.1",
+		LastSeenDate: creationDate,
+	}
+
+	expectedProto := &grpc.ActiveSession{
+		Id:           domain.SessionPublicID("session123"),
+		Device:       "Mozilla/5.0",
+		IpAddress:    "127.0.0.1",
+		CreationDate: timestamppb.New(creationDate),
+		LastSeenDate: timestamppb.New(creationDate),
+	}
+
+	assert.Equal(t, expectedProto, ActiveSessionConvertCoreInProto(&sessionModelCore))
+}
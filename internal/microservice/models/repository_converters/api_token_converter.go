@@ -0,0 +1,34 @@
+package repository_converters
+
+import (
+	"strings"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// APITokenConvertDbInCore converts a personal access token from database representation to core domain representation.
+func APITokenConvertDbInCore(tokenModelDb *database.APIToken) *domain.APIToken {
+	tokenModelCore := &domain.APIToken{
+		ID:             tokenModelDb.ID,
+		TokenHash:      tokenModelDb.TokenHash,
+		ProfileID:      tokenModelDb.ProfileID,
+		Name:           tokenModelDb.Name,
+		Scopes:         []string{},
+		CreationDate:   tokenModelDb.CreationDate,
+		ExpirationDate: tokenModelDb.ExpirationDate,
+	}
+	if tokenModelDb.Scopes != "" {
+		tokenModelCore.Scopes = strings.Split(tokenModelDb.Scopes, ",")
+	}
+	if tokenModelDb.LastUsedDate != nil {
+		tokenModelCore.LastUsedDate = *tokenModelDb.LastUsedDate
+	}
+
+	return tokenModelCore
+}
+
+// APITokenScopesConvertCoreInDb converts the scopes of a personal access token to database representation.
+func APITokenScopesConvertCoreInDb(scopes []string) string {
+	return strings.Join(scopes, ",")
+}
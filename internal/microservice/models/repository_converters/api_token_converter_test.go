@@ -0,0 +1,56 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestAPITokenConvertDbInCore(t *testing.T) {
+	creationDate := time.Now()
+	expirationDate := creationDate.Add(24 * time.Hour)
+	lastUsedDate := creationDate.Add(time.Minute)
+
+	t.Run("Used", func(t *testing.T) {
+		tokenModelDb := database.APIToken{
+			ID:             1,
+			TokenHash:      "hash",
+			ProfileID:      2,
+			Name:           "backup",
+			Scopes:         "mail:read,mail:send",
+			CreationDate:   creationDate,
+			ExpirationDate: expirationDate,
+			LastUsedDate:   &lastUsedDate,
+		}
+
+		expectedCore := &domain.APIToken{
+			ID:             1,
+			TokenHash:      "hash",
+			ProfileID:      2,
+			Name:           "backup",
+			Scopes:         []string{domain.APITokenScopeMailRead, domain.APITokenScopeMailSend},
+			CreationDate:   creationDate,
+			ExpirationDate: expirationDate,
+			LastUsedDate:   lastUsedDate,
+		}
+
+		assert.Equal(t, expectedCore, APITokenConvertDbInCore(&tokenModelDb))
+	})
+
+	t.Run("NeverUsed", func(t *testing.T) {
+		tokenModelDb := database.APIToken{ID: 1, ProfileID: 2, Name: "backup", CreationDate: creationDate, ExpirationDate: expirationDate}
+
+		expectedCore := &domain.APIToken{ID: 1, ProfileID: 2, Name: "backup", Scopes: []string{}, CreationDate: creationDate, ExpirationDate: expirationDate}
+
+		assert.Equal(t, expectedCore, APITokenConvertDbInCore(&tokenModelDb))
+	})
+}
+
+func TestAPITokenScopesConvertCoreInDb(t *testing.T) {
+	assert.Equal(t, "mail:read,folders:manage", APITokenScopesConvertCoreInDb([]string{domain.APITokenScopeMailRead, domain.APITokenScopeFoldersManage}))
+	assert.Equal(t, "", APITokenScopesConvertCoreInDb(nil))
+}
@@ -20,6 +20,11 @@ func EmailConvertDbInCore(emailModelDb *database.Email) *domain.Email {
 		avatar = *emailModelDb.PhotoID
 	}
 
+	var sentBy string
+	if emailModelDb.SentByEmail != nil {
+		sentBy = *emailModelDb.SentByEmail
+	}
+
 	return &domain.Email{
 		ID:             emailModelDb.ID,
 		Topic:          emailModelDb.Topic,
@@ -34,6 +39,7 @@ func EmailConvertDbInCore(emailModelDb *database.Email) *domain.Email {
 		SpamStatus:     emailModelDb.SpamStatus,
 		SenderEmail:    emailModelDb.SenderEmail,
 		RecipientEmail: emailModelDb.RecipientEmail,
+		SentByEmail:    sentBy,
 	}
 }
 
@@ -59,6 +65,10 @@ func EmailConvertCoreInDb(emailModelCore *domain.Email) *database.Email {
 		emailDB.ReplyToEmailID = emailModelCore.ReplyToEmailID
 	}
 
+	if emailModelCore.SentByEmail != "" {
+		emailDB.SentByEmail = &emailModelCore.SentByEmail
+	}
+
 	if emailModelCore.PhotoID != "" {
 		photoId := &emailModelCore.PhotoID
 		emailDB.PhotoID = photoId
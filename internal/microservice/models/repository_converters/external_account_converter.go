@@ -0,0 +1,43 @@
+package repository_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// ExternalAccountConvertDbInCore converts a linked mailbox from database representation to core domain representation.
+// The password stays encrypted and is not converted.
+func ExternalAccountConvertDbInCore(accountModelDb *database.ExternalAccount) *domain.ExternalAccount {
+	return &domain.ExternalAccount{
+		ID:           accountModelDb.ID,
+		ProfileID:    accountModelDb.ProfileID,
+		Email:        accountModelDb.Email,
+		IMAPHost:     accountModelDb.IMAPHost,
+		IMAPPort:     accountModelDb.IMAPPort,
+		IMAPTLSMode:  accountModelDb.IMAPTLSMode,
+		SMTPHost:     accountModelDb.SMTPHost,
+		SMTPPort:     accountModelDb.SMTPPort,
+		SMTPTLSMode:  accountModelDb.SMTPTLSMode,
+		Username:     accountModelDb.Username,
+		CreationDate: accountModelDb.CreationDate,
+	}
+}
+
+// ExternalAccountConvertCoreInDb converts a linked mailbox from core domain representation to database representation
+// with the encrypted password.
+func ExternalAccountConvertCoreInDb(accountModelCore *domain.ExternalAccount, encryptedPassword []byte) *database.ExternalAccount {
+	return &database.ExternalAccount{
+		ID:           accountModelCore.ID,
+		ProfileID:    accountModelCore.ProfileID,
+		Email:        accountModelCore.Email,
+		IMAPHost:     accountModelCore.IMAPHost,
+		IMAPPort:     accountModelCore.IMAPPort,
+		IMAPTLSMode:  accountModelCore.IMAPTLSMode,
+		SMTPHost:     accountModelCore.SMTPHost,
+		SMTPPort:     accountModelCore.SMTPPort,
+		SMTPTLSMode:  accountModelCore.SMTPTLSMode,
+		Username:     accountModelCore.Username,
+		Password:     encryptedPassword,
+		CreationDate: accountModelCore.CreationDate,
+	}
+}
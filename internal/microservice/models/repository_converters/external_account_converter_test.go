@@ -0,0 +1,50 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestExternalAccountConvert(t *testing.T) {
+	creationDate := time.Now()
+
+	accountModelCore := &domain.ExternalAccount{
+		ID:           1,
+		ProfileID:    2,
+		Email:        "user@example.com",
+		IMAPHost:     "imap.example.com",
+		IMAPPort:     993,
+		IMAPTLSMode:  domain.ExternalAccountTLSModeTLS,
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		SMTPTLSMode:  domain.ExternalAccountTLSModeSTARTTLS,
+		Username:     "user",
+		Password:     "password",
+		CreationDate: creationDate,
+	}
+
+	accountModelDb := ExternalAccountConvertCoreInDb(accountModelCore, []byte("encrypted"))
+	assert.Equal(t, &database.ExternalAccount{
+		ID:           1,
+		ProfileID:    2,
+		Email:        "user@example.com",
+		IMAPHost:     "imap.example.com",
+		IMAPPort:     993,
+		IMAPTLSMode:  "tls",
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		SMTPTLSMode:  "starttls",
+		Username:     "user",
+		Password:     []byte("encrypted"),
+		CreationDate: creationDate,
+	}, accountModelDb)
+
+	expectedCore := *accountModelCore
+	expectedCore.Password = ""
+	assert.Equal(t, &expectedCore, ExternalAccountConvertDbInCore(accountModelDb))
+}
@@ -7,18 +7,37 @@ import (
 
 // FolderConvertDbInCore converts a folder model from database representation to core domain representation.
 func FolderConvertDbInCore(folderModelDb *database.Folder) *domain.Folder {
+	var parentID uint32
+	if folderModelDb.ParentID != nil {
+		parentID = *folderModelDb.ParentID
+	}
+
 	return &domain.Folder{
 		ID:        folderModelDb.ID,
 		ProfileId: folderModelDb.ProfileId,
 		Name:      folderModelDb.Name,
+		ParentID:  parentID,
+		Path:      folderModelDb.Path,
+		Total:     folderModelDb.Total,
+		Unread:    folderModelDb.Unread,
 	}
 }
 
 // FolderConvertCoreInDb converts a folder model from core domain representation to database representation.
 func FolderConvertCoreInDb(folderModelCore *domain.Folder) *database.Folder {
+	var parentID *uint32
+	if folderModelCore.ParentID != 0 {
+		id := folderModelCore.ParentID
+		parentID = &id
+	}
+
 	return &database.Folder{
 		ID:        folderModelCore.ID,
 		ProfileId: folderModelCore.ProfileId,
 		Name:      folderModelCore.Name,
+		ParentID:  parentID,
+		Path:      folderModelCore.Path,
+		Total:     folderModelCore.Total,
+		Unread:    folderModelCore.Unread,
 	}
 }
@@ -42,3 +42,32 @@ func TestFolderConvertCoreInDb(t *testing.T) {
 	actualDb := FolderConvertCoreInDb(&folderModelCore)
 	assert.Equal(t, expectedDb, actualDb)
 }
+
+func TestFolderConvertNested(t *testing.T) {
+	parentID := uint32(12)
+	folderModelDb := database.Folder{
+		ID:        123,
+		ProfileId: 456,
+		Name:      "Reports",
+		ParentID:  &parentID,
+		Path:      "Work/Reports",
+		Total:     10,
+		Unread:    3,
+	}
+
+	expectedCore := &domain.Folder{
+		ID:        123,
+		ProfileId: 456,
+		Name:      "Reports",
+		ParentID:  12,
+		Path:      "Work/Reports",
+		Total:     10,
+		Unread:    3,
+	}
+
+	actualCore := FolderConvertDbInCore(&folderModelDb)
+	assert.Equal(t, expectedCore, actualCore)
+
+	actualDb := FolderConvertCoreInDb(actualCore)
+	assert.Equal(t, &folderModelDb, actualDb)
+}
@@ -0,0 +1,60 @@
+package repository_converters
+
+import (
+	"strings"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// GMailMessageConvertDbInCore converts the local copy of a Gmail email from database representation to core domain representation.
+func GMailMessageConvertDbInCore(messageModelDb *database.GMailMessage) *domain.GMailMessage {
+	return &domain.GMailMessage{
+		ID:        messageModelDb.ID,
+		ThreadID:  messageModelDb.ThreadID,
+		LabelIDs:  GMailLabelIDsConvertDbInCore(messageModelDb.LabelIDs),
+		Subject:   messageModelDb.Subject,
+		Sender:    messageModelDb.Sender,
+		Recipient: messageModelDb.Recipient,
+		Text:      messageModelDb.Text,
+		Date:      messageModelDb.Date,
+	}
+}
+
+// GMailMessageConvertCoreInDb converts a Gmail email of the mailbox from core domain representation to database representation.
+func GMailMessageConvertCoreInDb(login string, messageModelCore *domain.GMailMessage) *database.GMailMessage {
+	return &database.GMailMessage{
+		Login:     login,
+		ID:        messageModelCore.ID,
+		ThreadID:  messageModelCore.ThreadID,
+		LabelIDs:  GMailLabelIDsConvertCoreInDb(messageModelCore.LabelIDs),
+		Subject:   messageModelCore.Subject,
+		Sender:    messageModelCore.Sender,
+		Recipient: messageModelCore.Recipient,
+		Text:      messageModelCore.Text,
+		Date:      messageModelCore.Date,
+	}
+}
+
+// GMailLabelConvertDbInCore converts the local copy of a Gmail label from database representation to core domain representation.
+func GMailLabelConvertDbInCore(labelModelDb *database.GMailLabel) *domain.GMailLabel {
+	return &domain.GMailLabel{
+		ID:   labelModelDb.ID,
+		Name: labelModelDb.Name,
+		Type: labelModelDb.Type,
+	}
+}
+
+// GMailLabelIDsConvertCoreInDb converts the labels of a Gmail email to database representation.
+func GMailLabelIDsConvertCoreInDb(labelIDs []string) string {
+	return strings.Join(labelIDs, ",")
+}
+
+// GMailLabelIDsConvertDbInCore converts the labels of a Gmail email from database representation.
+func GMailLabelIDsConvertDbInCore(labelIDs string) []string {
+	if labelIDs == "" {
+		return []string{}
+	}
+
+	return strings.Split(labelIDs, ",")
+}
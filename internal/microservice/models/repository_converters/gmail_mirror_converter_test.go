@@ -0,0 +1,53 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestGMailMessageConvert(t *testing.T) {
+	date := time.Now()
+
+	messageModelCore := &domain.GMailMessage{
+		ID:        "18c1",
+		ThreadID:  "18c0",
+		LabelIDs:  []string{domain.GMailLabelInbox, domain.GMailLabelUnread},
+		Subject:   "Hello",
+		Sender:    "friend@gmail.com",
+		Recipient: "user@gmail.com",
+		Text:      "<p>hi</p>",
+		Date:      date,
+	}
+
+	messageModelDb := GMailMessageConvertCoreInDb("user@gmail.com", messageModelCore)
+	assert.Equal(t, &database.GMailMessage{
+		Login:     "user@gmail.com",
+		ID:        "18c1",
+		ThreadID:  "18c0",
+		LabelIDs:  "INBOX,UNREAD",
+		Subject:   "Hello",
+		Sender:    "friend@gmail.com",
+		Recipient: "user@gmail.com",
+		Text:      "<p>hi</p>",
+		Date:      date,
+	}, messageModelDb)
+
+	assert.Equal(t, messageModelCore, GMailMessageConvertDbInCore(messageModelDb))
+}
+
+func TestGMailLabelConvertDbInCore(t *testing.T) {
+	labelModelDb := &database.GMailLabel{Login: "user@gmail.com", ID: "Label_1", Name: "Work", Type: "user"}
+
+	assert.Equal(t, &domain.GMailLabel{ID: "Label_1", Name: "Work", Type: "user"}, GMailLabelConvertDbInCore(labelModelDb))
+}
+
+func TestGMailLabelIDsConvert(t *testing.T) {
+	assert.Equal(t, "", GMailLabelIDsConvertCoreInDb(nil))
+	assert.Equal(t, []string{}, GMailLabelIDsConvertDbInCore(""))
+	assert.Equal(t, []string{"INBOX", "Label_1"}, GMailLabelIDsConvertDbInCore("INBOX,Label_1"))
+}
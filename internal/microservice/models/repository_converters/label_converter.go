@@ -0,0 +1,26 @@
+package repository_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// LabelConvertDbInCore converts a label model from database representation to core domain representation.
+func LabelConvertDbInCore(labelModelDb *database.Label) *domain.Label {
+	return &domain.Label{
+		ID:        labelModelDb.ID,
+		ProfileID: labelModelDb.ProfileID,
+		Name:      labelModelDb.Name,
+		Color:     labelModelDb.Color,
+	}
+}
+
+// LabelConvertCoreInDb converts a label model from core domain representation to database representation.
+func LabelConvertCoreInDb(labelModelCore *domain.Label) *database.Label {
+	return &database.Label{
+		ID:        labelModelCore.ID,
+		ProfileID: labelModelCore.ProfileID,
+		Name:      labelModelCore.Name,
+		Color:     labelModelCore.Color,
+	}
+}
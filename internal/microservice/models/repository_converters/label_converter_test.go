@@ -0,0 +1,48 @@
+package repository_converters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestLabelConvertDbInCore(t *testing.T) {
+	labelModelDb := database.Label{
+		ID:        1,
+		ProfileID: 2,
+		Name:      "Work",
+		Color:     "#ff0000",
+	}
+
+	expectedCore := &domain.Label{
+		ID:        1,
+		ProfileID: 2,
+		Name:      "Work",
+		Color:     "#ff0000",
+	}
+
+	actualCore := LabelConvertDbInCore(&labelModelDb)
+	assert.Equal(t, expectedCore, actualCore)
+}
+
+func TestLabelConvertCoreInDb(t *testing.T) {
+	labelModelCore := domain.Label{
+		ID:        1,
+		ProfileID: 2,
+		Name:      "Work",
+		Color:     "#ff0000",
+	}
+
+	expectedDb := &database.Label{
+		ID:        1,
+		ProfileID: 2,
+		Name:      "Work",
+		Color:     "#ff0000",
+	}
+
+	actualDb := LabelConvertCoreInDb(&labelModelCore)
+	assert.Equal(t, expectedDb, actualDb)
+}
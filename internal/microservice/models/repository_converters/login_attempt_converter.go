@@ -0,0 +1,23 @@
+package repository_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// LoginAttemptsConvertDbInCore converts failed login attempts from database representation to core domain representation.
+func LoginAttemptsConvertDbInCore(attemptsModelDb *database.LoginAttempts) *domain.LoginAttempts {
+	attemptsModelCore := &domain.LoginAttempts{
+		Key:             attemptsModelDb.Key,
+		Failures:        attemptsModelDb.Failures,
+		LastFailureDate: attemptsModelDb.LastFailureDate,
+	}
+	if attemptsModelDb.PreviousFailureDate != nil {
+		attemptsModelCore.PreviousFailureDate = *attemptsModelDb.PreviousFailureDate
+	}
+	if attemptsModelDb.LockedUntil != nil {
+		attemptsModelCore.LockedUntil = *attemptsModelDb.LockedUntil
+	}
+
+	return attemptsModelCore
+}
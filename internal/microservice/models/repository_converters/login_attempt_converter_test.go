@@ -0,0 +1,33 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestLoginAttemptsConvertDbInCore(t *testing.T) {
+	lastFailureDate := time.Now()
+	lockedUntil := lastFailureDate.Add(time.Minute)
+
+	t.Run("Locked", func(t *testing.T) {
+		attemptsModelDb := database.LoginAttempts{Key: "login:user@mailhub.su", Failures: 10, LastFailureDate: lastFailureDate, LockedUntil: &lockedUntil}
+
+		expectedCore := &domain.LoginAttempts{Key: "login:user@mailhub.su", Failures: 10, LastFailureDate: lastFailureDate, LockedUntil: lockedUntil}
+
+		assert.Equal(t, expectedCore, LoginAttemptsConvertDbInCore(&attemptsModelDb))
+	})
+
+	t.Run("NotLocked", func(t *testing.T) {
+		previousFailureDate := lastFailureDate.Add(-time.Second)
+		attemptsModelDb := database.LoginAttempts{Key: "ip:127.0.0.1", Failures: 2, LastFailureDate: lastFailureDate, PreviousFailureDate: &previousFailureDate}
+
+		expectedCore := &domain.LoginAttempts{Key: "ip:127.0.0.1", Failures: 2, LastFailureDate: lastFailureDate, PreviousFailureDate: previousFailureDate}
+
+		assert.Equal(t, expectedCore, LoginAttemptsConvertDbInCore(&attemptsModelDb))
+	})
+}
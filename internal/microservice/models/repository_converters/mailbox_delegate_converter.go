@@ -0,0 +1,35 @@
+package repository_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// MailboxDelegateConvertDbInCore converts a mailbox delegate model from database representation to core domain representation.
+func MailboxDelegateConvertDbInCore(delegateModelDb *database.MailboxDelegate) *domain.MailboxDelegate {
+	return &domain.MailboxDelegate{
+		MailboxID:    delegateModelDb.MailboxID,
+		DelegateID:   delegateModelDb.DelegateID,
+		Mailbox:      delegateModelDb.Mailbox,
+		Delegate:     delegateModelDb.Delegate,
+		Role:         delegateModelDb.Role,
+		CreationDate: delegateModelDb.CreationDate,
+	}
+}
+
+// DelegateActionConvertDbInCore converts a delegate action model from database representation to core domain representation.
+func DelegateActionConvertDbInCore(actionModelDb *database.DelegateAction) *domain.DelegateAction {
+	var emailID uint64
+	if actionModelDb.EmailID != nil {
+		emailID = *actionModelDb.EmailID
+	}
+
+	return &domain.DelegateAction{
+		ID:           actionModelDb.ID,
+		Mailbox:      actionModelDb.Mailbox,
+		Delegate:     actionModelDb.Delegate,
+		Action:       actionModelDb.Action,
+		EmailID:      emailID,
+		CreationDate: actionModelDb.CreationDate,
+	}
+}
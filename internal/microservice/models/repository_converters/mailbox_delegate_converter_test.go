@@ -0,0 +1,64 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestMailboxDelegateConvertDbInCore(t *testing.T) {
+	creationDate := time.Now()
+
+	delegateModelDb := database.MailboxDelegate{
+		MailboxID:    1,
+		DelegateID:   2,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "user@mailhub.su",
+		Role:         "send_as",
+		CreationDate: creationDate,
+	}
+
+	expectedCore := &domain.MailboxDelegate{
+		MailboxID:    1,
+		DelegateID:   2,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "user@mailhub.su",
+		Role:         "send_as",
+		CreationDate: creationDate,
+	}
+
+	assert.Equal(t, expectedCore, MailboxDelegateConvertDbInCore(&delegateModelDb))
+}
+
+func TestDelegateActionConvertDbInCore(t *testing.T) {
+	creationDate := time.Now()
+	emailID := uint64(5)
+
+	actionModelDb := database.DelegateAction{
+		ID:           1,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "user@mailhub.su",
+		Action:       "send",
+		EmailID:      &emailID,
+		CreationDate: creationDate,
+	}
+
+	expectedCore := &domain.DelegateAction{
+		ID:           1,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "user@mailhub.su",
+		Action:       "send",
+		EmailID:      5,
+		CreationDate: creationDate,
+	}
+
+	assert.Equal(t, expectedCore, DelegateActionConvertDbInCore(&actionModelDb))
+
+	actionModelDb.EmailID = nil
+	expectedCore.EmailID = 0
+	assert.Equal(t, expectedCore, DelegateActionConvertDbInCore(&actionModelDb))
+}
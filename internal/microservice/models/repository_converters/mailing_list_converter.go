@@ -0,0 +1,49 @@
+package repository_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// MailingListConvertDbInCore converts a mailing list model from database representation to core domain representation.
+func MailingListConvertDbInCore(listModelDb *database.MailingList) *domain.MailingList {
+	var description string
+	if listModelDb.Description != nil {
+		description = *listModelDb.Description
+	}
+
+	return &domain.MailingList{
+		ID:           listModelDb.ID,
+		Address:      listModelDb.Address,
+		Name:         listModelDb.Name,
+		Description:  description,
+		PostPolicy:   listModelDb.PostPolicy,
+		Moderated:    listModelDb.Moderated,
+		CreationDate: listModelDb.CreationDate,
+	}
+}
+
+// MailingListConvertCoreInDb converts a mailing list model from core domain representation to database representation.
+func MailingListConvertCoreInDb(listModelCore *domain.MailingList) *database.MailingList {
+	description := listModelCore.Description
+
+	return &database.MailingList{
+		ID:           listModelCore.ID,
+		Address:      listModelCore.Address,
+		Name:         listModelCore.Name,
+		Description:  &description,
+		PostPolicy:   listModelCore.PostPolicy,
+		Moderated:    listModelCore.Moderated,
+		CreationDate: listModelCore.CreationDate,
+	}
+}
+
+// MailingListMemberConvertDbInCore converts a mailing list member model from database representation to core domain representation.
+func MailingListMemberConvertDbInCore(memberModelDb *database.MailingListMember) *domain.MailingListMember {
+	return &domain.MailingListMember{
+		ListID:    memberModelDb.ListID,
+		ProfileID: memberModelDb.ProfileID,
+		Login:     memberModelDb.Login,
+		Role:      memberModelDb.Role,
+	}
+}
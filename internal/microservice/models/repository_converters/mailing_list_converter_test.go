@@ -0,0 +1,67 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestMailingListConvertDbInCore(t *testing.T) {
+	creationDate := time.Now()
+	description := "Our team"
+
+	listModelDb := database.MailingList{
+		ID:           1,
+		Address:      "team@mailhub.su",
+		Name:         "Team",
+		Description:  &description,
+		PostPolicy:   "members",
+		Moderated:    true,
+		CreationDate: creationDate,
+	}
+
+	expectedCore := &domain.MailingList{
+		ID:           1,
+		Address:      "team@mailhub.su",
+		Name:         "Team",
+		Description:  "Our team",
+		PostPolicy:   "members",
+		Moderated:    true,
+		CreationDate: creationDate,
+	}
+
+	assert.Equal(t, expectedCore, MailingListConvertDbInCore(&listModelDb))
+
+	listModelDb.Description = nil
+	expectedCore.Description = ""
+	assert.Equal(t, expectedCore, MailingListConvertDbInCore(&listModelDb))
+}
+
+func TestMailingListConvertCoreInDb(t *testing.T) {
+	listModelCore := domain.MailingList{
+		ID:         1,
+		Address:    "team@mailhub.su",
+		Name:       "Team",
+		PostPolicy: "anyone",
+	}
+
+	listModelDb := MailingListConvertCoreInDb(&listModelCore)
+
+	assert.Equal(t, listModelCore.ID, listModelDb.ID)
+	assert.Equal(t, listModelCore.Address, listModelDb.Address)
+	assert.Equal(t, listModelCore.Name, listModelDb.Name)
+	assert.Equal(t, "", *listModelDb.Description)
+	assert.Equal(t, listModelCore.PostPolicy, listModelDb.PostPolicy)
+}
+
+func TestMailingListMemberConvertDbInCore(t *testing.T) {
+	memberModelDb := database.MailingListMember{ListID: 1, ProfileID: 2, Login: "ivan@mailhub.su", Role: "member"}
+
+	expectedCore := &domain.MailingListMember{ListID: 1, ProfileID: 2, Login: "ivan@mailhub.su", Role: "member"}
+
+	assert.Equal(t, expectedCore, MailingListMemberConvertDbInCore(&memberModelDb))
+}
@@ -0,0 +1,85 @@
+package repository_converters
+
+import (
+	"strings"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// OIDCClientConvertDbInCore converts an OpenID Connect client from database representation to core domain representation.
+func OIDCClientConvertDbInCore(clientModelDb *database.OIDCClient) *domain.OIDCClient {
+	clientModelCore := &domain.OIDCClient{
+		ClientID:     clientModelDb.ClientID,
+		Name:         clientModelDb.Name,
+		RedirectURIs: strings.Fields(clientModelDb.RedirectURIs),
+		CreationDate: clientModelDb.CreationDate,
+	}
+	if clientModelDb.SecretHash != nil {
+		clientModelCore.SecretHash = *clientModelDb.SecretHash
+	}
+
+	return clientModelCore
+}
+
+// OIDCClientConvertCoreInDb converts an OpenID Connect client from core domain representation to database representation.
+func OIDCClientConvertCoreInDb(clientModelCore *domain.OIDCClient) *database.OIDCClient {
+	clientModelDb := &database.OIDCClient{
+		ClientID:     clientModelCore.ClientID,
+		Name:         clientModelCore.Name,
+		RedirectURIs: strings.Join(clientModelCore.RedirectURIs, " "),
+		CreationDate: clientModelCore.CreationDate,
+	}
+	if !clientModelCore.IsPublic() {
+		clientModelDb.SecretHash = &clientModelCore.SecretHash
+	}
+
+	return clientModelDb
+}
+
+// OIDCAuthorizationCodeConvertDbInCore converts an authorization code from database representation to core domain representation.
+func OIDCAuthorizationCodeConvertDbInCore(codeModelDb *database.OIDCAuthorizationCode) *domain.OIDCAuthorizationCode {
+	return &domain.OIDCAuthorizationCode{
+		CodeHash:       codeModelDb.CodeHash,
+		ClientID:       codeModelDb.ClientID,
+		ProfileID:      codeModelDb.ProfileID,
+		RedirectURI:    codeModelDb.RedirectURI,
+		Scopes:         OIDCScopesConvertDbInCore(codeModelDb.Scopes),
+		Nonce:          codeModelDb.Nonce,
+		CodeChallenge:  codeModelDb.CodeChallenge,
+		ExpirationDate: codeModelDb.ExpirationDate,
+	}
+}
+
+// OIDCAuthorizationCodeConvertCoreInDb converts an authorization code from core domain representation to database representation.
+func OIDCAuthorizationCodeConvertCoreInDb(codeModelCore *domain.OIDCAuthorizationCode) *database.OIDCAuthorizationCode {
+	return &database.OIDCAuthorizationCode{
+		CodeHash:       codeModelCore.CodeHash,
+		ClientID:       codeModelCore.ClientID,
+		ProfileID:      codeModelCore.ProfileID,
+		RedirectURI:    codeModelCore.RedirectURI,
+		Scopes:         OIDCScopesConvertCoreInDb(codeModelCore.Scopes),
+		Nonce:          codeModelCore.Nonce,
+		CodeChallenge:  codeModelCore.CodeChallenge,
+		ExpirationDate: codeModelCore.ExpirationDate,
+	}
+}
+
+// OIDCSigningKeyConvertDbInCore converts a signing key from database representation to core domain representation.
+func OIDCSigningKeyConvertDbInCore(keyModelDb *database.OIDCSigningKey) *domain.OIDCSigningKey {
+	return &domain.OIDCSigningKey{
+		KeyID:        keyModelDb.KeyID,
+		PrivateKey:   keyModelDb.PrivateKey,
+		CreationDate: keyModelDb.CreationDate,
+	}
+}
+
+// OIDCScopesConvertDbInCore converts the scopes of a consent or a code from database representation.
+func OIDCScopesConvertDbInCore(scopes string) []string {
+	return strings.Fields(scopes)
+}
+
+// OIDCScopesConvertCoreInDb converts the scopes of a consent or a code to database representation.
+func OIDCScopesConvertCoreInDb(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
@@ -0,0 +1,82 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestOIDCClientConvert(t *testing.T) {
+	creationDate := time.Now()
+	secretHash := "hash"
+
+	t.Run("Confidential", func(t *testing.T) {
+		clientModelDb := &database.OIDCClient{
+			ClientID:     "client",
+			SecretHash:   &secretHash,
+			Name:         "App",
+			RedirectURIs: "https://app.example.com/callback https://app.example.com/other",
+			CreationDate: creationDate,
+		}
+		clientModelCore := &domain.OIDCClient{
+			ClientID:     "client",
+			SecretHash:   "hash",
+			Name:         "App",
+			RedirectURIs: []string{"https://app.example.com/callback", "https://app.example.com/other"},
+			CreationDate: creationDate,
+		}
+
+		assert.Equal(t, clientModelCore, OIDCClientConvertDbInCore(clientModelDb))
+		assert.Equal(t, clientModelDb, OIDCClientConvertCoreInDb(clientModelCore))
+	})
+
+	t.Run("Public", func(t *testing.T) {
+		clientModelDb := &database.OIDCClient{
+			ClientID:     "client",
+			Name:         "App",
+			RedirectURIs: "https://app.example.com/callback",
+			CreationDate: creationDate,
+		}
+		clientModelCore := &domain.OIDCClient{
+			ClientID:     "client",
+			Name:         "App",
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			CreationDate: creationDate,
+		}
+
+		assert.Equal(t, clientModelCore, OIDCClientConvertDbInCore(clientModelDb))
+		assert.Equal(t, clientModelDb, OIDCClientConvertCoreInDb(clientModelCore))
+	})
+}
+
+func TestOIDCAuthorizationCodeConvert(t *testing.T) {
+	expirationDate := time.Now()
+
+	codeModelDb := &database.OIDCAuthorizationCode{
+		CodeHash:       "hash",
+		ClientID:       "client",
+		ProfileID:      1,
+		RedirectURI:    "https://app.example.com/callback",
+		Scopes:         "openid email",
+		Nonce:          "nonce",
+		CodeChallenge:  "challenge",
+		ExpirationDate: expirationDate,
+	}
+	codeModelCore := &domain.OIDCAuthorizationCode{
+		CodeHash:       "hash",
+		ClientID:       "client",
+		ProfileID:      1,
+		RedirectURI:    "https://app.example.com/callback",
+		Scopes:         []string{domain.OIDCScopeOpenID, domain.OIDCScopeEmail},
+		Nonce:          "nonce",
+		CodeChallenge:  "challenge",
+		ExpirationDate: expirationDate,
+	}
+
+	assert.Equal(t, codeModelCore, OIDCAuthorizationCodeConvertDbInCore(codeModelDb))
+	assert.Equal(t, codeModelDb, OIDCAuthorizationCodeConvertCoreInDb(codeModelCore))
+}
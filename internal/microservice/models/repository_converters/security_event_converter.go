@@ -0,0 +1,26 @@
+package repository_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// SecurityEventConvertDbInCore converts a security event from database representation to core domain representation.
+func SecurityEventConvertDbInCore(eventModelDb *database.SecurityEvent) *domain.SecurityEvent {
+	eventModelCore := &domain.SecurityEvent{
+		ID:           eventModelDb.ID,
+		Login:        eventModelDb.Login,
+		Type:         eventModelDb.Type,
+		Method:       eventModelDb.Method,
+		Success:      eventModelDb.Success,
+		Details:      eventModelDb.Details,
+		IPAddress:    eventModelDb.IPAddress,
+		UserAgent:    eventModelDb.UserAgent,
+		CreationDate: eventModelDb.CreationDate,
+	}
+	if eventModelDb.ProfileID != nil {
+		eventModelCore.ProfileID = *eventModelDb.ProfileID
+	}
+
+	return eventModelCore
+}
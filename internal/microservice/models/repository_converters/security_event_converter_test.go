@@ -0,0 +1,58 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestSecurityEventConvertDbInCore(t *testing.T) {
+	creationDate := time.Now()
+
+	t.Run("KnownAccount", func(t *testing.T) {
+		profileID := uint32(2)
+		eventModelDb := database.SecurityEvent{
+			ID:           1,
+			ProfileID:    &profileID,
+			Login:        "user@mailhub.su",
+			Type:         domain.SecurityEventLogin,
+			Method:       domain.SecurityEventMethodPassword,
+			Success:      true,
+			IPAddress:    "127.0.0.1",
+			UserAgent:    "Firefox",
+			CreationDate: creationDate,
+		}
+
+		expectedCore := &domain.SecurityEvent{
+			ID:           1,
+			ProfileID:    2,
+			Login:        "user@mailhub.su",
+			Type:         domain.SecurityEventLogin,
+			Method:       domain.SecurityEventMethodPassword,
+			Success:      true,
+			IPAddress:    "127.0.0.1",
+			UserAgent:    "Firefox",
+			CreationDate: creationDate,
+		}
+
+		assert.Equal(t, expectedCore, SecurityEventConvertDbInCore(&eventModelDb))
+	})
+
+	t.Run("UnknownAccount", func(t *testing.T) {
+		eventModelDb := database.SecurityEvent{
+			ID:           3,
+			Login:        "nobody@mailhub.su",
+			Type:         domain.SecurityEventLogin,
+			Method:       domain.SecurityEventMethodPassword,
+			CreationDate: creationDate,
+		}
+
+		eventModelCore := SecurityEventConvertDbInCore(&eventModelDb)
+		assert.Equal(t, uint32(0), eventModelCore.ProfileID)
+		assert.False(t, eventModelCore.Success)
+	})
+}
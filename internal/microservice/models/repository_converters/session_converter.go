@@ -14,6 +14,8 @@ func SessionConvertDbInCore(sessionModelDb *sessionDb.Session) *sessionCore.Sess
 		Device:       sessionModelDb.Device,
 		LifeTime:     sessionModelDb.LifeTime,
 		CsrfToken:    sessionModelDb.CsrfToken,
+		IPAddress:    sessionModelDb.IPAddress,
+		LastSeenDate: sessionModelDb.LastSeenDate,
 	}
 }
 
@@ -26,5 +28,7 @@ func SessionConvertCoreInDb(sessionModelCore *sessionCore.Session) *sessionDb.Se
 		Device:       sessionModelCore.Device,
 		LifeTime:     sessionModelCore.LifeTime,
 		CsrfToken:    sessionModelCore.CsrfToken,
+		IPAddress:    sessionModelCore.IPAddress,
+		LastSeenDate: sessionModelCore.LastSeenDate,
 	}
 }
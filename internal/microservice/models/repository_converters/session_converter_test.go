@@ -17,6 +17,8 @@ func TestSessionConvertDbInCore(t *testing.T) {
 		Device:       "test_device",
 		LifeTime:     3600,
 		CsrfToken:    "csrf_token_123",
+		IPAddress:    "127.0.0.1",
+		LastSeenDate: time.Now(),
 	}
 
 	sessionCore := SessionConvertDbInCore(&sessionModelDb)
@@ -28,6 +30,8 @@ func TestSessionConvertDbInCore(t *testing.T) {
 		Device:       sessionModelDb.Device,
 		LifeTime:     sessionModelDb.LifeTime,
 		CsrfToken:    sessionModelDb.CsrfToken,
+		IPAddress:    sessionModelDb.IPAddress,
+		LastSeenDate: sessionModelDb.LastSeenDate,
 	}
 
 	if !reflect.DeepEqual(sessionCore, expectedSessionCore) {
@@ -43,6 +47,8 @@ func TestSessionConvertCoreInDb(t *testing.T) {
 		Device:       "test_device",
 		LifeTime:     3600,
 		CsrfToken:    "csrf_token_123",
+		IPAddress:    "127.0.0.1",
+		LastSeenDate: time.Now(),
 	}
 
 	sessionDb := SessionConvertCoreInDb(&sessionModelCore)
@@ -54,6 +60,8 @@ func TestSessionConvertCoreInDb(t *testing.T) {
 		Device:       sessionModelCore.Device,
 		LifeTime:     sessionModelCore.LifeTime,
 		CsrfToken:    sessionModelCore.CsrfToken,
+		IPAddress:    sessionModelCore.IPAddress,
+		LastSeenDate: sessionModelCore.LastSeenDate,
 	}
 
 	if !reflect.DeepEqual(sessionDb, expectedSessionDb) {
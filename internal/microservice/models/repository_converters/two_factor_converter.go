@@ -0,0 +1,32 @@
+package repository_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// TwoFactorConvertDbInCore converts two-factor settings from database representation to core domain representation.
+func TwoFactorConvertDbInCore(twoFactorModelDb *database.TwoFactor) *domain.TwoFactor {
+	twoFactorModelCore := &domain.TwoFactor{
+		ProfileID:    twoFactorModelDb.ProfileID,
+		Secret:       twoFactorModelDb.Secret,
+		Enabled:      twoFactorModelDb.Enabled,
+		LastUsedStep: twoFactorModelDb.LastUsedStep,
+		CreationDate: twoFactorModelDb.CreationDate,
+	}
+	if twoFactorModelDb.ConfirmationDate != nil {
+		twoFactorModelCore.ConfirmationDate = *twoFactorModelDb.ConfirmationDate
+	}
+
+	return twoFactorModelCore
+}
+
+// TwoFactorChallengeConvertDbInCore converts a two-factor challenge from database representation to core domain representation.
+func TwoFactorChallengeConvertDbInCore(challengeModelDb *database.TwoFactorChallenge) *domain.TwoFactorChallenge {
+	return &domain.TwoFactorChallenge{
+		ID:             challengeModelDb.ID,
+		ProfileID:      challengeModelDb.ProfileID,
+		Attempts:       challengeModelDb.Attempts,
+		ExpirationDate: challengeModelDb.ExpirationDate,
+	}
+}
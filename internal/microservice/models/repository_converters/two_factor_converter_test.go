@@ -0,0 +1,56 @@
+package repository_converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+func TestTwoFactorConvertDbInCore(t *testing.T) {
+	creationDate := time.Now()
+	confirmationDate := creationDate.Add(time.Minute)
+
+	t.Run("Confirmed", func(t *testing.T) {
+		twoFactorModelDb := database.TwoFactor{
+			ProfileID:        1,
+			Secret:           "SECRET",
+			Enabled:          true,
+			LastUsedStep:     42,
+			CreationDate:     creationDate,
+			ConfirmationDate: &confirmationDate,
+		}
+
+		expectedCore := &domain.TwoFactor{
+			ProfileID:        1,
+			Secret:           "SECRET",
+			Enabled:          true,
+			LastUsedStep:     42,
+			CreationDate:     creationDate,
+			ConfirmationDate: confirmationDate,
+		}
+
+		assert.Equal(t, expectedCore, TwoFactorConvertDbInCore(&twoFactorModelDb))
+	})
+
+	t.Run("NotConfirmed", func(t *testing.T) {
+		twoFactorModelDb := database.TwoFactor{ProfileID: 1, Secret: "SECRET", CreationDate: creationDate}
+
+		expectedCore := &domain.TwoFactor{ProfileID: 1, Secret: "SECRET", CreationDate: creationDate}
+
+		assert.Equal(t, expectedCore, TwoFactorConvertDbInCore(&twoFactorModelDb))
+	})
+}
+
+func TestTwoFactorChallengeConvertDbInCore(t *testing.T) {
+	expirationDate := time.Now()
+
+	challengeModelDb := database.TwoFactorChallenge{ID: "challenge", ProfileID: 1, Attempts: 2, ExpirationDate: expirationDate}
+
+	expectedCore := &domain.TwoFactorChallenge{ID: "challenge", ProfileID: 1, Attempts: 2, ExpirationDate: expirationDate}
+
+	assert.Equal(t, expectedCore, TwoFactorChallengeConvertDbInCore(&challengeModelDb))
+}
@@ -0,0 +1,15 @@
+package repository_models
+
+import "time"
+
+// APIToken represents a personal access token of a user.
+type APIToken struct {
+	ID             uint32     `db:"id"`              // ID is the unique identifier of the token.
+	TokenHash      string     `db:"token_hash"`      // TokenHash is the SHA-256 hash of the token.
+	ProfileID      uint32     `db:"profile_id"`      // ProfileID is the unique identifier of the user who owns the token.
+	Name           string     `db:"name"`            // Name is the description given by the user.
+	Scopes         string     `db:"scopes"`          // Scopes are the comma-separated actions allowed with the token.
+	CreationDate   time.Time  `db:"creation_date"`   // CreationDate is the date when the token was created.
+	ExpirationDate time.Time  `db:"expiration_date"` // ExpirationDate is the date after which the token is no longer valid.
+	LastUsedDate   *time.Time `db:"last_used_date"`  // LastUsedDate is the date of the last request made with the token.
+}
@@ -17,4 +17,5 @@ type Email struct {
 	SpamStatus     bool        `db:"isspam"`            // SpamStatus indicates whether the email is a spam
 	SenderEmail    string      `db:"sender_email"`      // SenderEmail is the email of the sender user
 	RecipientEmail string      `db:"recipient_email"`   // RecipientEmail is the email of the recipient user
+	SentByEmail    *string     `db:"sent_by_email"`     // SentByEmail is the delegate who sent the email on behalf of the shared mailbox, if any.
 }
@@ -0,0 +1,19 @@
+package repository_models
+
+import "time"
+
+// ExternalAccount represents a mailbox of another mail provider linked by a user.
+type ExternalAccount struct {
+	ID           uint32    `db:"id"`            // ID is the unique identifier of the linked mailbox.
+	ProfileID    uint32    `db:"profile_id"`    // ProfileID is the unique identifier of the user who linked the mailbox.
+	Email        string    `db:"email"`         // Email is the address of the mailbox.
+	IMAPHost     string    `db:"imap_host"`     // IMAPHost is the host name of the IMAP server.
+	IMAPPort     uint32    `db:"imap_port"`     // IMAPPort is the port of the IMAP server.
+	IMAPTLSMode  string    `db:"imap_tls_mode"` // IMAPTLSMode is how the connection to the IMAP server is secured.
+	SMTPHost     string    `db:"smtp_host"`     // SMTPHost is the host name of the SMTP server.
+	SMTPPort     uint32    `db:"smtp_port"`     // SMTPPort is the port of the SMTP server.
+	SMTPTLSMode  string    `db:"smtp_tls_mode"` // SMTPTLSMode is how the connection to the SMTP server is secured.
+	Username     string    `db:"username"`      // Username is the name the user signs in to the mail servers with.
+	Password     []byte    `db:"password"`      // Password is the encrypted password of the mail servers.
+	CreationDate time.Time `db:"creation_date"` // CreationDate is the date when the mailbox was linked.
+}
@@ -2,7 +2,11 @@ package repository_models
 
 // Folder represents the information about an folder.
 type Folder struct {
-	ID        uint32 `db:"id"`         // ID he unique id of the folder in the database.
-	ProfileId uint32 `db:"profile_id"` // ProfileId the unique identifier of the user who owns the folder.
-	Name      string `db:"name"`       // Name the name of the folder.
+	ID        uint32  `db:"id"`         // ID he unique id of the folder in the database.
+	ProfileId uint32  `db:"profile_id"` // ProfileId the unique identifier of the user who owns the folder.
+	Name      string  `db:"name"`       // Name the name of the folder.
+	ParentID  *uint32 `db:"parent_id"`  // ParentID the unique id of the parent folder, nil for the top-level folders.
+	Path      string  `db:"path"`       // Path the full path of the folder.
+	Total     uint32  `db:"total"`      // Total the number of emails in the folder.
+	Unread    uint32  `db:"unread"`     // Unread the number of unread emails in the folder.
 }
@@ -0,0 +1,24 @@
+package repository_models
+
+import "time"
+
+// GMailMessage represents the local copy of an email of a Gmail mailbox.
+type GMailMessage struct {
+	Login     string    `db:"login"`      // Login is the Gmail address of the mailbox.
+	ID        string    `db:"message_id"` // ID is the identifier of the email given by Gmail.
+	ThreadID  string    `db:"thread_id"`  // ThreadID is the identifier of the conversation given by Gmail.
+	LabelIDs  string    `db:"label_ids"`  // LabelIDs are the identifiers of the labels separated by commas.
+	Subject   string    `db:"subject"`    // Subject is the subject of the email.
+	Sender    string    `db:"sender"`     // Sender is the From header of the email.
+	Recipient string    `db:"recipient"`  // Recipient is the To header of the email.
+	Text      string    `db:"text"`       // Text is the body of the email.
+	Date      time.Time `db:"date"`       // Date is the date when Gmail received the email.
+}
+
+// GMailLabel represents the local copy of a label of a Gmail mailbox.
+type GMailLabel struct {
+	Login string `db:"login"`    // Login is the Gmail address of the mailbox.
+	ID    string `db:"label_id"` // ID is the identifier of the label given by Gmail.
+	Name  string `db:"name"`     // Name is the name of the label.
+	Type  string `db:"type"`     // Type is the type of the label: system or user.
+}
@@ -0,0 +1,15 @@
+package repository_models
+
+// Label represents the information about a user label.
+type Label struct {
+	ID        uint32 `db:"id"`         // ID is the unique identifier of the label in the database.
+	ProfileID uint32 `db:"profile_id"` // ProfileID is the unique identifier of the user who owns the label.
+	Name      string `db:"name"`       // Name is the name of the label.
+	Color     string `db:"color"`      // Color is the color of the label in the #RRGGBB format.
+}
+
+// EmailLabel represents a label put on an email.
+type EmailLabel struct {
+	EmailID uint64 `db:"email_id"` // EmailID is the unique identifier of the email.
+	Label
+}
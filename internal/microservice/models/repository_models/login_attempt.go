@@ -0,0 +1,12 @@
+package repository_models
+
+import "time"
+
+// LoginAttempts represents the recent failed login attempts on a login or from an IP address.
+type LoginAttempts struct {
+	Key                 string     `db:"key"`                   // Key is the login or the IP address.
+	Failures            int        `db:"failures"`              // Failures is the number of failed attempts in a row.
+	LastFailureDate     time.Time  `db:"last_failure_date"`     // LastFailureDate is the time of the last failed attempt.
+	PreviousFailureDate *time.Time `db:"previous_failure_date"` // PreviousFailureDate is the time of the attempt before the last one.
+	LockedUntil         *time.Time `db:"locked_until"`          // LockedUntil is the time until which the key is locked.
+}
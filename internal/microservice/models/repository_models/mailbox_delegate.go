@@ -0,0 +1,23 @@
+package repository_models
+
+import "time"
+
+// MailboxDelegate represents the information about a profile that has access to a shared mailbox.
+type MailboxDelegate struct {
+	MailboxID    uint32    `db:"mailbox_id"`    // MailboxID is the unique identifier of the shared mailbox profile.
+	DelegateID   uint32    `db:"delegate_id"`   // DelegateID is the unique identifier of the delegate profile.
+	Mailbox      string    `db:"mailbox"`       // Mailbox is the login of the shared mailbox.
+	Delegate     string    `db:"delegate"`      // Delegate is the login of the delegate profile.
+	Role         string    `db:"role"`          // Role is the role of the delegate.
+	CreationDate time.Time `db:"creation_date"` // CreationDate is the date when the access was granted.
+}
+
+// DelegateAction represents an action a delegate performed in a shared mailbox.
+type DelegateAction struct {
+	ID           uint64    `db:"id"`            // ID is the unique identifier of the action in the database.
+	Mailbox      string    `db:"mailbox"`       // Mailbox is the login of the shared mailbox.
+	Delegate     string    `db:"delegate"`      // Delegate is the login of the delegate who performed the action.
+	Action       string    `db:"action"`        // Action is the performed action.
+	EmailID      *uint64   `db:"email_id"`      // EmailID is the identifier of the email the action was performed on, if any.
+	CreationDate time.Time `db:"creation_date"` // CreationDate is the date when the action was performed.
+}
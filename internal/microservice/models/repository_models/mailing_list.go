@@ -0,0 +1,22 @@
+package repository_models
+
+import "time"
+
+// MailingList represents the information about a distribution list.
+type MailingList struct {
+	ID           uint32    `db:"id"`            // ID is the unique identifier of the list in the database.
+	Address      string    `db:"address"`       // Address is the group address of the list.
+	Name         string    `db:"name"`          // Name is the display name of the list.
+	Description  *string   `db:"description"`   // Description is an optional description of the list.
+	PostPolicy   string    `db:"post_policy"`   // PostPolicy determines who can post to the list.
+	Moderated    bool      `db:"is_moderated"`  // Moderated indicates whether posts from non-owners are held for approval.
+	CreationDate time.Time `db:"creation_date"` // CreationDate is the date when the list was created.
+}
+
+// MailingListMember represents the information about a member of a distribution list.
+type MailingListMember struct {
+	ListID    uint32 `db:"list_id"`    // ListID is the unique identifier of the list.
+	ProfileID uint32 `db:"profile_id"` // ProfileID is the unique identifier of the member profile.
+	Login     string `db:"login"`      // Login is the login of the member profile.
+	Role      string `db:"role"`       // Role is the role of the member in the list.
+}
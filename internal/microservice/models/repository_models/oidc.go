@@ -0,0 +1,31 @@
+package repository_models
+
+import "time"
+
+// OIDCClient represents an application registered to sign users in with OpenID Connect.
+type OIDCClient struct {
+	ClientID     string    `db:"client_id"`     // ClientID is the unique identifier of the client.
+	SecretHash   *string   `db:"secret_hash"`   // SecretHash is the SHA-256 hash of the secret, NULL for public clients.
+	Name         string    `db:"name"`          // Name is shown to the user when they are asked for consent.
+	RedirectURIs string    `db:"redirect_uris"` // RedirectURIs are the space-separated addresses the user can be sent back to.
+	CreationDate time.Time `db:"creation_date"` // CreationDate is the date when the client was registered.
+}
+
+// OIDCAuthorizationCode represents a code issued to an OpenID Connect client.
+type OIDCAuthorizationCode struct {
+	CodeHash       string    `db:"code_hash"`       // CodeHash is the SHA-256 hash of the code.
+	ClientID       string    `db:"client_id"`       // ClientID is the unique identifier of the client the code is issued to.
+	ProfileID      uint32    `db:"profile_id"`      // ProfileID is the unique identifier of the signed-in user.
+	RedirectURI    string    `db:"redirect_uri"`    // RedirectURI is the address the code was sent to.
+	Scopes         string    `db:"scopes"`          // Scopes are the space-separated data of the user the client is allowed to read.
+	Nonce          string    `db:"nonce"`           // Nonce is the value of the client put into the ID token.
+	CodeChallenge  string    `db:"code_challenge"`  // CodeChallenge is the PKCE challenge of the client.
+	ExpirationDate time.Time `db:"expiration_date"` // ExpirationDate is the date after which the code can no longer be exchanged.
+}
+
+// OIDCSigningKey represents a key the OpenID Connect tokens are signed with.
+type OIDCSigningKey struct {
+	KeyID        string    `db:"kid"`           // KeyID is the unique identifier of the key.
+	PrivateKey   string    `db:"private_key"`   // PrivateKey is the RSA private key in the PEM format.
+	CreationDate time.Time `db:"creation_date"` // CreationDate is the date when the key was generated.
+}
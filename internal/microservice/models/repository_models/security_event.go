@@ -0,0 +1,17 @@
+package repository_models
+
+import "time"
+
+// SecurityEvent represents a security relevant action on an account in the audit log.
+type SecurityEvent struct {
+	ID           uint64    `db:"id"`            // ID is the unique identifier of the event.
+	ProfileID    *uint32   `db:"profile_id"`    // ProfileID is the unique identifier of the account, NULL if the account is unknown.
+	Login        string    `db:"login"`         // Login is the login given at sign-in.
+	Type         string    `db:"event_type"`    // Type is the kind of the action.
+	Method       string    `db:"method"`        // Method is the sign-in method for sign-in events.
+	Success      bool      `db:"success"`       // Success is whether the action succeeded.
+	Details      string    `db:"details"`       // Details describe the action.
+	IPAddress    string    `db:"ip_address"`    // IPAddress is the address of the client.
+	UserAgent    string    `db:"user_agent"`    // UserAgent is the device of the client.
+	CreationDate time.Time `db:"creation_date"` // CreationDate is the date of the event.
+}
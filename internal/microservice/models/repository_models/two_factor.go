@@ -0,0 +1,21 @@
+package repository_models
+
+import "time"
+
+// TwoFactor represents the two-factor authentication settings of a user.
+type TwoFactor struct {
+	ProfileID        uint32     `db:"profile_id"`        // ProfileID is the unique identifier of the user.
+	Secret           string     `db:"secret"`            // Secret is the base32 encoded TOTP secret.
+	Enabled          bool       `db:"enabled"`           // Enabled is set once the user confirmed the secret.
+	LastUsedStep     int64      `db:"last_used_step"`    // LastUsedStep is the last accepted TOTP time step.
+	CreationDate     time.Time  `db:"creation_date"`     // CreationDate is the date when the setup was started.
+	ConfirmationDate *time.Time `db:"confirmation_date"` // ConfirmationDate is the date when the setup was confirmed.
+}
+
+// TwoFactorChallenge represents a login waiting for the second factor.
+type TwoFactorChallenge struct {
+	ID             string    `db:"id"`              // ID is the unique identifier of the challenge.
+	ProfileID      uint32    `db:"profile_id"`      // ProfileID is the unique identifier of the user logging in.
+	Attempts       int       `db:"attempts"`        // Attempts is the number of wrong codes entered.
+	ExpirationDate time.Time `db:"expiration_date"` // ExpirationDate is the date after which the login has to be started again.
+}
@@ -11,7 +11,7 @@ import (
 // SessionRepository represents the interface for managing user sessions.
 type SessionRepository interface {
 	// CreateSession creates a new session and returns its ID.
-	CreateSession(userID uint32, device string, lifeTime int, ctx context.Context) (string, error)
+	CreateSession(userID uint32, device, ipAddress string, lifeTime int, ctx context.Context) (string, error)
 
 	// GetSessionByID retrieves a session by its ID.
 	GetSessionByID(sessionID string, ctx context.Context) (*domain.Session, error)
@@ -22,9 +22,28 @@ type SessionRepository interface {
 	// GetProfileIDBySessionID retrieves the profile id associated with the given session ID.
 	GetProfileIDBySessionID(sessionID string, ctx context.Context) (uint32, error)
 
+	// GetMailboxRoleBySessionID retrieves the login of the session and its role in the mailbox;
+	// the role is empty if the login has no access to the mailbox.
+	GetMailboxRoleBySessionID(sessionID, mailbox string, ctx context.Context) (string, string, error)
+
+	// GetSessionsByProfileID retrieves the active sessions of the profile, the most recently used first.
+	GetSessionsByProfileID(profileID uint32, ctx context.Context) ([]*domain.Session, error)
+
+	// UpdateSessionLastSeen sets the last seen date of the session to the current time.
+	UpdateSessionLastSeen(sessionID string, ctx context.Context) error
+
+	// DeleteOtherSessions deletes every session of the session owner except the given one and returns their number.
+	DeleteOtherSessions(sessionID string, ctx context.Context) (int64, error)
+
+	// DeleteSessionsByProfileID deletes every session of the profile and returns their number.
+	DeleteSessionsByProfileID(profileID uint32, ctx context.Context) (int64, error)
+
 	// DeleteSessionByID deletes a session by its ID.
 	DeleteSessionByID(sessionID string, ctx context.Context) error
 
+	// UpdateCsrfToken replaces the CSRF token of the session with a new one and returns it.
+	UpdateCsrfToken(sessionID string, ctx context.Context) (string, error)
+
 	// DeleteExpiredSessions removes all expired sessions.
 	DeleteExpiredSessions(ctx context.Context) error
 }
@@ -11,7 +11,7 @@ import (
 // SessionUseCase represents the interface for session-related operations.
 type SessionUseCase interface {
 	// CreateNewSession initiates a new session for a user.
-	CreateNewSession(userID uint32, device string, lifeTime int, ctx context.Context) (string, error)
+	CreateNewSession(userID uint32, device, ipAddress string, lifeTime int, ctx context.Context) (string, error)
 
 	// GetSession fetches a session by its unique identifier.
 	GetSession(sessionID string, ctx context.Context) (*domain.Session, error)
@@ -22,9 +22,27 @@ type SessionUseCase interface {
 	// GetProfileID retrieves the profile id associated with the given session ID.
 	GetProfileID(sessionID string, ctx context.Context) (uint32, error)
 
+	// GetMailboxRole retrieves the login of the session and its role in the mailbox.
+	GetMailboxRole(sessionID, mailbox string, ctx context.Context) (string, string, error)
+
+	// GetUserSessions retrieves the active sessions of the owner of the given session.
+	GetUserSessions(sessionID string, ctx context.Context) ([]*domain.Session, error)
+
+	// DeleteUserSession terminates a session of the owner of the given session by its public identifier.
+	DeleteUserSession(sessionID, publicID string, ctx context.Context) error
+
+	// DeleteOtherSessions terminates every session of the owner of the given session except the session itself.
+	DeleteOtherSessions(sessionID string, ctx context.Context) (int64, error)
+
+	// DeleteUserSessions terminates every session of the user.
+	DeleteUserSessions(userID uint32, ctx context.Context) (int64, error)
+
 	// DeleteSession terminates a session identified by its ID.
 	DeleteSession(sessionID string, ctx context.Context) error
 
+	// RotateCsrfToken replaces the CSRF token of the session and returns the new one.
+	RotateCsrfToken(sessionID string, ctx context.Context) (string, error)
+
 	// CleanupExpiredSessions removes sessions that have exceeded their lifetime.
 	CleanupExpiredSessions(ctx context.Context) error
 }
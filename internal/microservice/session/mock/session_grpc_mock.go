@@ -36,7 +36,7 @@ func (m *MockSessionServiceClient) EXPECT() *MockSessionServiceClientMockRecorde
 	return m.recorder
 }
 
-// CleanupExpiredSessions mock base method.
+// CleanupExpiredSessions mocks base method.
 func (m *MockSessionServiceClient) CleanupExpiredSessions(ctx context.Context, in *proto.CleanupExpiredSessionsRequest, opts ...grpc.CallOption) (*proto.CleanupExpiredSessionsReply, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
@@ -56,7 +56,7 @@ func (mr *MockSessionServiceClientMockRecorder) CleanupExpiredSessions(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupExpiredSessions", reflect.TypeOf((*MockSessionServiceClient)(nil).CleanupExpiredSessions), varargs...)
 }
 
-// CreateSession mock base method.
+// CreateSession mocks base method.
 func (m *MockSessionServiceClient) CreateSession(ctx context.Context, in *proto.CreateSessionRequest, opts ...grpc.CallOption) (*proto.CreateSessionReply, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
@@ -76,7 +76,27 @@ func (mr *MockSessionServiceClientMockRecorder) CreateSession(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionServiceClient)(nil).CreateSession), varargs...)
 }
 
-// DeleteSession mock base method.
+// DeleteOtherSessions mocks base method.
+func (m *MockSessionServiceClient) DeleteOtherSessions(ctx context.Context, in *proto.DeleteOtherSessionsRequest, opts ...grpc.CallOption) (*proto.DeleteOtherSessionsReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOtherSessions", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteOtherSessionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOtherSessions indicates an expected call of DeleteOtherSessions.
+func (mr *MockSessionServiceClientMockRecorder) DeleteOtherSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSessions", reflect.TypeOf((*MockSessionServiceClient)(nil).DeleteOtherSessions), varargs...)
+}
+
+// DeleteSession mocks base method.
 func (m *MockSessionServiceClient) DeleteSession(ctx context.Context, in *proto.DeleteSessionRequest, opts ...grpc.CallOption) (*proto.DeleteSessionReply, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
@@ -96,7 +116,47 @@ func (mr *MockSessionServiceClientMockRecorder) DeleteSession(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionServiceClient)(nil).DeleteSession), varargs...)
 }
 
-// GetLoginBySession mock base method.
+// DeleteUserSession mocks base method.
+func (m *MockSessionServiceClient) DeleteUserSession(ctx context.Context, in *proto.DeleteUserSessionRequest, opts ...grpc.CallOption) (*proto.DeleteSessionReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserSession", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteSessionReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSession indicates an expected call of DeleteUserSession.
+func (mr *MockSessionServiceClientMockRecorder) DeleteUserSession(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSession", reflect.TypeOf((*MockSessionServiceClient)(nil).DeleteUserSession), varargs...)
+}
+
+// DeleteUserSessions mocks base method.
+func (m *MockSessionServiceClient) DeleteUserSessions(ctx context.Context, in *proto.DeleteUserSessionsRequest, opts ...grpc.CallOption) (*proto.DeleteOtherSessionsReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserSessions", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteOtherSessionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *MockSessionServiceClientMockRecorder) DeleteUserSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockSessionServiceClient)(nil).DeleteUserSessions), varargs...)
+}
+
+// GetLoginBySession mocks base method.
 func (m *MockSessionServiceClient) GetLoginBySession(ctx context.Context, in *proto.GetLoginBySessionRequest, opts ...grpc.CallOption) (*proto.GetLoginBySessionReply, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
@@ -116,7 +176,27 @@ func (mr *MockSessionServiceClientMockRecorder) GetLoginBySession(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginBySession", reflect.TypeOf((*MockSessionServiceClient)(nil).GetLoginBySession), varargs...)
 }
 
-// GetProfileIDBySession mock base method.
+// GetMailboxRole mocks base method.
+func (m *MockSessionServiceClient) GetMailboxRole(ctx context.Context, in *proto.GetMailboxRoleRequest, opts ...grpc.CallOption) (*proto.GetMailboxRoleReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMailboxRole", varargs...)
+	ret0, _ := ret[0].(*proto.GetMailboxRoleReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailboxRole indicates an expected call of GetMailboxRole.
+func (mr *MockSessionServiceClientMockRecorder) GetMailboxRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxRole", reflect.TypeOf((*MockSessionServiceClient)(nil).GetMailboxRole), varargs...)
+}
+
+// GetProfileIDBySession mocks base method.
 func (m *MockSessionServiceClient) GetProfileIDBySession(ctx context.Context, in *proto.GetLoginBySessionRequest, opts ...grpc.CallOption) (*proto.GetProfileIDBySessionReply, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
@@ -136,7 +216,7 @@ func (mr *MockSessionServiceClientMockRecorder) GetProfileIDBySession(ctx, in in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileIDBySession", reflect.TypeOf((*MockSessionServiceClient)(nil).GetProfileIDBySession), varargs...)
 }
 
-// GetSession mock base method.
+// GetSession mocks base method.
 func (m *MockSessionServiceClient) GetSession(ctx context.Context, in *proto.GetSessionRequest, opts ...grpc.CallOption) (*proto.GetSessionReply, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
@@ -156,6 +236,46 @@ func (mr *MockSessionServiceClientMockRecorder) GetSession(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionServiceClient)(nil).GetSession), varargs...)
 }
 
+// GetUserSessions mocks base method.
+func (m *MockSessionServiceClient) GetUserSessions(ctx context.Context, in *proto.GetUserSessionsRequest, opts ...grpc.CallOption) (*proto.GetUserSessionsReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserSessions", varargs...)
+	ret0, _ := ret[0].(*proto.GetUserSessionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockSessionServiceClientMockRecorder) GetUserSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockSessionServiceClient)(nil).GetUserSessions), varargs...)
+}
+
+// RotateCsrfToken mocks base method.
+func (m *MockSessionServiceClient) RotateCsrfToken(ctx context.Context, in *proto.RotateCsrfTokenRequest, opts ...grpc.CallOption) (*proto.RotateCsrfTokenReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateCsrfToken", varargs...)
+	ret0, _ := ret[0].(*proto.RotateCsrfTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCsrfToken indicates an expected call of RotateCsrfToken.
+func (mr *MockSessionServiceClientMockRecorder) RotateCsrfToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCsrfToken", reflect.TypeOf((*MockSessionServiceClient)(nil).RotateCsrfToken), varargs...)
+}
+
 // MockSessionServiceServer is a mock of SessionServiceServer interface.
 type MockSessionServiceServer struct {
 	ctrl     *gomock.Controller
@@ -179,7 +299,7 @@ func (m *MockSessionServiceServer) EXPECT() *MockSessionServiceServerMockRecorde
 	return m.recorder
 }
 
-// CleanupExpiredSessions mock base method.
+// CleanupExpiredSessions mocks base method.
 func (m *MockSessionServiceServer) CleanupExpiredSessions(arg0 context.Context, arg1 *proto.CleanupExpiredSessionsRequest) (*proto.CleanupExpiredSessionsReply, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CleanupExpiredSessions", arg0, arg1)
@@ -194,7 +314,7 @@ func (mr *MockSessionServiceServerMockRecorder) CleanupExpiredSessions(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupExpiredSessions", reflect.TypeOf((*MockSessionServiceServer)(nil).CleanupExpiredSessions), arg0, arg1)
 }
 
-// CreateSession mock base method.
+// CreateSession mocks base method.
 func (m *MockSessionServiceServer) CreateSession(arg0 context.Context, arg1 *proto.CreateSessionRequest) (*proto.CreateSessionReply, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
@@ -209,7 +329,22 @@ func (mr *MockSessionServiceServerMockRecorder) CreateSession(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionServiceServer)(nil).CreateSession), arg0, arg1)
 }
 
-// DeleteSession mock base method.
+// DeleteOtherSessions mocks base method.
+func (m *MockSessionServiceServer) DeleteOtherSessions(arg0 context.Context, arg1 *proto.DeleteOtherSessionsRequest) (*proto.DeleteOtherSessionsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOtherSessions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteOtherSessionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOtherSessions indicates an expected call of DeleteOtherSessions.
+func (mr *MockSessionServiceServerMockRecorder) DeleteOtherSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSessions", reflect.TypeOf((*MockSessionServiceServer)(nil).DeleteOtherSessions), arg0, arg1)
+}
+
+// DeleteSession mocks base method.
 func (m *MockSessionServiceServer) DeleteSession(arg0 context.Context, arg1 *proto.DeleteSessionRequest) (*proto.DeleteSessionReply, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
@@ -224,7 +359,37 @@ func (mr *MockSessionServiceServerMockRecorder) DeleteSession(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionServiceServer)(nil).DeleteSession), arg0, arg1)
 }
 
-// GetLoginBySession mock base method.
+// DeleteUserSession mocks base method.
+func (m *MockSessionServiceServer) DeleteUserSession(arg0 context.Context, arg1 *proto.DeleteUserSessionRequest) (*proto.DeleteSessionReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSession", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteSessionReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSession indicates an expected call of DeleteUserSession.
+func (mr *MockSessionServiceServerMockRecorder) DeleteUserSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSession", reflect.TypeOf((*MockSessionServiceServer)(nil).DeleteUserSession), arg0, arg1)
+}
+
+// DeleteUserSessions mocks base method.
+func (m *MockSessionServiceServer) DeleteUserSessions(arg0 context.Context, arg1 *proto.DeleteUserSessionsRequest) (*proto.DeleteOtherSessionsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteOtherSessionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *MockSessionServiceServerMockRecorder) DeleteUserSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockSessionServiceServer)(nil).DeleteUserSessions), arg0, arg1)
+}
+
+// GetLoginBySession mocks base method.
 func (m *MockSessionServiceServer) GetLoginBySession(arg0 context.Context, arg1 *proto.GetLoginBySessionRequest) (*proto.GetLoginBySessionReply, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLoginBySession", arg0, arg1)
@@ -239,7 +404,22 @@ func (mr *MockSessionServiceServerMockRecorder) GetLoginBySession(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginBySession", reflect.TypeOf((*MockSessionServiceServer)(nil).GetLoginBySession), arg0, arg1)
 }
 
-// GetProfileIDBySession mock base method.
+// GetMailboxRole mocks base method.
+func (m *MockSessionServiceServer) GetMailboxRole(arg0 context.Context, arg1 *proto.GetMailboxRoleRequest) (*proto.GetMailboxRoleReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailboxRole", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetMailboxRoleReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailboxRole indicates an expected call of GetMailboxRole.
+func (mr *MockSessionServiceServerMockRecorder) GetMailboxRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxRole", reflect.TypeOf((*MockSessionServiceServer)(nil).GetMailboxRole), arg0, arg1)
+}
+
+// GetProfileIDBySession mocks base method.
 func (m *MockSessionServiceServer) GetProfileIDBySession(arg0 context.Context, arg1 *proto.GetLoginBySessionRequest) (*proto.GetProfileIDBySessionReply, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProfileIDBySession", arg0, arg1)
@@ -254,7 +434,7 @@ func (mr *MockSessionServiceServerMockRecorder) GetProfileIDBySession(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileIDBySession", reflect.TypeOf((*MockSessionServiceServer)(nil).GetProfileIDBySession), arg0, arg1)
 }
 
-// GetSession mock base method.
+// GetSession mocks base method.
 func (m *MockSessionServiceServer) GetSession(arg0 context.Context, arg1 *proto.GetSessionRequest) (*proto.GetSessionReply, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
@@ -269,7 +449,37 @@ func (mr *MockSessionServiceServerMockRecorder) GetSession(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionServiceServer)(nil).GetSession), arg0, arg1)
 }
 
-// mustEmbedUnimplementedSessionServiceServer mock base method.
+// GetUserSessions mocks base method.
+func (m *MockSessionServiceServer) GetUserSessions(arg0 context.Context, arg1 *proto.GetUserSessionsRequest) (*proto.GetUserSessionsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetUserSessionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockSessionServiceServerMockRecorder) GetUserSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockSessionServiceServer)(nil).GetUserSessions), arg0, arg1)
+}
+
+// RotateCsrfToken mocks base method.
+func (m *MockSessionServiceServer) RotateCsrfToken(arg0 context.Context, arg1 *proto.RotateCsrfTokenRequest) (*proto.RotateCsrfTokenReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCsrfToken", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RotateCsrfTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCsrfToken indicates an expected call of RotateCsrfToken.
+func (mr *MockSessionServiceServerMockRecorder) RotateCsrfToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCsrfToken", reflect.TypeOf((*MockSessionServiceServer)(nil).RotateCsrfToken), arg0, arg1)
+}
+
+// mustEmbedUnimplementedSessionServiceServer mocks base method.
 func (m *MockSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "mustEmbedUnimplementedSessionServiceServer")
@@ -304,7 +514,7 @@ func (m *MockUnsafeSessionServiceServer) EXPECT() *MockUnsafeSessionServiceServe
 	return m.recorder
 }
 
-// mustEmbedUnimplementedSessionServiceServer mock base method.
+// mustEmbedUnimplementedSessionServiceServer mocks base method.
 func (m *MockUnsafeSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "mustEmbedUnimplementedSessionServiceServer")
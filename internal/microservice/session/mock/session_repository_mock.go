@@ -35,22 +35,22 @@ func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateSession mock base method.
-func (m *MockSessionRepository) CreateSession(userID uint32, device string, lifeTime int, ctx context.Context) (string, error) {
+// CreateSession mocks base method.
+func (m *MockSessionRepository) CreateSession(userID uint32, device, ipAddress string, lifeTime int, ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", userID, device, lifeTime, ctx)
+	ret := m.ctrl.Call(m, "CreateSession", userID, device, ipAddress, lifeTime, ctx)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockSessionRepositoryMockRecorder) CreateSession(userID, device, lifeTime, ctx interface{}) *gomock.Call {
+func (mr *MockSessionRepositoryMockRecorder) CreateSession(userID, device, ipAddress, lifeTime, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionRepository)(nil).CreateSession), userID, device, lifeTime, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionRepository)(nil).CreateSession), userID, device, ipAddress, lifeTime, ctx)
 }
 
-// DeleteExpiredSessions mock base method.
+// DeleteExpiredSessions mocks base method.
 func (m *MockSessionRepository) DeleteExpiredSessions(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteExpiredSessions", ctx)
@@ -64,7 +64,22 @@ func (mr *MockSessionRepositoryMockRecorder) DeleteExpiredSessions(ctx interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredSessions", reflect.TypeOf((*MockSessionRepository)(nil).DeleteExpiredSessions), ctx)
 }
 
-// DeleteSessionByID mock base method.
+// DeleteOtherSessions mocks base method.
+func (m *MockSessionRepository) DeleteOtherSessions(sessionID string, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOtherSessions", sessionID, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOtherSessions indicates an expected call of DeleteOtherSessions.
+func (mr *MockSessionRepositoryMockRecorder) DeleteOtherSessions(sessionID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSessions", reflect.TypeOf((*MockSessionRepository)(nil).DeleteOtherSessions), sessionID, ctx)
+}
+
+// DeleteSessionByID mocks base method.
 func (m *MockSessionRepository) DeleteSessionByID(sessionID string, ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSessionByID", sessionID, ctx)
@@ -78,7 +93,22 @@ func (mr *MockSessionRepositoryMockRecorder) DeleteSessionByID(sessionID, ctx in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionByID", reflect.TypeOf((*MockSessionRepository)(nil).DeleteSessionByID), sessionID, ctx)
 }
 
-// GetLoginBySessionID mock base method.
+// DeleteSessionsByProfileID mocks base method.
+func (m *MockSessionRepository) DeleteSessionsByProfileID(profileID uint32, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionsByProfileID", profileID, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSessionsByProfileID indicates an expected call of DeleteSessionsByProfileID.
+func (mr *MockSessionRepositoryMockRecorder) DeleteSessionsByProfileID(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByProfileID", reflect.TypeOf((*MockSessionRepository)(nil).DeleteSessionsByProfileID), profileID, ctx)
+}
+
+// GetLoginBySessionID mocks base method.
 func (m *MockSessionRepository) GetLoginBySessionID(sessionID string, ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLoginBySessionID", sessionID, ctx)
@@ -93,7 +123,23 @@ func (mr *MockSessionRepositoryMockRecorder) GetLoginBySessionID(sessionID, ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginBySessionID", reflect.TypeOf((*MockSessionRepository)(nil).GetLoginBySessionID), sessionID, ctx)
 }
 
-// GetProfileIDBySessionID mock base method.
+// GetMailboxRoleBySessionID mocks base method.
+func (m *MockSessionRepository) GetMailboxRoleBySessionID(sessionID, mailbox string, ctx context.Context) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailboxRoleBySessionID", sessionID, mailbox, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMailboxRoleBySessionID indicates an expected call of GetMailboxRoleBySessionID.
+func (mr *MockSessionRepositoryMockRecorder) GetMailboxRoleBySessionID(sessionID, mailbox, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxRoleBySessionID", reflect.TypeOf((*MockSessionRepository)(nil).GetMailboxRoleBySessionID), sessionID, mailbox, ctx)
+}
+
+// GetProfileIDBySessionID mocks base method.
 func (m *MockSessionRepository) GetProfileIDBySessionID(sessionID string, ctx context.Context) (uint32, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProfileIDBySessionID", sessionID, ctx)
@@ -108,7 +154,7 @@ func (mr *MockSessionRepositoryMockRecorder) GetProfileIDBySessionID(sessionID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileIDBySessionID", reflect.TypeOf((*MockSessionRepository)(nil).GetProfileIDBySessionID), sessionID, ctx)
 }
 
-// GetSessionByID mock base method.
+// GetSessionByID mocks base method.
 func (m *MockSessionRepository) GetSessionByID(sessionID string, ctx context.Context) (*domain_models.Session, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSessionByID", sessionID, ctx)
@@ -122,3 +168,47 @@ func (mr *MockSessionRepositoryMockRecorder) GetSessionByID(sessionID, ctx inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByID", reflect.TypeOf((*MockSessionRepository)(nil).GetSessionByID), sessionID, ctx)
 }
+
+// GetSessionsByProfileID mocks base method.
+func (m *MockSessionRepository) GetSessionsByProfileID(profileID uint32, ctx context.Context) ([]*domain_models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsByProfileID", profileID, ctx)
+	ret0, _ := ret[0].([]*domain_models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsByProfileID indicates an expected call of GetSessionsByProfileID.
+func (mr *MockSessionRepositoryMockRecorder) GetSessionsByProfileID(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsByProfileID", reflect.TypeOf((*MockSessionRepository)(nil).GetSessionsByProfileID), profileID, ctx)
+}
+
+// UpdateCsrfToken mocks base method.
+func (m *MockSessionRepository) UpdateCsrfToken(sessionID string, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCsrfToken", sessionID, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCsrfToken indicates an expected call of UpdateCsrfToken.
+func (mr *MockSessionRepositoryMockRecorder) UpdateCsrfToken(sessionID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCsrfToken", reflect.TypeOf((*MockSessionRepository)(nil).UpdateCsrfToken), sessionID, ctx)
+}
+
+// UpdateSessionLastSeen mocks base method.
+func (m *MockSessionRepository) UpdateSessionLastSeen(sessionID string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSessionLastSeen", sessionID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSessionLastSeen indicates an expected call of UpdateSessionLastSeen.
+func (mr *MockSessionRepositoryMockRecorder) UpdateSessionLastSeen(sessionID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSessionLastSeen", reflect.TypeOf((*MockSessionRepository)(nil).UpdateSessionLastSeen), sessionID, ctx)
+}
@@ -35,7 +35,7 @@ func (m *MockSessionUseCase) EXPECT() *MockSessionUseCaseMockRecorder {
 	return m.recorder
 }
 
-// CleanupExpiredSessions mock base method.
+// CleanupExpiredSessions mocks base method.
 func (m *MockSessionUseCase) CleanupExpiredSessions(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CleanupExpiredSessions", ctx)
@@ -49,22 +49,37 @@ func (mr *MockSessionUseCaseMockRecorder) CleanupExpiredSessions(ctx interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupExpiredSessions", reflect.TypeOf((*MockSessionUseCase)(nil).CleanupExpiredSessions), ctx)
 }
 
-// CreateNewSession mock base method.
-func (m *MockSessionUseCase) CreateNewSession(userID uint32, device string, lifeTime int, ctx context.Context) (string, error) {
+// CreateNewSession mocks base method.
+func (m *MockSessionUseCase) CreateNewSession(userID uint32, device, ipAddress string, lifeTime int, ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNewSession", userID, device, lifeTime, ctx)
+	ret := m.ctrl.Call(m, "CreateNewSession", userID, device, ipAddress, lifeTime, ctx)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNewSession indicates an expected call of CreateNewSession.
-func (mr *MockSessionUseCaseMockRecorder) CreateNewSession(userID, device, lifeTime, ctx interface{}) *gomock.Call {
+func (mr *MockSessionUseCaseMockRecorder) CreateNewSession(userID, device, ipAddress, lifeTime, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewSession", reflect.TypeOf((*MockSessionUseCase)(nil).CreateNewSession), userID, device, lifeTime, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewSession", reflect.TypeOf((*MockSessionUseCase)(nil).CreateNewSession), userID, device, ipAddress, lifeTime, ctx)
 }
 
-// DeleteSession mock base method.
+// DeleteOtherSessions mocks base method.
+func (m *MockSessionUseCase) DeleteOtherSessions(sessionID string, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOtherSessions", sessionID, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOtherSessions indicates an expected call of DeleteOtherSessions.
+func (mr *MockSessionUseCaseMockRecorder) DeleteOtherSessions(sessionID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSessions", reflect.TypeOf((*MockSessionUseCase)(nil).DeleteOtherSessions), sessionID, ctx)
+}
+
+// DeleteSession mocks base method.
 func (m *MockSessionUseCase) DeleteSession(sessionID string, ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSession", sessionID, ctx)
@@ -78,7 +93,36 @@ func (mr *MockSessionUseCaseMockRecorder) DeleteSession(sessionID, ctx interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionUseCase)(nil).DeleteSession), sessionID, ctx)
 }
 
-// GetLogin mock base method.
+// DeleteUserSession mocks base method.
+func (m *MockSessionUseCase) DeleteUserSession(sessionID, publicID string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSession", sessionID, publicID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSession indicates an expected call of DeleteUserSession.
+func (mr *MockSessionUseCaseMockRecorder) DeleteUserSession(sessionID, publicID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSession", reflect.TypeOf((*MockSessionUseCase)(nil).DeleteUserSession), sessionID, publicID, ctx)
+}
+
+// DeleteUserSessions mocks base method.
+func (m *MockSessionUseCase) DeleteUserSessions(userID uint32, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", userID, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *MockSessionUseCaseMockRecorder) DeleteUserSessions(userID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockSessionUseCase)(nil).DeleteUserSessions), userID, ctx)
+}
+
+// GetLogin mocks base method.
 func (m *MockSessionUseCase) GetLogin(sessionID string, ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLogin", sessionID, ctx)
@@ -93,7 +137,23 @@ func (mr *MockSessionUseCaseMockRecorder) GetLogin(sessionID, ctx interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogin", reflect.TypeOf((*MockSessionUseCase)(nil).GetLogin), sessionID, ctx)
 }
 
-// GetProfileID mock base method.
+// GetMailboxRole mocks base method.
+func (m *MockSessionUseCase) GetMailboxRole(sessionID, mailbox string, ctx context.Context) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailboxRole", sessionID, mailbox, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMailboxRole indicates an expected call of GetMailboxRole.
+func (mr *MockSessionUseCaseMockRecorder) GetMailboxRole(sessionID, mailbox, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailboxRole", reflect.TypeOf((*MockSessionUseCase)(nil).GetMailboxRole), sessionID, mailbox, ctx)
+}
+
+// GetProfileID mocks base method.
 func (m *MockSessionUseCase) GetProfileID(sessionID string, ctx context.Context) (uint32, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProfileID", sessionID, ctx)
@@ -108,7 +168,7 @@ func (mr *MockSessionUseCaseMockRecorder) GetProfileID(sessionID, ctx interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileID", reflect.TypeOf((*MockSessionUseCase)(nil).GetProfileID), sessionID, ctx)
 }
 
-// GetSession mock base method.
+// GetSession mocks base method.
 func (m *MockSessionUseCase) GetSession(sessionID string, ctx context.Context) (*domain_models.Session, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSession", sessionID, ctx)
@@ -122,3 +182,33 @@ func (mr *MockSessionUseCaseMockRecorder) GetSession(sessionID, ctx interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionUseCase)(nil).GetSession), sessionID, ctx)
 }
+
+// GetUserSessions mocks base method.
+func (m *MockSessionUseCase) GetUserSessions(sessionID string, ctx context.Context) ([]*domain_models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", sessionID, ctx)
+	ret0, _ := ret[0].([]*domain_models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockSessionUseCaseMockRecorder) GetUserSessions(sessionID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockSessionUseCase)(nil).GetUserSessions), sessionID, ctx)
+}
+
+// RotateCsrfToken mocks base method.
+func (m *MockSessionUseCase) RotateCsrfToken(sessionID string, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCsrfToken", sessionID, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCsrfToken indicates an expected call of RotateCsrfToken.
+func (mr *MockSessionUseCaseMockRecorder) RotateCsrfToken(sessionID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCsrfToken", reflect.TypeOf((*MockSessionUseCase)(nil).RotateCsrfToken), sessionID, ctx)
+}
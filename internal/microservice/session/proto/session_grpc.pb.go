@@ -30,6 +30,12 @@ type SessionServiceClient interface {
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionReply, error)
 	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionReply, error)
 	CleanupExpiredSessions(ctx context.Context, in *CleanupExpiredSessionsRequest, opts ...grpc.CallOption) (*CleanupExpiredSessionsReply, error)
+	GetMailboxRole(ctx context.Context, in *GetMailboxRoleRequest, opts ...grpc.CallOption) (*GetMailboxRoleReply, error)
+	GetUserSessions(ctx context.Context, in *GetUserSessionsRequest, opts ...grpc.CallOption) (*GetUserSessionsReply, error)
+	DeleteUserSession(ctx context.Context, in *DeleteUserSessionRequest, opts ...grpc.CallOption) (*DeleteSessionReply, error)
+	DeleteOtherSessions(ctx context.Context, in *DeleteOtherSessionsRequest, opts ...grpc.CallOption) (*DeleteOtherSessionsReply, error)
+	DeleteUserSessions(ctx context.Context, in *DeleteUserSessionsRequest, opts ...grpc.CallOption) (*DeleteOtherSessionsReply, error)
+	RotateCsrfToken(ctx context.Context, in *RotateCsrfTokenRequest, opts ...grpc.CallOption) (*RotateCsrfTokenReply, error)
 }
 
 type sessionServiceClient struct {
@@ -94,6 +100,60 @@ func (c *sessionServiceClient) CleanupExpiredSessions(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *sessionServiceClient) GetMailboxRole(ctx context.Context, in *GetMailboxRoleRequest, opts ...grpc.CallOption) (*GetMailboxRoleReply, error) {
+	out := new(GetMailboxRoleReply)
+	err := c.cc.Invoke(ctx, "/proto.SessionService/GetMailboxRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetUserSessions(ctx context.Context, in *GetUserSessionsRequest, opts ...grpc.CallOption) (*GetUserSessionsReply, error) {
+	out := new(GetUserSessionsReply)
+	err := c.cc.Invoke(ctx, "/proto.SessionService/GetUserSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DeleteUserSession(ctx context.Context, in *DeleteUserSessionRequest, opts ...grpc.CallOption) (*DeleteSessionReply, error) {
+	out := new(DeleteSessionReply)
+	err := c.cc.Invoke(ctx, "/proto.SessionService/DeleteUserSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DeleteOtherSessions(ctx context.Context, in *DeleteOtherSessionsRequest, opts ...grpc.CallOption) (*DeleteOtherSessionsReply, error) {
+	out := new(DeleteOtherSessionsReply)
+	err := c.cc.Invoke(ctx, "/proto.SessionService/DeleteOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DeleteUserSessions(ctx context.Context, in *DeleteUserSessionsRequest, opts ...grpc.CallOption) (*DeleteOtherSessionsReply, error) {
+	out := new(DeleteOtherSessionsReply)
+	err := c.cc.Invoke(ctx, "/proto.SessionService/DeleteUserSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RotateCsrfToken(ctx context.Context, in *RotateCsrfTokenRequest, opts ...grpc.CallOption) (*RotateCsrfTokenReply, error) {
+	out := new(RotateCsrfTokenReply)
+	err := c.cc.Invoke(ctx, "/proto.SessionService/RotateCsrfToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -104,6 +164,12 @@ type SessionServiceServer interface {
 	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionReply, error)
 	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionReply, error)
 	CleanupExpiredSessions(context.Context, *CleanupExpiredSessionsRequest) (*CleanupExpiredSessionsReply, error)
+	GetMailboxRole(context.Context, *GetMailboxRoleRequest) (*GetMailboxRoleReply, error)
+	GetUserSessions(context.Context, *GetUserSessionsRequest) (*GetUserSessionsReply, error)
+	DeleteUserSession(context.Context, *DeleteUserSessionRequest) (*DeleteSessionReply, error)
+	DeleteOtherSessions(context.Context, *DeleteOtherSessionsRequest) (*DeleteOtherSessionsReply, error)
+	DeleteUserSessions(context.Context, *DeleteUserSessionsRequest) (*DeleteOtherSessionsReply, error)
+	RotateCsrfToken(context.Context, *RotateCsrfTokenRequest) (*RotateCsrfTokenReply, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -129,6 +195,24 @@ func (UnimplementedSessionServiceServer) DeleteSession(context.Context, *DeleteS
 func (UnimplementedSessionServiceServer) CleanupExpiredSessions(context.Context, *CleanupExpiredSessionsRequest) (*CleanupExpiredSessionsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CleanupExpiredSessions not implemented")
 }
+func (UnimplementedSessionServiceServer) GetMailboxRole(context.Context, *GetMailboxRoleRequest) (*GetMailboxRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailboxRole not implemented")
+}
+func (UnimplementedSessionServiceServer) GetUserSessions(context.Context, *GetUserSessionsRequest) (*GetUserSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSessions not implemented")
+}
+func (UnimplementedSessionServiceServer) DeleteUserSession(context.Context, *DeleteUserSessionRequest) (*DeleteSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSession not implemented")
+}
+func (UnimplementedSessionServiceServer) DeleteOtherSessions(context.Context, *DeleteOtherSessionsRequest) (*DeleteOtherSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOtherSessions not implemented")
+}
+func (UnimplementedSessionServiceServer) DeleteUserSessions(context.Context, *DeleteUserSessionsRequest) (*DeleteOtherSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSessions not implemented")
+}
+func (UnimplementedSessionServiceServer) RotateCsrfToken(context.Context, *RotateCsrfTokenRequest) (*RotateCsrfTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCsrfToken not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +334,114 @@ func _SessionService_CleanupExpiredSessions_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_GetMailboxRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMailboxRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetMailboxRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SessionService/GetMailboxRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetMailboxRole(ctx, req.(*GetMailboxRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SessionService/GetUserSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetUserSessions(ctx, req.(*GetUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DeleteUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DeleteUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SessionService/DeleteUserSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DeleteUserSession(ctx, req.(*DeleteUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DeleteOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DeleteOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SessionService/DeleteOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DeleteOtherSessions(ctx, req.(*DeleteOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DeleteUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DeleteUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SessionService/DeleteUserSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DeleteUserSessions(ctx, req.(*DeleteUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RotateCsrfToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCsrfTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RotateCsrfToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SessionService/RotateCsrfToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RotateCsrfToken(ctx, req.(*RotateCsrfTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +473,30 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CleanupExpiredSessions",
 			Handler:    _SessionService_CleanupExpiredSessions_Handler,
 		},
+		{
+			MethodName: "GetMailboxRole",
+			Handler:    _SessionService_GetMailboxRole_Handler,
+		},
+		{
+			MethodName: "GetUserSessions",
+			Handler:    _SessionService_GetUserSessions_Handler,
+		},
+		{
+			MethodName: "DeleteUserSession",
+			Handler:    _SessionService_DeleteUserSession_Handler,
+		},
+		{
+			MethodName: "DeleteOtherSessions",
+			Handler:    _SessionService_DeleteOtherSessions_Handler,
+		},
+		{
+			MethodName: "DeleteUserSessions",
+			Handler:    _SessionService_DeleteUserSessions_Handler,
+		},
+		{
+			MethodName: "RotateCsrfToken",
+			Handler:    _SessionService_RotateCsrfToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "session.proto",
@@ -0,0 +1,515 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+const (
+	// sessionKeyPrefix is the prefix of the keys of the hashes the sessions are stored in.
+	sessionKeyPrefix = "session:"
+	// profileSessionsKeyPrefix is the prefix of the keys of the sets of session IDs of every profile.
+	profileSessionsKeyPrefix = "profile_sessions:"
+)
+
+// setExistingSessionField sets a field of the session hash only if the session still exists,
+// so that a session expired in the meantime is not recreated without a lifetime.
+// The optional third argument is the new expiration date of the session in milliseconds.
+var setExistingSessionField = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+if ARGV[3] then
+	redis.call('PEXPIREAT', KEYS[1], ARGV[3])
+end
+return 1
+`)
+
+// SessionRedisRepository represents an implementation of the SessionRepository interface
+// on a key-value store speaking the Redis protocol.
+// Sessions are stored as hashes expiring by themselves, the profiles and mailbox delegates are read from PostgreSQL.
+type SessionRedisRepository struct {
+	Client *redis.Client
+	DB     *sqlx.DB
+}
+
+// NewSessionRedisRepository creates a new instance of SessionRedisRepository.
+func NewSessionRedisRepository(client *redis.Client, db *sqlx.DB) *SessionRedisRepository {
+	return &SessionRedisRepository{
+		Client: client,
+		DB:     db,
+	}
+}
+
+// sessionKey returns the key of the hash the session is stored in.
+func sessionKey(sessionID string) string {
+	return sessionKeyPrefix + sessionID
+}
+
+// profileSessionsKey returns the key of the set of session IDs of the profile.
+func profileSessionsKey(profileID uint32) string {
+	return profileSessionsKeyPrefix + strconv.FormatUint(uint64(profileID), 10)
+}
+
+// sessionConvertDbInRedis converts a session into the fields of its hash.
+func sessionConvertDbInRedis(session *database.Session) map[string]interface{} {
+	return map[string]interface{}{
+		"profile_id":     session.UserID,
+		"creation_date":  session.CreationDate.Format(time.RFC3339Nano),
+		"device":         session.Device,
+		"life_time":      session.LifeTime,
+		"csrf_token":     session.CsrfToken,
+		"ip_address":     session.IPAddress,
+		"last_seen_date": session.LastSeenDate.Format(time.RFC3339Nano),
+	}
+}
+
+// sessionConvertRedisInDb converts the fields of a session hash into a session.
+func sessionConvertRedisInDb(sessionID string, fields map[string]string) (*database.Session, error) {
+	profileID, err := strconv.ParseUint(fields["profile_id"], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile id: %v", err)
+	}
+
+	lifeTime, err := strconv.Atoi(fields["life_time"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid life time: %v", err)
+	}
+
+	creationDate, err := time.Parse(time.RFC3339Nano, fields["creation_date"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid creation date: %v", err)
+	}
+
+	lastSeenDate, err := time.Parse(time.RFC3339Nano, fields["last_seen_date"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid last seen date: %v", err)
+	}
+
+	return &database.Session{
+		ID:           sessionID,
+		UserID:       uint32(profileID),
+		CreationDate: creationDate,
+		Device:       fields["device"],
+		LifeTime:     lifeTime,
+		CsrfToken:    fields["csrf_token"],
+		IPAddress:    fields["ip_address"],
+		LastSeenDate: lastSeenDate,
+	}, nil
+}
+
+// CreateSession creates a new session and returns its ID.
+// The session key expires together with the session, the set of the profile sessions outlives every session in it.
+func (repo *SessionRedisRepository) CreateSession(userID uint32, device, ipAddress string, lifeTime int, ctx context.Context) (string, error) {
+	ID := SessionGenerateRandomID()
+	creationDate := time.Now()
+
+	session := &database.Session{
+		ID:           ID,
+		UserID:       userID,
+		CreationDate: creationDate,
+		Device:       device,
+		LifeTime:     lifeTime,
+		CsrfToken:    SessionGenerateRandomID(),
+		IPAddress:    ipAddress,
+		LastSeenDate: creationDate,
+	}
+	expirationDate := converters.SessionConvertDbInCore(session).ExpirationDate()
+
+	query := "HSET " + sessionKey(ID) + "; PEXPIREAT; SADD " + profileSessionsKey(userID) + "; EXPIRE"
+
+	start := time.Now()
+	_, err := repo.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, sessionKey(ID), sessionConvertDbInRedis(session))
+		pipe.PExpireAt(ctx, sessionKey(ID), expirationDate)
+		pipe.SAdd(ctx, profileSessionsKey(userID), ID)
+		pipe.Expire(ctx, profileSessionsKey(userID), domain.SessionMaxLifeTime)
+
+		return nil
+	})
+
+	args := []interface{}{ID, userID, creationDate, device, lifeTime, ipAddress}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to create a session: %v", err)
+	}
+
+	return ID, nil
+}
+
+// GetSessionByID retrieves a session by its ID.
+func (repo *SessionRedisRepository) GetSessionByID(sessionID string, ctx context.Context) (*domain.Session, error) {
+	query := "HGETALL " + sessionKey(sessionID)
+
+	start := time.Now()
+	fields, err := repo.Client.HGetAll(ctx, sessionKey(sessionID)).Result()
+	if err == nil && len(fields) == 0 {
+		err = redis.Nil
+	}
+
+	args := []interface{}{sessionID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %v", err)
+	}
+
+	session, err := sessionConvertRedisInDb(sessionID, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %v", err)
+	}
+
+	return converters.SessionConvertDbInCore(session), nil
+}
+
+// GetProfileIDBySessionID retrieves the profile id associated with the given session ID.
+func (repo *SessionRedisRepository) GetProfileIDBySessionID(sessionID string, ctx context.Context) (uint32, error) {
+	query := "HGET " + sessionKey(sessionID) + " profile_id"
+
+	start := time.Now()
+	profileID, err := repo.Client.HGet(ctx, sessionKey(sessionID), "profile_id").Uint64()
+
+	args := []interface{}{sessionID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user id: %v", err)
+	}
+
+	return uint32(profileID), nil
+}
+
+// GetLoginBySessionID retrieves the login associated with the given session ID.
+func (repo *SessionRedisRepository) GetLoginBySessionID(sessionID string, ctx context.Context) (string, error) {
+	profileID, err := repo.GetProfileIDBySessionID(sessionID, ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get session: %v", err)
+	}
+
+	query := "SELECT login FROM profile WHERE id = $1"
+
+	var login string
+
+	start := time.Now()
+	err = repo.DB.Get(&login, query, profileID)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get session: %v", err)
+	}
+
+	return login, nil
+}
+
+// GetMailboxRoleBySessionID retrieves the login associated with the given session ID and its role in the mailbox.
+// The owner of the mailbox gets the owner role, delegates get their role and other users get an empty role.
+func (repo *SessionRedisRepository) GetMailboxRoleBySessionID(sessionID, mailbox string, ctx context.Context) (string, string, error) {
+	profileID, err := repo.GetProfileIDBySessionID(sessionID, ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get session: %v", err)
+	}
+
+	query := `
+		SELECT profile.login,
+			CASE WHEN profile.login = $2 THEN 'owner' ELSE COALESCE(mailbox_delegate.role, '') END AS role
+		FROM profile
+		LEFT JOIN profile mailbox ON mailbox.login = $2
+		LEFT JOIN mailbox_delegate ON mailbox_delegate.mailbox_id = mailbox.id AND mailbox_delegate.delegate_id = profile.id
+		WHERE profile.id = $1
+	`
+
+	var login, role string
+
+	start := time.Now()
+	err = repo.DB.QueryRow(query, profileID, mailbox).Scan(&login, &role)
+
+	args := []interface{}{profileID, mailbox}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get session: %v", err)
+	}
+
+	return login, role, nil
+}
+
+// GetSessionsByProfileID retrieves the active sessions of the profile, the most recently used first.
+// The IDs of the sessions expired since the last call are removed from the set of the profile sessions.
+func (repo *SessionRedisRepository) GetSessionsByProfileID(profileID uint32, ctx context.Context) ([]*domain.Session, error) {
+	query := "SMEMBERS " + profileSessionsKey(profileID) + "; HGETALL"
+
+	start := time.Now()
+	sessionIDs, err := repo.Client.SMembers(ctx, profileSessionsKey(profileID)).Result()
+	var results []*redis.MapStringStringCmd
+	if err == nil {
+		_, err = repo.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, sessionID := range sessionIDs {
+				results = append(results, pipe.HGetAll(ctx, sessionKey(sessionID)))
+			}
+
+			return nil
+		})
+	}
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %v", err)
+	}
+
+	now := time.Now()
+	sessions := make([]*domain.Session, 0, len(results))
+	var expiredIDs []interface{}
+	for i, result := range results {
+		fields := result.Val()
+		if len(fields) == 0 {
+			expiredIDs = append(expiredIDs, sessionIDs[i])
+			continue
+		}
+
+		sessionDb, convertErr := sessionConvertRedisInDb(sessionIDs[i], fields)
+		if convertErr != nil {
+			continue
+		}
+
+		session := converters.SessionConvertDbInCore(sessionDb)
+		if !session.Expired(now) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	if len(expiredIDs) > 0 {
+		repo.Client.SRem(ctx, profileSessionsKey(profileID), expiredIDs...)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenDate.After(sessions[j].LastSeenDate)
+	})
+
+	return sessions, nil
+}
+
+// UpdateSessionLastSeen sets the last seen date of the session to the current time and extends its expiration.
+func (repo *SessionRedisRepository) UpdateSessionLastSeen(sessionID string, ctx context.Context) error {
+	session, err := repo.GetSessionByID(sessionID, ctx)
+	if err != nil {
+		return nil
+	}
+
+	session.LastSeenDate = time.Now()
+
+	query := "HSET " + sessionKey(sessionID) + " last_seen_date; PEXPIREAT"
+
+	start := time.Now()
+	err = setExistingSessionField.Run(ctx, repo.Client, []string{sessionKey(sessionID)},
+		"last_seen_date", session.LastSeenDate.Format(time.RFC3339Nano), session.ExpirationDate().UnixMilli()).Err()
+
+	args := []interface{}{sessionID, session.LastSeenDate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to update session: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateCsrfToken replaces the CSRF token of the session with a new random one and returns it.
+func (repo *SessionRedisRepository) UpdateCsrfToken(sessionID string, ctx context.Context) (string, error) {
+	query := "HSET " + sessionKey(sessionID) + " csrf_token"
+
+	csrfToken := SessionGenerateRandomID()
+
+	start := time.Now()
+	updated, err := setExistingSessionField.Run(ctx, repo.Client, []string{sessionKey(sessionID)}, "csrf_token", csrfToken).Int()
+
+	args := []interface{}{sessionID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to update session: %v", err)
+	}
+
+	if updated == 0 {
+		return "", fmt.Errorf("session not found")
+	}
+
+	return csrfToken, nil
+}
+
+// deleteSessions deletes the sessions of the profile and removes them from the set of the profile sessions.
+// It returns the number of deleted sessions, the IDs of already expired sessions are not counted.
+func (repo *SessionRedisRepository) deleteSessions(profileID uint32, sessionIDs []string, ctx context.Context) (int64, error) {
+	if len(sessionIDs) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, 0, len(sessionIDs))
+	members := make([]interface{}, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, sessionKey(sessionID))
+		members = append(members, sessionID)
+	}
+
+	var deleted *redis.IntCmd
+	_, err := repo.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		deleted = pipe.Del(ctx, keys...)
+		pipe.SRem(ctx, profileSessionsKey(profileID), members...)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted.Val(), nil
+}
+
+// DeleteOtherSessions deletes every session of the profile the given session belongs to, except the session itself.
+// It returns the number of deleted sessions.
+func (repo *SessionRedisRepository) DeleteOtherSessions(sessionID string, ctx context.Context) (int64, error) {
+	profileID, err := repo.GetProfileIDBySessionID(sessionID, ctx)
+	if err != nil {
+		return 0, nil
+	}
+
+	query := "SMEMBERS " + profileSessionsKey(profileID) + "; DEL; SREM"
+
+	start := time.Now()
+	sessionIDs, err := repo.Client.SMembers(ctx, profileSessionsKey(profileID)).Result()
+	var count int64
+	if err == nil {
+		otherIDs := make([]string, 0, len(sessionIDs))
+		for _, ID := range sessionIDs {
+			if ID != sessionID {
+				otherIDs = append(otherIDs, ID)
+			}
+		}
+		count, err = repo.deleteSessions(profileID, otherIDs, ctx)
+	}
+
+	args := []interface{}{sessionID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %v", err)
+	}
+
+	return count, nil
+}
+
+// DeleteSessionsByProfileID deletes every session of the profile and returns their number.
+func (repo *SessionRedisRepository) DeleteSessionsByProfileID(profileID uint32, ctx context.Context) (int64, error) {
+	query := "SMEMBERS " + profileSessionsKey(profileID) + "; DEL; SREM"
+
+	start := time.Now()
+	sessionIDs, err := repo.Client.SMembers(ctx, profileSessionsKey(profileID)).Result()
+	var count int64
+	if err == nil {
+		count, err = repo.deleteSessions(profileID, sessionIDs, ctx)
+	}
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %v", err)
+	}
+
+	return count, nil
+}
+
+// DeleteSessionByID deletes a session by its ID.
+func (repo *SessionRedisRepository) DeleteSessionByID(sessionID string, ctx context.Context) error {
+	profileID, err := repo.GetProfileIDBySessionID(sessionID, ctx)
+	if err != nil {
+		return nil
+	}
+
+	query := "DEL " + sessionKey(sessionID) + "; SREM " + profileSessionsKey(profileID)
+
+	start := time.Now()
+	_, err = repo.deleteSessions(profileID, []string{sessionID}, ctx)
+
+	args := []interface{}{sessionID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredSessions removes the IDs of expired sessions from the sets of the profile sessions.
+// The sessions themselves expire in the store.
+func (repo *SessionRedisRepository) DeleteExpiredSessions(ctx context.Context) error {
+	query := "SCAN " + profileSessionsKeyPrefix + "*; SMEMBERS; EXISTS; SREM"
+
+	start := time.Now()
+	var err error
+	iter := repo.Client.Scan(ctx, 0, profileSessionsKeyPrefix+"*", 100).Iterator()
+	for err == nil && iter.Next(ctx) {
+		err = repo.removeExpiredSessionIDs(iter.Val(), ctx)
+	}
+	if err == nil {
+		err = iter.Err()
+	}
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete expired sessions: %v", err)
+	}
+
+	return nil
+}
+
+// removeExpiredSessionIDs removes the IDs of the sessions which no longer exist from the set of the profile sessions.
+func (repo *SessionRedisRepository) removeExpiredSessionIDs(profileSessionsKey string, ctx context.Context) error {
+	sessionIDs, err := repo.Client.SMembers(ctx, profileSessionsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	exists := make([]*redis.IntCmd, 0, len(sessionIDs))
+	_, err = repo.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, sessionID := range sessionIDs {
+			exists = append(exists, pipe.Exists(ctx, sessionKey(sessionID)))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var expiredIDs []interface{}
+	for i, exist := range exists {
+		if exist.Val() == 0 {
+			expiredIDs = append(expiredIDs, sessionIDs[i])
+		}
+	}
+	if len(expiredIDs) == 0 {
+		return nil
+	}
+
+	return repo.Client.SRem(ctx, profileSessionsKey, expiredIDs...).Err()
+}
@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// newTestSessionRedisRepository returns a repository on an in-memory Redis server and a stub database.
+func newTestSessionRedisRepository(t *testing.T) (*SessionRedisRepository, *miniredis.Miniredis, sqlmock.Sqlmock) {
+	server := miniredis.RunT(t)
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() { mockDB.Close() })
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewSessionRedisRepository(client, sqlx.NewDb(mockDB, "sqlmock")), server, mock
+}
+
+func TestSessionRedisRepository_CreateAndGetSession(t *testing.T) {
+	repo, server, _ := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	ID, err := repo.CreateSession(100, "mobile", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	session, err := repo.GetSessionByID(ID, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, ID, session.ID)
+	assert.Equal(t, uint32(100), session.UserID)
+	assert.Equal(t, "mobile", session.Device)
+	assert.Equal(t, "127.0.0.1", session.IPAddress)
+	assert.Equal(t, 3600, session.LifeTime)
+	assert.NotEmpty(t, session.CsrfToken)
+	assert.WithinDuration(t, time.Now(), session.CreationDate, time.Second)
+
+	assert.Equal(t, time.Hour, server.TTL(sessionKey(ID)).Round(time.Second))
+	assert.True(t, server.Exists(profileSessionsKey(100)))
+
+	profileID, err := repo.GetProfileIDBySessionID(ID, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(100), profileID)
+
+	server.FastForward(time.Hour)
+
+	_, err = repo.GetSessionByID(ID, ctx)
+	assert.Error(t, err)
+}
+
+func TestSessionRedisRepository_GetSessionByID_NotFound(t *testing.T) {
+	repo, _, _ := newTestSessionRedisRepository(t)
+
+	session, err := repo.GetSessionByID("missing", GetCTX())
+
+	assert.Error(t, err)
+	assert.Nil(t, session)
+}
+
+func TestSessionRedisRepository_GetLoginBySessionID(t *testing.T) {
+	repo, _, mock := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	ID, err := repo.CreateSession(100, "mobile", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT login FROM profile WHERE id = $1")).WithArgs(uint32(100)).
+		WillReturnRows(sqlmock.NewRows([]string{"login"}).AddRow("user@mailhub.su"))
+
+	login, err := repo.GetLoginBySessionID(ID, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@mailhub.su", login)
+
+	_, err = repo.GetLoginBySessionID("missing", ctx)
+	assert.Error(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRedisRepository_GetMailboxRoleBySessionID(t *testing.T) {
+	repo, _, mock := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	ID, err := repo.CreateSession(100, "mobile", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT profile.login`).WithArgs(uint32(100), "team@mailhub.su").
+		WillReturnRows(sqlmock.NewRows([]string{"login", "role"}).AddRow("user@mailhub.su", "editor"))
+
+	login, role, err := repo.GetMailboxRoleBySessionID(ID, "team@mailhub.su", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@mailhub.su", login)
+	assert.Equal(t, "editor", role)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRedisRepository_GetSessionsByProfileID(t *testing.T) {
+	repo, server, _ := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	shortID, err := repo.CreateSession(100, "old", "127.0.0.1", 60, ctx)
+	assert.NoError(t, err)
+	firstID, err := repo.CreateSession(100, "first", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+	secondID, err := repo.CreateSession(100, "second", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+	_, err = repo.CreateSession(200, "other", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	server.FastForward(time.Minute)
+	assert.NoError(t, repo.UpdateSessionLastSeen(secondID, ctx))
+
+	sessions, err := repo.GetSessionsByProfileID(100, ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, secondID, sessions[0].ID)
+	assert.Equal(t, firstID, sessions[1].ID)
+
+	isMember, err := server.SIsMember(profileSessionsKey(100), shortID)
+	assert.NoError(t, err)
+	assert.False(t, isMember)
+}
+
+func TestSessionRedisRepository_UpdateSessionLastSeen(t *testing.T) {
+	repo, server, _ := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	ID, err := repo.CreateSession(100, "mobile", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	server.FastForward(30 * time.Minute)
+
+	assert.NoError(t, repo.UpdateSessionLastSeen(ID, ctx))
+
+	session, err := repo.GetSessionByID(ID, ctx)
+	assert.NoError(t, err)
+	assert.True(t, session.LastSeenDate.After(session.CreationDate))
+	assert.Greater(t, server.TTL(sessionKey(ID)), 30*time.Minute)
+
+	assert.NoError(t, repo.UpdateSessionLastSeen("missing", ctx))
+	assert.False(t, server.Exists(sessionKey("missing")))
+}
+
+func TestSessionRedisRepository_UpdateSessionLastSeen_MaxLifeTime(t *testing.T) {
+	repo, server, _ := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	ID, err := repo.CreateSession(100, "mobile", "127.0.0.1", domain.SessionLifeTimeSeconds(true), ctx)
+	assert.NoError(t, err)
+
+	session, err := repo.GetSessionByID(ID, ctx)
+	assert.NoError(t, err)
+	session.CreationDate = time.Now().Add(-domain.SessionMaxLifeTime + time.Hour)
+	server.HSet(sessionKey(ID), "creation_date", session.CreationDate.Format(time.RFC3339Nano))
+
+	assert.NoError(t, repo.UpdateSessionLastSeen(ID, ctx))
+
+	assert.LessOrEqual(t, server.TTL(sessionKey(ID)), time.Hour)
+}
+
+func TestSessionRedisRepository_UpdateCsrfToken(t *testing.T) {
+	repo, server, _ := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	ID, err := repo.CreateSession(100, "mobile", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	before, err := repo.GetSessionByID(ID, ctx)
+	assert.NoError(t, err)
+
+	csrfToken, err := repo.UpdateCsrfToken(ID, ctx)
+	assert.NoError(t, err)
+	assert.NotEqual(t, before.CsrfToken, csrfToken)
+
+	after, err := repo.GetSessionByID(ID, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, csrfToken, after.CsrfToken)
+	assert.Equal(t, time.Hour, server.TTL(sessionKey(ID)).Round(time.Second))
+
+	_, err = repo.UpdateCsrfToken("missing", ctx)
+	assert.EqualError(t, err, "session not found")
+	assert.False(t, server.Exists(sessionKey("missing")))
+}
+
+func TestSessionRedisRepository_DeleteSessions(t *testing.T) {
+	repo, server, _ := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	currentID, err := repo.CreateSession(100, "current", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+	otherID, err := repo.CreateSession(100, "other", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+	anotherID, err := repo.CreateSession(100, "another", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	t.Run("DeleteSessionByID", func(t *testing.T) {
+		assert.NoError(t, repo.DeleteSessionByID(anotherID, ctx))
+		assert.False(t, server.Exists(sessionKey(anotherID)))
+
+		assert.NoError(t, repo.DeleteSessionByID("missing", ctx))
+	})
+
+	t.Run("DeleteOtherSessions", func(t *testing.T) {
+		count, err := repo.DeleteOtherSessions(currentID, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		assert.False(t, server.Exists(sessionKey(otherID)))
+		assert.True(t, server.Exists(sessionKey(currentID)))
+	})
+
+	t.Run("DeleteSessionsByProfileID", func(t *testing.T) {
+		count, err := repo.DeleteSessionsByProfileID(100, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		assert.False(t, server.Exists(sessionKey(currentID)))
+
+		members, _ := server.Members(profileSessionsKey(100))
+		assert.Empty(t, members)
+	})
+}
+
+func TestSessionRedisRepository_DeleteExpiredSessions(t *testing.T) {
+	repo, server, _ := newTestSessionRedisRepository(t)
+	ctx := GetCTX()
+
+	shortID, err := repo.CreateSession(100, "short", "127.0.0.1", 60, ctx)
+	assert.NoError(t, err)
+	longID, err := repo.CreateSession(100, "long", "127.0.0.1", 3600, ctx)
+	assert.NoError(t, err)
+
+	server.FastForward(2 * time.Minute)
+
+	assert.NoError(t, repo.DeleteExpiredSessions(ctx))
+
+	members, err := server.Members(profileSessionsKey(100))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{longID}, members)
+	assert.NotContains(t, members, shortID)
+}
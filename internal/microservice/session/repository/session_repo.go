@@ -18,6 +18,12 @@ import (
 // requestIDContextKey is the context key for the request ID.
 var requestIDContextKey interface{} = "requestID"
 
+// sessionExpirationDate returns the SQL expression of the date a session expires, the same as Session.ExpirationDate.
+// The lifetime slides with the last seen date and is limited by the maximum lifetime in seconds passed in the parameter.
+func sessionExpirationDate(maxLifeTimeParam string) string {
+	return "LEAST(last_seen_date + life_time * interval '1 second', creation_date + " + maxLifeTimeParam + " * interval '1 second')"
+}
+
 // SessionRepository represents a PostgreSQL implementation of the SessionRepository interface.
 type SessionRepository struct {
 	DB *sqlx.DB
@@ -45,10 +51,10 @@ var SessionGenerateRandomID SessionGenerate = func() string {
 }
 
 // CreateSession creates a new session and returns its ID.
-func (repo *SessionRepository) CreateSession(userID uint32, device string, lifeTime int, ctx context.Context) (string, error) {
+func (repo *SessionRepository) CreateSession(userID uint32, device, ipAddress string, lifeTime int, ctx context.Context) (string, error) {
 	query := `
-		INSERT INTO session (id, profile_id, creation_date, device, life_time, csrf_token)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO session (id, profile_id, creation_date, device, life_time, csrf_token, ip_address, last_seen_date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $3)
 	`
 
 	ID := SessionGenerateRandomID()
@@ -56,9 +62,9 @@ func (repo *SessionRepository) CreateSession(userID uint32, device string, lifeT
 	creationDate := time.Now()
 
 	start := time.Now()
-	_, err := repo.DB.Exec(query, ID, userID, creationDate, device, lifeTime, csrfToken)
+	_, err := repo.DB.Exec(query, ID, userID, creationDate, device, lifeTime, csrfToken, ipAddress)
 
-	args := []interface{}{ID, userID, creationDate, device, lifeTime, csrfToken}
+	args := []interface{}{ID, userID, creationDate, device, lifeTime, csrfToken, ipAddress}
 	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
 
 	if err != nil {
@@ -110,6 +116,34 @@ func (repo *SessionRepository) GetLoginBySessionID(sessionID string, ctx context
 	return login, nil
 }
 
+// GetMailboxRoleBySessionID retrieves the login associated with the given session ID and its role in the mailbox.
+// The owner of the mailbox gets the owner role, delegates get their role and other users get an empty role.
+func (repo *SessionRepository) GetMailboxRoleBySessionID(sessionID, mailbox string, ctx context.Context) (string, string, error) {
+	query := `
+		SELECT profile.login,
+			CASE WHEN profile.login = $2 THEN 'owner' ELSE COALESCE(mailbox_delegate.role, '') END AS role
+		FROM session
+		JOIN profile ON session.profile_id = profile.id
+		LEFT JOIN profile mailbox ON mailbox.login = $2
+		LEFT JOIN mailbox_delegate ON mailbox_delegate.mailbox_id = mailbox.id AND mailbox_delegate.delegate_id = profile.id
+		WHERE session.id = $1
+	`
+
+	var login, role string
+
+	start := time.Now()
+	err := repo.DB.QueryRow(query, sessionID, mailbox).Scan(&login, &role)
+
+	args := []interface{}{sessionID, mailbox}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get session: %v", err)
+	}
+
+	return login, role, nil
+}
+
 // GetProfileIDBySessionID retrieves the login associated with the given session ID.
 func (repo *SessionRepository) GetProfileIDBySessionID(sessionID string, ctx context.Context) (uint32, error) {
 	query := `
@@ -131,6 +165,131 @@ func (repo *SessionRepository) GetProfileIDBySessionID(sessionID string, ctx con
 	return id, nil
 }
 
+// GetSessionsByProfileID retrieves the active sessions of the profile, the most recently used first.
+func (repo *SessionRepository) GetSessionsByProfileID(profileID uint32, ctx context.Context) ([]*domain.Session, error) {
+	query := `
+		SELECT * FROM session
+		WHERE profile_id = $1 AND ` + sessionExpirationDate("$2") + ` > now()
+		ORDER BY last_seen_date DESC
+	`
+
+	maxLifeTime := int(domain.SessionMaxLifeTime.Seconds())
+
+	var sessionsDb []database.Session
+
+	start := time.Now()
+	err := repo.DB.Select(&sessionsDb, query, profileID, maxLifeTime)
+
+	args := []interface{}{profileID, maxLifeTime}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %v", err)
+	}
+
+	sessions := make([]*domain.Session, 0, len(sessionsDb))
+	for i := range sessionsDb {
+		sessions = append(sessions, converters.SessionConvertDbInCore(&sessionsDb[i]))
+	}
+
+	return sessions, nil
+}
+
+// UpdateSessionLastSeen sets the last seen date of the session to the current time.
+func (repo *SessionRepository) UpdateSessionLastSeen(sessionID string, ctx context.Context) error {
+	query := "UPDATE session SET last_seen_date = $2 WHERE id = $1"
+
+	lastSeenDate := time.Now()
+
+	start := time.Now()
+	_, err := repo.DB.Exec(query, sessionID, lastSeenDate)
+
+	args := []interface{}{sessionID, lastSeenDate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to update session: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateCsrfToken replaces the CSRF token of the session with a new random one and returns it.
+func (repo *SessionRepository) UpdateCsrfToken(sessionID string, ctx context.Context) (string, error) {
+	query := "UPDATE session SET csrf_token = $2 WHERE id = $1"
+
+	csrfToken := SessionGenerateRandomID()
+
+	start := time.Now()
+	result, err := repo.DB.Exec(query, sessionID, csrfToken)
+
+	args := []interface{}{sessionID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to update session: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return "", fmt.Errorf("session not found")
+	}
+
+	return csrfToken, nil
+}
+
+// DeleteOtherSessions deletes every session of the profile the given session belongs to, except the session itself.
+// It returns the number of deleted sessions.
+func (repo *SessionRepository) DeleteOtherSessions(sessionID string, ctx context.Context) (int64, error) {
+	query := `
+		DELETE FROM session
+		WHERE profile_id = (SELECT profile_id FROM session WHERE id = $1) AND id <> $1
+	`
+
+	start := time.Now()
+	result, err := repo.DB.Exec(query, sessionID)
+
+	args := []interface{}{sessionID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %v", err)
+	}
+
+	return count, nil
+}
+
+// DeleteSessionsByProfileID deletes every session of the profile and returns their number.
+func (repo *SessionRepository) DeleteSessionsByProfileID(profileID uint32, ctx context.Context) (int64, error) {
+	query := "DELETE FROM session WHERE profile_id = $1"
+
+	start := time.Now()
+	result, err := repo.DB.Exec(query, profileID)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %v", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %v", err)
+	}
+
+	return count, nil
+}
+
 // DeleteSessionByID deletes a session by its ID.
 func (repo *SessionRepository) DeleteSessionByID(sessionID string, ctx context.Context) error {
 	query := "DELETE FROM session WHERE id = $1"
@@ -150,12 +309,14 @@ func (repo *SessionRepository) DeleteSessionByID(sessionID string, ctx context.C
 
 // DeleteExpiredSessions removes all expired sessions.
 func (repo *SessionRepository) DeleteExpiredSessions(ctx context.Context) error {
-	query := "DELETE FROM session WHERE creation_date + life_time * interval '1 second' < now()"
+	query := "DELETE FROM session WHERE " + sessionExpirationDate("$1") + " <= now()"
+
+	maxLifeTime := int(domain.SessionMaxLifeTime.Seconds())
 
 	start := time.Now()
-	_, err := repo.DB.Exec(query)
+	_, err := repo.DB.Exec(query, maxLifeTime)
 
-	args := []interface{}{}
+	args := []interface{}{maxLifeTime}
 	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
 
 	if err != nil {
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/jackc/pgx/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+
+	sessionInterface "mail/internal/microservice/session/interface"
+	"mail/internal/pkg/logger"
+	"mail/internal/pkg/utils/constants"
+)
+
+// The benchmarks compare the session backends on the calls made for every authenticated request.
+// The Redis backend runs on an in-memory stand-in unless SESSION_BENCH_REDIS sets the address of a real server.
+// The PostgreSQL backend runs only if SESSION_BENCH_DSN sets a database with at least one profile:
+//
+//	SESSION_BENCH_DSN="user=postgres dbname=Mail password=postgres host=localhost port=5432 sslmode=disable" \
+//	SESSION_BENCH_REDIS=localhost:6379 go test -run=^$ -bench=. ./internal/microservice/session/repository/
+
+// benchmarkCTX returns the context of the benchmark requests, the queries are not logged.
+func benchmarkCTX() context.Context {
+	dbLogger := logger.InitializationBdLog(nil)
+	dbLogger.LogrusLogger.Out = io.Discard
+
+	ctx := context.WithValue(context.Background(), interface{}(string(constants.LoggerKey)), dbLogger)
+
+	return context.WithValue(ctx, interface{}(string(constants.RequestIDKey)), []string{"benchmark"})
+}
+
+// sessionBenchmarkBackend is a session backend with a profile to create the benchmark sessions for.
+type sessionBenchmarkBackend struct {
+	name      string
+	repo      sessionInterface.SessionRepository
+	profileID uint32
+}
+
+// sessionBenchmarkBackends returns the backends available for the benchmarks.
+func sessionBenchmarkBackends(b *testing.B) []sessionBenchmarkBackend {
+	redisAddress := os.Getenv("SESSION_BENCH_REDIS")
+	if redisAddress == "" {
+		server := miniredis.NewMiniRedis()
+		if err := server.Start(); err != nil {
+			b.Fatalf("failed to start in-memory redis: %v", err)
+		}
+		b.Cleanup(server.Close)
+		redisAddress = server.Addr()
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: redisAddress})
+	b.Cleanup(func() { client.Close() })
+
+	backends := []sessionBenchmarkBackend{
+		{name: "redis", repo: NewSessionRedisRepository(client, nil), profileID: 1},
+	}
+
+	dsn := os.Getenv("SESSION_BENCH_DSN")
+	if dsn == "" {
+		return backends
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		b.Fatalf("failed to open database: %v", err)
+	}
+	b.Cleanup(func() { db.Close() })
+
+	var profileID uint32
+	if err := db.QueryRow("SELECT id FROM profile LIMIT 1").Scan(&profileID); err != nil {
+		b.Fatalf("failed to find a profile: %v", err)
+	}
+
+	return append(backends, sessionBenchmarkBackend{
+		name:      "postgres",
+		repo:      NewSessionRepository(sqlx.NewDb(db, "pgx")),
+		profileID: profileID,
+	})
+}
+
+// createBenchmarkSession creates a session removed when the benchmark ends.
+func createBenchmarkSession(b *testing.B, backend sessionBenchmarkBackend, ctx context.Context) string {
+	sessionID, err := backend.repo.CreateSession(backend.profileID, "benchmark", "127.0.0.1", 3600, ctx)
+	if err != nil {
+		b.Fatalf("failed to create session: %v", err)
+	}
+	b.Cleanup(func() { backend.repo.DeleteSessionByID(sessionID, ctx) })
+
+	return sessionID
+}
+
+func BenchmarkGetSessionByID(b *testing.B) {
+	ctx := benchmarkCTX()
+
+	for _, backend := range sessionBenchmarkBackends(b) {
+		b.Run(backend.name, func(b *testing.B) {
+			sessionID := createBenchmarkSession(b, backend, ctx)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := backend.repo.GetSessionByID(sessionID, ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUpdateSessionLastSeen(b *testing.B) {
+	ctx := benchmarkCTX()
+
+	for _, backend := range sessionBenchmarkBackends(b) {
+		b.Run(backend.name, func(b *testing.B) {
+			sessionID := createBenchmarkSession(b, backend, ctx)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := backend.repo.UpdateSessionLastSeen(sessionID, ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCreateSession(b *testing.B) {
+	ctx := benchmarkCTX()
+
+	for _, backend := range sessionBenchmarkBackends(b) {
+		b.Run(backend.name, func(b *testing.B) {
+			sessionIDs := make([]string, 0, b.N)
+			b.Cleanup(func() {
+				for _, sessionID := range sessionIDs {
+					backend.repo.DeleteSessionByID(sessionID, ctx)
+				}
+			})
+
+			for i := 0; i < b.N; i++ {
+				sessionID, err := backend.repo.CreateSession(backend.profileID, "benchmark", "127.0.0.1", 3600, ctx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				sessionIDs = append(sessionIDs, sessionID)
+			}
+		})
+	}
+}
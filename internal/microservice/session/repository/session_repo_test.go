@@ -57,9 +57,9 @@ func TestCreateSession(t *testing.T) {
 		lifeTime := 3600
 		csrfToken := "10101010"
 
-		mock.ExpectExec(`INSERT INTO session`).WithArgs(ID, userID, sqlmock.AnyArg(), device, lifeTime, csrfToken).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`INSERT INTO session`).WithArgs(ID, userID, sqlmock.AnyArg(), device, lifeTime, csrfToken, "127.0.0.1").WillReturnResult(sqlmock.NewResult(1, 1))
 
-		sessionID, err := repo.CreateSession(userID, device, lifeTime, ctx)
+		sessionID, err := repo.CreateSession(userID, device, "127.0.0.1", lifeTime, ctx)
 
 		assert.NoError(t, err)
 		assert.Equal(t, ID, sessionID)
@@ -72,9 +72,9 @@ func TestCreateSession(t *testing.T) {
 		lifeTime := 7200
 		csrfToken := "10101010"
 
-		mock.ExpectExec(`INSERT INTO session`).WithArgs(ID, userID, sqlmock.AnyArg(), device, lifeTime, csrfToken).WillReturnError(fmt.Errorf("failed to insert"))
+		mock.ExpectExec(`INSERT INTO session`).WithArgs(ID, userID, sqlmock.AnyArg(), device, lifeTime, csrfToken, "127.0.0.1").WillReturnError(fmt.Errorf("failed to insert"))
 
-		sessionID, err := repo.CreateSession(userID, device, lifeTime, ctx)
+		sessionID, err := repo.CreateSession(userID, device, "127.0.0.1", lifeTime, ctx)
 
 		assert.Error(t, err)
 		assert.Zero(t, sessionID)
@@ -142,6 +142,44 @@ func TestGetSessionByID(t *testing.T) {
 	}
 }
 
+func TestGetMailboxRoleBySessionID(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := SessionRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+	sessionID := "10101010"
+	mailbox := "support@mailhub.su"
+	query := `SELECT profile.login, CASE WHEN profile.login = \$2 THEN 'owner' ELSE COALESCE\(mailbox_delegate.role, ''\) END AS role FROM session`
+
+	t.Run("Delegate", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"login", "role"}).AddRow("test_user", "send_as")
+		mock.ExpectQuery(query).WithArgs(sessionID, mailbox).WillReturnRows(rows)
+
+		login, role, err := repo.GetMailboxRoleBySessionID(sessionID, mailbox, ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_user", login)
+		assert.Equal(t, "send_as", role)
+	})
+
+	t.Run("SessionNotFound", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(sessionID, mailbox).WillReturnRows(sqlmock.NewRows([]string{"login", "role"}))
+
+		login, role, err := repo.GetMailboxRoleBySessionID(sessionID, mailbox, ctx)
+
+		assert.Error(t, err)
+		assert.Empty(t, login)
+		assert.Empty(t, role)
+	})
+}
+
 func TestGetLoginBySessionID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -278,8 +316,8 @@ func TestDeleteExpiredSessions(t *testing.T) {
 	ctx := GetCTX()
 
 	t.Run("Success", func(t *testing.T) {
-		queryPattern := regexp.QuoteMeta(`DELETE FROM session WHERE creation_date + life_time * interval '1 second' < now()`)
-		mock.ExpectExec(queryPattern).WillReturnResult(sqlmock.NewResult(0, 3))
+		queryPattern := regexp.QuoteMeta(`DELETE FROM session WHERE LEAST(last_seen_date + life_time * interval '1 second', creation_date + $1 * interval '1 second') <= now()`)
+		mock.ExpectExec(queryPattern).WithArgs(int(domain.SessionMaxLifeTime.Seconds())).WillReturnResult(sqlmock.NewResult(0, 3))
 
 		err := repo.DeleteExpiredSessions(ctx)
 
@@ -287,7 +325,7 @@ func TestDeleteExpiredSessions(t *testing.T) {
 	})
 
 	t.Run("Error", func(t *testing.T) {
-		queryPattern := regexp.QuoteMeta(`DELETE FROM session WHERE creation_date + life_time * interval '1 second' < now()`)
+		queryPattern := regexp.QuoteMeta(`DELETE FROM session WHERE LEAST(`)
 		mock.ExpectExec(queryPattern).WillReturnError(fmt.Errorf("failed to delete expired sessions"))
 
 		err := repo.DeleteExpiredSessions(ctx)
@@ -343,3 +381,155 @@ func TestGetProfileIDBySessionID(t *testing.T) {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 	}
 }
+
+func TestGetSessionsByProfileID(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := SessionRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "profile_id", "creation_date", "device", "life_time", "csrf_token", "ip_address", "last_seen_date"}).
+			AddRow("current", 1, now, "Mozilla/5.0", 3600, "csrf1", "127.0.0.1", now).
+			AddRow("other", 1, now, "curl/8.0", 3600, "csrf2", "10.0.0.1", now.Add(-time.Hour))
+		mock.ExpectQuery(`SELECT \* FROM session WHERE profile_id = \$1 AND LEAST\((.+)\) > now\(\) ORDER BY last_seen_date DESC`).
+			WithArgs(uint32(1), int(domain.SessionMaxLifeTime.Seconds())).WillReturnRows(rows)
+
+		sessions, err := repo.GetSessionsByProfileID(1, ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 2)
+		assert.Equal(t, "current", sessions[0].ID)
+		assert.Equal(t, "10.0.0.1", sessions[1].IPAddress)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT \* FROM session`).WithArgs(uint32(2), sqlmock.AnyArg()).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetSessionsByProfileID(2, ctx)
+
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSessionLastSeen(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := SessionRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+
+	mock.ExpectExec(`UPDATE session SET last_seen_date = \$2 WHERE id = \$1`).
+		WithArgs("10101010", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.UpdateSessionLastSeen("10101010", ctx))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateCsrfToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := SessionRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE session SET csrf_token = \$2 WHERE id = \$1`).
+			WithArgs("10101010", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		csrfToken, err := repo.UpdateCsrfToken("10101010", ctx)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, csrfToken)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE session SET csrf_token`).
+			WithArgs("unknown", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := repo.UpdateCsrfToken("unknown", ctx)
+
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteOtherSessions(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := SessionRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM session WHERE profile_id = \(SELECT profile_id FROM session WHERE id = \$1\) AND id <> \$1`).
+			WithArgs("current").WillReturnResult(sqlmock.NewResult(0, 3))
+
+		count, err := repo.DeleteOtherSessions("current", ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM session`).WithArgs("current").WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.DeleteOtherSessions("current", ctx)
+
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteSessionsByProfileID(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := SessionRepository{
+		DB: sqlx.NewDb(mockDB, "sqlmock"),
+	}
+
+	ctx := GetCTX()
+
+	mock.ExpectExec(`DELETE FROM session WHERE profile_id = \$1`).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 4))
+
+	count, err := repo.DeleteSessionsByProfileID(1, ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
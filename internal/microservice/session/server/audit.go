@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/interceptors"
+	"mail/internal/microservice/session/proto"
+
+	domain "mail/internal/microservice/models/domain_models"
+	usecase "mail/internal/microservice/session/interface"
+)
+
+// AuditRules returns the rules recording the sign-outs in the security audit log.
+// The owner of the current session is found before the call, since the session may be deleted by it.
+// Sessions deleted together with a password change are recorded as the password change.
+func AuditRules(sessionUseCase usecase.SessionUseCase) map[string]interceptors.AuditRule {
+	sessionOwner := func(sessionID string, ctx context.Context) uint32 {
+		profileID, err := sessionUseCase.GetProfileID(sessionID, ctx)
+		if err != nil {
+			return 0
+		}
+		return profileID
+	}
+
+	return map[string]interceptors.AuditRule{
+		"/proto.SessionService/DeleteSession": {
+			Subject: func(ctx context.Context, req interface{}) uint32 {
+				return sessionOwner(req.(*proto.DeleteSessionRequest).SessionId, ctx)
+			},
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				return &domain.SecurityEvent{ProfileID: subject, Type: domain.SecurityEventLogout, Success: err == nil}
+			},
+		},
+		"/proto.SessionService/DeleteUserSession": {
+			Subject: func(ctx context.Context, req interface{}) uint32 {
+				return sessionOwner(req.(*proto.DeleteUserSessionRequest).SessionId, ctx)
+			},
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				return &domain.SecurityEvent{
+					ProfileID: subject,
+					Type:      domain.SecurityEventSessionRevoke,
+					Success:   err == nil,
+					Details:   fmt.Sprintf("session: %s", req.(*proto.DeleteUserSessionRequest).Id),
+				}
+			},
+		},
+		"/proto.SessionService/DeleteOtherSessions": {
+			Subject: func(ctx context.Context, req interface{}) uint32 {
+				return sessionOwner(req.(*proto.DeleteOtherSessionsRequest).SessionId, ctx)
+			},
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				deleted, _ := reply.(*proto.DeleteOtherSessionsReply)
+				return &domain.SecurityEvent{
+					ProfileID: subject,
+					Type:      domain.SecurityEventSessionRevoke,
+					Success:   err == nil,
+					Details:   fmt.Sprintf("other sessions: %d", deleted.GetCount()),
+				}
+			},
+		},
+	}
+}
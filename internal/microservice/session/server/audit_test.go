@@ -0,0 +1,49 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/models/domain_models"
+	"mail/internal/microservice/session/mock"
+	"mail/internal/microservice/session/proto"
+)
+
+func TestAuditRules_DeleteSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+	rule := AuditRules(mockSessionUseCase)["/proto.SessionService/DeleteSession"]
+	req := &proto.DeleteSessionRequest{SessionId: "123"}
+
+	mockSessionUseCase.EXPECT().GetProfileID("123", gomock.Any()).Return(uint32(2), nil)
+	assert.Equal(t, uint32(2), rule.Subject(GetCTX(), req))
+
+	mockSessionUseCase.EXPECT().GetProfileID("123", gomock.Any()).Return(uint32(0), fmt.Errorf("session not found"))
+	assert.Equal(t, uint32(0), rule.Subject(GetCTX(), req))
+
+	event := rule.Event(req, &proto.DeleteSessionReply{Status: true}, nil, 2)
+	assert.Equal(t, &domain_models.SecurityEvent{ProfileID: 2, Type: domain_models.SecurityEventLogout, Success: true}, event)
+}
+
+func TestAuditRules_RevokeSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rules := AuditRules(mock.NewMockSessionUseCase(ctrl))
+
+	event := rules["/proto.SessionService/DeleteUserSession"].Event(&proto.DeleteUserSessionRequest{SessionId: "123", Id: "public"},
+		(*proto.DeleteSessionReply)(nil), fmt.Errorf("session not found"), 2)
+	assert.Equal(t, domain_models.SecurityEventSessionRevoke, event.Type)
+	assert.Equal(t, "session: public", event.Details)
+	assert.False(t, event.Success)
+
+	event = rules["/proto.SessionService/DeleteOtherSessions"].Event(&proto.DeleteOtherSessionsRequest{SessionId: "123"},
+		&proto.DeleteOtherSessionsReply{Count: 3}, nil, 2)
+	assert.Equal(t, "other sessions: 3", event.Details)
+	assert.True(t, event.Success)
+}
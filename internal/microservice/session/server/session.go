@@ -50,6 +50,20 @@ func (ss *SessionServer) GetLoginBySession(ctx context.Context, input *proto.Get
 	return &proto.GetLoginBySessionReply{Login: login}, nil
 }
 
+// GetMailboxRole retrieves the login associated with the session and its role in the mailbox.
+func (ss *SessionServer) GetMailboxRole(ctx context.Context, input *proto.GetMailboxRoleRequest) (*proto.GetMailboxRoleReply, error) {
+	if validUtil.IsEmpty(input.SessionId) || validUtil.IsEmpty(input.Mailbox) {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	login, role, err := ss.SessionUseCase.GetMailboxRole(input.SessionId, input.Mailbox, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	return &proto.GetMailboxRoleReply{Login: login, Role: role}, nil
+}
+
 // GetProfileIDBySession retrieves the login associated with the session.
 func (ss *SessionServer) GetProfileIDBySession(ctx context.Context, input *proto.GetLoginBySessionRequest) (*proto.GetProfileIDBySessionReply, error) {
 	if validUtil.IsEmpty(input.SessionId) {
@@ -74,7 +88,7 @@ func (ss *SessionServer) CreateSession(ctx context.Context, input *proto.CreateS
 		return nil, fmt.Errorf("life time session error")
 	}
 
-	sessionId, err := ss.SessionUseCase.CreateNewSession(input.Session.UserId, input.Session.Device, int(input.Session.LifeTime), ctx)
+	sessionId, err := ss.SessionUseCase.CreateNewSession(input.Session.UserId, input.Session.Device, input.Session.IpAddress, int(input.Session.LifeTime), ctx)
 	if err != nil {
 		return nil, fmt.Errorf("session not found")
 	}
@@ -96,6 +110,84 @@ func (ss *SessionServer) DeleteSession(ctx context.Context, input *proto.DeleteS
 	return &proto.DeleteSessionReply{Status: true}, nil
 }
 
+// GetUserSessions retrieves the active sessions of the owner of the current session.
+// The sessions are identified by their public identifiers, the session IDs are never returned.
+func (ss *SessionServer) GetUserSessions(ctx context.Context, input *proto.GetUserSessionsRequest) (*proto.GetUserSessionsReply, error) {
+	if validUtil.IsEmpty(input.SessionId) {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	sessionsCore, err := ss.SessionUseCase.GetUserSessions(input.SessionId, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions")
+	}
+
+	sessionsProto := make([]*proto.ActiveSession, 0, len(sessionsCore))
+	for _, sessionCore := range sessionsCore {
+		activeSession := proto_converters.ActiveSessionConvertCoreInProto(sessionCore)
+		activeSession.Current = sessionCore.ID == input.SessionId
+		sessionsProto = append(sessionsProto, activeSession)
+	}
+
+	return &proto.GetUserSessionsReply{Sessions: sessionsProto}, nil
+}
+
+// DeleteUserSession destroys a session of the owner of the current session.
+func (ss *SessionServer) DeleteUserSession(ctx context.Context, input *proto.DeleteUserSessionRequest) (*proto.DeleteSessionReply, error) {
+	if validUtil.IsEmpty(input.SessionId) || validUtil.IsEmpty(input.Id) {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	err := ss.SessionUseCase.DeleteUserSession(input.SessionId, input.Id, ctx)
+	if err != nil {
+		return &proto.DeleteSessionReply{Status: false}, fmt.Errorf("session not found")
+	}
+
+	return &proto.DeleteSessionReply{Status: true}, nil
+}
+
+// DeleteOtherSessions destroys every session of the owner of the current session except the current one.
+func (ss *SessionServer) DeleteOtherSessions(ctx context.Context, input *proto.DeleteOtherSessionsRequest) (*proto.DeleteOtherSessionsReply, error) {
+	if validUtil.IsEmpty(input.SessionId) {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	count, err := ss.SessionUseCase.DeleteOtherSessions(input.SessionId, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete sessions")
+	}
+
+	return &proto.DeleteOtherSessionsReply{Count: count}, nil
+}
+
+// DeleteUserSessions destroys every session of the user.
+func (ss *SessionServer) DeleteUserSessions(ctx context.Context, input *proto.DeleteUserSessionsRequest) (*proto.DeleteOtherSessionsReply, error) {
+	if input.UserId <= 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	count, err := ss.SessionUseCase.DeleteUserSessions(input.UserId, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete sessions")
+	}
+
+	return &proto.DeleteOtherSessionsReply{Count: count}, nil
+}
+
+// RotateCsrfToken replaces the CSRF token of the session and returns the new one.
+func (ss *SessionServer) RotateCsrfToken(ctx context.Context, input *proto.RotateCsrfTokenRequest) (*proto.RotateCsrfTokenReply, error) {
+	if validUtil.IsEmpty(input.SessionId) {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	csrfToken, err := ss.SessionUseCase.RotateCsrfToken(input.SessionId, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	return &proto.RotateCsrfTokenReply{CsrfToken: csrfToken}, nil
+}
+
 // CleanupExpiredSessions destroys all current session.
 func (ss *SessionServer) CleanupExpiredSessions(ctx context.Context, input *proto.CleanupExpiredSessionsRequest) (*proto.CleanupExpiredSessionsReply, error) {
 	err := ss.SessionUseCase.CleanupExpiredSessions(ctx)
@@ -98,6 +98,46 @@ func TestGetLoginBySession_Error(t *testing.T) {
 	assert.Nil(t, reply)
 }
 
+func TestGetMailboxRole_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	mockSessionUseCase.EXPECT().GetMailboxRole("some_session_id", "support@mailhub.su", ctx).Return("example_login", "read", nil)
+
+	reply, err := server.GetMailboxRole(ctx, &proto.GetMailboxRoleRequest{SessionId: "some_session_id", Mailbox: "support@mailhub.su"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "example_login", reply.Login)
+	assert.Equal(t, "read", reply.Role)
+}
+
+func TestGetMailboxRole_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	reply, err := server.GetMailboxRole(ctx, &proto.GetMailboxRoleRequest{SessionId: "some_session_id"})
+	assert.Error(t, err)
+	assert.Nil(t, reply)
+
+	mockSessionUseCase.EXPECT().GetMailboxRole("some_session_id", "support@mailhub.su", ctx).Return("", "", fmt.Errorf("session not found"))
+
+	reply, err = server.GetMailboxRole(ctx, &proto.GetMailboxRoleRequest{SessionId: "some_session_id", Mailbox: "support@mailhub.su"})
+	assert.Error(t, err)
+	assert.Nil(t, reply)
+}
+
 func TestGetProfileIDBySession_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -148,7 +188,7 @@ func TestCreateSession_Success(t *testing.T) {
 
 	expectedSessionID := "abc123"
 
-	mockSessionUseCase.EXPECT().CreateNewSession(uint32(42), "device", 3600, ctx).Return(expectedSessionID, nil)
+	mockSessionUseCase.EXPECT().CreateNewSession(uint32(42), "device", "", 3600, ctx).Return(expectedSessionID, nil)
 
 	reply, err := server.CreateSession(ctx, &proto.CreateSessionRequest{
 		Session: &proto.Session{
@@ -216,7 +256,7 @@ func TestCreateSession_SessionNotFound(t *testing.T) {
 
 	ctx := GetCTX()
 
-	mockSessionUseCase.EXPECT().CreateNewSession(uint32(42), "device", 3600, ctx).Return("", fmt.Errorf("session not found"))
+	mockSessionUseCase.EXPECT().CreateNewSession(uint32(42), "device", "", 3600, ctx).Return("", fmt.Errorf("session not found"))
 
 	reply, err := server.CreateSession(ctx, &proto.CreateSessionRequest{
 		Session: &proto.Session{
@@ -317,3 +357,156 @@ func TestCleanupExpiredSessions_Error(t *testing.T) {
 	assert.Error(t, err)
 	assert.NotNil(t, reply)
 }
+
+func TestGetUserSessions_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	mockSessionUseCase.EXPECT().GetUserSessions("current", ctx).Return([]*domain_models.Session{
+		{ID: "current", UserID: 1, Device: "Mozilla/5.0", CsrfToken: "csrf1"},
+		{ID: "other", UserID: 1, Device: "curl/8.0", CsrfToken: "csrf2"},
+	}, nil)
+
+	reply, err := server.GetUserSessions(ctx, &proto.GetUserSessionsRequest{SessionId: "current"})
+
+	assert.NoError(t, err)
+	assert.Len(t, reply.Sessions, 2)
+	assert.Equal(t, domain_models.SessionPublicID("current"), reply.Sessions[0].Id)
+	assert.True(t, reply.Sessions[0].Current)
+	assert.False(t, reply.Sessions[1].Current)
+}
+
+func TestGetUserSessions_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	mockSessionUseCase.EXPECT().GetUserSessions("current", ctx).Return(nil, fmt.Errorf("session not found"))
+
+	reply, err := server.GetUserSessions(ctx, &proto.GetUserSessionsRequest{SessionId: "current"})
+
+	assert.Error(t, err)
+	assert.Nil(t, reply)
+}
+
+func TestDeleteUserSession_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	mockSessionUseCase.EXPECT().DeleteUserSession("current", "public_id", ctx).Return(nil)
+
+	reply, err := server.DeleteUserSession(ctx, &proto.DeleteUserSessionRequest{SessionId: "current", Id: "public_id"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.Status)
+}
+
+func TestDeleteUserSession_EmptyID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	reply, err := server.DeleteUserSession(GetCTX(), &proto.DeleteUserSessionRequest{SessionId: "current"})
+
+	assert.Error(t, err)
+	assert.Nil(t, reply)
+}
+
+func TestDeleteOtherSessions_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	mockSessionUseCase.EXPECT().DeleteOtherSessions("current", ctx).Return(int64(2), nil)
+
+	reply, err := server.DeleteOtherSessions(ctx, &proto.DeleteOtherSessionsRequest{SessionId: "current"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), reply.Count)
+}
+
+func TestDeleteUserSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockSessionUseCase.EXPECT().DeleteUserSessions(uint32(1), ctx).Return(int64(4), nil)
+
+		reply, err := server.DeleteUserSessions(ctx, &proto.DeleteUserSessionsRequest{UserId: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), reply.Count)
+	})
+
+	t.Run("InvalidUser", func(t *testing.T) {
+		_, err := server.DeleteUserSessions(ctx, &proto.DeleteUserSessionsRequest{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRotateCsrfToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionUseCase := mock.NewMockSessionUseCase(ctrl)
+
+	server := NewSessionServer(mockSessionUseCase)
+
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockSessionUseCase.EXPECT().RotateCsrfToken("current", ctx).Return("new_csrf", nil)
+
+		reply, err := server.RotateCsrfToken(ctx, &proto.RotateCsrfTokenRequest{SessionId: "current"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new_csrf", reply.CsrfToken)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockSessionUseCase.EXPECT().RotateCsrfToken("missing", ctx).Return("", fmt.Errorf("session not found"))
+
+		reply, err := server.RotateCsrfToken(ctx, &proto.RotateCsrfTokenRequest{SessionId: "missing"})
+
+		assert.Error(t, err)
+		assert.Nil(t, reply)
+	})
+
+	t.Run("EmptySession", func(t *testing.T) {
+		_, err := server.RotateCsrfToken(ctx, &proto.RotateCsrfTokenRequest{})
+
+		assert.Error(t, err)
+	})
+}
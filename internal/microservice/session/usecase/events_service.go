@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// revokingLogin returns the login of the owner of the session about to be revoked, the events are published to it.
+// It is empty when there is no events bus or the login can't be found, then nothing is published.
+func (uc *SessionUseCase) revokingLogin(sessionID string, ctx context.Context) string {
+	if uc.events == nil {
+		return ""
+	}
+
+	login, err := uc.sessionRepo.GetLoginBySessionID(sessionID, ctx)
+	if err != nil {
+		log.Printf("failed to get login of revoked session: %v", err)
+		return ""
+	}
+
+	return login
+}
+
+// publishSessionsRevoked tells every replica of the gateway to forget the revoked sessions of the user.
+// The sessions are already deleted when it is published, so a failure is only logged:
+// the replicas then trust the sessions until their cache entries expire.
+func (uc *SessionUseCase) publishSessionsRevoked(login string, sessions []*domain.Session, ctx context.Context) {
+	if uc.events == nil || login == "" || len(sessions) == 0 {
+		return
+	}
+
+	publicIDs := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		publicIDs = append(publicIDs, domain.SessionPublicID(session.ID))
+	}
+
+	event := &domain.MailboxEvent{Type: domain.MailboxEventSessionRevoked, SessionIDs: publicIDs}
+	if err := uc.events.Publish(login, event, ctx); err != nil {
+		log.Printf("failed to publish %s event to %s: %v", event.Type, login, err)
+	}
+}
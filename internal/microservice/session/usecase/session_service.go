@@ -2,7 +2,11 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"time"
 
+	events "mail/internal/microservice/events/interface"
 	domain "mail/internal/microservice/models/domain_models"
 	repository "mail/internal/microservice/session/interface"
 )
@@ -10,23 +14,52 @@ import (
 // SessionUseCase is a concrete implementation of the SessionUseCase interface.
 type SessionUseCase struct {
 	sessionRepo repository.SessionRepository
+	events      events.EventsBus
 }
 
-// NewSessionUseCase creates a new instance of a session use case with necessary dependencies.
-func NewSessionUseCase(repo repository.SessionRepository) *SessionUseCase {
+// NewSessionUseCase creates a new instance of a session use case with necessary dependencies,
+// the revoked sessions are published to the events bus so the gateway replicas stop trusting them.
+func NewSessionUseCase(repo repository.SessionRepository, events events.EventsBus) *SessionUseCase {
 	return &SessionUseCase{
 		sessionRepo: repo,
+		events:      events,
 	}
 }
 
 // CreateNewSession initiates a new session for a user.
-func (uc *SessionUseCase) CreateNewSession(userID uint32, device string, lifeTime int, ctx context.Context) (string, error) {
-	return uc.sessionRepo.CreateSession(userID, device, lifeTime, ctx)
+// The lifetime is the time the session stays valid without requests, it is limited to SessionRememberLifeTime.
+func (uc *SessionUseCase) CreateNewSession(userID uint32, device, ipAddress string, lifeTime int, ctx context.Context) (string, error) {
+	device = domain.TruncateSessionField(device, domain.SessionDeviceMaxLength)
+	ipAddress = domain.TruncateSessionField(ipAddress, domain.SessionIPAddressMaxLength)
+
+	if maxLifeTime := domain.SessionLifeTimeSeconds(true); lifeTime > maxLifeTime {
+		lifeTime = maxLifeTime
+	}
+
+	return uc.sessionRepo.CreateSession(userID, device, ipAddress, lifeTime, ctx)
 }
 
 // GetSession fetches a session by its unique identifier.
+// An expired session is removed instead of being returned. The last seen date of the session,
+// which its expiration slides with, is refreshed at most once per SessionLastSeenInterval.
 func (uc *SessionUseCase) GetSession(sessionID string, ctx context.Context) (*domain.Session, error) {
-	return uc.sessionRepo.GetSessionByID(sessionID, ctx)
+	session, err := uc.sessionRepo.GetSessionByID(sessionID, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.Expired(time.Now()) {
+		_ = uc.sessionRepo.DeleteSessionByID(sessionID, ctx)
+		return nil, fmt.Errorf("session expired")
+	}
+
+	if time.Since(session.LastSeenDate) >= domain.SessionLastSeenInterval {
+		if err = uc.sessionRepo.UpdateSessionLastSeen(sessionID, ctx); err == nil {
+			session.LastSeenDate = time.Now()
+		}
+	}
+
+	return session, nil
 }
 
 // GetLogin retrieves the login associated with the provided session ID.
@@ -39,9 +72,111 @@ func (uc *SessionUseCase) GetProfileID(sessionID string, ctx context.Context) (u
 	return uc.sessionRepo.GetProfileIDBySessionID(sessionID, ctx)
 }
 
+// GetMailboxRole retrieves the login associated with the provided session ID and its role in the mailbox.
+func (uc *SessionUseCase) GetMailboxRole(sessionID, mailbox string, ctx context.Context) (string, string, error) {
+	return uc.sessionRepo.GetMailboxRoleBySessionID(sessionID, mailbox, ctx)
+}
+
+// GetUserSessions retrieves the active sessions of the owner of the given session.
+func (uc *SessionUseCase) GetUserSessions(sessionID string, ctx context.Context) ([]*domain.Session, error) {
+	profileID, err := uc.sessionRepo.GetProfileIDBySessionID(sessionID, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.sessionRepo.GetSessionsByProfileID(profileID, ctx)
+}
+
+// DeleteUserSession terminates a session of the owner of the given session by its public identifier.
+// Only the sessions of the same user can be found, so a user cannot sign out someone else.
+func (uc *SessionUseCase) DeleteUserSession(sessionID, publicID string, ctx context.Context) error {
+	sessions, err := uc.GetUserSessions(sessionID, ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if domain.SessionPublicID(session.ID) != publicID {
+			continue
+		}
+
+		login := uc.revokingLogin(sessionID, ctx)
+		if err = uc.sessionRepo.DeleteSessionByID(session.ID, ctx); err != nil {
+			return err
+		}
+		uc.publishSessionsRevoked(login, []*domain.Session{session}, ctx)
+
+		return nil
+	}
+
+	return fmt.Errorf("session not found")
+}
+
+// DeleteOtherSessions terminates every session of the owner of the given session except the session itself.
+func (uc *SessionUseCase) DeleteOtherSessions(sessionID string, ctx context.Context) (int64, error) {
+	login := uc.revokingLogin(sessionID, ctx)
+
+	var others []*domain.Session
+	if login != "" {
+		sessions, err := uc.GetUserSessions(sessionID, ctx)
+		if err != nil {
+			log.Printf("failed to get sessions revoked by %s: %v", login, err)
+		}
+		for _, session := range sessions {
+			if session.ID != sessionID {
+				others = append(others, session)
+			}
+		}
+	}
+
+	count, err := uc.sessionRepo.DeleteOtherSessions(sessionID, ctx)
+	if err != nil {
+		return 0, err
+	}
+	uc.publishSessionsRevoked(login, others, ctx)
+
+	return count, nil
+}
+
+// DeleteUserSessions terminates every session of the user.
+func (uc *SessionUseCase) DeleteUserSessions(userID uint32, ctx context.Context) (int64, error) {
+	var login string
+	var sessions []*domain.Session
+	if uc.events != nil {
+		var err error
+		if sessions, err = uc.sessionRepo.GetSessionsByProfileID(userID, ctx); err != nil {
+			log.Printf("failed to get sessions of user %d: %v", userID, err)
+		}
+		if len(sessions) > 0 {
+			login = uc.revokingLogin(sessions[0].ID, ctx)
+		}
+	}
+
+	count, err := uc.sessionRepo.DeleteSessionsByProfileID(userID, ctx)
+	if err != nil {
+		return 0, err
+	}
+	uc.publishSessionsRevoked(login, sessions, ctx)
+
+	return count, nil
+}
+
 // DeleteSession terminates a session identified by its ID.
 func (uc *SessionUseCase) DeleteSession(sessionID string, ctx context.Context) error {
-	return uc.sessionRepo.DeleteSessionByID(sessionID, ctx)
+	login := uc.revokingLogin(sessionID, ctx)
+
+	if err := uc.sessionRepo.DeleteSessionByID(sessionID, ctx); err != nil {
+		return err
+	}
+	uc.publishSessionsRevoked(login, []*domain.Session{{ID: sessionID}}, ctx)
+
+	return nil
+}
+
+// RotateCsrfToken replaces the CSRF token of the session and returns the new one.
+// It is called after privilege-sensitive actions, so a token leaked before them stops working.
+func (uc *SessionUseCase) RotateCsrfToken(sessionID string, ctx context.Context) (string, error) {
+	return uc.sessionRepo.UpdateCsrfToken(sessionID, ctx)
 }
 
 // CleanupExpiredSessions removes sessions that have exceeded their lifetime.
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +13,7 @@ import (
 	"mail/internal/pkg/logger"
 	"mail/internal/pkg/utils/constants"
 
+	events_mock "mail/internal/microservice/events/mock"
 	domain "mail/internal/microservice/models/domain_models"
 )
 
@@ -26,7 +29,7 @@ func TestCreateNewSession(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockSessionRepository(ctrl)
-	usecase := NewSessionUseCase(mockRepo)
+	usecase := NewSessionUseCase(mockRepo, nil)
 
 	ID := "10101010"
 	userID := uint32(1)
@@ -35,25 +38,43 @@ func TestCreateNewSession(t *testing.T) {
 
 	ctx := GetCTX()
 
-	mockRepo.EXPECT().CreateSession(userID, device, lifetime, ctx).Return(ID, nil)
+	mockRepo.EXPECT().CreateSession(userID, device, "127.0.0.1", lifetime, ctx).Return(ID, nil)
 
-	sessionID, err := usecase.CreateNewSession(userID, device, lifetime, ctx)
+	sessionID, err := usecase.CreateNewSession(userID, device, "127.0.0.1", lifetime, ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, ID, sessionID)
 }
 
+func TestCreateNewSessionLimitsLifeTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+
+	ctx := GetCTX()
+	maxLifeTime := domain.SessionLifeTimeSeconds(true)
+
+	mockRepo.EXPECT().CreateSession(uint32(1), "testDevice", "127.0.0.1", maxLifeTime, ctx).Return("10101010", nil)
+
+	_, err := usecase.CreateNewSession(1, "testDevice", "127.0.0.1", maxLifeTime*2, ctx)
+	assert.NoError(t, err)
+}
+
 func TestGetSession(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockSessionRepository(ctrl)
-	usecase := NewSessionUseCase(mockRepo)
+	usecase := NewSessionUseCase(mockRepo, nil)
 
 	expectedSession := &domain.Session{
-		ID:       "10101010",
-		UserID:   uint32(100),
-		Device:   "testDevice",
-		LifeTime: 3600,
+		ID:           "10101010",
+		UserID:       uint32(100),
+		Device:       "testDevice",
+		LifeTime:     3600,
+		CreationDate: time.Now(),
+		LastSeenDate: time.Now(),
 	}
 	ctx := GetCTX()
 
@@ -64,12 +85,139 @@ func TestGetSession(t *testing.T) {
 	assert.Equal(t, expectedSession, session)
 }
 
+func TestGetSessionUpdatesLastSeen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+
+	lastSeenDate := time.Now().Add(-time.Hour)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetSessionByID("10101010", ctx).
+		Return(&domain.Session{ID: "10101010", LifeTime: 3 * 3600, CreationDate: lastSeenDate, LastSeenDate: lastSeenDate}, nil)
+	mockRepo.EXPECT().UpdateSessionLastSeen("10101010", ctx).Return(nil)
+
+	session, err := usecase.GetSession("10101010", ctx)
+	assert.NoError(t, err)
+	assert.True(t, session.LastSeenDate.After(lastSeenDate))
+}
+
+func TestGetSessionExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+	ctx := GetCTX()
+
+	t.Run("Idle", func(t *testing.T) {
+		mockRepo.EXPECT().GetSessionByID("idle", ctx).
+			Return(&domain.Session{ID: "idle", LifeTime: 3600, CreationDate: time.Now().Add(-3 * time.Hour), LastSeenDate: time.Now().Add(-2 * time.Hour)}, nil)
+		mockRepo.EXPECT().DeleteSessionByID("idle", ctx).Return(nil)
+
+		_, err := usecase.GetSession("idle", ctx)
+		assert.EqualError(t, err, "session expired")
+	})
+
+	t.Run("MaxLifeTime", func(t *testing.T) {
+		mockRepo.EXPECT().GetSessionByID("old", ctx).
+			Return(&domain.Session{ID: "old", LifeTime: domain.SessionLifeTimeSeconds(true), CreationDate: time.Now().Add(-domain.SessionMaxLifeTime), LastSeenDate: time.Now()}, nil)
+		mockRepo.EXPECT().DeleteSessionByID("old", ctx).Return(nil)
+
+		_, err := usecase.GetSession("old", ctx)
+		assert.EqualError(t, err, "session expired")
+	})
+}
+
+func TestRotateCsrfToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().UpdateCsrfToken("10101010", ctx).Return("newcsrf", nil)
+
+	csrfToken, err := usecase.RotateCsrfToken("10101010", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "newcsrf", csrfToken)
+}
+
+func TestGetUserSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+
+	ctx := GetCTX()
+	sessions := []*domain.Session{{ID: "current", UserID: 1}, {ID: "other", UserID: 1}}
+
+	mockRepo.EXPECT().GetProfileIDBySessionID("current", ctx).Return(uint32(1), nil)
+	mockRepo.EXPECT().GetSessionsByProfileID(uint32(1), ctx).Return(sessions, nil)
+
+	result, err := usecase.GetUserSessions("current", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, sessions, result)
+}
+
+func TestDeleteUserSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+
+	ctx := GetCTX()
+	sessions := []*domain.Session{{ID: "current", UserID: 1}, {ID: "other", UserID: 1}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetProfileIDBySessionID("current", ctx).Return(uint32(1), nil)
+		mockRepo.EXPECT().GetSessionsByProfileID(uint32(1), ctx).Return(sessions, nil)
+		mockRepo.EXPECT().DeleteSessionByID("other", ctx).Return(nil)
+
+		assert.NoError(t, usecase.DeleteUserSession("current", domain.SessionPublicID("other"), ctx))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetProfileIDBySessionID("current", ctx).Return(uint32(1), nil)
+		mockRepo.EXPECT().GetSessionsByProfileID(uint32(1), ctx).Return(sessions, nil)
+
+		assert.Error(t, usecase.DeleteUserSession("current", domain.SessionPublicID("foreign"), ctx))
+	})
+
+	t.Run("SessionError", func(t *testing.T) {
+		mockRepo.EXPECT().GetProfileIDBySessionID("expired", ctx).Return(uint32(0), fmt.Errorf("no rows"))
+
+		assert.Error(t, usecase.DeleteUserSession("expired", domain.SessionPublicID("other"), ctx))
+	})
+}
+
+func TestDeleteOtherSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().DeleteOtherSessions("current", ctx).Return(int64(3), nil)
+
+	count, err := usecase.DeleteOtherSessions("current", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
+
 func TestDeleteSession(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockSessionRepository(ctrl)
-	usecase := NewSessionUseCase(mockRepo)
+	usecase := NewSessionUseCase(mockRepo, nil)
 
 	sessionID := "10101010"
 	ctx := GetCTX()
@@ -85,7 +233,7 @@ func TestCleanupExpiredSessions(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockSessionRepository(ctrl)
-	usecase := NewSessionUseCase(mockRepo)
+	usecase := NewSessionUseCase(mockRepo, nil)
 
 	ctx := GetCTX()
 
@@ -100,7 +248,7 @@ func TestGetLogin(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockSessionRepository(ctrl)
-	usecase := NewSessionUseCase(mockRepo)
+	usecase := NewSessionUseCase(mockRepo, nil)
 
 	sessionID := "10101010"
 
@@ -115,12 +263,31 @@ func TestGetLogin(t *testing.T) {
 	assert.Equal(t, expectedLogin, login)
 }
 
+func TestGetMailboxRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+
+	sessionID := "10101010"
+
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().GetMailboxRoleBySessionID(sessionID, "support@mailhub.su", ctx).Return("testuser", "manage", nil)
+
+	login, role, err := usecase.GetMailboxRole(sessionID, "support@mailhub.su", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "testuser", login)
+	assert.Equal(t, "manage", role)
+}
+
 func TestGetProfileID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockSessionRepository(ctrl)
-	usecase := NewSessionUseCase(mockRepo)
+	usecase := NewSessionUseCase(mockRepo, nil)
 
 	sessionID := "10101010"
 
@@ -134,3 +301,90 @@ func TestGetProfileID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedProfileId, profileId)
 }
+
+func TestDeleteUserSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	usecase := NewSessionUseCase(mockRepo, nil)
+
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().DeleteSessionsByProfileID(uint32(1), ctx).Return(int64(4), nil)
+
+	count, err := usecase.DeleteUserSessions(1, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestDeleteSessionsPublishesRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockSessionRepository(ctrl)
+	mockEvents := events_mock.NewMockEventsBus(ctrl)
+	usecase := NewSessionUseCase(mockRepo, mockEvents)
+
+	ctx := GetCTX()
+	sessions := []*domain.Session{{ID: "current", UserID: 1}, {ID: "other", UserID: 1}}
+
+	revoked := func(sessionIDs ...string) *domain.MailboxEvent {
+		publicIDs := make([]string, 0, len(sessionIDs))
+		for _, sessionID := range sessionIDs {
+			publicIDs = append(publicIDs, domain.SessionPublicID(sessionID))
+		}
+
+		return &domain.MailboxEvent{Type: domain.MailboxEventSessionRevoked, SessionIDs: publicIDs}
+	}
+
+	t.Run("DeleteSession", func(t *testing.T) {
+		gomock.InOrder(
+			mockRepo.EXPECT().GetLoginBySessionID("current", ctx).Return("user@mailhub.su", nil),
+			mockRepo.EXPECT().DeleteSessionByID("current", ctx).Return(nil),
+			mockEvents.EXPECT().Publish("user@mailhub.su", revoked("current"), ctx).Return(nil),
+		)
+
+		assert.NoError(t, usecase.DeleteSession("current", ctx))
+	})
+
+	t.Run("DeleteUserSession", func(t *testing.T) {
+		mockRepo.EXPECT().GetProfileIDBySessionID("current", ctx).Return(uint32(1), nil)
+		mockRepo.EXPECT().GetSessionsByProfileID(uint32(1), ctx).Return(sessions, nil)
+		mockRepo.EXPECT().GetLoginBySessionID("current", ctx).Return("user@mailhub.su", nil)
+		mockRepo.EXPECT().DeleteSessionByID("other", ctx).Return(nil)
+		mockEvents.EXPECT().Publish("user@mailhub.su", revoked("other"), ctx).Return(nil)
+
+		assert.NoError(t, usecase.DeleteUserSession("current", domain.SessionPublicID("other"), ctx))
+	})
+
+	t.Run("DeleteOtherSessions", func(t *testing.T) {
+		mockRepo.EXPECT().GetLoginBySessionID("current", ctx).Return("user@mailhub.su", nil)
+		mockRepo.EXPECT().GetProfileIDBySessionID("current", ctx).Return(uint32(1), nil)
+		mockRepo.EXPECT().GetSessionsByProfileID(uint32(1), ctx).Return(sessions, nil)
+		mockRepo.EXPECT().DeleteOtherSessions("current", ctx).Return(int64(1), nil)
+		mockEvents.EXPECT().Publish("user@mailhub.su", revoked("other"), ctx).Return(nil)
+
+		count, err := usecase.DeleteOtherSessions("current", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("DeleteUserSessions", func(t *testing.T) {
+		mockRepo.EXPECT().GetSessionsByProfileID(uint32(1), ctx).Return(sessions, nil)
+		mockRepo.EXPECT().GetLoginBySessionID("current", ctx).Return("user@mailhub.su", nil)
+		mockRepo.EXPECT().DeleteSessionsByProfileID(uint32(1), ctx).Return(int64(2), nil)
+		mockEvents.EXPECT().Publish("user@mailhub.su", revoked("current", "other"), ctx).Return(fmt.Errorf("bus error"))
+
+		count, err := usecase.DeleteUserSessions(1, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("DeleteFailed", func(t *testing.T) {
+		mockRepo.EXPECT().GetLoginBySessionID("current", ctx).Return("user@mailhub.su", nil)
+		mockRepo.EXPECT().DeleteSessionByID("current", ctx).Return(fmt.Errorf("db error"))
+
+		assert.Error(t, usecase.DeleteSession("current", ctx))
+	})
+}
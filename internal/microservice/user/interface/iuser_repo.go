@@ -4,6 +4,7 @@ package _interface
 
 import (
 	"context"
+	"time"
 
 	domain "mail/internal/microservice/models/domain_models"
 )
@@ -42,4 +43,72 @@ type UserRepository interface {
 
 	// GetByOnlyLogin returns the user by its unique identifier.
 	GetByOnlyLogin(login string, ctx context.Context) (*domain.User, error)
+
+	// GetTwoFactor returns the two-factor authentication settings of the user, or nil if there are none.
+	GetTwoFactor(profileID uint32, ctx context.Context) (*domain.TwoFactor, error)
+
+	// SaveTwoFactorSecret stores a new not yet confirmed secret of the user.
+	SaveTwoFactorSecret(profileID uint32, secret string, ctx context.Context) error
+
+	// EnableTwoFactor confirms the secret of the user and replaces the recovery codes.
+	EnableTwoFactor(profileID uint32, step int64, recoveryCodeHashes []string, ctx context.Context) error
+
+	// DisableTwoFactor removes the secret and the recovery codes of the user.
+	DisableTwoFactor(profileID uint32, ctx context.Context) error
+
+	// UseTwoFactorStep marks the TOTP time step as used, returns false if it has already been used.
+	UseTwoFactorStep(profileID uint32, step int64, ctx context.Context) (bool, error)
+
+	// UseRecoveryCode marks the recovery code with the hash as used, returns false if there is no such unused code.
+	UseRecoveryCode(profileID uint32, codeHash string, ctx context.Context) (bool, error)
+
+	// CountRecoveryCodes returns the number of unused recovery codes of the user.
+	CountRecoveryCodes(profileID uint32, ctx context.Context) (int, error)
+
+	// AddTwoFactorChallenge stores a login waiting for the second factor.
+	AddTwoFactorChallenge(challenge *domain.TwoFactorChallenge, ctx context.Context) error
+
+	// SpendTwoFactorChallengeAttempt atomically counts an entered code for the login waiting for the second factor and returns it,
+	// or nil when it is not found, has expired or has no attempts left.
+	SpendTwoFactorChallengeAttempt(id string, maxAttempts int, ctx context.Context) (*domain.TwoFactorChallenge, error)
+
+	// DeleteTwoFactorChallenge removes the login waiting for the second factor.
+	DeleteTwoFactorChallenge(id string, ctx context.Context) error
+
+	// UpdatePassword hashes the new password of the user and stores it.
+	UpdatePassword(profileID uint32, password string, ctx context.Context) error
+
+	// GetRecoveryEmail returns the recovery email of the user, or an empty string if there is none,
+	// and whether the user has verified it.
+	GetRecoveryEmail(profileID uint32, ctx context.Context) (string, bool, error)
+
+	// SetRecoveryEmail sets the recovery email of the user, an empty email removes it.
+	SetRecoveryEmail(profileID uint32, recoveryEmail string, ctx context.Context) error
+
+	// ConfirmRecoveryEmail marks the recovery email of the user as verified, returns false if it is no longer the address.
+	ConfirmRecoveryEmail(profileID uint32, recoveryEmail string, ctx context.Context) (bool, error)
+
+	// AddPasswordResetToken stores a new password reset token and removes the previous ones of the user.
+	AddPasswordResetToken(token *domain.PasswordResetToken, ctx context.Context) error
+
+	// ResetPasswordByToken uses the password reset token and stores the new password of its user.
+	ResetPasswordByToken(tokenHash, password string, ctx context.Context) (uint32, error)
+
+	// AddAPIToken stores a new personal access token and returns its unique identifier.
+	AddAPIToken(token *domain.APIToken, ctx context.Context) (uint32, error)
+
+	// GetAPITokens returns the personal access tokens of the user.
+	GetAPITokens(profileID uint32, ctx context.Context) ([]*domain.APIToken, error)
+
+	// GetAPITokenByHash returns the personal access token with the hash.
+	GetAPITokenByHash(tokenHash string, ctx context.Context) (*domain.APIToken, error)
+
+	// UpdateAPITokenLastUsed records the date of the last request made with the personal access token.
+	UpdateAPITokenLastUsed(id uint32, lastUsedDate time.Time, ctx context.Context) error
+
+	// DeleteAPIToken removes the personal access token of the user, returns false if the user has no such token.
+	DeleteAPIToken(profileID, id uint32, ctx context.Context) (bool, error)
+
+	// QuerySecurityEvents returns the security events selected by the filter, the latest first.
+	QuerySecurityEvents(filter *domain.SecurityEventFilter, ctx context.Context) ([]*domain.SecurityEvent, error)
 }
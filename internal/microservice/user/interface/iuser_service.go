@@ -42,4 +42,61 @@ type UserUseCase interface {
 
 	// GetUserByOnlyLogin get user by login.
 	GetUserByOnlyLogin(login string, ctx context.Context) (*domain.User, error)
+
+	// GetTwoFactorStatus returns whether two-factor authentication is enabled and the number of unused recovery codes.
+	GetTwoFactorStatus(userID uint32, ctx context.Context) (bool, int, error)
+
+	// BeginTwoFactorSetup generates a new secret for the user to add to an authenticator application.
+	BeginTwoFactorSetup(userID uint32, ctx context.Context) (*domain.TwoFactorSetup, error)
+
+	// ConfirmTwoFactorSetup enables two-factor authentication after a valid code and returns the recovery codes.
+	ConfirmTwoFactorSetup(userID uint32, code string, ctx context.Context) ([]string, error)
+
+	// DisableTwoFactor disables two-factor authentication after the user authenticated again.
+	DisableTwoFactor(userID uint32, password, code string, ctx context.Context) error
+
+	// CreateTwoFactorChallenge starts a login waiting for the second factor if the user has enabled it.
+	CreateTwoFactorChallenge(userID uint32, ctx context.Context) (string, error)
+
+	// VerifyTwoFactorChallenge checks the code of the login waiting for the second factor and returns the user.
+	VerifyTwoFactorChallenge(challengeID, code string, ctx context.Context) (uint32, error)
+
+	// ChangePassword sets a new password after checking the current one.
+	ChangePassword(userID uint32, oldPassword, newPassword string, ctx context.Context) error
+
+	// SetRecoveryEmail sets the external address password reset links are sent to after checking the password.
+	SetRecoveryEmail(userID uint32, password, recoveryEmail string, ctx context.Context) error
+
+	// AddRecoveryEmail sets the recovery email of a user who has just signed up, without checking the password.
+	AddRecoveryEmail(userID uint32, recoveryEmail string, ctx context.Context) error
+
+	// GetRecoveryEmail returns the recovery email of the user and whether it is verified.
+	GetRecoveryEmail(userID uint32, ctx context.Context) (string, bool, error)
+
+	// ConfirmRecoveryEmail marks the recovery email of the user as verified if it is still the address.
+	ConfirmRecoveryEmail(userID uint32, recoveryEmail string, ctx context.Context) error
+
+	// CreatePasswordResetToken creates a password reset token of the user and returns it with the address to send it to.
+	CreatePasswordResetToken(login string, ctx context.Context) (string, string, error)
+
+	// ResetPassword sets a new password with a password reset token and returns the user.
+	ResetPassword(token, newPassword string, ctx context.Context) (uint32, error)
+
+	// CreateAPIToken creates a personal access token of the user and returns it with the token itself.
+	CreateAPIToken(userID uint32, name string, scopes []string, lifeTimeDays int, ctx context.Context) (*domain.APIToken, string, error)
+
+	// GetAPITokens returns the personal access tokens of the user.
+	GetAPITokens(userID uint32, ctx context.Context) ([]*domain.APIToken, error)
+
+	// DeleteAPIToken revokes a personal access token of the user.
+	DeleteAPIToken(userID, tokenID uint32, ctx context.Context) error
+
+	// AuthenticateAPIToken checks the personal access token and returns it with its owner.
+	AuthenticateAPIToken(token string, ctx context.Context) (*domain.APIToken, *domain.User, error)
+
+	// GetSecurityEvents returns the security events of the user recorded before the event with beforeID, the latest first.
+	GetSecurityEvents(userID uint32, beforeID uint64, limit int, ctx context.Context) ([]*domain.SecurityEvent, error)
+
+	// QuerySecurityEvents returns the security events of any account selected by the filter, the latest first.
+	QuerySecurityEvents(filter *domain.SecurityEventFilter, ctx context.Context) ([]*domain.SecurityEvent, error)
 }
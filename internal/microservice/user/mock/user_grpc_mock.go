@@ -36,6 +36,166 @@ func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AuthenticateAPIToken mocks base method.
+func (m *MockUserServiceClient) AuthenticateAPIToken(ctx context.Context, in *proto.AuthenticateAPITokenRequest, opts ...grpc.CallOption) (*proto.AuthenticateAPITokenReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", varargs...)
+	ret0, _ := ret[0].(*proto.AuthenticateAPITokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockUserServiceClientMockRecorder) AuthenticateAPIToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockUserServiceClient)(nil).AuthenticateAPIToken), varargs...)
+}
+
+// BeginTwoFactorSetup mocks base method.
+func (m *MockUserServiceClient) BeginTwoFactorSetup(ctx context.Context, in *proto.TwoFactorUserRequest, opts ...grpc.CallOption) (*proto.BeginTwoFactorSetupReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BeginTwoFactorSetup", varargs...)
+	ret0, _ := ret[0].(*proto.BeginTwoFactorSetupReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTwoFactorSetup indicates an expected call of BeginTwoFactorSetup.
+func (mr *MockUserServiceClientMockRecorder) BeginTwoFactorSetup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTwoFactorSetup", reflect.TypeOf((*MockUserServiceClient)(nil).BeginTwoFactorSetup), varargs...)
+}
+
+// ChangePassword mocks base method.
+func (m *MockUserServiceClient) ChangePassword(ctx context.Context, in *proto.ChangePasswordRequest, opts ...grpc.CallOption) (*proto.ChangePasswordReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*proto.ChangePasswordReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserServiceClientMockRecorder) ChangePassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserServiceClient)(nil).ChangePassword), varargs...)
+}
+
+// ConfirmRecoveryEmail mocks base method.
+func (m *MockUserServiceClient) ConfirmRecoveryEmail(ctx context.Context, in *proto.ConfirmRecoveryEmailRequest, opts ...grpc.CallOption) (*proto.ConfirmRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmRecoveryEmail", varargs...)
+	ret0, _ := ret[0].(*proto.ConfirmRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmRecoveryEmail indicates an expected call of ConfirmRecoveryEmail.
+func (mr *MockUserServiceClientMockRecorder) ConfirmRecoveryEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmRecoveryEmail", reflect.TypeOf((*MockUserServiceClient)(nil).ConfirmRecoveryEmail), varargs...)
+}
+
+// ConfirmTwoFactorSetup mocks base method.
+func (m *MockUserServiceClient) ConfirmTwoFactorSetup(ctx context.Context, in *proto.ConfirmTwoFactorSetupRequest, opts ...grpc.CallOption) (*proto.ConfirmTwoFactorSetupReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmTwoFactorSetup", varargs...)
+	ret0, _ := ret[0].(*proto.ConfirmTwoFactorSetupReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTwoFactorSetup indicates an expected call of ConfirmTwoFactorSetup.
+func (mr *MockUserServiceClientMockRecorder) ConfirmTwoFactorSetup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactorSetup", reflect.TypeOf((*MockUserServiceClient)(nil).ConfirmTwoFactorSetup), varargs...)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockUserServiceClient) CreateAPIToken(ctx context.Context, in *proto.CreateAPITokenRequest, opts ...grpc.CallOption) (*proto.CreateAPITokenReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIToken", varargs...)
+	ret0, _ := ret[0].(*proto.CreateAPITokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockUserServiceClientMockRecorder) CreateAPIToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockUserServiceClient)(nil).CreateAPIToken), varargs...)
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockUserServiceClient) CreatePasswordResetToken(ctx context.Context, in *proto.CreatePasswordResetTokenRequest, opts ...grpc.CallOption) (*proto.CreatePasswordResetTokenReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", varargs...)
+	ret0, _ := ret[0].(*proto.CreatePasswordResetTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockUserServiceClientMockRecorder) CreatePasswordResetToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockUserServiceClient)(nil).CreatePasswordResetToken), varargs...)
+}
+
+// CreateTwoFactorChallenge mocks base method.
+func (m *MockUserServiceClient) CreateTwoFactorChallenge(ctx context.Context, in *proto.TwoFactorUserRequest, opts ...grpc.CallOption) (*proto.CreateTwoFactorChallengeReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTwoFactorChallenge", varargs...)
+	ret0, _ := ret[0].(*proto.CreateTwoFactorChallengeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTwoFactorChallenge indicates an expected call of CreateTwoFactorChallenge.
+func (mr *MockUserServiceClientMockRecorder) CreateTwoFactorChallenge(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTwoFactorChallenge", reflect.TypeOf((*MockUserServiceClient)(nil).CreateTwoFactorChallenge), varargs...)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserServiceClient) CreateUser(ctx context.Context, in *proto.CreateUserRequest, opts ...grpc.CallOption) (*proto.CreateUserReply, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +236,26 @@ func (mr *MockUserServiceClientMockRecorder) CreateUserOtherMail(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserOtherMail", reflect.TypeOf((*MockUserServiceClient)(nil).CreateUserOtherMail), varargs...)
 }
 
+// DeleteAPIToken mocks base method.
+func (m *MockUserServiceClient) DeleteAPIToken(ctx context.Context, in *proto.DeleteAPITokenRequest, opts ...grpc.CallOption) (*proto.DeleteAPITokenReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAPIToken", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteAPITokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAPIToken indicates an expected call of DeleteAPIToken.
+func (mr *MockUserServiceClientMockRecorder) DeleteAPIToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIToken", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteAPIToken), varargs...)
+}
+
 // DeleteUserAvatar mocks base method.
 func (m *MockUserServiceClient) DeleteUserAvatar(ctx context.Context, in *proto.DeleteUserAvatarRequest, opts ...grpc.CallOption) (*proto.DeleteUserAvatarReply, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +296,106 @@ func (mr *MockUserServiceClientMockRecorder) DeleteUserById(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserById", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteUserById), varargs...)
 }
 
+// DisableTwoFactor mocks base method.
+func (m *MockUserServiceClient) DisableTwoFactor(ctx context.Context, in *proto.DisableTwoFactorRequest, opts ...grpc.CallOption) (*proto.DisableTwoFactorReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableTwoFactor", varargs...)
+	ret0, _ := ret[0].(*proto.DisableTwoFactorReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockUserServiceClientMockRecorder) DisableTwoFactor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUserServiceClient)(nil).DisableTwoFactor), varargs...)
+}
+
+// GetAPITokens mocks base method.
+func (m *MockUserServiceClient) GetAPITokens(ctx context.Context, in *proto.GetAPITokensRequest, opts ...grpc.CallOption) (*proto.GetAPITokensReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAPITokens", varargs...)
+	ret0, _ := ret[0].(*proto.GetAPITokensReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokens indicates an expected call of GetAPITokens.
+func (mr *MockUserServiceClientMockRecorder) GetAPITokens(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokens", reflect.TypeOf((*MockUserServiceClient)(nil).GetAPITokens), varargs...)
+}
+
+// GetRecoveryEmail mocks base method.
+func (m *MockUserServiceClient) GetRecoveryEmail(ctx context.Context, in *proto.TwoFactorUserRequest, opts ...grpc.CallOption) (*proto.GetRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecoveryEmail", varargs...)
+	ret0, _ := ret[0].(*proto.GetRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryEmail indicates an expected call of GetRecoveryEmail.
+func (mr *MockUserServiceClientMockRecorder) GetRecoveryEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryEmail", reflect.TypeOf((*MockUserServiceClient)(nil).GetRecoveryEmail), varargs...)
+}
+
+// GetSecurityEvents mocks base method.
+func (m *MockUserServiceClient) GetSecurityEvents(ctx context.Context, in *proto.GetSecurityEventsRequest, opts ...grpc.CallOption) (*proto.SecurityEventsReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecurityEvents", varargs...)
+	ret0, _ := ret[0].(*proto.SecurityEventsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityEvents indicates an expected call of GetSecurityEvents.
+func (mr *MockUserServiceClientMockRecorder) GetSecurityEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityEvents", reflect.TypeOf((*MockUserServiceClient)(nil).GetSecurityEvents), varargs...)
+}
+
+// GetTwoFactorStatus mocks base method.
+func (m *MockUserServiceClient) GetTwoFactorStatus(ctx context.Context, in *proto.TwoFactorUserRequest, opts ...grpc.CallOption) (*proto.GetTwoFactorStatusReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTwoFactorStatus", varargs...)
+	ret0, _ := ret[0].(*proto.GetTwoFactorStatusReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactorStatus indicates an expected call of GetTwoFactorStatus.
+func (mr *MockUserServiceClientMockRecorder) GetTwoFactorStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorStatus", reflect.TypeOf((*MockUserServiceClient)(nil).GetTwoFactorStatus), varargs...)
+}
+
 // GetUser mocks base method.
 func (m *MockUserServiceClient) GetUser(ctx context.Context, in *proto.GetUserRequest, opts ...grpc.CallOption) (*proto.GetUserReply, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +516,66 @@ func (mr *MockUserServiceClientMockRecorder) IsLoginUnique(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLoginUnique", reflect.TypeOf((*MockUserServiceClient)(nil).IsLoginUnique), varargs...)
 }
 
+// QuerySecurityEvents mocks base method.
+func (m *MockUserServiceClient) QuerySecurityEvents(ctx context.Context, in *proto.QuerySecurityEventsRequest, opts ...grpc.CallOption) (*proto.SecurityEventsReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuerySecurityEvents", varargs...)
+	ret0, _ := ret[0].(*proto.SecurityEventsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySecurityEvents indicates an expected call of QuerySecurityEvents.
+func (mr *MockUserServiceClientMockRecorder) QuerySecurityEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySecurityEvents", reflect.TypeOf((*MockUserServiceClient)(nil).QuerySecurityEvents), varargs...)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserServiceClient) ResetPassword(ctx context.Context, in *proto.ResetPasswordRequest, opts ...grpc.CallOption) (*proto.ResetPasswordReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPassword", varargs...)
+	ret0, _ := ret[0].(*proto.ResetPasswordReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserServiceClientMockRecorder) ResetPassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserServiceClient)(nil).ResetPassword), varargs...)
+}
+
+// SetRecoveryEmail mocks base method.
+func (m *MockUserServiceClient) SetRecoveryEmail(ctx context.Context, in *proto.SetRecoveryEmailRequest, opts ...grpc.CallOption) (*proto.SetRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRecoveryEmail", varargs...)
+	ret0, _ := ret[0].(*proto.SetRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecoveryEmail indicates an expected call of SetRecoveryEmail.
+func (mr *MockUserServiceClientMockRecorder) SetRecoveryEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecoveryEmail", reflect.TypeOf((*MockUserServiceClient)(nil).SetRecoveryEmail), varargs...)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserServiceClient) UpdateUser(ctx context.Context, in *proto.UpdateUserRequest, opts ...grpc.CallOption) (*proto.UpdateUserReply, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +616,26 @@ func (mr *MockUserServiceClientMockRecorder) UploadUserAvatar(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadUserAvatar", reflect.TypeOf((*MockUserServiceClient)(nil).UploadUserAvatar), varargs...)
 }
 
+// VerifyTwoFactorChallenge mocks base method.
+func (m *MockUserServiceClient) VerifyTwoFactorChallenge(ctx context.Context, in *proto.VerifyTwoFactorChallengeRequest, opts ...grpc.CallOption) (*proto.VerifyTwoFactorChallengeReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyTwoFactorChallenge", varargs...)
+	ret0, _ := ret[0].(*proto.VerifyTwoFactorChallengeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTwoFactorChallenge indicates an expected call of VerifyTwoFactorChallenge.
+func (mr *MockUserServiceClientMockRecorder) VerifyTwoFactorChallenge(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTwoFactorChallenge", reflect.TypeOf((*MockUserServiceClient)(nil).VerifyTwoFactorChallenge), varargs...)
+}
+
 // MockUserServiceServer is a mock of UserServiceServer interface.
 type MockUserServiceServer struct {
 	ctrl     *gomock.Controller
@@ -299,6 +659,126 @@ func (m *MockUserServiceServer) EXPECT() *MockUserServiceServerMockRecorder {
 	return m.recorder
 }
 
+// AuthenticateAPIToken mocks base method.
+func (m *MockUserServiceServer) AuthenticateAPIToken(arg0 context.Context, arg1 *proto.AuthenticateAPITokenRequest) (*proto.AuthenticateAPITokenReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AuthenticateAPITokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockUserServiceServerMockRecorder) AuthenticateAPIToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockUserServiceServer)(nil).AuthenticateAPIToken), arg0, arg1)
+}
+
+// BeginTwoFactorSetup mocks base method.
+func (m *MockUserServiceServer) BeginTwoFactorSetup(arg0 context.Context, arg1 *proto.TwoFactorUserRequest) (*proto.BeginTwoFactorSetupReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTwoFactorSetup", arg0, arg1)
+	ret0, _ := ret[0].(*proto.BeginTwoFactorSetupReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTwoFactorSetup indicates an expected call of BeginTwoFactorSetup.
+func (mr *MockUserServiceServerMockRecorder) BeginTwoFactorSetup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTwoFactorSetup", reflect.TypeOf((*MockUserServiceServer)(nil).BeginTwoFactorSetup), arg0, arg1)
+}
+
+// ChangePassword mocks base method.
+func (m *MockUserServiceServer) ChangePassword(arg0 context.Context, arg1 *proto.ChangePasswordRequest) (*proto.ChangePasswordReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ChangePasswordReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserServiceServerMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserServiceServer)(nil).ChangePassword), arg0, arg1)
+}
+
+// ConfirmRecoveryEmail mocks base method.
+func (m *MockUserServiceServer) ConfirmRecoveryEmail(arg0 context.Context, arg1 *proto.ConfirmRecoveryEmailRequest) (*proto.ConfirmRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmRecoveryEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ConfirmRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmRecoveryEmail indicates an expected call of ConfirmRecoveryEmail.
+func (mr *MockUserServiceServerMockRecorder) ConfirmRecoveryEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmRecoveryEmail", reflect.TypeOf((*MockUserServiceServer)(nil).ConfirmRecoveryEmail), arg0, arg1)
+}
+
+// ConfirmTwoFactorSetup mocks base method.
+func (m *MockUserServiceServer) ConfirmTwoFactorSetup(arg0 context.Context, arg1 *proto.ConfirmTwoFactorSetupRequest) (*proto.ConfirmTwoFactorSetupReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactorSetup", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ConfirmTwoFactorSetupReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTwoFactorSetup indicates an expected call of ConfirmTwoFactorSetup.
+func (mr *MockUserServiceServerMockRecorder) ConfirmTwoFactorSetup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactorSetup", reflect.TypeOf((*MockUserServiceServer)(nil).ConfirmTwoFactorSetup), arg0, arg1)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockUserServiceServer) CreateAPIToken(arg0 context.Context, arg1 *proto.CreateAPITokenRequest) (*proto.CreateAPITokenReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateAPITokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockUserServiceServerMockRecorder) CreateAPIToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockUserServiceServer)(nil).CreateAPIToken), arg0, arg1)
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockUserServiceServer) CreatePasswordResetToken(arg0 context.Context, arg1 *proto.CreatePasswordResetTokenRequest) (*proto.CreatePasswordResetTokenReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreatePasswordResetTokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockUserServiceServerMockRecorder) CreatePasswordResetToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockUserServiceServer)(nil).CreatePasswordResetToken), arg0, arg1)
+}
+
+// CreateTwoFactorChallenge mocks base method.
+func (m *MockUserServiceServer) CreateTwoFactorChallenge(arg0 context.Context, arg1 *proto.TwoFactorUserRequest) (*proto.CreateTwoFactorChallengeReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTwoFactorChallenge", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateTwoFactorChallengeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTwoFactorChallenge indicates an expected call of CreateTwoFactorChallenge.
+func (mr *MockUserServiceServerMockRecorder) CreateTwoFactorChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTwoFactorChallenge", reflect.TypeOf((*MockUserServiceServer)(nil).CreateTwoFactorChallenge), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserServiceServer) CreateUser(arg0 context.Context, arg1 *proto.CreateUserRequest) (*proto.CreateUserReply, error) {
 	m.ctrl.T.Helper()
@@ -329,6 +809,21 @@ func (mr *MockUserServiceServerMockRecorder) CreateUserOtherMail(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserOtherMail", reflect.TypeOf((*MockUserServiceServer)(nil).CreateUserOtherMail), arg0, arg1)
 }
 
+// DeleteAPIToken mocks base method.
+func (m *MockUserServiceServer) DeleteAPIToken(arg0 context.Context, arg1 *proto.DeleteAPITokenRequest) (*proto.DeleteAPITokenReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteAPITokenReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAPIToken indicates an expected call of DeleteAPIToken.
+func (mr *MockUserServiceServerMockRecorder) DeleteAPIToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIToken", reflect.TypeOf((*MockUserServiceServer)(nil).DeleteAPIToken), arg0, arg1)
+}
+
 // DeleteUserAvatar mocks base method.
 func (m *MockUserServiceServer) DeleteUserAvatar(arg0 context.Context, arg1 *proto.DeleteUserAvatarRequest) (*proto.DeleteUserAvatarReply, error) {
 	m.ctrl.T.Helper()
@@ -359,6 +854,81 @@ func (mr *MockUserServiceServerMockRecorder) DeleteUserById(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserById", reflect.TypeOf((*MockUserServiceServer)(nil).DeleteUserById), arg0, arg1)
 }
 
+// DisableTwoFactor mocks base method.
+func (m *MockUserServiceServer) DisableTwoFactor(arg0 context.Context, arg1 *proto.DisableTwoFactorRequest) (*proto.DisableTwoFactorReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DisableTwoFactorReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockUserServiceServerMockRecorder) DisableTwoFactor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUserServiceServer)(nil).DisableTwoFactor), arg0, arg1)
+}
+
+// GetAPITokens mocks base method.
+func (m *MockUserServiceServer) GetAPITokens(arg0 context.Context, arg1 *proto.GetAPITokensRequest) (*proto.GetAPITokensReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPITokens", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetAPITokensReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokens indicates an expected call of GetAPITokens.
+func (mr *MockUserServiceServerMockRecorder) GetAPITokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokens", reflect.TypeOf((*MockUserServiceServer)(nil).GetAPITokens), arg0, arg1)
+}
+
+// GetRecoveryEmail mocks base method.
+func (m *MockUserServiceServer) GetRecoveryEmail(arg0 context.Context, arg1 *proto.TwoFactorUserRequest) (*proto.GetRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryEmail indicates an expected call of GetRecoveryEmail.
+func (mr *MockUserServiceServerMockRecorder) GetRecoveryEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryEmail", reflect.TypeOf((*MockUserServiceServer)(nil).GetRecoveryEmail), arg0, arg1)
+}
+
+// GetSecurityEvents mocks base method.
+func (m *MockUserServiceServer) GetSecurityEvents(arg0 context.Context, arg1 *proto.GetSecurityEventsRequest) (*proto.SecurityEventsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityEvents", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SecurityEventsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityEvents indicates an expected call of GetSecurityEvents.
+func (mr *MockUserServiceServerMockRecorder) GetSecurityEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityEvents", reflect.TypeOf((*MockUserServiceServer)(nil).GetSecurityEvents), arg0, arg1)
+}
+
+// GetTwoFactorStatus mocks base method.
+func (m *MockUserServiceServer) GetTwoFactorStatus(arg0 context.Context, arg1 *proto.TwoFactorUserRequest) (*proto.GetTwoFactorStatusReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactorStatus", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetTwoFactorStatusReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactorStatus indicates an expected call of GetTwoFactorStatus.
+func (mr *MockUserServiceServerMockRecorder) GetTwoFactorStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorStatus", reflect.TypeOf((*MockUserServiceServer)(nil).GetTwoFactorStatus), arg0, arg1)
+}
+
 // GetUser mocks base method.
 func (m *MockUserServiceServer) GetUser(arg0 context.Context, arg1 *proto.GetUserRequest) (*proto.GetUserReply, error) {
 	m.ctrl.T.Helper()
@@ -449,6 +1019,51 @@ func (mr *MockUserServiceServerMockRecorder) IsLoginUnique(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLoginUnique", reflect.TypeOf((*MockUserServiceServer)(nil).IsLoginUnique), arg0, arg1)
 }
 
+// QuerySecurityEvents mocks base method.
+func (m *MockUserServiceServer) QuerySecurityEvents(arg0 context.Context, arg1 *proto.QuerySecurityEventsRequest) (*proto.SecurityEventsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySecurityEvents", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SecurityEventsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySecurityEvents indicates an expected call of QuerySecurityEvents.
+func (mr *MockUserServiceServerMockRecorder) QuerySecurityEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySecurityEvents", reflect.TypeOf((*MockUserServiceServer)(nil).QuerySecurityEvents), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserServiceServer) ResetPassword(arg0 context.Context, arg1 *proto.ResetPasswordRequest) (*proto.ResetPasswordReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ResetPasswordReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserServiceServerMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserServiceServer)(nil).ResetPassword), arg0, arg1)
+}
+
+// SetRecoveryEmail mocks base method.
+func (m *MockUserServiceServer) SetRecoveryEmail(arg0 context.Context, arg1 *proto.SetRecoveryEmailRequest) (*proto.SetRecoveryEmailReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecoveryEmail", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SetRecoveryEmailReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecoveryEmail indicates an expected call of SetRecoveryEmail.
+func (mr *MockUserServiceServerMockRecorder) SetRecoveryEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecoveryEmail", reflect.TypeOf((*MockUserServiceServer)(nil).SetRecoveryEmail), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserServiceServer) UpdateUser(arg0 context.Context, arg1 *proto.UpdateUserRequest) (*proto.UpdateUserReply, error) {
 	m.ctrl.T.Helper()
@@ -479,6 +1094,21 @@ func (mr *MockUserServiceServerMockRecorder) UploadUserAvatar(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadUserAvatar", reflect.TypeOf((*MockUserServiceServer)(nil).UploadUserAvatar), arg0, arg1)
 }
 
+// VerifyTwoFactorChallenge mocks base method.
+func (m *MockUserServiceServer) VerifyTwoFactorChallenge(arg0 context.Context, arg1 *proto.VerifyTwoFactorChallengeRequest) (*proto.VerifyTwoFactorChallengeReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTwoFactorChallenge", arg0, arg1)
+	ret0, _ := ret[0].(*proto.VerifyTwoFactorChallengeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTwoFactorChallenge indicates an expected call of VerifyTwoFactorChallenge.
+func (mr *MockUserServiceServerMockRecorder) VerifyTwoFactorChallenge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTwoFactorChallenge", reflect.TypeOf((*MockUserServiceServer)(nil).VerifyTwoFactorChallenge), arg0, arg1)
+}
+
 // mustEmbedUnimplementedUserServiceServer mocks base method.
 func (m *MockUserServiceServer) mustEmbedUnimplementedUserServiceServer() {
 	m.ctrl.T.Helper()
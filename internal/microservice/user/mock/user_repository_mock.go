@@ -8,6 +8,7 @@ import (
 	context "context"
 	domain_models "mail/internal/microservice/models/domain_models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -50,6 +51,21 @@ func (mr *MockUserRepositoryMockRecorder) Add(user, ctx interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockUserRepository)(nil).Add), user, ctx)
 }
 
+// AddAPIToken mocks base method.
+func (m *MockUserRepository) AddAPIToken(token *domain_models.APIToken, ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAPIToken", token, ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAPIToken indicates an expected call of AddAPIToken.
+func (mr *MockUserRepositoryMockRecorder) AddAPIToken(token, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAPIToken", reflect.TypeOf((*MockUserRepository)(nil).AddAPIToken), token, ctx)
+}
+
 // AddAvatar mocks base method.
 func (m *MockUserRepository) AddAvatar(id uint32, fileID, fileType string, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +81,64 @@ func (mr *MockUserRepositoryMockRecorder) AddAvatar(id, fileID, fileType, ctx in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAvatar", reflect.TypeOf((*MockUserRepository)(nil).AddAvatar), id, fileID, fileType, ctx)
 }
 
+// AddPasswordResetToken mocks base method.
+func (m *MockUserRepository) AddPasswordResetToken(token *domain_models.PasswordResetToken, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPasswordResetToken", token, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPasswordResetToken indicates an expected call of AddPasswordResetToken.
+func (mr *MockUserRepositoryMockRecorder) AddPasswordResetToken(token, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPasswordResetToken", reflect.TypeOf((*MockUserRepository)(nil).AddPasswordResetToken), token, ctx)
+}
+
+// AddTwoFactorChallenge mocks base method.
+func (m *MockUserRepository) AddTwoFactorChallenge(challenge *domain_models.TwoFactorChallenge, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTwoFactorChallenge", challenge, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTwoFactorChallenge indicates an expected call of AddTwoFactorChallenge.
+func (mr *MockUserRepositoryMockRecorder) AddTwoFactorChallenge(challenge, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTwoFactorChallenge", reflect.TypeOf((*MockUserRepository)(nil).AddTwoFactorChallenge), challenge, ctx)
+}
+
+// ConfirmRecoveryEmail mocks base method.
+func (m *MockUserRepository) ConfirmRecoveryEmail(profileID uint32, recoveryEmail string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmRecoveryEmail", profileID, recoveryEmail, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmRecoveryEmail indicates an expected call of ConfirmRecoveryEmail.
+func (mr *MockUserRepositoryMockRecorder) ConfirmRecoveryEmail(profileID, recoveryEmail, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmRecoveryEmail", reflect.TypeOf((*MockUserRepository)(nil).ConfirmRecoveryEmail), profileID, recoveryEmail, ctx)
+}
+
+// CountRecoveryCodes mocks base method.
+func (m *MockUserRepository) CountRecoveryCodes(profileID uint32, ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRecoveryCodes", profileID, ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRecoveryCodes indicates an expected call of CountRecoveryCodes.
+func (mr *MockUserRepositoryMockRecorder) CountRecoveryCodes(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRecoveryCodes", reflect.TypeOf((*MockUserRepository)(nil).CountRecoveryCodes), profileID, ctx)
+}
+
 // Delete mocks base method.
 func (m *MockUserRepository) Delete(id uint32, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +154,21 @@ func (mr *MockUserRepositoryMockRecorder) Delete(id, ctx interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), id, ctx)
 }
 
+// DeleteAPIToken mocks base method.
+func (m *MockUserRepository) DeleteAPIToken(profileID, id uint32, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIToken", profileID, id, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAPIToken indicates an expected call of DeleteAPIToken.
+func (mr *MockUserRepositoryMockRecorder) DeleteAPIToken(profileID, id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIToken", reflect.TypeOf((*MockUserRepository)(nil).DeleteAPIToken), profileID, id, ctx)
+}
+
 // DeleteAvatarByUserID mocks base method.
 func (m *MockUserRepository) DeleteAvatarByUserID(userID uint32, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -94,6 +183,78 @@ func (mr *MockUserRepositoryMockRecorder) DeleteAvatarByUserID(userID, ctx inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAvatarByUserID", reflect.TypeOf((*MockUserRepository)(nil).DeleteAvatarByUserID), userID, ctx)
 }
 
+// DeleteTwoFactorChallenge mocks base method.
+func (m *MockUserRepository) DeleteTwoFactorChallenge(id string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTwoFactorChallenge", id, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTwoFactorChallenge indicates an expected call of DeleteTwoFactorChallenge.
+func (mr *MockUserRepositoryMockRecorder) DeleteTwoFactorChallenge(id, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTwoFactorChallenge", reflect.TypeOf((*MockUserRepository)(nil).DeleteTwoFactorChallenge), id, ctx)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockUserRepository) DisableTwoFactor(profileID uint32, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", profileID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) DisableTwoFactor(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).DisableTwoFactor), profileID, ctx)
+}
+
+// EnableTwoFactor mocks base method.
+func (m *MockUserRepository) EnableTwoFactor(profileID uint32, step int64, recoveryCodeHashes []string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", profileID, step, recoveryCodeHashes, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) EnableTwoFactor(profileID, step, recoveryCodeHashes, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).EnableTwoFactor), profileID, step, recoveryCodeHashes, ctx)
+}
+
+// GetAPITokenByHash mocks base method.
+func (m *MockUserRepository) GetAPITokenByHash(tokenHash string, ctx context.Context) (*domain_models.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPITokenByHash", tokenHash, ctx)
+	ret0, _ := ret[0].(*domain_models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokenByHash indicates an expected call of GetAPITokenByHash.
+func (mr *MockUserRepositoryMockRecorder) GetAPITokenByHash(tokenHash, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokenByHash", reflect.TypeOf((*MockUserRepository)(nil).GetAPITokenByHash), tokenHash, ctx)
+}
+
+// GetAPITokens mocks base method.
+func (m *MockUserRepository) GetAPITokens(profileID uint32, ctx context.Context) ([]*domain_models.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPITokens", profileID, ctx)
+	ret0, _ := ret[0].([]*domain_models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokens indicates an expected call of GetAPITokens.
+func (mr *MockUserRepositoryMockRecorder) GetAPITokens(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokens", reflect.TypeOf((*MockUserRepository)(nil).GetAPITokens), profileID, ctx)
+}
+
 // GetAll mocks base method.
 func (m *MockUserRepository) GetAll(offset, limit int, ctx context.Context) ([]*domain_models.User, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +315,37 @@ func (mr *MockUserRepositoryMockRecorder) GetByVKID(vkId, ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByVKID", reflect.TypeOf((*MockUserRepository)(nil).GetByVKID), vkId, ctx)
 }
 
+// GetRecoveryEmail mocks base method.
+func (m *MockUserRepository) GetRecoveryEmail(profileID uint32, ctx context.Context) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryEmail", profileID, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecoveryEmail indicates an expected call of GetRecoveryEmail.
+func (mr *MockUserRepositoryMockRecorder) GetRecoveryEmail(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryEmail", reflect.TypeOf((*MockUserRepository)(nil).GetRecoveryEmail), profileID, ctx)
+}
+
+// GetTwoFactor mocks base method.
+func (m *MockUserRepository) GetTwoFactor(profileID uint32, ctx context.Context) (*domain_models.TwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactor", profileID, ctx)
+	ret0, _ := ret[0].(*domain_models.TwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactor indicates an expected call of GetTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) GetTwoFactor(profileID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).GetTwoFactor), profileID, ctx)
+}
+
 // GetUserByLogin mocks base method.
 func (m *MockUserRepository) GetUserByLogin(login, password string, ctx context.Context) (*domain_models.User, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +376,79 @@ func (mr *MockUserRepositoryMockRecorder) InitAvatar(id, fileID, fileType, ctx i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitAvatar", reflect.TypeOf((*MockUserRepository)(nil).InitAvatar), id, fileID, fileType, ctx)
 }
 
+// QuerySecurityEvents mocks base method.
+func (m *MockUserRepository) QuerySecurityEvents(filter *domain_models.SecurityEventFilter, ctx context.Context) ([]*domain_models.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySecurityEvents", filter, ctx)
+	ret0, _ := ret[0].([]*domain_models.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySecurityEvents indicates an expected call of QuerySecurityEvents.
+func (mr *MockUserRepositoryMockRecorder) QuerySecurityEvents(filter, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySecurityEvents", reflect.TypeOf((*MockUserRepository)(nil).QuerySecurityEvents), filter, ctx)
+}
+
+// ResetPasswordByToken mocks base method.
+func (m *MockUserRepository) ResetPasswordByToken(tokenHash, password string, ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordByToken", tokenHash, password, ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordByToken indicates an expected call of ResetPasswordByToken.
+func (mr *MockUserRepositoryMockRecorder) ResetPasswordByToken(tokenHash, password, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordByToken", reflect.TypeOf((*MockUserRepository)(nil).ResetPasswordByToken), tokenHash, password, ctx)
+}
+
+// SaveTwoFactorSecret mocks base method.
+func (m *MockUserRepository) SaveTwoFactorSecret(profileID uint32, secret string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTwoFactorSecret", profileID, secret, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTwoFactorSecret indicates an expected call of SaveTwoFactorSecret.
+func (mr *MockUserRepositoryMockRecorder) SaveTwoFactorSecret(profileID, secret, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTwoFactorSecret", reflect.TypeOf((*MockUserRepository)(nil).SaveTwoFactorSecret), profileID, secret, ctx)
+}
+
+// SetRecoveryEmail mocks base method.
+func (m *MockUserRepository) SetRecoveryEmail(profileID uint32, recoveryEmail string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecoveryEmail", profileID, recoveryEmail, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRecoveryEmail indicates an expected call of SetRecoveryEmail.
+func (mr *MockUserRepositoryMockRecorder) SetRecoveryEmail(profileID, recoveryEmail, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecoveryEmail", reflect.TypeOf((*MockUserRepository)(nil).SetRecoveryEmail), profileID, recoveryEmail, ctx)
+}
+
+// SpendTwoFactorChallengeAttempt mocks base method.
+func (m *MockUserRepository) SpendTwoFactorChallengeAttempt(id string, maxAttempts int, ctx context.Context) (*domain_models.TwoFactorChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendTwoFactorChallengeAttempt", id, maxAttempts, ctx)
+	ret0, _ := ret[0].(*domain_models.TwoFactorChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendTwoFactorChallengeAttempt indicates an expected call of SpendTwoFactorChallengeAttempt.
+func (mr *MockUserRepositoryMockRecorder) SpendTwoFactorChallengeAttempt(id, maxAttempts, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendTwoFactorChallengeAttempt", reflect.TypeOf((*MockUserRepository)(nil).SpendTwoFactorChallengeAttempt), id, maxAttempts, ctx)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(newUser *domain_models.User, ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -198,3 +463,61 @@ func (mr *MockUserRepositoryMockRecorder) Update(newUser, ctx interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), newUser, ctx)
 }
+
+// UpdateAPITokenLastUsed mocks base method.
+func (m *MockUserRepository) UpdateAPITokenLastUsed(id uint32, lastUsedDate time.Time, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAPITokenLastUsed", id, lastUsedDate, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAPITokenLastUsed indicates an expected call of UpdateAPITokenLastUsed.
+func (mr *MockUserRepositoryMockRecorder) UpdateAPITokenLastUsed(id, lastUsedDate, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAPITokenLastUsed", reflect.TypeOf((*MockUserRepository)(nil).UpdateAPITokenLastUsed), id, lastUsedDate, ctx)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUserRepository) UpdatePassword(profileID uint32, password string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", profileID, password, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserRepositoryMockRecorder) UpdatePassword(profileID, password, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepository)(nil).UpdatePassword), profileID, password, ctx)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockUserRepository) UseRecoveryCode(profileID uint32, codeHash string, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", profileID, codeHash, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockUserRepositoryMockRecorder) UseRecoveryCode(profileID, codeHash, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockUserRepository)(nil).UseRecoveryCode), profileID, codeHash, ctx)
+}
+
+// UseTwoFactorStep mocks base method.
+func (m *MockUserRepository) UseTwoFactorStep(profileID uint32, step int64, ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTwoFactorStep", profileID, step, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTwoFactorStep indicates an expected call of UseTwoFactorStep.
+func (mr *MockUserRepositoryMockRecorder) UseTwoFactorStep(profileID, step, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTwoFactorStep", reflect.TypeOf((*MockUserRepository)(nil).UseTwoFactorStep), profileID, step, ctx)
+}
@@ -50,6 +50,141 @@ func (mr *MockUserUseCaseMockRecorder) AddAvatar(id, fileID, ctx interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAvatar", reflect.TypeOf((*MockUserUseCase)(nil).AddAvatar), id, fileID, ctx)
 }
 
+// AddRecoveryEmail mocks base method.
+func (m *MockUserUseCase) AddRecoveryEmail(userID uint32, recoveryEmail string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecoveryEmail", userID, recoveryEmail, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecoveryEmail indicates an expected call of AddRecoveryEmail.
+func (mr *MockUserUseCaseMockRecorder) AddRecoveryEmail(userID, recoveryEmail, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecoveryEmail", reflect.TypeOf((*MockUserUseCase)(nil).AddRecoveryEmail), userID, recoveryEmail, ctx)
+}
+
+// AuthenticateAPIToken mocks base method.
+func (m *MockUserUseCase) AuthenticateAPIToken(token string, ctx context.Context) (*domain_models.APIToken, *domain_models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", token, ctx)
+	ret0, _ := ret[0].(*domain_models.APIToken)
+	ret1, _ := ret[1].(*domain_models.User)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockUserUseCaseMockRecorder) AuthenticateAPIToken(token, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockUserUseCase)(nil).AuthenticateAPIToken), token, ctx)
+}
+
+// BeginTwoFactorSetup mocks base method.
+func (m *MockUserUseCase) BeginTwoFactorSetup(userID uint32, ctx context.Context) (*domain_models.TwoFactorSetup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTwoFactorSetup", userID, ctx)
+	ret0, _ := ret[0].(*domain_models.TwoFactorSetup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTwoFactorSetup indicates an expected call of BeginTwoFactorSetup.
+func (mr *MockUserUseCaseMockRecorder) BeginTwoFactorSetup(userID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTwoFactorSetup", reflect.TypeOf((*MockUserUseCase)(nil).BeginTwoFactorSetup), userID, ctx)
+}
+
+// ChangePassword mocks base method.
+func (m *MockUserUseCase) ChangePassword(userID uint32, oldPassword, newPassword string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", userID, oldPassword, newPassword, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserUseCaseMockRecorder) ChangePassword(userID, oldPassword, newPassword, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserUseCase)(nil).ChangePassword), userID, oldPassword, newPassword, ctx)
+}
+
+// ConfirmRecoveryEmail mocks base method.
+func (m *MockUserUseCase) ConfirmRecoveryEmail(userID uint32, recoveryEmail string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmRecoveryEmail", userID, recoveryEmail, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmRecoveryEmail indicates an expected call of ConfirmRecoveryEmail.
+func (mr *MockUserUseCaseMockRecorder) ConfirmRecoveryEmail(userID, recoveryEmail, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmRecoveryEmail", reflect.TypeOf((*MockUserUseCase)(nil).ConfirmRecoveryEmail), userID, recoveryEmail, ctx)
+}
+
+// ConfirmTwoFactorSetup mocks base method.
+func (m *MockUserUseCase) ConfirmTwoFactorSetup(userID uint32, code string, ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactorSetup", userID, code, ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTwoFactorSetup indicates an expected call of ConfirmTwoFactorSetup.
+func (mr *MockUserUseCaseMockRecorder) ConfirmTwoFactorSetup(userID, code, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactorSetup", reflect.TypeOf((*MockUserUseCase)(nil).ConfirmTwoFactorSetup), userID, code, ctx)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockUserUseCase) CreateAPIToken(userID uint32, name string, scopes []string, lifeTimeDays int, ctx context.Context) (*domain_models.APIToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", userID, name, scopes, lifeTimeDays, ctx)
+	ret0, _ := ret[0].(*domain_models.APIToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockUserUseCaseMockRecorder) CreateAPIToken(userID, name, scopes, lifeTimeDays, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockUserUseCase)(nil).CreateAPIToken), userID, name, scopes, lifeTimeDays, ctx)
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockUserUseCase) CreatePasswordResetToken(login string, ctx context.Context) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", login, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockUserUseCaseMockRecorder) CreatePasswordResetToken(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockUserUseCase)(nil).CreatePasswordResetToken), login, ctx)
+}
+
+// CreateTwoFactorChallenge mocks base method.
+func (m *MockUserUseCase) CreateTwoFactorChallenge(userID uint32, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTwoFactorChallenge", userID, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTwoFactorChallenge indicates an expected call of CreateTwoFactorChallenge.
+func (mr *MockUserUseCaseMockRecorder) CreateTwoFactorChallenge(userID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTwoFactorChallenge", reflect.TypeOf((*MockUserUseCase)(nil).CreateTwoFactorChallenge), userID, ctx)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserUseCase) CreateUser(user *domain_models.User, ctx context.Context) (*domain_models.User, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +200,20 @@ func (mr *MockUserUseCaseMockRecorder) CreateUser(user, ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserUseCase)(nil).CreateUser), user, ctx)
 }
 
+// DeleteAPIToken mocks base method.
+func (m *MockUserUseCase) DeleteAPIToken(userID, tokenID uint32, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIToken", userID, tokenID, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIToken indicates an expected call of DeleteAPIToken.
+func (mr *MockUserUseCaseMockRecorder) DeleteAPIToken(userID, tokenID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIToken", reflect.TypeOf((*MockUserUseCase)(nil).DeleteAPIToken), userID, tokenID, ctx)
+}
+
 // DeleteAvatarByUserID mocks base method.
 func (m *MockUserUseCase) DeleteAvatarByUserID(userID uint32, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -94,6 +243,35 @@ func (mr *MockUserUseCaseMockRecorder) DeleteUserByID(id, ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserByID", reflect.TypeOf((*MockUserUseCase)(nil).DeleteUserByID), id, ctx)
 }
 
+// DisableTwoFactor mocks base method.
+func (m *MockUserUseCase) DisableTwoFactor(userID uint32, password, code string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", userID, password, code, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockUserUseCaseMockRecorder) DisableTwoFactor(userID, password, code, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUserUseCase)(nil).DisableTwoFactor), userID, password, code, ctx)
+}
+
+// GetAPITokens mocks base method.
+func (m *MockUserUseCase) GetAPITokens(userID uint32, ctx context.Context) ([]*domain_models.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPITokens", userID, ctx)
+	ret0, _ := ret[0].([]*domain_models.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokens indicates an expected call of GetAPITokens.
+func (mr *MockUserUseCaseMockRecorder) GetAPITokens(userID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokens", reflect.TypeOf((*MockUserUseCase)(nil).GetAPITokens), userID, ctx)
+}
+
 // GetAllUsers mocks base method.
 func (m *MockUserUseCase) GetAllUsers(ctx context.Context) ([]*domain_models.User, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +287,53 @@ func (mr *MockUserUseCaseMockRecorder) GetAllUsers(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserUseCase)(nil).GetAllUsers), ctx)
 }
 
+// GetRecoveryEmail mocks base method.
+func (m *MockUserUseCase) GetRecoveryEmail(userID uint32, ctx context.Context) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryEmail", userID, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecoveryEmail indicates an expected call of GetRecoveryEmail.
+func (mr *MockUserUseCaseMockRecorder) GetRecoveryEmail(userID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryEmail", reflect.TypeOf((*MockUserUseCase)(nil).GetRecoveryEmail), userID, ctx)
+}
+
+// GetSecurityEvents mocks base method.
+func (m *MockUserUseCase) GetSecurityEvents(userID uint32, beforeID uint64, limit int, ctx context.Context) ([]*domain_models.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityEvents", userID, beforeID, limit, ctx)
+	ret0, _ := ret[0].([]*domain_models.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityEvents indicates an expected call of GetSecurityEvents.
+func (mr *MockUserUseCaseMockRecorder) GetSecurityEvents(userID, beforeID, limit, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityEvents", reflect.TypeOf((*MockUserUseCase)(nil).GetSecurityEvents), userID, beforeID, limit, ctx)
+}
+
+// GetTwoFactorStatus mocks base method.
+func (m *MockUserUseCase) GetTwoFactorStatus(userID uint32, ctx context.Context) (bool, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactorStatus", userID, ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTwoFactorStatus indicates an expected call of GetTwoFactorStatus.
+func (mr *MockUserUseCaseMockRecorder) GetTwoFactorStatus(userID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorStatus", reflect.TypeOf((*MockUserUseCase)(nil).GetTwoFactorStatus), userID, ctx)
+}
+
 // GetUserByID mocks base method.
 func (m *MockUserUseCase) GetUserByID(id uint32, ctx context.Context) (*domain_models.User, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +409,50 @@ func (mr *MockUserUseCaseMockRecorder) IsLoginUnique(login, ctx interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLoginUnique", reflect.TypeOf((*MockUserUseCase)(nil).IsLoginUnique), login, ctx)
 }
 
+// QuerySecurityEvents mocks base method.
+func (m *MockUserUseCase) QuerySecurityEvents(filter *domain_models.SecurityEventFilter, ctx context.Context) ([]*domain_models.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySecurityEvents", filter, ctx)
+	ret0, _ := ret[0].([]*domain_models.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySecurityEvents indicates an expected call of QuerySecurityEvents.
+func (mr *MockUserUseCaseMockRecorder) QuerySecurityEvents(filter, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySecurityEvents", reflect.TypeOf((*MockUserUseCase)(nil).QuerySecurityEvents), filter, ctx)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserUseCase) ResetPassword(token, newPassword string, ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", token, newPassword, ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserUseCaseMockRecorder) ResetPassword(token, newPassword, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserUseCase)(nil).ResetPassword), token, newPassword, ctx)
+}
+
+// SetRecoveryEmail mocks base method.
+func (m *MockUserUseCase) SetRecoveryEmail(userID uint32, password, recoveryEmail string, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecoveryEmail", userID, password, recoveryEmail, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRecoveryEmail indicates an expected call of SetRecoveryEmail.
+func (mr *MockUserUseCaseMockRecorder) SetRecoveryEmail(userID, password, recoveryEmail, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecoveryEmail", reflect.TypeOf((*MockUserUseCase)(nil).SetRecoveryEmail), userID, password, recoveryEmail, ctx)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserUseCase) UpdateUser(userNew *domain_models.User, ctx context.Context) (*domain_models.User, error) {
 	m.ctrl.T.Helper()
@@ -198,3 +467,18 @@ func (mr *MockUserUseCaseMockRecorder) UpdateUser(userNew, ctx interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserUseCase)(nil).UpdateUser), userNew, ctx)
 }
+
+// VerifyTwoFactorChallenge mocks base method.
+func (m *MockUserUseCase) VerifyTwoFactorChallenge(challengeID, code string, ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTwoFactorChallenge", challengeID, code, ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTwoFactorChallenge indicates an expected call of VerifyTwoFactorChallenge.
+func (mr *MockUserUseCaseMockRecorder) VerifyTwoFactorChallenge(challengeID, code, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTwoFactorChallenge", reflect.TypeOf((*MockUserUseCase)(nil).VerifyTwoFactorChallenge), challengeID, code, ctx)
+}
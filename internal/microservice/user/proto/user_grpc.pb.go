@@ -36,6 +36,24 @@ type UserServiceClient interface {
 	GetUserByVKId(ctx context.Context, in *GetUserVKIdRequest, opts ...grpc.CallOption) (*GetUserReply, error)
 	GetUserByOnlyLogin(ctx context.Context, in *GetUserByOnlyLoginRequest, opts ...grpc.CallOption) (*GetUserByOnlyLoginReply, error)
 	CreateUserOtherMail(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserReply, error)
+	GetTwoFactorStatus(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusReply, error)
+	BeginTwoFactorSetup(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*BeginTwoFactorSetupReply, error)
+	ConfirmTwoFactorSetup(ctx context.Context, in *ConfirmTwoFactorSetupRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorSetupReply, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorReply, error)
+	CreateTwoFactorChallenge(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*CreateTwoFactorChallengeReply, error)
+	VerifyTwoFactorChallenge(ctx context.Context, in *VerifyTwoFactorChallengeRequest, opts ...grpc.CallOption) (*VerifyTwoFactorChallengeReply, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error)
+	SetRecoveryEmail(ctx context.Context, in *SetRecoveryEmailRequest, opts ...grpc.CallOption) (*SetRecoveryEmailReply, error)
+	GetRecoveryEmail(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*GetRecoveryEmailReply, error)
+	ConfirmRecoveryEmail(ctx context.Context, in *ConfirmRecoveryEmailRequest, opts ...grpc.CallOption) (*ConfirmRecoveryEmailReply, error)
+	CreatePasswordResetToken(ctx context.Context, in *CreatePasswordResetTokenRequest, opts ...grpc.CallOption) (*CreatePasswordResetTokenReply, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error)
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenReply, error)
+	GetAPITokens(ctx context.Context, in *GetAPITokensRequest, opts ...grpc.CallOption) (*GetAPITokensReply, error)
+	DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenReply, error)
+	AuthenticateAPIToken(ctx context.Context, in *AuthenticateAPITokenRequest, opts ...grpc.CallOption) (*AuthenticateAPITokenReply, error)
+	GetSecurityEvents(ctx context.Context, in *GetSecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsReply, error)
+	QuerySecurityEvents(ctx context.Context, in *QuerySecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsReply, error)
 }
 
 type userServiceClient struct {
@@ -154,6 +172,168 @@ func (c *userServiceClient) CreateUserOtherMail(ctx context.Context, in *CreateU
 	return out, nil
 }
 
+func (c *userServiceClient) GetTwoFactorStatus(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusReply, error) {
+	out := new(GetTwoFactorStatusReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetTwoFactorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginTwoFactorSetup(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*BeginTwoFactorSetupReply, error) {
+	out := new(BeginTwoFactorSetupReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/BeginTwoFactorSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmTwoFactorSetup(ctx context.Context, in *ConfirmTwoFactorSetupRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorSetupReply, error) {
+	out := new(ConfirmTwoFactorSetupReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ConfirmTwoFactorSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorReply, error) {
+	out := new(DisableTwoFactorReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/DisableTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateTwoFactorChallenge(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*CreateTwoFactorChallengeReply, error) {
+	out := new(CreateTwoFactorChallengeReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/CreateTwoFactorChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyTwoFactorChallenge(ctx context.Context, in *VerifyTwoFactorChallengeRequest, opts ...grpc.CallOption) (*VerifyTwoFactorChallengeReply, error) {
+	out := new(VerifyTwoFactorChallengeReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/VerifyTwoFactorChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error) {
+	out := new(ChangePasswordReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetRecoveryEmail(ctx context.Context, in *SetRecoveryEmailRequest, opts ...grpc.CallOption) (*SetRecoveryEmailReply, error) {
+	out := new(SetRecoveryEmailReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/SetRecoveryEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetRecoveryEmail(ctx context.Context, in *TwoFactorUserRequest, opts ...grpc.CallOption) (*GetRecoveryEmailReply, error) {
+	out := new(GetRecoveryEmailReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetRecoveryEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmRecoveryEmail(ctx context.Context, in *ConfirmRecoveryEmailRequest, opts ...grpc.CallOption) (*ConfirmRecoveryEmailReply, error) {
+	out := new(ConfirmRecoveryEmailReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ConfirmRecoveryEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreatePasswordResetToken(ctx context.Context, in *CreatePasswordResetTokenRequest, opts ...grpc.CallOption) (*CreatePasswordResetTokenReply, error) {
+	out := new(CreatePasswordResetTokenReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/CreatePasswordResetToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error) {
+	out := new(ResetPasswordReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenReply, error) {
+	out := new(CreateAPITokenReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/CreateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAPITokens(ctx context.Context, in *GetAPITokensRequest, opts ...grpc.CallOption) (*GetAPITokensReply, error) {
+	out := new(GetAPITokensReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetAPITokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenReply, error) {
+	out := new(DeleteAPITokenReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/DeleteAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AuthenticateAPIToken(ctx context.Context, in *AuthenticateAPITokenRequest, opts ...grpc.CallOption) (*AuthenticateAPITokenReply, error) {
+	out := new(AuthenticateAPITokenReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/AuthenticateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSecurityEvents(ctx context.Context, in *GetSecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsReply, error) {
+	out := new(SecurityEventsReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/GetSecurityEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) QuerySecurityEvents(ctx context.Context, in *QuerySecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsReply, error) {
+	out := new(SecurityEventsReply)
+	err := c.cc.Invoke(ctx, "/proto.UserService/QuerySecurityEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -170,6 +350,24 @@ type UserServiceServer interface {
 	GetUserByVKId(context.Context, *GetUserVKIdRequest) (*GetUserReply, error)
 	GetUserByOnlyLogin(context.Context, *GetUserByOnlyLoginRequest) (*GetUserByOnlyLoginReply, error)
 	CreateUserOtherMail(context.Context, *CreateUserRequest) (*CreateUserReply, error)
+	GetTwoFactorStatus(context.Context, *TwoFactorUserRequest) (*GetTwoFactorStatusReply, error)
+	BeginTwoFactorSetup(context.Context, *TwoFactorUserRequest) (*BeginTwoFactorSetupReply, error)
+	ConfirmTwoFactorSetup(context.Context, *ConfirmTwoFactorSetupRequest) (*ConfirmTwoFactorSetupReply, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorReply, error)
+	CreateTwoFactorChallenge(context.Context, *TwoFactorUserRequest) (*CreateTwoFactorChallengeReply, error)
+	VerifyTwoFactorChallenge(context.Context, *VerifyTwoFactorChallengeRequest) (*VerifyTwoFactorChallengeReply, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	SetRecoveryEmail(context.Context, *SetRecoveryEmailRequest) (*SetRecoveryEmailReply, error)
+	GetRecoveryEmail(context.Context, *TwoFactorUserRequest) (*GetRecoveryEmailReply, error)
+	ConfirmRecoveryEmail(context.Context, *ConfirmRecoveryEmailRequest) (*ConfirmRecoveryEmailReply, error)
+	CreatePasswordResetToken(context.Context, *CreatePasswordResetTokenRequest) (*CreatePasswordResetTokenReply, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenReply, error)
+	GetAPITokens(context.Context, *GetAPITokensRequest) (*GetAPITokensReply, error)
+	DeleteAPIToken(context.Context, *DeleteAPITokenRequest) (*DeleteAPITokenReply, error)
+	AuthenticateAPIToken(context.Context, *AuthenticateAPITokenRequest) (*AuthenticateAPITokenReply, error)
+	GetSecurityEvents(context.Context, *GetSecurityEventsRequest) (*SecurityEventsReply, error)
+	QuerySecurityEvents(context.Context, *QuerySecurityEventsRequest) (*SecurityEventsReply, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -213,6 +411,60 @@ func (UnimplementedUserServiceServer) GetUserByOnlyLogin(context.Context, *GetUs
 func (UnimplementedUserServiceServer) CreateUserOtherMail(context.Context, *CreateUserRequest) (*CreateUserReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserOtherMail not implemented")
 }
+func (UnimplementedUserServiceServer) GetTwoFactorStatus(context.Context, *TwoFactorUserRequest) (*GetTwoFactorStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactorStatus not implemented")
+}
+func (UnimplementedUserServiceServer) BeginTwoFactorSetup(context.Context, *TwoFactorUserRequest) (*BeginTwoFactorSetupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTwoFactorSetup not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmTwoFactorSetup(context.Context, *ConfirmTwoFactorSetupRequest) (*ConfirmTwoFactorSetupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactorSetup not implemented")
+}
+func (UnimplementedUserServiceServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) CreateTwoFactorChallenge(context.Context, *TwoFactorUserRequest) (*CreateTwoFactorChallengeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTwoFactorChallenge not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyTwoFactorChallenge(context.Context, *VerifyTwoFactorChallengeRequest) (*VerifyTwoFactorChallengeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactorChallenge not implemented")
+}
+func (UnimplementedUserServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServiceServer) SetRecoveryEmail(context.Context, *SetRecoveryEmailRequest) (*SetRecoveryEmailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecoveryEmail not implemented")
+}
+func (UnimplementedUserServiceServer) GetRecoveryEmail(context.Context, *TwoFactorUserRequest) (*GetRecoveryEmailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryEmail not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmRecoveryEmail(context.Context, *ConfirmRecoveryEmailRequest) (*ConfirmRecoveryEmailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRecoveryEmail not implemented")
+}
+func (UnimplementedUserServiceServer) CreatePasswordResetToken(context.Context, *CreatePasswordResetTokenRequest) (*CreatePasswordResetTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePasswordResetToken not implemented")
+}
+func (UnimplementedUserServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServiceServer) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedUserServiceServer) GetAPITokens(context.Context, *GetAPITokensRequest) (*GetAPITokensReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPITokens not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteAPIToken(context.Context, *DeleteAPITokenRequest) (*DeleteAPITokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIToken not implemented")
+}
+func (UnimplementedUserServiceServer) AuthenticateAPIToken(context.Context, *AuthenticateAPITokenRequest) (*AuthenticateAPITokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateAPIToken not implemented")
+}
+func (UnimplementedUserServiceServer) GetSecurityEvents(context.Context, *GetSecurityEventsRequest) (*SecurityEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecurityEvents not implemented")
+}
+func (UnimplementedUserServiceServer) QuerySecurityEvents(context.Context, *QuerySecurityEventsRequest) (*SecurityEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySecurityEvents not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -442,6 +694,330 @@ func _UserService_CreateUserOtherMail_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetTwoFactorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTwoFactorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetTwoFactorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTwoFactorStatus(ctx, req.(*TwoFactorUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BeginTwoFactorSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginTwoFactorSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/BeginTwoFactorSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginTwoFactorSetup(ctx, req.(*TwoFactorUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmTwoFactorSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorSetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmTwoFactorSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ConfirmTwoFactorSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmTwoFactorSetup(ctx, req.(*ConfirmTwoFactorSetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/DisableTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateTwoFactorChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateTwoFactorChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/CreateTwoFactorChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateTwoFactorChallenge(ctx, req.(*TwoFactorUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyTwoFactorChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyTwoFactorChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/VerifyTwoFactorChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyTwoFactorChallenge(ctx, req.(*VerifyTwoFactorChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetRecoveryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecoveryEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetRecoveryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/SetRecoveryEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetRecoveryEmail(ctx, req.(*SetRecoveryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetRecoveryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRecoveryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetRecoveryEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRecoveryEmail(ctx, req.(*TwoFactorUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmRecoveryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmRecoveryEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmRecoveryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ConfirmRecoveryEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmRecoveryEmail(ctx, req.(*ConfirmRecoveryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreatePasswordResetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePasswordResetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreatePasswordResetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/CreatePasswordResetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreatePasswordResetToken(ctx, req.(*CreatePasswordResetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/CreateAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetAPITokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAPITokens(ctx, req.(*GetAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/DeleteAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteAPIToken(ctx, req.(*DeleteAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AuthenticateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AuthenticateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/AuthenticateAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AuthenticateAPIToken(ctx, req.(*AuthenticateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/GetSecurityEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSecurityEvents(ctx, req.(*GetSecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_QuerySecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).QuerySecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.UserService/QuerySecurityEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).QuerySecurityEvents(ctx, req.(*QuerySecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +1073,78 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUserOtherMail",
 			Handler:    _UserService_CreateUserOtherMail_Handler,
 		},
+		{
+			MethodName: "GetTwoFactorStatus",
+			Handler:    _UserService_GetTwoFactorStatus_Handler,
+		},
+		{
+			MethodName: "BeginTwoFactorSetup",
+			Handler:    _UserService_BeginTwoFactorSetup_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactorSetup",
+			Handler:    _UserService_ConfirmTwoFactorSetup_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _UserService_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "CreateTwoFactorChallenge",
+			Handler:    _UserService_CreateTwoFactorChallenge_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFactorChallenge",
+			Handler:    _UserService_VerifyTwoFactorChallenge_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "SetRecoveryEmail",
+			Handler:    _UserService_SetRecoveryEmail_Handler,
+		},
+		{
+			MethodName: "GetRecoveryEmail",
+			Handler:    _UserService_GetRecoveryEmail_Handler,
+		},
+		{
+			MethodName: "ConfirmRecoveryEmail",
+			Handler:    _UserService_ConfirmRecoveryEmail_Handler,
+		},
+		{
+			MethodName: "CreatePasswordResetToken",
+			Handler:    _UserService_CreatePasswordResetToken_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UserService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _UserService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "GetAPITokens",
+			Handler:    _UserService_GetAPITokens_Handler,
+		},
+		{
+			MethodName: "DeleteAPIToken",
+			Handler:    _UserService_DeleteAPIToken_Handler,
+		},
+		{
+			MethodName: "AuthenticateAPIToken",
+			Handler:    _UserService_AuthenticateAPIToken_Handler,
+		},
+		{
+			MethodName: "GetSecurityEvents",
+			Handler:    _UserService_GetSecurityEvents_Handler,
+		},
+		{
+			MethodName: "QuerySecurityEvents",
+			Handler:    _UserService_QuerySecurityEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// AddAPIToken stores a new personal access token and returns its unique identifier.
+// Nothing is stored if the user already has the maximum number of valid tokens.
+func (r *UserRepository) AddAPIToken(token *domain.APIToken, ctx context.Context) (uint32, error) {
+	query := `
+		INSERT INTO api_token (token_hash, profile_id, name, scopes, expiration_date, creation_date)
+		SELECT $1, $2, $3, $4, $5, $6
+		WHERE (SELECT COUNT(*) FROM api_token WHERE profile_id = $2 AND expiration_date > $6) < $7
+		RETURNING id
+	`
+
+	now := time.Now()
+	scopes := converters.APITokenScopesConvertCoreInDb(token.Scopes)
+
+	var id uint32
+
+	start := time.Now()
+
+	err := r.DB.Get(&id, query, token.TokenHash, token.ProfileID, token.Name, scopes, token.ExpirationDate, now, domain.APITokenMaxCount)
+
+	args := []interface{}{token.ProfileID, token.Name, scopes, token.ExpirationDate, now}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("user with id %d already has %d api tokens", token.ProfileID, domain.APITokenMaxCount)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to add api token: %v", err)
+	}
+
+	return id, nil
+}
+
+// GetAPITokens returns the personal access tokens of the user, the newest first.
+func (r *UserRepository) GetAPITokens(profileID uint32, ctx context.Context) ([]*domain.APIToken, error) {
+	query := `
+		SELECT id, token_hash, profile_id, name, scopes, creation_date, expiration_date, last_used_date
+		FROM api_token
+		WHERE profile_id = $1
+		ORDER BY creation_date DESC
+	`
+
+	var tokensDb []*database.APIToken
+
+	start := time.Now()
+
+	err := r.DB.Select(&tokensDb, query, profileID)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api tokens: %v", err)
+	}
+
+	tokens := make([]*domain.APIToken, 0, len(tokensDb))
+	for _, tokenDb := range tokensDb {
+		tokens = append(tokens, converters.APITokenConvertDbInCore(tokenDb))
+	}
+
+	return tokens, nil
+}
+
+// GetAPITokenByHash returns the personal access token with the hash.
+func (r *UserRepository) GetAPITokenByHash(tokenHash string, ctx context.Context) (*domain.APIToken, error) {
+	query := `
+		SELECT id, token_hash, profile_id, name, scopes, creation_date, expiration_date, last_used_date
+		FROM api_token
+		WHERE token_hash = $1
+	`
+
+	var tokenDb database.APIToken
+
+	start := time.Now()
+
+	err := r.DB.Get(&tokenDb, query, tokenHash)
+
+	args := []interface{}{}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api token: %v", err)
+	}
+
+	return converters.APITokenConvertDbInCore(&tokenDb), nil
+}
+
+// UpdateAPITokenLastUsed records the date of the last request made with the personal access token.
+func (r *UserRepository) UpdateAPITokenLastUsed(id uint32, lastUsedDate time.Time, ctx context.Context) error {
+	query := "UPDATE api_token SET last_used_date = $1 WHERE id = $2"
+
+	start := time.Now()
+
+	_, err := r.DB.Exec(query, lastUsedDate, id)
+
+	args := []interface{}{lastUsedDate, id}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to update api token: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteAPIToken removes the personal access token of the user, returns false if the user has no such token.
+func (r *UserRepository) DeleteAPIToken(profileID, id uint32, ctx context.Context) (bool, error) {
+	query := "DELETE FROM api_token WHERE id = $1 AND profile_id = $2"
+
+	start := time.Now()
+
+	result, err := r.DB.Exec(query, id, profileID)
+
+	args := []interface{}{id, profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to delete api token: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+var apiTokenColumns = []string{"id", "token_hash", "profile_id", "name", "scopes", "creation_date", "expiration_date", "last_used_date"}
+
+func TestAddAPIToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+	token := &domain.APIToken{
+		TokenHash:      "hash",
+		ProfileID:      1,
+		Name:           "backup",
+		Scopes:         []string{domain.APITokenScopeMailRead, domain.APITokenScopeMailSend},
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO api_token`).
+			WithArgs("hash", 1, "backup", "mail:read,mail:send", token.ExpirationDate, sqlmock.AnyArg(), domain.APITokenMaxCount).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+
+		id, err := repo.AddAPIToken(token, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(5), id)
+	})
+
+	t.Run("LimitReached", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO api_token`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.AddAPIToken(token, ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO api_token`).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.AddAPIToken(token, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAPITokens(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+	creationDate := time.Now()
+	expirationDate := creationDate.Add(24 * time.Hour)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM api_token WHERE profile_id = \$1 ORDER BY creation_date DESC`).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(apiTokenColumns).
+				AddRow(2, "hash2", 1, "sync", "folders:manage", creationDate, expirationDate, creationDate).
+				AddRow(1, "hash1", 1, "backup", "mail:read", creationDate, expirationDate, nil))
+
+		tokens, err := repo.GetAPITokens(1, ctx)
+		assert.NoError(t, err)
+		assert.Len(t, tokens, 2)
+		assert.Equal(t, []string{domain.APITokenScopeFoldersManage}, tokens[0].Scopes)
+		assert.Equal(t, creationDate, tokens[0].LastUsedDate)
+		assert.True(t, tokens[1].LastUsedDate.IsZero())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM api_token`).WithArgs(1).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetAPITokens(1, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAPITokenByHash(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+	creationDate := time.Now()
+
+	t.Run("Found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM api_token WHERE token_hash = \$1`).WithArgs("hash").
+			WillReturnRows(sqlmock.NewRows(apiTokenColumns).
+				AddRow(1, "hash", 2, "backup", "mail:read", creationDate, creationDate, nil))
+
+		token, err := repo.GetAPITokenByHash("hash", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), token.ID)
+		assert.Equal(t, uint32(2), token.ProfileID)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM api_token WHERE token_hash = \$1`).WithArgs("unknown").
+			WillReturnRows(sqlmock.NewRows(apiTokenColumns))
+
+		_, err := repo.GetAPITokenByHash("unknown", ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAPITokenLastUsed(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE api_token SET last_used_date = \$1 WHERE id = \$2`).
+			WithArgs(now, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdateAPITokenLastUsed(1, now, ctx))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE api_token SET last_used_date`).WillReturnError(fmt.Errorf("db error"))
+
+		assert.Error(t, repo.UpdateAPITokenLastUsed(1, now, ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAPIToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Deleted", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM api_token WHERE id = \$1 AND profile_id = \$2`).
+			WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		deleted, err := repo.DeleteAPIToken(1, 3, ctx)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM api_token`).
+			WithArgs(4, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		deleted, err := repo.DeleteAPIToken(1, 4, ctx)
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
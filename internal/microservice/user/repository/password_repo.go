@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// UpdatePassword hashes the new password of the user and stores it.
+func (r *UserRepository) UpdatePassword(profileID uint32, password string, ctx context.Context) error {
+	query := "UPDATE profile SET password_hash = $1 WHERE id = $2"
+
+	passwordHash, ok := HashPassword(password)
+	if !ok {
+		return fmt.Errorf("failed to hash password")
+	}
+
+	start := time.Now()
+
+	result, err := r.DB.Exec(query, passwordHash, profileID)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to update password: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user with id %d not found", profileID)
+	}
+
+	return nil
+}
+
+// GetRecoveryEmail returns the recovery email of the user, or an empty string if there is none,
+// and whether the user has verified it.
+func (r *UserRepository) GetRecoveryEmail(profileID uint32, ctx context.Context) (string, bool, error) {
+	query := "SELECT COALESCE(recovery_email, ''), recovery_email_verified FROM profile WHERE id = $1"
+
+	var recoveryEmail string
+	var verified bool
+
+	start := time.Now()
+
+	err := r.DB.QueryRowContext(ctx, query, profileID).Scan(&recoveryEmail, &verified)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get recovery email: %v", err)
+	}
+
+	return recoveryEmail, verified, nil
+}
+
+// SetRecoveryEmail sets the recovery email of the user, an empty email removes it.
+// A new address has to be verified again, setting the same address keeps it verified.
+func (r *UserRepository) SetRecoveryEmail(profileID uint32, recoveryEmail string, ctx context.Context) error {
+	query := `
+		UPDATE profile
+		SET recovery_email = NULLIF($1, ''),
+			recovery_email_verified = recovery_email_verified AND recovery_email IS NOT DISTINCT FROM NULLIF($1, '')
+		WHERE id = $2
+	`
+
+	start := time.Now()
+
+	result, err := r.DB.Exec(query, recoveryEmail, profileID)
+
+	args := []interface{}{recoveryEmail, profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to set recovery email: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user with id %d not found", profileID)
+	}
+
+	return nil
+}
+
+// ConfirmRecoveryEmail marks the recovery email of the user as verified.
+// It returns false if the recovery email of the user is no longer the verified address.
+func (r *UserRepository) ConfirmRecoveryEmail(profileID uint32, recoveryEmail string, ctx context.Context) (bool, error) {
+	query := "UPDATE profile SET recovery_email_verified = TRUE WHERE id = $1 AND recovery_email = $2"
+
+	start := time.Now()
+
+	result, err := r.DB.Exec(query, profileID, recoveryEmail)
+
+	args := []interface{}{profileID, recoveryEmail}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to confirm recovery email: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// AddPasswordResetToken stores a new password reset token.
+// The previous tokens of the user and the expired tokens of all users are removed, so only the last link works.
+func (r *UserRepository) AddPasswordResetToken(token *domain.PasswordResetToken, ctx context.Context) error {
+	query := `
+		WITH deleted AS (
+			DELETE FROM password_reset_token WHERE profile_id = $2 OR expiration_date < $4
+		)
+		INSERT INTO password_reset_token (token_hash, profile_id, expiration_date, creation_date)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	now := time.Now()
+
+	start := time.Now()
+
+	_, err := r.DB.Exec(query, token.TokenHash, token.ProfileID, token.ExpirationDate, now)
+
+	args := []interface{}{token.ProfileID, token.ExpirationDate, now}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add password reset token: %v", err)
+	}
+
+	return nil
+}
+
+// ResetPasswordByToken marks the unused and not expired token as used and stores the new password
+// of its user in one transaction. It returns the unique identifier of the user.
+func (r *UserRepository) ResetPasswordByToken(tokenHash, password string, ctx context.Context) (uint32, error) {
+	passwordHash, ok := HashPassword(password)
+	if !ok {
+		return 0, fmt.Errorf("failed to hash password")
+	}
+
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE password_reset_token
+		SET used_date = $2
+		WHERE token_hash = $1 AND used_date IS NULL AND expiration_date > $2
+		RETURNING profile_id
+	`
+
+	var profileID uint32
+	now := time.Now()
+
+	start := time.Now()
+	err = tx.Get(&profileID, query, tokenHash, now)
+
+	args := []interface{}{now}
+	ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("password reset token is invalid or expired")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to use password reset token: %v", err)
+	}
+
+	query = "UPDATE profile SET password_hash = $1 WHERE id = $2"
+
+	start = time.Now()
+	_, err = tx.Exec(query, passwordHash, profileID)
+
+	args = []interface{}{profileID}
+	ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to update password: %v", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return profileID, nil
+}
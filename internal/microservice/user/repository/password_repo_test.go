@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestUpdatePassword(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE profile SET password_hash = \$1 WHERE id = \$2`).
+			WithArgs(sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdatePassword(1, "newpassword", ctx))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE profile SET password_hash`).
+			WithArgs(sqlmock.AnyArg(), 2).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.Error(t, repo.UpdatePassword(2, "newpassword", ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRecoveryEmail(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COALESCE\(recovery_email, ''\), recovery_email_verified FROM profile WHERE id = \$1`).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"recovery_email", "recovery_email_verified"}).AddRow("user@example.com", true))
+
+		recoveryEmail, verified, err := repo.GetRecoveryEmail(1, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "user@example.com", recoveryEmail)
+		assert.True(t, verified)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT COALESCE\(recovery_email, ''\), recovery_email_verified FROM profile`).WithArgs(2).
+			WillReturnError(fmt.Errorf("db error"))
+
+		_, _, err := repo.GetRecoveryEmail(2, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetRecoveryEmail(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	mock.ExpectExec(`UPDATE profile SET recovery_email = NULLIF\(\$1, ''\), recovery_email_verified = recovery_email_verified AND recovery_email IS NOT DISTINCT FROM NULLIF\(\$1, ''\) WHERE id = \$2`).
+		WithArgs("user@example.com", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.SetRecoveryEmail(1, "user@example.com", ctx))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConfirmRecoveryEmail(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Confirmed", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE profile SET recovery_email_verified = TRUE WHERE id = \$1 AND recovery_email = \$2`).
+			WithArgs(1, "user@example.com").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		confirmed, err := repo.ConfirmRecoveryEmail(1, "user@example.com", ctx)
+		assert.NoError(t, err)
+		assert.True(t, confirmed)
+	})
+
+	t.Run("AddressChanged", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE profile SET recovery_email_verified = TRUE`).
+			WithArgs(1, "old@example.com").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		confirmed, err := repo.ConfirmRecoveryEmail(1, "old@example.com", ctx)
+		assert.NoError(t, err)
+		assert.False(t, confirmed)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddPasswordResetToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	token := &domain.PasswordResetToken{TokenHash: "hash", ProfileID: 1, ExpirationDate: time.Now().Add(time.Hour)}
+
+	mock.ExpectExec(`DELETE FROM password_reset_token WHERE profile_id = \$2 (.+) INSERT INTO password_reset_token`).
+		WithArgs("hash", 1, token.ExpirationDate, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.AddPasswordResetToken(token, ctx))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResetPasswordByToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE password_reset_token SET used_date = \$2 WHERE token_hash = \$1 AND used_date IS NULL AND expiration_date > \$2 RETURNING profile_id`).
+			WithArgs("hash", sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"profile_id"}).AddRow(1))
+		mock.ExpectExec(`UPDATE profile SET password_hash = \$1 WHERE id = \$2`).
+			WithArgs(sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		profileID, err := repo.ResetPasswordByToken("hash", "newpassword", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), profileID)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE password_reset_token`).
+			WithArgs("used", sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"profile_id"}))
+		mock.ExpectRollback()
+
+		_, err := repo.ResetPasswordByToken("used", "newpassword", ctx)
+		assert.EqualError(t, err, "password reset token is invalid or expired")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// QuerySecurityEvents returns the security events selected by the filter, the latest first.
+// The zero fields of the filter do not restrict the selection.
+func (r *UserRepository) QuerySecurityEvents(filter *domain.SecurityEventFilter, ctx context.Context) ([]*domain.SecurityEvent, error) {
+	query := `
+		SELECT id, profile_id, login, event_type, method, success, details, ip_address, user_agent, creation_date
+		FROM security_event
+		WHERE ($1 = 0 OR profile_id = $1)
+			AND ($2 = '' OR login = $2)
+			AND ($3 = '' OR event_type = $3)
+			AND ($4::TIMESTAMPTZ IS NULL OR creation_date >= $4)
+			AND ($5::TIMESTAMPTZ IS NULL OR creation_date < $5)
+			AND ($6 = 0 OR id < $6)
+		ORDER BY id DESC
+		LIMIT $7
+	`
+
+	var since, until *time.Time
+	if !filter.Since.IsZero() {
+		since = &filter.Since
+	}
+	if !filter.Until.IsZero() {
+		until = &filter.Until
+	}
+
+	var eventsDb []*database.SecurityEvent
+
+	start := time.Now()
+
+	err := r.DB.Select(&eventsDb, query, int64(filter.ProfileID), filter.Login, filter.Type, since, until,
+		int64(filter.BeforeID), domain.SecurityEventsLimit(filter.Limit))
+
+	args := []interface{}{filter.ProfileID, filter.Login, filter.Type, since, until, filter.BeforeID, filter.Limit}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get security events: %v", err)
+	}
+
+	events := make([]*domain.SecurityEvent, 0, len(eventsDb))
+	for _, eventDb := range eventsDb {
+		events = append(events, converters.SecurityEventConvertDbInCore(eventDb))
+	}
+
+	return events, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+var securityEventColumns = []string{"id", "profile_id", "login", "event_type", "method", "success", "details", "ip_address", "user_agent", "creation_date"}
+
+func TestQuerySecurityEvents(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+	creationDate := time.Now()
+
+	t.Run("Profile", func(t *testing.T) {
+		rows := sqlmock.NewRows(securityEventColumns).
+			AddRow(5, 1, "user@mailhub.su", domain.SecurityEventLogin, domain.SecurityEventMethodPassword, true, "", "127.0.0.1", "Firefox", creationDate).
+			AddRow(4, 1, "", domain.SecurityEventPasswordChange, "", false, "", "127.0.0.1", "Firefox", creationDate)
+		mock.ExpectQuery(`SELECT (.+) FROM security_event`).
+			WithArgs(int64(1), "", "", nil, nil, int64(6), domain.SecurityEventsDefaultLimit).
+			WillReturnRows(rows)
+
+		events, err := repo.QuerySecurityEvents(&domain.SecurityEventFilter{ProfileID: 1, BeforeID: 6}, ctx)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, &domain.SecurityEvent{
+			ID:           5,
+			ProfileID:    1,
+			Login:        "user@mailhub.su",
+			Type:         domain.SecurityEventLogin,
+			Method:       domain.SecurityEventMethodPassword,
+			Success:      true,
+			IPAddress:    "127.0.0.1",
+			UserAgent:    "Firefox",
+			CreationDate: creationDate,
+		}, events[0])
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		since := creationDate.Add(-time.Hour)
+		mock.ExpectQuery(`SELECT (.+) FROM security_event`).
+			WithArgs(int64(0), "nobody@mailhub.su", domain.SecurityEventLogin, &since, nil, int64(0), 10).
+			WillReturnRows(sqlmock.NewRows(securityEventColumns).
+				AddRow(3, nil, "nobody@mailhub.su", domain.SecurityEventLogin, domain.SecurityEventMethodPassword, false, "", "10.0.0.1", "curl", creationDate))
+
+		events, err := repo.QuerySecurityEvents(&domain.SecurityEventFilter{
+			Login: "nobody@mailhub.su",
+			Type:  domain.SecurityEventLogin,
+			Since: since,
+			Limit: 10,
+		}, ctx)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, uint32(0), events[0].ProfileID)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM security_event`).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.QuerySecurityEvents(&domain.SecurityEventFilter{ProfileID: 1}, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"mail/internal/pkg/logger"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/repository_converters"
+	database "mail/internal/microservice/models/repository_models"
+)
+
+// GetTwoFactor returns the two-factor authentication settings of the user,
+// or nil if the user has never started to set it up.
+func (r *UserRepository) GetTwoFactor(profileID uint32, ctx context.Context) (*domain.TwoFactor, error) {
+	query := `
+		SELECT profile_id, secret, enabled, last_used_step, creation_date, confirmation_date
+		FROM profile_two_factor
+		WHERE profile_id = $1
+	`
+
+	var twoFactorModelDb database.TwoFactor
+
+	start := time.Now()
+
+	err := r.DB.Get(&twoFactorModelDb, query, profileID)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get two-factor settings: %v", err)
+	}
+
+	return converters.TwoFactorConvertDbInCore(&twoFactorModelDb), nil
+}
+
+// SaveTwoFactorSecret stores a new not yet confirmed secret of the user.
+// The secret of a user with enabled two-factor authentication is never replaced.
+func (r *UserRepository) SaveTwoFactorSecret(profileID uint32, secret string, ctx context.Context) error {
+	query := `
+		INSERT INTO profile_two_factor (profile_id, secret, enabled, last_used_step, creation_date)
+		VALUES ($1, $2, FALSE, 0, $3)
+		ON CONFLICT (profile_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, creation_date = EXCLUDED.creation_date, confirmation_date = NULL
+		WHERE profile_two_factor.enabled = FALSE
+	`
+
+	start := time.Now()
+
+	result, err := r.DB.Exec(query, profileID, secret, time.Now())
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to save two-factor secret: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		err = fmt.Errorf("two-factor authentication is already enabled for user %d", profileID)
+		return err
+	}
+
+	return nil
+}
+
+// EnableTwoFactor confirms the secret of the user and replaces the recovery codes in one transaction.
+func (r *UserRepository) EnableTwoFactor(profileID uint32, step int64, recoveryCodeHashes []string, ctx context.Context) error {
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := r.twoFactorExec(tx, `
+		UPDATE profile_two_factor
+		SET enabled = TRUE, last_used_step = $2, confirmation_date = $3
+		WHERE profile_id = $1 AND enabled = FALSE
+	`, []interface{}{profileID, step, time.Now()}, ctx)
+	if err != nil {
+		return fmt.Errorf("failed to enable two-factor authentication: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("two-factor setup of user %d not found", profileID)
+	}
+
+	if err = r.replaceRecoveryCodes(tx, profileID, recoveryCodeHashes, ctx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// DisableTwoFactor removes the secret and the recovery codes of the user in one transaction.
+func (r *UserRepository) DisableTwoFactor(profileID uint32, ctx context.Context) error {
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := r.twoFactorExec(tx, `DELETE FROM profile_two_factor WHERE profile_id = $1`, []interface{}{profileID}, ctx)
+	if err != nil {
+		return fmt.Errorf("failed to disable two-factor authentication: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("two-factor authentication of user %d not found", profileID)
+	}
+
+	if err = r.replaceRecoveryCodes(tx, profileID, nil, ctx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return nil
+}
+
+// replaceRecoveryCodes deletes the recovery codes of the user and stores the new ones inside the transaction.
+func (r *UserRepository) replaceRecoveryCodes(tx *sqlx.Tx, profileID uint32, recoveryCodeHashes []string, ctx context.Context) error {
+	if _, err := r.twoFactorExec(tx, `DELETE FROM profile_recovery_code WHERE profile_id = $1`, []interface{}{profileID}, ctx); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %v", err)
+	}
+
+	for _, codeHash := range recoveryCodeHashes {
+		_, err := r.twoFactorExec(tx, `INSERT INTO profile_recovery_code (profile_id, code_hash) VALUES ($1, $2)`, []interface{}{profileID, codeHash}, ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add recovery code: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// twoFactorExec runs one statement inside the transaction.
+func (r *UserRepository) twoFactorExec(tx *sqlx.Tx, query string, args []interface{}, ctx context.Context) (result sql.Result, err error) {
+	start := time.Now()
+	result, err = tx.Exec(query, args...)
+
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	return result, err
+}
+
+// UseTwoFactorStep marks the TOTP time step as used and returns false if a code of this
+// or a later step has already been accepted, so that a code can not be replayed.
+func (r *UserRepository) UseTwoFactorStep(profileID uint32, step int64, ctx context.Context) (bool, error) {
+	query := `
+		UPDATE profile_two_factor
+		SET last_used_step = $2
+		WHERE profile_id = $1 AND last_used_step < $2
+	`
+
+	start := time.Now()
+
+	result, err := r.DB.Exec(query, profileID, step)
+
+	args := []interface{}{profileID, step}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to use two-factor code: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// UseRecoveryCode marks the unused recovery code with the hash as used and returns false if there is no such code.
+func (r *UserRepository) UseRecoveryCode(profileID uint32, codeHash string, ctx context.Context) (bool, error) {
+	query := `
+		UPDATE profile_recovery_code
+		SET used_date = $3
+		WHERE profile_id = $1 AND code_hash = $2 AND used_date IS NULL
+	`
+
+	start := time.Now()
+
+	result, err := r.DB.Exec(query, profileID, codeHash, time.Now())
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve rows affected: %v", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// CountRecoveryCodes returns the number of unused recovery codes of the user.
+func (r *UserRepository) CountRecoveryCodes(profileID uint32, ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM profile_recovery_code WHERE profile_id = $1 AND used_date IS NULL`
+
+	var count int
+
+	start := time.Now()
+
+	err := r.DB.Get(&count, query, profileID)
+
+	args := []interface{}{profileID}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count recovery codes: %v", err)
+	}
+
+	return count, nil
+}
+
+// AddTwoFactorChallenge stores a login waiting for the second factor.
+// Expired challenges of all users are removed at the same time.
+func (r *UserRepository) AddTwoFactorChallenge(challenge *domain.TwoFactorChallenge, ctx context.Context) error {
+	query := `
+		WITH expired AS (
+			DELETE FROM two_factor_challenge WHERE expiration_date < $4
+		)
+		INSERT INTO two_factor_challenge (id, profile_id, attempts, expiration_date)
+		VALUES ($1, $2, 0, $3)
+	`
+
+	start := time.Now()
+
+	_, err := r.DB.Exec(query, challenge.ID, challenge.ProfileID, challenge.ExpirationDate, time.Now())
+
+	args := []interface{}{challenge.ProfileID, challenge.ExpirationDate}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to add two-factor challenge: %v", err)
+	}
+
+	return nil
+}
+
+// SpendTwoFactorChallengeAttempt counts an entered code for the login waiting for the second factor and returns it.
+// The attempt is counted by a single statement before the code is checked, so parallel guesses can't exceed maxAttempts.
+// A nil challenge is returned when it is not found, has expired or has no attempts left.
+func (r *UserRepository) SpendTwoFactorChallengeAttempt(id string, maxAttempts int, ctx context.Context) (*domain.TwoFactorChallenge, error) {
+	query := `
+		UPDATE two_factor_challenge
+		SET attempts = attempts + 1
+		WHERE id = $1 AND attempts < $2 AND expiration_date > now()
+		RETURNING id, profile_id, attempts, expiration_date
+	`
+
+	var challengeModelDb database.TwoFactorChallenge
+
+	start := time.Now()
+
+	err := r.DB.Get(&challengeModelDb, query, id, maxAttempts)
+
+	args := []interface{}{maxAttempts}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to update two-factor challenge: %v", err)
+	}
+
+	return converters.TwoFactorChallengeConvertDbInCore(&challengeModelDb), nil
+}
+
+// DeleteTwoFactorChallenge removes the login waiting for the second factor.
+func (r *UserRepository) DeleteTwoFactorChallenge(id string, ctx context.Context) error {
+	query := `DELETE FROM two_factor_challenge WHERE id = $1`
+
+	start := time.Now()
+
+	_, err := r.DB.Exec(query, id)
+
+	args := []interface{}{}
+	defer ctx.Value("logger").(*logger.LogrusLogger).DbLog(query, ctx.Value(requestIDContextKey).([]string)[0], start, &err, args)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete two-factor challenge: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+func TestGetTwoFactor(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Found", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"profile_id", "secret", "enabled", "last_used_step"}).
+			AddRow(1, "SECRET", true, 42)
+		mock.ExpectQuery(`SELECT (.+) FROM profile_two_factor WHERE profile_id = \$1`).WithArgs(1).WillReturnRows(rows)
+
+		twoFactor, err := repo.GetTwoFactor(1, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.TwoFactor{ProfileID: 1, Secret: "SECRET", Enabled: true, LastUsedStep: 42}, twoFactor)
+	})
+
+	t.Run("NotSetUp", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM profile_two_factor WHERE profile_id = \$1`).WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"profile_id"}))
+
+		twoFactor, err := repo.GetTwoFactor(2, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, twoFactor)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM profile_two_factor`).WithArgs(3).WillReturnError(fmt.Errorf("db error"))
+
+		_, err := repo.GetTwoFactor(3, ctx)
+		assert.Error(t, err)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTwoFactorSecret(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO profile_two_factor (.+) ON CONFLICT \(profile_id\) DO UPDATE (.+) WHERE profile_two_factor.enabled = FALSE`).
+			WithArgs(1, "SECRET", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.SaveTwoFactorSecret(1, "SECRET", ctx))
+	})
+
+	t.Run("AlreadyEnabled", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO profile_two_factor`).
+			WithArgs(1, "SECRET", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.Error(t, repo.SaveTwoFactorSecret(1, "SECRET", ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnableTwoFactor(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE profile_two_factor SET enabled = TRUE`).
+			WithArgs(1, int64(42), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM profile_recovery_code WHERE profile_id = \$1`).
+			WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`INSERT INTO profile_recovery_code`).WithArgs(1, "hash1").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`INSERT INTO profile_recovery_code`).WithArgs(1, "hash2").WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.EnableTwoFactor(1, 42, []string{"hash1", "hash2"}, ctx))
+	})
+
+	t.Run("NotStarted", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE profile_two_factor SET enabled = TRUE`).
+			WithArgs(1, int64(42), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		assert.Error(t, repo.EnableTwoFactor(1, 42, []string{"hash1"}, ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM profile_two_factor WHERE profile_id = \$1`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM profile_recovery_code WHERE profile_id = \$1`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.DisableTwoFactor(1, ctx))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseTwoFactorStep(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	mock.ExpectExec(`UPDATE profile_two_factor SET last_used_step = \$2 WHERE profile_id = \$1 AND last_used_step < \$2`).
+		WithArgs(1, int64(42)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE profile_two_factor SET last_used_step`).
+		WithArgs(1, int64(42)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	used, err := repo.UseTwoFactorStep(1, 42, ctx)
+	assert.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = repo.UseTwoFactorStep(1, 42, ctx)
+	assert.NoError(t, err)
+	assert.False(t, used)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	mock.ExpectExec(`UPDATE profile_recovery_code SET used_date = \$3 WHERE profile_id = \$1 AND code_hash = \$2 AND used_date IS NULL`).
+		WithArgs(1, "hash", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	used, err := repo.UseRecoveryCode(1, "hash", ctx)
+	assert.NoError(t, err)
+	assert.True(t, used)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountRecoveryCodes(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM profile_recovery_code WHERE profile_id = \$1 AND used_date IS NULL`).
+		WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	count, err := repo.CountRecoveryCodes(1, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, count)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTwoFactorChallenge(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer mockDB.Close()
+
+	repo := UserRepository{DB: sqlx.NewDb(mockDB, "sqlmock")}
+	ctx := GetCTX()
+
+	expirationDate := time.Now().Add(domain.TwoFactorChallengeLifeTime)
+
+	t.Run("Add", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM two_factor_challenge WHERE expiration_date < \$4 (.+) INSERT INTO two_factor_challenge`).
+			WithArgs("challenge", 1, expirationDate, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.AddTwoFactorChallenge(&domain.TwoFactorChallenge{ID: "challenge", ProfileID: 1, ExpirationDate: expirationDate}, ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("SpendAttempt", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "profile_id", "attempts", "expiration_date"}).
+			AddRow("challenge", 1, 2, expirationDate)
+		mock.ExpectQuery(`UPDATE two_factor_challenge SET attempts = attempts \+ 1 WHERE id = \$1 AND attempts < \$2 AND expiration_date > now\(\) RETURNING id, profile_id, attempts, expiration_date`).
+			WithArgs("challenge", domain.TwoFactorChallengeMaxAttempts).WillReturnRows(rows)
+
+		challenge, err := repo.SpendTwoFactorChallengeAttempt("challenge", domain.TwoFactorChallengeMaxAttempts, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.TwoFactorChallenge{ID: "challenge", ProfileID: 1, Attempts: 2, ExpirationDate: expirationDate}, challenge)
+	})
+
+	t.Run("SpendAttemptNoneLeft", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE two_factor_challenge`).WithArgs("challenge", domain.TwoFactorChallengeMaxAttempts).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		challenge, err := repo.SpendTwoFactorChallengeAttempt("challenge", domain.TwoFactorChallengeMaxAttempts, ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, challenge)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM two_factor_challenge WHERE id = \$1`).
+			WithArgs("challenge").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.DeleteTwoFactorChallenge("challenge", ctx))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/microservice/user/proto"
+
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// CreateAPIToken creates a personal access token of the user.
+// The token itself is returned only here, it is not stored.
+func (us *UserServer) CreateAPIToken(ctx context.Context, input *proto.CreateAPITokenRequest) (*proto.CreateAPITokenReply, error) {
+	if input.Id <= 0 || validUtil.IsEmpty(input.Name) || len(input.Scopes) == 0 {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	apiToken, token, err := us.UserUseCase.CreateAPIToken(input.Id, input.Name, input.Scopes, int(input.LifeTimeDays), ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api token: %v", err)
+	}
+
+	return &proto.CreateAPITokenReply{Token: token, ApiToken: proto_converters.APITokenConvertCoreInProto(apiToken)}, nil
+}
+
+// GetAPITokens returns the personal access tokens of the user.
+func (us *UserServer) GetAPITokens(ctx context.Context, input *proto.GetAPITokensRequest) (*proto.GetAPITokensReply, error) {
+	if input.Id <= 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	apiTokens, err := us.UserUseCase.GetAPITokens(input.Id, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api tokens")
+	}
+
+	apiTokensProto := make([]*proto.APIToken, 0, len(apiTokens))
+	for _, apiToken := range apiTokens {
+		apiTokensProto = append(apiTokensProto, proto_converters.APITokenConvertCoreInProto(apiToken))
+	}
+
+	return &proto.GetAPITokensReply{ApiTokens: apiTokensProto}, nil
+}
+
+// DeleteAPIToken revokes a personal access token of the user.
+func (us *UserServer) DeleteAPIToken(ctx context.Context, input *proto.DeleteAPITokenRequest) (*proto.DeleteAPITokenReply, error) {
+	if input.Id <= 0 || input.TokenId <= 0 {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if err := us.UserUseCase.DeleteAPIToken(input.Id, input.TokenId, ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete api token")
+	}
+
+	return &proto.DeleteAPITokenReply{Status: true}, nil
+}
+
+// AuthenticateAPIToken checks a personal access token and returns its owner and scopes.
+func (us *UserServer) AuthenticateAPIToken(ctx context.Context, input *proto.AuthenticateAPITokenRequest) (*proto.AuthenticateAPITokenReply, error) {
+	if validUtil.IsEmpty(input.Token) {
+		return nil, fmt.Errorf("invalid api token")
+	}
+
+	apiToken, user, err := us.UserUseCase.AuthenticateAPIToken(input.Token, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid api token")
+	}
+
+	return &proto.AuthenticateAPITokenReply{Id: user.ID, Login: user.Login, Scopes: apiToken.Scopes}, nil
+}
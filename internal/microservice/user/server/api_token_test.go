@@ -0,0 +1,130 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	"mail/internal/microservice/user/mock"
+	"mail/internal/microservice/user/proto"
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+	scopes := []string{domain.APITokenScopeMailRead}
+
+	t.Run("Success", func(t *testing.T) {
+		apiToken := &domain.APIToken{ID: 3, Name: "backup", Scopes: scopes, CreationDate: time.Now(), ExpirationDate: time.Now()}
+		mockUserUseCase.EXPECT().CreateAPIToken(uint32(1), "backup", scopes, 30, ctx).Return(apiToken, "mhp_token", nil)
+
+		reply, err := server.CreateAPIToken(ctx, &proto.CreateAPITokenRequest{Id: 1, Name: "backup", Scopes: scopes, LifeTimeDays: 30})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "mhp_token", reply.Token)
+		assert.Equal(t, uint32(3), reply.ApiToken.Id)
+	})
+
+	t.Run("EmptyScopes", func(t *testing.T) {
+		_, err := server.CreateAPIToken(ctx, &proto.CreateAPITokenRequest{Id: 1, Name: "backup", LifeTimeDays: 30})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockUserUseCase.EXPECT().CreateAPIToken(uint32(1), "backup", scopes, 0, ctx).Return(nil, "", fmt.Errorf("invalid life time"))
+
+		_, err := server.CreateAPIToken(ctx, &proto.CreateAPITokenRequest{Id: 1, Name: "backup", Scopes: scopes})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetAPITokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().GetAPITokens(uint32(1), ctx).Return([]*domain.APIToken{{ID: 1}, {ID: 2}}, nil)
+
+		reply, err := server.GetAPITokens(ctx, &proto.GetAPITokensRequest{Id: 1})
+
+		assert.NoError(t, err)
+		assert.Len(t, reply.ApiTokens, 2)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockUserUseCase.EXPECT().GetAPITokens(uint32(1), ctx).Return(nil, fmt.Errorf("db error"))
+
+		_, err := server.GetAPITokens(ctx, &proto.GetAPITokensRequest{Id: 1})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().DeleteAPIToken(uint32(1), uint32(3), ctx).Return(nil)
+
+		reply, err := server.DeleteAPIToken(ctx, &proto.DeleteAPITokenRequest{Id: 1, TokenId: 3})
+
+		assert.NoError(t, err)
+		assert.True(t, reply.Status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUserUseCase.EXPECT().DeleteAPIToken(uint32(1), uint32(4), ctx).Return(fmt.Errorf("api token not found"))
+
+		_, err := server.DeleteAPIToken(ctx, &proto.DeleteAPITokenRequest{Id: 1, TokenId: 4})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		apiToken := &domain.APIToken{ID: 3, ProfileID: 1, Scopes: []string{domain.APITokenScopeMailSend}}
+		mockUserUseCase.EXPECT().AuthenticateAPIToken("mhp_token", ctx).Return(apiToken, &domain.User{ID: 1, Login: "user@mailhub.su"}, nil)
+
+		reply, err := server.AuthenticateAPIToken(ctx, &proto.AuthenticateAPITokenRequest{Token: "mhp_token"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), reply.Id)
+		assert.Equal(t, "user@mailhub.su", reply.Login)
+		assert.Equal(t, []string{domain.APITokenScopeMailSend}, reply.Scopes)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		mockUserUseCase.EXPECT().AuthenticateAPIToken("mhp_expired", ctx).Return(nil, nil, fmt.Errorf("api token expired"))
+
+		_, err := server.AuthenticateAPIToken(ctx, &proto.AuthenticateAPITokenRequest{Token: "mhp_expired"})
+
+		assert.Error(t, err)
+	})
+}
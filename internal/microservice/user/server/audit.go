@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+
+	"mail/internal/microservice/interceptors"
+	"mail/internal/microservice/user/proto"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// accountEvent returns the rule recording the calls changing the account with the unique identifier in the request.
+func accountEvent(eventType string, details func(req interface{}) string) interceptors.AuditRule {
+	return interceptors.AuditRule{
+		Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+			event := &domain.SecurityEvent{
+				ProfileID: req.(interface{ GetId() uint32 }).GetId(),
+				Type:      eventType,
+				Success:   err == nil,
+			}
+			if details != nil {
+				event.Details = details(req)
+			}
+			return event
+		},
+	}
+}
+
+// AuditRules returns the rules recording the changes of the account security in the audit log.
+func AuditRules() map[string]interceptors.AuditRule {
+	return map[string]interceptors.AuditRule{
+		"/proto.UserService/ChangePassword":        accountEvent(domain.SecurityEventPasswordChange, nil),
+		"/proto.UserService/SetRecoveryEmail":      accountEvent(domain.SecurityEventRecoveryEmailChange, nil),
+		"/proto.UserService/ConfirmTwoFactorSetup": accountEvent(domain.SecurityEventTwoFactorEnable, nil),
+		"/proto.UserService/DisableTwoFactor":      accountEvent(domain.SecurityEventTwoFactorDisable, nil),
+		"/proto.UserService/UploadUserAvatar":      accountEvent(domain.SecurityEventAvatarChange, nil),
+		"/proto.UserService/DeleteUserAvatar":      accountEvent(domain.SecurityEventAvatarDelete, nil),
+		"/proto.UserService/DeleteUserById":        accountEvent(domain.SecurityEventAccountDelete, nil),
+		"/proto.UserService/CreateAPIToken": accountEvent(domain.SecurityEventAPITokenCreate, func(req interface{}) string {
+			return fmt.Sprintf("token: %s", req.(*proto.CreateAPITokenRequest).Name)
+		}),
+		"/proto.UserService/DeleteAPIToken": accountEvent(domain.SecurityEventAPITokenDelete, func(req interface{}) string {
+			return fmt.Sprintf("token: %d", req.(*proto.DeleteAPITokenRequest).TokenId)
+		}),
+		"/proto.UserService/ConfirmRecoveryEmail": accountEvent(domain.SecurityEventRecoveryEmailVerify, func(req interface{}) string {
+			return fmt.Sprintf("address: %s", req.(*proto.ConfirmRecoveryEmailRequest).RecoveryEmail)
+		}),
+		// The account of a password reset is known only from the reset token, failed resets are not recorded.
+		"/proto.UserService/ResetPassword": {
+			Event: func(req, reply interface{}, err error, subject uint32) *domain.SecurityEvent {
+				resetReply, _ := reply.(*proto.ResetPasswordReply)
+				if err != nil || resetReply.GetId() == 0 {
+					return nil
+				}
+				return &domain.SecurityEvent{ProfileID: resetReply.GetId(), Type: domain.SecurityEventPasswordReset, Success: true}
+			},
+		},
+	}
+}
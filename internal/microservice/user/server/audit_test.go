@@ -0,0 +1,55 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	"mail/internal/microservice/user/proto"
+)
+
+func TestAuditRules(t *testing.T) {
+	rules := AuditRules()
+
+	t.Run("ChangePassword", func(t *testing.T) {
+		event := rules["/proto.UserService/ChangePassword"].Event(&proto.ChangePasswordRequest{Id: 1, OldPassword: "old", NewPassword: "new"},
+			(*proto.ChangePasswordReply)(nil), fmt.Errorf("wrong password"), 0)
+		assert.Equal(t, &domain.SecurityEvent{ProfileID: 1, Type: domain.SecurityEventPasswordChange}, event)
+	})
+
+	t.Run("DisableTwoFactor", func(t *testing.T) {
+		event := rules["/proto.UserService/DisableTwoFactor"].Event(&proto.DisableTwoFactorRequest{Id: 1},
+			&proto.DisableTwoFactorReply{Status: true}, nil, 0)
+		assert.Equal(t, &domain.SecurityEvent{ProfileID: 1, Type: domain.SecurityEventTwoFactorDisable, Success: true}, event)
+	})
+
+	t.Run("CreateAPIToken", func(t *testing.T) {
+		event := rules["/proto.UserService/CreateAPIToken"].Event(&proto.CreateAPITokenRequest{Id: 1, Name: "backup"},
+			&proto.CreateAPITokenReply{Token: "mhp_token"}, nil, 0)
+		assert.Equal(t, "token: backup", event.Details)
+	})
+
+	t.Run("DeleteAPIToken", func(t *testing.T) {
+		event := rules["/proto.UserService/DeleteAPIToken"].Event(&proto.DeleteAPITokenRequest{Id: 1, TokenId: 3},
+			&proto.DeleteAPITokenReply{Status: true}, nil, 0)
+		assert.Equal(t, "token: 3", event.Details)
+	})
+
+	t.Run("ConfirmRecoveryEmail", func(t *testing.T) {
+		event := rules["/proto.UserService/ConfirmRecoveryEmail"].Event(&proto.ConfirmRecoveryEmailRequest{Id: 1, RecoveryEmail: "user@example.com"},
+			&proto.ConfirmRecoveryEmailReply{Status: true}, nil, 0)
+		assert.Equal(t, &domain.SecurityEvent{ProfileID: 1, Type: domain.SecurityEventRecoveryEmailVerify, Success: true, Details: "address: user@example.com"}, event)
+	})
+
+	t.Run("ResetPassword", func(t *testing.T) {
+		rule := rules["/proto.UserService/ResetPassword"]
+		req := &proto.ResetPasswordRequest{Token: "token", NewPassword: "new"}
+
+		event := rule.Event(req, &proto.ResetPasswordReply{Id: 2}, nil, 0)
+		assert.Equal(t, &domain.SecurityEvent{ProfileID: 2, Type: domain.SecurityEventPasswordReset, Success: true}, event)
+
+		assert.Nil(t, rule.Event(req, (*proto.ResetPasswordReply)(nil), fmt.Errorf("invalid token"), 0))
+	})
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/user/proto"
+
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// ChangePassword sets a new password of the user after checking the current one.
+func (us *UserServer) ChangePassword(ctx context.Context, input *proto.ChangePasswordRequest) (*proto.ChangePasswordReply, error) {
+	if input.Id <= 0 || validUtil.IsEmpty(input.OldPassword) || validUtil.IsEmpty(input.NewPassword) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if err := us.UserUseCase.ChangePassword(input.Id, input.OldPassword, input.NewPassword, ctx); err != nil {
+		return nil, fmt.Errorf("failed to change password: %v", err)
+	}
+
+	return &proto.ChangePasswordReply{Status: true}, nil
+}
+
+// SetRecoveryEmail sets the external address password reset links are sent to.
+func (us *UserServer) SetRecoveryEmail(ctx context.Context, input *proto.SetRecoveryEmailRequest) (*proto.SetRecoveryEmailReply, error) {
+	if input.Id <= 0 || validUtil.IsEmpty(input.Password) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if err := us.UserUseCase.SetRecoveryEmail(input.Id, input.Password, input.RecoveryEmail, ctx); err != nil {
+		return nil, fmt.Errorf("failed to set recovery email: %v", err)
+	}
+
+	return &proto.SetRecoveryEmailReply{Status: true}, nil
+}
+
+// GetRecoveryEmail returns the recovery email of the user and whether it is verified.
+func (us *UserServer) GetRecoveryEmail(ctx context.Context, input *proto.TwoFactorUserRequest) (*proto.GetRecoveryEmailReply, error) {
+	if input.Id <= 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	recoveryEmail, verified, err := us.UserUseCase.GetRecoveryEmail(input.Id, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recovery email")
+	}
+
+	return &proto.GetRecoveryEmailReply{RecoveryEmail: recoveryEmail, Verified: verified}, nil
+}
+
+// ConfirmRecoveryEmail marks the recovery email of the user as verified.
+// It is called once the signed link sent to the address has been checked.
+func (us *UserServer) ConfirmRecoveryEmail(ctx context.Context, input *proto.ConfirmRecoveryEmailRequest) (*proto.ConfirmRecoveryEmailReply, error) {
+	if input.Id <= 0 || validUtil.IsEmpty(input.RecoveryEmail) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if err := us.UserUseCase.ConfirmRecoveryEmail(input.Id, input.RecoveryEmail, ctx); err != nil {
+		return nil, fmt.Errorf("failed to confirm recovery email: %v", err)
+	}
+
+	return &proto.ConfirmRecoveryEmailReply{Status: true}, nil
+}
+
+// CreatePasswordResetToken creates a password reset token of the user.
+// The token is empty if the user has no address to send it to.
+func (us *UserServer) CreatePasswordResetToken(ctx context.Context, input *proto.CreatePasswordResetTokenRequest) (*proto.CreatePasswordResetTokenReply, error) {
+	if validUtil.IsEmpty(input.Login) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	token, recoveryEmail, err := us.UserUseCase.CreatePasswordResetToken(input.Login, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create password reset token")
+	}
+
+	return &proto.CreatePasswordResetTokenReply{Token: token, RecoveryEmail: recoveryEmail}, nil
+}
+
+// ResetPassword sets a new password with a password reset token.
+func (us *UserServer) ResetPassword(ctx context.Context, input *proto.ResetPasswordRequest) (*proto.ResetPasswordReply, error) {
+	if validUtil.IsEmpty(input.Token) || validUtil.IsEmpty(input.NewPassword) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	userID, err := us.UserUseCase.ResetPassword(input.Token, input.NewPassword, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset password")
+	}
+
+	return &proto.ResetPasswordReply{Id: userID}, nil
+}
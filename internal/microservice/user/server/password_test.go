@@ -0,0 +1,146 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/user/mock"
+	"mail/internal/microservice/user/proto"
+)
+
+func TestChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ChangePassword(uint32(1), "old", "new", ctx).Return(nil)
+
+		reply, err := server.ChangePassword(ctx, &proto.ChangePasswordRequest{Id: 1, OldPassword: "old", NewPassword: "new"})
+
+		assert.NoError(t, err)
+		assert.True(t, reply.Status)
+	})
+
+	t.Run("EmptyPassword", func(t *testing.T) {
+		_, err := server.ChangePassword(ctx, &proto.ChangePasswordRequest{Id: 1, OldPassword: "old"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ChangePassword(uint32(1), "wrong", "new", ctx).Return(fmt.Errorf("invalid password"))
+
+		_, err := server.ChangePassword(ctx, &proto.ChangePasswordRequest{Id: 1, OldPassword: "wrong", NewPassword: "new"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSetRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	mockUserUseCase.EXPECT().SetRecoveryEmail(uint32(1), "pass", "user@example.com", ctx).Return(nil)
+
+	reply, err := server.SetRecoveryEmail(ctx, &proto.SetRecoveryEmailRequest{Id: 1, Password: "pass", RecoveryEmail: "user@example.com"})
+
+	assert.NoError(t, err)
+	assert.True(t, reply.Status)
+}
+
+func TestGetRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	mockUserUseCase.EXPECT().GetRecoveryEmail(uint32(1), ctx).Return("user@example.com", true, nil)
+
+	reply, err := server.GetRecoveryEmail(ctx, &proto.TwoFactorUserRequest{Id: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", reply.RecoveryEmail)
+	assert.True(t, reply.Verified)
+}
+
+func TestConfirmRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ConfirmRecoveryEmail(uint32(1), "user@example.com", ctx).Return(nil)
+
+		reply, err := server.ConfirmRecoveryEmail(ctx, &proto.ConfirmRecoveryEmailRequest{Id: 1, RecoveryEmail: "user@example.com"})
+
+		assert.NoError(t, err)
+		assert.True(t, reply.Status)
+	})
+
+	t.Run("AddressChanged", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ConfirmRecoveryEmail(uint32(1), "old@example.com", ctx).Return(fmt.Errorf("recovery email has changed"))
+
+		_, err := server.ConfirmRecoveryEmail(ctx, &proto.ConfirmRecoveryEmailRequest{Id: 1, RecoveryEmail: "old@example.com"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCreatePasswordResetToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	mockUserUseCase.EXPECT().CreatePasswordResetToken("user@mailhub.su", ctx).Return("token", "user@example.com", nil)
+
+	reply, err := server.CreatePasswordResetToken(ctx, &proto.CreatePasswordResetTokenRequest{Login: "user@mailhub.su"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "token", reply.Token)
+	assert.Equal(t, "user@example.com", reply.RecoveryEmail)
+}
+
+func TestResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ResetPassword("token", "new", ctx).Return(uint32(1), nil)
+
+		reply, err := server.ResetPassword(ctx, &proto.ResetPasswordRequest{Token: "token", NewPassword: "new"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), reply.Id)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ResetPassword("used", "new", ctx).Return(uint32(0), fmt.Errorf("password reset token is invalid or expired"))
+
+		_, err := server.ResetPassword(ctx, &proto.ResetPasswordRequest{Token: "used", NewPassword: "new"})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/microservice/user/proto"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// GetSecurityEvents returns the security audit log of the user, the latest events first.
+func (us *UserServer) GetSecurityEvents(ctx context.Context, input *proto.GetSecurityEventsRequest) (*proto.SecurityEventsReply, error) {
+	if input.Id <= 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	events, err := us.UserUseCase.GetSecurityEvents(input.Id, input.BeforeId, int(input.Limit), ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get security events")
+	}
+
+	return securityEventsConvertCoreInProto(events), nil
+}
+
+// QuerySecurityEvents returns the security events of any account selected by the request, the latest events first.
+// It is meant for the administrators and is not exposed through the HTTP API.
+func (us *UserServer) QuerySecurityEvents(ctx context.Context, input *proto.QuerySecurityEventsRequest) (*proto.SecurityEventsReply, error) {
+	events, err := us.UserUseCase.QuerySecurityEvents(proto_converters.SecurityEventFilterConvertProtoInCore(input), ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query security events: %v", err)
+	}
+
+	return securityEventsConvertCoreInProto(events), nil
+}
+
+// securityEventsConvertCoreInProto converts the security events to the reply.
+func securityEventsConvertCoreInProto(events []*domain.SecurityEvent) *proto.SecurityEventsReply {
+	eventsProto := make([]*proto.SecurityEvent, 0, len(events))
+	for _, event := range events {
+		eventsProto = append(eventsProto, proto_converters.SecurityEventConvertCoreInProto(event))
+	}
+
+	return &proto.SecurityEventsReply{Events: eventsProto}
+}
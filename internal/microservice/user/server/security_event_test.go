@@ -0,0 +1,84 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	domain "mail/internal/microservice/models/domain_models"
+	"mail/internal/microservice/user/mock"
+	"mail/internal/microservice/user/proto"
+)
+
+func TestGetSecurityEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		events := []*domain.SecurityEvent{{ID: 5, ProfileID: 1, Type: domain.SecurityEventLogin, Method: domain.SecurityEventMethodVK, Success: true, CreationDate: time.Now()}}
+		mockUserUseCase.EXPECT().GetSecurityEvents(uint32(1), uint64(6), 20, ctx).Return(events, nil)
+
+		reply, err := server.GetSecurityEvents(ctx, &proto.GetSecurityEventsRequest{Id: 1, BeforeId: 6, Limit: 20})
+
+		assert.NoError(t, err)
+		assert.Len(t, reply.Events, 1)
+		assert.Equal(t, uint64(5), reply.Events[0].Id)
+		assert.Equal(t, domain.SecurityEventMethodVK, reply.Events[0].Method)
+	})
+
+	t.Run("InvalidUser", func(t *testing.T) {
+		_, err := server.GetSecurityEvents(ctx, &proto.GetSecurityEventsRequest{})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockUserUseCase.EXPECT().GetSecurityEvents(uint32(1), uint64(0), 0, ctx).Return(nil, fmt.Errorf("db error"))
+
+		_, err := server.GetSecurityEvents(ctx, &proto.GetSecurityEventsRequest{Id: 1})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestQuerySecurityEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+	since := time.Now().UTC()
+
+	t.Run("Success", func(t *testing.T) {
+		filter := &domain.SecurityEventFilter{Login: "user@mailhub.su", Type: domain.SecurityEventLogin, Since: since}
+		events := []*domain.SecurityEvent{{ID: 3, Login: "user@mailhub.su", Type: domain.SecurityEventLogin, CreationDate: since}}
+		mockUserUseCase.EXPECT().QuerySecurityEvents(filter, ctx).Return(events, nil)
+
+		reply, err := server.QuerySecurityEvents(ctx, &proto.QuerySecurityEventsRequest{
+			Login: "user@mailhub.su",
+			Type:  domain.SecurityEventLogin,
+			Since: timestamppb.New(since),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, reply.Events, 1)
+		assert.False(t, reply.Events[0].Success)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockUserUseCase.EXPECT().QuerySecurityEvents(gomock.Any(), ctx).Return(nil, fmt.Errorf("since must be before until"))
+
+		_, err := server.QuerySecurityEvents(ctx, &proto.QuerySecurityEventsRequest{})
+
+		assert.Error(t, err)
+	})
+}
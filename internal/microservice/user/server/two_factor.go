@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/user/proto"
+
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// GetTwoFactorStatus returns whether the user has enabled two-factor authentication.
+func (us *UserServer) GetTwoFactorStatus(ctx context.Context, input *proto.TwoFactorUserRequest) (*proto.GetTwoFactorStatusReply, error) {
+	if input.Id <= 0 {
+		return nil, fmt.Errorf("invalid user id: %v", input.Id)
+	}
+
+	enabled, recoveryCodesLeft, err := us.UserUseCase.GetTwoFactorStatus(input.Id, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor status")
+	}
+
+	return &proto.GetTwoFactorStatusReply{Enabled: enabled, RecoveryCodesLeft: int32(recoveryCodesLeft)}, nil
+}
+
+// BeginTwoFactorSetup generates a new TOTP secret for the user.
+func (us *UserServer) BeginTwoFactorSetup(ctx context.Context, input *proto.TwoFactorUserRequest) (*proto.BeginTwoFactorSetupReply, error) {
+	if input.Id <= 0 {
+		return nil, fmt.Errorf("invalid user id: %v", input.Id)
+	}
+
+	setup, err := us.UserUseCase.BeginTwoFactorSetup(input.Id, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin two-factor setup: %v", err)
+	}
+
+	return &proto.BeginTwoFactorSetupReply{Secret: setup.Secret, Uri: setup.URI}, nil
+}
+
+// ConfirmTwoFactorSetup enables two-factor authentication and returns the recovery codes.
+func (us *UserServer) ConfirmTwoFactorSetup(ctx context.Context, input *proto.ConfirmTwoFactorSetupRequest) (*proto.ConfirmTwoFactorSetupReply, error) {
+	if input.Id <= 0 || validUtil.IsEmpty(input.Code) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	recoveryCodes, err := us.UserUseCase.ConfirmTwoFactorSetup(input.Id, input.Code, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm two-factor setup: %v", err)
+	}
+
+	return &proto.ConfirmTwoFactorSetupReply{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableTwoFactor disables two-factor authentication of the user.
+func (us *UserServer) DisableTwoFactor(ctx context.Context, input *proto.DisableTwoFactorRequest) (*proto.DisableTwoFactorReply, error) {
+	if input.Id <= 0 || validUtil.IsEmpty(input.Code) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	if err := us.UserUseCase.DisableTwoFactor(input.Id, input.Password, input.Code, ctx); err != nil {
+		return nil, fmt.Errorf("failed to disable two-factor authentication: %v", err)
+	}
+
+	return &proto.DisableTwoFactorReply{Status: true}, nil
+}
+
+// CreateTwoFactorChallenge starts a login waiting for the second factor.
+// The challenge id is empty if the user has not enabled two-factor authentication.
+func (us *UserServer) CreateTwoFactorChallenge(ctx context.Context, input *proto.TwoFactorUserRequest) (*proto.CreateTwoFactorChallengeReply, error) {
+	if input.Id <= 0 {
+		return nil, fmt.Errorf("invalid user id: %v", input.Id)
+	}
+
+	challengeID, err := us.UserUseCase.CreateTwoFactorChallenge(input.Id, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create two-factor challenge")
+	}
+
+	return &proto.CreateTwoFactorChallengeReply{ChallengeId: challengeID}, nil
+}
+
+// VerifyTwoFactorChallenge checks the code of the login waiting for the second factor.
+func (us *UserServer) VerifyTwoFactorChallenge(ctx context.Context, input *proto.VerifyTwoFactorChallengeRequest) (*proto.VerifyTwoFactorChallengeReply, error) {
+	if validUtil.IsEmpty(input.ChallengeId) || validUtil.IsEmpty(input.Code) {
+		return nil, fmt.Errorf("all fields must be filled in")
+	}
+
+	userID, err := us.UserUseCase.VerifyTwoFactorChallenge(input.ChallengeId, input.Code, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("two-factor verification failed")
+	}
+
+	return &proto.VerifyTwoFactorChallengeReply{Id: userID}, nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/microservice/models/domain_models"
+	"mail/internal/microservice/user/mock"
+	"mail/internal/microservice/user/proto"
+)
+
+func TestBeginTwoFactorSetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	mockUserUseCase.EXPECT().BeginTwoFactorSetup(uint32(1), ctx).
+		Return(&domain_models.TwoFactorSetup{Secret: "SECRET", URI: "otpauth://totp/MailHub:user@mailhub.su?secret=SECRET"}, nil)
+
+	reply, err := server.BeginTwoFactorSetup(ctx, &proto.TwoFactorUserRequest{Id: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", reply.Secret)
+	assert.Equal(t, "otpauth://totp/MailHub:user@mailhub.su?secret=SECRET", reply.Uri)
+}
+
+func TestConfirmTwoFactorSetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().ConfirmTwoFactorSetup(uint32(1), "123456", ctx).Return([]string{"abcde-fghij"}, nil)
+
+		reply, err := server.ConfirmTwoFactorSetup(ctx, &proto.ConfirmTwoFactorSetupRequest{Id: 1, Code: "123456"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"abcde-fghij"}, reply.RecoveryCodes)
+	})
+
+	t.Run("EmptyCode", func(t *testing.T) {
+		reply, err := server.ConfirmTwoFactorSetup(ctx, &proto.ConfirmTwoFactorSetupRequest{Id: 1})
+
+		assert.Nil(t, reply)
+		assert.EqualError(t, err, "all fields must be filled in")
+	})
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().DisableTwoFactor(uint32(1), "password", "123456", ctx).Return(nil)
+
+		reply, err := server.DisableTwoFactor(ctx, &proto.DisableTwoFactorRequest{Id: 1, Password: "password", Code: "123456"})
+
+		assert.NoError(t, err)
+		assert.True(t, reply.Status)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockUserUseCase.EXPECT().DisableTwoFactor(uint32(1), "wrong", "123456", ctx).Return(fmt.Errorf("invalid password"))
+
+		reply, err := server.DisableTwoFactor(ctx, &proto.DisableTwoFactorRequest{Id: 1, Password: "wrong", Code: "123456"})
+
+		assert.Error(t, err)
+		assert.Nil(t, reply)
+	})
+}
+
+func TestCreateTwoFactorChallenge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	mockUserUseCase.EXPECT().CreateTwoFactorChallenge(uint32(1), ctx).Return("challenge", nil)
+
+	reply, err := server.CreateTwoFactorChallenge(ctx, &proto.TwoFactorUserRequest{Id: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "challenge", reply.ChallengeId)
+}
+
+func TestVerifyTwoFactorChallenge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+	server := NewUserServer(mockUserUseCase)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserUseCase.EXPECT().VerifyTwoFactorChallenge("challenge", "123456", ctx).Return(uint32(1), nil)
+
+		reply, err := server.VerifyTwoFactorChallenge(ctx, &proto.VerifyTwoFactorChallengeRequest{ChallengeId: "challenge", Code: "123456"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), reply.Id)
+	})
+
+	t.Run("InvalidCode", func(t *testing.T) {
+		mockUserUseCase.EXPECT().VerifyTwoFactorChallenge("challenge", "000000", ctx).Return(uint32(0), fmt.Errorf("invalid two-factor code"))
+
+		reply, err := server.VerifyTwoFactorChallenge(ctx, &proto.VerifyTwoFactorChallengeRequest{ChallengeId: "challenge", Code: "000000"})
+
+		assert.Nil(t, reply)
+		assert.EqualError(t, err, "two-factor verification failed")
+	})
+}
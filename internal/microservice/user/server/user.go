@@ -5,6 +5,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"mail/internal/microservice/user/proto"
@@ -181,11 +182,18 @@ func (us *UserServer) CreateUser(ctx context.Context, input *proto.CreateUserReq
 		return nil, fmt.Errorf("domain in the login is not suitable")
 	}
 
+	input.RecoveryEmail = sanitize.SanitizeString(input.RecoveryEmail)
+	if input.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(input.RecoveryEmail) {
+		return nil, fmt.Errorf("invalid recovery email")
+	}
+
 	user, err := us.UserUseCase.CreateUser(userDomain, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("user with login %s create fail", userDomain.Login)
 	}
 
+	us.addRecoveryEmail(user.ID, input.RecoveryEmail, ctx)
+
 	return &proto.CreateUserReply{User: converters.UserConvertCoreInProto(user)}, nil
 }
 
@@ -234,10 +242,29 @@ func (us *UserServer) CreateUserOtherMail(ctx context.Context, input *proto.Crea
 		return nil, fmt.Errorf("all fields must be filled in")
 	}
 
+	input.RecoveryEmail = sanitize.SanitizeString(input.RecoveryEmail)
+	if input.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(input.RecoveryEmail) {
+		return nil, fmt.Errorf("invalid recovery email")
+	}
+
 	user, err := us.UserUseCase.CreateUser(userDomain, ctx)
 	if err != nil {
 		return nil, fmt.Errorf("user with login %s create fail", userDomain.Login)
 	}
 
+	us.addRecoveryEmail(user.ID, input.RecoveryEmail, ctx)
+
 	return &proto.CreateUserReply{User: converters.UserConvertCoreInProto(user)}, nil
 }
+
+// addRecoveryEmail stores the recovery email given at signup, it is not verified yet.
+// The account is already created, so a failure is only logged and the user can add the address later.
+func (us *UserServer) addRecoveryEmail(userID uint32, recoveryEmail string, ctx context.Context) {
+	if recoveryEmail == "" {
+		return
+	}
+
+	if err := us.UserUseCase.AddRecoveryEmail(userID, recoveryEmail, ctx); err != nil {
+		log.Printf("failed to add recovery email of user %d: %v", userID, err)
+	}
+}
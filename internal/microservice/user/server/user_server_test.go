@@ -416,6 +416,59 @@ func TestCreateUser_Success(t *testing.T) {
 	assert.NotNil(t, reply.User)
 }
 
+func TestCreateUser_WithRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+
+	server := NewUserServer(mockUserUseCase)
+
+	ctx := GetCTX()
+
+	mockUserUseCase.EXPECT().CreateUser(gomock.Any(), ctx).Return(&domain_models.User{ID: uint32(1), Login: "ivan@mailhub.su", FirstName: "John", Surname: "Doe", Gender: "male"}, nil)
+	mockUserUseCase.EXPECT().AddRecoveryEmail(uint32(1), "ivan@example.com", ctx).Return(nil)
+
+	reply, err := server.CreateUser(ctx, &proto.CreateUserRequest{
+		User: &proto.User{
+			Login:     "ivan@mailhub.su",
+			Password:  "password",
+			Firstname: "John",
+			Surname:   "Doe",
+			Gender:    "Male",
+		},
+		RecoveryEmail: "ivan@example.com",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), reply.User.Id)
+}
+
+func TestCreateUser_InvalidRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUseCase := mock.NewMockUserUseCase(ctrl)
+
+	server := NewUserServer(mockUserUseCase)
+
+	ctx := GetCTX()
+
+	reply, err := server.CreateUser(ctx, &proto.CreateUserRequest{
+		User: &proto.User{
+			Login:     "ivan@mailhub.su",
+			Password:  "password",
+			Firstname: "John",
+			Surname:   "Doe",
+			Gender:    "Male",
+		},
+		RecoveryEmail: "other@mailhub.su",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, reply)
+}
+
 func TestCreateUser_InvalidInput(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"mail/internal/microservice/models/domain_models"
+)
+
+// CreateAPIToken creates a personal access token of the user valid for the number of days and returns it
+// together with the token itself, which is not stored and can not be shown again.
+func (uc *UserUseCase) CreateAPIToken(userID uint32, name string, scopes []string, lifeTimeDays int, ctx context.Context) (*domain_models.APIToken, string, error) {
+	if len(name) == 0 || len(name) > 100 {
+		return nil, "", fmt.Errorf("invalid token name")
+	}
+
+	if lifeTimeDays <= 0 || lifeTimeDays > domain_models.APITokenMaxLifeTimeDays {
+		return nil, "", fmt.Errorf("token life time must be from 1 to %d days", domain_models.APITokenMaxLifeTimeDays)
+	}
+
+	if len(scopes) == 0 {
+		return nil, "", fmt.Errorf("token must have at least one scope")
+	}
+	uniqueScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !domain_models.IsValidAPITokenScope(scope) {
+			return nil, "", fmt.Errorf("unknown scope %s", scope)
+		}
+		if !domain_models.APITokenScopesAllow(uniqueScopes, scope) {
+			uniqueScopes = append(uniqueScopes, scope)
+		}
+	}
+
+	randBytes := make([]byte, 32)
+	if _, err := rand.Read(randBytes); err != nil {
+		return nil, "", fmt.Errorf("failed to generate api token: %v", err)
+	}
+	token := domain_models.APITokenPrefix + hex.EncodeToString(randBytes)
+
+	now := time.Now()
+	apiToken := &domain_models.APIToken{
+		TokenHash:      domain_models.HashAPIToken(token),
+		ProfileID:      userID,
+		Name:           name,
+		Scopes:         uniqueScopes,
+		CreationDate:   now,
+		ExpirationDate: now.AddDate(0, 0, lifeTimeDays),
+	}
+
+	id, err := uc.repo.AddAPIToken(apiToken, ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	apiToken.ID = id
+
+	return apiToken, token, nil
+}
+
+// GetAPITokens returns the personal access tokens of the user.
+func (uc *UserUseCase) GetAPITokens(userID uint32, ctx context.Context) ([]*domain_models.APIToken, error) {
+	return uc.repo.GetAPITokens(userID, ctx)
+}
+
+// DeleteAPIToken revokes a personal access token of the user.
+func (uc *UserUseCase) DeleteAPIToken(userID, tokenID uint32, ctx context.Context) error {
+	deleted, err := uc.repo.DeleteAPIToken(userID, tokenID, ctx)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("api token not found")
+	}
+
+	return nil
+}
+
+// AuthenticateAPIToken checks that the personal access token exists and has not expired,
+// records its use and returns it together with its owner.
+// The use is recorded at most once per APITokenLastUsedPrecision to keep frequent scripts from writing on every request.
+func (uc *UserUseCase) AuthenticateAPIToken(token string, ctx context.Context) (*domain_models.APIToken, *domain_models.User, error) {
+	if !domain_models.IsAPIToken(token) {
+		return nil, nil, fmt.Errorf("invalid api token")
+	}
+
+	apiToken, err := uc.repo.GetAPITokenByHash(domain_models.HashAPIToken(token), ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid api token")
+	}
+
+	now := time.Now()
+	if !apiToken.ExpirationDate.After(now) {
+		return nil, nil, fmt.Errorf("api token expired")
+	}
+
+	user, err := uc.repo.GetByID(apiToken.ProfileID, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if now.Sub(apiToken.LastUsedDate) >= domain_models.APITokenLastUsedPrecision {
+		if err = uc.repo.UpdateAPITokenLastUsed(apiToken.ID, now, ctx); err != nil {
+			return nil, nil, err
+		}
+		apiToken.LastUsedDate = now
+	}
+
+	return apiToken, user, nil
+}
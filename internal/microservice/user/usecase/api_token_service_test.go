@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	mock_repository "mail/internal/microservice/user/mock"
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		var stored *domain.APIToken
+		mockRepo.EXPECT().AddAPIToken(gomock.Any(), ctx).DoAndReturn(func(token *domain.APIToken, _ interface{}) (uint32, error) {
+			stored = token
+			return 7, nil
+		})
+
+		apiToken, token, err := useCase.CreateAPIToken(1, "backup", []string{domain.APITokenScopeMailRead, domain.APITokenScopeMailRead}, 30, ctx)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(token, domain.APITokenPrefix))
+		assert.Equal(t, uint32(7), apiToken.ID)
+		assert.Equal(t, []string{domain.APITokenScopeMailRead}, apiToken.Scopes)
+		assert.Equal(t, domain.HashAPIToken(token), stored.TokenHash)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), apiToken.ExpirationDate, time.Minute)
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		_, _, err := useCase.CreateAPIToken(1, "", []string{domain.APITokenScopeMailRead}, 30, ctx)
+		assert.Error(t, err)
+
+		_, _, err = useCase.CreateAPIToken(1, "backup", nil, 30, ctx)
+		assert.Error(t, err)
+
+		_, _, err = useCase.CreateAPIToken(1, "backup", []string{"admin"}, 30, ctx)
+		assert.Error(t, err)
+
+		_, _, err = useCase.CreateAPIToken(1, "backup", []string{domain.APITokenScopeMailRead}, 0, ctx)
+		assert.Error(t, err)
+
+		_, _, err = useCase.CreateAPIToken(1, "backup", []string{domain.APITokenScopeMailRead}, domain.APITokenMaxLifeTimeDays+1, ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("LimitReached", func(t *testing.T) {
+		mockRepo.EXPECT().AddAPIToken(gomock.Any(), ctx).Return(uint32(0), errors.New("limit"))
+
+		_, _, err := useCase.CreateAPIToken(1, "backup", []string{domain.APITokenScopeMailSend}, 30, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().DeleteAPIToken(uint32(1), uint32(3), ctx).Return(true, nil)
+	assert.NoError(t, useCase.DeleteAPIToken(1, 3, ctx))
+
+	mockRepo.EXPECT().DeleteAPIToken(uint32(1), uint32(4), ctx).Return(false, nil)
+	assert.Error(t, useCase.DeleteAPIToken(1, 4, ctx))
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	token := domain.APITokenPrefix + "secret"
+	tokenHash := domain.HashAPIToken(token)
+	user := &domain.User{ID: 2, Login: "user@mailhub.su"}
+
+	t.Run("RecordsUse", func(t *testing.T) {
+		apiToken := &domain.APIToken{ID: 1, ProfileID: 2, ExpirationDate: time.Now().Add(time.Hour)}
+		mockRepo.EXPECT().GetAPITokenByHash(tokenHash, ctx).Return(apiToken, nil)
+		mockRepo.EXPECT().GetByID(uint32(2), ctx).Return(user, nil)
+		mockRepo.EXPECT().UpdateAPITokenLastUsed(uint32(1), gomock.Any(), ctx).Return(nil)
+
+		gotToken, gotUser, err := useCase.AuthenticateAPIToken(token, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, apiToken, gotToken)
+		assert.Equal(t, user, gotUser)
+		assert.False(t, gotToken.LastUsedDate.IsZero())
+	})
+
+	t.Run("RecentlyUsed", func(t *testing.T) {
+		apiToken := &domain.APIToken{ID: 1, ProfileID: 2, ExpirationDate: time.Now().Add(time.Hour), LastUsedDate: time.Now()}
+		mockRepo.EXPECT().GetAPITokenByHash(tokenHash, ctx).Return(apiToken, nil)
+		mockRepo.EXPECT().GetByID(uint32(2), ctx).Return(user, nil)
+
+		_, _, err := useCase.AuthenticateAPIToken(token, ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		apiToken := &domain.APIToken{ID: 1, ProfileID: 2, ExpirationDate: time.Now().Add(-time.Minute)}
+		mockRepo.EXPECT().GetAPITokenByHash(tokenHash, ctx).Return(apiToken, nil)
+
+		_, _, err := useCase.AuthenticateAPIToken(token, ctx)
+		assert.EqualError(t, err, "api token expired")
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		mockRepo.EXPECT().GetAPITokenByHash(tokenHash, ctx).Return(nil, errors.New("not found"))
+
+		_, _, err := useCase.AuthenticateAPIToken(token, ctx)
+		assert.EqualError(t, err, "invalid api token")
+	})
+
+	t.Run("NotAnAPIToken", func(t *testing.T) {
+		_, _, err := useCase.AuthenticateAPIToken("session-id", ctx)
+		assert.EqualError(t, err, "invalid api token")
+	})
+}
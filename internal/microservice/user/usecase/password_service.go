@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"mail/internal/microservice/models/domain_models"
+
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// ChangePassword sets a new password of the user after checking the current one.
+func (uc *UserUseCase) ChangePassword(userID uint32, oldPassword, newPassword string, ctx context.Context) error {
+	if err := uc.checkPassword(userID, oldPassword, ctx); err != nil {
+		return err
+	}
+
+	if oldPassword == newPassword {
+		return fmt.Errorf("new password must differ from the current one")
+	}
+
+	return uc.repo.UpdatePassword(userID, newPassword, ctx)
+}
+
+// SetRecoveryEmail sets the external address password reset links are sent to after checking the password.
+// The address can not be a mailbox of the service itself, it would be unreachable without the password.
+// A new address is not trusted until it is verified.
+func (uc *UserUseCase) SetRecoveryEmail(userID uint32, password, recoveryEmail string, ctx context.Context) error {
+	if err := uc.checkPassword(userID, password, ctx); err != nil {
+		return err
+	}
+
+	return uc.AddRecoveryEmail(userID, recoveryEmail, ctx)
+}
+
+// AddRecoveryEmail sets the recovery email of a user who has just signed up, without checking the password.
+func (uc *UserUseCase) AddRecoveryEmail(userID uint32, recoveryEmail string, ctx context.Context) error {
+	if recoveryEmail != "" && !validUtil.IsValidRecoveryEmail(recoveryEmail) {
+		return fmt.Errorf("invalid recovery email")
+	}
+
+	return uc.repo.SetRecoveryEmail(userID, recoveryEmail, ctx)
+}
+
+// GetRecoveryEmail returns the recovery email of the user and whether it is verified.
+func (uc *UserUseCase) GetRecoveryEmail(userID uint32, ctx context.Context) (string, bool, error) {
+	return uc.repo.GetRecoveryEmail(userID, ctx)
+}
+
+// ConfirmRecoveryEmail marks the recovery email of the user as verified.
+// It fails if the user has changed the address since the verification link was sent.
+func (uc *UserUseCase) ConfirmRecoveryEmail(userID uint32, recoveryEmail string, ctx context.Context) error {
+	confirmed, err := uc.repo.ConfirmRecoveryEmail(userID, recoveryEmail, ctx)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return fmt.Errorf("recovery email has changed")
+	}
+
+	return nil
+}
+
+// CreatePasswordResetToken creates a password reset token of the user with the login and returns it
+// together with the recovery email to send it to. Nothing is created if the user has no verified recovery email.
+func (uc *UserUseCase) CreatePasswordResetToken(login string, ctx context.Context) (string, string, error) {
+	user, err := uc.repo.GetByOnlyLogin(login, ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	recoveryEmail, verified, err := uc.repo.GetRecoveryEmail(user.ID, ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if recoveryEmail == "" || !verified {
+		return "", "", nil
+	}
+
+	randBytes := make([]byte, 32)
+	if _, err = rand.Read(randBytes); err != nil {
+		return "", "", fmt.Errorf("failed to generate password reset token: %v", err)
+	}
+	token := hex.EncodeToString(randBytes)
+
+	err = uc.repo.AddPasswordResetToken(&domain_models.PasswordResetToken{
+		TokenHash:      domain_models.HashPasswordResetToken(token),
+		ProfileID:      user.ID,
+		ExpirationDate: time.Now().Add(domain_models.PasswordResetTokenLifeTime),
+	}, ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, recoveryEmail, nil
+}
+
+// ResetPassword sets a new password with a password reset token and returns the user.
+// The token can be used only once.
+func (uc *UserUseCase) ResetPassword(token, newPassword string, ctx context.Context) (uint32, error) {
+	return uc.repo.ResetPasswordByToken(domain_models.HashPasswordResetToken(token), newPassword, ctx)
+}
+
+// checkPassword checks the password of the user.
+func (uc *UserUseCase) checkPassword(userID uint32, password string, ctx context.Context) error {
+	user, err := uc.repo.GetByID(userID, ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = uc.repo.GetUserByLogin(user.Login, password, ctx); err != nil {
+		return fmt.Errorf("invalid password")
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	mock_repository "mail/internal/microservice/user/mock"
+)
+
+func TestChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(&domain.User{ID: 1, Login: "user@mailhub.su"}, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "old", ctx).Return(&domain.User{ID: 1}, nil)
+		mockRepo.EXPECT().UpdatePassword(uint32(1), "new", ctx).Return(nil)
+
+		assert.NoError(t, useCase.ChangePassword(1, "old", "new", ctx))
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(&domain.User{ID: 1, Login: "user@mailhub.su"}, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "wrong", ctx).Return(nil, errors.New("login failed"))
+
+		assert.EqualError(t, useCase.ChangePassword(1, "wrong", "new", ctx), "invalid password")
+	})
+
+	t.Run("SamePassword", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(&domain.User{ID: 1, Login: "user@mailhub.su"}, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "old", ctx).Return(&domain.User{ID: 1}, nil)
+
+		assert.Error(t, useCase.ChangePassword(1, "old", "old", ctx))
+	})
+}
+
+func TestSetRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	expectPassword := func() {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(&domain.User{ID: 1, Login: "user@mailhub.su"}, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "pass", ctx).Return(&domain.User{ID: 1}, nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expectPassword()
+		mockRepo.EXPECT().SetRecoveryEmail(uint32(1), "user@example.com", ctx).Return(nil)
+
+		assert.NoError(t, useCase.SetRecoveryEmail(1, "pass", "user@example.com", ctx))
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		expectPassword()
+		mockRepo.EXPECT().SetRecoveryEmail(uint32(1), "", ctx).Return(nil)
+
+		assert.NoError(t, useCase.SetRecoveryEmail(1, "pass", "", ctx))
+	})
+
+	t.Run("OwnDomain", func(t *testing.T) {
+		expectPassword()
+
+		assert.Error(t, useCase.SetRecoveryEmail(1, "pass", "other@mailhub.su", ctx))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		expectPassword()
+
+		assert.Error(t, useCase.SetRecoveryEmail(1, "pass", "not an email", ctx))
+	})
+}
+
+func TestAddRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().SetRecoveryEmail(uint32(1), "user@example.com", ctx).Return(nil)
+
+		assert.NoError(t, useCase.AddRecoveryEmail(1, "user@example.com", ctx))
+	})
+
+	t.Run("OwnDomain", func(t *testing.T) {
+		assert.Error(t, useCase.AddRecoveryEmail(1, "other@mailhub.su", ctx))
+	})
+}
+
+func TestConfirmRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().ConfirmRecoveryEmail(uint32(1), "user@example.com", ctx).Return(true, nil)
+
+		assert.NoError(t, useCase.ConfirmRecoveryEmail(1, "user@example.com", ctx))
+	})
+
+	t.Run("AddressChanged", func(t *testing.T) {
+		mockRepo.EXPECT().ConfirmRecoveryEmail(uint32(1), "old@example.com", ctx).Return(false, nil)
+
+		assert.Error(t, useCase.ConfirmRecoveryEmail(1, "old@example.com", ctx))
+	})
+}
+
+func TestCreatePasswordResetToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		var stored *domain.PasswordResetToken
+
+		mockRepo.EXPECT().GetByOnlyLogin("user@mailhub.su", ctx).Return(&domain.User{ID: 1}, nil)
+		mockRepo.EXPECT().GetRecoveryEmail(uint32(1), ctx).Return("user@example.com", true, nil)
+		mockRepo.EXPECT().AddPasswordResetToken(gomock.Any(), ctx).
+			DoAndReturn(func(token *domain.PasswordResetToken, _ interface{}) error {
+				stored = token
+				return nil
+			})
+
+		token, recoveryEmail, err := useCase.CreatePasswordResetToken("user@mailhub.su", ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "user@example.com", recoveryEmail)
+		assert.Len(t, token, 64)
+		assert.Equal(t, domain.HashPasswordResetToken(token), stored.TokenHash)
+		assert.Equal(t, uint32(1), stored.ProfileID)
+	})
+
+	t.Run("NoRecoveryEmail", func(t *testing.T) {
+		mockRepo.EXPECT().GetByOnlyLogin("user@mailhub.su", ctx).Return(&domain.User{ID: 1}, nil)
+		mockRepo.EXPECT().GetRecoveryEmail(uint32(1), ctx).Return("", false, nil)
+
+		token, recoveryEmail, err := useCase.CreatePasswordResetToken("user@mailhub.su", ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, token)
+		assert.Empty(t, recoveryEmail)
+	})
+
+	t.Run("NotVerified", func(t *testing.T) {
+		mockRepo.EXPECT().GetByOnlyLogin("user@mailhub.su", ctx).Return(&domain.User{ID: 1}, nil)
+		mockRepo.EXPECT().GetRecoveryEmail(uint32(1), ctx).Return("user@example.com", false, nil)
+
+		token, recoveryEmail, err := useCase.CreatePasswordResetToken("user@mailhub.su", ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, token)
+		assert.Empty(t, recoveryEmail)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	mockRepo.EXPECT().ResetPasswordByToken(domain.HashPasswordResetToken("token"), "new", ctx).Return(uint32(1), nil)
+
+	userID, err := useCase.ResetPassword("token", "new", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), userID)
+}
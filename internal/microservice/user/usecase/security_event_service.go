@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"mail/internal/microservice/models/domain_models"
+)
+
+// GetSecurityEvents returns the security events of the user recorded before the event with beforeID, the latest first.
+// A zero beforeID starts from the latest event.
+func (uc *UserUseCase) GetSecurityEvents(userID uint32, beforeID uint64, limit int, ctx context.Context) ([]*domain_models.SecurityEvent, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	return uc.repo.QuerySecurityEvents(&domain_models.SecurityEventFilter{
+		ProfileID: userID,
+		BeforeID:  beforeID,
+		Limit:     limit,
+	}, ctx)
+}
+
+// QuerySecurityEvents returns the security events of any account selected by the filter, the latest first.
+func (uc *UserUseCase) QuerySecurityEvents(filter *domain_models.SecurityEventFilter, ctx context.Context) ([]*domain_models.SecurityEvent, error) {
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return nil, fmt.Errorf("since must be before until")
+	}
+
+	return uc.repo.QuerySecurityEvents(filter, ctx)
+}
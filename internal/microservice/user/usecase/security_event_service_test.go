@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	mock_repository "mail/internal/microservice/user/mock"
+)
+
+func TestGetSecurityEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		events := []*domain.SecurityEvent{{ID: 5, ProfileID: 1, Type: domain.SecurityEventLogout, Success: true}}
+		mockRepo.EXPECT().QuerySecurityEvents(&domain.SecurityEventFilter{ProfileID: 1, BeforeID: 6, Limit: 20}, ctx).Return(events, nil)
+
+		result, err := useCase.GetSecurityEvents(1, 6, 20, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, events, result)
+	})
+
+	t.Run("InvalidUser", func(t *testing.T) {
+		_, err := useCase.GetSecurityEvents(0, 0, 20, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestQuerySecurityEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		filter := &domain.SecurityEventFilter{Login: "user@mailhub.su", Since: now.Add(-time.Hour), Until: now}
+		mockRepo.EXPECT().QuerySecurityEvents(filter, ctx).Return([]*domain.SecurityEvent{}, nil)
+
+		events, err := useCase.QuerySecurityEvents(filter, ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("InvalidPeriod", func(t *testing.T) {
+		_, err := useCase.QuerySecurityEvents(&domain.SecurityEventFilter{Since: now, Until: now.Add(-time.Hour)}, ctx)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"mail/internal/microservice/models/domain_models"
+	"mail/internal/pkg/utils/totp"
+)
+
+// recoveryCodeEncoding is the alphabet of the recovery codes.
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GetTwoFactorStatus returns whether two-factor authentication is enabled and the number of unused recovery codes.
+func (uc *UserUseCase) GetTwoFactorStatus(userID uint32, ctx context.Context) (bool, int, error) {
+	twoFactor, err := uc.repo.GetTwoFactor(userID, ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return false, 0, nil
+	}
+
+	count, err := uc.repo.CountRecoveryCodes(userID, ctx)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return true, count, nil
+}
+
+// BeginTwoFactorSetup generates a new secret for the user to add to an authenticator application.
+// Two-factor authentication stays disabled until the secret is confirmed with a valid code.
+func (uc *UserUseCase) BeginTwoFactorSetup(userID uint32, ctx context.Context) (*domain_models.TwoFactorSetup, error) {
+	user, err := uc.repo.GetByID(userID, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := uc.repo.GetTwoFactor(userID, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor != nil && twoFactor.Enabled {
+		return nil, fmt.Errorf("two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.repo.SaveTwoFactorSecret(userID, secret, ctx); err != nil {
+		return nil, err
+	}
+
+	return &domain_models.TwoFactorSetup{
+		Secret: secret,
+		URI:    totp.URI(domain_models.TwoFactorIssuer, user.Login, secret),
+	}, nil
+}
+
+// ConfirmTwoFactorSetup enables two-factor authentication after a valid code and returns the recovery codes.
+// The recovery codes are shown to the user only once, only their hashes are stored.
+func (uc *UserUseCase) ConfirmTwoFactorSetup(userID uint32, code string, ctx context.Context) ([]string, error) {
+	twoFactor, err := uc.repo.GetTwoFactor(userID, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor == nil {
+		return nil, fmt.Errorf("two-factor setup not started")
+	}
+	if twoFactor.Enabled {
+		return nil, fmt.Errorf("two-factor authentication is already enabled")
+	}
+
+	step, ok := totp.Validate(twoFactor.Secret, code, time.Now())
+	if !ok {
+		return nil, fmt.Errorf("invalid two-factor code")
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.repo.EnableTwoFactor(userID, step, recoveryCodeHashes, ctx); err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableTwoFactor disables two-factor authentication. The user has to authenticate
+// again with the password and a code, so a stolen session is not enough to turn it off.
+func (uc *UserUseCase) DisableTwoFactor(userID uint32, password, code string, ctx context.Context) error {
+	user, err := uc.repo.GetByID(userID, ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = uc.repo.GetUserByLogin(user.Login, password, ctx); err != nil {
+		return fmt.Errorf("invalid password")
+	}
+
+	twoFactor, err := uc.repo.GetTwoFactor(userID, ctx)
+	if err != nil {
+		return err
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return fmt.Errorf("two-factor authentication is not enabled")
+	}
+
+	ok, err := uc.verifyTwoFactorCode(twoFactor, code, ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("invalid two-factor code")
+	}
+
+	return uc.repo.DisableTwoFactor(userID, ctx)
+}
+
+// CreateTwoFactorChallenge starts a login waiting for the second factor and returns its identifier.
+// An empty identifier is returned if the user has not enabled two-factor authentication.
+func (uc *UserUseCase) CreateTwoFactorChallenge(userID uint32, ctx context.Context) (string, error) {
+	twoFactor, err := uc.repo.GetTwoFactor(userID, ctx)
+	if err != nil {
+		return "", err
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return "", nil
+	}
+
+	challengeID, err := generateChallengeID()
+	if err != nil {
+		return "", err
+	}
+
+	err = uc.repo.AddTwoFactorChallenge(&domain_models.TwoFactorChallenge{
+		ID:             challengeID,
+		ProfileID:      userID,
+		ExpirationDate: time.Now().Add(domain_models.TwoFactorChallengeLifeTime),
+	}, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return challengeID, nil
+}
+
+// VerifyTwoFactorChallenge checks the code of the login waiting for the second factor and returns the user.
+// The attempt is spent before the code is checked, and the challenge is removed after a successful check,
+// after it expired or after too many wrong codes.
+func (uc *UserUseCase) VerifyTwoFactorChallenge(challengeID, code string, ctx context.Context) (uint32, error) {
+	challenge, err := uc.repo.SpendTwoFactorChallengeAttempt(challengeID, domain_models.TwoFactorChallengeMaxAttempts, ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if challenge == nil {
+		_ = uc.repo.DeleteTwoFactorChallenge(challengeID, ctx)
+		return 0, fmt.Errorf("two-factor challenge expired")
+	}
+
+	twoFactor, err := uc.repo.GetTwoFactor(challenge.ProfileID, ctx)
+	if err != nil {
+		return 0, err
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		_ = uc.repo.DeleteTwoFactorChallenge(challengeID, ctx)
+		return 0, fmt.Errorf("two-factor authentication is not enabled")
+	}
+
+	ok, err := uc.verifyTwoFactorCode(twoFactor, code, ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("invalid two-factor code")
+	}
+
+	if err = uc.repo.DeleteTwoFactorChallenge(challengeID, ctx); err != nil {
+		return 0, err
+	}
+
+	return challenge.ProfileID, nil
+}
+
+// verifyTwoFactorCode checks a TOTP code or a recovery code and marks it as used.
+func (uc *UserUseCase) verifyTwoFactorCode(twoFactor *domain_models.TwoFactor, code string, ctx context.Context) (bool, error) {
+	code = strings.TrimSpace(code)
+
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(twoFactor.Secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+
+		return uc.repo.UseTwoFactorStep(twoFactor.ProfileID, step, ctx)
+	}
+
+	return uc.repo.UseRecoveryCode(twoFactor.ProfileID, HashRecoveryCode(code), ctx)
+}
+
+// generateRecoveryCodes generates the recovery codes in the form xxxxx-xxxxx and their hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, domain_models.RecoveryCodesCount)
+	hashes := make([]string, 0, domain_models.RecoveryCodesCount)
+
+	for i := 0; i < domain_models.RecoveryCodesCount; i++ {
+		randBytes := make([]byte, 7)
+		if _, err := rand.Read(randBytes); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %v", err)
+		}
+
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(randBytes)[:10])
+		code = code[:5] + "-" + code[5:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the hash the recovery code is stored with.
+// Case, spaces and dashes are ignored, so the code can be entered the way it was written down.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// generateChallengeID generates a random identifier of a login waiting for the second factor.
+func generateChallengeID() (string, error) {
+	randBytes := make([]byte, 32)
+	if _, err := rand.Read(randBytes); err != nil {
+		return "", fmt.Errorf("failed to generate challenge id: %v", err)
+	}
+
+	return hex.EncodeToString(randBytes), nil
+}
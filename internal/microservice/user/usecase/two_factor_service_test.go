@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/utils/totp"
+
+	domain "mail/internal/microservice/models/domain_models"
+	mock_repository "mail/internal/microservice/user/mock"
+)
+
+// testSecret is a valid base32 TOTP secret.
+const testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func currentCode(t *testing.T) string {
+	code, err := totp.Code(testSecret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatalf("totp.Code() error = %v", err)
+	}
+
+	return code
+}
+
+func TestGetTwoFactorStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Enabled", func(t *testing.T) {
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(&domain.TwoFactor{ProfileID: 1, Enabled: true}, nil)
+		mockRepo.EXPECT().CountRecoveryCodes(uint32(1), ctx).Return(8, nil)
+
+		enabled, left, err := useCase.GetTwoFactorStatus(1, ctx)
+		assert.NoError(t, err)
+		assert.True(t, enabled)
+		assert.Equal(t, 8, left)
+	})
+
+	t.Run("NotConfirmed", func(t *testing.T) {
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(&domain.TwoFactor{ProfileID: 1}, nil)
+
+		enabled, _, err := useCase.GetTwoFactorStatus(1, ctx)
+		assert.NoError(t, err)
+		assert.False(t, enabled)
+	})
+}
+
+func TestBeginTwoFactorSetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(&domain.User{ID: 1, Login: "user@mailhub.su"}, nil)
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(nil, nil)
+		mockRepo.EXPECT().SaveTwoFactorSecret(uint32(1), gomock.Any(), ctx).Return(nil)
+
+		setup, err := useCase.BeginTwoFactorSetup(1, ctx)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, setup.Secret)
+		assert.True(t, strings.HasPrefix(setup.URI, "otpauth://totp/MailHub:user@mailhub.su?"))
+		assert.Contains(t, setup.URI, "secret="+setup.Secret)
+	})
+
+	t.Run("AlreadyEnabled", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(&domain.User{ID: 1, Login: "user@mailhub.su"}, nil)
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(&domain.TwoFactor{ProfileID: 1, Enabled: true}, nil)
+
+		_, err := useCase.BeginTwoFactorSetup(1, ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestConfirmTwoFactorSetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Success", func(t *testing.T) {
+		var storedHashes []string
+
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(&domain.TwoFactor{ProfileID: 1, Secret: testSecret}, nil)
+		mockRepo.EXPECT().EnableTwoFactor(uint32(1), gomock.Any(), gomock.Any(), ctx).
+			DoAndReturn(func(_ uint32, _ int64, hashes []string, _ interface{}) error {
+				storedHashes = hashes
+				return nil
+			})
+
+		codes, err := useCase.ConfirmTwoFactorSetup(1, currentCode(t), ctx)
+		assert.NoError(t, err)
+		assert.Len(t, codes, domain.RecoveryCodesCount)
+		assert.Len(t, storedHashes, domain.RecoveryCodesCount)
+		assert.Equal(t, HashRecoveryCode(codes[0]), storedHashes[0])
+		assert.NotContains(t, storedHashes, codes[0])
+	})
+
+	t.Run("InvalidCode", func(t *testing.T) {
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(&domain.TwoFactor{ProfileID: 1, Secret: testSecret}, nil)
+
+		_, err := useCase.ConfirmTwoFactorSetup(1, "abcdef", ctx)
+		assert.EqualError(t, err, "invalid two-factor code")
+	})
+
+	t.Run("NotStarted", func(t *testing.T) {
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(nil, nil)
+
+		_, err := useCase.ConfirmTwoFactorSetup(1, currentCode(t), ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	user := &domain.User{ID: 1, Login: "user@mailhub.su"}
+	twoFactor := &domain.TwoFactor{ProfileID: 1, Secret: testSecret, Enabled: true}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(user, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "password", ctx).Return(user, nil)
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(twoFactor, nil)
+		mockRepo.EXPECT().UseTwoFactorStep(uint32(1), gomock.Any(), ctx).Return(true, nil)
+		mockRepo.EXPECT().DisableTwoFactor(uint32(1), ctx).Return(nil)
+
+		assert.NoError(t, useCase.DisableTwoFactor(1, "password", currentCode(t), ctx))
+	})
+
+	t.Run("WithRecoveryCode", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(user, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "password", ctx).Return(user, nil)
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(twoFactor, nil)
+		mockRepo.EXPECT().UseRecoveryCode(uint32(1), HashRecoveryCode("abcde-fghij"), ctx).Return(true, nil)
+		mockRepo.EXPECT().DisableTwoFactor(uint32(1), ctx).Return(nil)
+
+		assert.NoError(t, useCase.DisableTwoFactor(1, "password", "ABCDE-FGHIJ", ctx))
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(user, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "wrong", ctx).Return(nil, errors.New("not found"))
+
+		assert.EqualError(t, useCase.DisableTwoFactor(1, "wrong", currentCode(t), ctx), "invalid password")
+	})
+
+	t.Run("ReplayedCode", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(uint32(1), ctx).Return(user, nil)
+		mockRepo.EXPECT().GetUserByLogin("user@mailhub.su", "password", ctx).Return(user, nil)
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(twoFactor, nil)
+		mockRepo.EXPECT().UseTwoFactorStep(uint32(1), gomock.Any(), ctx).Return(false, nil)
+
+		assert.EqualError(t, useCase.DisableTwoFactor(1, "password", currentCode(t), ctx), "invalid two-factor code")
+	})
+}
+
+func TestCreateTwoFactorChallenge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	t.Run("Enabled", func(t *testing.T) {
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(&domain.TwoFactor{ProfileID: 1, Enabled: true}, nil)
+		mockRepo.EXPECT().AddTwoFactorChallenge(gomock.Any(), ctx).
+			DoAndReturn(func(challenge *domain.TwoFactorChallenge, _ interface{}) error {
+				assert.Equal(t, uint32(1), challenge.ProfileID)
+				assert.True(t, challenge.ExpirationDate.After(time.Now()))
+				return nil
+			})
+
+		challengeID, err := useCase.CreateTwoFactorChallenge(1, ctx)
+		assert.NoError(t, err)
+		assert.Len(t, challengeID, 64)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(nil, nil)
+
+		challengeID, err := useCase.CreateTwoFactorChallenge(1, ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, challengeID)
+	})
+}
+
+func TestVerifyTwoFactorChallenge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockUserRepository(ctrl)
+	useCase := NewUserUseCase(mockRepo)
+	ctx := GetCTX()
+
+	challenge := &domain.TwoFactorChallenge{ID: "challenge", ProfileID: 1, ExpirationDate: time.Now().Add(time.Minute)}
+	twoFactor := &domain.TwoFactor{ProfileID: 1, Secret: testSecret, Enabled: true}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().SpendTwoFactorChallengeAttempt("challenge", domain.TwoFactorChallengeMaxAttempts, ctx).Return(challenge, nil)
+		mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(twoFactor, nil)
+		mockRepo.EXPECT().UseTwoFactorStep(uint32(1), gomock.Any(), ctx).Return(true, nil)
+		mockRepo.EXPECT().DeleteTwoFactorChallenge("challenge", ctx).Return(nil)
+
+		userID, err := useCase.VerifyTwoFactorChallenge("challenge", currentCode(t), ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), userID)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		// The attempt is spent before the code is checked.
+		gomock.InOrder(
+			mockRepo.EXPECT().SpendTwoFactorChallengeAttempt("challenge", domain.TwoFactorChallengeMaxAttempts, ctx).Return(challenge, nil),
+			mockRepo.EXPECT().GetTwoFactor(uint32(1), ctx).Return(twoFactor, nil),
+			mockRepo.EXPECT().UseRecoveryCode(uint32(1), gomock.Any(), ctx).Return(false, nil),
+		)
+
+		_, err := useCase.VerifyTwoFactorChallenge("challenge", "wrong-code", ctx)
+		assert.EqualError(t, err, "invalid two-factor code")
+	})
+
+	t.Run("NoAttemptsLeft", func(t *testing.T) {
+		// An expired challenge or one with no attempts left is not returned, the code is never checked.
+		mockRepo.EXPECT().SpendTwoFactorChallengeAttempt("challenge", domain.TwoFactorChallengeMaxAttempts, ctx).Return(nil, nil)
+		mockRepo.EXPECT().DeleteTwoFactorChallenge("challenge", ctx).Return(nil)
+
+		_, err := useCase.VerifyTwoFactorChallenge("challenge", currentCode(t), ctx)
+		assert.EqualError(t, err, "two-factor challenge expired")
+	})
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	assert.Equal(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode(" ABCDE FGHIJ"))
+	assert.NotEqual(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode("abcde-fghik"))
+}
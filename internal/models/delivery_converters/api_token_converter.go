@@ -0,0 +1,23 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+// APITokenConvertCoreInApi converts a personal access token from the core package to the API representation.
+func APITokenConvertCoreInApi(tokenModelCore *domain.APIToken) *api.APIToken {
+	tokenModelApi := &api.APIToken{
+		ID:             tokenModelCore.ID,
+		Name:           tokenModelCore.Name,
+		Scopes:         tokenModelCore.Scopes,
+		CreationDate:   tokenModelCore.CreationDate,
+		ExpirationDate: tokenModelCore.ExpirationDate,
+	}
+	if !tokenModelCore.LastUsedDate.IsZero() {
+		lastUsedDate := tokenModelCore.LastUsedDate
+		tokenModelApi.LastUsedDate = &lastUsedDate
+	}
+
+	return tokenModelApi
+}
@@ -0,0 +1,43 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAPITokenConvertCoreInApi(t *testing.T) {
+	creationDate := time.Now()
+	expirationDate := creationDate.Add(24 * time.Hour)
+
+	tokenModelCore := domain.APIToken{
+		ID:             1,
+		TokenHash:      "hash",
+		ProfileID:      2,
+		Name:           "backup",
+		Scopes:         []string{domain.APITokenScopeMailRead},
+		CreationDate:   creationDate,
+		ExpirationDate: expirationDate,
+		LastUsedDate:   creationDate,
+	}
+
+	expectedTokenModelApi := &api.APIToken{
+		ID:             1,
+		Name:           "backup",
+		Scopes:         []string{domain.APITokenScopeMailRead},
+		CreationDate:   creationDate,
+		ExpirationDate: expirationDate,
+		LastUsedDate:   &creationDate,
+	}
+
+	if tokenModelApi := APITokenConvertCoreInApi(&tokenModelCore); !reflect.DeepEqual(tokenModelApi, expectedTokenModelApi) {
+		t.Errorf("APITokenConvertCoreInApi() = %v, want %v", tokenModelApi, expectedTokenModelApi)
+	}
+
+	tokenModelCore.LastUsedDate = time.Time{}
+	if tokenModelApi := APITokenConvertCoreInApi(&tokenModelCore); tokenModelApi.LastUsedDate != nil {
+		t.Errorf("APITokenConvertCoreInApi() LastUsedDate = %v, want nil", tokenModelApi.LastUsedDate)
+	}
+}
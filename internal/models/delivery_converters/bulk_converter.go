@@ -0,0 +1,15 @@
+package delivery_converters
+
+import (
+	bulkCore "mail/internal/microservice/models/domain_models"
+	bulkApi "mail/internal/models/delivery_models"
+)
+
+// BulkResultConvertCoreInApi converts a bulk result from the core package to the API representation.
+func BulkResultConvertCoreInApi(resultModelCore bulkCore.BulkResult) *bulkApi.BulkResult {
+	return &bulkApi.BulkResult{
+		EmailID: resultModelCore.EmailID,
+		Success: resultModelCore.Success,
+		Error:   resultModelCore.Error,
+	}
+}
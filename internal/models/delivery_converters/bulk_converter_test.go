@@ -0,0 +1,28 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+)
+
+func TestBulkResultConvertCoreInApi(t *testing.T) {
+	resultModelCore := domain.BulkResult{
+		EmailID: 1,
+		Success: false,
+		Error:   "email not found",
+	}
+
+	resultModelApi := BulkResultConvertCoreInApi(resultModelCore)
+
+	expectedResultModelApi := &api.BulkResult{
+		EmailID: resultModelCore.EmailID,
+		Success: resultModelCore.Success,
+		Error:   resultModelCore.Error,
+	}
+
+	if !reflect.DeepEqual(resultModelApi, expectedResultModelApi) {
+		t.Errorf("BulkResultConvertCoreInApi() = %v, want %v", resultModelApi, expectedResultModelApi)
+	}
+}
@@ -0,0 +1,43 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+// ExternalAccountConvertCoreInApi converts a linked mailbox from the core package to the API representation.
+func ExternalAccountConvertCoreInApi(accountModelCore *domain.ExternalAccount) *api.ExternalAccount {
+	return &api.ExternalAccount{
+		ID:           accountModelCore.ID,
+		Email:        accountModelCore.Email,
+		IMAPHost:     accountModelCore.IMAPHost,
+		IMAPPort:     accountModelCore.IMAPPort,
+		IMAPTLSMode:  accountModelCore.IMAPTLSMode,
+		SMTPHost:     accountModelCore.SMTPHost,
+		SMTPPort:     accountModelCore.SMTPPort,
+		SMTPTLSMode:  accountModelCore.SMTPTLSMode,
+		Username:     accountModelCore.Username,
+		CreationDate: accountModelCore.CreationDate,
+	}
+}
+
+// ExternalAccountLinkConvertApiInCore converts a request to link a mailbox from the API representation to the core package.
+// The email is used as the user name when none is given, as most providers expect.
+func ExternalAccountLinkConvertApiInCore(linkModelApi *api.ExternalAccountLink) *domain.ExternalAccount {
+	username := linkModelApi.Username
+	if username == "" {
+		username = linkModelApi.Email
+	}
+
+	return &domain.ExternalAccount{
+		Email:       linkModelApi.Email,
+		IMAPHost:    linkModelApi.IMAPHost,
+		IMAPPort:    linkModelApi.IMAPPort,
+		IMAPTLSMode: linkModelApi.IMAPTLSMode,
+		SMTPHost:    linkModelApi.SMTPHost,
+		SMTPPort:    linkModelApi.SMTPPort,
+		SMTPTLSMode: linkModelApi.SMTPTLSMode,
+		Username:    username,
+		Password:    linkModelApi.Password,
+	}
+}
@@ -0,0 +1,79 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExternalAccountConvertCoreInApi(t *testing.T) {
+	creationDate := time.Now()
+
+	accountModelCore := domain.ExternalAccount{
+		ID:           1,
+		ProfileID:    2,
+		Email:        "user@example.com",
+		IMAPHost:     "imap.example.com",
+		IMAPPort:     993,
+		IMAPTLSMode:  domain.ExternalAccountTLSModeTLS,
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		SMTPTLSMode:  domain.ExternalAccountTLSModeSTARTTLS,
+		Username:     "user",
+		Password:     "secret",
+		CreationDate: creationDate,
+	}
+
+	expectedAccountModelApi := &api.ExternalAccount{
+		ID:           1,
+		Email:        "user@example.com",
+		IMAPHost:     "imap.example.com",
+		IMAPPort:     993,
+		IMAPTLSMode:  domain.ExternalAccountTLSModeTLS,
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		SMTPTLSMode:  domain.ExternalAccountTLSModeSTARTTLS,
+		Username:     "user",
+		CreationDate: creationDate,
+	}
+
+	if accountModelApi := ExternalAccountConvertCoreInApi(&accountModelCore); !reflect.DeepEqual(accountModelApi, expectedAccountModelApi) {
+		t.Errorf("ExternalAccountConvertCoreInApi() = %v, want %v", accountModelApi, expectedAccountModelApi)
+	}
+}
+
+func TestExternalAccountLinkConvertApiInCore(t *testing.T) {
+	linkModelApi := api.ExternalAccountLink{
+		Email:       "user@example.com",
+		IMAPHost:    "imap.example.com",
+		IMAPPort:    993,
+		IMAPTLSMode: domain.ExternalAccountTLSModeTLS,
+		SMTPHost:    "smtp.example.com",
+		SMTPPort:    465,
+		SMTPTLSMode: domain.ExternalAccountTLSModeTLS,
+		Password:    "secret",
+	}
+
+	expectedAccountModelCore := &domain.ExternalAccount{
+		Email:       "user@example.com",
+		IMAPHost:    "imap.example.com",
+		IMAPPort:    993,
+		IMAPTLSMode: domain.ExternalAccountTLSModeTLS,
+		SMTPHost:    "smtp.example.com",
+		SMTPPort:    465,
+		SMTPTLSMode: domain.ExternalAccountTLSModeTLS,
+		Username:    "user@example.com",
+		Password:    "secret",
+	}
+
+	if accountModelCore := ExternalAccountLinkConvertApiInCore(&linkModelApi); !reflect.DeepEqual(accountModelCore, expectedAccountModelCore) {
+		t.Errorf("ExternalAccountLinkConvertApiInCore() = %v, want %v", accountModelCore, expectedAccountModelCore)
+	}
+
+	linkModelApi.Username = "user"
+	if accountModelCore := ExternalAccountLinkConvertApiInCore(&linkModelApi); accountModelCore.Username != "user" {
+		t.Errorf("ExternalAccountLinkConvertApiInCore() Username = %v, want user", accountModelCore.Username)
+	}
+}
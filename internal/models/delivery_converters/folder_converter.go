@@ -11,6 +11,10 @@ func FolderConvertCoreInApi(folderModelDb folderCore.Folder) *folderApi.Folder {
 		ID:        folderModelDb.ID,
 		ProfileId: folderModelDb.ProfileId,
 		Name:      folderModelDb.Name,
+		ParentID:  folderModelDb.ParentID,
+		Path:      folderModelDb.Path,
+		Total:     folderModelDb.Total,
+		Unread:    folderModelDb.Unread,
 	}
 }
 
@@ -20,5 +24,6 @@ func FolderConvertApiInCore(folderModelApi folderApi.Folder) *folderCore.Folder
 		ID:        folderModelApi.ID,
 		ProfileId: folderModelApi.ProfileId,
 		Name:      folderModelApi.Name,
+		ParentID:  folderModelApi.ParentID,
 	}
 }
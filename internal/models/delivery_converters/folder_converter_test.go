@@ -12,6 +12,10 @@ func TestFolderConvertCoreInApi(t *testing.T) {
 		ID:        1,
 		ProfileId: 1,
 		Name:      "folder",
+		ParentID:  2,
+		Path:      "parent/folder",
+		Total:     4,
+		Unread:    2,
 	}
 
 	folderModelApi := FolderConvertCoreInApi(folderModelCore)
@@ -20,6 +24,10 @@ func TestFolderConvertCoreInApi(t *testing.T) {
 		ID:        folderModelCore.ID,
 		ProfileId: folderModelCore.ProfileId,
 		Name:      folderModelCore.Name,
+		ParentID:  folderModelCore.ParentID,
+		Path:      folderModelCore.Path,
+		Total:     folderModelCore.Total,
+		Unread:    folderModelCore.Unread,
 	}
 
 	if !reflect.DeepEqual(folderModelApi, expectedFolderModelApi) {
@@ -32,6 +40,7 @@ func TestFolderConvertApiInCore(t *testing.T) {
 		ID:        1,
 		ProfileId: 1,
 		Name:      "folder",
+		ParentID:  2,
 	}
 
 	folderModelCore := FolderConvertApiInCore(folderModelApi)
@@ -40,6 +49,7 @@ func TestFolderConvertApiInCore(t *testing.T) {
 		ID:        folderModelApi.ID,
 		ProfileId: folderModelApi.ProfileId,
 		Name:      folderModelApi.Name,
+		ParentID:  folderModelApi.ParentID,
 	}
 
 	if !reflect.DeepEqual(folderModelCore, expectedFolderModelCore) {
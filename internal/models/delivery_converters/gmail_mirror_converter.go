@@ -0,0 +1,31 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+// GMailMessageConvertCoreInApi converts an email of the local copy of the Gmail mailbox to the API representation.
+// The read, spam and important states of the email are kept by Gmail as its labels.
+func GMailMessageConvertCoreInApi(messageModelCore *domain.GMailMessage) *api.OtherEmail {
+	return &api.OtherEmail{
+		ID:             messageModelCore.ID,
+		Topic:          messageModelCore.Subject,
+		Text:           messageModelCore.Text,
+		ReadStatus:     !messageModelCore.HasLabel(domain.GMailLabelUnread),
+		Flag:           messageModelCore.HasLabel(domain.GMailLabelImportant),
+		DateOfDispatch: messageModelCore.Date,
+		DraftStatus:    messageModelCore.HasLabel(domain.GMailLabelDraft),
+		SpamStatus:     messageModelCore.HasLabel(domain.GMailLabelSpam),
+		SenderEmail:    messageModelCore.Sender,
+		RecipientEmail: messageModelCore.Recipient,
+	}
+}
+
+// GMailLabelConvertCoreInApi converts a label of the local copy of the Gmail mailbox to the API representation.
+func GMailLabelConvertCoreInApi(labelModelCore *domain.GMailLabel) *api.OtherLabel {
+	return &api.OtherLabel{
+		ID:   labelModelCore.ID,
+		Name: labelModelCore.Name,
+	}
+}
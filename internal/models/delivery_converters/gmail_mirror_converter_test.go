@@ -0,0 +1,54 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGMailMessageConvertCoreInApi(t *testing.T) {
+	date := time.Now()
+
+	messageModelCore := domain.GMailMessage{
+		ID:        "18c1",
+		ThreadID:  "18c0",
+		LabelIDs:  []string{domain.GMailLabelSpam, domain.GMailLabelImportant},
+		Subject:   "Hello",
+		Sender:    "friend@gmail.com",
+		Recipient: "user@gmail.com",
+		Text:      "Hi there",
+		Date:      date,
+	}
+
+	expectedEmailModelApi := &api.OtherEmail{
+		ID:             "18c1",
+		Topic:          "Hello",
+		Text:           "Hi there",
+		ReadStatus:     true,
+		Flag:           true,
+		DateOfDispatch: date,
+		SpamStatus:     true,
+		SenderEmail:    "friend@gmail.com",
+		RecipientEmail: "user@gmail.com",
+	}
+
+	if emailModelApi := GMailMessageConvertCoreInApi(&messageModelCore); !reflect.DeepEqual(emailModelApi, expectedEmailModelApi) {
+		t.Errorf("GMailMessageConvertCoreInApi() = %v, want %v", emailModelApi, expectedEmailModelApi)
+	}
+
+	messageModelCore.LabelIDs = []string{domain.GMailLabelInbox, domain.GMailLabelUnread}
+	if emailModelApi := GMailMessageConvertCoreInApi(&messageModelCore); emailModelApi.ReadStatus || emailModelApi.SpamStatus || emailModelApi.Flag {
+		t.Errorf("GMailMessageConvertCoreInApi() = %v, want an unread email", emailModelApi)
+	}
+}
+
+func TestGMailLabelConvertCoreInApi(t *testing.T) {
+	labelModelCore := domain.GMailLabel{ID: "Label_1", Name: "Work", Type: "user"}
+	expectedLabelModelApi := &api.OtherLabel{ID: "Label_1", Name: "Work"}
+
+	if labelModelApi := GMailLabelConvertCoreInApi(&labelModelCore); !reflect.DeepEqual(labelModelApi, expectedLabelModelApi) {
+		t.Errorf("GMailLabelConvertCoreInApi() = %v, want %v", labelModelApi, expectedLabelModelApi)
+	}
+}
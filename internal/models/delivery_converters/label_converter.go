@@ -0,0 +1,54 @@
+package delivery_converters
+
+import (
+	labelCore "mail/internal/microservice/models/domain_models"
+	labelApi "mail/internal/models/delivery_models"
+)
+
+// LabelConvertCoreInApi converts a label model from the core package to the API representation.
+func LabelConvertCoreInApi(labelModelCore labelCore.Label) *labelApi.Label {
+	return &labelApi.Label{
+		ID:        labelModelCore.ID,
+		ProfileId: labelModelCore.ProfileID,
+		Name:      labelModelCore.Name,
+		Color:     labelModelCore.Color,
+	}
+}
+
+// LabelConvertApiInCore converts a label model from the API representation to the core package.
+func LabelConvertApiInCore(labelModelApi labelApi.Label) *labelCore.Label {
+	return &labelCore.Label{
+		ID:        labelModelApi.ID,
+		ProfileID: labelModelApi.ProfileId,
+		Name:      labelModelApi.Name,
+		Color:     labelModelApi.Color,
+	}
+}
+
+// LabelsConvertCoreInApi converts a list of label models from the core package to the API representation.
+func LabelsConvertCoreInApi(labelsModelCore []*labelCore.Label) []*labelApi.Label {
+	if len(labelsModelCore) == 0 {
+		return nil
+	}
+
+	labelsModelApi := make([]*labelApi.Label, 0, len(labelsModelCore))
+	for _, l := range labelsModelCore {
+		labelsModelApi = append(labelsModelApi, LabelConvertCoreInApi(*l))
+	}
+
+	return labelsModelApi
+}
+
+// LabelsConvertApiInCore converts a list of label models from the API representation to the core package.
+func LabelsConvertApiInCore(labelsModelApi []*labelApi.Label) []*labelCore.Label {
+	if len(labelsModelApi) == 0 {
+		return nil
+	}
+
+	labelsModelCore := make([]*labelCore.Label, 0, len(labelsModelApi))
+	for _, l := range labelsModelApi {
+		labelsModelCore = append(labelsModelCore, LabelConvertApiInCore(*l))
+	}
+
+	return labelsModelCore
+}
@@ -0,0 +1,63 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+)
+
+func TestLabelConvertCoreInApi(t *testing.T) {
+	labelModelCore := domain.Label{
+		ID:        1,
+		ProfileID: 2,
+		Name:      "Work",
+		Color:     "#ff0000",
+	}
+
+	labelModelApi := LabelConvertCoreInApi(labelModelCore)
+
+	expectedLabelModelApi := &api.Label{
+		ID:        labelModelCore.ID,
+		ProfileId: labelModelCore.ProfileID,
+		Name:      labelModelCore.Name,
+		Color:     labelModelCore.Color,
+	}
+
+	if !reflect.DeepEqual(labelModelApi, expectedLabelModelApi) {
+		t.Errorf("LabelConvertCoreInApi() = %v, want %v", labelModelApi, expectedLabelModelApi)
+	}
+}
+
+func TestLabelConvertApiInCore(t *testing.T) {
+	labelModelApi := api.Label{
+		ID:        1,
+		ProfileId: 2,
+		Name:      "Work",
+		Color:     "#ff0000",
+	}
+
+	labelModelCore := LabelConvertApiInCore(labelModelApi)
+
+	expectedLabelModelCore := &domain.Label{
+		ID:        labelModelApi.ID,
+		ProfileID: labelModelApi.ProfileId,
+		Name:      labelModelApi.Name,
+		Color:     labelModelApi.Color,
+	}
+
+	if !reflect.DeepEqual(labelModelCore, expectedLabelModelCore) {
+		t.Errorf("LabelConvertApiInCore() = %v, want %v", labelModelCore, expectedLabelModelCore)
+	}
+}
+
+func TestLabelsConvertCoreInApi(t *testing.T) {
+	if labels := LabelsConvertCoreInApi(nil); labels != nil {
+		t.Errorf("LabelsConvertCoreInApi(nil) = %v, want nil", labels)
+	}
+
+	labels := LabelsConvertCoreInApi([]*domain.Label{{ID: 1, Name: "Work"}, {ID: 2, Name: "Home"}})
+	if len(labels) != 2 || labels[1].Name != "Home" {
+		t.Errorf("LabelsConvertCoreInApi() = %v", labels)
+	}
+}
@@ -0,0 +1,28 @@
+package delivery_converters
+
+import (
+	delegateCore "mail/internal/microservice/models/domain_models"
+	delegateApi "mail/internal/models/delivery_models"
+)
+
+// MailboxDelegateConvertCoreInApi converts a mailbox delegate model from the core package to the API representation.
+func MailboxDelegateConvertCoreInApi(delegateModelCore delegateCore.MailboxDelegate) *delegateApi.MailboxDelegate {
+	return &delegateApi.MailboxDelegate{
+		Mailbox:      delegateModelCore.Mailbox,
+		Delegate:     delegateModelCore.Delegate,
+		Role:         delegateModelCore.Role,
+		CreationDate: delegateModelCore.CreationDate,
+	}
+}
+
+// DelegateActionConvertCoreInApi converts a delegate action model from the core package to the API representation.
+func DelegateActionConvertCoreInApi(actionModelCore delegateCore.DelegateAction) *delegateApi.DelegateAction {
+	return &delegateApi.DelegateAction{
+		ID:           actionModelCore.ID,
+		Mailbox:      actionModelCore.Mailbox,
+		Delegate:     actionModelCore.Delegate,
+		Action:       actionModelCore.Action,
+		EmailID:      actionModelCore.EmailID,
+		CreationDate: actionModelCore.CreationDate,
+	}
+}
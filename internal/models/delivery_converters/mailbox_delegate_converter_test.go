@@ -0,0 +1,59 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMailboxDelegateConvertCoreInApi(t *testing.T) {
+	delegateModelCore := domain.MailboxDelegate{
+		MailboxID:    1,
+		DelegateID:   2,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "delegate@mailhub.su",
+		Role:         domain.DelegateRoleSendAs,
+		CreationDate: time.Now(),
+	}
+
+	delegateModelApi := MailboxDelegateConvertCoreInApi(delegateModelCore)
+
+	expectedDelegateModelApi := &api.MailboxDelegate{
+		Mailbox:      delegateModelCore.Mailbox,
+		Delegate:     delegateModelCore.Delegate,
+		Role:         delegateModelCore.Role,
+		CreationDate: delegateModelCore.CreationDate,
+	}
+
+	if !reflect.DeepEqual(delegateModelApi, expectedDelegateModelApi) {
+		t.Errorf("MailboxDelegateConvertCoreInApi() = %v, want %v", delegateModelApi, expectedDelegateModelApi)
+	}
+}
+
+func TestDelegateActionConvertCoreInApi(t *testing.T) {
+	actionModelCore := domain.DelegateAction{
+		ID:           1,
+		Mailbox:      "support@mailhub.su",
+		Delegate:     "delegate@mailhub.su",
+		Action:       domain.DelegateActionSend,
+		EmailID:      3,
+		CreationDate: time.Now(),
+	}
+
+	actionModelApi := DelegateActionConvertCoreInApi(actionModelCore)
+
+	expectedActionModelApi := &api.DelegateAction{
+		ID:           actionModelCore.ID,
+		Mailbox:      actionModelCore.Mailbox,
+		Delegate:     actionModelCore.Delegate,
+		Action:       actionModelCore.Action,
+		EmailID:      actionModelCore.EmailID,
+		CreationDate: actionModelCore.CreationDate,
+	}
+
+	if !reflect.DeepEqual(actionModelApi, expectedActionModelApi) {
+		t.Errorf("DelegateActionConvertCoreInApi() = %v, want %v", actionModelApi, expectedActionModelApi)
+	}
+}
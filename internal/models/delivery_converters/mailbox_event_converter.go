@@ -0,0 +1,26 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+// MailboxEventConvertCoreInApi converts a mailbox event from the core package to the API representation.
+func MailboxEventConvertCoreInApi(eventModelCore *domain.MailboxEvent) *api.MailboxEvent {
+	eventModelApi := &api.MailboxEvent{
+		Seq:        eventModelCore.Seq,
+		Type:       eventModelCore.Type,
+		Date:       eventModelCore.Date,
+		EmailID:    eventModelCore.EmailID,
+		ReadStatus: eventModelCore.ReadStatus,
+		FolderID:   eventModelCore.FolderID,
+		FolderName: eventModelCore.FolderName,
+		Unread:     eventModelCore.Unread,
+		SessionIDs: eventModelCore.SessionIDs,
+	}
+	if eventModelCore.Email != nil {
+		eventModelApi.Email = EmailConvertCoreInApi(*eventModelCore.Email)
+	}
+
+	return eventModelApi
+}
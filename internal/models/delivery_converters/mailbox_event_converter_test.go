@@ -0,0 +1,43 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMailboxEventConvertCoreInApi(t *testing.T) {
+	date := time.Now()
+	unread := int64(2)
+
+	eventModelCore := domain.MailboxEvent{
+		Seq:     3,
+		Type:    domain.MailboxEventNewMail,
+		Date:    date,
+		EmailID: 7,
+		Email:   &domain.Email{ID: 7, Topic: "Hi", SenderEmail: "alice@mailhub.su", RecipientEmail: "bob@mailhub.su"},
+		Unread:  &unread,
+	}
+
+	expectedEventModelApi := &api.MailboxEvent{
+		Seq:     3,
+		Type:    domain.MailboxEventNewMail,
+		Date:    date,
+		EmailID: 7,
+		Email:   &api.Email{ID: 7, Topic: "Hi", SenderEmail: "alice@mailhub.su", RecipientEmail: "bob@mailhub.su"},
+		Unread:  &unread,
+	}
+
+	if eventModelApi := MailboxEventConvertCoreInApi(&eventModelCore); !reflect.DeepEqual(eventModelApi, expectedEventModelApi) {
+		t.Errorf("MailboxEventConvertCoreInApi() = %v, want %v", eventModelApi, expectedEventModelApi)
+	}
+
+	folderEvent := domain.MailboxEvent{Seq: 4, Type: domain.MailboxEventFolderCreated, Date: date, FolderID: 2, FolderName: "Work"}
+	expectedFolderEvent := &api.MailboxEvent{Seq: 4, Type: domain.MailboxEventFolderCreated, Date: date, FolderID: 2, FolderName: "Work"}
+
+	if eventModelApi := MailboxEventConvertCoreInApi(&folderEvent); !reflect.DeepEqual(eventModelApi, expectedFolderEvent) {
+		t.Errorf("MailboxEventConvertCoreInApi() = %v, want %v", eventModelApi, expectedFolderEvent)
+	}
+}
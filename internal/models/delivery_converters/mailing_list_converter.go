@@ -0,0 +1,31 @@
+package delivery_converters
+
+import (
+	listCore "mail/internal/microservice/models/domain_models"
+	listApi "mail/internal/models/delivery_models"
+)
+
+// MailingListConvertCoreInApi converts a mailing list model from the core package to the API representation.
+func MailingListConvertCoreInApi(listModelCore listCore.MailingList) *listApi.MailingList {
+	return &listApi.MailingList{
+		ID:              listModelCore.ID,
+		Address:         listModelCore.Address,
+		Name:            listModelCore.Name,
+		Description:     listModelCore.Description,
+		PostPolicy:      listModelCore.PostPolicy,
+		Moderated:       listModelCore.Moderated,
+		CreationDate:    listModelCore.CreationDate,
+		ListID:          listModelCore.ListIDHeader(),
+		ListUnsubscribe: listModelCore.UnsubscribeURL(),
+	}
+}
+
+// MailingListMemberConvertCoreInApi converts a mailing list member model from the core package to the API representation.
+func MailingListMemberConvertCoreInApi(memberModelCore listCore.MailingListMember) *listApi.MailingListMember {
+	return &listApi.MailingListMember{
+		ListID:    memberModelCore.ListID,
+		ProfileID: memberModelCore.ProfileID,
+		Login:     memberModelCore.Login,
+		Role:      memberModelCore.Role,
+	}
+}
@@ -0,0 +1,20 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+// SecurityEventConvertCoreInApi converts a security event from the core package to the API representation.
+func SecurityEventConvertCoreInApi(eventModelCore *domain.SecurityEvent) *api.SecurityEvent {
+	return &api.SecurityEvent{
+		ID:           eventModelCore.ID,
+		Type:         eventModelCore.Type,
+		Method:       eventModelCore.Method,
+		Success:      eventModelCore.Success,
+		Details:      eventModelCore.Details,
+		IPAddress:    eventModelCore.IPAddress,
+		UserAgent:    eventModelCore.UserAgent,
+		CreationDate: eventModelCore.CreationDate,
+	}
+}
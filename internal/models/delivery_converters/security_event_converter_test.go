@@ -0,0 +1,39 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecurityEventConvertCoreInApi(t *testing.T) {
+	creationDate := time.Now()
+
+	eventModelCore := domain.SecurityEvent{
+		ID:           5,
+		ProfileID:    2,
+		Login:        "user@mailhub.su",
+		Type:         domain.SecurityEventLogin,
+		Method:       domain.SecurityEventMethodPassword,
+		Details:      "login is locked",
+		IPAddress:    "127.0.0.1",
+		UserAgent:    "Firefox",
+		CreationDate: creationDate,
+	}
+
+	expectedEventModelApi := &api.SecurityEvent{
+		ID:           5,
+		Type:         domain.SecurityEventLogin,
+		Method:       domain.SecurityEventMethodPassword,
+		Details:      "login is locked",
+		IPAddress:    "127.0.0.1",
+		UserAgent:    "Firefox",
+		CreationDate: creationDate,
+	}
+
+	if eventModelApi := SecurityEventConvertCoreInApi(&eventModelCore); !reflect.DeepEqual(eventModelApi, expectedEventModelApi) {
+		t.Errorf("SecurityEventConvertCoreInApi() = %v, want %v", eventModelApi, expectedEventModelApi)
+	}
+}
@@ -14,6 +14,8 @@ func SessionConvertCoreInApi(sessionModelCore *sessionCore.Session) *sessionApi.
 		Device:       sessionModelCore.Device,
 		LifeTime:     sessionModelCore.LifeTime,
 		CsrfToken:    sessionModelCore.CsrfToken,
+		IPAddress:    sessionModelCore.IPAddress,
+		LastSeenDate: sessionModelCore.LastSeenDate,
 	}
 }
 
@@ -26,5 +28,7 @@ func SessionConvertApiInCore(sessionModelApi *sessionApi.Session) *sessionCore.S
 		Device:       sessionModelApi.Device,
 		LifeTime:     sessionModelApi.LifeTime,
 		CsrfToken:    sessionModelApi.CsrfToken,
+		IPAddress:    sessionModelApi.IPAddress,
+		LastSeenDate: sessionModelApi.LastSeenDate,
 	}
 }
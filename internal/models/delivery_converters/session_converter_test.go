@@ -16,6 +16,8 @@ func TestSessionConvertCoreInApi(t *testing.T) {
 		Device:       "desktop",
 		LifeTime:     3600,
 		CsrfToken:    "csrf_token",
+		IPAddress:    "127.0.0.1",
+		LastSeenDate: time.Now(),
 	}
 
 	sessionModelApi := SessionConvertCoreInApi(&sessionModelCore)
@@ -27,6 +29,8 @@ func TestSessionConvertCoreInApi(t *testing.T) {
 		Device:       sessionModelCore.Device,
 		LifeTime:     sessionModelCore.LifeTime,
 		CsrfToken:    sessionModelCore.CsrfToken,
+		IPAddress:    sessionModelCore.IPAddress,
+		LastSeenDate: sessionModelCore.LastSeenDate,
 	}
 
 	if !reflect.DeepEqual(sessionModelApi, expectedSessionModelApi) {
@@ -42,6 +46,8 @@ func TestSessionConvertApiInCore(t *testing.T) {
 		Device:       "desktop",
 		LifeTime:     3600,
 		CsrfToken:    "csrf_token",
+		IPAddress:    "127.0.0.1",
+		LastSeenDate: time.Now(),
 	}
 
 	sessionModelCore := SessionConvertApiInCore(&sessionModelApi)
@@ -53,6 +59,8 @@ func TestSessionConvertApiInCore(t *testing.T) {
 		Device:       sessionModelApi.Device,
 		LifeTime:     sessionModelApi.LifeTime,
 		CsrfToken:    sessionModelApi.CsrfToken,
+		IPAddress:    sessionModelApi.IPAddress,
+		LastSeenDate: sessionModelApi.LastSeenDate,
 	}
 
 	if !reflect.DeepEqual(sessionModelCore, expectedSessionModelCore) {
@@ -0,0 +1,42 @@
+package delivery_converters
+
+import (
+	"strconv"
+
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+// EmailConvertCoreInUnifiedApi converts an email of the MailHub mailbox to the unified inbox representation.
+func EmailConvertCoreInUnifiedApi(emailModelCore domain.Email, account string) *api.UnifiedEmail {
+	return &api.UnifiedEmail{
+		ID:             strconv.FormatUint(emailModelCore.ID, 10),
+		Source:         domain.UnifiedSourceMailHub,
+		Account:        account,
+		Topic:          emailModelCore.Topic,
+		Text:           emailModelCore.Text,
+		ReadStatus:     emailModelCore.ReadStatus,
+		Flag:           emailModelCore.Flag,
+		SpamStatus:     emailModelCore.SpamStatus,
+		DateOfDispatch: emailModelCore.DateOfDispatch,
+		SenderEmail:    emailModelCore.SenderEmail,
+		RecipientEmail: emailModelCore.RecipientEmail,
+	}
+}
+
+// GMailMessageConvertCoreInUnifiedApi converts an email of the local copy of the Gmail mailbox to the unified inbox representation.
+func GMailMessageConvertCoreInUnifiedApi(messageModelCore *domain.GMailMessage, account string) *api.UnifiedEmail {
+	return &api.UnifiedEmail{
+		ID:             messageModelCore.ID,
+		Source:         domain.UnifiedSourceGMail,
+		Account:        account,
+		Topic:          messageModelCore.Subject,
+		Text:           messageModelCore.Text,
+		ReadStatus:     !messageModelCore.HasLabel(domain.GMailLabelUnread),
+		Flag:           messageModelCore.HasLabel(domain.GMailLabelImportant),
+		SpamStatus:     messageModelCore.HasLabel(domain.GMailLabelSpam),
+		DateOfDispatch: messageModelCore.Date,
+		SenderEmail:    messageModelCore.Sender,
+		RecipientEmail: messageModelCore.Recipient,
+	}
+}
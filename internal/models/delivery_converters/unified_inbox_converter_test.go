@@ -0,0 +1,68 @@
+package delivery_converters
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEmailConvertCoreInUnifiedApi(t *testing.T) {
+	date := time.Now()
+
+	emailModelCore := domain.Email{
+		ID:             12,
+		Topic:          "Hello",
+		Text:           "Hi there",
+		ReadStatus:     true,
+		DateOfDispatch: date,
+		SenderEmail:    "friend@mailhub.su",
+		RecipientEmail: "user@gmail.com",
+	}
+
+	expectedEmailModelApi := &api.UnifiedEmail{
+		ID:             "12",
+		Source:         domain.UnifiedSourceMailHub,
+		Account:        "user@gmail.com",
+		Topic:          "Hello",
+		Text:           "Hi there",
+		ReadStatus:     true,
+		DateOfDispatch: date,
+		SenderEmail:    "friend@mailhub.su",
+		RecipientEmail: "user@gmail.com",
+	}
+
+	if emailModelApi := EmailConvertCoreInUnifiedApi(emailModelCore, "user@gmail.com"); !reflect.DeepEqual(emailModelApi, expectedEmailModelApi) {
+		t.Errorf("EmailConvertCoreInUnifiedApi() = %v, want %v", emailModelApi, expectedEmailModelApi)
+	}
+}
+
+func TestGMailMessageConvertCoreInUnifiedApi(t *testing.T) {
+	date := time.Now()
+
+	messageModelCore := domain.GMailMessage{
+		ID:        "18c1",
+		LabelIDs:  []string{domain.GMailLabelInbox, domain.GMailLabelUnread},
+		Subject:   "Hello",
+		Sender:    "friend@gmail.com",
+		Recipient: "user@gmail.com",
+		Text:      "Hi there",
+		Date:      date,
+	}
+
+	expectedEmailModelApi := &api.UnifiedEmail{
+		ID:             "18c1",
+		Source:         domain.UnifiedSourceGMail,
+		Account:        "user@gmail.com",
+		Topic:          "Hello",
+		Text:           "Hi there",
+		DateOfDispatch: date,
+		SenderEmail:    "friend@gmail.com",
+		RecipientEmail: "user@gmail.com",
+	}
+
+	if emailModelApi := GMailMessageConvertCoreInUnifiedApi(&messageModelCore, "user@gmail.com"); !reflect.DeepEqual(emailModelApi, expectedEmailModelApi) {
+		t.Errorf("GMailMessageConvertCoreInUnifiedApi() = %v, want %v", emailModelApi, expectedEmailModelApi)
+	}
+}
@@ -0,0 +1,28 @@
+package delivery_models
+
+import "time"
+
+// APIToken represents a personal access token shown to its owner, the token itself is shown only once.
+type APIToken struct {
+	ID             uint32     `json:"id"`                     // ID is the unique identifier of the token.
+	Name           string     `json:"name"`                   // Name is the description given by the user.
+	Scopes         []string   `json:"scopes"`                 // Scopes are the actions allowed with the token.
+	CreationDate   time.Time  `json:"creationDate"`           // CreationDate is the date when the token was created.
+	ExpirationDate time.Time  `json:"expirationDate"`         // ExpirationDate is the date after which the token is no longer valid.
+	LastUsedDate   *time.Time `json:"lastUsedDate,omitempty"` // LastUsedDate is the date of the last request made with the token.
+	Token          string     `json:"token,omitempty"`        // Token is the token itself, set only in the response to its creation.
+}
+
+// APITokenCreate represents a request to create a personal access token.
+type APITokenCreate struct {
+	Name         string   `json:"name"`         // Name is the description of the token.
+	Scopes       []string `json:"scopes"`       // Scopes are the actions to allow with the token.
+	LifeTimeDays int      `json:"lifeTimeDays"` // LifeTimeDays is the number of days the token stays valid.
+}
+
+// APITokenOwner represents the user a request made with a personal access token acts for.
+type APITokenOwner struct {
+	UserID uint32   // UserID is the unique identifier of the owner of the token.
+	Login  string   // Login is the login of the owner of the token.
+	Scopes []string // Scopes are the actions allowed with the token.
+}
@@ -0,0 +1,16 @@
+package delivery_models
+
+// BulkRequest represents an action applied to a batch of emails.
+type BulkRequest struct {
+	Action   string   `json:"action"`             // Action is one of read, unread, flag, unflag, spam, not_spam, delete, move or label.
+	EmailIDs []uint64 `json:"emailIds"`           // EmailIDs are the unique identifiers of the emails of the batch.
+	FolderID uint32   `json:"folderId,omitempty"` // FolderID is the target folder of the move action.
+	LabelID  uint32   `json:"labelId,omitempty"`  // LabelID is the label put on the emails by the label action.
+}
+
+// BulkResult represents the outcome of a bulk operation for a single email.
+type BulkResult struct {
+	EmailID uint64 `json:"emailId"`         // EmailID is the unique identifier of the email.
+	Success bool   `json:"success"`         // Success indicates whether the action was applied to the email.
+	Error   string `json:"error,omitempty"` // Error describes why the action was not applied.
+}
@@ -0,0 +1,218 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson96d41fe8DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *BulkResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "emailId":
+			out.EmailID = uint64(in.Uint64())
+		case "success":
+			out.Success = bool(in.Bool())
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson96d41fe8EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in BulkResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"emailId\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.EmailID))
+	}
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Success))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BulkResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson96d41fe8EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BulkResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson96d41fe8EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BulkResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson96d41fe8DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BulkResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson96d41fe8DecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjson96d41fe8DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *BulkRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "emailIds":
+			if in.IsNull() {
+				in.Skip()
+				out.EmailIDs = nil
+			} else {
+				in.Delim('[')
+				if out.EmailIDs == nil {
+					if !in.IsDelim(']') {
+						out.EmailIDs = make([]uint64, 0, 8)
+					} else {
+						out.EmailIDs = []uint64{}
+					}
+				} else {
+					out.EmailIDs = (out.EmailIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uint64
+					v1 = uint64(in.Uint64())
+					out.EmailIDs = append(out.EmailIDs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "folderId":
+			out.FolderID = uint32(in.Uint32())
+		case "labelId":
+			out.LabelID = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson96d41fe8EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in BulkRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"emailIds\":"
+		out.RawString(prefix)
+		if in.EmailIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.EmailIDs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.FolderID != 0 {
+		const prefix string = ",\"folderId\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.FolderID))
+	}
+	if in.LabelID != 0 {
+		const prefix string = ",\"labelId\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.LabelID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BulkRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson96d41fe8EncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BulkRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson96d41fe8EncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BulkRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson96d41fe8DecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BulkRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson96d41fe8DecodeMailInternalModelsDeliveryModels1(l, v)
+}
@@ -64,6 +64,43 @@ func easyjsonFc263e4eDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, ou
 			out.SenderEmail = string(in.String())
 		case "recipientEmail":
 			out.RecipientEmail = string(in.String())
+		case "listId":
+			out.ListID = string(in.String())
+		case "listUnsubscribe":
+			out.ListUnsubscribe = string(in.String())
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]*Label, 0, 8)
+					} else {
+						out.Labels = []*Label{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *Label
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(Label)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Labels = append(out.Labels, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sentBy":
+			out.SentByEmail = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -149,6 +186,39 @@ func easyjsonFc263e4eEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.RecipientEmail))
 	}
+	if in.ListID != "" {
+		const prefix string = ",\"listId\":"
+		out.RawString(prefix)
+		out.String(string(in.ListID))
+	}
+	if in.ListUnsubscribe != "" {
+		const prefix string = ",\"listUnsubscribe\":"
+		out.RawString(prefix)
+		out.String(string(in.ListUnsubscribe))
+	}
+	if len(in.Labels) != 0 {
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Labels {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.SentByEmail != "" {
+		const prefix string = ",\"sentBy\":"
+		out.RawString(prefix)
+		out.String(string(in.SentByEmail))
+	}
 	out.RawByte('}')
 }
 
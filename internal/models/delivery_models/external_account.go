@@ -0,0 +1,30 @@
+package delivery_models
+
+import "time"
+
+// ExternalAccount represents a mailbox of another mail provider linked by the user, the password is never shown.
+type ExternalAccount struct {
+	ID           uint32    `json:"id"`           // ID is the unique identifier of the linked mailbox.
+	Email        string    `json:"email"`        // Email is the address of the mailbox.
+	IMAPHost     string    `json:"imapHost"`     // IMAPHost is the host name of the IMAP server.
+	IMAPPort     uint32    `json:"imapPort"`     // IMAPPort is the port of the IMAP server.
+	IMAPTLSMode  string    `json:"imapTlsMode"`  // IMAPTLSMode is how the connection to the IMAP server is secured: tls, starttls or none.
+	SMTPHost     string    `json:"smtpHost"`     // SMTPHost is the host name of the SMTP server.
+	SMTPPort     uint32    `json:"smtpPort"`     // SMTPPort is the port of the SMTP server.
+	SMTPTLSMode  string    `json:"smtpTlsMode"`  // SMTPTLSMode is how the connection to the SMTP server is secured: tls, starttls or none.
+	Username     string    `json:"username"`     // Username is the name the user signs in to the mail servers with.
+	CreationDate time.Time `json:"creationDate"` // CreationDate is the date when the mailbox was linked.
+}
+
+// ExternalAccountLink represents a request to link a mailbox of another mail provider.
+type ExternalAccountLink struct {
+	Email       string `json:"email"`       // Email is the address of the mailbox.
+	IMAPHost    string `json:"imapHost"`    // IMAPHost is the host name of the IMAP server.
+	IMAPPort    uint32 `json:"imapPort"`    // IMAPPort is the port of the IMAP server.
+	IMAPTLSMode string `json:"imapTlsMode"` // IMAPTLSMode is how the connection to the IMAP server is secured: tls, starttls or none.
+	SMTPHost    string `json:"smtpHost"`    // SMTPHost is the host name of the SMTP server.
+	SMTPPort    uint32 `json:"smtpPort"`    // SMTPPort is the port of the SMTP server.
+	SMTPTLSMode string `json:"smtpTlsMode"` // SMTPTLSMode is how the connection to the SMTP server is secured: tls, starttls or none.
+	Username    string `json:"username"`    // Username is the name to sign in to the mail servers with, the email if empty.
+	Password    string `json:"password"`    // Password is the password of the mail servers, often an application password.
+}
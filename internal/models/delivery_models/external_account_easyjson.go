@@ -0,0 +1,272 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6bbe6cc1DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *ExternalAccountLink) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		case "imapHost":
+			out.IMAPHost = string(in.String())
+		case "imapPort":
+			out.IMAPPort = uint32(in.Uint32())
+		case "imapTlsMode":
+			out.IMAPTLSMode = string(in.String())
+		case "smtpHost":
+			out.SMTPHost = string(in.String())
+		case "smtpPort":
+			out.SMTPPort = uint32(in.Uint32())
+		case "smtpTlsMode":
+			out.SMTPTLSMode = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6bbe6cc1EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in ExternalAccountLink) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"imapHost\":"
+		out.RawString(prefix)
+		out.String(string(in.IMAPHost))
+	}
+	{
+		const prefix string = ",\"imapPort\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.IMAPPort))
+	}
+	{
+		const prefix string = ",\"imapTlsMode\":"
+		out.RawString(prefix)
+		out.String(string(in.IMAPTLSMode))
+	}
+	{
+		const prefix string = ",\"smtpHost\":"
+		out.RawString(prefix)
+		out.String(string(in.SMTPHost))
+	}
+	{
+		const prefix string = ",\"smtpPort\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.SMTPPort))
+	}
+	{
+		const prefix string = ",\"smtpTlsMode\":"
+		out.RawString(prefix)
+		out.String(string(in.SMTPTLSMode))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExternalAccountLink) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6bbe6cc1EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExternalAccountLink) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6bbe6cc1EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExternalAccountLink) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6bbe6cc1DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExternalAccountLink) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6bbe6cc1DecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjson6bbe6cc1DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *ExternalAccount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "email":
+			out.Email = string(in.String())
+		case "imapHost":
+			out.IMAPHost = string(in.String())
+		case "imapPort":
+			out.IMAPPort = uint32(in.Uint32())
+		case "imapTlsMode":
+			out.IMAPTLSMode = string(in.String())
+		case "smtpHost":
+			out.SMTPHost = string(in.String())
+		case "smtpPort":
+			out.SMTPPort = uint32(in.Uint32())
+		case "smtpTlsMode":
+			out.SMTPTLSMode = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		case "creationDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreationDate).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6bbe6cc1EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in ExternalAccount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"imapHost\":"
+		out.RawString(prefix)
+		out.String(string(in.IMAPHost))
+	}
+	{
+		const prefix string = ",\"imapPort\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.IMAPPort))
+	}
+	{
+		const prefix string = ",\"imapTlsMode\":"
+		out.RawString(prefix)
+		out.String(string(in.IMAPTLSMode))
+	}
+	{
+		const prefix string = ",\"smtpHost\":"
+		out.RawString(prefix)
+		out.String(string(in.SMTPHost))
+	}
+	{
+		const prefix string = ",\"smtpPort\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.SMTPPort))
+	}
+	{
+		const prefix string = ",\"smtpTlsMode\":"
+		out.RawString(prefix)
+		out.String(string(in.SMTPTLSMode))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"creationDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CreationDate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExternalAccount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6bbe6cc1EncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExternalAccount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6bbe6cc1EncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExternalAccount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6bbe6cc1DecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExternalAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6bbe6cc1DecodeMailInternalModelsDeliveryModels1(l, v)
+}
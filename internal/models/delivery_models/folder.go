@@ -5,6 +5,10 @@ type Folder struct {
 	ID        uint32 `json:"id,omitempty"`        // ID he unique ID of the folder in the database.
 	ProfileId uint32 `json:"profileId,omitempty"` // ProfileId the unique identifier of the user who owns the folder.
 	Name      string `json:"name"`                // Name the name of the folder.
+	ParentID  uint32 `json:"parentId,omitempty"`  // ParentID the unique ID of the parent folder.
+	Path      string `json:"path,omitempty"`      // Path the full path of the folder.
+	Total     uint32 `json:"total"`               // Total the number of emails in the folder.
+	Unread    uint32 `json:"unread"`              // Unread the number of unread emails in the folder.
 }
 
 // FolderEmail represents the information about an folderID and emailID.
@@ -12,3 +16,10 @@ type FolderEmail struct {
 	FolderID uint32 `json:"folderId"` // FolderID he unique ID of the folder in the database.
 	EmailID  uint32 `json:"emailId"`  // EmailID he unique ID of the email in the database.
 }
+
+// MoveEmail represents the information about moving an email between folders.
+type MoveEmail struct {
+	EmailID      uint32 `json:"emailId"`      // EmailID the unique ID of the email in the database.
+	FromFolderID uint32 `json:"fromFolderId"` // FromFolderID the unique ID of the source folder.
+	ToFolderID   uint32 `json:"toFolderId"`   // ToFolderID the unique ID of the target folder.
+}
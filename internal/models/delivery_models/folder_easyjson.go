@@ -17,7 +17,87 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson408d1214DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *FolderEmail) {
+func easyjson408d1214DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *MoveEmail) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "emailId":
+			out.EmailID = uint32(in.Uint32())
+		case "fromFolderId":
+			out.FromFolderID = uint32(in.Uint32())
+		case "toFolderId":
+			out.ToFolderID = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson408d1214EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in MoveEmail) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"emailId\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.EmailID))
+	}
+	{
+		const prefix string = ",\"fromFolderId\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.FromFolderID))
+	}
+	{
+		const prefix string = ",\"toFolderId\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ToFolderID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MoveEmail) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson408d1214EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MoveEmail) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson408d1214EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MoveEmail) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson408d1214DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MoveEmail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson408d1214DecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjson408d1214DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *FolderEmail) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -50,7 +130,7 @@ func easyjson408d1214DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson408d1214EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in FolderEmail) {
+func easyjson408d1214EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in FolderEmail) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -70,27 +150,27 @@ func easyjson408d1214EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v FolderEmail) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson408d1214EncodeMailInternalModelsDeliveryModels(&w, v)
+	easyjson408d1214EncodeMailInternalModelsDeliveryModels1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FolderEmail) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson408d1214EncodeMailInternalModelsDeliveryModels(w, v)
+	easyjson408d1214EncodeMailInternalModelsDeliveryModels1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FolderEmail) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson408d1214DecodeMailInternalModelsDeliveryModels(&r, v)
+	easyjson408d1214DecodeMailInternalModelsDeliveryModels1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FolderEmail) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson408d1214DecodeMailInternalModelsDeliveryModels(l, v)
+	easyjson408d1214DecodeMailInternalModelsDeliveryModels1(l, v)
 }
-func easyjson408d1214DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *Folder) {
+func easyjson408d1214DecodeMailInternalModelsDeliveryModels2(in *jlexer.Lexer, out *Folder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -115,6 +195,14 @@ func easyjson408d1214DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, o
 			out.ProfileId = uint32(in.Uint32())
 		case "name":
 			out.Name = string(in.String())
+		case "parentId":
+			out.ParentID = uint32(in.Uint32())
+		case "path":
+			out.Path = string(in.String())
+		case "total":
+			out.Total = uint32(in.Uint32())
+		case "unread":
+			out.Unread = uint32(in.Uint32())
 		default:
 			in.SkipRecursive()
 		}
@@ -125,7 +213,7 @@ func easyjson408d1214DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjson408d1214EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in Folder) {
+func easyjson408d1214EncodeMailInternalModelsDeliveryModels2(out *jwriter.Writer, in Folder) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -155,29 +243,49 @@ func easyjson408d1214EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer
 		}
 		out.String(string(in.Name))
 	}
+	if in.ParentID != 0 {
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ParentID))
+	}
+	if in.Path != "" {
+		const prefix string = ",\"path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Total))
+	}
+	{
+		const prefix string = ",\"unread\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Unread))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Folder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson408d1214EncodeMailInternalModelsDeliveryModels1(&w, v)
+	easyjson408d1214EncodeMailInternalModelsDeliveryModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Folder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson408d1214EncodeMailInternalModelsDeliveryModels1(w, v)
+	easyjson408d1214EncodeMailInternalModelsDeliveryModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Folder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson408d1214DecodeMailInternalModelsDeliveryModels1(&r, v)
+	easyjson408d1214DecodeMailInternalModelsDeliveryModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Folder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson408d1214DecodeMailInternalModelsDeliveryModels1(l, v)
+	easyjson408d1214DecodeMailInternalModelsDeliveryModels2(l, v)
 }
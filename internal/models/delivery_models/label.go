@@ -0,0 +1,16 @@
+package delivery_models
+
+// Label represents the information about a local label of the user.
+type Label struct {
+	ID        uint32 `json:"id,omitempty"`        // ID is the unique identifier of the label in the database.
+	ProfileId uint32 `json:"profileId,omitempty"` // ProfileId is the unique identifier of the user who owns the label.
+	Name      string `json:"name"`                // Name is the name of the label.
+	Color     string `json:"color"`               // Color is the color of the label in #rrggbb format.
+}
+
+// LabelEmails represents the information about a label and one or several emails it is put on or removed from.
+type LabelEmails struct {
+	LabelID  uint32   `json:"folderId"`           // LabelID is the unique identifier of the label, named as in the Gmail label API.
+	EmailID  uint64   `json:"emailId,omitempty"`  // EmailID is the unique identifier of a single email.
+	EmailIDs []uint64 `json:"emailIds,omitempty"` // EmailIDs are the unique identifiers of a batch of emails.
+}
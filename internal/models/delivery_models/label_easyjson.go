@@ -0,0 +1,227 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD9818010DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *LabelEmails) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "folderId":
+			out.LabelID = uint32(in.Uint32())
+		case "emailId":
+			out.EmailID = uint64(in.Uint64())
+		case "emailIds":
+			if in.IsNull() {
+				in.Skip()
+				out.EmailIDs = nil
+			} else {
+				in.Delim('[')
+				if out.EmailIDs == nil {
+					if !in.IsDelim(']') {
+						out.EmailIDs = make([]uint64, 0, 8)
+					} else {
+						out.EmailIDs = []uint64{}
+					}
+				} else {
+					out.EmailIDs = (out.EmailIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uint64
+					v1 = uint64(in.Uint64())
+					out.EmailIDs = append(out.EmailIDs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD9818010EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in LabelEmails) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"folderId\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.LabelID))
+	}
+	if in.EmailID != 0 {
+		const prefix string = ",\"emailId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.EmailID))
+	}
+	if len(in.EmailIDs) != 0 {
+		const prefix string = ",\"emailIds\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.EmailIDs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LabelEmails) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD9818010EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LabelEmails) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD9818010EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LabelEmails) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD9818010DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LabelEmails) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD9818010DecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjsonD9818010DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *Label) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "profileId":
+			out.ProfileId = uint32(in.Uint32())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD9818010EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in Label) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	if in.ProfileId != 0 {
+		const prefix string = ",\"profileId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint32(uint32(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Label) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD9818010EncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Label) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD9818010EncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Label) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD9818010DecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Label) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD9818010DecodeMailInternalModelsDeliveryModels1(l, v)
+}
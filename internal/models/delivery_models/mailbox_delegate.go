@@ -0,0 +1,21 @@
+package delivery_models
+
+import "time"
+
+// MailboxDelegate represents the information about a profile that has access to a shared mailbox.
+type MailboxDelegate struct {
+	Mailbox      string    `json:"mailbox"`                // Mailbox is the login of the shared mailbox, e.g. support@mailhub.su.
+	Delegate     string    `json:"delegate"`               // Delegate is the login of the delegate profile.
+	Role         string    `json:"role,omitempty"`         // Role is the role of the delegate: read, send_as, send_on_behalf or manage.
+	CreationDate time.Time `json:"creationDate,omitempty"` // CreationDate is the date when the access was granted.
+}
+
+// DelegateAction represents an action a delegate performed in a shared mailbox.
+type DelegateAction struct {
+	ID           uint64    `json:"id"`                // ID is the unique identifier of the action.
+	Mailbox      string    `json:"mailbox"`           // Mailbox is the login of the shared mailbox.
+	Delegate     string    `json:"delegate"`          // Delegate is the login of the delegate who performed the action.
+	Action       string    `json:"action"`            // Action is the performed action, e.g. send or delete.
+	EmailID      uint64    `json:"emailId,omitempty"` // EmailID is the identifier of the email the action was performed on, if any.
+	CreationDate time.Time `json:"creationDate"`      // CreationDate is the date when the action was performed.
+}
@@ -0,0 +1,211 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE8f4f1beDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *MailboxDelegate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mailbox":
+			out.Mailbox = string(in.String())
+		case "delegate":
+			out.Delegate = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "creationDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreationDate).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE8f4f1beEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in MailboxDelegate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mailbox\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Mailbox))
+	}
+	{
+		const prefix string = ",\"delegate\":"
+		out.RawString(prefix)
+		out.String(string(in.Delegate))
+	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	if true {
+		const prefix string = ",\"creationDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CreationDate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MailboxDelegate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE8f4f1beEncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MailboxDelegate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE8f4f1beEncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MailboxDelegate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE8f4f1beDecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MailboxDelegate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE8f4f1beDecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjsonE8f4f1beDecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *DelegateAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "mailbox":
+			out.Mailbox = string(in.String())
+		case "delegate":
+			out.Delegate = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		case "emailId":
+			out.EmailID = uint64(in.Uint64())
+		case "creationDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreationDate).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE8f4f1beEncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in DelegateAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"mailbox\":"
+		out.RawString(prefix)
+		out.String(string(in.Mailbox))
+	}
+	{
+		const prefix string = ",\"delegate\":"
+		out.RawString(prefix)
+		out.String(string(in.Delegate))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	if in.EmailID != 0 {
+		const prefix string = ",\"emailId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.EmailID))
+	}
+	{
+		const prefix string = ",\"creationDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CreationDate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DelegateAction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE8f4f1beEncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DelegateAction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE8f4f1beEncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DelegateAction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE8f4f1beDecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DelegateAction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE8f4f1beDecodeMailInternalModelsDeliveryModels1(l, v)
+}
@@ -0,0 +1,17 @@
+package delivery_models
+
+import "time"
+
+// MailboxEvent represents a change of the mailbox pushed to the connected clients of its owner.
+type MailboxEvent struct {
+	Seq        uint64    `json:"seq"`                  // Seq is the sequence number of the event, later events of the user have greater numbers.
+	Type       string    `json:"type"`                 // Type is the kind of the change, e.g. new_mail or unread_count.
+	Date       time.Time `json:"date"`                 // Date is the date of the change.
+	EmailID    uint64    `json:"emailId,omitempty"`    // EmailID is the identifier of the changed email.
+	Email      *Email    `json:"email,omitempty"`      // Email is the new email or the saved draft.
+	ReadStatus *bool     `json:"readStatus,omitempty"` // ReadStatus is the new read status of the email.
+	FolderID   uint32    `json:"folderId,omitempty"`   // FolderID is the identifier of the created or renamed folder, or of the folder the email was moved to.
+	FolderName string    `json:"folderName,omitempty"` // FolderName is the name of the created or renamed folder.
+	Unread     *int64    `json:"unread,omitempty"`     // Unread is the number of the unread incoming emails.
+	SessionIDs []string  `json:"sessionIds,omitempty"` // SessionIDs are the public identifiers of the revoked sessions.
+}
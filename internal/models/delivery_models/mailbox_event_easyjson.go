@@ -0,0 +1,167 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson740ec737DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *MailboxEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "seq":
+			out.Seq = uint64(in.Uint64())
+		case "type":
+			out.Type = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "emailId":
+			out.EmailID = uint64(in.Uint64())
+		case "email":
+			if in.IsNull() {
+				in.Skip()
+				out.Email = nil
+			} else {
+				if out.Email == nil {
+					out.Email = new(Email)
+				}
+				(*out.Email).UnmarshalEasyJSON(in)
+			}
+		case "readStatus":
+			if in.IsNull() {
+				in.Skip()
+				out.ReadStatus = nil
+			} else {
+				if out.ReadStatus == nil {
+					out.ReadStatus = new(bool)
+				}
+				*out.ReadStatus = bool(in.Bool())
+			}
+		case "folderId":
+			out.FolderID = uint32(in.Uint32())
+		case "folderName":
+			out.FolderName = string(in.String())
+		case "unread":
+			if in.IsNull() {
+				in.Skip()
+				out.Unread = nil
+			} else {
+				if out.Unread == nil {
+					out.Unread = new(int64)
+				}
+				*out.Unread = int64(in.Int64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson740ec737EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in MailboxEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"seq\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Seq))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	if in.EmailID != 0 {
+		const prefix string = ",\"emailId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.EmailID))
+	}
+	if in.Email != nil {
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		(*in.Email).MarshalEasyJSON(out)
+	}
+	if in.ReadStatus != nil {
+		const prefix string = ",\"readStatus\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.ReadStatus))
+	}
+	if in.FolderID != 0 {
+		const prefix string = ",\"folderId\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.FolderID))
+	}
+	if in.FolderName != "" {
+		const prefix string = ",\"folderName\":"
+		out.RawString(prefix)
+		out.String(string(in.FolderName))
+	}
+	if in.Unread != nil {
+		const prefix string = ",\"unread\":"
+		out.RawString(prefix)
+		out.Int64(int64(*in.Unread))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MailboxEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson740ec737EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MailboxEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson740ec737EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MailboxEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson740ec737DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MailboxEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson740ec737DecodeMailInternalModelsDeliveryModels(l, v)
+}
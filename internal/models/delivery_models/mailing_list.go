@@ -0,0 +1,30 @@
+package delivery_models
+
+import "time"
+
+// MailingList represents the information about a distribution list.
+type MailingList struct {
+	ID              uint32    `json:"id,omitempty"`              // ID is the unique identifier of the list in the database.
+	Address         string    `json:"address"`                   // Address is the group address of the list, e.g. team@mailhub.su.
+	Name            string    `json:"name"`                      // Name is the display name of the list.
+	Description     string    `json:"description"`               // Description is an optional description of the list.
+	PostPolicy      string    `json:"postPolicy"`                // PostPolicy determines who can post to the list: anyone, members or owners.
+	Moderated       bool      `json:"moderated"`                 // Moderated indicates whether posts from non-owners are held for approval.
+	CreationDate    time.Time `json:"creationDate,omitempty"`    // CreationDate is the date when the list was created.
+	ListID          string    `json:"listId,omitempty"`          // ListID is the List-Id header of the list.
+	ListUnsubscribe string    `json:"listUnsubscribe,omitempty"` // ListUnsubscribe is the endpoint the signed in members use to leave the list.
+}
+
+// MailingListMember represents the information about a member of a distribution list.
+type MailingListMember struct {
+	ListID    uint32 `json:"listId,omitempty"`    // ListID is the unique identifier of the list.
+	ProfileID uint32 `json:"profileId,omitempty"` // ProfileID is the unique identifier of the member profile.
+	Login     string `json:"login"`               // Login is the login of the member profile.
+	Role      string `json:"role,omitempty"`      // Role is the role of the member in the list: owner or member.
+}
+
+// MailingListModeration represents the decision of a list owner about a held email.
+type MailingListModeration struct {
+	EmailID uint64 `json:"emailId"` // EmailID is the unique identifier of the held email.
+	Approve bool   `json:"approve"` // Approve indicates whether the email should be delivered to the list.
+}
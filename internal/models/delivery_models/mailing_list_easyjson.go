@@ -0,0 +1,320 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson15e7415aDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *MailingListModeration) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "emailId":
+			out.EmailID = uint64(in.Uint64())
+		case "approve":
+			out.Approve = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15e7415aEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in MailingListModeration) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"emailId\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.EmailID))
+	}
+	{
+		const prefix string = ",\"approve\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approve))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MailingListModeration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15e7415aEncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MailingListModeration) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15e7415aEncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MailingListModeration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15e7415aDecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MailingListModeration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15e7415aDecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjson15e7415aDecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *MailingListMember) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "listId":
+			out.ListID = uint32(in.Uint32())
+		case "profileId":
+			out.ProfileID = uint32(in.Uint32())
+		case "login":
+			out.Login = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15e7415aEncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in MailingListMember) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ListID != 0 {
+		const prefix string = ",\"listId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ListID))
+	}
+	if in.ProfileID != 0 {
+		const prefix string = ",\"profileId\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint32(uint32(in.ProfileID))
+	}
+	{
+		const prefix string = ",\"login\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Login))
+	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MailingListMember) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15e7415aEncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MailingListMember) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15e7415aEncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MailingListMember) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15e7415aDecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MailingListMember) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15e7415aDecodeMailInternalModelsDeliveryModels1(l, v)
+}
+func easyjson15e7415aDecodeMailInternalModelsDeliveryModels2(in *jlexer.Lexer, out *MailingList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "address":
+			out.Address = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "postPolicy":
+			out.PostPolicy = string(in.String())
+		case "moderated":
+			out.Moderated = bool(in.Bool())
+		case "creationDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreationDate).UnmarshalJSON(data))
+			}
+		case "listId":
+			out.ListID = string(in.String())
+		case "listUnsubscribe":
+			out.ListUnsubscribe = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson15e7415aEncodeMailInternalModelsDeliveryModels2(out *jwriter.Writer, in MailingList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"address\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"postPolicy\":"
+		out.RawString(prefix)
+		out.String(string(in.PostPolicy))
+	}
+	{
+		const prefix string = ",\"moderated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Moderated))
+	}
+	if true {
+		const prefix string = ",\"creationDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CreationDate).MarshalJSON())
+	}
+	if in.ListID != "" {
+		const prefix string = ",\"listId\":"
+		out.RawString(prefix)
+		out.String(string(in.ListID))
+	}
+	if in.ListUnsubscribe != "" {
+		const prefix string = ",\"listUnsubscribe\":"
+		out.RawString(prefix)
+		out.String(string(in.ListUnsubscribe))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MailingList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson15e7415aEncodeMailInternalModelsDeliveryModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MailingList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson15e7415aEncodeMailInternalModelsDeliveryModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MailingList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson15e7415aDecodeMailInternalModelsDeliveryModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MailingList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson15e7415aDecodeMailInternalModelsDeliveryModels2(l, v)
+}
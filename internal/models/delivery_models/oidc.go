@@ -0,0 +1,58 @@
+package delivery_models
+
+import "mail/internal/pkg/utils/jwt"
+
+// OIDCDiscovery represents the OpenID Connect discovery document describing the provider to the clients.
+type OIDCDiscovery struct {
+	Issuer                            string   `json:"issuer"`                                // Issuer is the address of the provider.
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`                // AuthorizationEndpoint is the address users are sent to sign in.
+	TokenEndpoint                     string   `json:"token_endpoint"`                        // TokenEndpoint is the address codes are exchanged at.
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`                     // UserInfoEndpoint is the address the claims about the user are read at.
+	JWKSURI                           string   `json:"jwks_uri"`                              // JWKSURI is the address of the keys the tokens are signed with.
+	ScopesSupported                   []string `json:"scopes_supported"`                      // ScopesSupported are the scopes clients can request.
+	ResponseTypesSupported            []string `json:"response_types_supported"`              // ResponseTypesSupported are the supported flows.
+	GrantTypesSupported               []string `json:"grant_types_supported"`                 // GrantTypesSupported are the grants accepted at the token endpoint.
+	SubjectTypesSupported             []string `json:"subject_types_supported"`               // SubjectTypesSupported are the kinds of user identifiers given.
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"` // IDTokenSigningAlgValuesSupported are the algorithms the ID tokens are signed with.
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"` // TokenEndpointAuthMethodsSupported are the ways clients authenticate.
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`      // CodeChallengeMethodsSupported are the accepted PKCE methods.
+	ClaimsSupported                   []string `json:"claims_supported"`                      // ClaimsSupported are the claims about the user that can be given.
+}
+
+// OIDCKeySet represents the public keys the tokens are signed with.
+type OIDCKeySet struct {
+	Keys []jwt.JWK `json:"keys"` // Keys are the published keys.
+}
+
+// OIDCToken represents the tokens issued for an authorization code.
+type OIDCToken struct {
+	AccessToken string `json:"access_token"` // AccessToken is the token the user info is read with.
+	TokenType   string `json:"token_type"`   // TokenType is always Bearer.
+	ExpiresIn   int64  `json:"expires_in"`   // ExpiresIn is the number of seconds the access token is valid.
+	IDToken     string `json:"id_token"`     // IDToken is the signed statement about the sign-in.
+	Scope       string `json:"scope"`        // Scope are the space-separated scopes the client is allowed to read.
+}
+
+// OIDCError represents an error of the token or user info endpoint in the format of the OAuth 2.0 specification.
+type OIDCError struct {
+	Error            string `json:"error"`                       // Error is the code of the error.
+	ErrorDescription string `json:"error_description,omitempty"` // ErrorDescription explains the error to the developer.
+}
+
+// OIDCConsent represents the authorization request of a client the user is asked to allow.
+type OIDCConsent struct {
+	ClientID   string   `json:"clientId"`   // ClientID is the unique identifier of the client.
+	ClientName string   `json:"clientName"` // ClientName is the name of the client.
+	Scopes     []string `json:"scopes"`     // Scopes are the data of the user requested by the client.
+}
+
+// OIDCConsentDecision represents the decision of the user on the authorization request of a client.
+type OIDCConsentDecision struct {
+	Request string `json:"request"` // Request is the signed authorization request from the consent page address.
+	Approve bool   `json:"approve"` // Approve is whether the user allows the client to read the requested data.
+}
+
+// OIDCRedirect represents the address the user is sent back to the client with.
+type OIDCRedirect struct {
+	RedirectURI string `json:"redirectUri"` // RedirectURI is the address with the code or the error.
+}
@@ -0,0 +1,1019 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	jwt "mail/internal/pkg/utils/jwt"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonA689914bDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *OIDCToken) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "access_token":
+			out.AccessToken = string(in.String())
+		case "token_type":
+			out.TokenType = string(in.String())
+		case "expires_in":
+			out.ExpiresIn = int64(in.Int64())
+		case "id_token":
+			out.IDToken = string(in.String())
+		case "scope":
+			out.Scope = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in OIDCToken) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"access_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccessToken))
+	}
+	{
+		const prefix string = ",\"token_type\":"
+		out.RawString(prefix)
+		out.String(string(in.TokenType))
+	}
+	{
+		const prefix string = ",\"expires_in\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ExpiresIn))
+	}
+	{
+		const prefix string = ",\"id_token\":"
+		out.RawString(prefix)
+		out.String(string(in.IDToken))
+	}
+	{
+		const prefix string = ",\"scope\":"
+		out.RawString(prefix)
+		out.String(string(in.Scope))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCToken) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCToken) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCToken) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjsonA689914bDecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *OIDCRedirect) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "redirectUri":
+			out.RedirectURI = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in OIDCRedirect) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"redirectUri\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RedirectURI))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCRedirect) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCRedirect) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCRedirect) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCRedirect) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels1(l, v)
+}
+func easyjsonA689914bDecodeMailInternalModelsDeliveryModels2(in *jlexer.Lexer, out *OIDCKeySet) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "keys":
+			if in.IsNull() {
+				in.Skip()
+				out.Keys = nil
+			} else {
+				in.Delim('[')
+				if out.Keys == nil {
+					if !in.IsDelim(']') {
+						out.Keys = make([]jwt.JWK, 0, 0)
+					} else {
+						out.Keys = []jwt.JWK{}
+					}
+				} else {
+					out.Keys = (out.Keys)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 jwt.JWK
+					easyjsonA689914bDecodeMailInternalPkgUtilsJwt(in, &v1)
+					out.Keys = append(out.Keys, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalModelsDeliveryModels2(out *jwriter.Writer, in OIDCKeySet) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"keys\":"
+		out.RawString(prefix[1:])
+		if in.Keys == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Keys {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA689914bEncodeMailInternalPkgUtilsJwt(out, v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCKeySet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCKeySet) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCKeySet) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCKeySet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels2(l, v)
+}
+func easyjsonA689914bDecodeMailInternalPkgUtilsJwt(in *jlexer.Lexer, out *jwt.JWK) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kty":
+			out.KeyType = string(in.String())
+		case "use":
+			out.Use = string(in.String())
+		case "alg":
+			out.Algorithm = string(in.String())
+		case "kid":
+			out.KeyID = string(in.String())
+		case "n":
+			out.Modulus = string(in.String())
+		case "e":
+			out.Exponent = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalPkgUtilsJwt(out *jwriter.Writer, in jwt.JWK) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kty\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.KeyType))
+	}
+	{
+		const prefix string = ",\"use\":"
+		out.RawString(prefix)
+		out.String(string(in.Use))
+	}
+	{
+		const prefix string = ",\"alg\":"
+		out.RawString(prefix)
+		out.String(string(in.Algorithm))
+	}
+	{
+		const prefix string = ",\"kid\":"
+		out.RawString(prefix)
+		out.String(string(in.KeyID))
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.String(string(in.Modulus))
+	}
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix)
+		out.String(string(in.Exponent))
+	}
+	out.RawByte('}')
+}
+func easyjsonA689914bDecodeMailInternalModelsDeliveryModels3(in *jlexer.Lexer, out *OIDCError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "error":
+			out.Error = string(in.String())
+		case "error_description":
+			out.ErrorDescription = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalModelsDeliveryModels3(out *jwriter.Writer, in OIDCError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Error))
+	}
+	if in.ErrorDescription != "" {
+		const prefix string = ",\"error_description\":"
+		out.RawString(prefix)
+		out.String(string(in.ErrorDescription))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels3(l, v)
+}
+func easyjsonA689914bDecodeMailInternalModelsDeliveryModels4(in *jlexer.Lexer, out *OIDCDiscovery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "issuer":
+			out.Issuer = string(in.String())
+		case "authorization_endpoint":
+			out.AuthorizationEndpoint = string(in.String())
+		case "token_endpoint":
+			out.TokenEndpoint = string(in.String())
+		case "userinfo_endpoint":
+			out.UserInfoEndpoint = string(in.String())
+		case "jwks_uri":
+			out.JWKSURI = string(in.String())
+		case "scopes_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.ScopesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.ScopesSupported == nil {
+					if !in.IsDelim(']') {
+						out.ScopesSupported = make([]string, 0, 4)
+					} else {
+						out.ScopesSupported = []string{}
+					}
+				} else {
+					out.ScopesSupported = (out.ScopesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.ScopesSupported = append(out.ScopesSupported, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "response_types_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.ResponseTypesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.ResponseTypesSupported == nil {
+					if !in.IsDelim(']') {
+						out.ResponseTypesSupported = make([]string, 0, 4)
+					} else {
+						out.ResponseTypesSupported = []string{}
+					}
+				} else {
+					out.ResponseTypesSupported = (out.ResponseTypesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					v5 = string(in.String())
+					out.ResponseTypesSupported = append(out.ResponseTypesSupported, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "grant_types_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.GrantTypesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.GrantTypesSupported == nil {
+					if !in.IsDelim(']') {
+						out.GrantTypesSupported = make([]string, 0, 4)
+					} else {
+						out.GrantTypesSupported = []string{}
+					}
+				} else {
+					out.GrantTypesSupported = (out.GrantTypesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 string
+					v6 = string(in.String())
+					out.GrantTypesSupported = append(out.GrantTypesSupported, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "subject_types_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.SubjectTypesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.SubjectTypesSupported == nil {
+					if !in.IsDelim(']') {
+						out.SubjectTypesSupported = make([]string, 0, 4)
+					} else {
+						out.SubjectTypesSupported = []string{}
+					}
+				} else {
+					out.SubjectTypesSupported = (out.SubjectTypesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.SubjectTypesSupported = append(out.SubjectTypesSupported, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id_token_signing_alg_values_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.IDTokenSigningAlgValuesSupported = nil
+			} else {
+				in.Delim('[')
+				if out.IDTokenSigningAlgValuesSupported == nil {
+					if !in.IsDelim(']') {
+						out.IDTokenSigningAlgValuesSupported = make([]string, 0, 4)
+					} else {
+						out.IDTokenSigningAlgValuesSupported = []string{}
+					}
+				} else {
+					out.IDTokenSigningAlgValuesSupported = (out.IDTokenSigningAlgValuesSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 string
+					v8 = string(in.String())
+					out.IDTokenSigningAlgValuesSupported = append(out.IDTokenSigningAlgValuesSupported, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "token_endpoint_auth_methods_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.TokenEndpointAuthMethodsSupported = nil
+			} else {
+				in.Delim('[')
+				if out.TokenEndpointAuthMethodsSupported == nil {
+					if !in.IsDelim(']') {
+						out.TokenEndpointAuthMethodsSupported = make([]string, 0, 4)
+					} else {
+						out.TokenEndpointAuthMethodsSupported = []string{}
+					}
+				} else {
+					out.TokenEndpointAuthMethodsSupported = (out.TokenEndpointAuthMethodsSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 string
+					v9 = string(in.String())
+					out.TokenEndpointAuthMethodsSupported = append(out.TokenEndpointAuthMethodsSupported, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "code_challenge_methods_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.CodeChallengeMethodsSupported = nil
+			} else {
+				in.Delim('[')
+				if out.CodeChallengeMethodsSupported == nil {
+					if !in.IsDelim(']') {
+						out.CodeChallengeMethodsSupported = make([]string, 0, 4)
+					} else {
+						out.CodeChallengeMethodsSupported = []string{}
+					}
+				} else {
+					out.CodeChallengeMethodsSupported = (out.CodeChallengeMethodsSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 string
+					v10 = string(in.String())
+					out.CodeChallengeMethodsSupported = append(out.CodeChallengeMethodsSupported, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "claims_supported":
+			if in.IsNull() {
+				in.Skip()
+				out.ClaimsSupported = nil
+			} else {
+				in.Delim('[')
+				if out.ClaimsSupported == nil {
+					if !in.IsDelim(']') {
+						out.ClaimsSupported = make([]string, 0, 4)
+					} else {
+						out.ClaimsSupported = []string{}
+					}
+				} else {
+					out.ClaimsSupported = (out.ClaimsSupported)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 string
+					v11 = string(in.String())
+					out.ClaimsSupported = append(out.ClaimsSupported, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalModelsDeliveryModels4(out *jwriter.Writer, in OIDCDiscovery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"issuer\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Issuer))
+	}
+	{
+		const prefix string = ",\"authorization_endpoint\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorizationEndpoint))
+	}
+	{
+		const prefix string = ",\"token_endpoint\":"
+		out.RawString(prefix)
+		out.String(string(in.TokenEndpoint))
+	}
+	{
+		const prefix string = ",\"userinfo_endpoint\":"
+		out.RawString(prefix)
+		out.String(string(in.UserInfoEndpoint))
+	}
+	{
+		const prefix string = ",\"jwks_uri\":"
+		out.RawString(prefix)
+		out.String(string(in.JWKSURI))
+	}
+	{
+		const prefix string = ",\"scopes_supported\":"
+		out.RawString(prefix)
+		if in.ScopesSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.ScopesSupported {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v13))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"response_types_supported\":"
+		out.RawString(prefix)
+		if in.ResponseTypesSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.ResponseTypesSupported {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"grant_types_supported\":"
+		out.RawString(prefix)
+		if in.GrantTypesSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.GrantTypesSupported {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v17))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"subject_types_supported\":"
+		out.RawString(prefix)
+		if in.SubjectTypesSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.SubjectTypesSupported {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v19))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id_token_signing_alg_values_supported\":"
+		out.RawString(prefix)
+		if in.IDTokenSigningAlgValuesSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.IDTokenSigningAlgValuesSupported {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v21))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"token_endpoint_auth_methods_supported\":"
+		out.RawString(prefix)
+		if in.TokenEndpointAuthMethodsSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22, v23 := range in.TokenEndpointAuthMethodsSupported {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v23))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"code_challenge_methods_supported\":"
+		out.RawString(prefix)
+		if in.CodeChallengeMethodsSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.CodeChallengeMethodsSupported {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v25))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"claims_supported\":"
+		out.RawString(prefix)
+		if in.ClaimsSupported == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.ClaimsSupported {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v27))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCDiscovery) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels4(l, v)
+}
+func easyjsonA689914bDecodeMailInternalModelsDeliveryModels5(in *jlexer.Lexer, out *OIDCConsentDecision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "request":
+			out.Request = string(in.String())
+		case "approve":
+			out.Approve = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalModelsDeliveryModels5(out *jwriter.Writer, in OIDCConsentDecision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"request\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Request))
+	}
+	{
+		const prefix string = ",\"approve\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approve))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCConsentDecision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCConsentDecision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCConsentDecision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCConsentDecision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels5(l, v)
+}
+func easyjsonA689914bDecodeMailInternalModelsDeliveryModels6(in *jlexer.Lexer, out *OIDCConsent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clientId":
+			out.ClientID = string(in.String())
+		case "clientName":
+			out.ClientName = string(in.String())
+		case "scopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Scopes = nil
+			} else {
+				in.Delim('[')
+				if out.Scopes == nil {
+					if !in.IsDelim(']') {
+						out.Scopes = make([]string, 0, 4)
+					} else {
+						out.Scopes = []string{}
+					}
+				} else {
+					out.Scopes = (out.Scopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 string
+					v28 = string(in.String())
+					out.Scopes = append(out.Scopes, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA689914bEncodeMailInternalModelsDeliveryModels6(out *jwriter.Writer, in OIDCConsent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"clientId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ClientID))
+	}
+	{
+		const prefix string = ",\"clientName\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientName))
+	}
+	{
+		const prefix string = ",\"scopes\":"
+		out.RawString(prefix)
+		if in.Scopes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Scopes {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v30))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OIDCConsent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OIDCConsent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA689914bEncodeMailInternalModelsDeliveryModels6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OIDCConsent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OIDCConsent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA689914bDecodeMailInternalModelsDeliveryModels6(l, v)
+}
@@ -4,16 +4,29 @@ import "time"
 
 // OtherEmail represents the information about an email.
 type OtherEmail struct {
-	ID             string    `json:"id,omitempty"`             // ID is the unique identifier of the email in the database.
-	Topic          string    `json:"topic"`                    // Topic is the subject of the email.
-	Text           string    `json:"text"`                     // Text is the body of the email.
-	ReadStatus     bool      `json:"readStatus"`               // ReadStatus indicates whether the email has been read.
-	Flag           bool      `json:"mark,omitempty"`           // Flag is a flag, such as marking the email as a favorite.
-	Deleted        bool      `json:"deleted"`                  // Deleted indicates whether the email has been deleted.
-	DateOfDispatch time.Time `json:"dateOfDispatch,omitempty"` // DateOfDispatch is the date when the email was sent.
-	ReplyToEmailID uint64    `json:"replyToEmailId,omitempty"` // ReplyToEmailID is the ID of the email to which a reply can be sent.
-	DraftStatus    bool      `json:"draftStatus"`              // DraftStatus indicates whether the email is a draft.
-	SpamStatus     bool      `json:"spamStatus"`               // SpamStatus indicates whether the email is a spam
-	SenderEmail    string    `json:"senderEmail"`              // SenderEmail is the email of the sender user
-	RecipientEmail string    `json:"recipientEmail"`           // RecipientEmail is the email of the recipient user
+	ID             string             `json:"id,omitempty"`             // ID is the unique identifier of the email in the database.
+	Topic          string             `json:"topic"`                    // Topic is the subject of the email.
+	Text           string             `json:"text"`                     // Text is the body of the email.
+	ReadStatus     bool               `json:"readStatus"`               // ReadStatus indicates whether the email has been read.
+	Flag           bool               `json:"mark,omitempty"`           // Flag is a flag, such as marking the email as a favorite.
+	Deleted        bool               `json:"deleted"`                  // Deleted indicates whether the email has been deleted.
+	DateOfDispatch time.Time          `json:"dateOfDispatch,omitempty"` // DateOfDispatch is the date when the email was sent.
+	ReplyToEmailID uint64             `json:"replyToEmailId,omitempty"` // ReplyToEmailID is the ID of the email to which a reply can be sent.
+	DraftStatus    bool               `json:"draftStatus"`              // DraftStatus indicates whether the email is a draft.
+	SpamStatus     bool               `json:"spamStatus"`               // SpamStatus indicates whether the email is a spam
+	SenderEmail    string             `json:"senderEmail"`              // SenderEmail is the email of the sender user
+	RecipientEmail string             `json:"recipientEmail"`           // RecipientEmail is the email of the recipient user
+	Attachments    []*OtherAttachment `json:"attachments,omitempty"`    // Attachments are the files of the email
+	Files          []uint64           `json:"files,omitempty"`          // Files are the identifiers of the uploaded files to attach to the sent email
+}
+
+// OtherAttachment represents the information about a file of an email.
+type OtherAttachment struct {
+	PartID    string `json:"partId"`              // PartID is the identifier of the file inside the email.
+	FileName  string `json:"fileName"`            // FileName is the name of the file.
+	FileType  string `json:"fileType"`            // FileType is the type of the content of the file.
+	FileSize  int64  `json:"fileSize"`            // FileSize is the size of the file in bytes.
+	ContentID string `json:"contentId,omitempty"` // ContentID is the identifier the HTML body refers to the inline image with.
+	Inline    bool   `json:"inline"`              // Inline indicates whether the file is shown inside the body.
+	URL       string `json:"url"`                 // URL is the link to download the file.
 }
@@ -62,6 +62,60 @@ func easyjsonCa3c062aDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, ou
 			out.SenderEmail = string(in.String())
 		case "recipientEmail":
 			out.RecipientEmail = string(in.String())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*OtherAttachment, 0, 8)
+					} else {
+						out.Attachments = []*OtherAttachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *OtherAttachment
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(OtherAttachment)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "files":
+			if in.IsNull() {
+				in.Skip()
+				out.Files = nil
+			} else {
+				in.Delim('[')
+				if out.Files == nil {
+					if !in.IsDelim(']') {
+						out.Files = make([]uint64, 0, 8)
+					} else {
+						out.Files = []uint64{}
+					}
+				} else {
+					out.Files = (out.Files)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 uint64
+					v2 = uint64(in.Uint64())
+					out.Files = append(out.Files, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -142,6 +196,38 @@ func easyjsonCa3c062aEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.RecipientEmail))
 	}
+	if len(in.Attachments) != 0 {
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.Attachments {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				if v4 == nil {
+					out.RawString("null")
+				} else {
+					(*v4).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Files) != 0 {
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Files {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v6))
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -168,3 +254,111 @@ func (v *OtherEmail) UnmarshalJSON(data []byte) error {
 func (v *OtherEmail) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCa3c062aDecodeMailInternalModelsDeliveryModels(l, v)
 }
+func easyjsonCa3c062aDecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *OtherAttachment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "partId":
+			out.PartID = string(in.String())
+		case "fileName":
+			out.FileName = string(in.String())
+		case "fileType":
+			out.FileType = string(in.String())
+		case "fileSize":
+			out.FileSize = int64(in.Int64())
+		case "contentId":
+			out.ContentID = string(in.String())
+		case "inline":
+			out.Inline = bool(in.Bool())
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCa3c062aEncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in OtherAttachment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"partId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PartID))
+	}
+	{
+		const prefix string = ",\"fileName\":"
+		out.RawString(prefix)
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"fileType\":"
+		out.RawString(prefix)
+		out.String(string(in.FileType))
+	}
+	{
+		const prefix string = ",\"fileSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FileSize))
+	}
+	if in.ContentID != "" {
+		const prefix string = ",\"contentId\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentID))
+	}
+	{
+		const prefix string = ",\"inline\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Inline))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OtherAttachment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCa3c062aEncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OtherAttachment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCa3c062aEncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OtherAttachment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCa3c062aDecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OtherAttachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCa3c062aDecodeMailInternalModelsDeliveryModels1(l, v)
+}
@@ -0,0 +1,31 @@
+package delivery_models
+
+// PasswordChange represents a request to change the password of the user.
+type PasswordChange struct {
+	OldPassword string `json:"oldPassword"` // OldPassword is the current password of the user.
+	NewPassword string `json:"newPassword"` // NewPassword is the password to set.
+}
+
+// PasswordReset represents a step of the password reset flow.
+type PasswordReset struct {
+	Login    string `json:"login,omitempty"`    // Login is the login of the user who forgot the password.
+	Token    string `json:"token,omitempty"`    // Token is the token from the password reset link.
+	Password string `json:"password,omitempty"` // Password is the new password of the user.
+}
+
+// RecoveryEmail represents the external address password reset links are sent to.
+type RecoveryEmail struct {
+	RecoveryEmail string `json:"recoveryEmail"` // RecoveryEmail is the address, an empty one removes it.
+	Password      string `json:"password"`      // Password is the password of the user, required to change the address.
+}
+
+// RecoveryEmailStatus represents the recovery email of the user and whether it can be trusted.
+type RecoveryEmailStatus struct {
+	RecoveryEmail string `json:"recoveryEmail"` // RecoveryEmail is the address, empty if there is none.
+	Verified      bool   `json:"verified"`      // Verified is whether the user has confirmed the address, only then reset links are sent to it.
+}
+
+// RecoveryEmailVerification represents a request to verify the recovery email.
+type RecoveryEmailVerification struct {
+	Token string `json:"token"` // Token is the token from the verification link.
+}
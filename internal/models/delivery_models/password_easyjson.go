@@ -0,0 +1,395 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *RecoveryEmailVerification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in RecoveryEmailVerification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryEmailVerification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryEmailVerification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryEmailVerification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryEmailVerification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *RecoveryEmailStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recoveryEmail":
+			out.RecoveryEmail = string(in.String())
+		case "verified":
+			out.Verified = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in RecoveryEmailStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recoveryEmail\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RecoveryEmail))
+	}
+	{
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryEmailStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryEmailStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryEmailStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryEmailStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels1(l, v)
+}
+func easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels2(in *jlexer.Lexer, out *RecoveryEmail) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recoveryEmail":
+			out.RecoveryEmail = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels2(out *jwriter.Writer, in RecoveryEmail) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recoveryEmail\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RecoveryEmail))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryEmail) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryEmail) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryEmail) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryEmail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels2(l, v)
+}
+func easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels3(in *jlexer.Lexer, out *PasswordReset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "login":
+			out.Login = string(in.String())
+		case "token":
+			out.Token = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels3(out *jwriter.Writer, in PasswordReset) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Login != "" {
+		const prefix string = ",\"login\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Login))
+	}
+	if in.Token != "" {
+		const prefix string = ",\"token\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Token))
+	}
+	if in.Password != "" {
+		const prefix string = ",\"password\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordReset) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordReset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordReset) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordReset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels3(l, v)
+}
+func easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels4(in *jlexer.Lexer, out *PasswordChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oldPassword":
+			out.OldPassword = string(in.String())
+		case "newPassword":
+			out.NewPassword = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels4(out *jwriter.Writer, in PasswordChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"oldPassword\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OldPassword))
+	}
+	{
+		const prefix string = ",\"newPassword\":"
+		out.RawString(prefix)
+		out.String(string(in.NewPassword))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBf87f2d7EncodeMailInternalModelsDeliveryModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBf87f2d7DecodeMailInternalModelsDeliveryModels4(l, v)
+}
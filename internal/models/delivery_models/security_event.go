@@ -0,0 +1,15 @@
+package delivery_models
+
+import "time"
+
+// SecurityEvent represents a security relevant action on the account shown to its owner in the audit log.
+type SecurityEvent struct {
+	ID           uint64    `json:"id"`                // ID is the unique identifier of the event, later events have greater identifiers.
+	Type         string    `json:"type"`              // Type is the kind of the action, e.g. login or password_change.
+	Method       string    `json:"method,omitempty"`  // Method is the sign-in method for sign-in events: password, two_factor, vk or gmail.
+	Success      bool      `json:"success"`           // Success is whether the action succeeded.
+	Details      string    `json:"details,omitempty"` // Details describe the action, e.g. the delegate given access to the mailbox.
+	IPAddress    string    `json:"ipAddress"`         // IPAddress is the address of the client, if known.
+	UserAgent    string    `json:"userAgent"`         // UserAgent is the device of the client, if known.
+	CreationDate time.Time `json:"creationDate"`      // CreationDate is the date of the event.
+}
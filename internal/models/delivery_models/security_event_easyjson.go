@@ -0,0 +1,136 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonB243303fDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *SecurityEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "type":
+			out.Type = string(in.String())
+		case "method":
+			out.Method = string(in.String())
+		case "success":
+			out.Success = bool(in.Bool())
+		case "details":
+			out.Details = string(in.String())
+		case "ipAddress":
+			out.IPAddress = string(in.String())
+		case "userAgent":
+			out.UserAgent = string(in.String())
+		case "creationDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreationDate).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB243303fEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in SecurityEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Method != "" {
+		const prefix string = ",\"method\":"
+		out.RawString(prefix)
+		out.String(string(in.Method))
+	}
+	{
+		const prefix string = ",\"success\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Success))
+	}
+	if in.Details != "" {
+		const prefix string = ",\"details\":"
+		out.RawString(prefix)
+		out.String(string(in.Details))
+	}
+	{
+		const prefix string = ",\"ipAddress\":"
+		out.RawString(prefix)
+		out.String(string(in.IPAddress))
+	}
+	{
+		const prefix string = ",\"userAgent\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAgent))
+	}
+	{
+		const prefix string = ",\"creationDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CreationDate).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SecurityEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB243303fEncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SecurityEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB243303fEncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SecurityEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB243303fDecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SecurityEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB243303fDecodeMailInternalModelsDeliveryModels(l, v)
+}
@@ -4,10 +4,22 @@ import "time"
 
 // Session represents a user's session information.
 type Session struct {
-	ID           string    `json:"id,omitempty"`            // ID uniquely identifies the session.
-	UserID       uint32    `json:"user-id,omitempty"`       // UserID specifies the ID of the user this session belongs to.
-	CreationDate time.Time `json:"creation-date,omitempty"` // CreationDate is the timestamp when the session was created.
-	Device       string    `json:"device,omitempty"`        // Device describes the device used to initiate the session, e.g., 'web', 'mobile'.
-	LifeTime     int       `json:"life-time,omitempty"`     // LifeTime indicates the duration (in seconds) for which the session is valid.
-	CsrfToken    string    `json:"csrf_token,omitempty"`    // CsrfToken represents the Cross-Site Request Forgery (CSRF) token associated with the session.
+	ID           string    `json:"id,omitempty"`             // ID uniquely identifies the session.
+	UserID       uint32    `json:"user-id,omitempty"`        // UserID specifies the ID of the user this session belongs to.
+	CreationDate time.Time `json:"creation-date,omitempty"`  // CreationDate is the timestamp when the session was created.
+	Device       string    `json:"device,omitempty"`         // Device describes the device used to initiate the session, e.g., 'web', 'mobile'.
+	LifeTime     int       `json:"life-time,omitempty"`      // LifeTime indicates the duration (in seconds) for which the session is valid.
+	CsrfToken    string    `json:"csrf_token,omitempty"`     // CsrfToken represents the Cross-Site Request Forgery (CSRF) token associated with the session.
+	IPAddress    string    `json:"ip-address,omitempty"`     // IPAddress is the address of the client the session was created from.
+	LastSeenDate time.Time `json:"last-seen-date,omitempty"` // LastSeenDate is the timestamp of the last request made with the session.
+}
+
+// ActiveSession represents a session shown to its owner in the list of active sessions.
+type ActiveSession struct {
+	ID           string    `json:"id"`           // ID is the public identifier of the session, not the session cookie.
+	Device       string    `json:"device"`       // Device is the user agent the session was created from.
+	IPAddress    string    `json:"ipAddress"`    // IPAddress is the address of the client the session was created from.
+	CreationDate time.Time `json:"creationDate"` // CreationDate is the timestamp when the session was created.
+	LastSeenDate time.Time `json:"lastSeenDate"` // LastSeenDate is the timestamp of the last request made with the session.
+	Current      bool      `json:"current"`      // Current is whether the session is the one the list was requested with.
 }
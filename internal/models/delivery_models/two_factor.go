@@ -0,0 +1,21 @@
+package delivery_models
+
+// TwoFactorCode represents a code of the second factor entered by the user.
+type TwoFactorCode struct {
+	ChallengeID string `json:"challengeId,omitempty"` // ChallengeID is the identifier of the login waiting for the second factor.
+	Code        string `json:"code"`                  // Code is the TOTP code or one of the recovery codes.
+	Password    string `json:"password,omitempty"`    // Password is the password of the user, required to disable two-factor authentication.
+	RememberMe  bool   `json:"rememberMe,omitempty"`  // RememberMe asks at login to keep the session for longer than a day.
+}
+
+// TwoFactorStatus represents the two-factor authentication settings of the user.
+type TwoFactorStatus struct {
+	Enabled           bool `json:"enabled"`           // Enabled is whether two-factor authentication is enabled.
+	RecoveryCodesLeft int  `json:"recoveryCodesLeft"` // RecoveryCodesLeft is the number of unused recovery codes.
+}
+
+// TwoFactorSetup represents the secret to add to an authenticator application.
+type TwoFactorSetup struct {
+	Secret string `json:"secret"` // Secret is the base32 encoded TOTP secret.
+	URI    string `json:"uri"`    // URI is the otpauth URI to be shown as a QR code.
+}
@@ -0,0 +1,258 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson2c89d924DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *TwoFactorStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		case "recoveryCodesLeft":
+			out.RecoveryCodesLeft = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2c89d924EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in TwoFactorStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	{
+		const prefix string = ",\"recoveryCodesLeft\":"
+		out.RawString(prefix)
+		out.Int(int(in.RecoveryCodesLeft))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2c89d924EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2c89d924EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2c89d924DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2c89d924DecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjson2c89d924DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *TwoFactorSetup) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "secret":
+			out.Secret = string(in.String())
+		case "uri":
+			out.URI = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2c89d924EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in TwoFactorSetup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"secret\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Secret))
+	}
+	{
+		const prefix string = ",\"uri\":"
+		out.RawString(prefix)
+		out.String(string(in.URI))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorSetup) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2c89d924EncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorSetup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2c89d924EncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorSetup) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2c89d924DecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorSetup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2c89d924DecodeMailInternalModelsDeliveryModels1(l, v)
+}
+func easyjson2c89d924DecodeMailInternalModelsDeliveryModels2(in *jlexer.Lexer, out *TwoFactorCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "challengeId":
+			out.ChallengeID = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "rememberMe":
+			out.RememberMe = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2c89d924EncodeMailInternalModelsDeliveryModels2(out *jwriter.Writer, in TwoFactorCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ChallengeID != "" {
+		const prefix string = ",\"challengeId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ChallengeID))
+	}
+	{
+		const prefix string = ",\"code\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Code))
+	}
+	if in.Password != "" {
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	if in.RememberMe {
+		const prefix string = ",\"rememberMe\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RememberMe))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2c89d924EncodeMailInternalModelsDeliveryModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2c89d924EncodeMailInternalModelsDeliveryModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2c89d924DecodeMailInternalModelsDeliveryModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2c89d924DecodeMailInternalModelsDeliveryModels2(l, v)
+}
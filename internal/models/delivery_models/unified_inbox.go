@@ -0,0 +1,26 @@
+package delivery_models
+
+import "time"
+
+// UnifiedEmail represents an email of the unified inbox, which merges the mailboxes of the user.
+type UnifiedEmail struct {
+	ID             string    `json:"id"`             // ID is the unique identifier of the email in its mailbox.
+	Source         string    `json:"source"`         // Source is the kind of the mailbox of the email: mailhub or gmail.
+	Account        string    `json:"account"`        // Account is the address of the mailbox of the email.
+	Topic          string    `json:"topic"`          // Topic is the subject of the email.
+	Text           string    `json:"text"`           // Text is the body of the email.
+	ReadStatus     bool      `json:"readStatus"`     // ReadStatus indicates whether the email has been read.
+	Flag           bool      `json:"mark,omitempty"` // Flag is a flag, such as marking the email as a favorite.
+	SpamStatus     bool      `json:"spamStatus"`     // SpamStatus indicates whether the email is a spam.
+	DateOfDispatch time.Time `json:"dateOfDispatch"` // DateOfDispatch is the date when the email was sent.
+	SenderEmail    string    `json:"senderEmail"`    // SenderEmail is the email of the sender user.
+	RecipientEmail string    `json:"recipientEmail"` // RecipientEmail is the email of the recipient user.
+}
+
+// UnifiedAction represents an action on an email of the unified inbox, it is applied by the mailbox of the email.
+type UnifiedAction struct {
+	Action string `json:"action"`           // Action is one of read, unread, spam, not_spam, delete or move.
+	Source string `json:"source"`           // Source is the kind of the mailbox of the email: mailhub or gmail.
+	ID     string `json:"id"`               // ID is the unique identifier of the email in its mailbox.
+	Folder string `json:"folder,omitempty"` // Folder is the target of the move action: the ID of a MailHub folder or of a Gmail label.
+}
@@ -0,0 +1,244 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package delivery_models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson259e1f35DecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, out *UnifiedEmail) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "source":
+			out.Source = string(in.String())
+		case "account":
+			out.Account = string(in.String())
+		case "topic":
+			out.Topic = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "readStatus":
+			out.ReadStatus = bool(in.Bool())
+		case "mark":
+			out.Flag = bool(in.Bool())
+		case "spamStatus":
+			out.SpamStatus = bool(in.Bool())
+		case "dateOfDispatch":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateOfDispatch).UnmarshalJSON(data))
+			}
+		case "senderEmail":
+			out.SenderEmail = string(in.String())
+		case "recipientEmail":
+			out.RecipientEmail = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson259e1f35EncodeMailInternalModelsDeliveryModels(out *jwriter.Writer, in UnifiedEmail) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"account\":"
+		out.RawString(prefix)
+		out.String(string(in.Account))
+	}
+	{
+		const prefix string = ",\"topic\":"
+		out.RawString(prefix)
+		out.String(string(in.Topic))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"readStatus\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadStatus))
+	}
+	if in.Flag {
+		const prefix string = ",\"mark\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Flag))
+	}
+	{
+		const prefix string = ",\"spamStatus\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SpamStatus))
+	}
+	{
+		const prefix string = ",\"dateOfDispatch\":"
+		out.RawString(prefix)
+		out.Raw((in.DateOfDispatch).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"senderEmail\":"
+		out.RawString(prefix)
+		out.String(string(in.SenderEmail))
+	}
+	{
+		const prefix string = ",\"recipientEmail\":"
+		out.RawString(prefix)
+		out.String(string(in.RecipientEmail))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnifiedEmail) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson259e1f35EncodeMailInternalModelsDeliveryModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnifiedEmail) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson259e1f35EncodeMailInternalModelsDeliveryModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnifiedEmail) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson259e1f35DecodeMailInternalModelsDeliveryModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnifiedEmail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson259e1f35DecodeMailInternalModelsDeliveryModels(l, v)
+}
+func easyjson259e1f35DecodeMailInternalModelsDeliveryModels1(in *jlexer.Lexer, out *UnifiedAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "source":
+			out.Source = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "folder":
+			out.Folder = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson259e1f35EncodeMailInternalModelsDeliveryModels1(out *jwriter.Writer, in UnifiedAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.Folder != "" {
+		const prefix string = ",\"folder\":"
+		out.RawString(prefix)
+		out.String(string(in.Folder))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnifiedAction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson259e1f35EncodeMailInternalModelsDeliveryModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnifiedAction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson259e1f35EncodeMailInternalModelsDeliveryModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnifiedAction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson259e1f35DecodeMailInternalModelsDeliveryModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnifiedAction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson259e1f35DecodeMailInternalModelsDeliveryModels1(l, v)
+}
@@ -61,6 +61,10 @@ func easyjson9e1087fdDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, ou
 			out.PhoneNumber = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "rememberMe":
+			out.RememberMe = bool(in.Bool())
+		case "recoveryEmail":
+			out.RecoveryEmail = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -161,6 +165,16 @@ func easyjson9e1087fdEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
+	if in.RememberMe {
+		const prefix string = ",\"rememberMe\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RememberMe))
+	}
+	if in.RecoveryEmail != "" {
+		const prefix string = ",\"recoveryEmail\":"
+		out.RawString(prefix)
+		out.String(string(in.RecoveryEmail))
+	}
 	out.RawByte('}')
 }
 
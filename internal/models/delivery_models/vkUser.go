@@ -7,11 +7,12 @@ import (
 
 // User represents information about a user.
 type VKUser struct {
-	ID        uint32            `json:"id,omitempty"`        // ID uniquely identifies the user.
-	FirstName string            `json:"firstname,omitempty"` // FirstName stores the first name of the user.
-	Surname   string            `json:"surname,omitempty"`   // Surname stores the last name of the user.
-	Gender    domain.UserGender `json:"gender,omitempty"`    // Gender stores the gender of the user.
-	Birthday  time.Time         `json:"birthday,omitempty"`  // Birthday stores the birthdate of the user.
-	VKId      uint32            `json:"vkId"`                // ID user in VK
-	Login     string            `json:"login,omitempty"`     // Login user
+	ID            uint32            `json:"id,omitempty"`            // ID uniquely identifies the user.
+	FirstName     string            `json:"firstname,omitempty"`     // FirstName stores the first name of the user.
+	Surname       string            `json:"surname,omitempty"`       // Surname stores the last name of the user.
+	Gender        domain.UserGender `json:"gender,omitempty"`        // Gender stores the gender of the user.
+	Birthday      time.Time         `json:"birthday,omitempty"`      // Birthday stores the birthdate of the user.
+	VKId          uint32            `json:"vkId"`                    // ID user in VK
+	Login         string            `json:"login,omitempty"`         // Login user
+	RecoveryEmail string            `json:"recoveryEmail,omitempty"` // RecoveryEmail is the external address to restore access to the account without VK.
 }
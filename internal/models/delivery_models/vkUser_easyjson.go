@@ -53,6 +53,8 @@ func easyjson67454eacDecodeMailInternalModelsDeliveryModels(in *jlexer.Lexer, ou
 			out.VKId = uint32(in.Uint32())
 		case "login":
 			out.Login = string(in.String())
+		case "recoveryEmail":
+			out.RecoveryEmail = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -128,6 +130,11 @@ func easyjson67454eacEncodeMailInternalModelsDeliveryModels(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Login))
 	}
+	if in.RecoveryEmail != "" {
+		const prefix string = ",\"recoveryEmail\":"
+		out.RawString(prefix)
+		out.String(string(in.RecoveryEmail))
+	}
 	out.RawByte('}')
 }
 
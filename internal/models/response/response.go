@@ -55,17 +55,19 @@ const (
 )
 
 type UserSwag struct {
-	ID          uint32         `json:"id,omitempty"`
-	FirstName   string         `json:"firstname,omitempty"`
-	Surname     string         `json:"surname,omitempty"`
-	Patronymic  string         `json:"middlename,omitempty"`
-	Gender      UserGenderSwag `json:"gender,omitempty"`
-	Birthday    time.Time      `json:"birthday,omitempty"`
-	Login       string         `json:"login"`
-	Password    string         `json:"password"`
-	AvatarID    string         `json:"avatar,omitempty"`
-	PhoneNumber string         `json:"phonenumber,omitempty"`
-	Description string         `json:"description,omitempty"`
+	ID            uint32         `json:"id,omitempty"`
+	FirstName     string         `json:"firstname,omitempty"`
+	Surname       string         `json:"surname,omitempty"`
+	Patronymic    string         `json:"middlename,omitempty"`
+	Gender        UserGenderSwag `json:"gender,omitempty"`
+	Birthday      time.Time      `json:"birthday,omitempty"`
+	Login         string         `json:"login"`
+	Password      string         `json:"password"`
+	AvatarID      string         `json:"avatar,omitempty"`
+	PhoneNumber   string         `json:"phonenumber,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	RememberMe    bool           `json:"rememberMe,omitempty"`
+	RecoveryEmail string         `json:"recoveryEmail,omitempty"`
 }
 
 type UserGoogleSwag struct {
@@ -82,13 +84,14 @@ type UserGoogleSwag struct {
 }
 
 type UserVKSwag struct {
-	ID        uint32         `json:"id,omitempty"`
-	FirstName string         `json:"firstname,omitempty"`
-	Surname   string         `json:"surname,omitempty"`
-	Gender    UserGenderSwag `json:"gender,omitempty"`
-	Birthday  time.Time      `json:"birthday,omitempty"`
-	VKId      uint32         `json:"vkId"`
-	Login     string         `json:"login"`
+	ID            uint32         `json:"id,omitempty"`
+	FirstName     string         `json:"firstname,omitempty"`
+	Surname       string         `json:"surname,omitempty"`
+	Gender        UserGenderSwag `json:"gender,omitempty"`
+	Birthday      time.Time      `json:"birthday,omitempty"`
+	VKId          uint32         `json:"vkId"`
+	Login         string         `json:"login"`
+	RecoveryEmail string         `json:"recoveryEmail,omitempty"`
 }
 
 type EmailSwag struct {
@@ -119,10 +122,12 @@ type EmailOtherSwag struct {
 	SpamStatus     bool      `json:"spamStatus"`
 	SenderEmail    string    `json:"senderEmail"`
 	RecipientEmail string    `json:"recipientEmail"`
+	Files          []uint64  `json:"files,omitempty"`
 }
 
 type FolderSwag struct {
-	Name string `json:"name"`
+	Name     string `json:"name"`
+	ParentID uint32 `json:"parentId"`
 }
 
 type FolderEmailSwag struct {
@@ -130,6 +135,12 @@ type FolderEmailSwag struct {
 	EmailID  uint32 `json:"emailId"`
 }
 
+type MoveEmailSwag struct {
+	EmailID      uint32 `json:"emailId"`
+	FromFolderID uint32 `json:"fromFolderId"`
+	ToFolderID   uint32 `json:"toFolderId"`
+}
+
 type FolderEmailGoogleSwag struct {
 	FolderID string `json:"folderId"`
 	EmailID  string `json:"emailId"`
@@ -150,3 +161,97 @@ type AnswerSwag struct {
 	Mark       uint32 `json:"mark,omitempty"`
 	Text       string `json:"text,omitempty"`
 }
+
+type MailingListSwag struct {
+	Address     string `json:"address"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	PostPolicy  string `json:"postPolicy"`
+	Moderated   bool   `json:"moderated"`
+}
+
+type MailingListMemberSwag struct {
+	Login string `json:"login"`
+	Role  string `json:"role,omitempty"`
+}
+
+type MailingListModerationSwag struct {
+	EmailID uint64 `json:"emailId"`
+	Approve bool   `json:"approve"`
+}
+
+type LabelSwag struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type LabelEmailsSwag struct {
+	LabelID  uint32   `json:"folderId"`
+	EmailID  uint64   `json:"emailId,omitempty"`
+	EmailIDs []uint64 `json:"emailIds,omitempty"`
+}
+
+type BulkRequestSwag struct {
+	Action   string   `json:"action"`
+	EmailIDs []uint64 `json:"emailIds"`
+	FolderID uint32   `json:"folderId,omitempty"`
+	LabelID  uint32   `json:"labelId,omitempty"`
+}
+
+type MailboxDelegateSwag struct {
+	Mailbox  string `json:"mailbox"`
+	Delegate string `json:"delegate"`
+	Role     string `json:"role,omitempty"`
+}
+
+type TwoFactorCodeSwag struct {
+	ChallengeID string `json:"challengeId,omitempty"`
+	Code        string `json:"code"`
+	Password    string `json:"password,omitempty"`
+	RememberMe  bool   `json:"rememberMe,omitempty"`
+}
+
+type PasswordChangeSwag struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+type PasswordResetSwag struct {
+	Login    string `json:"login,omitempty"`
+	Token    string `json:"token,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+type RecoveryEmailSwag struct {
+	RecoveryEmail string `json:"recoveryEmail"`
+	Password      string `json:"password"`
+}
+
+type RecoveryEmailVerificationSwag struct {
+	Token string `json:"token"`
+}
+
+type APITokenCreateSwag struct {
+	Name         string   `json:"name"`
+	Scopes       []string `json:"scopes"`
+	LifeTimeDays int      `json:"lifeTimeDays"`
+}
+
+type ExternalAccountLinkSwag struct {
+	Email       string `json:"email"`
+	IMAPHost    string `json:"imapHost"`
+	IMAPPort    uint32 `json:"imapPort"`
+	IMAPTLSMode string `json:"imapTlsMode"`
+	SMTPHost    string `json:"smtpHost"`
+	SMTPPort    uint32 `json:"smtpPort"`
+	SMTPTLSMode string `json:"smtpTlsMode"`
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+}
+
+type UnifiedActionSwag struct {
+	Action string `json:"action"`
+	Source string `json:"source"`
+	ID     string `json:"id"`
+	Folder string `json:"folder,omitempty"`
+}
@@ -5,7 +5,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"io"
 	"net/http"
+	"strconv"
 
+	"mail/internal/pkg/utils/client_info"
 	"mail/internal/pkg/utils/sanitize"
 
 	auth_proto "mail/internal/microservice/auth/proto"
@@ -38,6 +40,7 @@ type AuthHandler struct {
 // @Success 200 {object} response.Response "Login successful"
 // @Failure 400 {object} response.ErrorResponse "Invalid request body"
 // @Failure 401 {object} response.ErrorResponse "Invalid credentials"
+// @Failure 429 {object} response.ErrorResponse "Too many login attempts"
 // @Failure 500 {object} response.ErrorResponse "Failed to create session"
 // @Router /api/v1/auth/login [post]
 func (ah *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
@@ -67,7 +70,9 @@ func (ah *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 	sessionId, errStatus := ah.AuthServiceClient.Login(
 		metadata.NewOutgoingContext(r.Context(),
-			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string),
+				"userAgent": r.UserAgent(), "ipAddress": client_info.ClientIP(r),
+				"rememberMe": strconv.FormatBool(credentials.RememberMe)})),
 		&auth_proto.LoginRequest{Login: credentials.Login, Password: credentials.Password},
 	)
 	if errStatus != nil {
@@ -75,6 +80,70 @@ func (ah *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if sessionId.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.FormatInt(sessionId.RetryAfter, 10))
+		response.HandleError(w, http.StatusTooManyRequests, "Too many login attempts")
+		return
+	}
+
+	if sessionId.TwoFactorRequired {
+		response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"TwoFactorRequired": true, "ChallengeID": sessionId.ChallengeId})
+		return
+	}
+
+	er := ah.Sessions.SetSession(sessionId.SessionId, w, r, r.Context())
+	if er != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to create session")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Login successful"})
+}
+
+// LoginTwoFactor completes the login of a user with two-factor authentication enabled.
+// @Summary Login User with the second factor
+// @Description Checks the TOTP or recovery code of a login waiting for the second factor and creates the session.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param code body response.TwoFactorCodeSwag true "Challenge id and code"
+// @Success 200 {object} response.Response "Login successful"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Invalid code"
+// @Failure 500 {object} response.ErrorResponse "Failed to create session"
+// @Router /api/v1/auth/login/2fa [post]
+func (ah *AuthHandler) LoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var twoFactorCode api.TwoFactorCode
+	if err := twoFactorCode.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	twoFactorCode.ChallengeID = sanitize.SanitizeString(twoFactorCode.ChallengeID)
+	twoFactorCode.Code = sanitize.SanitizeString(twoFactorCode.Code)
+
+	if validUtil.IsEmpty(twoFactorCode.ChallengeID) || validUtil.IsEmpty(twoFactorCode.Code) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	sessionId, errStatus := ah.AuthServiceClient.LoginTwoFactor(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string),
+				"userAgent": r.UserAgent(), "ipAddress": client_info.ClientIP(r),
+				"rememberMe": strconv.FormatBool(twoFactorCode.RememberMe)})),
+		&auth_proto.LoginTwoFactorRequest{ChallengeId: twoFactorCode.ChallengeID, Code: twoFactorCode.Code},
+	)
+	if errStatus != nil {
+		response.HandleError(w, http.StatusUnauthorized, "Invalid two-factor code")
+		return
+	}
+
 	er := ah.Sessions.SetSession(sessionId.SessionId, w, r, r.Context())
 	if er != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Failed to create session")
@@ -115,6 +184,7 @@ func (ah *AuthHandler) Signup(w http.ResponseWriter, r *http.Request) {
 	newUser.PhoneNumber = sanitize.SanitizeString(newUser.PhoneNumber)
 	newUser.Description = sanitize.SanitizeString(newUser.Description)
 	newUser.AvatarID = sanitize.SanitizeString(newUser.AvatarID)
+	newUser.RecoveryEmail = sanitize.SanitizeString(newUser.RecoveryEmail)
 
 	if validUtil.IsEmpty(newUser.Login) || validUtil.IsEmpty(newUser.Password) || validUtil.IsEmpty(newUser.FirstName) || validUtil.IsEmpty(newUser.Surname) || !domain.IsValidGender(newUser.Gender) {
 		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
@@ -126,6 +196,11 @@ func (ah *AuthHandler) Signup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if newUser.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(newUser.RecoveryEmail) {
+		response.HandleError(w, http.StatusBadRequest, "Invalid recovery email")
+		return
+	}
+
 	userExists, err := ah.UserServiceClient.GetUserByOnlyLogin(
 		metadata.NewOutgoingContext(r.Context(),
 			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
@@ -142,15 +217,16 @@ func (ah *AuthHandler) Signup(w http.ResponseWriter, r *http.Request) {
 		metadata.NewOutgoingContext(r.Context(),
 			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
 		&auth_proto.SignupRequest{Login: newUser.Login,
-			Password:    newUser.Password,
-			Firstname:   newUser.FirstName,
-			Surname:     newUser.Surname,
-			Patronymic:  newUser.Patronymic,
-			Birthday:    timestamppb.New(newUser.Birthday),
-			Gender:      domain.GetGender(newUser.Gender),
-			Avatar:      newUser.AvatarID,
-			Description: newUser.Description,
-			PhoneNumber: newUser.PhoneNumber,
+			Password:      newUser.Password,
+			Firstname:     newUser.FirstName,
+			Surname:       newUser.Surname,
+			Patronymic:    newUser.Patronymic,
+			Birthday:      timestamppb.New(newUser.Birthday),
+			Gender:        domain.GetGender(newUser.Gender),
+			Avatar:        newUser.AvatarID,
+			Description:   newUser.Description,
+			PhoneNumber:   newUser.PhoneNumber,
+			RecoveryEmail: newUser.RecoveryEmail,
 		},
 	)
 	if errStatus != nil {
@@ -221,6 +221,123 @@ func TestAuthHandler_Login_SessionFailed(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 }
 
+func TestAuthHandler_Login_TwoFactorRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+
+	ah := &AuthHandler{
+		Sessions:          mockSessionsManager,
+		AuthServiceClient: mockAuthServiceClient,
+	}
+
+	reqBody := `{"login": "user@mailhub.su", "password": "password123"}`
+
+	req := httptest.NewRequest("POST", "/api/v1/auth/login", strings.NewReader(reqBody))
+	ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+	req = req.WithContext(ctx)
+
+	w := httptest.NewRecorder()
+
+	mockAuthServiceClient.EXPECT().
+		Login(gomock.Any(), gomock.Any()).
+		Return(&auth_proto.LoginReply{TwoFactorRequired: true, ChallengeId: "challenge"}, nil)
+
+	ah.Login(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"status":200,"body":{"ChallengeID":"challenge","TwoFactorRequired":true}}`+"\n", w.Body.String())
+}
+
+func TestAuthHandler_Login_TooManyAttempts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+
+	ah := &AuthHandler{
+		Sessions:          mockSessionsManager,
+		AuthServiceClient: mockAuthServiceClient,
+	}
+
+	reqBody := `{"login": "user@mailhub.su", "password": "password123"}`
+
+	req := httptest.NewRequest("POST", "/api/v1/auth/login", strings.NewReader(reqBody))
+	ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+	req = req.WithContext(ctx)
+
+	w := httptest.NewRecorder()
+
+	mockAuthServiceClient.EXPECT().
+		Login(gomock.Any(), gomock.Any()).
+		Return(&auth_proto.LoginReply{RetryAfter: 30}, nil)
+
+	ah.Login(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+}
+
+func TestAuthHandler_LoginTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+
+	ah := &AuthHandler{
+		Sessions:          mockSessionsManager,
+		AuthServiceClient: mockAuthServiceClient,
+	}
+
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest("POST", "/api/v1/auth/login/2fa", strings.NewReader(body))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		return req.WithContext(ctx)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newRequest(`{"challengeId": "challenge", "code": "123456"}`)
+		w := httptest.NewRecorder()
+
+		mockAuthServiceClient.EXPECT().
+			LoginTwoFactor(gomock.Any(), &auth_proto.LoginTwoFactorRequest{ChallengeId: "challenge", Code: "123456"}).
+			Return(&auth_proto.LoginReply{SessionId: "123", LoginStatus: true}, nil)
+		mockSessionsManager.EXPECT().
+			SetSession("123", w, req, req.Context()).
+			Return(nil)
+
+		ah.LoginTwoFactor(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("InvalidCode", func(t *testing.T) {
+		req := newRequest(`{"challengeId": "challenge", "code": "000000"}`)
+		w := httptest.NewRecorder()
+
+		mockAuthServiceClient.EXPECT().
+			LoginTwoFactor(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("invalid code"))
+
+		ah.LoginTwoFactor(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("EmptyFields", func(t *testing.T) {
+		req := newRequest(`{"code": "123456"}`)
+		w := httptest.NewRecorder()
+
+		ah.LoginTwoFactor(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
 func TestAuthHandler_Signup_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
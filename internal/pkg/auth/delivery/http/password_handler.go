@@ -0,0 +1,155 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/pkg/utils/sanitize"
+
+	auth_proto "mail/internal/microservice/auth/proto"
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+	sessionManager "mail/internal/pkg/session"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// ChangePassword handles requests to change the password of the user.
+// @Summary Change password
+// @Description Changes the password of the user after checking the current one and signs out all the other sessions.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param password body response.PasswordChangeSwag true "Current and new password"
+// @Success 200 {object} response.Response "Password changed"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 403 {object} response.ErrorResponse "Failed to change password"
+// @Router /api/v1/user/password [post]
+func (ah *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var passwordChange api.PasswordChange
+	if err := passwordChange.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	passwordChange.OldPassword = sanitize.SanitizeString(passwordChange.OldPassword)
+	passwordChange.NewPassword = sanitize.SanitizeString(passwordChange.NewPassword)
+
+	if validUtil.IsEmpty(passwordChange.OldPassword) || validUtil.IsEmpty(passwordChange.NewPassword) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	session := ah.Sessions.GetSession(r, r.Context())
+	if session == nil {
+		response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+		return
+	}
+
+	_, errStatus := ah.AuthServiceClient.ChangePassword(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.PasswordChangeRequest{SessionId: session.ID, OldPassword: passwordChange.OldPassword, NewPassword: passwordChange.NewPassword},
+	)
+	if errStatus != nil {
+		response.HandleError(w, http.StatusForbidden, "Failed to change password")
+		return
+	}
+
+	sessionManager.RotateCsrfTokenAfterAction(ah.Sessions, w, r)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Password changed"})
+}
+
+// RequestPasswordReset handles requests to send a password reset link.
+// @Summary Request password reset
+// @Description Sends a password reset link to the recovery email of the user. The reply does not tell whether the user exists.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param login body response.PasswordResetSwag true "Login of the user"
+// @Success 200 {object} response.Response "Password reset link sent"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Router /api/v1/auth/password/reset/request [post]
+func (ah *AuthHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var passwordReset api.PasswordReset
+	if err := passwordReset.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	passwordReset.Login = sanitize.SanitizeString(passwordReset.Login)
+
+	if validUtil.IsEmpty(passwordReset.Login) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	_, errStatus := ah.AuthServiceClient.RequestPasswordReset(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.PasswordResetLinkRequest{Login: passwordReset.Login},
+	)
+	if errStatus != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid login")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "If the user has a recovery email, the link has been sent to it"})
+}
+
+// ResetPassword handles requests to set a new password with a password reset token.
+// @Summary Reset password
+// @Description Sets a new password with the token from the password reset link and signs out all the sessions of the user.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param password body response.PasswordResetSwag true "Token and new password"
+// @Success 200 {object} response.Response "Password reset"
+// @Failure 400 {object} response.ErrorResponse "Invalid or expired token"
+// @Router /api/v1/auth/password/reset [post]
+func (ah *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var passwordReset api.PasswordReset
+	if err := passwordReset.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	passwordReset.Token = sanitize.SanitizeString(passwordReset.Token)
+	passwordReset.Password = sanitize.SanitizeString(passwordReset.Password)
+
+	if validUtil.IsEmpty(passwordReset.Token) || validUtil.IsEmpty(passwordReset.Password) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	_, errStatus := ah.AuthServiceClient.ResetPassword(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.PasswordResetRequest{Token: passwordReset.Token, NewPassword: passwordReset.Password},
+	)
+	if errStatus != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid or expired token")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Password reset"})
+}
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/utils/constants"
+
+	auth_mock "mail/internal/microservice/auth/mock"
+	auth_proto "mail/internal/microservice/auth/proto"
+	api "mail/internal/models/delivery_models"
+	session_mock "mail/internal/pkg/session/mock"
+)
+
+func newAuthRequest(url, body string) *http.Request {
+	req := httptest.NewRequest("POST", url, strings.NewReader(body))
+
+	return req.WithContext(context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID"))
+}
+
+func TestAuthHandler_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+
+	ah := &AuthHandler{
+		Sessions:          mockSessionsManager,
+		AuthServiceClient: mockAuthServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/user/password", `{"oldPassword":"old","newPassword":"new"}`)
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "current", UserID: 1})
+		mockAuthServiceClient.EXPECT().
+			ChangePassword(gomock.Any(), &auth_proto.PasswordChangeRequest{SessionId: "current", OldPassword: "old", NewPassword: "new"}).
+			Return(&auth_proto.PasswordChangeReply{Status: true}, nil)
+		mockSessionsManager.EXPECT().RotateCsrfToken(w, req, req.Context()).Return(nil)
+
+		ah.ChangePassword(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("EmptyPassword", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/user/password", `{"oldPassword":"old"}`)
+		w := httptest.NewRecorder()
+
+		ah.ChangePassword(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/user/password", `{"oldPassword":"wrong","newPassword":"new"}`)
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "current", UserID: 1})
+		mockAuthServiceClient.EXPECT().ChangePassword(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to change password"))
+
+		ah.ChangePassword(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestAuthHandler_RequestPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+
+	ah := &AuthHandler{AuthServiceClient: mockAuthServiceClient}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/auth/password/reset/request", `{"login":"user@mailhub.su"}`)
+		w := httptest.NewRecorder()
+
+		mockAuthServiceClient.EXPECT().
+			RequestPasswordReset(gomock.Any(), &auth_proto.PasswordResetLinkRequest{Login: "user@mailhub.su"}).
+			Return(&auth_proto.PasswordResetLinkReply{}, nil)
+
+		ah.RequestPasswordReset(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("EmptyLogin", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/auth/password/reset/request", `{}`)
+		w := httptest.NewRecorder()
+
+		ah.RequestPasswordReset(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAuthHandler_ResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+
+	ah := &AuthHandler{AuthServiceClient: mockAuthServiceClient}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/auth/password/reset", `{"token":"abc","password":"new"}`)
+		w := httptest.NewRecorder()
+
+		mockAuthServiceClient.EXPECT().
+			ResetPassword(gomock.Any(), &auth_proto.PasswordResetRequest{Token: "abc", NewPassword: "new"}).
+			Return(&auth_proto.PasswordResetReply{Status: true}, nil)
+
+		ah.ResetPassword(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/auth/password/reset", `{"token":"used","password":"new"}`)
+		w := httptest.NewRecorder()
+
+		mockAuthServiceClient.EXPECT().ResetPassword(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid token"))
+
+		ah.ResetPassword(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
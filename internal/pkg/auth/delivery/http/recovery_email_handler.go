@@ -0,0 +1,145 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/pkg/utils/sanitize"
+
+	auth_proto "mail/internal/microservice/auth/proto"
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+	sessionManager "mail/internal/pkg/session"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// SetRecoveryEmail handles requests to set the address password reset links are sent to.
+// @Summary Set recovery email
+// @Description Sets or removes the external address password reset links are sent to, the password is required. A verification link is sent to the new address, reset links are sent only after it is followed.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param recoveryEmail body response.RecoveryEmailSwag true "Recovery email and password"
+// @Success 200 {object} response.Response "Recovery email updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 403 {object} response.ErrorResponse "Failed to set recovery email"
+// @Router /api/v1/user/recovery-email [put]
+func (ah *AuthHandler) SetRecoveryEmail(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var recoveryEmail api.RecoveryEmail
+	if err := recoveryEmail.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	recoveryEmail.RecoveryEmail = sanitize.SanitizeString(recoveryEmail.RecoveryEmail)
+	recoveryEmail.Password = sanitize.SanitizeString(recoveryEmail.Password)
+	if validUtil.IsEmpty(recoveryEmail.Password) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+	if recoveryEmail.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(recoveryEmail.RecoveryEmail) {
+		response.HandleError(w, http.StatusBadRequest, "Invalid recovery email")
+		return
+	}
+
+	session := ah.Sessions.GetSession(r, r.Context())
+	if session == nil {
+		response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+		return
+	}
+
+	_, errStatus := ah.AuthServiceClient.SetRecoveryEmail(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.RecoveryEmailChangeRequest{SessionId: session.ID, Password: recoveryEmail.Password, RecoveryEmail: recoveryEmail.RecoveryEmail},
+	)
+	if errStatus != nil {
+		response.HandleError(w, http.StatusForbidden, "Failed to set recovery email")
+		return
+	}
+
+	sessionManager.RotateCsrfTokenAfterAction(ah.Sessions, w, r)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Recovery email updated"})
+}
+
+// SendRecoveryEmailVerification handles requests to send the recovery email verification link again.
+// @Summary Resend recovery email verification
+// @Description Sends a new verification link to the recovery email of the user if it is not verified yet
+// @Tags auth
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Verification link sent"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 400 {object} response.ErrorResponse "No recovery email to verify"
+// @Router /api/v1/user/recovery-email/verify/send [post]
+func (ah *AuthHandler) SendRecoveryEmailVerification(w http.ResponseWriter, r *http.Request) {
+	session := ah.Sessions.GetSession(r, r.Context())
+	if session == nil {
+		response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+		return
+	}
+
+	_, errStatus := ah.AuthServiceClient.SendRecoveryEmailVerification(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.RecoveryEmailVerificationRequest{SessionId: session.ID},
+	)
+	if errStatus != nil {
+		response.HandleError(w, http.StatusBadRequest, "No recovery email to verify")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Verification link sent"})
+}
+
+// VerifyRecoveryEmail handles requests to verify the recovery email with the token from the verification link.
+// @Summary Verify recovery email
+// @Description Confirms the recovery email with the token from the link sent to it, the link is valid for 24 hours
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param token body response.RecoveryEmailVerificationSwag true "Token from the verification link"
+// @Success 200 {object} response.Response "Recovery email verified"
+// @Failure 400 {object} response.ErrorResponse "Invalid or expired token"
+// @Router /api/v1/auth/recovery-email/verify [post]
+func (ah *AuthHandler) VerifyRecoveryEmail(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var verification api.RecoveryEmailVerification
+	if err := verification.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	verification.Token = sanitize.SanitizeString(verification.Token)
+
+	if validUtil.IsEmpty(verification.Token) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	_, errStatus := ah.AuthServiceClient.VerifyRecoveryEmail(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.VerifyRecoveryEmailRequest{Token: verification.Token},
+	)
+	if errStatus != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid or expired token")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Recovery email verified"})
+}
@@ -0,0 +1,132 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	auth_mock "mail/internal/microservice/auth/mock"
+	auth_proto "mail/internal/microservice/auth/proto"
+	api "mail/internal/models/delivery_models"
+	session_mock "mail/internal/pkg/session/mock"
+)
+
+func TestAuthHandler_SetRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+
+	ah := &AuthHandler{
+		Sessions:          mockSessionsManager,
+		AuthServiceClient: mockAuthServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/user/recovery-email", `{"recoveryEmail":"user@example.com","password":"pass"}`)
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "current", UserID: 1})
+		mockAuthServiceClient.EXPECT().
+			SetRecoveryEmail(gomock.Any(), &auth_proto.RecoveryEmailChangeRequest{SessionId: "current", Password: "pass", RecoveryEmail: "user@example.com"}).
+			Return(&auth_proto.RecoveryEmailChangeReply{Status: true}, nil)
+		mockSessionsManager.EXPECT().RotateCsrfToken(w, req, req.Context()).Return(nil)
+
+		ah.SetRecoveryEmail(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("InvalidEmail", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/user/recovery-email", `{"recoveryEmail":"user@mailhub.su","password":"pass"}`)
+		w := httptest.NewRecorder()
+
+		ah.SetRecoveryEmail(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/user/recovery-email", `{"recoveryEmail":"user@example.com","password":"wrong"}`)
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "current", UserID: 1})
+		mockAuthServiceClient.EXPECT().SetRecoveryEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to set recovery email"))
+
+		ah.SetRecoveryEmail(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestAuthHandler_SendRecoveryEmailVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+
+	ah := &AuthHandler{
+		Sessions:          mockSessionsManager,
+		AuthServiceClient: mockAuthServiceClient,
+	}
+
+	req := newAuthRequest("/api/v1/user/recovery-email/verify/send", "")
+	w := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "current", UserID: 1})
+	mockAuthServiceClient.EXPECT().
+		SendRecoveryEmailVerification(gomock.Any(), &auth_proto.RecoveryEmailVerificationRequest{SessionId: "current"}).
+		Return(&auth_proto.RecoveryEmailVerificationReply{}, nil)
+
+	ah.SendRecoveryEmailVerification(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAuthHandler_VerifyRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthServiceClient := auth_mock.NewMockAuthServiceClient(ctrl)
+
+	ah := &AuthHandler{AuthServiceClient: mockAuthServiceClient}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/auth/recovery-email/verify", `{"token":"abc.123.def"}`)
+		w := httptest.NewRecorder()
+
+		mockAuthServiceClient.EXPECT().
+			VerifyRecoveryEmail(gomock.Any(), &auth_proto.VerifyRecoveryEmailRequest{Token: "abc.123.def"}).
+			Return(&auth_proto.VerifyRecoveryEmailReply{Status: true}, nil)
+
+		ah.VerifyRecoveryEmail(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("EmptyToken", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/auth/recovery-email/verify", `{}`)
+		w := httptest.NewRecorder()
+
+		ah.VerifyRecoveryEmail(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		req := newAuthRequest("/api/v1/auth/recovery-email/verify", `{"token":"forged"}`)
+		w := httptest.NewRecorder()
+
+		mockAuthServiceClient.EXPECT().VerifyRecoveryEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid verification token"))
+
+		ah.VerifyRecoveryEmail(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/email/proto"
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/models/response"
+	"mail/internal/pkg/utils/constants"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/models/delivery_converters"
+	emailApi "mail/internal/models/delivery_models"
+)
+
+// Bulk applies one action to a batch of emails.
+// @Summary Apply an action to a batch of emails
+// @Description Mark read or unread, flag or unflag, mark spam or not spam, delete, move to a folder or put a label on up to 100 emails at once.
+// @Description The batch runs in one transaction and the outcome is reported for each email.
+// @Tags emails
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param request body response.BulkRequestSwag true "Action and emails in JSON format"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
+// @Success 200 {object} response.Response "Outcome for each email"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to apply bulk action"
+// @Router /api/v1/emails/bulk [post]
+func (h *EmailHandler) Bulk(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var request emailApi.BulkRequest
+	if err := request.UnmarshalJSON(body); err != nil || request.Action == "" || len(request.EmailIDs) == 0 {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	mailbox, err := h.requestMailbox(login, domain.MailboxPermissionWrite, r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user login")
+		return
+	}
+
+	resultsDataProto, err := h.EmailServiceClient.BulkEmails(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.BulkEmailsRequest{
+			Action:   request.Action,
+			EmailIds: request.EmailIDs,
+			FolderId: request.FolderID,
+			LabelId:  request.LabelID,
+			Login:    mailbox,
+		},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to apply bulk action")
+		return
+	}
+
+	resultsApi := make([]*emailApi.BulkResult, 0, len(resultsDataProto.Results))
+	for _, result := range resultsDataProto.Results {
+		resultsApi = append(resultsApi, converters.BulkResultConvertCoreInApi(*proto_converters.BulkResultConvertProtoInCore(result)))
+		if result.Success {
+			h.recordDelegateAction(r, mailbox, login, domain.DelegateActionBulk+request.Action, result.EmailId)
+		}
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"results": resultsApi})
+}
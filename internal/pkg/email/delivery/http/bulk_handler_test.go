@@ -0,0 +1,78 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/utils/constants"
+
+	email_mock "mail/internal/microservice/email/mock"
+	email_proto "mail/internal/microservice/email/proto"
+	mockSession "mail/internal/pkg/session/mock"
+)
+
+func TestBulk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	login := "test@mailhub.su"
+
+	t.Run("Success", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/emails/bulk", bytes.NewReader([]byte(`{"action":"move","emailIds":[1,2],"folderId":3}`)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
+		mockEmailServiceClient.EXPECT().BulkEmails(gomock.Any(), &email_proto.BulkEmailsRequest{Action: "move", EmailIds: []uint64{1, 2}, FolderId: 3, Login: login}).
+			Return(&email_proto.BulkEmailsResults{Results: []*email_proto.BulkEmailResult{
+				{EmailId: 1, Success: true},
+				{EmailId: 2, Success: false, Error: "email not found"},
+			}}, nil)
+
+		emailHandler.Bulk(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `{"emailId":2,"success":false,"error":"email not found"}`)
+	})
+
+	t.Run("NoEmails", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/emails/bulk", bytes.NewReader([]byte(`{"action":"read"}`)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		emailHandler.Bulk(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("FailedBulkEmails", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/emails/bulk", bytes.NewReader([]byte(`{"action":"read","emailIds":[1]}`)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
+		mockEmailServiceClient.EXPECT().BulkEmails(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("failed bulk operation"))
+
+		emailHandler.Bulk(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/microcosm-cc/bluemonday"
@@ -31,6 +32,7 @@ import (
 	"mail/internal/pkg/utils/generate_filename"
 	"mail/internal/pkg/utils/validators"
 
+	domain "mail/internal/microservice/models/domain_models"
 	email_proto "mail/internal/microservice/email/proto"
 	converters "mail/internal/models/delivery_converters"
 	emailApi "mail/internal/models/delivery_models"
@@ -61,19 +63,28 @@ func sanitizeString(str string) string {
 // @Tags emails
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
+// @Param label query integer false "ID of the label to filter emails by"
 // @Success 200 {object} response.Response "List of all email messages"
+// @Failure 400 {object} response.Response "Bad label in request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 404 {object} response.Response "DB error"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/emails/incoming [get]
 func (h *EmailHandler) Incoming(w http.ResponseWriter, r *http.Request) {
+	labelID, err := labelFilterFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad label in request")
+		return
+	}
+
 	login, err := h.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
 		return
 	}
 
-	err = h.Sessions.CheckLogin(login, r, r.Context())
+	login, err = h.requestMailbox(login, domain.MailboxPermissionRead, r)
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user login")
 		return
@@ -88,7 +99,7 @@ func (h *EmailHandler) Incoming(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	emailsCore := proto_converters.EmailsConvertProtoInCore(emailDataProto)
+	emailsCore := domain.FilterEmailsByLabel(proto_converters.EmailsConvertProtoInCore(emailDataProto), labelID)
 
 	emailsApi := make([]*emailApi.Email, 0, len(emailsCore))
 	for _, email := range emailsCore {
@@ -104,19 +115,28 @@ func (h *EmailHandler) Incoming(w http.ResponseWriter, r *http.Request) {
 // @Tags emails
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
+// @Param label query integer false "ID of the label to filter emails by"
 // @Success 200 {object} response.Response "List of all email messages"
+// @Failure 400 {object} response.Response "Bad label in request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 404 {object} response.Response "DB error"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/emails/sent [get]
 func (h *EmailHandler) Sent(w http.ResponseWriter, r *http.Request) {
+	labelID, err := labelFilterFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad label in request")
+		return
+	}
+
 	login, err := h.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
 		return
 	}
 
-	err = h.Sessions.CheckLogin(login, r, r.Context())
+	login, err = h.requestMailbox(login, domain.MailboxPermissionRead, r)
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user login")
 		return
@@ -131,7 +151,7 @@ func (h *EmailHandler) Sent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	emailsCore := proto_converters.EmailsConvertProtoInCore(emailDataProto)
+	emailsCore := domain.FilterEmailsByLabel(proto_converters.EmailsConvertProtoInCore(emailDataProto), labelID)
 
 	emailsApi := make([]*emailApi.Email, 0, len(emailsCore))
 	for _, email := range emailsCore {
@@ -148,6 +168,7 @@ func (h *EmailHandler) Sent(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path integer true "ID of the email message"
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
 // @Success 200 {object} response.Response "Email message data"
 // @Failure 400 {object} response.Response "Bad id in request"
 // @Failure 401 {object} response.Response "Not Authorized"
@@ -167,7 +188,7 @@ func (h *EmailHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.Sessions.CheckLogin(login, r, r.Context())
+	login, err = h.requestMailbox(login, domain.MailboxPermissionRead, r)
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 		return
@@ -186,6 +207,85 @@ func (h *EmailHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"email": converters.EmailConvertCoreInApi(*emailData)})
 }
 
+// GetRawByID returns an email message with its attachments in the MIME format, ready to be opened by a mail client.
+// The emails delivered through a mailing list carry the List-Id and List-Unsubscribe headers of the list.
+// @Summary Download an email message
+// @Description Download an email message as an .eml file
+// @Tags emails
+// @Produce message/rfc822
+// @Param id path integer true "ID of the email message"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
+// @Success 200 {file} file "Email message"
+// @Failure 400 {object} response.Response "Bad id in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Email not found"
+// @Failure 500 {object} response.Response "Failed to compose mail"
+// @Router /api/v1/email/{id}/raw [get]
+func (h *EmailHandler) GetRawByID(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad sender session")
+		return
+	}
+
+	login, err = h.requestMailbox(login, domain.MailboxPermissionRead, r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad sender login")
+		return
+	}
+
+	ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)}))
+	emailDataProto, err := h.EmailServiceClient.GetEmailByID(ctx, &proto.EmailIdAndLogin{Id: id, Login: login})
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Email not found")
+		return
+	}
+
+	filesProto, err := h.EmailServiceClient.GetFilesByEmailID(ctx, &proto.GetFilesByEmailIDRequest{EmailId: emailDataProto.Id})
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Failed to get files")
+		return
+	}
+
+	attachments := make(map[string][]byte)
+	for _, file := range filesProto.Files {
+		fileData, err := downloadFile(file.FileId)
+		if err != nil {
+			response.HandleError(w, http.StatusInternalServerError, "Failed to download file")
+			return
+		}
+		attachments[file.FileName] = fileData
+	}
+
+	headers := map[string]string{"Date": emailDataProto.DateOfDispatch.AsTime().Format(time.RFC1123Z)}
+	if emailDataProto.ListId != "" {
+		headers["List-Id"] = emailDataProto.ListId
+	}
+	if emailDataProto.ListUnsubscribe != "" {
+		headers["List-Unsubscribe"] = emailDataProto.ListUnsubscribe
+		headers["List-Unsubscribe-Post"] = domain.ListUnsubscribePost
+	}
+
+	msg, err := composeMimeMail(emailDataProto.RecipientEmail, emailDataProto.SenderEmail, emailDataProto.Topic, emailDataProto.Text, attachments, headers)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to compose mail")
+		return
+	}
+
+	w.Header().Set("Content-Type", "message/rfc822")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fmt.Sprintf("%d.eml", id)}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(msg)
+}
+
 // Send adds a new email message.
 // @Summary Send a new email message
 // @Description Send a new email message to the system
@@ -227,7 +327,7 @@ func (h *EmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 
 	switch {
 	case validators.IsValidEmailFormat(sender) && validators.IsValidEmailFormat(recipient):
-		err = h.Sessions.CheckLogin(sender, r, r.Context())
+		delegate, err := h.Sessions.CheckMailboxAccess(sender, domain.MailboxPermissionWrite, r, r.Context())
 		if err != nil {
 			response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 			return
@@ -235,7 +335,7 @@ func (h *EmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 
 		_, err = h.EmailServiceClient.CheckRecipientEmail(
 			metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
-			&proto.Recipient{Recipient: recipient},
+			&proto.Recipient{Recipient: recipient, Sender: sender},
 		)
 		if err != nil {
 			response.HandleError(w, http.StatusBadRequest, "Bad login")
@@ -258,6 +358,7 @@ func (h *EmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 				SpamStatus:     newEmail.SpamStatus,
 				SenderEmail:    sender,
 				RecipientEmail: recipient,
+				SentByEmail:    delegate,
 			},
 		)
 		if err != nil {
@@ -276,10 +377,12 @@ func (h *EmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		h.recordDelegateAction(r, sender, delegate, domain.DelegateActionSend, emailData.ID)
+
 		response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"email": converters.EmailConvertCoreInApi(*emailData)})
 		return
 	case validators.IsValidEmailFormat(sender) && !validators.IsValidEmailFormat(recipient):
-		err = h.Sessions.CheckLogin(sender, r, r.Context())
+		delegate, err := h.Sessions.CheckMailboxAccess(sender, domain.MailboxPermissionWrite, r, r.Context())
 		if err != nil {
 			response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 			return
@@ -301,6 +404,7 @@ func (h *EmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 				SpamStatus:     newEmail.SpamStatus,
 				SenderEmail:    sender,
 				RecipientEmail: recipient,
+				SentByEmail:    delegate,
 			},
 		)
 		if err != nil {
@@ -319,12 +423,14 @@ func (h *EmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		h.recordDelegateAction(r, sender, delegate, domain.DelegateActionSend, emailData.ID)
+
 		response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"email": converters.EmailConvertCoreInApi(*emailData)})
 		return
 	case !validators.IsValidEmailFormat(sender) && validators.IsValidEmailFormat(recipient):
 		_, err = h.EmailServiceClient.CheckRecipientEmail(
 			metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
-			&proto.Recipient{Recipient: recipient},
+			&proto.Recipient{Recipient: recipient, Sender: sender},
 		)
 		if err != nil {
 			response.HandleError(w, http.StatusBadRequest, "Bad login")
@@ -444,8 +550,8 @@ func addAttachment(writer *multipart.Writer, fileName string, fileData []byte) e
 	return err
 }
 
-// composeMimeMail creates a MIME email with attachments.
-func composeMimeMail(to string, from string, subject string, body string, attachments map[string][]byte) ([]byte, error) {
+// composeMimeMail creates a MIME email with attachments, the extra headers are added to the standard ones.
+func composeMimeMail(to string, from string, subject string, body string, attachments map[string][]byte, extraHeaders map[string]string) ([]byte, error) {
 	var msg bytes.Buffer
 	writer := multipart.NewWriter(&msg)
 	boundary := writer.Boundary()
@@ -456,6 +562,9 @@ func composeMimeMail(to string, from string, subject string, body string, attach
 	header["Subject"] = encodeRFC2047(subject)
 	header["MIME-Version"] = "1.0"
 	header["Content-Type"] = fmt.Sprintf(`multipart/mixed; boundary="%s"`, boundary)
+	for k, v := range extraHeaders {
+		header[k] = v
+	}
 
 	for k, v := range header {
 		msg.WriteString(fmt.Sprintf("%s: %s\r\n", k, v))
@@ -564,7 +673,7 @@ func (h *EmailHandler) SendEmailToOtherDomains(w http.ResponseWriter, r *http.Re
 			return
 		}
 
-		msg, err := composeMimeMail(emailDataProto.RecipientEmail, emailDataProto.SenderEmail, emailDataProto.Topic, emailDataProto.Text, attachments)
+		msg, err := composeMimeMail(emailDataProto.RecipientEmail, emailDataProto.SenderEmail, emailDataProto.Topic, emailDataProto.Text, attachments, nil)
 		if err != nil {
 			response.HandleError(w, http.StatusBadRequest, "Failed to compose mail")
 			return
@@ -624,9 +733,13 @@ func (h *EmailHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	updatedEmail.ID = id
 
-	err1 := h.Sessions.CheckLogin(updatedEmail.SenderEmail, r, r.Context())
-	err2 := h.Sessions.CheckLogin(updatedEmail.RecipientEmail, r, r.Context())
-	if err1 != nil && err2 != nil {
+	mailbox := updatedEmail.SenderEmail
+	delegate, err := h.Sessions.CheckMailboxAccess(mailbox, domain.MailboxPermissionWrite, r, r.Context())
+	if err != nil {
+		mailbox = updatedEmail.RecipientEmail
+		delegate, err = h.Sessions.CheckMailboxAccess(mailbox, domain.MailboxPermissionWrite, r, r.Context())
+	}
+	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 		return
 	}
@@ -654,6 +767,8 @@ func (h *EmailHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.recordDelegateAction(r, mailbox, delegate, domain.DelegateActionUpdate, updatedEmail.ID)
+
 	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": emailDataProto.Status})
 }
 
@@ -664,6 +779,7 @@ func (h *EmailHandler) Update(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path integer true "ID of the email message"
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
 // @Success 200 {object} response.Response "Deletion success status"
 // @Failure 400 {object} response.Response "Bad id"
 // @Failure 401 {object} response.Response "Not Authorized"
@@ -683,7 +799,7 @@ func (h *EmailHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.Sessions.CheckLogin(login, r, r.Context())
+	mailbox, err := h.requestMailbox(login, domain.MailboxPermissionWrite, r)
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 		return
@@ -691,13 +807,15 @@ func (h *EmailHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	emailDataProto, err := h.EmailServiceClient.DeleteEmail(
 		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
-		&proto.LoginWithID{Id: id, Login: login},
+		&proto.LoginWithID{Id: id, Login: mailbox},
 	)
 	if err != nil || !emailDataProto.Status {
 		response.HandleError(w, http.StatusInternalServerError, "Failed to delete email message")
 		return
 	}
 
+	h.recordDelegateAction(r, mailbox, login, domain.DelegateActionDelete, id)
+
 	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": emailDataProto.Status})
 }
 
@@ -707,19 +825,28 @@ func (h *EmailHandler) Delete(w http.ResponseWriter, r *http.Request) {
 // @Tags emails
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
+// @Param label query integer false "ID of the label to filter emails by"
 // @Success 200 {object} response.Response "List of all email messages"
+// @Failure 400 {object} response.Response "Bad label in request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 404 {object} response.Response "DB error"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/emails/draft [get]
 func (h *EmailHandler) Draft(w http.ResponseWriter, r *http.Request) {
+	labelID, err := labelFilterFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad label in request")
+		return
+	}
+
 	login, err := h.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
 		return
 	}
 
-	err = h.Sessions.CheckLogin(login, r, r.Context())
+	login, err = h.requestMailbox(login, domain.MailboxPermissionRead, r)
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user login")
 		return
@@ -734,7 +861,7 @@ func (h *EmailHandler) Draft(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	emailsCore := proto_converters.EmailsConvertProtoInCore(emailDataProto)
+	emailsCore := domain.FilterEmailsByLabel(proto_converters.EmailsConvertProtoInCore(emailDataProto), labelID)
 
 	emailsApi := make([]*emailApi.Email, 0, len(emailsCore))
 	for _, email := range emailsCore {
@@ -750,19 +877,28 @@ func (h *EmailHandler) Draft(w http.ResponseWriter, r *http.Request) {
 // @Tags emails
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param mailbox query string false "Shared mailbox to work with instead of the user's own"
+// @Param label query integer false "ID of the label to filter emails by"
 // @Success 200 {object} response.Response "List of all email messages"
+// @Failure 400 {object} response.Response "Bad label in request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 404 {object} response.Response "DB error"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/emails/spam [get]
 func (h *EmailHandler) Spam(w http.ResponseWriter, r *http.Request) {
+	labelID, err := labelFilterFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad label in request")
+		return
+	}
+
 	login, err := h.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
 		return
 	}
 
-	err = h.Sessions.CheckLogin(login, r, r.Context())
+	login, err = h.requestMailbox(login, domain.MailboxPermissionRead, r)
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user login")
 		return
@@ -777,7 +913,7 @@ func (h *EmailHandler) Spam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	emailsCore := proto_converters.EmailsConvertProtoInCore(emailDataProto)
+	emailsCore := domain.FilterEmailsByLabel(proto_converters.EmailsConvertProtoInCore(emailDataProto), labelID)
 
 	emailsApi := make([]*emailApi.Email, 0, len(emailsCore))
 	for _, email := range emailsCore {
@@ -831,7 +967,7 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if validators.IsEmpty(recipient) && validators.IsValidEmailFormat(sender) {
-		err = h.Sessions.CheckLogin(sender, r, r.Context())
+		delegate, err := h.Sessions.CheckMailboxAccess(sender, domain.MailboxPermissionWrite, r, r.Context())
 		if err != nil {
 			response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 			return
@@ -853,6 +989,7 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 				SpamStatus:     newEmail.SpamStatus,
 				SenderEmail:    sender,
 				RecipientEmail: recipient,
+				SentByEmail:    delegate,
 			},
 		)
 		if err != nil {
@@ -862,12 +999,14 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 		emailData := proto_converters.EmailConvertProtoInCore(emailDataProto.Email)
 		emailData.ID = emailDataProto.Id
 
+		h.recordDelegateAction(r, sender, delegate, domain.DelegateActionDraft, emailData.ID)
+
 		response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"email": converters.EmailConvertCoreInApi(*emailData)})
 		return
 	} else {
 		switch {
 		case validators.IsValidEmailFormat(sender) && validators.IsValidEmailFormat(recipient):
-			err = h.Sessions.CheckLogin(sender, r, r.Context())
+			delegate, err := h.Sessions.CheckMailboxAccess(sender, domain.MailboxPermissionWrite, r, r.Context())
 			if err != nil {
 				response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 				return
@@ -875,7 +1014,7 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 
 			_, err = h.EmailServiceClient.CheckRecipientEmail(
 				metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
-				&proto.Recipient{Recipient: recipient},
+				&proto.Recipient{Recipient: recipient, Sender: sender},
 			)
 			if err != nil {
 				response.HandleError(w, http.StatusBadRequest, "Bad login")
@@ -898,6 +1037,7 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 					SpamStatus:     newEmail.SpamStatus,
 					SenderEmail:    sender,
 					RecipientEmail: recipient,
+					SentByEmail:    delegate,
 				},
 			)
 			if err != nil {
@@ -916,10 +1056,12 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 
+			h.recordDelegateAction(r, sender, delegate, domain.DelegateActionSend, emailData.ID)
+
 			response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"email": converters.EmailConvertCoreInApi(*emailData)})
 			return
 		case validators.IsValidEmailFormat(sender) && !validators.IsValidEmailFormat(recipient):
-			err = h.Sessions.CheckLogin(sender, r, r.Context())
+			delegate, err := h.Sessions.CheckMailboxAccess(sender, domain.MailboxPermissionWrite, r, r.Context())
 			if err != nil {
 				response.HandleError(w, http.StatusBadRequest, "Bad sender login")
 				return
@@ -941,6 +1083,7 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 					SpamStatus:     newEmail.SpamStatus,
 					SenderEmail:    sender,
 					RecipientEmail: recipient,
+					SentByEmail:    delegate,
 				},
 			)
 			if err != nil {
@@ -959,12 +1102,14 @@ func (h *EmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 
+			h.recordDelegateAction(r, sender, delegate, domain.DelegateActionSend, emailData.ID)
+
 			response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"email": converters.EmailConvertCoreInApi(*emailData)})
 			return
 		case !validators.IsValidEmailFormat(sender) && validators.IsValidEmailFormat(recipient):
 			_, err = h.EmailServiceClient.CheckRecipientEmail(
 				metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
-				&proto.Recipient{Recipient: recipient},
+				&proto.Recipient{Recipient: recipient, Sender: sender},
 			)
 			if err != nil {
 				response.HandleError(w, http.StatusBadRequest, "Bad login")
@@ -55,7 +55,6 @@ func TestGelAllIncoming(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetAllIncoming(gomock.Any(), gomock.Any()).Return(mockEmails, nil)
 
 		emailHandler.Incoming(w, r)
@@ -75,15 +74,15 @@ func TestGelAllIncoming(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
-	t.Run("GelAllIncoming Fail in CheckLogin", func(t *testing.T) {
-		req := httptest.NewRequest("GET", "/api/v1/emails/incoming", bytes.NewReader([]byte(``)))
+	t.Run("GelAllIncoming Fail in CheckMailboxAccess", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/emails/incoming?mailbox=shared@mailhub.su", bytes.NewReader([]byte(``)))
 		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
 		r := req.WithContext(ctx)
 
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(fmt.Errorf("CheckLogin"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess("shared@mailhub.su", "read", r, ctx).Return("", fmt.Errorf("CheckMailboxAccess"))
 
 		emailHandler.Incoming(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -109,7 +108,6 @@ func TestGelAllIncoming(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetAllIncoming(gomock.Any(), gomock.Any()).Return(mockEmails, fmt.Errorf("GetAllEmailsIncoming"))
 
 		emailHandler.Incoming(w, r)
@@ -151,7 +149,6 @@ func TestGelAllSent(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetAllSent(gomock.Any(), gomock.Any()).Return(mockEmails, nil)
 
 		emailHandler.Sent(w, r)
@@ -171,15 +168,15 @@ func TestGelAllSent(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
-	t.Run("GelAllSent Fail in CheckLogin", func(t *testing.T) {
-		req := httptest.NewRequest("GET", "/api/v1/emails/sent", bytes.NewReader([]byte(``)))
+	t.Run("GelAllSent Fail in CheckMailboxAccess", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/emails/sent?mailbox=shared@mailhub.su", bytes.NewReader([]byte(``)))
 		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
 		r := req.WithContext(ctx)
 
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(fmt.Errorf("CheckLogin"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess("shared@mailhub.su", "read", r, ctx).Return("", fmt.Errorf("CheckMailboxAccess"))
 
 		emailHandler.Sent(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -205,7 +202,6 @@ func TestGelAllSent(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetAllSent(gomock.Any(), gomock.Any()).Return(mockEmails, fmt.Errorf("GetAllEmailsIncoming"))
 
 		emailHandler.Sent(w, r)
@@ -237,7 +233,6 @@ func TestGetByID(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, r.Context()).Return(nil)
 		mockEmailServiceClient.EXPECT().GetEmailByID(gomock.Any(), gomock.Any()).Return(&email_proto.Email{}, nil)
 
 		emailHandler.GetByID(w, r)
@@ -259,8 +254,8 @@ func TestGetByID(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
-	t.Run("GetByID CheckLogin", func(t *testing.T) {
-		req := httptest.NewRequest("GET", "/api/v1/email/{id}", bytes.NewReader([]byte(``)))
+	t.Run("GetByID CheckMailboxAccess", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/email/{id}?mailbox=shared@mailhub.su", bytes.NewReader([]byte(``)))
 		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
 		r := req.WithContext(ctx)
 		vars := map[string]string{"id": "1"}
@@ -269,7 +264,7 @@ func TestGetByID(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, r.Context()).Return(fmt.Errorf("CheckLogin"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess("shared@mailhub.su", "read", r, r.Context()).Return("", fmt.Errorf("CheckMailboxAccess"))
 
 		emailHandler.GetByID(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -285,7 +280,6 @@ func TestGetByID(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, r.Context()).Return(nil)
 		mockEmailServiceClient.EXPECT().GetEmailByID(gomock.Any(), gomock.Any()).Return(&email_proto.Email{}, fmt.Errorf("GetEmailByID"))
 
 		emailHandler.GetByID(w, r)
@@ -324,8 +318,7 @@ func TestUpdate(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		mockSessionsManager.EXPECT().CheckLogin(newEmail.SenderEmail, r, r.Context()).Return(nil)
-		mockSessionsManager.EXPECT().CheckLogin(newEmail.RecipientEmail, r, r.Context()).Return(nil)
+		mockSessionsManager.EXPECT().CheckMailboxAccess(newEmail.SenderEmail, "write", r, r.Context()).Return(newEmail.SenderEmail, nil)
 		mockEmailServiceClient.EXPECT().UpdateEmail(gomock.Any(), gomock.Any()).Return(&email_proto.StatusEmail{Status: true}, nil)
 
 		emailHandler.Update(w, r)
@@ -341,8 +334,8 @@ func TestUpdate(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		mockSessionsManager.EXPECT().CheckLogin(newEmail.SenderEmail, r, r.Context()).Return(fmt.Errorf("CheckLogin"))
-		mockSessionsManager.EXPECT().CheckLogin(newEmail.RecipientEmail, r, r.Context()).Return(fmt.Errorf("CheckLogin"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess(newEmail.SenderEmail, "write", r, r.Context()).Return("", fmt.Errorf("CheckMailboxAccess"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess(newEmail.RecipientEmail, "write", r, r.Context()).Return("", fmt.Errorf("CheckMailboxAccess"))
 
 		emailHandler.Update(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -357,8 +350,7 @@ func TestUpdate(t *testing.T) {
 
 		w := httptest.NewRecorder()
 
-		mockSessionsManager.EXPECT().CheckLogin(newEmail.SenderEmail, r, r.Context()).Return(nil)
-		mockSessionsManager.EXPECT().CheckLogin(newEmail.RecipientEmail, r, r.Context()).Return(nil)
+		mockSessionsManager.EXPECT().CheckMailboxAccess(newEmail.SenderEmail, "write", r, r.Context()).Return(newEmail.SenderEmail, nil)
 		mockEmailServiceClient.EXPECT().UpdateEmail(gomock.Any(), gomock.Any()).Return(&email_proto.StatusEmail{Status: false}, fmt.Errorf("UpdateEmail"))
 
 		emailHandler.Update(w, r)
@@ -390,7 +382,6 @@ func TestDelete(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, r.Context()).Return(nil)
 		mockEmailServiceClient.EXPECT().DeleteEmail(gomock.Any(), gomock.Any()).Return(&email_proto.StatusEmail{Status: true}, nil)
 
 		emailHandler.Delete(w, r)
@@ -412,8 +403,8 @@ func TestDelete(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
-	t.Run("Delete Fail CheckLogin", func(t *testing.T) {
-		req := httptest.NewRequest("POST", "/api/v1/email/delete/{id}", bytes.NewReader([]byte("")))
+	t.Run("Delete Fail CheckMailboxAccess", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/email/delete/{id}?mailbox=shared@mailhub.su", bytes.NewReader([]byte("")))
 		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
 		r := req.WithContext(ctx)
 		vars := map[string]string{"id": "1"}
@@ -422,7 +413,7 @@ func TestDelete(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, r.Context()).Return(fmt.Errorf("CheckLogin"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess("shared@mailhub.su", "write", r, r.Context()).Return("", fmt.Errorf("CheckMailboxAccess"))
 
 		emailHandler.Delete(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -438,7 +429,6 @@ func TestDelete(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, r.Context()).Return(nil)
 		mockEmailServiceClient.EXPECT().DeleteEmail(gomock.Any(), gomock.Any()).Return(&email_proto.StatusEmail{Status: false}, fmt.Errorf("DeleteEmail"))
 
 		emailHandler.Delete(w, r)
@@ -480,7 +470,6 @@ func TestDraft(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetDraftEmails(gomock.Any(), gomock.Any()).Return(mockEmails, nil)
 
 		emailHandler.Draft(w, r)
@@ -500,15 +489,15 @@ func TestDraft(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
-	t.Run("GelAllSent Fail in CheckLogin", func(t *testing.T) {
-		req := httptest.NewRequest("GET", "/api/v1/emails/sent", bytes.NewReader([]byte(``)))
+	t.Run("GelAllSent Fail in CheckMailboxAccess", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/emails/sent?mailbox=shared@mailhub.su", bytes.NewReader([]byte(``)))
 		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
 		r := req.WithContext(ctx)
 
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(fmt.Errorf("CheckLogin"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess("shared@mailhub.su", "read", r, ctx).Return("", fmt.Errorf("CheckMailboxAccess"))
 
 		emailHandler.Draft(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -534,7 +523,6 @@ func TestDraft(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetDraftEmails(gomock.Any(), gomock.Any()).Return(mockEmails, fmt.Errorf("GetAllEmailsIncoming"))
 
 		emailHandler.Draft(w, r)
@@ -576,7 +564,6 @@ func TestSpam(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetSpamEmails(gomock.Any(), gomock.Any()).Return(mockEmails, nil)
 
 		emailHandler.Spam(w, r)
@@ -596,15 +583,15 @@ func TestSpam(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
-	t.Run("GelAllSent Fail in CheckLogin", func(t *testing.T) {
-		req := httptest.NewRequest("GET", "/api/v1/emails/sent", bytes.NewReader([]byte(``)))
+	t.Run("GelAllSent Fail in CheckMailboxAccess", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/emails/sent?mailbox=shared@mailhub.su", bytes.NewReader([]byte(``)))
 		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
 		r := req.WithContext(ctx)
 
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(fmt.Errorf("CheckLogin"))
+		mockSessionsManager.EXPECT().CheckMailboxAccess("shared@mailhub.su", "read", r, ctx).Return("", fmt.Errorf("CheckMailboxAccess"))
 
 		emailHandler.Spam(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -630,7 +617,6 @@ func TestSpam(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
-		mockSessionsManager.EXPECT().CheckLogin(login, r, ctx).Return(nil)
 		mockEmailServiceClient.EXPECT().GetSpamEmails(gomock.Any(), gomock.Any()).Return(mockEmails, fmt.Errorf("GetAllEmailsIncoming"))
 
 		emailHandler.Spam(w, r)
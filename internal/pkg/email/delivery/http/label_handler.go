@@ -0,0 +1,382 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/email/proto"
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/models/response"
+	"mail/internal/pkg/utils/constants"
+
+	converters "mail/internal/models/delivery_converters"
+	emailApi "mail/internal/models/delivery_models"
+)
+
+// labelFilterFromRequest parses the optional label query parameter used to filter email lists;
+// zero means the list is not filtered.
+func labelFilterFromRequest(r *http.Request) (uint32, error) {
+	label := r.URL.Query().Get("label")
+	if label == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseUint(label, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(id), nil
+}
+
+// labelEmailIDs returns the emails of the request, whether a single email or a batch is passed.
+func labelEmailIDs(labelEmails emailApi.LabelEmails) []uint64 {
+	emailIDs := labelEmails.EmailIDs
+	if labelEmails.EmailID != 0 {
+		emailIDs = append(emailIDs, labelEmails.EmailID)
+	}
+
+	return emailIDs
+}
+
+// GetLabels returns the labels of the current user.
+// @Summary Get all labels of the user
+// @Description Get all local labels of the user with their colors
+// @Tags labels
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of labels"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "DB error"
+// @Router /api/v1/labels [get]
+func (h *EmailHandler) GetLabels(w http.ResponseWriter, r *http.Request) {
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	labelsDataProto, err := h.EmailServiceClient.GetLabels(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.LoginOffsetLimit{Login: login, Offset: 0, Limit: 0},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, fmt.Sprintf("DB error: %s", err.Error()))
+		return
+	}
+
+	labelsApi := converters.LabelsConvertCoreInApi(proto_converters.LabelsConvertProtoInCore(labelsDataProto.Labels))
+	if labelsApi == nil {
+		labelsApi = []*emailApi.Label{}
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"labels": labelsApi})
+}
+
+// GetEmailLabels returns the labels the current user has put on the email.
+// @Summary Get labels of an email
+// @Description Get all local labels the user has put on the email
+// @Tags labels
+// @Produce json
+// @Param id path integer true "ID of the email message"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of labels"
+// @Failure 400 {object} response.Response "Bad id in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Email not found"
+// @Router /api/v1/labels/email/{id} [get]
+func (h *EmailHandler) GetEmailLabels(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	labelsDataProto, err := h.EmailServiceClient.GetEmailLabels(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.EmailIdAndLogin{Id: id, Login: login},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Email not found")
+		return
+	}
+
+	labelsApi := converters.LabelsConvertCoreInApi(proto_converters.LabelsConvertProtoInCore(labelsDataProto.Labels))
+	if labelsApi == nil {
+		labelsApi = []*emailApi.Label{}
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"labels": labelsApi})
+}
+
+// GetAllEmailsInLabel returns the emails of the current user marked with the label.
+// @Summary Get emails in a label
+// @Description Get all emails of the user marked with the label with the specified name
+// @Tags labels
+// @Produce json
+// @Param name path string true "Name of the label"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of emails"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Label not found"
+// @Router /api/v1/label/{name}/emails [get]
+func (h *EmailHandler) GetAllEmailsInLabel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	name := vars["name"]
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	emailDataProto, err := h.EmailServiceClient.GetAllEmailsInLabel(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.LabelNameAndLogin{Name: name, Login: login},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Label not found")
+		return
+	}
+
+	emailsCore := proto_converters.EmailsConvertProtoInCore(emailDataProto)
+
+	emailsApi := make([]*emailApi.Email, 0, len(emailsCore))
+	for _, email := range emailsCore {
+		emailsApi = append(emailsApi, converters.EmailConvertCoreInApi(*email))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi})
+}
+
+// CreateLabel creates a new label of the current user.
+// @Summary Create a new label
+// @Description Create a new local label with an optional color in #rrggbb format
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param label body response.LabelSwag true "Label in JSON format"
+// @Success 200 {object} response.Response "Created label"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to create label"
+// @Router /api/v1/label/create [post]
+func (h *EmailHandler) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var newLabel emailApi.Label
+	if err := newLabel.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	labelDataProto, err := h.EmailServiceClient.CreateLabel(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.LabelWithLogin{
+			Label: &proto.Label{
+				Name:  sanitizeString(newLabel.Name),
+				Color: newLabel.Color,
+			},
+			Login: login,
+		},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to create label")
+		return
+	}
+	labelData := proto_converters.LabelConvertProtoInCore(labelDataProto)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"label": converters.LabelConvertCoreInApi(*labelData)})
+}
+
+// UpdateLabel updates the name and color of the label.
+// @Summary Update a label
+// @Description Rename a local label or change its color
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the label"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param label body response.LabelSwag true "Label in JSON format"
+// @Success 200 {object} response.Response "Update success status"
+// @Failure 400 {object} response.Response "Bad id or Bad JSON"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to update label"
+// @Router /api/v1/label/update/{id} [put]
+func (h *EmailHandler) UpdateLabel(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var updatedLabel emailApi.Label
+	if err := updatedLabel.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	status, err := h.EmailServiceClient.UpdateLabel(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.LabelWithLogin{
+			Label: &proto.Label{
+				Id:    id,
+				Name:  sanitizeString(updatedLabel.Name),
+				Color: updatedLabel.Color,
+			},
+			Login: login,
+		},
+	)
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to update label")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// DeleteLabel deletes the label and removes it from all emails.
+// @Summary Delete a label
+// @Description Delete a local label; the emails themselves are kept
+// @Tags labels
+// @Produce json
+// @Param id path integer true "ID of the label"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Deletion success status"
+// @Failure 400 {object} response.Response "Bad id"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to delete label"
+// @Router /api/v1/label/delete/{id} [delete]
+func (h *EmailHandler) DeleteLabel(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	status, err := h.EmailServiceClient.DeleteLabel(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.LabelIdAndLogin{Id: id, Login: login},
+	)
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to delete label")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// AddEmailInLabel puts the label on one email or a batch of emails.
+// @Summary Put a label on emails
+// @Description Put a local label on a single email (emailId) or on a batch of up to 100 emails (emailIds)
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param labelEmails body response.LabelEmailsSwag true "Label and emails in JSON format"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to change label emails"
+// @Router /api/v1/label/add_email [post]
+func (h *EmailHandler) AddEmailInLabel(w http.ResponseWriter, r *http.Request) {
+	h.changeLabelEmails(w, r, true)
+}
+
+// DeleteEmailInLabel removes the label from one email or a batch of emails.
+// @Summary Remove a label from emails
+// @Description Remove a local label from a single email (emailId) or from a batch of up to 100 emails (emailIds)
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param labelEmails body response.LabelEmailsSwag true "Label and emails in JSON format"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to change label emails"
+// @Router /api/v1/label/delete_email [delete]
+func (h *EmailHandler) DeleteEmailInLabel(w http.ResponseWriter, r *http.Request) {
+	h.changeLabelEmails(w, r, false)
+}
+
+// changeLabelEmails puts the label on the emails of the request or removes it from them.
+func (h *EmailHandler) changeLabelEmails(w http.ResponseWriter, r *http.Request, add bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var labelEmails emailApi.LabelEmails
+	if err := labelEmails.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	emailIDs := labelEmailIDs(labelEmails)
+	if labelEmails.LabelID == 0 || len(emailIDs) == 0 {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)}))
+	request := &proto.LabelEmailsRequest{LabelId: labelEmails.LabelID, EmailIds: emailIDs, Login: login}
+
+	var status *proto.StatusEmail
+	if add {
+		status, err = h.EmailServiceClient.AddEmailsInLabel(ctx, request)
+	} else {
+		status, err = h.EmailServiceClient.DeleteEmailsInLabel(ctx, request)
+	}
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to change label emails")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
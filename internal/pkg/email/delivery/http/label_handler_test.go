@@ -0,0 +1,123 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/utils/constants"
+
+	email_mock "mail/internal/microservice/email/mock"
+	email_proto "mail/internal/microservice/email/proto"
+	mockSession "mail/internal/pkg/session/mock"
+)
+
+func TestIncomingFilteredByLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	login := "recipient_test@mailhub.su"
+
+	t.Run("FilterByLabel", func(t *testing.T) {
+		mockEmails := &email_proto.Emails{
+			Emails: []*email_proto.Email{
+				{Id: 1, Topic: "Work", Labels: []*email_proto.Label{{Id: 7, Name: "Work", Color: "#ff0000"}}},
+				{Id: 2, Topic: "Other"},
+			},
+		}
+
+		req := httptest.NewRequest("GET", "/api/v1/emails/incoming?label=7", bytes.NewReader([]byte(``)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
+		mockEmailServiceClient.EXPECT().GetAllIncoming(gomock.Any(), gomock.Any()).Return(mockEmails, nil)
+
+		emailHandler.Incoming(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"labels":[{"id":7,"name":"Work","color":"#ff0000"}]`)
+		assert.NotContains(t, w.Body.String(), `"Other"`)
+	})
+
+	t.Run("BadLabel", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/emails/incoming?label=work", bytes.NewReader([]byte(``)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		emailHandler.Incoming(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAddEmailInLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	login := "test@mailhub.su"
+
+	t.Run("Batch", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/label/add_email", bytes.NewReader([]byte(`{"folderId":7,"emailIds":[1,2]}`)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
+		mockEmailServiceClient.EXPECT().AddEmailsInLabel(gomock.Any(), &email_proto.LabelEmailsRequest{LabelId: 7, EmailIds: []uint64{1, 2}, Login: login}).
+			Return(&email_proto.StatusEmail{Status: true}, nil)
+
+		emailHandler.AddEmailInLabel(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("SingleEmail", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/label/add_email", bytes.NewReader([]byte(`{"folderId":7,"emailId":3}`)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return(login, nil)
+		mockEmailServiceClient.EXPECT().AddEmailsInLabel(gomock.Any(), &email_proto.LabelEmailsRequest{LabelId: 7, EmailIds: []uint64{3}, Login: login}).
+			Return(&email_proto.StatusEmail{Status: true}, nil)
+
+		emailHandler.AddEmailInLabel(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("NoEmails", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/label/add_email", bytes.NewReader([]byte(`{"folderId":7}`)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+
+		w := httptest.NewRecorder()
+
+		emailHandler.AddEmailInLabel(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
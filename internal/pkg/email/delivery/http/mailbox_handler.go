@@ -0,0 +1,226 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/email/proto"
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/models/response"
+	"mail/internal/pkg/utils/constants"
+
+	converters "mail/internal/models/delivery_converters"
+	emailApi "mail/internal/models/delivery_models"
+)
+
+// requestMailbox returns the shared mailbox passed in the "mailbox" query parameter if the user
+// has the permission in it, or the login of the user if the request works with the user's own mailbox.
+func (h *EmailHandler) requestMailbox(login, permission string, r *http.Request) (string, error) {
+	mailbox := r.URL.Query().Get("mailbox")
+	if mailbox == "" || mailbox == login {
+		return login, nil
+	}
+
+	if _, err := h.Sessions.CheckMailboxAccess(mailbox, permission, r, r.Context()); err != nil {
+		return "", err
+	}
+
+	return mailbox, nil
+}
+
+// recordDelegateAction records the action the delegate performed in the shared mailbox.
+// Nothing is recorded when users work with their own mailbox; a failure to record does not fail the request.
+func (h *EmailHandler) recordDelegateAction(r *http.Request, mailbox, delegate, action string, emailID uint64) {
+	if delegate == "" || mailbox == delegate {
+		return
+	}
+
+	_, _ = h.EmailServiceClient.AddDelegateAction(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.DelegateAction{Mailbox: mailbox, Delegate: delegate, Action: action, EmailId: emailID},
+	)
+}
+
+// GetMailboxDelegates returns the delegates of the shared mailbox.
+// @Summary Get the delegates of a shared mailbox
+// @Description Get the users that have access to the shared mailbox and their roles; only the owner and managers may see them
+// @Tags mailboxes
+// @Produce json
+// @Param mailbox path string true "Login of the shared mailbox"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of delegates"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Delegates not found"
+// @Router /api/v1/mailbox/{mailbox}/delegates [get]
+func (h *EmailHandler) GetMailboxDelegates(w http.ResponseWriter, r *http.Request) {
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	delegatesDataProto, err := h.EmailServiceClient.GetMailboxDelegates(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailboxAndLogin{Mailbox: mux.Vars(r)["mailbox"], Login: login},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Delegates not found")
+		return
+	}
+
+	delegatesCore := proto_converters.MailboxDelegatesConvertProtoInCore(delegatesDataProto)
+
+	delegatesApi := make([]*emailApi.MailboxDelegate, 0, len(delegatesCore))
+	for _, delegate := range delegatesCore {
+		delegatesApi = append(delegatesApi, converters.MailboxDelegateConvertCoreInApi(*delegate))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"delegates": delegatesApi})
+}
+
+// GetDelegatedMailboxes returns the shared mailboxes the current user is a delegate of.
+// @Summary Get the shared mailboxes of the user
+// @Description Get the shared mailboxes the user has access to and the user's role in each of them
+// @Tags mailboxes
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of shared mailboxes"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "DB error"
+// @Router /api/v1/mailboxes/delegated [get]
+func (h *EmailHandler) GetDelegatedMailboxes(w http.ResponseWriter, r *http.Request) {
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	delegatesDataProto, err := h.EmailServiceClient.GetDelegatedMailboxes(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.LoginOffsetLimit{Login: login, Offset: 0, Limit: 0},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, fmt.Sprintf("DB error: %s", err.Error()))
+		return
+	}
+
+	delegatesCore := proto_converters.MailboxDelegatesConvertProtoInCore(delegatesDataProto)
+
+	mailboxesApi := make([]*emailApi.MailboxDelegate, 0, len(delegatesCore))
+	for _, delegate := range delegatesCore {
+		mailboxesApi = append(mailboxesApi, converters.MailboxDelegateConvertCoreInApi(*delegate))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"mailboxes": mailboxesApi})
+}
+
+// AddMailboxDelegate gives a user access to the shared mailbox.
+// @Summary Add a delegate to a shared mailbox
+// @Description Give a user access to the shared mailbox with the role read, send_as, send_on_behalf or manage, or change the role of a delegate
+// @Tags mailboxes
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param delegate body response.MailboxDelegateSwag true "Delegate in JSON format"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to change mailbox delegate"
+// @Router /api/v1/mailbox/delegate/add [post]
+func (h *EmailHandler) AddMailboxDelegate(w http.ResponseWriter, r *http.Request) {
+	h.changeMailboxDelegate(w, r, true)
+}
+
+// DeleteMailboxDelegate takes away the access of a user to the shared mailbox.
+// @Summary Remove a delegate from a shared mailbox
+// @Description Take away the access of a user to the shared mailbox; delegates may remove themselves
+// @Tags mailboxes
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param delegate body response.MailboxDelegateSwag true "Delegate in JSON format"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to change mailbox delegate"
+// @Router /api/v1/mailbox/delegate/delete [delete]
+func (h *EmailHandler) DeleteMailboxDelegate(w http.ResponseWriter, r *http.Request) {
+	h.changeMailboxDelegate(w, r, false)
+}
+
+// changeMailboxDelegate adds or removes the delegate passed in the request body.
+func (h *EmailHandler) changeMailboxDelegate(w http.ResponseWriter, r *http.Request, add bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var delegate emailApi.MailboxDelegate
+	if err := delegate.UnmarshalJSON(body); err != nil || delegate.Mailbox == "" || delegate.Delegate == "" {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	request := &proto.MailboxDelegateRequest{Mailbox: sanitizeString(delegate.Mailbox), Delegate: sanitizeString(delegate.Delegate), Role: delegate.Role, Login: login}
+	ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)}))
+
+	var status *proto.StatusEmail
+	if add {
+		status, err = h.EmailServiceClient.AddMailboxDelegate(ctx, request)
+	} else {
+		status, err = h.EmailServiceClient.DeleteMailboxDelegate(ctx, request)
+	}
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to change mailbox delegate")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// GetDelegateActions returns the actions the delegates performed in the shared mailbox.
+// @Summary Get the actions of the delegates of a shared mailbox
+// @Description Get which delegate sent, changed or deleted which email of the shared mailbox, newest first; only the owner and managers may see them
+// @Tags mailboxes
+// @Produce json
+// @Param mailbox path string true "Login of the shared mailbox"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of delegate actions"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Delegate actions not found"
+// @Router /api/v1/mailbox/{mailbox}/actions [get]
+func (h *EmailHandler) GetDelegateActions(w http.ResponseWriter, r *http.Request) {
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	actionsDataProto, err := h.EmailServiceClient.GetDelegateActions(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailboxAndLogin{Mailbox: mux.Vars(r)["mailbox"], Login: login},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Delegate actions not found")
+		return
+	}
+
+	actionsCore := proto_converters.DelegateActionsConvertProtoInCore(actionsDataProto)
+
+	actionsApi := make([]*emailApi.DelegateAction, 0, len(actionsCore))
+	for _, action := range actionsCore {
+		actionsApi = append(actionsApi, converters.DelegateActionConvertCoreInApi(*action))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"actions": actionsApi})
+}
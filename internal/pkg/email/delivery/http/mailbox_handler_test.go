@@ -0,0 +1,158 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/utils/constants"
+
+	email_mock "mail/internal/microservice/email/mock"
+	email_proto "mail/internal/microservice/email/proto"
+	mockSession "mail/internal/pkg/session/mock"
+)
+
+func TestIncomingSharedMailbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	req := httptest.NewRequest("GET", "/api/v1/emails/incoming?mailbox=support@mailhub.su", bytes.NewReader([]byte(``)))
+	ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+	r := req.WithContext(ctx)
+
+	w := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return("delegate@mailhub.su", nil)
+	mockSessionsManager.EXPECT().CheckMailboxAccess("support@mailhub.su", "read", r, ctx).Return("delegate@mailhub.su", nil)
+	mockEmailServiceClient.EXPECT().GetAllIncoming(gomock.Any(), &email_proto.LoginOffsetLimit{Login: "support@mailhub.su"}).Return(&email_proto.Emails{}, nil)
+
+	emailHandler.Incoming(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestDeleteSharedMailboxRecordsAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	req := httptest.NewRequest("DELETE", "/api/v1/email/delete/{id}?mailbox=support@mailhub.su", bytes.NewReader([]byte("")))
+	ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+	r := mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": "1"})
+
+	w := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return("delegate@mailhub.su", nil)
+	mockSessionsManager.EXPECT().CheckMailboxAccess("support@mailhub.su", "write", r, r.Context()).Return("delegate@mailhub.su", nil)
+	mockEmailServiceClient.EXPECT().DeleteEmail(gomock.Any(), &email_proto.LoginWithID{Login: "support@mailhub.su", Id: 1}).Return(&email_proto.StatusEmail{Status: true}, nil)
+	mockEmailServiceClient.EXPECT().AddDelegateAction(gomock.Any(), &email_proto.DelegateAction{
+		Mailbox: "support@mailhub.su", Delegate: "delegate@mailhub.su", Action: "delete", EmailId: 1,
+	}).Return(&email_proto.EmptyEmail{}, nil)
+
+	emailHandler.Delete(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGetMailboxDelegates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest("GET", "/api/v1/mailbox/{mailbox}/delegates", bytes.NewReader([]byte(``)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		return mux.SetURLVars(req.WithContext(ctx), map[string]string{"mailbox": "support@mailhub.su"})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		r := newRequest()
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return("owner@mailhub.su", nil)
+		mockEmailServiceClient.EXPECT().GetMailboxDelegates(gomock.Any(), &email_proto.MailboxAndLogin{Mailbox: "support@mailhub.su", Login: "owner@mailhub.su"}).
+			Return(&email_proto.MailboxDelegates{Delegates: []*email_proto.MailboxDelegate{
+				{Mailbox: "support@mailhub.su", Delegate: "delegate@mailhub.su", Role: "send_as"},
+			}}, nil)
+
+		emailHandler.GetMailboxDelegates(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"delegate":"delegate@mailhub.su"`)
+	})
+
+	t.Run("NotManager", func(t *testing.T) {
+		r := newRequest()
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return("delegate@mailhub.su", nil)
+		mockEmailServiceClient.EXPECT().GetMailboxDelegates(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("access denied"))
+
+		emailHandler.GetMailboxDelegates(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAddMailboxDelegate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		body := `{"mailbox":"support@mailhub.su","delegate":"delegate@mailhub.su","role":"send_on_behalf"}`
+		req := httptest.NewRequest("POST", "/api/v1/mailbox/delegate/add", bytes.NewReader([]byte(body)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		r := req.WithContext(ctx)
+		w := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(r, ctx).Return("owner@mailhub.su", nil)
+		mockEmailServiceClient.EXPECT().AddMailboxDelegate(gomock.Any(), &email_proto.MailboxDelegateRequest{
+			Mailbox: "support@mailhub.su", Delegate: "delegate@mailhub.su", Role: "send_on_behalf", Login: "owner@mailhub.su",
+		}).Return(&email_proto.StatusEmail{Status: true}, nil)
+
+		emailHandler.AddMailboxDelegate(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("BadJSON", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/mailbox/delegate/add", bytes.NewReader([]byte(`{"mailbox":""}`)))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		w := httptest.NewRecorder()
+
+		emailHandler.AddMailboxDelegate(w, req.WithContext(ctx))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
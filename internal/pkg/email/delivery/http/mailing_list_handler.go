@@ -0,0 +1,526 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/email/proto"
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/models/response"
+	"mail/internal/pkg/utils/constants"
+
+	converters "mail/internal/models/delivery_converters"
+	emailApi "mail/internal/models/delivery_models"
+)
+
+// listIDFromRequest parses the mailing list id from the request path.
+func listIDFromRequest(r *http.Request) (uint32, error) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(id), nil
+}
+
+// CreateMailingList creates a new mailing list owned by the current user.
+// @Summary Create a new mailing list
+// @Description Create a new distribution list with a group address that fans out to its members
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param list body response.MailingListSwag true "Mailing list in JSON format"
+// @Success 200 {object} response.Response "Created mailing list"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to create mailing list"
+// @Router /api/v1/list/create [post]
+func (h *EmailHandler) CreateMailingList(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var newList emailApi.MailingList
+	if err := newList.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	listDataProto, err := h.EmailServiceClient.CreateMailingList(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailingListWithLogin{
+			List: &proto.MailingList{
+				Address:     sanitizeString(newList.Address),
+				Name:        sanitizeString(newList.Name),
+				Description: sanitizeString(newList.Description),
+				PostPolicy:  newList.PostPolicy,
+				Moderated:   newList.Moderated,
+			},
+			Login: login,
+		},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to create mailing list")
+		return
+	}
+	listData := proto_converters.MailingListConvertProtoInCore(listDataProto)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"list": converters.MailingListConvertCoreInApi(*listData)})
+}
+
+// GetMailingLists returns the mailing lists the current user is a member of.
+// @Summary Get all mailing lists of the user
+// @Description Get all distribution lists the user is a member or an owner of
+// @Tags lists
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of mailing lists"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "DB error"
+// @Router /api/v1/lists [get]
+func (h *EmailHandler) GetMailingLists(w http.ResponseWriter, r *http.Request) {
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	listsDataProto, err := h.EmailServiceClient.GetMailingLists(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.LoginOffsetLimit{Login: login, Offset: 0, Limit: 0},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, fmt.Sprintf("DB error: %s", err.Error()))
+		return
+	}
+
+	listsCore := proto_converters.MailingListsConvertProtoInCore(listsDataProto)
+
+	listsApi := make([]*emailApi.MailingList, 0, len(listsCore))
+	for _, list := range listsCore {
+		listsApi = append(listsApi, converters.MailingListConvertCoreInApi(*list))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"lists": listsApi})
+}
+
+// GetMailingList returns the mailing list by its ID.
+// @Summary Get a mailing list by ID
+// @Description Get a distribution list the user is a member of
+// @Tags lists
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Mailing list data"
+// @Failure 400 {object} response.Response "Bad id in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Mailing list not found"
+// @Router /api/v1/list/{id} [get]
+func (h *EmailHandler) GetMailingList(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	listDataProto, err := h.EmailServiceClient.GetMailingListByID(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailingListIdAndLogin{Id: id, Login: login},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Mailing list not found")
+		return
+	}
+	listData := proto_converters.MailingListConvertProtoInCore(listDataProto)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"list": converters.MailingListConvertCoreInApi(*listData)})
+}
+
+// UpdateMailingList updates the settings of the mailing list.
+// @Summary Update a mailing list
+// @Description Update the name, description, post policy and moderation of a distribution list
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param list body response.MailingListSwag true "Mailing list in JSON format"
+// @Success 200 {object} response.Response "Update success status"
+// @Failure 400 {object} response.Response "Bad id or Bad JSON"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to update mailing list"
+// @Router /api/v1/list/update/{id} [put]
+func (h *EmailHandler) UpdateMailingList(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var updatedList emailApi.MailingList
+	if err := updatedList.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	status, err := h.EmailServiceClient.UpdateMailingList(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailingListWithLogin{
+			List: &proto.MailingList{
+				Id:          id,
+				Name:        sanitizeString(updatedList.Name),
+				Description: sanitizeString(updatedList.Description),
+				PostPolicy:  updatedList.PostPolicy,
+				Moderated:   updatedList.Moderated,
+			},
+			Login: login,
+		},
+	)
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to update mailing list")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// DeleteMailingList deletes the mailing list.
+// @Summary Delete a mailing list
+// @Description Delete a distribution list owned by the user
+// @Tags lists
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Deletion success status"
+// @Failure 400 {object} response.Response "Bad id"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to delete mailing list"
+// @Router /api/v1/list/delete/{id} [delete]
+func (h *EmailHandler) DeleteMailingList(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	status, err := h.EmailServiceClient.DeleteMailingList(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailingListIdAndLogin{Id: id, Login: login},
+	)
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to delete mailing list")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// GetMailingListMembers returns the members of the mailing list.
+// @Summary Get members of a mailing list
+// @Description Get all members and owners of a distribution list
+// @Tags lists
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of members"
+// @Failure 400 {object} response.Response "Bad id"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Mailing list not found"
+// @Router /api/v1/list/{id}/members [get]
+func (h *EmailHandler) GetMailingListMembers(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	membersDataProto, err := h.EmailServiceClient.GetMailingListMembers(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailingListIdAndLogin{Id: id, Login: login},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Mailing list not found")
+		return
+	}
+
+	membersApi := make([]*emailApi.MailingListMember, 0, len(membersDataProto.Members))
+	for _, member := range membersDataProto.Members {
+		membersApi = append(membersApi, converters.MailingListMemberConvertCoreInApi(*proto_converters.MailingListMemberConvertProtoInCore(member)))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"members": membersApi})
+}
+
+// AddMailingListMember adds a member to the mailing list.
+// @Summary Add a member to a mailing list
+// @Description Add a user to a distribution list or change the role of an existing member
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param member body response.MailingListMemberSwag true "Member in JSON format"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad id or Bad JSON"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to add member"
+// @Router /api/v1/list/{id}/member/add [post]
+func (h *EmailHandler) AddMailingListMember(w http.ResponseWriter, r *http.Request) {
+	h.changeMailingListMember(w, r, true)
+}
+
+// DeleteMailingListMember removes a member from the mailing list.
+// @Summary Remove a member from a mailing list
+// @Description Remove a user from a distribution list
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param member body response.MailingListMemberSwag true "Member in JSON format"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad id or Bad JSON"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to delete member"
+// @Router /api/v1/list/{id}/member/delete [delete]
+func (h *EmailHandler) DeleteMailingListMember(w http.ResponseWriter, r *http.Request) {
+	h.changeMailingListMember(w, r, false)
+}
+
+// changeMailingListMember adds or removes the member passed in the request body.
+func (h *EmailHandler) changeMailingListMember(w http.ResponseWriter, r *http.Request, add bool) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var member emailApi.MailingListMember
+	if err := member.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	request := &proto.MailingListMemberRequest{ListId: id, Login: login, MemberLogin: sanitizeString(member.Login), Role: member.Role}
+	ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)}))
+
+	var status *proto.StatusEmail
+	if add {
+		status, err = h.EmailServiceClient.AddMailingListMember(ctx, request)
+	} else {
+		status, err = h.EmailServiceClient.DeleteMailingListMember(ctx, request)
+	}
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to change mailing list member")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// UnsubscribeMailingList removes the current user from the mailing list.
+// @Summary Unsubscribe from a mailing list
+// @Description Remove the current user from a distribution list, target of the List-Unsubscribe header
+// @Tags lists
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad id"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to unsubscribe"
+// @Router /api/v1/list/{id}/unsubscribe [post]
+func (h *EmailHandler) UnsubscribeMailingList(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	status, err := h.EmailServiceClient.DeleteMailingListMember(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailingListMemberRequest{ListId: id, Login: login, MemberLogin: login},
+	)
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to unsubscribe")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// OneClickUnsubscribeMailingList removes the member named by the signed link of the List-Unsubscribe header from the list.
+// @Summary One-click unsubscribe from a mailing list
+// @Description Target of the List-Unsubscribe header (RFC 8058), the signed link names the member so no session is needed
+// @Tags lists
+// @Produce json
+// @Param token path string true "Signed unsubscribe token from the List-Unsubscribe header"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad unsubscribe link"
+// @Router /api/v1/list/unsubscribe/{token} [post]
+func (h *EmailHandler) OneClickUnsubscribeMailingList(w http.ResponseWriter, r *http.Request) {
+	status, err := h.EmailServiceClient.UnsubscribeMailingList(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.ListUnsubscribeToken{Token: mux.Vars(r)["token"]},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad unsubscribe link")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
+
+// GetMailingListModeration returns the emails waiting for moderation.
+// @Summary Get the moderation queue of a mailing list
+// @Description Get emails sent to a moderated distribution list that wait for approval of an owner
+// @Tags lists
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "List of held emails"
+// @Failure 400 {object} response.Response "Bad id"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Mailing list not found"
+// @Router /api/v1/list/{id}/moderation [get]
+func (h *EmailHandler) GetMailingListModeration(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	emailDataProto, err := h.EmailServiceClient.GetMailingListModeration(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.MailingListIdAndLogin{Id: id, Login: login},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Mailing list not found")
+		return
+	}
+
+	emailsCore := proto_converters.EmailsConvertProtoInCore(emailDataProto)
+
+	emailsApi := make([]*emailApi.Email, 0, len(emailsCore))
+	for _, email := range emailsCore {
+		emailsApi = append(emailsApi, converters.EmailConvertCoreInApi(*email))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi})
+}
+
+// ModerateMailingListEmail approves or rejects an email waiting for moderation.
+// @Summary Moderate an email sent to a mailing list
+// @Description Approve (deliver to all members) or reject an email held for moderation
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Param id path integer true "ID of the mailing list"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param moderation body response.MailingListModerationSwag true "Moderation decision in JSON format"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.Response "Bad id or Bad JSON"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to moderate email"
+// @Router /api/v1/list/{id}/moderate [post]
+func (h *EmailHandler) ModerateMailingListEmail(w http.ResponseWriter, r *http.Request) {
+	id, err := listIDFromRequest(r)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var moderation emailApi.MailingListModeration
+	if err := moderation.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	status, err := h.EmailServiceClient.ModerateMailingListEmail(
+		metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{string(constants.RequestIDKey): r.Context().Value(requestIDContextKey).(string)})),
+		&proto.ModerateEmailRequest{ListId: id, EmailId: moderation.EmailID, Login: login, Approve: moderation.Approve},
+	)
+	if err != nil || !status.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to moderate email")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": status.Status})
+}
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"mail/internal/pkg/utils/constants"
+
+	email_mock "mail/internal/microservice/email/mock"
+	email_proto "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	mockSession "mail/internal/pkg/session/mock"
+)
+
+func TestOneClickUnsubscribeMailingList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+
+	// The link is opened by the mail client of the member, without a session.
+	emailHandler := EmailHandler{EmailServiceClient: mockEmailServiceClient}
+
+	newRequest := func(token string) *http.Request {
+		req := httptest.NewRequest("POST", "/api/v1/list/unsubscribe/"+token, strings.NewReader("List-Unsubscribe=One-Click"))
+		ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+		return mux.SetURLVars(req.WithContext(ctx), map[string]string{"token": token})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockEmailServiceClient.EXPECT().UnsubscribeMailingList(gomock.Any(), &email_proto.ListUnsubscribeToken{Token: "signed"}).
+			Return(&email_proto.StatusEmail{Status: true}, nil)
+
+		w := httptest.NewRecorder()
+		emailHandler.OneClickUnsubscribeMailingList(w, newRequest("signed"))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("BadToken", func(t *testing.T) {
+		mockEmailServiceClient.EXPECT().UnsubscribeMailingList(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("invalid token signature"))
+
+		w := httptest.NewRecorder()
+		emailHandler.OneClickUnsubscribeMailingList(w, newRequest("forged"))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetRawByID_ListHeaders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailServiceClient := email_mock.NewMockEmailServiceClient(ctrl)
+	mockSessionsManager := mockSession.NewMockSessionsManager(ctrl)
+
+	emailHandler := EmailHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockEmailServiceClient,
+	}
+
+	req := httptest.NewRequest("GET", "/api/v1/email/5/raw", nil)
+	ctx := context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID")
+	r := mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": "5"})
+	w := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetLoginBySession(r, r.Context()).Return("member@mailhub.su", nil)
+	mockEmailServiceClient.EXPECT().GetEmailByID(gomock.Any(), &email_proto.EmailIdAndLogin{Id: 5, Login: "member@mailhub.su"}).
+		Return(&email_proto.Email{
+			Id:              5,
+			Topic:           "Standup",
+			Text:            "At ten",
+			SenderEmail:     "lead@mailhub.su",
+			RecipientEmail:  "team@mailhub.su",
+			DateOfDispatch:  timestamppb.Now(),
+			ListId:          "Team <team.mailhub.su>",
+			ListUnsubscribe: "<https://mailhub.su/api/v1/list/unsubscribe/signed>",
+		}, nil)
+	mockEmailServiceClient.EXPECT().GetFilesByEmailID(gomock.Any(), gomock.Any()).Return(&email_proto.GetFilesByEmailIDReply{}, nil)
+
+	emailHandler.GetRawByID(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "message/rfc822", w.Header().Get("Content-Type"))
+
+	msg, err := mail.ReadMessage(w.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Team <team.mailhub.su>", msg.Header.Get("List-Id"))
+	assert.Equal(t, "<https://mailhub.su/api/v1/list/unsubscribe/signed>", msg.Header.Get("List-Unsubscribe"))
+	assert.Equal(t, domain.ListUnsubscribePost, msg.Header.Get("List-Unsubscribe-Post"))
+	assert.NotEmpty(t, msg.Header.Get("Date"))
+}
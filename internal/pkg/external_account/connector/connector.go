@@ -0,0 +1,208 @@
+package connector
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// Folder roles which a client may ask for without knowing the folder names of the server.
+const (
+	RoleInbox  = "inbox"
+	RoleSent   = "sent"
+	RoleDrafts = "drafts"
+)
+
+// roleAttributes are the special-use attributes of the folders, RFC 6154.
+var roleAttributes = map[string]string{
+	RoleSent:   `\Sent`,
+	RoleDrafts: `\Drafts`,
+}
+
+// roleNames are the usual names of the folders on the servers which do not support the special-use attributes.
+var roleNames = map[string][]string{
+	RoleSent:   {"Sent", "Sent Items", "Sent Messages", "Sent Mail", "INBOX.Sent", "Отправленные"},
+	RoleDrafts: {"Drafts", "INBOX.Drafts", "Черновики"},
+}
+
+// Connector reads and sends the emails of the linked external accounts.
+type Connector struct {
+	pool      *Pool
+	tlsConfig *tls.Config
+	policy    DialPolicy
+}
+
+// NewConnector creates a connector keeping up to maxIdle IMAP connections per account for at most idleTimeout.
+// The TLS configuration may be nil to use the system roots, the policy limits the servers connected to.
+func NewConnector(maxIdle int, idleTimeout time.Duration, tlsConfig *tls.Config, policy DialPolicy) *Connector {
+	return &Connector{
+		pool:      NewPool(maxIdle, idleTimeout),
+		tlsConfig: tlsConfig,
+		policy:    policy,
+	}
+}
+
+// Check signs in to the IMAP and SMTP servers of the account to make sure it can be linked.
+func (c *Connector) Check(account *domain.ExternalAccount) error {
+	client, err := c.dial(account)
+	if err != nil {
+		return err
+	}
+	client.Logout()
+
+	return checkSMTP(account, c.tlsConfig, c.policy)
+}
+
+// Folders returns the folders of the account.
+func (c *Connector) Folders(account *domain.ExternalAccount) ([]*Mailbox, error) {
+	var mailboxes []*Mailbox
+	err := c.withClient(account, func(client *IMAPClient) (err error) {
+		mailboxes, err = client.List()
+		return err
+	})
+
+	return mailboxes, err
+}
+
+// Messages returns up to limit of the newest emails of the folder, the newest first.
+func (c *Connector) Messages(account *domain.ExternalAccount, folder string, limit int) ([]*Message, error) {
+	var messages []*Message
+	err := c.withClient(account, func(client *IMAPClient) error {
+		exists, err := client.Examine(folder)
+		if err != nil {
+			return err
+		}
+		messages, err = client.FetchLast(exists, limit)
+		return err
+	})
+
+	return messages, err
+}
+
+// RoleMessages returns up to limit of the newest emails of the folder with the role, the newest first.
+// It returns no emails if the account has no such folder.
+func (c *Connector) RoleMessages(account *domain.ExternalAccount, role string, limit int) ([]*Message, error) {
+	var messages []*Message
+	err := c.withClient(account, func(client *IMAPClient) error {
+		folder, err := roleFolder(client, role)
+		if err != nil {
+			return err
+		}
+		if folder == "" {
+			messages = []*Message{}
+			return nil
+		}
+
+		exists, err := client.Examine(folder)
+		if err != nil {
+			return err
+		}
+		messages, err = client.FetchLast(exists, limit)
+		return err
+	})
+
+	return messages, err
+}
+
+// Send sends the email over SMTP and keeps a copy of it in the sent folder of the account.
+// The copy is best-effort, since many servers save the sent emails themselves.
+func (c *Connector) Send(account *domain.ExternalAccount, to []string, message []byte) error {
+	if err := sendSMTP(account, to, message, c.tlsConfig, c.policy); err != nil {
+		return err
+	}
+
+	c.withClient(account, func(client *IMAPClient) error {
+		folder, err := roleFolder(client, RoleSent)
+		if err != nil || folder == "" {
+			return err
+		}
+		return client.Append(folder, []string{`\Seen`}, message)
+	})
+
+	return nil
+}
+
+// Remove closes the connections of the account, it is called when the account is unlinked.
+func (c *Connector) Remove(accountID uint32) {
+	c.pool.Remove(accountID)
+}
+
+// Close closes all the connections.
+func (c *Connector) Close() {
+	c.pool.Close()
+}
+
+// withClient runs the function with a signed in connection of the account. A pooled connection may have been closed
+// by the server while idle, so the function is run once more with a new connection if the pooled one breaks.
+func (c *Connector) withClient(account *domain.ExternalAccount, f func(client *IMAPClient) error) error {
+	if client := c.pool.Get(account.ID, account.Password); client != nil {
+		err := f(client)
+		if !client.Broken() {
+			c.pool.Put(account.ID, account.Password, client)
+			return err
+		}
+		client.Logout()
+	}
+
+	client, err := c.dial(account)
+	if err != nil {
+		return err
+	}
+	err = f(client)
+	c.pool.Put(account.ID, account.Password, client)
+
+	return err
+}
+
+// dial opens a new signed in IMAP connection of the account.
+func (c *Connector) dial(account *domain.ExternalAccount) (*IMAPClient, error) {
+	client, err := DialIMAP(account.IMAPHost, account.IMAPPort, account.IMAPTLSMode, c.tlsConfig, c.policy)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Login(account.Username, account.Password); err != nil {
+		client.Logout()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// roleFolder finds the folder with the role, or returns an empty name if there is none.
+func roleFolder(client *IMAPClient, role string) (string, error) {
+	if role == RoleInbox {
+		return "INBOX", nil
+	}
+
+	mailboxes, err := client.List()
+	if err != nil {
+		return "", err
+	}
+
+	if attribute, ok := roleAttributes[role]; ok {
+		for _, mailbox := range mailboxes {
+			for _, a := range mailbox.Attributes {
+				if strings.EqualFold(a, attribute) {
+					return mailbox.Name, nil
+				}
+			}
+		}
+	}
+
+	names, ok := roleNames[role]
+	if !ok {
+		return "", fmt.Errorf("unknown folder role %q", role)
+	}
+	for _, name := range names {
+		for _, mailbox := range mailboxes {
+			if strings.EqualFold(mailbox.Name, name) {
+				return mailbox.Name, nil
+			}
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,447 @@
+package connector
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mhale/smtpd"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+type fakeMessage struct {
+	uid   uint32
+	flags []string
+	raw   string
+}
+
+type fakeFolder struct {
+	attributes string
+	messages   []*fakeMessage
+}
+
+// fakeIMAPServer is an IMAP stand-in serving the commands used by the connector.
+type fakeIMAPServer struct {
+	mu       sync.Mutex
+	listener net.Listener
+	username string
+	password string
+	folders  map[string]*fakeFolder
+	logins   int
+	commands []string
+}
+
+func newFakeIMAPServer(t *testing.T) *fakeIMAPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s := &fakeIMAPServer{
+		listener: listener,
+		username: "user@example.com",
+		password: `pa"ss\word`,
+		folders: map[string]*fakeFolder{
+			"INBOX": {attributes: `\HasNoChildren`, messages: []*fakeMessage{
+				{uid: 11, flags: []string{`\Seen`}, raw: "Subject: first\r\n\r\nfirst body\r\n"},
+				{uid: 12, flags: []string{}, raw: "Subject: second\r\n\r\nsecond body\r\n"},
+				{uid: 14, flags: []string{`\Flagged`}, raw: "Subject: third\r\n\r\nthird body\r\n"},
+			}},
+			"Sent Items": {attributes: `\HasNoChildren \Sent`},
+			encodeMailboxName("Черновики"): {attributes: `\HasNoChildren`, messages: []*fakeMessage{
+				{uid: 3, flags: []string{`\Draft`}, raw: "Subject: draft\r\n\r\ndraft body\r\n"},
+			}},
+		},
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *fakeIMAPServer) port() uint32 {
+	return uint32(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *fakeIMAPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	fmt.Fprint(conn, "* OK [CAPABILITY IMAP4rev1] fake server ready\r\n")
+
+	selected := ""
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		parts := strings.SplitN(line, " ", 3)
+		if len(parts) < 2 {
+			fmt.Fprint(conn, "* BAD missing command\r\n")
+			continue
+		}
+		tag, command, args := parts[0], strings.ToUpper(parts[1]), ""
+		if len(parts) == 3 {
+			args = parts[2]
+		}
+
+		s.mu.Lock()
+		s.commands = append(s.commands, command)
+		s.mu.Unlock()
+
+		switch command {
+		case "LOGIN":
+			if args != quoteIMAPString(s.username)+" "+quoteIMAPString(s.password) {
+				fmt.Fprintf(conn, "%s NO [AUTHENTICATIONFAILED] invalid credentials\r\n", tag)
+				continue
+			}
+			s.mu.Lock()
+			s.logins++
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "%s OK LOGIN completed\r\n", tag)
+		case "LIST":
+			s.mu.Lock()
+			for name, folder := range s.folders {
+				fmt.Fprintf(conn, "* LIST (%s) \"/\" %s\r\n", folder.attributes, quoteIMAPString(name))
+			}
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "%s OK LIST completed\r\n", tag)
+		case "EXAMINE":
+			name, _ := strconv.Unquote(args)
+			s.mu.Lock()
+			folder, ok := s.folders[name]
+			if !ok {
+				s.mu.Unlock()
+				fmt.Fprintf(conn, "%s NO no such folder\r\n", tag)
+				continue
+			}
+			selected = name
+			fmt.Fprintf(conn, "* %d EXISTS\r\n* FLAGS (\\Seen \\Draft)\r\n", len(folder.messages))
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "%s OK [READ-ONLY] EXAMINE completed\r\n", tag)
+		case "FETCH":
+			var first, last int
+			fmt.Sscanf(args, "%d:%d", &first, &last)
+			s.mu.Lock()
+			messages := s.folders[selected].messages
+			for i := first; i <= last && i <= len(messages); i++ {
+				m := messages[i-1]
+				fmt.Fprintf(conn, "* %d FETCH (UID %d FLAGS (%s) BODY[] {%d}\r\n%s)\r\n", i, m.uid, strings.Join(m.flags, " "), len(m.raw), m.raw)
+			}
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "%s OK FETCH completed\r\n", tag)
+		case "APPEND":
+			open := strings.LastIndex(args, "{")
+			size, _ := strconv.Atoi(strings.TrimSuffix(args[open+1:], "}"))
+			nameEnd := strings.Index(args, " (")
+			name, _ := strconv.Unquote(args[:nameEnd])
+			fmt.Fprint(conn, "+ Ready for literal data\r\n")
+
+			raw := make([]byte, size+2)
+			if _, err := io.ReadFull(r, raw); err != nil {
+				return
+			}
+			flags := strings.Fields(strings.Trim(args[nameEnd+1:open-1], "()"))
+
+			s.mu.Lock()
+			folder := s.folders[name]
+			folder.messages = append(folder.messages, &fakeMessage{uid: uint32(len(folder.messages) + 1), flags: flags, raw: string(raw[:size])})
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "%s OK APPEND completed\r\n", tag)
+		case "NOOP":
+			fmt.Fprintf(conn, "%s OK NOOP completed\r\n", tag)
+		case "LOGOUT":
+			fmt.Fprintf(conn, "* BYE logging out\r\n%s OK LOGOUT completed\r\n", tag)
+			return
+		default:
+			fmt.Fprintf(conn, "%s BAD unknown command\r\n", tag)
+		}
+	}
+}
+
+func (s *fakeIMAPServer) count(command string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := 0
+	for _, c := range s.commands {
+		if c == command {
+			n++
+		}
+	}
+
+	return n
+}
+
+// newTestPolicy returns a policy trusting the local test servers on their ports.
+func newTestPolicy(ports ...uint32) DialPolicy {
+	return DialPolicy{Ports: ports, AllowedHosts: []string{"127.0.0.1"}}
+}
+
+func newTestAccount(imapPort, smtpPort uint32) *domain.ExternalAccount {
+	return &domain.ExternalAccount{
+		ID:          1,
+		Email:       "user@example.com",
+		IMAPHost:    "127.0.0.1",
+		IMAPPort:    imapPort,
+		IMAPTLSMode: domain.ExternalAccountTLSModeNone,
+		SMTPHost:    "127.0.0.1",
+		SMTPPort:    smtpPort,
+		SMTPTLSMode: domain.ExternalAccountTLSModeNone,
+		Username:    "user@example.com",
+		Password:    `pa"ss\word`,
+	}
+}
+
+type receivedEmail struct {
+	from string
+	to   []string
+	data []byte
+}
+
+// newFakeSMTPServer starts an SMTP stand-in accepting the credentials of the test account.
+func newFakeSMTPServer(t *testing.T) (uint32, chan receivedEmail) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan receivedEmail, 1)
+	server := &smtpd.Server{
+		Hostname:  "localhost",
+		AuthMechs: map[string]bool{"PLAIN": true, "CRAM-MD5": false},
+		AuthHandler: func(remoteAddr net.Addr, mechanism string, username []byte, password []byte, shared []byte) (bool, error) {
+			return string(username) == "user@example.com" && string(password) == `pa"ss\word`, nil
+		},
+		AuthRequired: true,
+		Handler: func(remoteAddr net.Addr, from string, to []string, data []byte) error {
+			received <- receivedEmail{from: from, to: to, data: data}
+			return nil
+		},
+	}
+	go server.Serve(listener)
+
+	return uint32(listener.Addr().(*net.TCPAddr).Port), received
+}
+
+func TestConnector_Folders(t *testing.T) {
+	imap := newFakeIMAPServer(t)
+	c := NewConnector(2, time.Minute, nil, newTestPolicy(imap.port()))
+	defer c.Close()
+
+	folders, err := c.Folders(newTestAccount(imap.port(), 0))
+	assert.NoError(t, err)
+
+	names := []string{}
+	for _, f := range folders {
+		names = append(names, f.Name)
+	}
+	assert.ElementsMatch(t, []string{"INBOX", "Sent Items", "Черновики"}, names)
+}
+
+func TestConnector_Messages(t *testing.T) {
+	imap := newFakeIMAPServer(t)
+	c := NewConnector(2, time.Minute, nil, newTestPolicy(imap.port()))
+	defer c.Close()
+	account := newTestAccount(imap.port(), 0)
+
+	messages, err := c.RoleMessages(account, RoleInbox, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, messages, 2) {
+		assert.Equal(t, uint32(14), messages[0].UID)
+		assert.Equal(t, "Subject: third\r\n\r\nthird body\r\n", string(messages[0].Raw))
+		assert.True(t, messages[0].HasFlag(`\flagged`))
+		assert.Equal(t, uint32(12), messages[1].UID)
+		assert.False(t, messages[1].HasFlag(`\Seen`))
+	}
+
+	messages, err = c.RoleMessages(account, RoleDrafts, 50)
+	assert.NoError(t, err)
+	if assert.Len(t, messages, 1) {
+		assert.True(t, messages[0].HasFlag(`\Draft`))
+	}
+
+	messages, err = c.Messages(account, "Sent Items", 50)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+
+	_, err = c.Messages(account, "Missing", 50)
+	assert.Error(t, err)
+
+	// All the requests reuse the pooled connection.
+	assert.Equal(t, 1, imap.logins)
+}
+
+func TestConnector_PoolReconnect(t *testing.T) {
+	imap := newFakeIMAPServer(t)
+	c := NewConnector(2, time.Minute, nil, newTestPolicy(imap.port()))
+	defer c.Close()
+	account := newTestAccount(imap.port(), 0)
+
+	_, err := c.Folders(account)
+	assert.NoError(t, err)
+
+	// The server drops the idle connection.
+	client := c.pool.Get(account.ID, account.Password)
+	client.conn.Close()
+	c.pool.idle[account.ID] = []*pooledClient{{client: client, password: account.Password, since: time.Now()}}
+
+	_, err = c.Folders(account)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, imap.logins)
+
+	// A new password closes the connections opened with the old one.
+	account.Password = "changed"
+	_, err = c.Folders(account)
+	assert.Error(t, err)
+	assert.Nil(t, c.pool.Get(account.ID, account.Password))
+}
+
+func TestConnector_Check(t *testing.T) {
+	imap := newFakeIMAPServer(t)
+	smtpPort, _ := newFakeSMTPServer(t)
+	c := NewConnector(2, time.Minute, nil, newTestPolicy(imap.port(), smtpPort))
+	defer c.Close()
+
+	account := newTestAccount(imap.port(), smtpPort)
+	assert.NoError(t, c.Check(account))
+
+	account.Password = "wrong"
+	assert.Error(t, c.Check(account))
+
+	account = newTestAccount(imap.port(), smtpPort)
+	account.SMTPTLSMode = domain.ExternalAccountTLSModeSTARTTLS
+	assert.Error(t, c.Check(account))
+
+	account = newTestAccount(imap.port(), smtpPort)
+	account.IMAPTLSMode = domain.ExternalAccountTLSModeTLS
+	assert.Error(t, c.Check(account))
+}
+
+func TestConnector_Send(t *testing.T) {
+	imap := newFakeIMAPServer(t)
+	smtpPort, received := newFakeSMTPServer(t)
+	c := NewConnector(2, time.Minute, nil, newTestPolicy(imap.port(), smtpPort))
+	defer c.Close()
+	account := newTestAccount(imap.port(), smtpPort)
+
+	message, err := BuildMessage(account.Email, []string{"friend@example.org"}, "Привет", "<p>hello</p>", time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.NoError(t, c.Send(account, []string{"friend@example.org"}, message))
+
+	select {
+	case email := <-received:
+		assert.Equal(t, "user@example.com", email.from)
+		assert.Equal(t, []string{"friend@example.org"}, email.to)
+		assert.Contains(t, string(email.data), "Subject: =?utf-8?q?=D0=9F=D1=80=D0=B8=D0=B2=D0=B5=D1=82?=")
+	case <-time.After(5 * time.Second):
+		t.Fatal("email was not received")
+	}
+
+	sent, err := c.RoleMessages(account, RoleSent, 50)
+	assert.NoError(t, err)
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, string(message), string(sent[0].Raw))
+		assert.True(t, sent[0].HasFlag(`\Seen`))
+	}
+	assert.Equal(t, 1, imap.count("APPEND"))
+}
+
+func TestConnector_DialPolicy(t *testing.T) {
+	imap := newFakeIMAPServer(t)
+	smtpPort, _ := newFakeSMTPServer(t)
+
+	t.Run("InternalAddresses", func(t *testing.T) {
+		for _, host := range []string{"127.0.0.1", "10.0.0.1", "169.254.169.254", "localhost"} {
+			_, err := DialIMAP(host, 993, domain.ExternalAccountTLSModeTLS, nil, DialPolicy{})
+			if assert.Error(t, err, host) {
+				assert.Contains(t, err.Error(), "is not allowed", host)
+			}
+
+			account := newTestAccount(0, 465)
+			account.SMTPHost, account.SMTPTLSMode = host, domain.ExternalAccountTLSModeTLS
+			_, err = dialSMTP(account, nil, DialPolicy{})
+			if assert.Error(t, err, host) {
+				assert.Contains(t, err.Error(), "is not allowed", host)
+			}
+		}
+	})
+
+	t.Run("Port", func(t *testing.T) {
+		_, err := DialIMAP("imap.example.com", 22, domain.ExternalAccountTLSModeTLS, nil, DialPolicy{})
+		assert.EqualError(t, err, "failed to connect to imap server: port 22 is not allowed")
+
+		c := NewConnector(2, time.Minute, nil, DialPolicy{AllowedHosts: []string{"127.0.0.1"}})
+		defer c.Close()
+		assert.Error(t, c.Check(newTestAccount(imap.port(), smtpPort)))
+		assert.Equal(t, 0, imap.logins)
+	})
+
+	t.Run("UnencryptedUntrustedHost", func(t *testing.T) {
+		_, err := DialIMAP("imap.example.com", 143, domain.ExternalAccountTLSModeNone, nil, DialPolicy{})
+		assert.EqualError(t, err, "failed to connect to imap server: unencrypted connection to imap.example.com is not allowed")
+
+		c := NewConnector(2, time.Minute, nil, DialPolicy{Ports: []uint32{imap.port(), smtpPort}})
+		defer c.Close()
+		assert.Error(t, c.Check(newTestAccount(imap.port(), smtpPort)))
+		assert.Equal(t, 0, imap.logins)
+	})
+
+	t.Run("TrustedHost", func(t *testing.T) {
+		c := NewConnector(2, time.Minute, nil, newTestPolicy(imap.port(), smtpPort))
+		defer c.Close()
+		assert.NoError(t, c.Check(newTestAccount(imap.port(), smtpPort)))
+	})
+}
+
+func TestNewDialPolicy(t *testing.T) {
+	policy, err := NewDialPolicy(" 993, 465,,", "mail.internal, relay.internal.")
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{993, 465}, policy.Ports)
+	assert.True(t, policy.isAllowedHost("MAIL.internal."))
+	assert.True(t, policy.isAllowedHost("relay.internal"))
+	assert.False(t, policy.isAllowedHost("internal"))
+
+	policy, err = NewDialPolicy("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, DialPolicy{}, policy)
+
+	_, err = NewDialPolicy("993,imap", "")
+	assert.Error(t, err)
+	_, err = NewDialPolicy("70000", "")
+	assert.Error(t, err)
+}
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	message, err := BuildMessage("user@example.com", []string{"a@example.org", "b@example.org"}, "Hello", "<p>hi</p>", date)
+	assert.NoError(t, err)
+	assert.Contains(t, string(message), "To: a@example.org, b@example.org\r\n")
+	assert.Contains(t, string(message), "Date: Wed, 01 May 2024 10:00:00 +0000\r\n")
+	assert.Contains(t, string(message), "Content-Type: text/html; charset=utf-8\r\n")
+
+	_, err = BuildMessage("user@example.com", []string{"a@example.org\r\nBcc: c@example.org"}, "Hello", "", date)
+	assert.Error(t, err)
+
+	_, err = BuildMessage("user@example.com", []string{}, "Hello", "", date)
+	assert.Error(t, err)
+}
@@ -0,0 +1,357 @@
+package connector
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+const (
+	// dialTimeout is the longest time to connect to a mail server.
+	dialTimeout = 10 * time.Second
+	// commandTimeout is the longest time a mail server may take to complete a command.
+	commandTimeout = 30 * time.Second
+)
+
+// Mailbox is a folder of an IMAP mailbox.
+type Mailbox struct {
+	Name       string   // Name is the decoded name of the folder, with the hierarchy delimiter of the server.
+	Attributes []string // Attributes are the flags of the folder, such as \Sent or \Noselect.
+}
+
+// Message is an email fetched from an IMAP folder.
+type Message struct {
+	UID   uint32   // UID is the unique identifier of the email in its folder.
+	Flags []string // Flags are the flags of the email, such as \Seen or \Draft.
+	Raw   []byte   // Raw is the whole email in the RFC 5322 format.
+}
+
+// HasFlag checks if the email has the flag.
+func (m *Message) HasFlag(flag string) bool {
+	for _, f := range m.Flags {
+		if strings.EqualFold(f, flag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IMAPClient is a connection to an IMAP4rev1 server, RFC 3501. It only implements the commands needed
+// to read the folders of a linked mailbox and to keep a copy of the sent emails, and is not safe for concurrent use.
+type IMAPClient struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	tag    int
+	broken bool
+}
+
+// DialIMAP connects to the IMAP server and reads its greeting, the connection is secured according to the TLS mode.
+// The TLS configuration may be nil, the server name is set from the host. The server must be allowed by the policy.
+func DialIMAP(host string, port uint32, tlsMode string, tlsConfig *tls.Config, policy DialPolicy) (*IMAPClient, error) {
+	if err := policy.check(host, port, tlsMode); err != nil {
+		return nil, fmt.Errorf("failed to connect to imap server: %v", err)
+	}
+
+	address := net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10))
+	dialer := policy.dialer(host)
+
+	var conn net.Conn
+	var err error
+	switch tlsMode {
+	case domain.ExternalAccountTLSModeTLS:
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, serverTLSConfig(host, tlsConfig))
+	case domain.ExternalAccountTLSModeSTARTTLS, domain.ExternalAccountTLSModeNone:
+		conn, err = dialer.Dial("tcp", address)
+	default:
+		return nil, fmt.Errorf("unknown tls mode %q", tlsMode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to imap server: %v", err)
+	}
+
+	c := &IMAPClient{conn: conn, reader: bufio.NewReader(conn)}
+	if err := c.readGreeting(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if tlsMode == domain.ExternalAccountTLSModeSTARTTLS {
+		if _, err := c.execute("STARTTLS"); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to start tls: %v", err)
+		}
+		tlsConn := tls.Client(conn, serverTLSConfig(host, tlsConfig))
+		tlsConn.SetDeadline(time.Now().Add(dialTimeout))
+		if err := tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to start tls: %v", err)
+		}
+		c.conn, c.reader = tlsConn, bufio.NewReader(tlsConn)
+	}
+
+	return c, nil
+}
+
+// Login signs in to the server.
+func (c *IMAPClient) Login(username, password string) error {
+	if strings.ContainsAny(username+password, "\r\n") {
+		return fmt.Errorf("invalid credentials")
+	}
+
+	if _, err := c.execute("LOGIN " + quoteIMAPString(username) + " " + quoteIMAPString(password)); err != nil {
+		return fmt.Errorf("failed to sign in to imap server: %v", err)
+	}
+
+	return nil
+}
+
+// List returns the folders of the mailbox.
+func (c *IMAPClient) List() ([]*Mailbox, error) {
+	responses, err := c.execute(`LIST "" "*"`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folders: %v", err)
+	}
+
+	mailboxes := []*Mailbox{}
+	for _, resp := range responses {
+		if resp.kind != "LIST" || len(resp.fields) < 3 {
+			continue
+		}
+
+		name, err := decodeMailboxName(imapString(resp.fields[2]))
+		if err != nil {
+			continue
+		}
+		mailbox := &Mailbox{Name: name, Attributes: []string{}}
+		for _, attribute := range imapList(resp.fields[0]) {
+			mailbox.Attributes = append(mailbox.Attributes, imapString(attribute))
+		}
+		mailboxes = append(mailboxes, mailbox)
+	}
+
+	return mailboxes, nil
+}
+
+// Examine opens the folder read-only and returns the number of emails in it.
+func (c *IMAPClient) Examine(mailbox string) (uint32, error) {
+	responses, err := c.execute("EXAMINE " + quoteIMAPString(encodeMailboxName(mailbox)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to open folder: %v", err)
+	}
+
+	var exists uint32
+	for _, resp := range responses {
+		if resp.kind == "EXISTS" {
+			exists = resp.number
+		}
+	}
+
+	return exists, nil
+}
+
+// FetchLast returns up to limit of the newest emails of the open folder, the newest first.
+func (c *IMAPClient) FetchLast(exists uint32, limit int) ([]*Message, error) {
+	if exists == 0 || limit <= 0 {
+		return []*Message{}, nil
+	}
+
+	first := uint32(1)
+	if exists > uint32(limit) {
+		first = exists - uint32(limit) + 1
+	}
+
+	responses, err := c.execute(fmt.Sprintf("FETCH %d:%d (UID FLAGS BODY.PEEK[])", first, exists))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch emails: %v", err)
+	}
+
+	messages := []*Message{}
+	for i := len(responses) - 1; i >= 0; i-- {
+		if responses[i].kind != "FETCH" || len(responses[i].fields) == 0 {
+			continue
+		}
+		if message := parseFetch(imapList(responses[i].fields[0])); message != nil {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+// Append adds the email to the folder with the flags.
+func (c *IMAPClient) Append(mailbox string, flags []string, message []byte) error {
+	c.tag++
+	tag := "A" + strconv.Itoa(c.tag)
+
+	command := fmt.Sprintf("%s APPEND %s (%s) {%d}\r\n", tag, quoteIMAPString(encodeMailboxName(mailbox)), strings.Join(flags, " "), len(message))
+	if err := c.write(command); err != nil {
+		return err
+	}
+
+	for {
+		resp, err := c.read()
+		if err != nil {
+			return err
+		}
+		if resp.tag == "+" {
+			break
+		}
+		if resp.tag == tag {
+			return fmt.Errorf("failed to append email: %s %s", resp.kind, resp.text)
+		}
+	}
+
+	if err := c.write(string(message) + "\r\n"); err != nil {
+		return err
+	}
+	if _, err := c.readCompletion(tag); err != nil {
+		return fmt.Errorf("failed to append email: %v", err)
+	}
+
+	return nil
+}
+
+// Noop checks that the connection is still alive.
+func (c *IMAPClient) Noop() error {
+	_, err := c.execute("NOOP")
+
+	return err
+}
+
+// Logout signs out and closes the connection.
+func (c *IMAPClient) Logout() error {
+	if !c.broken {
+		c.execute("LOGOUT")
+	}
+
+	return c.conn.Close()
+}
+
+// Broken reports if the connection failed and must not be used again.
+func (c *IMAPClient) Broken() bool {
+	return c.broken
+}
+
+// readGreeting reads the first response of the server.
+func (c *IMAPClient) readGreeting() error {
+	c.conn.SetDeadline(time.Now().Add(dialTimeout))
+
+	resp, err := c.read()
+	if err != nil {
+		return fmt.Errorf("failed to read imap greeting: %v", err)
+	}
+	if resp.tag != "*" || (resp.kind != "OK" && resp.kind != "PREAUTH") {
+		return fmt.Errorf("imap server refused the connection: %s %s", resp.kind, resp.text)
+	}
+
+	return nil
+}
+
+// execute sends the command and returns the untagged responses, it fails unless the server completes the command with OK.
+func (c *IMAPClient) execute(command string) ([]*imapResponse, error) {
+	c.tag++
+	tag := "A" + strconv.Itoa(c.tag)
+
+	if err := c.write(tag + " " + command + "\r\n"); err != nil {
+		return nil, err
+	}
+
+	return c.readCompletion(tag)
+}
+
+// readCompletion reads the responses up to the completion of the command with the tag.
+func (c *IMAPClient) readCompletion(tag string) ([]*imapResponse, error) {
+	responses := []*imapResponse{}
+	for {
+		resp, err := c.read()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case resp.tag == tag && resp.kind == "OK":
+			return responses, nil
+		case resp.tag == tag:
+			return nil, fmt.Errorf("%s %s", resp.kind, resp.text)
+		case resp.tag == "*" && resp.kind == "BYE":
+			c.broken = true
+			return nil, fmt.Errorf("imap server closed the connection: %s", resp.text)
+		case resp.tag == "*":
+			responses = append(responses, resp)
+		default:
+			c.broken = true
+			return nil, fmt.Errorf("unexpected imap response %s", resp.tag)
+		}
+	}
+}
+
+// write sends the data to the server, a connection which failed is not used again.
+func (c *IMAPClient) write(data string) error {
+	c.conn.SetDeadline(time.Now().Add(commandTimeout))
+
+	if _, err := c.conn.Write([]byte(data)); err != nil {
+		c.broken = true
+		return fmt.Errorf("failed to write to imap server: %v", err)
+	}
+
+	return nil
+}
+
+// read reads a response from the server, a connection which failed is not used again.
+func (c *IMAPClient) read() (*imapResponse, error) {
+	resp, err := readIMAPResponse(c.reader)
+	if err != nil {
+		c.broken = true
+		return nil, fmt.Errorf("failed to read from imap server: %v", err)
+	}
+
+	return resp, nil
+}
+
+// parseFetch returns the email described by the data of a FETCH response, or nil if the body is missing.
+func parseFetch(data []interface{}) *Message {
+	message := &Message{Flags: []string{}}
+	hasBody := false
+
+	for i := 0; i+1 < len(data); i += 2 {
+		switch name := strings.ToUpper(imapString(data[i])); {
+		case name == "UID":
+			uid, _ := strconv.ParseUint(imapString(data[i+1]), 10, 32)
+			message.UID = uint32(uid)
+		case name == "FLAGS":
+			for _, flag := range imapList(data[i+1]) {
+				message.Flags = append(message.Flags, imapString(flag))
+			}
+		case strings.HasPrefix(name, "BODY[]"):
+			message.Raw = []byte(imapString(data[i+1]))
+			hasBody = true
+		}
+	}
+
+	if !hasBody {
+		return nil
+	}
+
+	return message
+}
+
+// serverTLSConfig returns the TLS configuration to connect to the host.
+func serverTLSConfig(host string, tlsConfig *tls.Config) *tls.Config {
+	if tlsConfig == nil {
+		return &tls.Config{ServerName: host}
+	}
+
+	config := tlsConfig.Clone()
+	if config.ServerName == "" {
+		config.ServerName = host
+	}
+
+	return config
+}
@@ -0,0 +1,275 @@
+package connector
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// imapLiteralMaxSize is the largest literal accepted from a server, it bounds the memory taken by one message.
+const imapLiteralMaxSize = 50 << 20
+
+// imapResponse is a response line of an IMAP server, RFC 3501, section 7.
+type imapResponse struct {
+	tag    string        // tag is "*" for the untagged responses, "+" for the continuation requests and the command tag otherwise.
+	number uint32        // number is the message number of the EXISTS, EXPUNGE and FETCH responses.
+	kind   string        // kind is the upper-cased name of the response: OK, NO, BAD, BYE, LIST, FETCH, EXISTS...
+	fields []interface{} // fields is the data of the LIST, FETCH and other data responses, as strings and nested lists.
+	text   string        // text is the human-readable text of the status responses.
+}
+
+// readIMAPResponse reads one response from the server, with the literals it carries.
+func readIMAPResponse(r *bufio.Reader) (*imapResponse, error) {
+	tag, err := readIMAPAtom(r)
+	if err != nil {
+		return nil, err
+	}
+	if tag == "" {
+		return nil, fmt.Errorf("malformed imap response")
+	}
+
+	resp := &imapResponse{tag: tag}
+	if tag == "+" {
+		resp.text, err = readIMAPText(r)
+		return resp, err
+	}
+
+	if err := skipIMAPSpaces(r); err != nil {
+		return nil, err
+	}
+	word, err := readIMAPAtom(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if number, err := strconv.ParseUint(word, 10, 32); err == nil && tag == "*" {
+		resp.number = uint32(number)
+		if err := skipIMAPSpaces(r); err != nil {
+			return nil, err
+		}
+		if resp.kind, err = readIMAPAtom(r); err != nil {
+			return nil, err
+		}
+		resp.kind = strings.ToUpper(resp.kind)
+		resp.fields, err = readIMAPFields(r)
+		return resp, err
+	}
+
+	resp.kind = strings.ToUpper(word)
+	switch resp.kind {
+	case "OK", "NO", "BAD", "BYE", "PREAUTH":
+		resp.text, err = readIMAPText(r)
+	default:
+		resp.fields, err = readIMAPFields(r)
+	}
+
+	return resp, err
+}
+
+// readIMAPFields reads the values up to the end of the response line.
+func readIMAPFields(r *bufio.Reader) ([]interface{}, error) {
+	fields := []interface{}{}
+	for {
+		if err := skipIMAPSpaces(r); err != nil {
+			return nil, err
+		}
+		c, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		switch c {
+		case '\r':
+			if c, err = r.ReadByte(); err != nil || c != '\n' {
+				return nil, fmt.Errorf("malformed imap response line end")
+			}
+			return fields, nil
+		case '\n':
+			return fields, nil
+		}
+		if err := r.UnreadByte(); err != nil {
+			return nil, err
+		}
+
+		value, err := readIMAPValue(r)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, value)
+	}
+}
+
+// readIMAPValue reads a parenthesized list, a quoted string, a literal or an atom.
+func readIMAPValue(r *bufio.Reader) (interface{}, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch c {
+	case '(':
+		return readIMAPList(r)
+	case '"':
+		return readIMAPQuoted(r)
+	case '{':
+		return readIMAPLiteral(r)
+	}
+
+	if err := r.UnreadByte(); err != nil {
+		return nil, err
+	}
+	atom, err := readIMAPAtom(r)
+	if err != nil {
+		return nil, err
+	}
+	if atom == "" {
+		return nil, fmt.Errorf("unexpected %q in imap response", c)
+	}
+
+	return atom, nil
+}
+
+// readIMAPList reads the values of a list up to the closing parenthesis, the opening one is already read.
+func readIMAPList(r *bufio.Reader) ([]interface{}, error) {
+	list := []interface{}{}
+	for {
+		if err := skipIMAPSpaces(r); err != nil {
+			return nil, err
+		}
+		c, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if c == ')' {
+			return list, nil
+		}
+		if c == '\r' || c == '\n' {
+			return nil, fmt.Errorf("unterminated list in imap response")
+		}
+		if err := r.UnreadByte(); err != nil {
+			return nil, err
+		}
+
+		value, err := readIMAPValue(r)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+	}
+}
+
+// readIMAPQuoted reads a quoted string, the opening quote is already read.
+func readIMAPQuoted(r *bufio.Reader) (string, error) {
+	var b strings.Builder
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		switch c {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if c, err = r.ReadByte(); err != nil {
+				return "", err
+			}
+		case '\r', '\n':
+			return "", fmt.Errorf("unterminated quoted string in imap response")
+		}
+		b.WriteByte(c)
+	}
+}
+
+// readIMAPLiteral reads a literal {size}\r\n followed by size bytes, the opening brace is already read.
+func readIMAPLiteral(r *bufio.Reader) (string, error) {
+	sizeText, err := r.ReadString('}')
+	if err != nil {
+		return "", err
+	}
+	size, err := strconv.Atoi(strings.TrimSuffix(sizeText, "}"))
+	if err != nil || size < 0 || size > imapLiteralMaxSize {
+		return "", fmt.Errorf("invalid literal size in imap response")
+	}
+	if line, err := r.ReadString('\n'); err != nil || strings.TrimRight(line, "\r\n") != "" {
+		return "", fmt.Errorf("malformed literal in imap response")
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+// readIMAPAtom reads an atom, the brackets of the response codes and of the section specifications
+// such as BODY[HEADER.FIELDS (FROM)] are kept inside the atom with their spaces.
+func readIMAPAtom(r *bufio.Reader) (string, error) {
+	var b strings.Builder
+	depth := 0
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		if depth == 0 && (c == ' ' || c == '(' || c == ')' || c == '"' || c == '{' || c == '\r' || c == '\n') {
+			return b.String(), r.UnreadByte()
+		}
+		if c == '\r' || c == '\n' {
+			return "", fmt.Errorf("unterminated brackets in imap response")
+		}
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		}
+		b.WriteByte(c)
+	}
+}
+
+// readIMAPText reads the rest of the response line.
+func readIMAPText(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+// skipIMAPSpaces skips the spaces between the values.
+func skipIMAPSpaces(r *bufio.Reader) error {
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if c != ' ' {
+			return r.UnreadByte()
+		}
+	}
+}
+
+// imapString returns the value as a string, NIL and the lists are empty.
+func imapString(value interface{}) string {
+	s, ok := value.(string)
+	if !ok || s == "NIL" {
+		return ""
+	}
+
+	return s
+}
+
+// imapList returns the value as a list, NIL and the strings are empty.
+func imapList(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+
+	return list
+}
+
+// quoteIMAPString quotes the string for a command, the caller makes sure it has no line breaks.
+func quoteIMAPString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,103 @@
+package connector
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// DialPolicy limits the mail servers the connector connects to. The servers are set by the users,
+// without the limits the connector could be made to reach the services of the internal network.
+type DialPolicy struct {
+	Ports        []uint32 // Ports are the ports allowed, domain.ExternalAccountPorts if empty.
+	AllowedHosts []string // AllowedHosts are the trusted host names, they may resolve to internal addresses and be connected to without TLS.
+}
+
+// NewDialPolicy creates a policy from the comma separated lists of the ports and of the trusted host names, either can be empty.
+func NewDialPolicy(ports, allowedHosts string) (DialPolicy, error) {
+	var policy DialPolicy
+
+	for _, port := range strings.Split(ports, ",") {
+		if port = strings.TrimSpace(port); port == "" {
+			continue
+		}
+
+		number, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || number == 0 {
+			return DialPolicy{}, fmt.Errorf("invalid port %q", port)
+		}
+		policy.Ports = append(policy.Ports, uint32(number))
+	}
+
+	for _, host := range strings.Split(allowedHosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			policy.AllowedHosts = append(policy.AllowedHosts, host)
+		}
+	}
+
+	return policy, nil
+}
+
+// isAllowedHost checks if the host is trusted by the administrator.
+func (p DialPolicy) isAllowedHost(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	for _, allowed := range p.AllowedHosts {
+		if strings.EqualFold(host, strings.TrimSuffix(allowed, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// check checks the port and the TLS mode of the server before connecting to it.
+func (p DialPolicy) check(host string, port uint32, tlsMode string) error {
+	ports := p.Ports
+	if len(ports) == 0 {
+		ports = domain.ExternalAccountPorts
+	}
+
+	allowedPort := false
+	for _, allowed := range ports {
+		if port == allowed {
+			allowedPort = true
+			break
+		}
+	}
+	if !allowedPort {
+		return fmt.Errorf("port %d is not allowed", port)
+	}
+
+	if tlsMode == domain.ExternalAccountTLSModeNone && !p.isAllowedHost(host) {
+		return fmt.Errorf("unencrypted connection to %s is not allowed", host)
+	}
+
+	return nil
+}
+
+// dialer returns the dialer connecting to the server. Every address the host resolves to is checked
+// right before it is connected to, so a host resolving to an internal address after the validation can't get around it.
+func (p DialPolicy) dialer(host string) *net.Dialer {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	if p.isAllowedHost(host) {
+		return dialer
+	}
+
+	dialer.Control = func(network, address string, _ syscall.RawConn) error {
+		ipAddress, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		if !domain.IsPublicExternalAccountIP(net.ParseIP(ipAddress)) {
+			return fmt.Errorf("address %s is not allowed", ipAddress)
+		}
+
+		return nil
+	}
+
+	return dialer
+}
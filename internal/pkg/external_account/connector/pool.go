@@ -0,0 +1,95 @@
+package connector
+
+import (
+	"sync"
+	"time"
+)
+
+// pooledClient is an idle IMAP connection kept by the pool.
+type pooledClient struct {
+	client   *IMAPClient
+	password string
+	since    time.Time
+}
+
+// Pool keeps the signed in IMAP connections of every account to reuse them between requests.
+type Pool struct {
+	mu          sync.Mutex
+	idle        map[uint32][]*pooledClient
+	maxIdle     int
+	idleTimeout time.Duration
+}
+
+// NewPool creates a pool keeping up to maxIdle connections per account for at most idleTimeout.
+func NewPool(maxIdle int, idleTimeout time.Duration) *Pool {
+	return &Pool{
+		idle:        make(map[uint32][]*pooledClient),
+		maxIdle:     maxIdle,
+		idleTimeout: idleTimeout,
+	}
+}
+
+// Get takes an idle connection of the account, or returns nil if there is none.
+// The connections opened with another password are closed, since the account was linked again.
+func (p *Pool) Get(accountID uint32, password string) *IMAPClient {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	clients := p.idle[accountID]
+	for len(clients) > 0 {
+		last := clients[len(clients)-1]
+		clients = clients[:len(clients)-1]
+
+		if last.password == password && time.Since(last.since) < p.idleTimeout {
+			p.idle[accountID] = clients
+			return last.client
+		}
+		go last.client.Logout()
+	}
+	delete(p.idle, accountID)
+
+	return nil
+}
+
+// Put returns the connection of the account to the pool, the broken connections and the extra ones are closed.
+func (p *Pool) Put(accountID uint32, password string, client *IMAPClient) {
+	if client.Broken() {
+		client.Logout()
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.idle[accountID]) >= p.maxIdle {
+		go client.Logout()
+		return
+	}
+	p.idle[accountID] = append(p.idle[accountID], &pooledClient{client: client, password: password, since: time.Now()})
+}
+
+// Remove closes the idle connections of the account.
+func (p *Pool) Remove(accountID uint32) {
+	p.mu.Lock()
+	clients := p.idle[accountID]
+	delete(p.idle, accountID)
+	p.mu.Unlock()
+
+	for _, c := range clients {
+		c.client.Logout()
+	}
+}
+
+// Close closes all the idle connections.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = make(map[uint32][]*pooledClient)
+	p.mu.Unlock()
+
+	for _, clients := range idle {
+		for _, c := range clients {
+			c.client.Logout()
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package connector
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// dialSMTP connects to the SMTP server of the account and signs in if the server supports authentication.
+// The server must be allowed by the policy.
+func dialSMTP(account *domain.ExternalAccount, tlsConfig *tls.Config, policy DialPolicy) (*smtp.Client, error) {
+	if err := policy.check(account.SMTPHost, account.SMTPPort, account.SMTPTLSMode); err != nil {
+		return nil, fmt.Errorf("failed to connect to smtp server: %v", err)
+	}
+
+	address := net.JoinHostPort(account.SMTPHost, strconv.FormatUint(uint64(account.SMTPPort), 10))
+	dialer := policy.dialer(account.SMTPHost)
+
+	var conn net.Conn
+	var err error
+	switch account.SMTPTLSMode {
+	case domain.ExternalAccountTLSModeTLS:
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, serverTLSConfig(account.SMTPHost, tlsConfig))
+	case domain.ExternalAccountTLSModeSTARTTLS, domain.ExternalAccountTLSModeNone:
+		conn, err = dialer.Dial("tcp", address)
+	default:
+		return nil, fmt.Errorf("unknown tls mode %q", account.SMTPTLSMode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to smtp server: %v", err)
+	}
+	conn.SetDeadline(time.Now().Add(commandTimeout))
+
+	client, err := smtp.NewClient(conn, account.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to connect to smtp server: %v", err)
+	}
+
+	if account.SMTPTLSMode == domain.ExternalAccountTLSModeSTARTTLS {
+		if err := client.StartTLS(serverTLSConfig(account.SMTPHost, tlsConfig)); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to start tls: %v", err)
+		}
+	}
+
+	if ok, _ := client.Extension("AUTH"); ok {
+		// smtp.PlainAuth refuses to send the password over an unencrypted connection to a remote host.
+		if err := client.Auth(smtp.PlainAuth("", account.Username, account.Password, account.SMTPHost)); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to sign in to smtp server: %v", err)
+		}
+	}
+
+	return client, nil
+}
+
+// checkSMTP checks that the account can sign in to its SMTP server.
+func checkSMTP(account *domain.ExternalAccount, tlsConfig *tls.Config, policy DialPolicy) error {
+	client, err := dialSMTP(account, tlsConfig, policy)
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// sendSMTP sends the email from the address of the account to the recipients.
+func sendSMTP(account *domain.ExternalAccount, to []string, message []byte, tlsConfig *tls.Config, policy DialPolicy) error {
+	client, err := dialSMTP(account, tlsConfig, policy)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Mail(account.Email); err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("failed to send email to %s: %v", recipient, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+	if _, err := w.Write(message); err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+
+	return client.Quit()
+}
+
+// BuildMessage returns an HTML email in the RFC 5322 format.
+func BuildMessage(from string, to []string, subject, html string, date time.Time) ([]byte, error) {
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid sender %q: %v", from, err)
+	}
+	for _, recipient := range to {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return nil, fmt.Errorf("invalid recipient %q: %v", recipient, err)
+		}
+	}
+	if len(to) == 0 {
+		return nil, fmt.Errorf("no recipients")
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate message id: %v", err)
+	}
+	domainPart := from[strings.LastIndex(from, "@")+1:]
+
+	var b bytes.Buffer
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + strings.Join(to, ", ") + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	b.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("Message-ID: <" + hex.EncodeToString(id) + "@" + domainPart + ">\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/html; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&b)
+	if _, err := w.Write([]byte(html)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	b.WriteString("\r\n")
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,88 @@
+package connector
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// utf7Encoding is the modified base64 of the IMAP mailbox names, with "," instead of "/" and without padding.
+var utf7Encoding = base64.NewEncoding("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+,").WithPadding(base64.NoPadding)
+
+// encodeMailboxName encodes the mailbox name in the modified UTF-7 of RFC 3501, section 5.1.3.
+// The printable ASCII characters are kept as is, "&" becomes "&-" and the other runs are base64 encoded UTF-16 between "&" and "-".
+func encodeMailboxName(name string) string {
+	var b strings.Builder
+	var run []rune
+
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		units := utf16.Encode(run)
+		buf := make([]byte, 0, 2*len(units))
+		for _, u := range units {
+			buf = append(buf, byte(u>>8), byte(u))
+		}
+		b.WriteString("&" + utf7Encoding.EncodeToString(buf) + "-")
+		run = run[:0]
+	}
+
+	for _, r := range name {
+		if r >= 0x20 && r <= 0x7e {
+			flush()
+			if r == '&' {
+				b.WriteString("&-")
+			} else {
+				b.WriteRune(r)
+			}
+		} else {
+			run = append(run, r)
+		}
+	}
+	flush()
+
+	return b.String()
+}
+
+// decodeMailboxName decodes the mailbox name from the modified UTF-7 of RFC 3501, section 5.1.3.
+func decodeMailboxName(name string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(name); i++ {
+		if name[i] != '&' {
+			b.WriteByte(name[i])
+			continue
+		}
+
+		end := strings.IndexByte(name[i:], '-')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated shift in mailbox name %q", name)
+		}
+		encoded := name[i+1 : i+end]
+		i += end
+		if encoded == "" {
+			b.WriteByte('&')
+			continue
+		}
+
+		buf, err := utf7Encoding.DecodeString(encoded)
+		if err != nil || len(buf)%2 != 0 {
+			return "", fmt.Errorf("malformed mailbox name %q", name)
+		}
+		units := make([]uint16, 0, len(buf)/2)
+		for j := 0; j < len(buf); j += 2 {
+			units = append(units, uint16(buf[j])<<8|uint16(buf[j+1]))
+		}
+		for _, r := range utf16.Decode(units) {
+			if r == utf8.RuneError {
+				return "", fmt.Errorf("malformed mailbox name %q", name)
+			}
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String(), nil
+}
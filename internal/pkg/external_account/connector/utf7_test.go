@@ -0,0 +1,32 @@
+package connector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMailboxName(t *testing.T) {
+	tests := map[string]string{
+		"INBOX":           "INBOX",
+		"Sent & Received": "Sent &- Received",
+		"Отправленные":    "&BB4EQgQ,BEAEMAQyBDsENQQ9BD0ESwQ1-",
+		"Архив/2024":      "&BBAEQARFBDgEMg-/2024",
+		"日本語":             "&ZeVnLIqe-",
+		"Emoji 😀":         "Emoji &2D3eAA-",
+	}
+	for decoded, encoded := range tests {
+		assert.Equal(t, encoded, encodeMailboxName(decoded))
+
+		got, err := decodeMailboxName(encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, decoded, got)
+	}
+}
+
+func TestDecodeMailboxName_Malformed(t *testing.T) {
+	for _, name := range []string{"&BB4", "&!!!-", "&BB-"} {
+		_, err := decodeMailboxName(name)
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,183 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/pkg/utils/sanitize"
+
+	auth_proto "mail/internal/microservice/auth/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/models/delivery_converters"
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+	domainConnector "mail/internal/pkg/external_account/interface"
+	domainSession "mail/internal/pkg/session/interface"
+)
+
+var (
+	requestIDContextKey interface{} = "requestid"
+)
+
+// ExternalAccountHandler handles the HTTP requests to the mailboxes of other mail providers linked over IMAP and SMTP.
+type ExternalAccountHandler struct {
+	Sessions                     domainSession.SessionsManager
+	ExternalAccountServiceClient auth_proto.ExternalAccountServiceClient
+	Connector                    domainConnector.Connector
+}
+
+// GetAccounts handles requests to list the linked external mailboxes.
+// @Summary Get linked external mailboxes
+// @Description List the mailboxes of other mail providers linked by the user, without their passwords
+// @Tags external-accounts
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Linked mailboxes"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to get accounts"
+// @Router /api/v1/external/accounts [get]
+func (h *ExternalAccountHandler) GetAccounts(w http.ResponseWriter, r *http.Request) {
+	sessionUser := h.Sessions.GetSession(r, r.Context())
+
+	accountsProto, err := h.ExternalAccountServiceClient.GetExternalAccounts(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&auth_proto.GetExternalAccountsRequest{Id: sessionUser.UserID},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to get accounts")
+		return
+	}
+
+	accounts := make([]*api.ExternalAccount, 0, len(accountsProto.Accounts))
+	for _, accountProto := range accountsProto.Accounts {
+		accounts = append(accounts, converters.ExternalAccountConvertCoreInApi(proto_converters.ExternalAccountConvertProtoInCore(accountProto)))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"accounts": accounts})
+}
+
+// LinkAccount handles requests to link an external mailbox.
+// @Summary Link an external mailbox
+// @Description Link a mailbox of any provider reachable over IMAP and SMTP. The servers are checked with the credentials before the mailbox is linked, the password is stored encrypted.
+// @Tags external-accounts
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param account body response.ExternalAccountLinkSwag true "Address, servers, TLS modes (tls, starttls, none) and credentials"
+// @Success 200 {object} response.Response "Linked mailbox"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 422 {object} response.ErrorResponse "Failed to connect to the mail servers"
+// @Router /api/v1/external/account/link [post]
+func (h *ExternalAccountHandler) LinkAccount(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var link api.ExternalAccountLink
+	if err := link.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	link.Email = sanitize.SanitizeString(link.Email)
+	link.IMAPHost = sanitize.SanitizeString(link.IMAPHost)
+	link.SMTPHost = sanitize.SanitizeString(link.SMTPHost)
+	link.Username = sanitize.SanitizeString(link.Username)
+
+	account := converters.ExternalAccountLinkConvertApiInCore(&link)
+	if err := account.Validate(); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid account settings: "+err.Error())
+		return
+	}
+
+	if err := h.Connector.Check(account); err != nil {
+		response.HandleError(w, http.StatusUnprocessableEntity, "Failed to connect to the mail servers")
+		return
+	}
+
+	sessionUser := h.Sessions.GetSession(r, r.Context())
+
+	accountProto, err := h.ExternalAccountServiceClient.AddExternalAccount(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&auth_proto.AddExternalAccountRequest{Id: sessionUser.UserID, Account: proto_converters.ExternalAccountConvertCoreInProto(account)},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusUnprocessableEntity, "Failed to link account")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{
+		"account": converters.ExternalAccountConvertCoreInApi(proto_converters.ExternalAccountConvertProtoInCore(accountProto.Account)),
+	})
+}
+
+// UnlinkAccount handles requests to unlink an external mailbox.
+// @Summary Unlink an external mailbox
+// @Description Unlink a mailbox of another provider, its credentials are deleted and its connections closed
+// @Tags external-accounts
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param id path integer true "Account ID"
+// @Success 200 {object} response.Response "Account unlinked"
+// @Failure 400 {object} response.ErrorResponse "Bad id in request"
+// @Failure 404 {object} response.ErrorResponse "Account not found"
+// @Router /api/v1/external/account/delete/{id} [delete]
+func (h *ExternalAccountHandler) UnlinkAccount(w http.ResponseWriter, r *http.Request) {
+	accountID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil || accountID == 0 {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	sessionUser := h.Sessions.GetSession(r, r.Context())
+
+	_, err = h.ExternalAccountServiceClient.DeleteExternalAccount(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&auth_proto.DeleteExternalAccountRequest{Id: sessionUser.UserID, AccountId: uint32(accountID)},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Account not found")
+		return
+	}
+
+	h.Connector.Remove(uint32(accountID))
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Account unlinked"})
+}
+
+// getAccount returns the linked mailbox of the request with its password, it writes the error response if there is none.
+func (h *ExternalAccountHandler) getAccount(w http.ResponseWriter, r *http.Request) *domain.ExternalAccount {
+	accountID, err := strconv.ParseUint(mux.Vars(r)["account"], 10, 32)
+	if err != nil || accountID == 0 {
+		response.HandleError(w, http.StatusBadRequest, "Bad account id in request")
+		return nil
+	}
+
+	sessionUser := h.Sessions.GetSession(r, r.Context())
+
+	accountProto, err := h.ExternalAccountServiceClient.GetExternalAccount(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&auth_proto.GetExternalAccountRequest{Id: sessionUser.UserID, AccountId: uint32(accountID)},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to get account")
+		return nil
+	}
+	if !accountProto.Found {
+		response.HandleError(w, http.StatusNotFound, "Account not found")
+		return nil
+	}
+
+	return proto_converters.ExternalAccountConvertProtoInCore(accountProto.Account)
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authMock "mail/internal/microservice/auth/mock"
+	authProto "mail/internal/microservice/auth/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	connectorMock "mail/internal/pkg/external_account/mock"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func newExternalRequest(t *testing.T, method, url, body string, vars map[string]string) *http.Request {
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	assert.NoError(t, err)
+	req = mux.SetURLVars(req, vars)
+
+	return req.WithContext(context.WithValue(req.Context(), "requestid", "testID"))
+}
+
+func newTestHandler(ctrl *gomock.Controller) (*ExternalAccountHandler, *sessionMock.MockSessionsManager, *authMock.MockExternalAccountServiceClient, *connectorMock.MockConnector) {
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+	mockClient := authMock.NewMockExternalAccountServiceClient(ctrl)
+	mockConnector := connectorMock.NewMockConnector(ctrl)
+
+	return &ExternalAccountHandler{
+		Sessions:                     mockSessionsManager,
+		ExternalAccountServiceClient: mockClient,
+		Connector:                    mockConnector,
+	}, mockSessionsManager, mockClient, mockConnector
+}
+
+const linkBody = `{"email":"user@example.com","imapHost":"imap.example.com","imapPort":993,"imapTlsMode":"tls",` +
+	`"smtpHost":"smtp.example.com","smtpPort":587,"smtpTlsMode":"starttls","password":"secret"}`
+
+func TestGetAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, _ := newTestHandler(ctrl)
+
+	t.Run("Success", func(t *testing.T) {
+		req := newExternalRequest(t, "GET", "/api/v1/external/accounts", "", nil)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockClient.EXPECT().GetExternalAccounts(gomock.Any(), &authProto.GetExternalAccountsRequest{Id: 1}).
+			Return(&authProto.GetExternalAccountsReply{Accounts: []*authProto.ExternalAccount{{
+				Id: 3, Email: "user@example.com", ImapHost: "imap.example.com", CreationDate: timestamppb.Now(),
+			}}}, nil)
+
+		http.HandlerFunc(handler.GetAccounts).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"imapHost":"imap.example.com"`)
+		assert.NotContains(t, rr.Body.String(), `password`)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		req := newExternalRequest(t, "GET", "/api/v1/external/accounts", "", nil)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockClient.EXPECT().GetExternalAccounts(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+		http.HandlerFunc(handler.GetAccounts).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestLinkAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, mockConnector := newTestHandler(ctrl)
+
+	t.Run("Success", func(t *testing.T) {
+		req := newExternalRequest(t, "POST", "/api/v1/external/account/link", linkBody, nil)
+		rr := httptest.NewRecorder()
+
+		mockConnector.EXPECT().Check(gomock.Any()).DoAndReturn(func(account *domain.ExternalAccount) error {
+			assert.Equal(t, "user@example.com", account.Username)
+			assert.Equal(t, "secret", account.Password)
+			return nil
+		})
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockClient.EXPECT().AddExternalAccount(gomock.Any(), gomock.Any()).
+			Return(&authProto.AddExternalAccountReply{Account: &authProto.ExternalAccount{Id: 3, Email: "user@example.com", CreationDate: timestamppb.Now()}}, nil)
+
+		http.HandlerFunc(handler.LinkAccount).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":3`)
+	})
+
+	t.Run("InvalidSettings", func(t *testing.T) {
+		req := newExternalRequest(t, "POST", "/api/v1/external/account/link", `{"email":"user@example.com","imapHost":"imap.example.com","imapPort":993,"imapTlsMode":"ssl"}`, nil)
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(handler.LinkAccount).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("ConnectionFailed", func(t *testing.T) {
+		req := newExternalRequest(t, "POST", "/api/v1/external/account/link", linkBody, nil)
+		rr := httptest.NewRecorder()
+
+		mockConnector.EXPECT().Check(gomock.Any()).Return(errors.New("failed to sign in"))
+
+		http.HandlerFunc(handler.LinkAccount).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
+
+func TestUnlinkAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, mockConnector := newTestHandler(ctrl)
+
+	t.Run("Success", func(t *testing.T) {
+		req := newExternalRequest(t, "DELETE", "/api/v1/external/account/delete/3", "", map[string]string{"id": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockClient.EXPECT().DeleteExternalAccount(gomock.Any(), &authProto.DeleteExternalAccountRequest{Id: 1, AccountId: 3}).
+			Return(&authProto.DeleteExternalAccountReply{Status: true}, nil)
+		mockConnector.EXPECT().Remove(uint32(3))
+
+		http.HandlerFunc(handler.UnlinkAccount).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("BadID", func(t *testing.T) {
+		req := newExternalRequest(t, "DELETE", "/api/v1/external/account/delete/x", "", map[string]string{"id": "x"})
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(handler.UnlinkAccount).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		req := newExternalRequest(t, "DELETE", "/api/v1/external/account/delete/3", "", map[string]string{"id": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockClient.EXPECT().DeleteExternalAccount(gomock.Any(), gomock.Any()).Return(nil, errors.New("not found"))
+
+		http.HandlerFunc(handler.UnlinkAccount).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,286 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jhillyerd/enmime"
+	"github.com/microcosm-cc/bluemonday"
+
+	"mail/internal/pkg/external_account/connector"
+	"mail/internal/pkg/utils/sanitize"
+	"mail/internal/pkg/utils/validators"
+
+	domain "mail/internal/microservice/models/domain_models"
+	apiModels "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+)
+
+// GetIncoming handles requests to list the newest emails of the inbox of an external mailbox.
+// @Summary Display the list of incoming emails of an external mailbox
+// @Description Get the newest emails of the INBOX folder of a linked mailbox
+// @Tags emails-external
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param account path integer true "Account ID"
+// @Success 200 {object} response.Response "List of the emails"
+// @Failure 400 {object} response.ErrorResponse "Bad account id in request"
+// @Failure 404 {object} response.ErrorResponse "Account not found"
+// @Failure 502 {object} response.ErrorResponse "Failed to receive emails"
+// @Router /api/v1/external/{account}/emails/incoming [get]
+func (h *ExternalAccountHandler) GetIncoming(w http.ResponseWriter, r *http.Request) {
+	h.getRoleEmails(w, r, connector.RoleInbox)
+}
+
+// GetSent handles requests to list the newest emails of the sent folder of an external mailbox.
+// @Summary Display the list of sent emails of an external mailbox
+// @Description Get the newest emails of the sent folder of a linked mailbox, found by its \Sent attribute or usual name
+// @Tags emails-external
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param account path integer true "Account ID"
+// @Success 200 {object} response.Response "List of the emails"
+// @Failure 400 {object} response.ErrorResponse "Bad account id in request"
+// @Failure 404 {object} response.ErrorResponse "Account not found"
+// @Failure 502 {object} response.ErrorResponse "Failed to receive emails"
+// @Router /api/v1/external/{account}/emails/sent [get]
+func (h *ExternalAccountHandler) GetSent(w http.ResponseWriter, r *http.Request) {
+	h.getRoleEmails(w, r, connector.RoleSent)
+}
+
+// GetDrafts handles requests to list the newest drafts of an external mailbox.
+// @Summary Display the list of drafts of an external mailbox
+// @Description Get the newest emails of the drafts folder of a linked mailbox, found by its \Drafts attribute or usual name
+// @Tags emails-external
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param account path integer true "Account ID"
+// @Success 200 {object} response.Response "List of the drafts"
+// @Failure 400 {object} response.ErrorResponse "Bad account id in request"
+// @Failure 404 {object} response.ErrorResponse "Account not found"
+// @Failure 502 {object} response.ErrorResponse "Failed to receive emails"
+// @Router /api/v1/external/{account}/drafts [get]
+func (h *ExternalAccountHandler) GetDrafts(w http.ResponseWriter, r *http.Request) {
+	h.getRoleEmails(w, r, connector.RoleDrafts)
+}
+
+// GetFolders handles requests to list the folders of an external mailbox.
+// @Summary Display the list of folders of an external mailbox
+// @Description Get the IMAP folders of a linked mailbox, the name of a folder is its identifier
+// @Tags emails-external
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param account path integer true "Account ID"
+// @Success 200 {object} response.Response "List of the folders"
+// @Failure 400 {object} response.ErrorResponse "Bad account id in request"
+// @Failure 404 {object} response.ErrorResponse "Account not found"
+// @Failure 502 {object} response.ErrorResponse "Failed to receive folders"
+// @Router /api/v1/external/{account}/folders [get]
+func (h *ExternalAccountHandler) GetFolders(w http.ResponseWriter, r *http.Request) {
+	account := h.getAccount(w, r)
+	if account == nil {
+		return
+	}
+
+	mailboxes, err := h.Connector.Folders(account)
+	if err != nil {
+		response.HandleError(w, http.StatusBadGateway, "Failed to receive folders")
+		return
+	}
+
+	folders := make([]*apiModels.OtherLabel, 0, len(mailboxes))
+	for _, mailbox := range mailboxes {
+		if hasAttribute(mailbox.Attributes, `\Noselect`) || hasAttribute(mailbox.Attributes, `\NonExistent`) {
+			continue
+		}
+		folders = append(folders, &apiModels.OtherLabel{ID: mailbox.Name, Name: mailbox.Name})
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"folders": folders})
+}
+
+// GetFolderEmails handles requests to list the newest emails of a folder of an external mailbox.
+// @Summary Display the list of emails of a folder of an external mailbox
+// @Description Get the newest emails of an IMAP folder of a linked mailbox
+// @Tags emails-external
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param account path integer true "Account ID"
+// @Param name path string true "Folder name"
+// @Success 200 {object} response.Response "List of the emails"
+// @Failure 400 {object} response.ErrorResponse "Bad account id in request"
+// @Failure 404 {object} response.ErrorResponse "Account not found"
+// @Failure 502 {object} response.ErrorResponse "Failed to receive emails"
+// @Router /api/v1/external/{account}/folder/{name}/emails [get]
+func (h *ExternalAccountHandler) GetFolderEmails(w http.ResponseWriter, r *http.Request) {
+	folder := mux.Vars(r)["name"]
+	if validators.IsEmpty(folder) || strings.ContainsAny(folder, "\r\n") {
+		response.HandleError(w, http.StatusBadRequest, "Bad folder name in request")
+		return
+	}
+
+	account := h.getAccount(w, r)
+	if account == nil {
+		return
+	}
+
+	messages, err := h.Connector.Messages(account, folder, domain.ExternalAccountMessagesLimit)
+	if err != nil {
+		response.HandleError(w, http.StatusBadGateway, "Failed to receive emails")
+		return
+	}
+
+	writeEmails(w, messages)
+}
+
+// Send handles requests to send an email from an external mailbox.
+// @Summary Send an email from an external mailbox
+// @Description Send an email over the SMTP server of a linked mailbox, a copy is kept in its sent folder. Several recipients are separated by commas.
+// @Tags emails-external
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param account path integer true "Account ID"
+// @Param email body apiModels.OtherEmail true "Email data to send"
+// @Success 200 {object} response.Response "Email sent"
+// @Failure 400 {object} response.ErrorResponse "Bad JSON in request"
+// @Failure 404 {object} response.ErrorResponse "Account not found"
+// @Failure 502 {object} response.ErrorResponse "Error sending the email"
+// @Router /api/v1/external/{account}/email/send [post]
+func (h *ExternalAccountHandler) Send(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var newEmail apiModels.OtherEmail
+	if err := newEmail.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	newEmail.Topic = sanitize.SanitizeString(newEmail.Topic)
+	newEmail.Text = sanitize.SanitizeString(newEmail.Text)
+	newEmail.RecipientEmail = sanitize.SanitizeString(newEmail.RecipientEmail)
+
+	if validators.IsEmpty(newEmail.RecipientEmail) {
+		response.HandleError(w, http.StatusBadRequest, "Recipient is empty")
+		return
+	}
+	recipients := []string{}
+	for _, recipient := range strings.Split(newEmail.RecipientEmail, ",") {
+		if recipient = strings.TrimSpace(recipient); recipient != "" {
+			recipients = append(recipients, recipient)
+		}
+	}
+
+	account := h.getAccount(w, r)
+	if account == nil {
+		return
+	}
+
+	message, err := connector.BuildMessage(account.Email, recipients, newEmail.Topic, newEmail.Text, time.Now())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad recipient")
+		return
+	}
+
+	if err := h.Connector.Send(account, recipients, message); err != nil {
+		response.HandleError(w, http.StatusBadGateway, "Error sending the email")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Email sent"})
+}
+
+// getRoleEmails writes the newest emails of the folder with the role.
+func (h *ExternalAccountHandler) getRoleEmails(w http.ResponseWriter, r *http.Request, role string) {
+	account := h.getAccount(w, r)
+	if account == nil {
+		return
+	}
+
+	messages, err := h.Connector.RoleMessages(account, role, domain.ExternalAccountMessagesLimit)
+	if err != nil {
+		response.HandleError(w, http.StatusBadGateway, "Failed to receive emails")
+		return
+	}
+
+	writeEmails(w, messages)
+}
+
+// writeEmails writes the emails with a plain text preview of their bodies, as the Gmail lists do.
+func writeEmails(w http.ResponseWriter, messages []*connector.Message) {
+	p := bluemonday.StripTagsPolicy()
+	emails := make([]*apiModels.OtherEmail, 0, len(messages))
+	for _, message := range messages {
+		email, err := CreateEmailStruct(message)
+		if err != nil {
+			continue
+		}
+		email.Text = strings.Join(strings.Fields(p.Sanitize(email.Text)), " ")
+		emails = append(emails, email)
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emails})
+}
+
+// CreateEmailStruct converts an email fetched over IMAP to the API representation, the ID is its UID in the folder.
+func CreateEmailStruct(message *connector.Message) (*apiModels.OtherEmail, error) {
+	env, err := enmime.ReadEnvelope(bytes.NewReader(message.Raw))
+	if err != nil {
+		return nil, err
+	}
+
+	text := env.HTML
+	if text == "" {
+		text = env.Text
+	}
+
+	email := &apiModels.OtherEmail{
+		ID:             strconv.FormatUint(uint64(message.UID), 10),
+		Topic:          env.GetHeader("Subject"),
+		Text:           text,
+		ReadStatus:     message.HasFlag(`\Seen`),
+		Flag:           message.HasFlag(`\Flagged`),
+		DraftStatus:    message.HasFlag(`\Draft`),
+		SenderEmail:    addressList(env, "From"),
+		RecipientEmail: addressList(env, "To"),
+	}
+	if date, err := env.Date(); err == nil {
+		email.DateOfDispatch = date
+	}
+
+	return email, nil
+}
+
+// addressList returns the addresses of the header separated by commas.
+func addressList(env *enmime.Envelope, header string) string {
+	list, err := env.AddressList(header)
+	if err != nil {
+		return env.GetHeader(header)
+	}
+
+	addresses := make([]string, 0, len(list))
+	for _, address := range list {
+		addresses = append(addresses, address.Address)
+	}
+
+	return strings.Join(addresses, ", ")
+}
+
+// hasAttribute checks if the folder has the attribute.
+func hasAttribute(attributes []string, attribute string) bool {
+	for _, a := range attributes {
+		if strings.EqualFold(a, attribute) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	authMock "mail/internal/microservice/auth/mock"
+	authProto "mail/internal/microservice/auth/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	"mail/internal/pkg/external_account/connector"
+)
+
+const rawEmail = "From: Friend <friend@example.org>\r\n" +
+	"To: user@example.com\r\n" +
+	"Subject: =?utf-8?q?=D0=9F=D1=80=D0=B8=D0=B2=D0=B5=D1=82?=\r\n" +
+	"Date: Wed, 01 May 2024 10:00:00 +0000\r\n" +
+	"Content-Type: text/html; charset=utf-8\r\n\r\n" +
+	"<p>Hello\r\nthere</p>\r\n"
+
+func expectAccount(mockClient *authMock.MockExternalAccountServiceClient, found bool) {
+	reply := &authProto.GetExternalAccountReply{Found: found}
+	if found {
+		reply.Account = &authProto.ExternalAccount{Id: 3, Email: "user@example.com", Password: "secret"}
+	}
+	mockClient.EXPECT().GetExternalAccount(gomock.Any(), &authProto.GetExternalAccountRequest{Id: 1, AccountId: 3}).Return(reply, nil)
+}
+
+func TestGetIncoming(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, mockConnector := newTestHandler(ctrl)
+
+	t.Run("Success", func(t *testing.T) {
+		req := newExternalRequest(t, "GET", "/api/v1/external/3/emails/incoming", "", map[string]string{"account": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		expectAccount(mockClient, true)
+		mockConnector.EXPECT().RoleMessages(gomock.Any(), connector.RoleInbox, domain.ExternalAccountMessagesLimit).DoAndReturn(
+			func(account *domain.ExternalAccount, role string, limit int) ([]*connector.Message, error) {
+				assert.Equal(t, "secret", account.Password)
+				return []*connector.Message{{UID: 7, Flags: []string{`\Seen`}, Raw: []byte(rawEmail)}}, nil
+			})
+
+		http.HandlerFunc(handler.GetIncoming).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":"7"`)
+		assert.Contains(t, rr.Body.String(), `"topic":"Привет"`)
+		assert.Contains(t, rr.Body.String(), `"text":"Hello there"`)
+		assert.Contains(t, rr.Body.String(), `"readStatus":true`)
+		assert.Contains(t, rr.Body.String(), `"senderEmail":"friend@example.org"`)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		req := newExternalRequest(t, "GET", "/api/v1/external/3/emails/incoming", "", map[string]string{"account": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		expectAccount(mockClient, false)
+
+		http.HandlerFunc(handler.GetIncoming).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		req := newExternalRequest(t, "GET", "/api/v1/external/3/emails/incoming", "", map[string]string{"account": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		expectAccount(mockClient, true)
+		mockConnector.EXPECT().RoleMessages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+
+		http.HandlerFunc(handler.GetIncoming).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadGateway, rr.Code)
+	})
+}
+
+func TestGetFolders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, mockConnector := newTestHandler(ctrl)
+
+	req := newExternalRequest(t, "GET", "/api/v1/external/3/folders", "", map[string]string{"account": "3"})
+	rr := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+	expectAccount(mockClient, true)
+	mockConnector.EXPECT().Folders(gomock.Any()).Return([]*connector.Mailbox{
+		{Name: "INBOX"},
+		{Name: "[Gmail]", Attributes: []string{`\Noselect`}},
+		{Name: "Отправленные", Attributes: []string{`\Sent`}},
+	}, nil)
+
+	http.HandlerFunc(handler.GetFolders).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"name":"Отправленные"`)
+	assert.NotContains(t, rr.Body.String(), `[Gmail]`)
+}
+
+func TestGetFolderEmails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, mockConnector := newTestHandler(ctrl)
+
+	req := newExternalRequest(t, "GET", "/api/v1/external/3/folder/Archive/emails", "", map[string]string{"account": "3", "name": "Archive/2024"})
+	rr := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+	expectAccount(mockClient, true)
+	mockConnector.EXPECT().Messages(gomock.Any(), "Archive/2024", domain.ExternalAccountMessagesLimit).Return([]*connector.Message{}, nil)
+
+	http.HandlerFunc(handler.GetFolderEmails).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"emails":[]`)
+}
+
+func TestSend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, mockConnector := newTestHandler(ctrl)
+
+	t.Run("Success", func(t *testing.T) {
+		req := newExternalRequest(t, "POST", "/api/v1/external/3/email/send",
+			`{"topic":"Hello","text":"<p>hi</p>","recipientEmail":"a@example.org, b@example.org"}`, map[string]string{"account": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		expectAccount(mockClient, true)
+		mockConnector.EXPECT().Send(gomock.Any(), []string{"a@example.org", "b@example.org"}, gomock.Any()).DoAndReturn(
+			func(account *domain.ExternalAccount, to []string, message []byte) error {
+				assert.Contains(t, string(message), "From: user@example.com\r\n")
+				return nil
+			})
+
+		http.HandlerFunc(handler.Send).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("EmptyRecipient", func(t *testing.T) {
+		req := newExternalRequest(t, "POST", "/api/v1/external/3/email/send", `{"topic":"Hello","text":"hi"}`, map[string]string{"account": "3"})
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(handler.Send).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("SendFailed", func(t *testing.T) {
+		req := newExternalRequest(t, "POST", "/api/v1/external/3/email/send", `{"topic":"Hello","text":"hi","recipientEmail":"a@example.org"}`, map[string]string{"account": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		expectAccount(mockClient, true)
+		mockConnector.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("rejected"))
+
+		http.HandlerFunc(handler.Send).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadGateway, rr.Code)
+	})
+}
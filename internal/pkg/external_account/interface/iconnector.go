@@ -0,0 +1,29 @@
+//go:generate mockgen -source=./iconnector.go -destination=../mock/connector_mock.go -package=mock
+
+package _interface
+
+import (
+	domain "mail/internal/microservice/models/domain_models"
+	"mail/internal/pkg/external_account/connector"
+)
+
+// Connector represents the interface for reading and sending the emails of the linked external mailboxes.
+type Connector interface {
+	// Check signs in to the IMAP and SMTP servers of the account to make sure it can be linked.
+	Check(account *domain.ExternalAccount) error
+
+	// Folders returns the folders of the account.
+	Folders(account *domain.ExternalAccount) ([]*connector.Mailbox, error)
+
+	// Messages returns up to limit of the newest emails of the folder, the newest first.
+	Messages(account *domain.ExternalAccount, folder string, limit int) ([]*connector.Message, error)
+
+	// RoleMessages returns up to limit of the newest emails of the inbox, sent or drafts folder, the newest first.
+	RoleMessages(account *domain.ExternalAccount, role string, limit int) ([]*connector.Message, error)
+
+	// Send sends the email and keeps a copy of it in the sent folder of the account.
+	Send(account *domain.ExternalAccount, to []string, message []byte) error
+
+	// Remove closes the connections of the account.
+	Remove(accountID uint32)
+}
@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./iconnector.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	domain_models "mail/internal/microservice/models/domain_models"
+	connector "mail/internal/pkg/external_account/connector"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockConnector is a mock of Connector interface.
+type MockConnector struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectorMockRecorder
+}
+
+// MockConnectorMockRecorder is the mock recorder for MockConnector.
+type MockConnectorMockRecorder struct {
+	mock *MockConnector
+}
+
+// NewMockConnector creates a new mock instance.
+func NewMockConnector(ctrl *gomock.Controller) *MockConnector {
+	mock := &MockConnector{ctrl: ctrl}
+	mock.recorder = &MockConnectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnector) EXPECT() *MockConnectorMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockConnector) Check(account *domain_models.ExternalAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockConnectorMockRecorder) Check(account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockConnector)(nil).Check), account)
+}
+
+// Folders mocks base method.
+func (m *MockConnector) Folders(account *domain_models.ExternalAccount) ([]*connector.Mailbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Folders", account)
+	ret0, _ := ret[0].([]*connector.Mailbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Folders indicates an expected call of Folders.
+func (mr *MockConnectorMockRecorder) Folders(account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Folders", reflect.TypeOf((*MockConnector)(nil).Folders), account)
+}
+
+// Messages mocks base method.
+func (m *MockConnector) Messages(account *domain_models.ExternalAccount, folder string, limit int) ([]*connector.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Messages", account, folder, limit)
+	ret0, _ := ret[0].([]*connector.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Messages indicates an expected call of Messages.
+func (mr *MockConnectorMockRecorder) Messages(account, folder, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Messages", reflect.TypeOf((*MockConnector)(nil).Messages), account, folder, limit)
+}
+
+// Remove mocks base method.
+func (m *MockConnector) Remove(accountID uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Remove", accountID)
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockConnectorMockRecorder) Remove(accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockConnector)(nil).Remove), accountID)
+}
+
+// RoleMessages mocks base method.
+func (m *MockConnector) RoleMessages(account *domain_models.ExternalAccount, role string, limit int) ([]*connector.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleMessages", account, role, limit)
+	ret0, _ := ret[0].([]*connector.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoleMessages indicates an expected call of RoleMessages.
+func (mr *MockConnectorMockRecorder) RoleMessages(account, role, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleMessages", reflect.TypeOf((*MockConnector)(nil).RoleMessages), account, role, limit)
+}
+
+// Send mocks base method.
+func (m *MockConnector) Send(account *domain_models.ExternalAccount, to []string, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", account, to, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockConnectorMockRecorder) Send(account, to, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockConnector)(nil).Send), account, to, message)
+}
@@ -13,6 +13,7 @@ import (
 	"mail/internal/microservice/models/proto_converters"
 	"mail/internal/models/response"
 
+	domain "mail/internal/microservice/models/domain_models"
 	folderProto "mail/internal/microservice/folder/proto"
 	converters "mail/internal/models/delivery_converters"
 	folderApi "mail/internal/models/delivery_models"
@@ -76,6 +77,7 @@ func (h *FolderHandler) Add(w http.ResponseWriter, r *http.Request) {
 			Id:        newFolder.ID,
 			ProfileId: newFolder.ProfileId,
 			Name:      newFolder.Name,
+			ParentId:  newFolder.ParentID,
 		},
 	)
 	if err != nil {
@@ -213,6 +215,7 @@ func (h *FolderHandler) Update(w http.ResponseWriter, r *http.Request) {
 			Id:        newFolder.ID,
 			ProfileId: newFolder.ProfileId,
 			Name:      newFolder.Name,
+			ParentId:  newFolder.ParentID,
 		},
 	)
 	if err != nil || !folderDataProto.Status {
@@ -369,12 +372,62 @@ func (h *FolderHandler) DeleteEmailInFolder(w http.ResponseWriter, r *http.Reque
 	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": folderDataProto.Status})
 }
 
+// MoveEmail moves an email from one folder to another.
+// @Summary MoveEmail moves an email from one folder to another
+// @Description MoveEmail removes the email from the source folder and puts it in the target folder
+// @Tags folders
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param folder body response.MoveEmailSwag true "Email and folders in JSON format"
+// @Success 200 {object} response.Response "Move success status"
+// @Failure 400 {object} response.Response "Bad JSON in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 500 {object} response.Response "Failed to move email"
+// @Router /api/v1/folder/move_email [post]
+func (h *FolderHandler) MoveEmail(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var moveEmail folderApi.MoveEmail
+	if err := moveEmail.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	profileId, err := h.Sessions.GetProfileIDBySessionID(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad session")
+		return
+	}
+
+	folderDataProto, err := h.FolderServiceClient.MoveEmail(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&proto.MoveEmailData{
+			EmailID:      moveEmail.EmailID,
+			FromFolderID: moveEmail.FromFolderID,
+			ToFolderID:   moveEmail.ToFolderID,
+			ProfileID:    profileId,
+		},
+	)
+	if err != nil || !folderDataProto.Status {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to move email")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": folderDataProto.Status})
+}
+
 // GetAllEmailsInFolder get all emails in folder.
 // @Summary GetAllEmailsInFolder get all emails in folder
 // @Description GetAllEmailsInFolder emails in folder users
 // @Tags folders
 // @Produce json
 // @Param id path integer true "ID of the folder"
+// @Param label query integer false "ID of the label to filter emails by"
 // @Param X-Csrf-Token header string true "CSRF Token"
 // @Success 200 {object} response.Response "ID of the send folder message"
 // @Failure 400 {object} response.Response "Bad JSON in request"
@@ -389,6 +442,15 @@ func (h *FolderHandler) GetAllEmailsInFolder(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	var labelID uint64
+	if label := r.URL.Query().Get("label"); label != "" {
+		labelID, err = strconv.ParseUint(label, 10, 32)
+		if err != nil {
+			response.HandleError(w, http.StatusBadRequest, "Bad label in request")
+			return
+		}
+	}
+
 	profileId, err := h.Sessions.GetProfileIDBySessionID(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad session")
@@ -424,7 +486,7 @@ func (h *FolderHandler) GetAllEmailsInFolder(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	emailsCore := proto_converters.ObjectsEmailConvertProtoInCore(emailsDataProto)
+	emailsCore := domain.FilterEmailsByLabel(proto_converters.ObjectsEmailConvertProtoInCore(emailsDataProto), uint32(labelID))
 
 	emailsApi := make([]*folderApi.Email, 0, len(emailsCore))
 	for _, email := range emailsCore {
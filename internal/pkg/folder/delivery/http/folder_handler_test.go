@@ -60,7 +60,7 @@ func TestFolderHandler_Add_Success(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, rr.Code)
 
-	expectedResponse := `{"status":200,"body":{"folder":{"id":1,"name":"Test Folder"}}}` + "\n"
+	expectedResponse := `{"status":200,"body":{"folder":{"id":1,"name":"Test Folder","total":0,"unread":0}}}` + "\n"
 	assert.Equal(t, expectedResponse, rr.Body.String())
 }
 
@@ -1018,3 +1018,71 @@ func TestFolderHandler_GetAllEmailsInFolder_GetAllEmailsInFolderError(t *testing
 	resp := w.Result()
 	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 }
+
+func TestFolderHandler_MoveEmail_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+	mockFolderServiceClient := folder_mock.NewMockFolderServiceClient(ctrl)
+
+	handler := &FolderHandler{
+		Sessions:            mockSessionsManager,
+		FolderServiceClient: mockFolderServiceClient,
+	}
+
+	reqBody := `{"emailId":1,"fromFolderId":2,"toFolderId":3}`
+	req := httptest.NewRequest("POST", "/api/v1/folder/move_email", bytes.NewBufferString(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	ctx := req.Context()
+	ctx = context.WithValue(ctx, interface{}(string(constants.RequestIDKey)), "testID")
+	req = req.WithContext(ctx)
+
+	w := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetProfileIDBySessionID(gomock.Any(), gomock.Any()).Return(uint32(4), nil)
+
+	mockFolderServiceClient.EXPECT().MoveEmail(gomock.Any(), &folder_proto.MoveEmailData{
+		EmailID:      1,
+		FromFolderID: 2,
+		ToFolderID:   3,
+		ProfileID:    4,
+	}).Return(&folder_proto.FolderEmailStatus{Status: true}, nil)
+
+	handler.MoveEmail(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestFolderHandler_MoveEmail_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+	mockFolderServiceClient := folder_mock.NewMockFolderServiceClient(ctrl)
+
+	handler := &FolderHandler{
+		Sessions:            mockSessionsManager,
+		FolderServiceClient: mockFolderServiceClient,
+	}
+
+	reqBody := `{"emailId":1,"fromFolderId":2,"toFolderId":3}`
+	req := httptest.NewRequest("POST", "/api/v1/folder/move_email", bytes.NewBufferString(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	ctx := req.Context()
+	ctx = context.WithValue(ctx, interface{}(string(constants.RequestIDKey)), "testID")
+	req = req.WithContext(ctx)
+
+	w := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetProfileIDBySessionID(gomock.Any(), gomock.Any()).Return(uint32(4), nil)
+	mockFolderServiceClient.EXPECT().MoveEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("folder or email not found"))
+
+	handler.MoveEmail(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
@@ -1,9 +1,6 @@
 package http
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/gmail/v1"
@@ -15,26 +12,26 @@ import (
 	"os"
 
 	"mail/internal/models/response"
+	"mail/internal/pkg/utils/client_info"
 	"mail/internal/pkg/utils/sanitize"
 
 	auth_proto "mail/internal/microservice/auth/proto"
 	domain "mail/internal/microservice/models/domain_models"
 	user_proto "mail/internal/microservice/user/proto"
 	api "mail/internal/models/delivery_models"
+	gmailToken "mail/internal/pkg/gmail/gmail_token"
 	domainSession "mail/internal/pkg/session/interface"
 	validUtil "mail/internal/pkg/utils/validators"
 )
 
-var (
-	MapOAuthCongig                  = make(map[string]*gmail.Service)
-	requestIDContextKey interface{} = "requestid"
-)
+var requestIDContextKey interface{} = "requestid"
 
 // GMailAuthHandler handles user-related HTTP requests.
 type GMailAuthHandler struct {
 	Sessions          domainSession.SessionsManager
 	AuthServiceClient auth_proto.AuthServiceClient
 	UserServiceClient user_proto.UserServiceClient
+	GMailTokens       *gmailToken.Store
 } // struct
 
 // GoogleAuth handles user auth.
@@ -52,28 +49,19 @@ type GMailAuthHandler struct {
 func (g *GMailAuthHandler) GoogleAuth(w http.ResponseWriter, r *http.Request) {
 	authCode := r.URL.Query().Get("code")
 
-	b, err := os.ReadFile("cmd/configs/credentials_localhost.json")
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to read client secret file")
-		return
-	}
-
-	config, err := google.ConfigFromJSON(b, gmail.GmailReadonlyScope)
+	config, err := g.GMailTokens.Config()
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to parse client secret file to config")
 		return
 	}
 
-	tok, err := config.Exchange(context.TODO(), authCode)
+	tok, err := config.Exchange(r.Context(), authCode)
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve token from web")
 		return
 	}
 
-	ctx := context.Background()
-	client := config.Client(context.Background(), tok)
-
-	srv, err := gmail.NewService(ctx, option.WithHTTPClient(client))
+	srv, err := gmail.NewService(r.Context(), option.WithHTTPClient(config.Client(r.Context(), tok)))
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -85,15 +73,12 @@ func (g *GMailAuthHandler) GoogleAuth(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tokFile := "token.json"
-	err = saveToken(tokFile, tok)
+	err = g.GMailTokens.Save(profile.EmailAddress, tok, r.Context())
 	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to cache oauth token")
+		response.HandleError(w, http.StatusInternalServerError, "Unable to save oauth token")
 		return
 	}
 
-	MapOAuthCongig[profile.EmailAddress] = srv
-
 	userDataProto, err := g.UserServiceClient.GetUserByOnlyLogin(
 		metadata.NewOutgoingContext(r.Context(),
 			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
@@ -106,7 +91,8 @@ func (g *GMailAuthHandler) GoogleAuth(w http.ResponseWriter, r *http.Request) {
 
 	sessionId, errStatus := g.AuthServiceClient.LoginOtherMail(
 		metadata.NewOutgoingContext(r.Context(),
-			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string),
+				"userAgent": r.UserAgent(), "ipAddress": client_info.ClientIP(r)})),
 		&auth_proto.LoginOtherMailRequest{
 			Id: userDataProto.User.Id,
 		},
@@ -117,6 +103,11 @@ func (g *GMailAuthHandler) GoogleAuth(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if sessionId.TwoFactorRequired {
+		response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"TwoFactorRequired": true, "ChallengeID": sessionId.ChallengeId})
+		return
+	}
+
 	er := g.Sessions.SetSession(sessionId.SessionId, w, r, r.Context())
 	if er != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Failed to create session")
@@ -194,7 +185,8 @@ func (g *GMailAuthHandler) SugnupGMail(w http.ResponseWriter, r *http.Request) {
 
 	sessionId, errStatus := g.AuthServiceClient.LoginOtherMail(
 		metadata.NewOutgoingContext(r.Context(),
-			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string),
+				"userAgent": r.UserAgent(), "ipAddress": client_info.ClientIP(r)})),
 		&auth_proto.LoginOtherMailRequest{
 			Id: userDataProto.User.Id,
 		},
@@ -244,19 +236,3 @@ func (g *GMailAuthHandler) GetAuthURL(w http.ResponseWriter, r *http.Request) {
 
 	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"AuthURL": authURL})
 }
-
-func saveToken(path string, token *oauth2.Token) error {
-	fmt.Printf("Saving credential file to: %s\n", path)
-	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		return fmt.Errorf("Unable to cache oauth token: %v", err)
-	}
-	defer f.Close()
-
-	err = json.NewEncoder(f).Encode(token)
-	if err != nil {
-		return fmt.Errorf("Unable to cache oauth token: %v", err)
-	}
-
-	return nil
-}
@@ -0,0 +1,174 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/minio/minio-go/v7"
+
+	"mail/internal/models/response"
+	"mail/internal/pkg/utils/validators"
+
+	email_proto "mail/internal/microservice/email/proto"
+	apiModels "mail/internal/models/delivery_models"
+	gmailMessage "mail/internal/pkg/gmail/gmail_message"
+)
+
+// maxAttachmentsSize is the total size of the files attached to the sent email, Gmail rejects bigger emails.
+const maxAttachmentsSize = 25 << 20
+
+// errAttachmentsTooLarge is returned when the files attached to the email exceed maxAttachmentsSize.
+var errAttachmentsTooLarge = errors.New("attachments are too large")
+
+// attachmentURL returns the link to download the file of the Gmail email.
+func attachmentURL(messageID, partID string) string {
+	return fmt.Sprintf("/api/v1/gmail/email/%s/attachment/%s", messageID, partID)
+}
+
+// GetAttachment downloads a file of the email message.
+// @Summary Download a file of the email message
+// @Description Download an attachment or an inline image of the Gmail email message by the identifier of its part
+// @Tags emails-gmail
+// @Produce octet-stream
+// @Param id path string true "ID of the email message"
+// @Param part path string true "ID of the part of the email message"
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {file} file "Content of the file"
+// @Failure 400 {object} response.Response "Bad id in request"
+// @Failure 401 {object} response.Response "Not Authorized"
+// @Failure 404 {object} response.Response "Attachment not found"
+// @Failure 500 {object} response.Response "Error receiving attachment"
+// @Router /api/v1/gmail/email/{id}/attachment/{part} [get]
+func (g *GMailEmailHandler) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	messageID, ok := vars["id"]
+	if !ok {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+	partID, ok := vars["part"]
+	if !ok {
+		response.HandleError(w, http.StatusBadRequest, "Bad part in request")
+		return
+	}
+
+	login, err := g.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+	if !validators.IsValidEmailFormatGmail(login) {
+		response.HandleError(w, http.StatusBadRequest, "Login must end with @gmail.com")
+		return
+	}
+
+	srv, err := g.GetSRV(login, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
+		return
+	}
+
+	msg, err := srv.Users.Messages.Get("me", messageID).Format("full").Do()
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Error receiving messages")
+		return
+	}
+
+	part := gmailMessage.FindPart(msg.Payload, partID)
+	if part == nil || part.Body == nil {
+		response.HandleError(w, http.StatusNotFound, "Attachment not found")
+		return
+	}
+
+	encoded := part.Body.Data
+	if part.Body.AttachmentId != "" {
+		body, err := srv.Users.Messages.Attachments.Get("me", messageID, part.Body.AttachmentId).Do()
+		if err != nil {
+			response.HandleError(w, http.StatusInternalServerError, "Error receiving attachment")
+			return
+		}
+		encoded = body.Data
+	}
+
+	data, err := gmailMessage.DecodeData(encoded)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Error decoding attachment")
+		return
+	}
+
+	fileName := part.Filename
+	if fileName == "" {
+		fileName = "attachment-" + part.PartId
+	}
+	contentType := part.MimeType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// attachedFiles loads the uploaded files to attach to the sent email from the files bucket.
+func (g *GMailEmailHandler) attachedFiles(fileIDs []uint64, ctx context.Context) ([]*gmailMessage.File, error) {
+	files := make([]*gmailMessage.File, 0, len(fileIDs))
+	var size int64
+	for _, id := range fileIDs {
+		fileProto, err := g.EmailServiceClient.GetFileByID(outgoingContext(ctx), &email_proto.GetFileByIDRequest{FileId: id})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file: %v", err)
+		}
+
+		object, err := g.MinioClient.GetObject(ctx, "files", path.Base(fileProto.File.FileId), minio.GetObjectOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file content: %v", err)
+		}
+		data, err := io.ReadAll(io.LimitReader(object, maxAttachmentsSize-size+1))
+		object.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file content: %v", err)
+		}
+
+		size += int64(len(data))
+		if size > maxAttachmentsSize {
+			return nil, errAttachmentsTooLarge
+		}
+
+		files = append(files, &gmailMessage.File{
+			FileName: fileProto.File.FileName,
+			MimeType: fileProto.File.FileType,
+			Data:     data,
+		})
+	}
+
+	return files, nil
+}
+
+// rawEmail builds the raw Gmail email with the uploaded files attached.
+func (g *GMailEmailHandler) rawEmail(email *apiModels.OtherEmail, ctx context.Context) (string, error) {
+	files, err := g.attachedFiles(email.Files, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return gmailMessage.Compose(email.SenderEmail, email.RecipientEmail, email.Topic, email.Text, files)
+}
+
+// handleRawEmailError writes the error of rawEmail to the response.
+func handleRawEmailError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errAttachmentsTooLarge) {
+		response.HandleError(w, http.StatusBadRequest, "Attachments are too large")
+		return
+	}
+	response.HandleError(w, http.StatusInternalServerError, "Failed to attach files")
+}
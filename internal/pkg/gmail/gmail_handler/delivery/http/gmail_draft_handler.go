@@ -1,16 +1,13 @@
 package http
 
 import (
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"google.golang.org/api/gmail/v1"
 	"io"
 	"net/http"
-	"strings"
 
-	"github.com/denisbrodbeck/striphtmltags"
 	"github.com/gorilla/mux"
-	"github.com/microcosm-cc/bluemonday"
 
 	"mail/internal/models/response"
 	"mail/internal/pkg/utils/validators"
@@ -60,19 +57,17 @@ func (g *GMailEmailHandler) AddDraft(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
 	}
 
-	strRaw := fmt.Sprintf("From: %v\r\n", newDraft.SenderEmail)
-	if !validators.IsEmpty(newDraft.RecipientEmail) {
-		strRaw += fmt.Sprintf("To: %v\r\n", newDraft.RecipientEmail)
+	input, err := g.rawEmail(&newDraft, r.Context())
+	if err != nil {
+		handleRawEmailError(w, err)
+		return
 	}
-	strRaw += fmt.Sprintf("Subject: %v\r\n\r\n%v", newDraft.Topic, newDraft.Text)
-
-	input := base64.RawStdEncoding.EncodeToString([]byte(strRaw))
 
 	draft := &gmail.Draft{
 		Message: &gmail.Message{
@@ -136,13 +131,17 @@ func (g *GMailEmailHandler) SendDraft(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
 	}
 
-	input := base64.RawStdEncoding.EncodeToString([]byte(fmt.Sprintf("From: %v\r\nTo: %v\r\nSubject: %v\r\n\r\n%v", newDraft.SenderEmail, newDraft.RecipientEmail, newDraft.Topic, newDraft.Text)))
+	input, err := g.rawEmail(&newDraft, r.Context())
+	if err != nil {
+		handleRawEmailError(w, err)
+		return
+	}
 	draft := &gmail.Draft{
 		Id: newDraft.ID,
 		Message: &gmail.Message{
@@ -191,7 +190,7 @@ func (g *GMailEmailHandler) GetByIdDraft(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -203,7 +202,11 @@ func (g *GMailEmailHandler) GetByIdDraft(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	draftResult := CreateEmailStructDraft(draft)
+	draftResult, err := CreateEmailStructDraft(draft)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Error decoding body data")
+		return
+	}
 
 	for _, l := range draft.Message.LabelIds {
 		label, err := srv.Users.Labels.Get("me", l).Do()
@@ -273,19 +276,17 @@ func (g *GMailEmailHandler) UpdateDraft(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
 	}
 
-	strRaw := fmt.Sprintf("From: %v\r\n", newDraft.SenderEmail)
-	if !validators.IsEmpty(newDraft.RecipientEmail) {
-		strRaw += fmt.Sprintf("To: %v\r\n", newDraft.RecipientEmail)
+	input, err := g.rawEmail(&newDraft, r.Context())
+	if err != nil {
+		handleRawEmailError(w, err)
+		return
 	}
-	strRaw += fmt.Sprintf("Subject: %v\r\n\r\n%v", newDraft.Topic, newDraft.Text)
-
-	input := base64.RawStdEncoding.EncodeToString([]byte(strRaw))
 
 	draft := &gmail.Draft{
 		Id: draftID,
@@ -327,12 +328,21 @@ func (g *GMailEmailHandler) UpdateDraft(w http.ResponseWriter, r *http.Request)
 // @Tags drafts-gmail
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param limit query int false "Number of drafts, 20 by default, at most 50"
+// @Param cursor query string false "Cursor of the page returned with the previous page"
+// @Param q query string false "Gmail search query"
 // @Success 200 {object} response.Response "List of all draft messages"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/gmail/drafts [get]
 func (g *GMailEmailHandler) GetDrafts(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		handlePageError(w, err)
+		return
+	}
+
 	login, err := g.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
@@ -344,53 +354,23 @@ func (g *GMailEmailHandler) GetDrafts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
 	}
 
-	req, err := srv.Users.Drafts.List("me").Do()
+	draftsApi, nextCursor, err := g.draftsPage(srv, p, r.Context())
+	if errors.Is(err, errInvalidCursor) {
+		handlePageError(w, err)
+		return
+	}
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Error receiving list messages")
 		return
 	}
 
-	p := bluemonday.StripTagsPolicy()
-	draftsApi := make([]*apiModels.OtherEmail, len(req.Drafts))
-	for i, d := range req.Drafts {
-		dr, err := srv.Users.Drafts.Get("me", d.Id).Format("full").Do()
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error receiving messages")
-			return
-		}
-		email := CreateEmailStructDraft(dr)
-		text := p.Sanitize(email.Text)
-		text = strings.ReplaceAll(text, "\n", "")
-		fields := strings.Fields(text)
-		email.Text = strings.Join(fields, " ")
-		for _, l := range dr.Message.LabelIds {
-			label, err := srv.Users.Labels.Get("me", l).Do()
-			if err != nil {
-				response.HandleError(w, http.StatusInternalServerError, "Failed get label")
-				return
-			}
-			if label.Name == "UNREAD" {
-				email.ReadStatus = false
-				break
-			} else {
-				email.ReadStatus = true
-			}
-		}
-		email.DraftStatus = true
-		draftsApi[i] = email
-	}
-
-	for i := range draftsApi {
-		draftsApi[i].DraftStatus = true
-	}
-
-	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": draftsApi})
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": draftsApi, "nextCursor": nextCursor})
 }
 
 // DeleteDraft deletes an draft message.
@@ -424,7 +404,7 @@ func (g *GMailEmailHandler) DeleteDraft(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -439,52 +419,13 @@ func (g *GMailEmailHandler) DeleteDraft(w http.ResponseWriter, r *http.Request)
 	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": true})
 }
 
-func CreateEmailStructDraft(msg *gmail.Draft) *apiModels.OtherEmail {
-	email := &apiModels.OtherEmail{}
-	email.ID = msg.Id
-
-	fmt.Println(msg.Message.Payload.MimeType)
-	if msg.Message.Payload.MimeType == "text/plain" {
-		email = ParserMessageHeadersDraft(email, msg)
-		data, err := base64.URLEncoding.DecodeString(msg.Message.Payload.Body.Data)
-		if err != nil {
-			fmt.Println("Error: ", err)
-		}
-		email.Text = striphtmltags.StripTags(string(data))
-	} else if msg.Message.Payload.MimeType == "text/html" {
-		data, err := base64.URLEncoding.DecodeString(msg.Message.Payload.Body.Data)
-		if err != nil {
-			fmt.Println("Error: ", err)
-		}
-		email.Text = string(data)
-		email = ParserMessageHeadersDraft(email, msg)
-	} else if len(msg.Message.Payload.Parts) != 0 {
-		for _, part := range msg.Message.Payload.Parts {
-			if part.MimeType == "text/html" {
-				data, err := base64.URLEncoding.DecodeString(part.Body.Data)
-				if err != nil {
-					fmt.Println("Error: ", err)
-				}
-				email.Text = string(data)
-				email = ParserMessageHeadersDraft(email, msg)
-			}
-		}
+// CreateEmailStructDraft converts the Gmail draft to the API representation, the ID is the one of the draft.
+func CreateEmailStructDraft(msg *gmail.Draft) (*apiModels.OtherEmail, error) {
+	email, err := CreateEmailStruct(msg.Message)
+	if err != nil {
+		return nil, err
 	}
+	email.ID = msg.Id
 
-	return email
-}
-
-func ParserMessageHeadersDraft(email *apiModels.OtherEmail, msg *gmail.Draft) *apiModels.OtherEmail {
-	for _, mes := range msg.Message.Payload.Headers {
-		if mes.Name == "To" {
-			email.RecipientEmail = mes.Value
-		}
-		if mes.Name == "From" {
-			email.SenderEmail = mes.Value
-		}
-		if mes.Name == "Subject" {
-			email.Topic = striphtmltags.StripTags(mes.Value)
-		}
-	}
-	return email
+	return email, nil
 }
@@ -1,7 +1,7 @@
 package http
 
 import (
-	"encoding/base64"
+	"context"
 	"errors"
 	"fmt"
 	"google.golang.org/api/gmail/v1"
@@ -13,19 +13,27 @@ import (
 	"github.com/denisbrodbeck/striphtmltags"
 	"github.com/gorilla/mux"
 	"github.com/microcosm-cc/bluemonday"
+	"github.com/minio/minio-go/v7"
 
 	"mail/internal/models/response"
 	"mail/internal/pkg/utils/validators"
 
+	email_proto "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
 	apiModels "mail/internal/models/delivery_models"
-	gmailAuth "mail/internal/pkg/gmail/gmail_auth/delivery/http"
+	gmailMessage "mail/internal/pkg/gmail/gmail_message"
+	gmailSync "mail/internal/pkg/gmail/gmail_sync"
+	gmailToken "mail/internal/pkg/gmail/gmail_token"
 	domainSession "mail/internal/pkg/session/interface"
 )
 
 // GMailEmailHandler handles user-related HTTP requests.
 type GMailEmailHandler struct {
-	Sessions     domainSession.SessionsManager
-	GMailService *gmail.Service
+	Sessions           domainSession.SessionsManager
+	GMailTokens        *gmailToken.Store
+	EmailServiceClient email_proto.EmailServiceClient
+	GMailSync          *gmailSync.Worker
+	MinioClient        *minio.Client
 }
 
 func sanitizeString(str string) string {
@@ -40,12 +48,21 @@ func sanitizeString(str string) string {
 // @Tags emails-gmail
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param limit query int false "Number of emails, 20 by default, at most 50"
+// @Param cursor query string false "Cursor of the page returned with the previous page"
+// @Param q query string false "Gmail search query, the emails are searched in Gmail rather than in the local copy"
 // @Success 200 {object} response.Response "List of all email messages"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/gmail/emails/incoming [get]
 func (g *GMailEmailHandler) GetIncoming(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		handlePageError(w, err)
+		return
+	}
+
 	login, err := g.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
@@ -57,53 +74,17 @@ func (g *GMailEmailHandler) GetIncoming(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	srv, err := GetSRV(login)
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
+	emailsApi, nextCursor, err := g.emailsPage(login, domain.GMailLabelInbox, p, r.Context())
+	if errors.Is(err, errInvalidCursor) {
+		handlePageError(w, err)
 		return
 	}
-
-	req, err := srv.Users.Messages.List("me").Q("label:inbox").Do()
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Error receiving list messages")
 		return
 	}
 
-	p := bluemonday.StripTagsPolicy()
-	emailsApi := make([]*apiModels.OtherEmail, len(req.Messages))
-	for i, m := range req.Messages {
-		msg, err := srv.Users.Messages.Get("me", m.Id).Format("full").Do()
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error receiving messages")
-			return
-		}
-
-		email, err := CreateEmailStruct(msg)
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error decoding body data")
-			return
-		}
-
-		text := p.Sanitize(email.Text)
-		text = strings.ReplaceAll(text, "\n", "")
-		fields := strings.Fields(text)
-		email.Text = strings.Join(fields, " ")
-		for _, l := range msg.LabelIds {
-			label, err := srv.Users.Labels.Get("me", l).Do()
-			if err != nil {
-				response.HandleError(w, http.StatusInternalServerError, "Failed get label")
-				return
-			}
-			if label.Name == "UNREAD" {
-				email.ReadStatus = false
-				break
-			} else {
-				email.ReadStatus = true
-			}
-		}
-		emailsApi[i] = email
-	}
-	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi})
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi, "nextCursor": nextCursor})
 }
 
 // GetSent displays the list of email messages.
@@ -112,12 +93,21 @@ func (g *GMailEmailHandler) GetIncoming(w http.ResponseWriter, r *http.Request)
 // @Tags emails-gmail
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param limit query int false "Number of emails, 20 by default, at most 50"
+// @Param cursor query string false "Cursor of the page returned with the previous page"
+// @Param q query string false "Gmail search query, the emails are searched in Gmail rather than in the local copy"
 // @Success 200 {object} response.Response "List of all email messages"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/gmail/emails/sent [get]
 func (g *GMailEmailHandler) GetSent(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		handlePageError(w, err)
+		return
+	}
+
 	login, err := g.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
@@ -128,51 +118,17 @@ func (g *GMailEmailHandler) GetSent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
+	emailsApi, nextCursor, err := g.emailsPage(login, domain.GMailLabelSent, p, r.Context())
+	if errors.Is(err, errInvalidCursor) {
+		handlePageError(w, err)
 		return
 	}
-
-	req, err := srv.Users.Messages.List("me").Q("label:sent").Do()
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Error receiving list messages")
 		return
 	}
 
-	p := bluemonday.StripTagsPolicy()
-	emailsApi := make([]*apiModels.OtherEmail, len(req.Messages))
-	for i, m := range req.Messages {
-		msg, err := srv.Users.Messages.Get("me", m.Id).Format("full").Do()
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error receiving messages")
-			return
-		}
-		email, err := CreateEmailStruct(msg)
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error decoding body data")
-			return
-		}
-		text := p.Sanitize(email.Text)
-		text = strings.ReplaceAll(text, "\n", "")
-		fields := strings.Fields(text)
-		email.Text = strings.Join(fields, " ")
-		for _, l := range msg.LabelIds {
-			label, err := srv.Users.Labels.Get("me", l).Do()
-			if err != nil {
-				response.HandleError(w, http.StatusInternalServerError, "Failed get label")
-				return
-			}
-			if label.Name == "UNREAD" {
-				email.ReadStatus = false
-				break
-			} else {
-				email.ReadStatus = true
-			}
-		}
-		emailsApi[i] = email
-	}
-	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi})
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi, "nextCursor": nextCursor})
 }
 
 // GetSpam displays the list of email messages.
@@ -181,12 +137,21 @@ func (g *GMailEmailHandler) GetSent(w http.ResponseWriter, r *http.Request) {
 // @Tags emails-gmail
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
+// @Param limit query int false "Number of emails, 20 by default, at most 50"
+// @Param cursor query string false "Cursor of the page returned with the previous page"
+// @Param q query string false "Gmail search query, the emails are searched in Gmail rather than in the local copy"
 // @Success 200 {object} response.Response "List of all email messages"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/gmail/emails/spam [get]
 func (g *GMailEmailHandler) GetSpam(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		handlePageError(w, err)
+		return
+	}
+
 	login, err := g.Sessions.GetLoginBySession(r, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusBadRequest, "Bad user session")
@@ -197,57 +162,17 @@ func (g *GMailEmailHandler) GetSpam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
+	emailsApi, nextCursor, err := g.emailsPage(login, domain.GMailLabelSpam, p, r.Context())
+	if errors.Is(err, errInvalidCursor) {
+		handlePageError(w, err)
 		return
 	}
-
-	req, err := srv.Users.Messages.List("me").Q("label:spam").Do()
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Error receiving list messages")
 		return
 	}
 
-	p := bluemonday.StripTagsPolicy()
-	emailsApi := make([]*apiModels.OtherEmail, len(req.Messages))
-	for i, m := range req.Messages {
-		msg, err := srv.Users.Messages.Get("me", m.Id).Format("full").Do()
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error receiving messages")
-			return
-		}
-		email, err := CreateEmailStruct(msg)
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error decoding body data")
-			return
-		}
-		text := p.Sanitize(email.Text)
-		text = strings.ReplaceAll(text, "\n", "")
-		fields := strings.Fields(text)
-		email.Text = strings.Join(fields, " ")
-		for _, l := range msg.LabelIds {
-			label, err := srv.Users.Labels.Get("me", l).Do()
-			if err != nil {
-				response.HandleError(w, http.StatusInternalServerError, "Failed get label")
-				return
-			}
-			if label.Name == "UNREAD" {
-				email.ReadStatus = false
-				break
-			} else {
-				email.ReadStatus = true
-			}
-		}
-		email.SpamStatus = true
-		emailsApi[i] = email
-	}
-
-	for i := range emailsApi {
-		emailsApi[i].SpamStatus = true
-	}
-
-	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi})
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi, "nextCursor": nextCursor})
 }
 
 // GetById returns an email message by its ID.
@@ -280,7 +205,7 @@ func (g *GMailEmailHandler) GetById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -383,13 +308,17 @@ func (g *GMailEmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
 	}
 
-	input := base64.RawStdEncoding.EncodeToString([]byte(fmt.Sprintf("From: %v\r\nTo: %v\r\nSubject: %v\r\n\r\n%v", newEmail.SenderEmail, newEmail.RecipientEmail, newEmail.Topic, newEmail.Text)))
+	input, err := g.rawEmail(&newEmail, r.Context())
+	if err != nil {
+		handleRawEmailError(w, err)
+		return
+	}
 
 	message := &gmail.Message{
 		Raw: input,
@@ -435,13 +364,7 @@ func (g *GMailEmailHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
-		return
-	}
-
-	err = srv.Users.Messages.Delete("me", messageID).Do()
+	err = g.GMailSync.Delete(login, messageID, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Error deleting a message")
 		return
@@ -495,12 +418,6 @@ func (g *GMailEmailHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
-		return
-	}
-
 	var addModify []string
 	var removeModify []string
 	if newEmail.SpamStatus {
@@ -522,11 +439,7 @@ func (g *GMailEmailHandler) Update(w http.ResponseWriter, r *http.Request) {
 		removeModify = append(removeModify, "IMPORTANT")
 	}
 
-	modifyRequest := &gmail.ModifyMessageRequest{
-		RemoveLabelIds: removeModify,
-		AddLabelIds:    addModify,
-	}
-	_, err = srv.Users.Messages.Modify("me", messageID, modifyRequest).Do()
+	err = g.GMailSync.ModifyLabels(login, messageID, addModify, removeModify, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Error update the draft")
 		return
@@ -535,60 +448,47 @@ func (g *GMailEmailHandler) Update(w http.ResponseWriter, r *http.Request) {
 	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"email": newEmail})
 }
 
+// CreateEmailStruct converts the Gmail email to the API representation with its whole payload:
+// the HTML body with the inline images linked to the download endpoint and the attachments.
 func CreateEmailStruct(msg *gmail.Message) (*apiModels.OtherEmail, error) {
-	email := &apiModels.OtherEmail{}
-	email.ID = msg.Id
-	email.DateOfDispatch = time.Unix(msg.InternalDate/1000, 0)
-
-	if msg.Payload.MimeType == "text/plain" {
-		email = ParserMessageHeaders(email, msg)
-		data, err := base64.URLEncoding.DecodeString(msg.Payload.Body.Data)
-		if err != nil {
-			return nil, fmt.Errorf("error decoding body data")
-		}
-		email.Text = striphtmltags.StripTags(string(data))
-	} else if msg.Payload.MimeType == "text/html" {
-		data, err := base64.URLEncoding.DecodeString(msg.Payload.Body.Data)
-		if err != nil {
-			return nil, fmt.Errorf("error decoding body data")
-		}
-		email.Text = string(data)
-		email = ParserMessageHeaders(email, msg)
-	} else if len(msg.Payload.Parts) != 0 {
-		for _, part := range msg.Payload.Parts {
-			if part.MimeType == "text/html" {
-				data, err := base64.URLEncoding.DecodeString(part.Body.Data)
-				if err != nil {
-					return nil, fmt.Errorf("error decoding body data")
-				}
-				email.Text = string(data)
-				email = ParserMessageHeaders(email, msg)
-			}
-		}
+	parsed, err := gmailMessage.Parse(msg.Payload)
+	if err != nil {
+		return nil, err
 	}
 
-	return email, nil
-}
+	email := &apiModels.OtherEmail{
+		ID:             msg.Id,
+		Topic:          striphtmltags.StripTags(parsed.Subject),
+		DateOfDispatch: time.Unix(msg.InternalDate/1000, 0),
+		SenderEmail:    parsed.Sender,
+		RecipientEmail: parsed.Recipient,
+		Attachments:    make([]*apiModels.OtherAttachment, len(parsed.Attachments)),
+	}
 
-func ParserMessageHeaders(email *apiModels.OtherEmail, msg *gmail.Message) *apiModels.OtherEmail {
-	for _, mes := range msg.Payload.Headers {
-		if mes.Name == "To" {
-			email.RecipientEmail = mes.Value
-		}
-		if mes.Name == "From" {
-			email.SenderEmail = mes.Value
-		}
-		if mes.Name == "Subject" {
-			email.Topic = striphtmltags.StripTags(mes.Value)
+	if parsed.HTML != "" {
+		email.Text = parsed.ReplaceContentIDs(func(a *gmailMessage.Attachment) string {
+			return attachmentURL(msg.Id, a.PartID)
+		})
+	} else {
+		email.Text = striphtmltags.StripTags(parsed.Plain)
+	}
+
+	for i, a := range parsed.Attachments {
+		email.Attachments[i] = &apiModels.OtherAttachment{
+			PartID:    a.PartID,
+			FileName:  a.FileName,
+			FileType:  a.MimeType,
+			FileSize:  a.Size,
+			ContentID: a.ContentID,
+			Inline:    a.Inline,
+			URL:       attachmentURL(msg.Id, a.PartID),
 		}
 	}
-	return email
+
+	return email, nil
 }
 
-func GetSRV(login string) (*gmail.Service, error) {
-	srv, ok := gmailAuth.MapOAuthCongig[login]
-	if !ok {
-		return nil, errors.New("unable to retrieve Gmail client")
-	}
-	return srv, nil
+// GetSRV returns the Gmail API client of the user, the token is taken from the store and refreshed when it expires.
+func (g *GMailEmailHandler) GetSRV(login string, ctx context.Context) (*gmail.Service, error) {
+	return g.GMailTokens.Service(login, ctx)
 }
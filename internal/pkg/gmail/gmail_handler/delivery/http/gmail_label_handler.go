@@ -1,7 +1,7 @@
 package http
 
 import (
-	"fmt"
+	"errors"
 	"google.golang.org/api/gmail/v1"
 	"io"
 	"log"
@@ -9,11 +9,11 @@ import (
 	"strings"
 
 	"github.com/gorilla/mux"
-	"github.com/microcosm-cc/bluemonday"
 
 	"mail/internal/models/response"
 	"mail/internal/pkg/utils/validators"
 
+	converters "mail/internal/models/delivery_converters"
 	apiModels "mail/internal/models/delivery_models"
 )
 
@@ -39,25 +39,16 @@ func (g *GMailEmailHandler) GetLabels(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	srv, err := GetSRV(login)
+	labels, err := g.mirroredLabels(login, r.Context())
 	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
+		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve labels")
 		return
 	}
 
-	req, err := srv.Users.Labels.List("me").Do()
-	if err != nil {
-		log.Fatalf("Unable to retrieve labels: %v", err)
-	}
-
 	var labelsApi []*apiModels.OtherLabel
-	for _, l := range req.Labels {
-		if strings.Contains(l.Id, "Label") {
-			label := &apiModels.OtherLabel{
-				ID:   l.Id,
-				Name: l.Name,
-			}
-			labelsApi = append(labelsApi, label)
+	for _, l := range labels {
+		if strings.Contains(l.ID, "Label") {
+			labelsApi = append(labelsApi, converters.GMailLabelConvertCoreInApi(l))
 		}
 	}
 
@@ -71,12 +62,21 @@ func (g *GMailEmailHandler) GetLabels(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param X-Csrf-Token header string true "CSRF Token"
 // @Param name path string true "Name of the label message"
+// @Param limit query int false "Number of emails, 20 by default, at most 50"
+// @Param cursor query string false "Cursor of the page returned with the previous page"
+// @Param q query string false "Gmail search query, the emails are searched in Gmail rather than in the local copy"
 // @Success 200 {object} response.Response "List of all emails in label"
 // @Failure 400 {object} response.Response "Bad request"
 // @Failure 401 {object} response.Response "Not Authorized"
 // @Failure 500 {object} response.Response "JSON encoding error"
 // @Router /api/v1/gmail/label/{name}/emails [get]
 func (g *GMailEmailHandler) GetAllEmailsInLabel(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		handlePageError(w, err)
+		return
+	}
+
 	vars := mux.Vars(r)
 	labelName, ok := vars["name"]
 	if !ok {
@@ -97,33 +97,31 @@ func (g *GMailEmailHandler) GetAllEmailsInLabel(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	srv, err := GetSRV(login)
+	labels, err := g.mirroredLabels(login, r.Context())
 	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
+		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve labels")
 		return
 	}
 
-	req, err := srv.Users.Messages.List("me").Q(fmt.Sprintf("label:%v", labelName)).Do()
-	if err != nil {
-		log.Fatalf("Error: %v", err)
+	labelID := labelName
+	for _, l := range labels {
+		if strings.EqualFold(l.Name, labelName) {
+			labelID = l.ID
+			break
+		}
 	}
 
-	p := bluemonday.StripTagsPolicy()
-	emailsApi := make([]*apiModels.OtherEmail, len(req.Messages))
-	for i, m := range req.Messages {
-		msg, _ := srv.Users.Messages.Get("me", m.Id).Format("full").Do()
-		email, err := CreateEmailStruct(msg)
-		if err != nil {
-			response.HandleError(w, http.StatusInternalServerError, "Error decoding body data")
-			return
-		}
-		text := p.Sanitize(email.Text)
-		text = strings.ReplaceAll(text, "\n", "")
-		fields := strings.Fields(text)
-		email.Text = strings.Join(fields, " ")
-		emailsApi[i] = email
+	emailsApi, nextCursor, err := g.emailsPage(login, labelID, p, r.Context())
+	if errors.Is(err, errInvalidCursor) {
+		handlePageError(w, err)
+		return
+	}
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Error receiving list messages")
+		return
 	}
-	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi})
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emailsApi, "nextCursor": nextCursor})
 }
 
 // GetAllNameLabels displays the list of label.
@@ -157,7 +155,7 @@ func (g *GMailEmailHandler) GetAllNameLabels(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -226,7 +224,7 @@ func (g *GMailEmailHandler) CreateLabel(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -287,7 +285,7 @@ func (g *GMailEmailHandler) DeleteLabel(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -345,7 +343,7 @@ func (g *GMailEmailHandler) UpdateLabel(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	srv, err := GetSRV(login)
+	srv, err := g.GetSRV(login, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
 		return
@@ -406,17 +404,7 @@ func (g *GMailEmailHandler) AddEmailInLabel(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	srv, err := GetSRV(login)
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
-		return
-	}
-
-	modifyRequest := &gmail.ModifyMessageRequest{
-		AddLabelIds: []string{newLabelEmail.LabelID},
-	}
-
-	_, err = srv.Users.Messages.Modify("me", newLabelEmail.EmailID, modifyRequest).Do()
+	err = g.GMailSync.ModifyLabels(login, newLabelEmail.EmailID, []string{newLabelEmail.LabelID}, nil, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Failed to add LabelEmail message")
 		return
@@ -461,17 +449,7 @@ func (g *GMailEmailHandler) DeleteEmailInLabel(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	srv, err := GetSRV(login)
-	if err != nil {
-		response.HandleError(w, http.StatusInternalServerError, "Unable to retrieve Gmail client")
-		return
-	}
-
-	modifyRequest := &gmail.ModifyMessageRequest{
-		RemoveLabelIds: []string{newLabelEmail.LabelID},
-	}
-
-	_, err = srv.Users.Messages.Modify("me", newLabelEmail.EmailID, modifyRequest).Do()
+	err = g.GMailSync.ModifyLabels(login, newLabelEmail.EmailID, nil, []string{newLabelEmail.LabelID}, r.Context())
 	if err != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Failed to delete LabelEmail message")
 		return
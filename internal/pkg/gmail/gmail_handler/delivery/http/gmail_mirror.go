@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"mail/internal/pkg/logger"
+
+	email_proto "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	protoConverters "mail/internal/microservice/models/proto_converters"
+	converters "mail/internal/models/delivery_converters"
+	apiModels "mail/internal/models/delivery_models"
+)
+
+// mirroredEmails returns the newest emails with the label sent before the given one from the local copy of the Gmail mailbox,
+// a mailbox without a copy yet is queued for copying and has no emails until then.
+func (g *GMailEmailHandler) mirroredEmails(login, labelID string, beforeDate time.Time, beforeID string, limit int64, ctx context.Context) ([]*apiModels.OtherEmail, error) {
+	if err := g.GMailSync.Ensure(login, ctx); err != nil {
+		return nil, err
+	}
+
+	input := &email_proto.GMailLabelAndLogin{
+		Login:    login,
+		LabelId:  labelID,
+		Limit:    limit,
+		BeforeId: beforeID,
+	}
+	if !beforeDate.IsZero() {
+		input.BeforeDate = timestamppb.New(beforeDate)
+	}
+
+	reply, err := g.EmailServiceClient.GetGMailMessages(outgoingContext(ctx), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gmail messages: %v", err)
+	}
+
+	emailsApi := make([]*apiModels.OtherEmail, len(reply.Messages))
+	for i, m := range reply.Messages {
+		email := converters.GMailMessageConvertCoreInApi(protoConverters.GMailMessageConvertProtoInCore(m))
+		email.Text = preview(email.Text)
+		emailsApi[i] = email
+	}
+
+	return emailsApi, nil
+}
+
+// mirroredLabels returns the labels from the local copy of the Gmail mailbox,
+// a mailbox without a copy yet is queued for copying and has no emails until then.
+func (g *GMailEmailHandler) mirroredLabels(login string, ctx context.Context) ([]*domain.GMailLabel, error) {
+	if err := g.GMailSync.Ensure(login, ctx); err != nil {
+		return nil, err
+	}
+
+	reply, err := g.EmailServiceClient.GetGMailLabels(outgoingContext(ctx), &email_proto.GMailLogin{Login: login})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gmail labels: %v", err)
+	}
+
+	labels := make([]*domain.GMailLabel, len(reply.Labels))
+	for i, l := range reply.Labels {
+		labels[i] = protoConverters.GMailLabelConvertProtoInCore(l)
+	}
+
+	return labels, nil
+}
+
+// outgoingContext passes the request ID of the gateway request to the email service.
+func outgoingContext(ctx context.Context) context.Context {
+	return metadata.NewOutgoingContext(ctx,
+		metadata.New(map[string]string{"requestID": logger.GetRequestIDString(ctx.Value("requestID"))}))
+}
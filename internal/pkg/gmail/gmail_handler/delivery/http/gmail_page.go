@@ -0,0 +1,284 @@
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/microcosm-cc/bluemonday"
+	"google.golang.org/api/gmail/v1"
+
+	"mail/internal/models/response"
+
+	domain "mail/internal/microservice/models/domain_models"
+	apiModels "mail/internal/models/delivery_models"
+)
+
+// gmailFetchConcurrency is the number of the emails fetched from Gmail at the same time.
+const gmailFetchConcurrency = 8
+
+const (
+	// cursorMirror marks the cursor of the page read from the local copy of the mailbox.
+	cursorMirror = "m"
+	// cursorToken marks the cursor of the page read from Gmail, it keeps the nextPageToken of Gmail.
+	cursorToken = "t"
+)
+
+// errInvalidCursor is returned when the cursor is malformed or does not belong to the kind of the list.
+var errInvalidCursor = errors.New("invalid cursor")
+
+// pageCursor represents the position in a Gmail list the page starts after.
+type pageCursor struct {
+	PageToken  string    // PageToken is the nextPageToken of Gmail, set when the list is read from Gmail.
+	BeforeDate time.Time // BeforeDate is the date of the last email of the previous page of the local copy.
+	BeforeID   string    // BeforeID is the identifier of the last email of the previous page of the local copy.
+}
+
+// page represents the query of a page of a Gmail list.
+type page struct {
+	Limit  int64       // Limit is the number of emails in the page.
+	Query  string      // Query is the Gmail search query, the list is read from Gmail rather than from the local copy when it is set.
+	Cursor *pageCursor // Cursor is the position the page starts after, nil for the first page.
+}
+
+// encodeMirrorCursor returns the opaque cursor of the page of the local copy which starts after the email.
+func encodeMirrorCursor(email *apiModels.OtherEmail) string {
+	position := fmt.Sprintf("%s|%d|%s", cursorMirror, email.DateOfDispatch.UnixNano(), email.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(position))
+}
+
+// encodeTokenCursor returns the opaque cursor of the page of Gmail with the page token.
+func encodeTokenCursor(pageToken string) string {
+	if pageToken == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorToken + "|" + pageToken))
+}
+
+// decodeCursor returns the position the page starts after.
+func decodeCursor(cursor string) (*pageCursor, error) {
+	position, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	kind, value, _ := strings.Cut(string(position), "|")
+	switch kind {
+	case cursorToken:
+		if value != "" {
+			return &pageCursor{PageToken: value}, nil
+		}
+	case cursorMirror:
+		date, id, _ := strings.Cut(value, "|")
+		unixNano, err := strconv.ParseInt(date, 10, 64)
+		if err == nil && id != "" {
+			return &pageCursor{BeforeDate: time.Unix(0, unixNano), BeforeID: id}, nil
+		}
+	}
+
+	return nil, errInvalidCursor
+}
+
+// parsePage reads the limit, cursor and q query parameters of the request.
+// A cursor of the local copy can't be used with a search query and the other way round.
+func parsePage(r *http.Request) (*page, error) {
+	p := &page{Limit: domain.GMailPageLimit, Query: strings.TrimSpace(r.URL.Query().Get("q"))}
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid limit")
+		}
+		if limit > domain.GMailPageMaxLimit {
+			limit = domain.GMailPageMaxLimit
+		}
+		p.Limit = limit
+	}
+
+	if value := r.URL.Query().Get("cursor"); value != "" {
+		cursor, err := decodeCursor(value)
+		if err != nil {
+			return nil, err
+		}
+		p.Cursor = cursor
+	}
+
+	return p, nil
+}
+
+// validFor reports whether the cursor of the page can be used for a list read from Gmail (live) or from the local copy.
+func (p *page) validFor(live bool) bool {
+	return p.Cursor == nil || (p.Cursor.PageToken != "") == live
+}
+
+// handlePageError writes the error of parsePage to the response.
+func handlePageError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errInvalidCursor) {
+		response.HandleError(w, http.StatusBadRequest, "Invalid cursor")
+		return
+	}
+	response.HandleError(w, http.StatusBadRequest, "Invalid limit")
+}
+
+// emailsPage returns the page of the emails with the label and the cursor of the next one, empty for the last page.
+// The pages are read from the local copy of the mailbox, or from Gmail when the page has a search query.
+func (g *GMailEmailHandler) emailsPage(login, labelID string, p *page, ctx context.Context) ([]*apiModels.OtherEmail, string, error) {
+	if p.Query != "" {
+		if !p.validFor(true) {
+			return nil, "", errInvalidCursor
+		}
+		return g.liveEmails(login, labelID, p, ctx)
+	}
+
+	if !p.validFor(false) {
+		return nil, "", errInvalidCursor
+	}
+
+	var beforeDate time.Time
+	var beforeID string
+	if p.Cursor != nil {
+		beforeDate, beforeID = p.Cursor.BeforeDate, p.Cursor.BeforeID
+	}
+
+	emails, err := g.mirroredEmails(login, labelID, beforeDate, beforeID, p.Limit+1, ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if int64(len(emails)) > p.Limit {
+		emails = emails[:p.Limit]
+		nextCursor = encodeMirrorCursor(emails[p.Limit-1])
+	}
+
+	return emails, nextCursor, nil
+}
+
+// liveEmails searches Gmail for the page of the emails with the label, the details of the emails are fetched concurrently.
+func (g *GMailEmailHandler) liveEmails(login, labelID string, p *page, ctx context.Context) ([]*apiModels.OtherEmail, string, error) {
+	srv, err := g.GetSRV(login, ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get gmail client: %v", err)
+	}
+
+	call := srv.Users.Messages.List("me").Q(p.Query).MaxResults(p.Limit)
+	if labelID != "" {
+		call = call.LabelIds(labelID)
+	}
+	if p.Cursor != nil {
+		call = call.PageToken(p.Cursor.PageToken)
+	}
+	list, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list gmail messages: %v", err)
+	}
+
+	emails := make([]*apiModels.OtherEmail, len(list.Messages))
+	err = fetchConcurrently(len(list.Messages), func(i int) error {
+		msg, err := srv.Users.Messages.Get("me", list.Messages[i].Id).Format("full").Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to get gmail message: %v", err)
+		}
+		email, err := CreateEmailStruct(msg)
+		if err != nil {
+			return err
+		}
+		setLabelStatuses(email, msg.LabelIds)
+		email.Text = preview(email.Text)
+		emails[i] = email
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return emails, encodeTokenCursor(list.NextPageToken), nil
+}
+
+// draftsPage returns the page of the drafts from Gmail and the cursor of the next one, the drafts are fetched concurrently.
+func (g *GMailEmailHandler) draftsPage(srv *gmail.Service, p *page, ctx context.Context) ([]*apiModels.OtherEmail, string, error) {
+	if !p.validFor(true) {
+		return nil, "", errInvalidCursor
+	}
+
+	call := srv.Users.Drafts.List("me").MaxResults(p.Limit)
+	if p.Query != "" {
+		call = call.Q(p.Query)
+	}
+	if p.Cursor != nil {
+		call = call.PageToken(p.Cursor.PageToken)
+	}
+	list, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list gmail drafts: %v", err)
+	}
+
+	drafts := make([]*apiModels.OtherEmail, len(list.Drafts))
+	err = fetchConcurrently(len(list.Drafts), func(i int) error {
+		dr, err := srv.Users.Drafts.Get("me", list.Drafts[i].Id).Format("full").Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to get gmail draft: %v", err)
+		}
+		email, err := CreateEmailStructDraft(dr)
+		if err != nil {
+			return err
+		}
+		setLabelStatuses(email, dr.Message.LabelIds)
+		email.Text = preview(email.Text)
+		email.DraftStatus = true
+		drafts[i] = email
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return drafts, encodeTokenCursor(list.NextPageToken), nil
+}
+
+// fetchConcurrently calls fetch for the indexes from 0 to n with at most gmailFetchConcurrency calls at the same time,
+// the first error is returned.
+func fetchConcurrently(n int, fetch func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	slots := make(chan struct{}, gmailFetchConcurrency)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := fetch(i); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// setLabelStatuses sets the read, spam and important statuses of the email the same way as for the local copy.
+func setLabelStatuses(email *apiModels.OtherEmail, labelIDs []string) {
+	message := &domain.GMailMessage{LabelIDs: labelIDs}
+	email.ReadStatus = !message.HasLabel(domain.GMailLabelUnread)
+	email.SpamStatus = message.HasLabel(domain.GMailLabelSpam)
+	email.Flag = message.HasLabel(domain.GMailLabelImportant)
+}
+
+// preview returns the body of the email as a single line of plain text for the lists.
+func preview(text string) string {
+	text = bluemonday.StripTagsPolicy().Sanitize(text)
+	text = strings.ReplaceAll(text, "\n", "")
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,141 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
+
+	domain "mail/internal/microservice/models/domain_models"
+	apiModels "mail/internal/models/delivery_models"
+)
+
+func TestCursor(t *testing.T) {
+	email := &apiModels.OtherEmail{ID: "18c1", DateOfDispatch: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+
+	cursor, err := decodeCursor(encodeMirrorCursor(email))
+	assert.NoError(t, err)
+	assert.True(t, cursor.BeforeDate.Equal(email.DateOfDispatch))
+	assert.Equal(t, "18c1", cursor.BeforeID)
+	assert.Empty(t, cursor.PageToken)
+
+	cursor, err = decodeCursor(encodeTokenCursor("token|1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "token|1", cursor.PageToken)
+
+	assert.Empty(t, encodeTokenCursor(""))
+
+	for _, value := range []string{"%%%", "dA", "bXwxMjN8", "bXxhYmN8MQ"} {
+		_, err := decodeCursor(value)
+		assert.ErrorIs(t, err, errInvalidCursor, value)
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	p, err := parsePage(httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, &page{Limit: domain.GMailPageLimit}, p)
+
+	p, err = parsePage(httptest.NewRequest(http.MethodGet, "/?limit=1000&q=from:bob&cursor="+encodeTokenCursor("next"), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(domain.GMailPageMaxLimit), p.Limit)
+	assert.Equal(t, "from:bob", p.Query)
+	assert.True(t, p.validFor(true))
+	assert.False(t, p.validFor(false))
+
+	_, err = parsePage(httptest.NewRequest(http.MethodGet, "/?limit=0", nil))
+	assert.Error(t, err)
+
+	_, err = parsePage(httptest.NewRequest(http.MethodGet, "/?cursor=bad", nil))
+	assert.ErrorIs(t, err, errInvalidCursor)
+}
+
+func TestFetchConcurrently(t *testing.T) {
+	var running, maxRunning int32
+	err := fetchConcurrently(50, func(i int) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, maxRunning, int32(gmailFetchConcurrency))
+
+	err = fetchConcurrently(5, func(i int) error {
+		if i == 3 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "failed")
+}
+
+func TestDraftsPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/drafts"):
+			assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+			assert.Equal(t, "prev", r.URL.Query().Get("pageToken"))
+			assert.Equal(t, "is:starred", r.URL.Query().Get("q"))
+			_ = json.NewEncoder(w).Encode(&gmail.ListDraftsResponse{
+				Drafts:        []*gmail.Draft{{Id: "d1"}, {Id: "d2"}},
+				NextPageToken: "next",
+			})
+		case strings.HasSuffix(r.URL.Path, "/drafts/d1"), strings.HasSuffix(r.URL.Path, "/drafts/d2"):
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			labels := []string{"DRAFT"}
+			if id == "d2" {
+				labels = append(labels, "UNREAD")
+			}
+			_ = json.NewEncoder(w).Encode(&gmail.Draft{
+				Id: id,
+				Message: &gmail.Message{
+					Id:       "m" + id,
+					LabelIds: labels,
+					Payload: &gmail.MessagePart{
+						MimeType: "text/html",
+						Headers:  []*gmail.MessagePartHeader{{Name: "Subject", Value: "Draft " + id}},
+						Body:     &gmail.MessagePartBody{Data: "PHA-SGVsbG88L3A-"},
+					},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	srv, err := gmail.NewService(context.Background(), option.WithHTTPClient(server.Client()), option.WithEndpoint(server.URL))
+	assert.NoError(t, err)
+
+	drafts, nextCursor, err := (&GMailEmailHandler{}).draftsPage(srv, &page{Limit: 2, Query: "is:starred", Cursor: &pageCursor{PageToken: "prev"}}, context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, encodeTokenCursor("next"), nextCursor)
+	assert.Len(t, drafts, 2)
+	assert.Equal(t, "d1", drafts[0].ID)
+	assert.Equal(t, "Draft d1", drafts[0].Topic)
+	assert.Equal(t, "Hello", drafts[0].Text)
+	assert.True(t, drafts[0].ReadStatus)
+	assert.True(t, drafts[0].DraftStatus)
+	assert.Equal(t, "d2", drafts[1].ID)
+	assert.False(t, drafts[1].ReadStatus)
+
+	_, _, err = (&GMailEmailHandler{}).draftsPage(srv, &page{Limit: 2, Cursor: &pageCursor{BeforeID: "1"}}, context.Background())
+	assert.ErrorIs(t, err, errInvalidCursor)
+}
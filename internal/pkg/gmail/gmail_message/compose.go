@@ -0,0 +1,88 @@
+package gmail_message
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// File represents the content of the file attached to the sent email.
+type File struct {
+	FileName string // FileName is the name of the file.
+	MimeType string // MimeType is the type of the content, application/octet-stream when empty.
+	Data     []byte // Data is the content of the file.
+}
+
+// Compose builds the raw RFC 822 email for the Gmail API with the HTML body and the files attached,
+// encoded with base64url as the Raw field expects. The To header is left out when recipient is empty,
+// so drafts can be saved without it.
+func Compose(sender, recipient, subject, html string, files []*File) (string, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString(fmt.Sprintf("From: %v\r\n", sender))
+	if recipient != "" {
+		buf.WriteString(fmt.Sprintf("To: %v\r\n", recipient))
+	}
+	buf.WriteString(fmt.Sprintf("Subject: %v\r\n", mime.QEncoding.Encode("utf-8", subject)))
+
+	if len(files) == 0 {
+		buf.WriteString("Content-Type: text/html; charset=utf-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+		writeBase64(&buf, []byte(html))
+		return base64.URLEncoding.EncodeToString(buf.Bytes()), nil
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	buf.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q\r\n\r\n", writer.Boundary()))
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/html; charset=utf-8"},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to write body: %v", err)
+	}
+	writeBase64(part, []byte(html))
+
+	for _, file := range files {
+		mimeType := file.MimeType
+		if mimeType == "" {
+			mimeType = "application/octet-stream"
+		}
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(mimeType, map[string]string{"name": file.FileName})},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to write attachment: %v", err)
+		}
+		writeBase64(part, file.Data)
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("failed to close multipart: %v", err)
+	}
+	buf.Write(body.Bytes())
+
+	return base64.URLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// writeBase64 writes the data encoded with base64 in lines of 76 characters, as RFC 2045 requires.
+func writeBase64(w io.Writer, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var lines strings.Builder
+	for len(encoded) > 76 {
+		lines.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	lines.WriteString(encoded + "\r\n")
+	_, _ = w.Write([]byte(lines.String()))
+}
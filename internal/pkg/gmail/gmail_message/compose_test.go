@@ -0,0 +1,84 @@
+package gmail_message
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readRaw(t *testing.T, raw string) *mail.Message {
+	data, err := base64.URLEncoding.DecodeString(raw)
+	assert.NoError(t, err)
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	assert.NoError(t, err)
+	return msg
+}
+
+func readBase64(t *testing.T, r io.Reader) string {
+	data, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, r))
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestCompose(t *testing.T) {
+	raw, err := Compose("sender@gmail.com", "recipient@gmail.com", "Отчёт", "<p>Hello</p>", nil)
+	assert.NoError(t, err)
+
+	msg := readRaw(t, raw)
+	assert.Equal(t, "sender@gmail.com", msg.Header.Get("From"))
+	assert.Equal(t, "recipient@gmail.com", msg.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Отчёт", subject)
+	assert.Equal(t, "text/html; charset=utf-8", msg.Header.Get("Content-Type"))
+	assert.Equal(t, "<p>Hello</p>", readBase64(t, msg.Body))
+}
+
+func TestCompose_Draft(t *testing.T) {
+	raw, err := Compose("sender@gmail.com", "", "Draft", "text", nil)
+	assert.NoError(t, err)
+
+	msg := readRaw(t, raw)
+	_, ok := msg.Header["To"]
+	assert.False(t, ok)
+}
+
+func TestCompose_Files(t *testing.T) {
+	raw, err := Compose("sender@gmail.com", "recipient@gmail.com", "Report", "<p>Hello</p>", []*File{
+		{FileName: "report.pdf", MimeType: "application/pdf", Data: []byte("%PDF-1.4")},
+		{FileName: "data.bin", Data: bytes.Repeat([]byte{0xff}, 100)},
+	})
+	assert.NoError(t, err)
+
+	msg := readRaw(t, raw)
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	part, err := reader.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "text/html; charset=utf-8", part.Header.Get("Content-Type"))
+	assert.Equal(t, "<p>Hello</p>", readBase64(t, part))
+
+	part, err = reader.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "report.pdf", part.FileName())
+	assert.Equal(t, "%PDF-1.4", readBase64(t, part))
+
+	part, err = reader.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "data.bin", part.FileName())
+	assert.Contains(t, part.Header.Get("Content-Type"), "application/octet-stream")
+	assert.Equal(t, string(bytes.Repeat([]byte{0xff}, 100)), readBase64(t, part))
+
+	_, err = reader.NextPart()
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,184 @@
+package gmail_message
+
+import (
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+// Attachment represents a file of the Gmail email, including the images shown inside the HTML body.
+type Attachment struct {
+	PartID       string // PartID is the stable identifier of the MIME part inside the email.
+	AttachmentID string // AttachmentID is the identifier to download the content when Gmail keeps it apart from the email.
+	FileName     string // FileName is the name of the file.
+	MimeType     string // MimeType is the type of the content.
+	Size         int64  // Size is the size of the content in bytes.
+	ContentID    string // ContentID is the Content-ID header without brackets, the HTML body refers to it with "cid:".
+	Inline       bool   // Inline indicates whether the file is shown inside the body rather than attached.
+}
+
+// Message represents the parsed payload of the Gmail email.
+type Message struct {
+	Subject     string        // Subject is the Subject header of the email.
+	Sender      string        // Sender is the From header of the email.
+	Recipient   string        // Recipient is the To header of the email.
+	HTML        string        // HTML is the HTML body of the email.
+	Plain       string        // Plain is the plain text body of the email.
+	Attachments []*Attachment // Attachments are the files of the email.
+}
+
+// Parse walks the whole payload tree of the email: the text bodies are decoded
+// and every other part is collected as an attachment.
+func Parse(payload *gmail.MessagePart) (*Message, error) {
+	message := &Message{Attachments: []*Attachment{}}
+	if payload == nil {
+		return message, nil
+	}
+
+	for _, header := range payload.Headers {
+		switch strings.ToLower(header.Name) {
+		case "to":
+			message.Recipient = header.Value
+		case "from":
+			message.Sender = header.Value
+		case "subject":
+			message.Subject = header.Value
+		}
+	}
+
+	if err := walk(payload, message); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// Body returns the body to show: the HTML one when the email has it, the plain text one otherwise.
+func (m *Message) Body() string {
+	if m.HTML != "" {
+		return m.HTML
+	}
+	return m.Plain
+}
+
+// ReplaceContentIDs points the "cid:" references of the HTML body to the links returned by url,
+// so the inline images can be shown by the browser.
+func (m *Message) ReplaceContentIDs(url func(a *Attachment) string) string {
+	html := m.HTML
+	for _, a := range m.Attachments {
+		if a.ContentID == "" {
+			continue
+		}
+		html = strings.ReplaceAll(html, "cid:"+a.ContentID, url(a))
+	}
+	return html
+}
+
+// FindPart returns the part of the payload with the identifier, nil if there is no such part.
+func FindPart(part *gmail.MessagePart, partID string) *gmail.MessagePart {
+	if part == nil {
+		return nil
+	}
+	if part.PartId == partID {
+		return part
+	}
+	for _, p := range part.Parts {
+		if found := FindPart(p, partID); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// DecodeData decodes the body data returned by the Gmail API, which is base64url with or without padding.
+func DecodeData(data string) ([]byte, error) {
+	decoded, err := base64.URLEncoding.DecodeString(data)
+	if err == nil {
+		return decoded, nil
+	}
+	decoded, err = base64.RawURLEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding body data")
+	}
+	return decoded, nil
+}
+
+// walk adds the bodies and the attachments of the part and of its nested parts to the message.
+func walk(part *gmail.MessagePart, message *Message) error {
+	if attachment := attachmentOf(part); attachment != nil {
+		message.Attachments = append(message.Attachments, attachment)
+		return nil
+	}
+
+	if part.Body != nil && part.Body.Data != "" {
+		switch part.MimeType {
+		case "text/html":
+			if message.HTML == "" {
+				data, err := DecodeData(part.Body.Data)
+				if err != nil {
+					return err
+				}
+				message.HTML = string(data)
+			}
+		case "text/plain":
+			if message.Plain == "" {
+				data, err := DecodeData(part.Body.Data)
+				if err != nil {
+					return err
+				}
+				message.Plain = string(data)
+			}
+		}
+	}
+
+	for _, p := range part.Parts {
+		if err := walk(p, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attachmentOf returns the attachment the part holds, nil if the part is a body or a multipart.
+func attachmentOf(part *gmail.MessagePart) *Attachment {
+	if strings.HasPrefix(part.MimeType, "multipart/") {
+		return nil
+	}
+
+	var disposition, contentID string
+	for _, header := range part.Headers {
+		switch strings.ToLower(header.Name) {
+		case "content-disposition":
+			disposition, _, _ = mime.ParseMediaType(header.Value)
+		case "content-id":
+			contentID = strings.Trim(strings.TrimSpace(header.Value), "<>")
+		}
+	}
+
+	hasAttachmentID := part.Body != nil && part.Body.AttachmentId != ""
+	isText := part.MimeType == "text/plain" || part.MimeType == "text/html"
+	if part.Filename == "" && disposition != "attachment" && contentID == "" && (!hasAttachmentID || isText) {
+		return nil
+	}
+
+	attachment := &Attachment{
+		PartID:    part.PartId,
+		FileName:  part.Filename,
+		MimeType:  part.MimeType,
+		ContentID: contentID,
+		Inline:    disposition == "inline" || (disposition == "" && contentID != ""),
+	}
+	if part.Body != nil {
+		attachment.AttachmentID = part.Body.AttachmentId
+		attachment.Size = part.Body.Size
+	}
+	if attachment.FileName == "" {
+		attachment.FileName = "attachment-" + part.PartId
+	}
+
+	return attachment
+}
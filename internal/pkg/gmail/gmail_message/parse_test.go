@@ -0,0 +1,83 @@
+package gmail_message
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/gmail/v1"
+)
+
+func encode(s string) string {
+	return base64.URLEncoding.EncodeToString([]byte(s))
+}
+
+func TestParse(t *testing.T) {
+	payload := &gmail.MessagePart{
+		PartId:   "",
+		MimeType: "multipart/mixed",
+		Headers: []*gmail.MessagePartHeader{
+			{Name: "From", Value: "sender@gmail.com"},
+			{Name: "To", Value: "recipient@gmail.com"},
+			{Name: "Subject", Value: "Report"},
+		},
+		Parts: []*gmail.MessagePart{
+			{
+				PartId:   "0",
+				MimeType: "multipart/related",
+				Parts: []*gmail.MessagePart{
+					{
+						PartId:   "0.0",
+						MimeType: "multipart/alternative",
+						Parts: []*gmail.MessagePart{
+							{PartId: "0.0.0", MimeType: "text/plain", Body: &gmail.MessagePartBody{Data: encode("Hello")}},
+							{PartId: "0.0.1", MimeType: "text/html", Body: &gmail.MessagePartBody{Data: encode(`<p>Hello</p><img src="cid:logo@mail">`)}},
+						},
+					},
+					{
+						PartId:   "0.1",
+						MimeType: "image/png",
+						Filename: "logo.png",
+						Headers:  []*gmail.MessagePartHeader{{Name: "Content-ID", Value: "<logo@mail>"}},
+						Body:     &gmail.MessagePartBody{AttachmentId: "att-logo", Size: 10},
+					},
+				},
+			},
+			{
+				PartId:   "1",
+				MimeType: "application/pdf",
+				Filename: "report.pdf",
+				Headers:  []*gmail.MessagePartHeader{{Name: "Content-Disposition", Value: `attachment; filename="report.pdf"`}},
+				Body:     &gmail.MessagePartBody{AttachmentId: "att-report", Size: 2048},
+			},
+		},
+	}
+
+	message, err := Parse(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "sender@gmail.com", message.Sender)
+	assert.Equal(t, "recipient@gmail.com", message.Recipient)
+	assert.Equal(t, "Report", message.Subject)
+	assert.Equal(t, "Hello", message.Plain)
+	assert.Equal(t, `<p>Hello</p><img src="cid:logo@mail">`, message.Body())
+
+	assert.Len(t, message.Attachments, 2)
+	assert.Equal(t, &Attachment{PartID: "0.1", AttachmentID: "att-logo", FileName: "logo.png", MimeType: "image/png", Size: 10, ContentID: "logo@mail", Inline: true}, message.Attachments[0])
+	assert.Equal(t, &Attachment{PartID: "1", AttachmentID: "att-report", FileName: "report.pdf", MimeType: "application/pdf", Size: 2048}, message.Attachments[1])
+
+	html := message.ReplaceContentIDs(func(a *Attachment) string { return "/attachment/" + a.PartID })
+	assert.Equal(t, `<p>Hello</p><img src="/attachment/0.1">`, html)
+
+	assert.Equal(t, "1", FindPart(payload, "1").PartId)
+	assert.Nil(t, FindPart(payload, "7"))
+}
+
+func TestParse_PlainOnly(t *testing.T) {
+	message, err := Parse(&gmail.MessagePart{MimeType: "text/plain", Body: &gmail.MessagePartBody{Data: base64.RawURLEncoding.EncodeToString([]byte("Hi!"))}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi!", message.Body())
+	assert.Empty(t, message.Attachments)
+
+	_, err = Parse(&gmail.MessagePart{MimeType: "text/plain", Body: &gmail.MessagePartBody{Data: "%%%"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,239 @@
+package gmail_sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/denisbrodbeck/striphtmltags"
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/googleapi"
+
+	domain "mail/internal/microservice/models/domain_models"
+	gmailMessage "mail/internal/pkg/gmail/gmail_message"
+	gmailToken "mail/internal/pkg/gmail/gmail_token"
+	gmailInterface "mail/internal/pkg/gmail/interface"
+)
+
+var (
+	// ErrHistoryExpired is returned when Gmail no longer keeps the changes since the requested one,
+	// the mailbox has to be copied from scratch.
+	ErrHistoryExpired = errors.New("gmail history expired")
+
+	// ErrMessageNotFound is returned when the email has been deleted from the mailbox.
+	ErrMessageNotFound = errors.New("gmail message not found")
+)
+
+// ClientFactory connects to the Gmail mailboxes with the tokens of the store.
+type ClientFactory struct {
+	Tokens *gmailToken.Store
+}
+
+// NewClientFactory creates a new instance of ClientFactory.
+func NewClientFactory(tokens *gmailToken.Store) *ClientFactory {
+	return &ClientFactory{Tokens: tokens}
+}
+
+// Client returns the Gmail API client authorized to act on behalf of the Gmail address.
+func (f *ClientFactory) Client(login string, ctx context.Context) (gmailInterface.GMailClient, error) {
+	srv, err := f.Tokens.Service(login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &APIClient{Service: srv}, nil
+}
+
+// APIClient is the GMailClient on top of the Gmail API.
+type APIClient struct {
+	Service *gmail.Service
+}
+
+// HistoryID returns the identifier of the latest change of the mailbox.
+func (c *APIClient) HistoryID() (uint64, error) {
+	profile, err := c.Service.Users.GetProfile("me").Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get gmail profile: %v", err)
+	}
+
+	return profile.HistoryId, nil
+}
+
+// Labels returns the labels of the mailbox.
+func (c *APIClient) Labels() ([]*domain.GMailLabel, error) {
+	reply, err := c.Service.Users.Labels.List("me").Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gmail labels: %v", err)
+	}
+
+	labels := make([]*domain.GMailLabel, len(reply.Labels))
+	for i, l := range reply.Labels {
+		labels[i] = &domain.GMailLabel{ID: l.Id, Name: l.Name, Type: l.Type}
+	}
+
+	return labels, nil
+}
+
+// MessageIDs returns the identifiers of up to limit of the newest emails, drafts excluded.
+// Spam is included, as the spam folder is served from the local copy too.
+func (c *APIClient) MessageIDs(limit int) ([]string, error) {
+	var ids []string
+	pageToken := ""
+	for len(ids) < limit {
+		call := c.Service.Users.Messages.List("me").Q("-in:drafts -in:trash").IncludeSpamTrash(true).MaxResults(int64(limit - len(ids)))
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		reply, err := call.Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list gmail messages: %v", err)
+		}
+
+		for _, m := range reply.Messages {
+			ids = append(ids, m.Id)
+		}
+
+		if reply.NextPageToken == "" {
+			break
+		}
+		pageToken = reply.NextPageToken
+	}
+
+	return ids, nil
+}
+
+// Message returns the email by its identifier.
+func (c *APIClient) Message(id string) (*domain.GMailMessage, error) {
+	msg, err := c.Service.Users.Messages.Get("me", id).Format("full").Do()
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrMessageNotFound
+		}
+		return nil, fmt.Errorf("failed to get gmail message %s: %v", id, err)
+	}
+
+	return parseMessage(msg)
+}
+
+// History returns the emails changed and deleted since the change with startHistoryID.
+func (c *APIClient) History(startHistoryID uint64) (*domain.GMailHistory, error) {
+	history := &domain.GMailHistory{HistoryID: startHistoryID}
+	changed := make(map[string]bool)
+	deleted := make(map[string]bool)
+
+	pageToken := ""
+	for {
+		call := c.Service.Users.History.List("me").StartHistoryId(startHistoryID)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		reply, err := call.Do()
+		if err != nil {
+			if isNotFound(err) {
+				return nil, ErrHistoryExpired
+			}
+			return nil, fmt.Errorf("failed to list gmail history: %v", err)
+		}
+
+		for _, h := range reply.History {
+			for _, m := range h.MessagesAdded {
+				changed[m.Message.Id] = true
+			}
+			for _, m := range h.LabelsAdded {
+				changed[m.Message.Id] = true
+			}
+			for _, m := range h.LabelsRemoved {
+				changed[m.Message.Id] = true
+			}
+			for _, m := range h.MessagesDeleted {
+				deleted[m.Message.Id] = true
+			}
+		}
+
+		if reply.HistoryId > history.HistoryID {
+			history.HistoryID = reply.HistoryId
+		}
+
+		if reply.NextPageToken == "" {
+			break
+		}
+		pageToken = reply.NextPageToken
+	}
+
+	for id := range changed {
+		if !deleted[id] {
+			history.ChangedIDs = append(history.ChangedIDs, id)
+		}
+	}
+	for id := range deleted {
+		history.DeletedIDs = append(history.DeletedIDs, id)
+	}
+
+	return history, nil
+}
+
+// ModifyLabels adds and removes the labels of the email.
+func (c *APIClient) ModifyLabels(id string, addLabelIDs, removeLabelIDs []string) error {
+	modifyRequest := &gmail.ModifyMessageRequest{
+		AddLabelIds:    addLabelIDs,
+		RemoveLabelIds: removeLabelIDs,
+	}
+
+	_, err := c.Service.Users.Messages.Modify("me", id, modifyRequest).Do()
+	if err != nil {
+		return fmt.Errorf("failed to modify gmail message %s: %v", id, err)
+	}
+
+	return nil
+}
+
+// Delete removes the email.
+func (c *APIClient) Delete(id string) error {
+	if err := c.Service.Users.Messages.Delete("me", id).Do(); err != nil {
+		return fmt.Errorf("failed to delete gmail message %s: %v", id, err)
+	}
+
+	return nil
+}
+
+// isNotFound reports whether the Gmail API has answered with 404.
+func isNotFound(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// parseMessage takes the headers and the body of the email, the HTML body is preferred to the plain text one.
+func parseMessage(msg *gmail.Message) (*domain.GMailMessage, error) {
+	message := &domain.GMailMessage{
+		ID:       msg.Id,
+		ThreadID: msg.ThreadId,
+		LabelIDs: msg.LabelIds,
+		Date:     time.Unix(msg.InternalDate/1000, 0),
+	}
+	if message.LabelIDs == nil {
+		message.LabelIDs = []string{}
+	}
+	if msg.Payload == nil {
+		return message, nil
+	}
+
+	parsed, err := gmailMessage.Parse(msg.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	message.Recipient = parsed.Recipient
+	message.Sender = parsed.Sender
+	message.Subject = striphtmltags.StripTags(parsed.Subject)
+	if parsed.HTML != "" {
+		message.Text = parsed.HTML
+	} else {
+		message.Text = striphtmltags.StripTags(parsed.Plain)
+	}
+
+	return message, nil
+}
@@ -0,0 +1,300 @@
+package gmail_sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/pkg/logger"
+
+	email_proto "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/microservice/models/proto_converters"
+	gmailInterface "mail/internal/pkg/gmail/interface"
+)
+
+// Worker keeps the local copies of the Gmail mailboxes in the email service.
+// The first synchronization copies the newest emails, the next ones apply only the changes
+// made in Gmail since the previous one, identified by the history ID of the mailbox.
+type Worker struct {
+	Clients gmailInterface.GMailClientFactory
+	Mirror  email_proto.EmailServiceClient
+
+	mu      sync.Mutex
+	locks   map[string]*loginLock
+	pending map[string]bool
+	queue   chan string
+}
+
+// loginLock is the mutex of a mailbox, it is removed from the worker once nobody holds or waits for it.
+type loginLock struct {
+	sync.Mutex
+	refs int
+}
+
+// queueSize is the number of the mailboxes waiting for their first copy,
+// a mailbox which does not fit is queued again by the next request.
+const queueSize = 64
+
+// NewWorker creates a new instance of Worker.
+func NewWorker(clients gmailInterface.GMailClientFactory, mirror email_proto.EmailServiceClient) *Worker {
+	return &Worker{
+		Clients: clients,
+		Mirror:  mirror,
+		locks:   make(map[string]*loginLock),
+		pending: make(map[string]bool),
+		queue:   make(chan string, queueSize),
+	}
+}
+
+// Start synchronizes all the copied mailboxes every interval and copies the queued mailboxes in the background.
+func (w *Worker) Start(interval time.Duration) {
+	ctx := context.WithValue(context.Background(), "requestID", "GMailSync")
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			w.SyncAll(ctx)
+		}
+	}()
+
+	go func() {
+		for login := range w.queue {
+			if err := w.Sync(login, ctx); err != nil {
+				log.Printf("Error copying gmail mailbox %s: %v\n", login, err)
+			}
+			w.done(login)
+		}
+	}()
+}
+
+// SyncAll synchronizes all the copied mailboxes once, the failure of one mailbox does not stop the others.
+func (w *Worker) SyncAll(ctx context.Context) {
+	reply, err := w.Mirror.GetGMailLogins(outgoingContext(ctx), &email_proto.EmptyEmail{})
+	if err != nil {
+		log.Printf("Error getting gmail logins: %v\n", err)
+		return
+	}
+
+	for _, login := range reply.Logins {
+		if err := w.Sync(login, ctx); err != nil {
+			log.Printf("Error syncing gmail mailbox %s: %v\n", login, err)
+		}
+	}
+}
+
+// Ensure makes sure the mailbox has a local copy. A mailbox without one is queued for the worker
+// instead of being copied in the request, its emails appear in the local copy once the worker is done.
+func (w *Worker) Ensure(login string, ctx context.Context) error {
+	state, err := w.Mirror.GetGMailSyncState(outgoingContext(ctx), &email_proto.GMailLogin{Login: login})
+	if err != nil {
+		return fmt.Errorf("failed to get gmail sync state: %v", err)
+	}
+	if state.Found {
+		return nil
+	}
+
+	w.enqueue(login)
+	return nil
+}
+
+// Sync copies the changes of the mailbox made in Gmail since the previous synchronization.
+// The mailbox is copied from scratch if it has never been synchronized or Gmail no longer keeps the changes.
+func (w *Worker) Sync(login string, ctx context.Context) error {
+	unlock := w.lock(login)
+	defer unlock()
+
+	client, err := w.Clients.Client(login, ctx)
+	if err != nil {
+		return err
+	}
+
+	state, err := w.Mirror.GetGMailSyncState(outgoingContext(ctx), &email_proto.GMailLogin{Login: login})
+	if err != nil {
+		return fmt.Errorf("failed to get gmail sync state: %v", err)
+	}
+
+	var changes *domain.GMailSync
+	if state.Found {
+		changes, err = incrementalSync(client, state.HistoryId)
+		if errors.Is(err, ErrHistoryExpired) {
+			changes, err = fullSync(client)
+		}
+	} else {
+		changes, err = fullSync(client)
+	}
+	if err != nil {
+		return err
+	}
+
+	changes.Login = login
+	changes.Labels, err = client.Labels()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Mirror.ApplyGMailSync(outgoingContext(ctx), converters.GMailSyncConvertCoreInProto(changes))
+	if err != nil {
+		return fmt.Errorf("failed to apply gmail sync: %v", err)
+	}
+
+	return nil
+}
+
+// ModifyLabels adds and removes the labels of the email in Gmail and then in the local copy.
+// The local copy of an email it does not have yet is left to the next synchronization.
+func (w *Worker) ModifyLabels(login, id string, addLabelIDs, removeLabelIDs []string, ctx context.Context) error {
+	client, err := w.Clients.Client(login, ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := client.ModifyLabels(id, addLabelIDs, removeLabelIDs); err != nil {
+		return err
+	}
+
+	_, err = w.Mirror.UpdateGMailMessageLabels(outgoingContext(ctx), &email_proto.GMailMessageLabels{
+		Login:          login,
+		Id:             id,
+		AddLabelIds:    addLabelIDs,
+		RemoveLabelIds: removeLabelIDs,
+	})
+	if err != nil {
+		log.Printf("Error updating labels of the gmail message copy %s: %v\n", id, err)
+	}
+
+	return nil
+}
+
+// Delete removes the email from Gmail and then from the local copy.
+func (w *Worker) Delete(login, id string, ctx context.Context) error {
+	client, err := w.Clients.Client(login, ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Delete(id); err != nil {
+		return err
+	}
+
+	_, err = w.Mirror.DeleteGMailMessage(outgoingContext(ctx), &email_proto.GMailMessageIdAndLogin{Login: login, Id: id})
+	if err != nil {
+		log.Printf("Error deleting the gmail message copy %s: %v\n", id, err)
+	}
+
+	return nil
+}
+
+// enqueue queues the mailbox for its first copy unless it is queued already.
+func (w *Worker) enqueue(login string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.pending[login] {
+		return
+	}
+
+	select {
+	case w.queue <- login:
+		w.pending[login] = true
+	default:
+	}
+}
+
+// done removes the copied mailbox from the queued ones.
+func (w *Worker) done(login string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.pending, login)
+}
+
+// lock locks the mutex of the mailbox, so the same mailbox is not synchronized twice at a time,
+// and returns the function unlocking it. The mutex is removed by the last unlock.
+func (w *Worker) lock(login string) func() {
+	w.mu.Lock()
+	lock, ok := w.locks[login]
+	if !ok {
+		lock = new(loginLock)
+		w.locks[login] = lock
+	}
+	lock.refs++
+	w.mu.Unlock()
+
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		lock.refs--
+		if lock.refs == 0 {
+			delete(w.locks, login)
+		}
+	}
+}
+
+// fullSync copies the newest emails of the mailbox.
+// The history ID is taken before the emails, so the changes made meanwhile are copied by the next synchronization.
+func fullSync(client gmailInterface.GMailClient) (*domain.GMailSync, error) {
+	historyID, err := client.HistoryID()
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := client.MessageIDs(domain.GMailFullSyncMaxMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &domain.GMailSync{HistoryID: historyID, Full: true}
+	for _, id := range ids {
+		message, err := client.Message(id)
+		if errors.Is(err, ErrMessageNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		changes.Messages = append(changes.Messages, message)
+	}
+
+	return changes, nil
+}
+
+// incrementalSync copies the emails changed since the change with historyID.
+// The changed emails are fetched again rather than patched, so the copy gets their current labels.
+func incrementalSync(client gmailInterface.GMailClient, historyID uint64) (*domain.GMailSync, error) {
+	history, err := client.History(historyID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &domain.GMailSync{HistoryID: history.HistoryID, DeletedIDs: history.DeletedIDs}
+	for _, id := range history.ChangedIDs {
+		message, err := client.Message(id)
+		if errors.Is(err, ErrMessageNotFound) {
+			changes.DeletedIDs = append(changes.DeletedIDs, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		changes.Messages = append(changes.Messages, message)
+	}
+
+	return changes, nil
+}
+
+// outgoingContext passes the request ID to the email service.
+func outgoingContext(ctx context.Context) context.Context {
+	return metadata.NewOutgoingContext(ctx,
+		metadata.New(map[string]string{"requestID": logger.GetRequestIDString(ctx.Value("requestID"))}))
+}
@@ -0,0 +1,206 @@
+package gmail_sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	emailMock "mail/internal/microservice/email/mock"
+	email_proto "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	gmailInterface "mail/internal/pkg/gmail/interface"
+)
+
+// fakeClient is a Gmail mailbox kept in memory.
+type fakeClient struct {
+	historyID uint64
+	history   *domain.GMailHistory
+	messages  map[string]*domain.GMailMessage
+	labels    []*domain.GMailLabel
+	modified  map[string][]string
+}
+
+func (c *fakeClient) HistoryID() (uint64, error) { return c.historyID, nil }
+
+func (c *fakeClient) Labels() ([]*domain.GMailLabel, error) { return c.labels, nil }
+
+func (c *fakeClient) MessageIDs(limit int) ([]string, error) {
+	var ids []string
+	for id := range c.messages {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (c *fakeClient) Message(id string) (*domain.GMailMessage, error) {
+	message, ok := c.messages[id]
+	if !ok {
+		return nil, ErrMessageNotFound
+	}
+	return message, nil
+}
+
+func (c *fakeClient) History(startHistoryID uint64) (*domain.GMailHistory, error) {
+	if c.history == nil {
+		return nil, ErrHistoryExpired
+	}
+	return c.history, nil
+}
+
+func (c *fakeClient) ModifyLabels(id string, addLabelIDs, removeLabelIDs []string) error {
+	c.modified[id] = append(addLabelIDs, removeLabelIDs...)
+	return nil
+}
+
+func (c *fakeClient) Delete(id string) error {
+	delete(c.messages, id)
+	return nil
+}
+
+type fakeFactory struct {
+	client *fakeClient
+}
+
+func (f *fakeFactory) Client(login string, ctx context.Context) (gmailInterface.GMailClient, error) {
+	return f.client, nil
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		historyID: 2048,
+		messages: map[string]*domain.GMailMessage{
+			"18c1": {ID: "18c1", LabelIDs: []string{"INBOX", "UNREAD"}, Subject: "Hello"},
+		},
+		labels:   []*domain.GMailLabel{{ID: "INBOX", Name: "INBOX", Type: "system"}},
+		modified: make(map[string][]string),
+	}
+}
+
+func TestSyncFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mirror := emailMock.NewMockEmailServiceClient(ctrl)
+	worker := NewWorker(&fakeFactory{client: newFakeClient()}, mirror)
+
+	mirror.EXPECT().GetGMailSyncState(gomock.Any(), &email_proto.GMailLogin{Login: "user@gmail.com"}).
+		Return(&email_proto.GMailSyncState{Found: false}, nil)
+	mirror.EXPECT().ApplyGMailSync(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, sync *email_proto.GMailSync, _ ...interface{}) (*email_proto.StatusEmail, error) {
+			assert.Equal(t, "user@gmail.com", sync.Login)
+			assert.Equal(t, uint64(2048), sync.HistoryId)
+			assert.True(t, sync.Full)
+			assert.Len(t, sync.Messages, 1)
+			assert.Equal(t, "18c1", sync.Messages[0].Id)
+			assert.Len(t, sync.Labels, 1)
+			return &email_proto.StatusEmail{Status: true}, nil
+		})
+
+	assert.NoError(t, worker.Sync("user@gmail.com", context.Background()))
+	assert.Empty(t, worker.locks)
+}
+
+func TestSyncIncremental(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := newFakeClient()
+	client.history = &domain.GMailHistory{HistoryID: 2050, ChangedIDs: []string{"18c1", "18c2"}, DeletedIDs: []string{"18b9"}}
+
+	mirror := emailMock.NewMockEmailServiceClient(ctrl)
+	worker := NewWorker(&fakeFactory{client: client}, mirror)
+
+	mirror.EXPECT().GetGMailSyncState(gomock.Any(), gomock.Any()).
+		Return(&email_proto.GMailSyncState{HistoryId: 2048, Found: true}, nil)
+	mirror.EXPECT().ApplyGMailSync(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, sync *email_proto.GMailSync, _ ...interface{}) (*email_proto.StatusEmail, error) {
+			assert.Equal(t, uint64(2050), sync.HistoryId)
+			assert.False(t, sync.Full)
+			assert.Len(t, sync.Messages, 1)
+			assert.Equal(t, []string{"18b9", "18c2"}, sync.DeletedIds)
+			return &email_proto.StatusEmail{Status: true}, nil
+		})
+
+	assert.NoError(t, worker.Sync("user@gmail.com", context.Background()))
+}
+
+func TestSyncHistoryExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mirror := emailMock.NewMockEmailServiceClient(ctrl)
+	worker := NewWorker(&fakeFactory{client: newFakeClient()}, mirror)
+
+	mirror.EXPECT().GetGMailSyncState(gomock.Any(), gomock.Any()).
+		Return(&email_proto.GMailSyncState{HistoryId: 1, Found: true}, nil)
+	mirror.EXPECT().ApplyGMailSync(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, sync *email_proto.GMailSync, _ ...interface{}) (*email_proto.StatusEmail, error) {
+			assert.True(t, sync.Full)
+			assert.Equal(t, uint64(2048), sync.HistoryId)
+			return &email_proto.StatusEmail{Status: true}, nil
+		})
+
+	assert.NoError(t, worker.Sync("user@gmail.com", context.Background()))
+}
+
+func TestEnsure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mirror := emailMock.NewMockEmailServiceClient(ctrl)
+	worker := NewWorker(&fakeFactory{client: newFakeClient()}, mirror)
+
+	mirror.EXPECT().GetGMailSyncState(gomock.Any(), gomock.Any()).
+		Return(&email_proto.GMailSyncState{HistoryId: 2048, Found: true}, nil)
+
+	assert.NoError(t, worker.Ensure("user@gmail.com", context.Background()))
+}
+
+func TestEnsure_NotCopied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mirror := emailMock.NewMockEmailServiceClient(ctrl)
+	worker := NewWorker(&fakeFactory{client: newFakeClient()}, mirror)
+
+	mirror.EXPECT().GetGMailSyncState(gomock.Any(), gomock.Any()).
+		Return(&email_proto.GMailSyncState{Found: false}, nil).Times(2)
+
+	assert.NoError(t, worker.Ensure("user@gmail.com", context.Background()))
+	assert.NoError(t, worker.Ensure("user@gmail.com", context.Background()))
+	assert.Len(t, worker.queue, 1)
+	assert.Equal(t, "user@gmail.com", <-worker.queue)
+}
+
+func TestModifyLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := newFakeClient()
+	mirror := emailMock.NewMockEmailServiceClient(ctrl)
+	worker := NewWorker(&fakeFactory{client: client}, mirror)
+
+	mirror.EXPECT().UpdateGMailMessageLabels(gomock.Any(), &email_proto.GMailMessageLabels{
+		Login: "user@gmail.com", Id: "18c1", AddLabelIds: []string{"Label_1"}, RemoveLabelIds: []string{"UNREAD"},
+	}).Return(&email_proto.StatusEmail{Status: true}, nil)
+
+	assert.NoError(t, worker.ModifyLabels("user@gmail.com", "18c1", []string{"Label_1"}, []string{"UNREAD"}, context.Background()))
+	assert.Equal(t, []string{"Label_1", "UNREAD"}, client.modified["18c1"])
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := newFakeClient()
+	mirror := emailMock.NewMockEmailServiceClient(ctrl)
+	worker := NewWorker(&fakeFactory{client: client}, mirror)
+
+	mirror.EXPECT().DeleteGMailMessage(gomock.Any(), &email_proto.GMailMessageIdAndLogin{Login: "user@gmail.com", Id: "18c1"}).
+		Return(&email_proto.StatusEmail{Status: true}, nil)
+
+	assert.NoError(t, worker.Delete("user@gmail.com", "18c1", context.Background()))
+	assert.Empty(t, client.messages)
+}
@@ -0,0 +1,182 @@
+package gmail_token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/pkg/logger"
+
+	auth_proto "mail/internal/microservice/auth/proto"
+)
+
+// refreshTimeout is the time allowed to refresh a token and save the new one.
+const refreshTimeout = 30 * time.Second
+
+// Store keeps the OAuth tokens of the Gmail users in the auth service, encrypted,
+// so the Gmail connections survive restarts and are shared by all the gateway replicas.
+type Store struct {
+	TokenServiceClient auth_proto.GMailTokenServiceClient
+	CredentialsFile    string
+}
+
+// NewStore creates a new instance of Store, the tokens are issued and refreshed for the OAuth client from credentialsFile.
+func NewStore(tokenServiceClient auth_proto.GMailTokenServiceClient, credentialsFile string) *Store {
+	return &Store{
+		TokenServiceClient: tokenServiceClient,
+		CredentialsFile:    credentialsFile,
+	}
+}
+
+// Config returns the configuration of the OAuth client the tokens are issued for.
+func (s *Store) Config() (*oauth2.Config, error) {
+	b, err := os.ReadFile(s.CredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client secret file: %v", err)
+	}
+
+	config, err := google.ConfigFromJSON(b, gmail.MailGoogleComScope)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse client secret file to config: %v", err)
+	}
+
+	return config, nil
+}
+
+// Save stores the token of the Gmail address, replacing the previous one.
+func (s *Store) Save(login string, token *oauth2.Token, ctx context.Context) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %v", err)
+	}
+
+	_, err = s.TokenServiceClient.SaveGMailToken(outgoingContext(ctx), &auth_proto.SaveGMailTokenRequest{Login: login, Token: b})
+	if err != nil {
+		return fmt.Errorf("failed to save token: %v", err)
+	}
+
+	return nil
+}
+
+// Get returns the stored token of the Gmail address, or nil if the user has not signed in with Gmail.
+func (s *Store) Get(login string, ctx context.Context) (*oauth2.Token, error) {
+	reply, err := s.TokenServiceClient.GetGMailToken(outgoingContext(ctx), &auth_proto.GetGMailTokenRequest{Login: login})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %v", err)
+	}
+	if !reply.Found {
+		return nil, nil
+	}
+
+	var token oauth2.Token
+	if err := json.Unmarshal(reply.Token, &token); err != nil {
+		return nil, fmt.Errorf("failed to decode token: %v", err)
+	}
+
+	return &token, nil
+}
+
+// TokenSource returns the source of valid tokens of the Gmail address.
+// The expired token is refreshed with the refresh token, and the new one is saved for the next requests and replicas.
+func (s *Store) TokenSource(login string, ctx context.Context) (oauth2.TokenSource, error) {
+	token, err := s.Get(login, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, fmt.Errorf("no gmail token for %s", login)
+	}
+
+	config, err := s.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	return &savingTokenSource{
+		config: config,
+		store:  s,
+		login:  login,
+		ctx:    context.WithoutCancel(ctx),
+		token:  token,
+		saved:  token,
+	}, nil
+}
+
+// Client returns the HTTP client authorized to act on behalf of the Gmail address.
+func (s *Store) Client(login string, ctx context.Context) (*http.Client, error) {
+	tokenSource, err := s.TokenSource(login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauth2.NewClient(ctx, tokenSource), nil
+}
+
+// Service returns the Gmail API client of the Gmail address.
+func (s *Store) Service(login string, ctx context.Context) (*gmail.Service, error) {
+	client, err := s.Client(login, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	srv, err := gmail.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve Gmail client: %v", err)
+	}
+
+	return srv, nil
+}
+
+// savingTokenSource refreshes the expired token and saves the tokens which differ from the stored one.
+// A token which could not be saved is still returned, the save is retried with the next token request,
+// at worst the token is refreshed once more by the next request.
+// The source outlives the request it is created for, so ctx only carries the values of the request,
+// the refresh and the save get their own deadline.
+type savingTokenSource struct {
+	mu     sync.Mutex
+	config *oauth2.Config
+	store  *Store
+	login  string
+	ctx    context.Context
+	token  *oauth2.Token
+	saved  *oauth2.Token
+}
+
+// Token returns a valid token, refreshing and saving it if the previous one has expired.
+func (ts *savingTokenSource) Token() (*oauth2.Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ts.ctx, refreshTimeout)
+	defer cancel()
+
+	token, err := ts.config.TokenSource(ctx, ts.token).Token()
+	if err != nil {
+		return nil, err
+	}
+	ts.token = token
+
+	if token.AccessToken != ts.saved.AccessToken || token.RefreshToken != ts.saved.RefreshToken {
+		if err := ts.store.Save(ts.login, token, ctx); err == nil {
+			ts.saved = token
+		}
+	}
+
+	return token, nil
+}
+
+// outgoingContext passes the request ID of the gateway request to the auth service.
+func outgoingContext(ctx context.Context) context.Context {
+	return metadata.NewOutgoingContext(ctx,
+		metadata.New(map[string]string{"requestID": logger.GetRequestIDString(ctx.Value("requestID"))}))
+}
@@ -0,0 +1,225 @@
+package gmail_token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+
+	"mail/internal/microservice/auth/mock"
+
+	auth_proto "mail/internal/microservice/auth/proto"
+)
+
+// newTestStore returns a store whose OAuth client refreshes the tokens at the test server.
+func newTestStore(t *testing.T, client auth_proto.GMailTokenServiceClient, tokenURL string) *Store {
+	credentials := fmt.Sprintf(`{"web":{"client_id":"id","client_secret":"secret","auth_uri":"https://accounts.google.com/o/oauth2/auth","token_uri":%q,"redirect_uris":["http://localhost"]}}`, tokenURL)
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, []byte(credentials), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return NewStore(client, path)
+}
+
+func encodeToken(t *testing.T, token *oauth2.Token) []byte {
+	b, err := json.Marshal(token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return b
+}
+
+func TestStore_SaveGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockGMailTokenServiceClient(ctrl)
+	store := newTestStore(t, mockClient, "http://localhost/token")
+	ctx := context.WithValue(context.Background(), "requestID", "testID")
+	token := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer"}
+
+	var saved []byte
+	mockClient.EXPECT().SaveGMailToken(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *auth_proto.SaveGMailTokenRequest, opts ...grpc.CallOption) (*auth_proto.SaveGMailTokenReply, error) {
+			assert.Equal(t, "user@gmail.com", input.Login)
+			saved = input.Token
+			return &auth_proto.SaveGMailTokenReply{Status: true}, nil
+		})
+	assert.NoError(t, store.Save("user@gmail.com", token, ctx))
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), &auth_proto.GetGMailTokenRequest{Login: "user@gmail.com"}).
+		Return(&auth_proto.GetGMailTokenReply{Token: saved, Found: true}, nil)
+	got, err := store.Get("user@gmail.com", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "access", got.AccessToken)
+	assert.Equal(t, "refresh", got.RefreshToken)
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), &auth_proto.GetGMailTokenRequest{Login: "unknown@gmail.com"}).
+		Return(&auth_proto.GetGMailTokenReply{Found: false}, nil)
+	got, err = store.Get("unknown@gmail.com", ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("service error"))
+	_, err = store.Get("user@gmail.com", ctx)
+	assert.Error(t, err)
+}
+
+func TestStore_TokenSource_Valid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockGMailTokenServiceClient(ctrl)
+	store := newTestStore(t, mockClient, "http://localhost/token")
+	ctx := context.Background()
+	token := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)}
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), gomock.Any()).
+		Return(&auth_proto.GetGMailTokenReply{Token: encodeToken(t, token), Found: true}, nil)
+
+	tokenSource, err := store.TokenSource("user@gmail.com", ctx)
+	assert.NoError(t, err)
+
+	got, err := tokenSource.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "access", got.AccessToken)
+}
+
+func TestStore_TokenSource_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refreshes++
+		assert.Equal(t, "refresh", r.FormValue("refresh_token"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"new access","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	mockClient := mock.NewMockGMailTokenServiceClient(ctrl)
+	store := newTestStore(t, mockClient, server.URL)
+	ctx := context.Background()
+	expired := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", Expiry: time.Now().Add(-time.Hour)}
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), gomock.Any()).
+		Return(&auth_proto.GetGMailTokenReply{Token: encodeToken(t, expired), Found: true}, nil)
+	mockClient.EXPECT().SaveGMailToken(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *auth_proto.SaveGMailTokenRequest, opts ...grpc.CallOption) (*auth_proto.SaveGMailTokenReply, error) {
+			var token oauth2.Token
+			assert.NoError(t, json.Unmarshal(input.Token, &token))
+			assert.Equal(t, "new access", token.AccessToken)
+			assert.Equal(t, "refresh", token.RefreshToken, "the refresh token must be kept when the response has none")
+			return &auth_proto.SaveGMailTokenReply{Status: true}, nil
+		}).Times(1)
+
+	tokenSource, err := store.TokenSource("user@gmail.com", ctx)
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		got, err := tokenSource.Token()
+		assert.NoError(t, err)
+		assert.Equal(t, "new access", got.AccessToken)
+	}
+	assert.Equal(t, 1, refreshes)
+}
+
+func TestStore_TokenSource_SaveFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"new access","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	mockClient := mock.NewMockGMailTokenServiceClient(ctrl)
+	store := newTestStore(t, mockClient, server.URL)
+	ctx := context.Background()
+	expired := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", Expiry: time.Now().Add(-time.Hour)}
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), gomock.Any()).
+		Return(&auth_proto.GetGMailTokenReply{Token: encodeToken(t, expired), Found: true}, nil)
+	gomock.InOrder(
+		mockClient.EXPECT().SaveGMailToken(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("service error")),
+		mockClient.EXPECT().SaveGMailToken(gomock.Any(), gomock.Any()).Return(&auth_proto.SaveGMailTokenReply{Status: true}, nil),
+	)
+
+	tokenSource, err := store.TokenSource("user@gmail.com", ctx)
+	assert.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		got, err := tokenSource.Token()
+		assert.NoError(t, err)
+		assert.Equal(t, "new access", got.AccessToken)
+	}
+}
+
+func TestStore_TokenSource_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockGMailTokenServiceClient(ctrl)
+	store := newTestStore(t, mockClient, "http://localhost/token")
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), gomock.Any()).Return(&auth_proto.GetGMailTokenReply{Found: false}, nil)
+
+	_, err := store.Service("user@gmail.com", context.Background())
+	assert.Error(t, err)
+}
+
+func TestStore_Config_MissingFile(t *testing.T) {
+	store := NewStore(nil, filepath.Join(t.TempDir(), "missing.json"))
+
+	_, err := store.Config()
+	assert.Error(t, err)
+}
+
+func TestStore_TokenSource_RefreshAfterRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"new access","refresh_token":"new refresh","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	mockClient := mock.NewMockGMailTokenServiceClient(ctrl)
+	store := newTestStore(t, mockClient, server.URL)
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), "requestID", "testID"))
+	expired := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", Expiry: time.Now().Add(-time.Hour)}
+
+	mockClient.EXPECT().GetGMailToken(gomock.Any(), gomock.Any()).
+		Return(&auth_proto.GetGMailTokenReply{Token: encodeToken(t, expired), Found: true}, nil)
+	mockClient.EXPECT().SaveGMailToken(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *auth_proto.SaveGMailTokenRequest, opts ...grpc.CallOption) (*auth_proto.SaveGMailTokenReply, error) {
+			assert.NoError(t, ctx.Err())
+			return &auth_proto.SaveGMailTokenReply{Status: true}, nil
+		})
+
+	tokenSource, err := store.TokenSource("user@gmail.com", ctx)
+	assert.NoError(t, err)
+
+	// The request the source was created for has ended before the token is needed.
+	cancel()
+
+	got, err := tokenSource.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "new access", got.AccessToken)
+	assert.Equal(t, "new refresh", got.RefreshToken)
+}
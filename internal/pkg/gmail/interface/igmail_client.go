@@ -0,0 +1,39 @@
+//go:generate mockgen -source=./igmail_client.go -destination=../mock/gmail_client_mock.go -package=mock
+
+package _interface
+
+import (
+	"context"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// GMailClient represents the interface of the Gmail API used to keep the local copy of the mailbox.
+type GMailClient interface {
+	// HistoryID returns the identifier of the latest change of the mailbox.
+	HistoryID() (uint64, error)
+
+	// Labels returns the labels of the mailbox.
+	Labels() ([]*domain.GMailLabel, error)
+
+	// MessageIDs returns the identifiers of up to limit of the newest emails, drafts excluded.
+	MessageIDs(limit int) ([]string, error)
+
+	// Message returns the email by its identifier.
+	Message(id string) (*domain.GMailMessage, error)
+
+	// History returns the emails changed and deleted since the change with startHistoryID.
+	History(startHistoryID uint64) (*domain.GMailHistory, error)
+
+	// ModifyLabels adds and removes the labels of the email.
+	ModifyLabels(id string, addLabelIDs, removeLabelIDs []string) error
+
+	// Delete removes the email.
+	Delete(id string) error
+}
+
+// GMailClientFactory represents the interface for connecting to the Gmail mailboxes.
+type GMailClientFactory interface {
+	// Client returns the Gmail API client authorized to act on behalf of the Gmail address.
+	Client(login string, ctx context.Context) (GMailClient, error)
+}
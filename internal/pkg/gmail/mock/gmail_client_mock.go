@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./igmail_client.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	domain_models "mail/internal/microservice/models/domain_models"
+	_interface "mail/internal/pkg/gmail/interface"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGMailClient is a mock of GMailClient interface.
+type MockGMailClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGMailClientMockRecorder
+}
+
+// MockGMailClientMockRecorder is the mock recorder for MockGMailClient.
+type MockGMailClientMockRecorder struct {
+	mock *MockGMailClient
+}
+
+// NewMockGMailClient creates a new mock instance.
+func NewMockGMailClient(ctrl *gomock.Controller) *MockGMailClient {
+	mock := &MockGMailClient{ctrl: ctrl}
+	mock.recorder = &MockGMailClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGMailClient) EXPECT() *MockGMailClientMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockGMailClient) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGMailClientMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGMailClient)(nil).Delete), id)
+}
+
+// History mocks base method.
+func (m *MockGMailClient) History(startHistoryID uint64) (*domain_models.GMailHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", startHistoryID)
+	ret0, _ := ret[0].(*domain_models.GMailHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockGMailClientMockRecorder) History(startHistoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockGMailClient)(nil).History), startHistoryID)
+}
+
+// HistoryID mocks base method.
+func (m *MockGMailClient) HistoryID() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistoryID")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HistoryID indicates an expected call of HistoryID.
+func (mr *MockGMailClientMockRecorder) HistoryID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoryID", reflect.TypeOf((*MockGMailClient)(nil).HistoryID))
+}
+
+// Labels mocks base method.
+func (m *MockGMailClient) Labels() ([]*domain_models.GMailLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Labels")
+	ret0, _ := ret[0].([]*domain_models.GMailLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockGMailClientMockRecorder) Labels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockGMailClient)(nil).Labels))
+}
+
+// Message mocks base method.
+func (m *MockGMailClient) Message(id string) (*domain_models.GMailMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Message", id)
+	ret0, _ := ret[0].(*domain_models.GMailMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Message indicates an expected call of Message.
+func (mr *MockGMailClientMockRecorder) Message(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Message", reflect.TypeOf((*MockGMailClient)(nil).Message), id)
+}
+
+// MessageIDs mocks base method.
+func (m *MockGMailClient) MessageIDs(limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MessageIDs", limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MessageIDs indicates an expected call of MessageIDs.
+func (mr *MockGMailClientMockRecorder) MessageIDs(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageIDs", reflect.TypeOf((*MockGMailClient)(nil).MessageIDs), limit)
+}
+
+// ModifyLabels mocks base method.
+func (m *MockGMailClient) ModifyLabels(id string, addLabelIDs, removeLabelIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyLabels", id, addLabelIDs, removeLabelIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyLabels indicates an expected call of ModifyLabels.
+func (mr *MockGMailClientMockRecorder) ModifyLabels(id, addLabelIDs, removeLabelIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyLabels", reflect.TypeOf((*MockGMailClient)(nil).ModifyLabels), id, addLabelIDs, removeLabelIDs)
+}
+
+// MockGMailClientFactory is a mock of GMailClientFactory interface.
+type MockGMailClientFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockGMailClientFactoryMockRecorder
+}
+
+// MockGMailClientFactoryMockRecorder is the mock recorder for MockGMailClientFactory.
+type MockGMailClientFactoryMockRecorder struct {
+	mock *MockGMailClientFactory
+}
+
+// NewMockGMailClientFactory creates a new mock instance.
+func NewMockGMailClientFactory(ctrl *gomock.Controller) *MockGMailClientFactory {
+	mock := &MockGMailClientFactory{ctrl: ctrl}
+	mock.recorder = &MockGMailClientFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGMailClientFactory) EXPECT() *MockGMailClientFactoryMockRecorder {
+	return m.recorder
+}
+
+// Client mocks base method.
+func (m *MockGMailClientFactory) Client(login string, ctx context.Context) (_interface.GMailClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Client", login, ctx)
+	ret0, _ := ret[0].(_interface.GMailClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Client indicates an expected call of Client.
+func (mr *MockGMailClientFactoryMockRecorder) Client(login, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Client", reflect.TypeOf((*MockGMailClientFactory)(nil).Client), login, ctx)
+}
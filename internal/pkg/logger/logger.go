@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -13,6 +14,29 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// requestLogMode is the mode of the errors logged by LogRequestError.
+const requestLogMode = "[request_log]"
+
+// requestLogFormat is the format of the errors logged by LogRequestError, whatever the format of the logger of the request.
+const requestLogFormat = "[%lvl%]: %time% - %msg% requestID=%requestID% mode=%mode%\n"
+
+// defaultRequestLogger logs the errors of the requests which have no logger of their own.
+var defaultRequestLogger = &LogrusLogger{
+	LogrusLogger: &logrus.Logger{
+		Out:   os.Stdout,
+		Level: logrus.InfoLevel,
+		Formatter: &Formatter{
+			LogFormat:     requestLogFormat,
+			ForceColors:   true,
+			ColorInfo:     color.New(color.FgBlue),
+			ColorWarning:  color.New(color.FgYellow),
+			ColorError:    color.New(color.FgRed),
+			ColorCritical: color.New(color.BgRed, color.FgWhite),
+			ColorDefault:  color.New(color.FgWhite),
+		},
+	},
+}
+
 // LogrusLogger provides a structure for logging using Logrus.
 type LogrusLogger struct {
 	LogrusLogger *logrus.Logger
@@ -32,6 +56,9 @@ type Formatter struct {
 // Format building log message.
 func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
 	output := f.LogFormat
+	if entry.Data["mode"] == requestLogMode {
+		output = requestLogFormat
+	}
 	if f.ForceColors {
 		switch entry.Level {
 		case logrus.InfoLevel:
@@ -56,7 +83,9 @@ func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
 	time.Local = loc
 
 	output = strings.Replace(output, "%msg%", entry.Message, 1)
-	output = strings.Replace(output, "%work_time%", entry.Data["work_time"].(time.Duration).String(), 1)
+	if workTime, ok := entry.Data["work_time"].(time.Duration); ok {
+		output = strings.Replace(output, "%work_time%", workTime.String(), 1)
+	}
 	output = strings.Replace(output, "%mode%", entry.Data["mode"].(string), 1)
 	output = strings.Replace(output, "%requestID%", entry.Data["requestID"].(string), 1)
 	output = strings.Replace(output, "%time%", time.Now().Format("2006-01-02 15:04:05"), 1)
@@ -144,6 +173,20 @@ func (log *LogrusLogger) DbLog(query, requestID string, start time.Time, err *er
 	}
 }
 
+// LogRequestError logs an error which doesn't fail the request, e.g. of a side effect,
+// with the logger and the ID of the request from the context.
+func LogRequestError(ctx context.Context, message string, err error) {
+	log, ok := ctx.Value("logger").(*LogrusLogger)
+	if !ok || log == nil || log.LogrusLogger.Formatter == nil {
+		log = defaultRequestLogger
+	}
+
+	log.LogrusLogger.WithFields(logrus.Fields{
+		"requestID": GetRequestIDString(ctx.Value("requestID")),
+		"mode":      requestLogMode,
+	}).Error(fmt.Sprintf("%s: %v", message, err))
+}
+
 // GetRequestIDString converts the request ID Value to a string.
 func GetRequestIDString(requestIDValue interface{}) string {
 	if requestIDValue != nil {
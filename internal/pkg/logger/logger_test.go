@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -195,3 +197,23 @@ func TestFormat(t *testing.T) {
 		assert.Equal(t, expectedLog, string(b))
 	})
 }
+
+func TestLogRequestError(t *testing.T) {
+	log := InitializationBdLog(os.Stderr)
+	log.LogrusLogger.Hooks = make(logrus.LevelHooks)
+	hook := test.NewLocal(log.LogrusLogger)
+	ctx := context.WithValue(context.WithValue(context.Background(), "logger", log), "requestID", "test_request")
+
+	LogRequestError(ctx, "failed to rotate csrf token", errors.New("session service is down"))
+
+	assert.Equal(t, "failed to rotate csrf token: session service is down", hook.LastEntry().Message)
+	assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+	assert.Equal(t, "test_request", hook.LastEntry().Data["requestID"])
+
+	b, err := log.LogrusLogger.Formatter.Format(hook.LastEntry())
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), "failed to rotate csrf token: session service is down requestID=test_request mode=[request_log]\n")
+
+	// A request without a logger of its own still gets logged.
+	assert.NotPanics(t, func() { LogRequestError(context.Background(), "failed", errors.New("error")) })
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// apiTokenRoute is a group of routes a personal access token with the scope can be used for.
+type apiTokenRoute struct {
+	methods []string
+	path    *regexp.Regexp
+	scope   string
+}
+
+// apiTokenRoutes lists every route available with personal access tokens.
+// Account settings, sessions and the tokens themselves are left out on purpose,
+// a leaked token must not be enough to take over the account.
+var apiTokenRoutes = []apiTokenRoute{
+	{
+		methods: []string{http.MethodGet},
+		path:    regexp.MustCompile(`^/api/v1/(emails|email|labels|label|lists|list|folder/(all|all_emails|allname))(/|$)`),
+		scope:   domain.APITokenScopeMailRead,
+	},
+	{
+		methods: []string{http.MethodPost},
+		path:    regexp.MustCompile(`^/api/v1/email/(send|adddraft|addfile|sendToOtherDomain/\d+|\d+/addattachment|\d+/file/[^/]+)$`),
+		scope:   domain.APITokenScopeMailSend,
+	},
+	{
+		methods: []string{http.MethodPost, http.MethodPut, http.MethodDelete},
+		path:    regexp.MustCompile(`^/api/v1/folder/(add|update/\d+|delete/\d+|add_email|delete_email|move_email)$`),
+		scope:   domain.APITokenScopeFoldersManage,
+	},
+}
+
+// apiTokenScope returns the scope a personal access token needs for the request,
+// or an empty string if the route can not be used with personal access tokens.
+func apiTokenScope(r *http.Request) string {
+	for _, route := range apiTokenRoutes {
+		if !route.path.MatchString(r.URL.Path) {
+			continue
+		}
+
+		for _, method := range route.methods {
+			if method == r.Method {
+				return route.scope
+			}
+		}
+	}
+
+	return ""
+}
@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"regexp"
@@ -15,7 +17,9 @@ import (
 	"mail/internal/monitoring"
 	"mail/internal/pkg/logger"
 	"mail/internal/pkg/session"
+	"mail/internal/pkg/utils/client_info"
 
+	domain "mail/internal/microservice/models/domain_models"
 	response "mail/internal/models/response"
 )
 
@@ -42,9 +46,44 @@ func (lrw *LoggingResponseWriter) WriteHeader(code int) {
 	lrw.ResponseWriter.WriteHeader(code)
 }
 
+// Hijack lets the websocket handlers take over the connection through the logging writer.
+func (lrw *LoggingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := lrw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+
+	lrw.statusCode = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
+
+// Unwrap lets the server-sent events handlers flush the stream through the logging writer.
+func (lrw *LoggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
+
 // AuthMiddleware is a middleware to check user authentication using cookies.
+// Scripts can authenticate with a personal access token in the Authorization header instead,
+// such requests skip the CSRF check and are limited to the routes allowed by the token scopes.
 func AuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := session.BearerToken(r); ok {
+			owner, err := session.GlobalSessionManager.CheckAPIToken(r, r.Context())
+			if err != nil {
+				response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+				return
+			}
+
+			scope := apiTokenScope(r)
+			if scope == "" || !domain.APITokenScopesAllow(owner.Scopes, scope) {
+				response.HandleError(w, http.StatusForbidden, "Insufficient token scope")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(session.WithAPITokenOwner(r.Context(), owner)))
+			return
+		}
+
 		_, err := session.GlobalSessionManager.Check(r, r.Context())
 		if err != nil {
 			response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
@@ -73,7 +112,7 @@ func (log *Logger) AccessLogMiddleware(next http.Handler) http.Handler {
 		defer f.Close()
 
 		c := context.WithValue(r.Context(), "logger", logger.InitializationBdLog(f))
-		ctx := context.WithValue(c, "requestID", id)
+		ctx := client_info.NewContext(context.WithValue(c, "requestID", id), r)
 
 		req := r.WithContext(ctx)
 		method := r.Method
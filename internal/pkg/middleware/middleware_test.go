@@ -1,11 +1,19 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+
+	"mail/internal/pkg/session"
+
+	userMock "mail/internal/microservice/user/mock"
+	userProto "mail/internal/microservice/user/proto"
 )
 
 func TestPanicMiddleware(t *testing.T) {
@@ -41,3 +49,107 @@ func TestAuthMiddleware(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, recWithInvalidCookie.Code)
 	})
 }
+
+func TestAuthMiddleware_APIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	previousManager := session.GlobalSessionManager
+	session.InitializationGlobalSessionManager(session.NewSessionsManager(nil, mockUserServiceClient))
+	defer session.InitializationGlobalSessionManager(previousManager)
+
+	var login string
+	fakeHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		login, _ = session.GlobalSessionManager.GetLoginBySession(r, r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	newTokenRequest := func(method, url string) *http.Request {
+		req := httptest.NewRequest(method, url, nil)
+		req.Header.Set("Authorization", "Bearer mhp_secret")
+		return req.WithContext(context.WithValue(req.Context(), "requestID", "testID"))
+	}
+
+	t.Run("AllowedScope", func(t *testing.T) {
+		mockUserServiceClient.EXPECT().AuthenticateAPIToken(gomock.Any(), &userProto.AuthenticateAPITokenRequest{Token: "mhp_secret"}).
+			Return(&userProto.AuthenticateAPITokenReply{Id: 1, Login: "user@mailhub.su", Scopes: []string{"mail:read"}}, nil)
+
+		rec := httptest.NewRecorder()
+		AuthMiddleware(fakeHandler).ServeHTTP(rec, newTokenRequest("GET", "/api/v1/emails/incoming"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "user@mailhub.su", login)
+	})
+
+	t.Run("MissingScope", func(t *testing.T) {
+		mockUserServiceClient.EXPECT().AuthenticateAPIToken(gomock.Any(), gomock.Any()).
+			Return(&userProto.AuthenticateAPITokenReply{Id: 1, Login: "user@mailhub.su", Scopes: []string{"mail:read"}}, nil)
+
+		rec := httptest.NewRecorder()
+		AuthMiddleware(fakeHandler).ServeHTTP(rec, newTokenRequest("POST", "/api/v1/email/send"))
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("RouteNotAllowed", func(t *testing.T) {
+		mockUserServiceClient.EXPECT().AuthenticateAPIToken(gomock.Any(), gomock.Any()).
+			Return(&userProto.AuthenticateAPITokenReply{Id: 1, Login: "user@mailhub.su", Scopes: []string{"mail:read", "mail:send", "folders:manage"}}, nil)
+
+		rec := httptest.NewRecorder()
+		AuthMiddleware(fakeHandler).ServeHTTP(rec, newTokenRequest("POST", "/api/v1/user/token/create"))
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		mockUserServiceClient.EXPECT().AuthenticateAPIToken(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid api token"))
+
+		rec := httptest.NewRecorder()
+		AuthMiddleware(fakeHandler).ServeHTTP(rec, newTokenRequest("GET", "/api/v1/emails/incoming"))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestAPITokenScope(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		scope  string
+	}{
+		{"GET", "/api/v1/emails/incoming", "mail:read"},
+		{"GET", "/api/v1/email/12", "mail:read"},
+		{"GET", "/api/v1/label/work/emails", "mail:read"},
+		{"GET", "/api/v1/folder/all_emails/3", "mail:read"},
+		{"POST", "/api/v1/email/send", "mail:send"},
+		{"POST", "/api/v1/email/12/file/abc", "mail:send"},
+		{"POST", "/api/v1/folder/add", "folders:manage"},
+		{"PUT", "/api/v1/folder/update/3", "folders:manage"},
+		{"DELETE", "/api/v1/folder/delete_email", "folders:manage"},
+		{"DELETE", "/api/v1/email/delete/12", ""},
+		{"GET", "/api/v1/user/tokens", ""},
+		{"GET", "/api/v1/user/get", ""},
+		{"POST", "/api/v1/user/password", ""},
+		{"GET", "/api/v1/verify-auth", ""},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(test.method, test.path, nil)
+		assert.Equal(t, test.scope, apiTokenScope(req), "%s %s", test.method, test.path)
+	}
+}
+
+func TestLoggingResponseWriter_Hijack(t *testing.T) {
+	lrw := NewLoggingResponseWriter(httptest.NewRecorder())
+	_, _, err := lrw.Hijack()
+	assert.Error(t, err)
+}
+
+func TestLoggingResponseWriter_Flush(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	lrw := NewLoggingResponseWriter(recorder)
+
+	assert.NoError(t, http.NewResponseController(lrw).Flush())
+	assert.True(t, recorder.Flushed)
+}
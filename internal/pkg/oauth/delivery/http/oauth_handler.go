@@ -16,6 +16,7 @@ import (
 
 	"mail/internal/microservice/models/domain_models"
 	"mail/internal/models/microservice_ports"
+	"mail/internal/pkg/utils/client_info"
 	"mail/internal/pkg/utils/connect_microservice"
 	"mail/internal/pkg/utils/sanitize"
 
@@ -192,6 +193,7 @@ func (ah *OAuthHandler) SignupVK(w http.ResponseWriter, r *http.Request) {
 	newUser.FirstName = sanitize.SanitizeString(newUser.FirstName)
 	newUser.Surname = sanitize.SanitizeString(newUser.Surname)
 	newUser.Login = sanitize.SanitizeString(newUser.Login)
+	newUser.RecoveryEmail = sanitize.SanitizeString(newUser.RecoveryEmail)
 
 	if validUtil.IsEmpty(newUser.Login) || validUtil.IsEmpty(newUser.FirstName) || validUtil.IsEmpty(newUser.Surname) || !domain.IsValidGender(newUser.Gender) {
 		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
@@ -203,6 +205,11 @@ func (ah *OAuthHandler) SignupVK(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if newUser.RecoveryEmail != "" && !validUtil.IsValidRecoveryEmail(newUser.RecoveryEmail) {
+		response.HandleError(w, http.StatusBadRequest, "Invalid recovery email")
+		return
+	}
+
 	userExists, err := ah.UserServiceClient.GetUserByOnlyLogin(
 		metadata.NewOutgoingContext(r.Context(),
 			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
@@ -227,12 +234,13 @@ func (ah *OAuthHandler) SignupVK(w http.ResponseWriter, r *http.Request) {
 		metadata.NewOutgoingContext(r.Context(),
 			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
 		&auth_proto.SignupVKRequest{
-			Login:     newUser.Login,
-			Firstname: newUser.FirstName,
-			Surname:   newUser.Surname,
-			Birthday:  timestamppb.New(newUser.Birthday),
-			Gender:    domain.GetGender(newUser.Gender),
-			VkId:      newUser.VKId,
+			Login:         newUser.Login,
+			Firstname:     newUser.FirstName,
+			Surname:       newUser.Surname,
+			Birthday:      timestamppb.New(newUser.Birthday),
+			Gender:        domain.GetGender(newUser.Gender),
+			VkId:          newUser.VKId,
+			RecoveryEmail: newUser.RecoveryEmail,
 		},
 	)
 	if errStatus != nil {
@@ -242,7 +250,8 @@ func (ah *OAuthHandler) SignupVK(w http.ResponseWriter, r *http.Request) {
 
 	sessionId, errStatus := authServiceClient.LoginVK(
 		metadata.NewOutgoingContext(r.Context(),
-			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string),
+				"userAgent": r.UserAgent(), "ipAddress": client_info.ClientIP(r)})),
 		&auth_proto.LoginVKRequest{VkId: newUser.VKId},
 	)
 	if errStatus != nil {
@@ -314,7 +323,8 @@ func (ah *OAuthHandler) LoginVK(w http.ResponseWriter, r *http.Request) {
 	authServiceClient := auth_proto.NewAuthServiceClient(conn)
 	sessionId, errStatus := authServiceClient.LoginVK(
 		metadata.NewOutgoingContext(r.Context(),
-			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string),
+				"userAgent": r.UserAgent(), "ipAddress": client_info.ClientIP(r)})),
 		&auth_proto.LoginVKRequest{VkId: userVK.VKId},
 	)
 	if errStatus != nil {
@@ -331,6 +341,11 @@ func (ah *OAuthHandler) LoginVK(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if sessionId.TwoFactorRequired {
+		response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"TwoFactorRequired": true, "ChallengeID": sessionId.ChallengeId})
+		return
+	}
+
 	er := ah.Sessions.SetSession(sessionId.SessionId, w, r, r.Context())
 	if er != nil {
 		response.HandleError(w, http.StatusInternalServerError, "Failed to create session")
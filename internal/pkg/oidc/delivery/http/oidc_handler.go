@@ -0,0 +1,355 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mailru/easyjson"
+	"google.golang.org/grpc/metadata"
+
+	"mail/cmd/configs"
+	"mail/internal/pkg/utils/jwt"
+
+	auth_proto "mail/internal/microservice/auth/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+	domainSession "mail/internal/pkg/session/interface"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// OIDCHandler handles the HTTP requests of the OpenID Connect provider, which lets other applications
+// sign users in with their MailHub accounts. The endpoints used by the clients answer in the format of the specification,
+// the consent endpoints used by the frontend answer in the format of the rest of the API.
+type OIDCHandler struct {
+	Sessions          domainSession.SessionsManager
+	OIDCServiceClient auth_proto.OIDCServiceClient
+}
+
+// Discovery handles requests for the OpenID Connect discovery document.
+// @Summary OpenID Connect discovery document
+// @Description Describes the OpenID Connect provider to the clients: the addresses of the endpoints and the supported scopes, flows and algorithms.
+// @Tags oidc
+// @Produce json
+// @Success 200 {object} delivery_models.OIDCDiscovery "Discovery document"
+// @Router /.well-known/openid-configuration [get]
+func (oh *OIDCHandler) Discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &api.OIDCDiscovery{
+		Issuer:                            configs.OIDC_ISSUER,
+		AuthorizationEndpoint:             configs.OIDC_ISSUER + "/api/v1/oidc/authorize",
+		TokenEndpoint:                     configs.OIDC_ISSUER + "/api/v1/oidc/token",
+		UserInfoEndpoint:                  configs.OIDC_ISSUER + "/api/v1/oidc/userinfo",
+		JWKSURI:                           configs.OIDC_ISSUER + "/api/v1/oidc/jwks",
+		ScopesSupported:                   []string{domain.OIDCScopeOpenID, domain.OIDCScopeProfile, domain.OIDCScopeEmail, domain.OIDCScopePhone},
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{jwt.AlgorithmRS256},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{domain.OIDCCodeChallengeMethodS256},
+		ClaimsSupported: []string{"sub", "name", "given_name", "family_name", "middle_name", "preferred_username",
+			"picture", "gender", "birthdate", "email", "email_verified", "phone_number"},
+	})
+}
+
+// Keys handles requests for the public keys the tokens are signed with.
+// @Summary OpenID Connect signing keys
+// @Description Returns the public keys the ID and access tokens are signed with. The keys are rotated, clients should fetch them again when a token names an unknown key.
+// @Tags oidc
+// @Produce json
+// @Success 200 {object} delivery_models.OIDCKeySet "Signing keys"
+// @Failure 500 {object} delivery_models.OIDCError "Failed to get keys"
+// @Router /api/v1/oidc/jwks [get]
+func (oh *OIDCHandler) Keys(w http.ResponseWriter, r *http.Request) {
+	keys, err := oh.OIDCServiceClient.GetOIDCKeys(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.GetOIDCKeysRequest{},
+	)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &api.OIDCError{Error: "server_error"})
+		return
+	}
+
+	keySet := &api.OIDCKeySet{Keys: make([]jwt.JWK, 0, len(keys.Keys))}
+	for _, key := range keys.Keys {
+		keySet.Keys = append(keySet.Keys, jwt.JWK{
+			KeyType:   "RSA",
+			Use:       "sig",
+			Algorithm: jwt.AlgorithmRS256,
+			KeyID:     key.Kid,
+			Modulus:   key.N,
+			Exponent:  key.E,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, keySet)
+}
+
+// Authorize handles the authorization requests of the clients.
+// @Summary OpenID Connect authorization endpoint
+// @Description Signs the user in to the client with the authorization code flow and PKCE. A user who is not signed in is sent to the login page and back, a user who has not allowed the client to read the requested scopes is sent to the consent page. Then the user is sent to the redirect address of the client with the code or the error.
+// @Tags oidc
+// @Param response_type query string true "Always code"
+// @Param client_id query string true "Client ID"
+// @Param redirect_uri query string true "Registered redirect address"
+// @Param scope query string true "Space-separated scopes, openid is required"
+// @Param state query string false "Value returned to the client as is"
+// @Param nonce query string false "Value put into the ID token"
+// @Param code_challenge query string true "PKCE challenge"
+// @Param code_challenge_method query string true "Always S256"
+// @Success 302 "Redirect to the client, the login page or the consent page"
+// @Failure 400 {object} response.ErrorResponse "Unknown client or unregistered redirect address"
+// @Router /api/v1/oidc/authorize [get]
+func (oh *OIDCHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	// GetSession expects the session cookie to be present.
+	if _, err := r.Cookie("session_id"); err != nil {
+		http.Redirect(w, r, configs.OIDC_LOGIN_URL+url.QueryEscape(r.URL.RequestURI()), http.StatusFound)
+		return
+	}
+
+	session := oh.Sessions.GetSession(r, r.Context())
+	if session == nil {
+		http.Redirect(w, r, configs.OIDC_LOGIN_URL+url.QueryEscape(r.URL.RequestURI()), http.StatusFound)
+		return
+	}
+
+	query := r.URL.Query()
+	reply, err := oh.OIDCServiceClient.AuthorizeOIDC(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.AuthorizeOIDCRequest{
+			SessionId:           session.ID,
+			ResponseType:        query.Get("response_type"),
+			ClientId:            query.Get("client_id"),
+			RedirectUri:         query.Get("redirect_uri"),
+			Scope:               query.Get("scope"),
+			State:               query.Get("state"),
+			Nonce:               query.Get("nonce"),
+			CodeChallenge:       query.Get("code_challenge"),
+			CodeChallengeMethod: query.Get("code_challenge_method"),
+		},
+	)
+	if err != nil {
+		// The redirect address is not trusted, so the error is shown to the user instead of being sent to the client.
+		response.HandleError(w, http.StatusBadRequest, "Invalid authorization request")
+		return
+	}
+
+	if reply.ConsentRequired {
+		http.Redirect(w, r, configs.OIDC_CONSENT_URL+url.QueryEscape(reply.ConsentRequest), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, reply.RedirectUri, http.StatusFound)
+}
+
+// Token handles the exchange of authorization codes for tokens.
+// @Summary OpenID Connect token endpoint
+// @Description Exchanges the authorization code for the ID token and the access token. Confidential clients authenticate with HTTP Basic authentication or the client_secret parameter, public clients only give client_id.
+// @Tags oidc
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param grant_type formData string true "Always authorization_code"
+// @Param code formData string true "Authorization code"
+// @Param redirect_uri formData string true "Redirect address the code was sent to"
+// @Param code_verifier formData string true "PKCE verifier"
+// @Param client_id formData string false "Client ID, if HTTP Basic authentication is not used"
+// @Param client_secret formData string false "Client secret, if HTTP Basic authentication is not used"
+// @Success 200 {object} delivery_models.OIDCToken "Tokens"
+// @Failure 400 {object} delivery_models.OIDCError "Invalid request or grant"
+// @Failure 401 {object} delivery_models.OIDCError "Invalid client"
+// @Failure 500 {object} delivery_models.OIDCError "Failed to issue tokens"
+// @Router /api/v1/oidc/token [post]
+func (oh *OIDCHandler) Token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, &api.OIDCError{Error: "invalid_request"})
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		// The credentials of Basic authentication are form encoded by the specification.
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+		clientSecret = r.PostForm.Get("client_secret")
+	}
+
+	reply, err := oh.OIDCServiceClient.ExchangeOIDCCode(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.ExchangeOIDCCodeRequest{
+			GrantType:    r.PostForm.Get("grant_type"),
+			Code:         r.PostForm.Get("code"),
+			RedirectUri:  r.PostForm.Get("redirect_uri"),
+			ClientId:     clientID,
+			ClientSecret: clientSecret,
+			CodeVerifier: r.PostForm.Get("code_verifier"),
+		},
+	)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, &api.OIDCError{Error: "server_error"})
+		return
+	}
+
+	switch reply.Error {
+	case "":
+	case "invalid_client":
+		w.Header().Set("WWW-Authenticate", `Basic realm="MailHub"`)
+		writeJSON(w, http.StatusUnauthorized, &api.OIDCError{Error: reply.Error})
+		return
+	default:
+		writeJSON(w, http.StatusBadRequest, &api.OIDCError{Error: reply.Error})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &api.OIDCToken{
+		AccessToken: reply.AccessToken,
+		TokenType:   reply.TokenType,
+		ExpiresIn:   reply.ExpiresIn,
+		IDToken:     reply.IdToken,
+		Scope:       reply.Scope,
+	})
+}
+
+// UserInfo handles requests for the claims about the user.
+// @Summary OpenID Connect user info endpoint
+// @Description Returns the claims about the user the access token allows the client to read.
+// @Tags oidc
+// @Produce json
+// @Param Authorization header string true "Bearer access token"
+// @Success 200 {object} map[string]interface{} "Claims about the user"
+// @Failure 401 {object} delivery_models.OIDCError "Invalid access token"
+// @Router /api/v1/oidc/userinfo [get]
+func (oh *OIDCHandler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || validUtil.IsEmpty(accessToken) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="MailHub"`)
+		writeJSON(w, http.StatusUnauthorized, &api.OIDCError{Error: "invalid_token"})
+		return
+	}
+
+	reply, err := oh.OIDCServiceClient.GetOIDCUserInfo(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.GetOIDCUserInfoRequest{AccessToken: accessToken},
+	)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="MailHub", error="invalid_token"`)
+		writeJSON(w, http.StatusUnauthorized, &api.OIDCError{Error: "invalid_token"})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(reply.Claims)
+}
+
+// GetConsent handles requests for the authorization request the user is asked to allow.
+// @Summary Get OpenID Connect consent request
+// @Description Returns the client and the scopes of the authorization request shown on the consent page.
+// @Tags oidc
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param request query string true "Signed authorization request from the consent page address"
+// @Success 200 {object} response.Response "Client and scopes"
+// @Failure 400 {object} response.ErrorResponse "Invalid or expired request"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Router /api/v1/user/oidc/consent [get]
+func (oh *OIDCHandler) GetConsent(w http.ResponseWriter, r *http.Request) {
+	consentRequest := r.URL.Query().Get("request")
+	if validUtil.IsEmpty(consentRequest) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	session := oh.Sessions.GetSession(r, r.Context())
+	if session == nil {
+		response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+		return
+	}
+
+	consent, err := oh.OIDCServiceClient.GetOIDCConsent(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.GetOIDCConsentRequest{SessionId: session.ID, ConsentRequest: consentRequest},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid or expired consent request")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"consent": api.OIDCConsent{
+		ClientID:   consent.ClientId,
+		ClientName: consent.ClientName,
+		Scopes:     consent.Scopes,
+	}})
+}
+
+// GrantConsent handles the decision of the user on the authorization request.
+// @Summary Allow or deny an OpenID Connect client
+// @Description Records the decision of the user on the authorization request and returns the address of the client to send the user to, with the code if the request is approved.
+// @Tags oidc
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param decision body delivery_models.OIDCConsentDecision true "Signed authorization request and decision"
+// @Success 200 {object} response.Response "Redirect address of the client"
+// @Failure 400 {object} response.ErrorResponse "Invalid or expired request"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Router /api/v1/user/oidc/consent [post]
+func (oh *OIDCHandler) GrantConsent(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var decision api.OIDCConsentDecision
+	if err := decision.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if validUtil.IsEmpty(decision.Request) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	session := oh.Sessions.GetSession(r, r.Context())
+	if session == nil {
+		response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+		return
+	}
+
+	reply, err := oh.OIDCServiceClient.GrantOIDCConsent(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value("requestID").(string)})),
+		&auth_proto.GrantOIDCConsentRequest{SessionId: session.ID, ConsentRequest: decision.Request, Approve: decision.Approve},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid or expired consent request")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"redirect": api.OIDCRedirect{RedirectURI: reply.RedirectUri}})
+}
+
+// writeJSON writes the body in the plain JSON format the OpenID Connect clients expect.
+// The answers are not cached, as the specification requires for the tokens.
+func writeJSON(w http.ResponseWriter, status int, body easyjson.Marshaler) {
+	data, err := easyjson.Marshal(body)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data = []byte(`{"error":"server_error"}`)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
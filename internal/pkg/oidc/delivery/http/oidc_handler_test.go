@@ -0,0 +1,326 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"mail/cmd/configs"
+	"mail/internal/pkg/utils/constants"
+
+	auth_mock "mail/internal/microservice/auth/mock"
+	auth_proto "mail/internal/microservice/auth/proto"
+	api "mail/internal/models/delivery_models"
+	session_mock "mail/internal/pkg/session/mock"
+)
+
+func newOIDCRequest(method, url, body string) *http.Request {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+
+	return req.WithContext(context.WithValue(req.Context(), interface{}(string(constants.RequestIDKey)), "testID"))
+}
+
+func TestOIDCHandler_Discovery(t *testing.T) {
+	oh := &OIDCHandler{}
+	w := httptest.NewRecorder()
+
+	oh.Discovery(w, newOIDCRequest("GET", "/.well-known/openid-configuration", ""))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var discovery api.OIDCDiscovery
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &discovery))
+	assert.Equal(t, configs.OIDC_ISSUER, discovery.Issuer)
+	assert.Equal(t, configs.OIDC_ISSUER+"/api/v1/oidc/jwks", discovery.JWKSURI)
+	assert.Equal(t, []string{"S256"}, discovery.CodeChallengeMethodsSupported)
+}
+
+func TestOIDCHandler_Keys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOIDCServiceClient := auth_mock.NewMockOIDCServiceClient(ctrl)
+	oh := &OIDCHandler{OIDCServiceClient: mockOIDCServiceClient}
+
+	t.Run("Success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().GetOIDCKeys(gomock.Any(), gomock.Any()).
+			Return(&auth_proto.GetOIDCKeysReply{Keys: []*auth_proto.OIDCKey{{Kid: "kid", N: "n", E: "AQAB"}}}, nil)
+
+		oh.Keys(w, newOIDCRequest("GET", "/api/v1/oidc/jwks", ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"keys":[{"kty":"RSA","use":"sig","alg":"RS256","kid":"kid","n":"n","e":"AQAB"}]}`, w.Body.String())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().GetOIDCKeys(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+		oh.Keys(w, newOIDCRequest("GET", "/api/v1/oidc/jwks", ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestOIDCHandler_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOIDCServiceClient := auth_mock.NewMockOIDCServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+	oh := &OIDCHandler{Sessions: mockSessionsManager, OIDCServiceClient: mockOIDCServiceClient}
+
+	authorizeURL := "/api/v1/oidc/authorize?response_type=code&client_id=client&redirect_uri=https%3A%2F%2Fapp.example.com%2Fcallback" +
+		"&scope=openid&state=state&code_challenge=challenge&code_challenge_method=S256"
+	newAuthorizeRequest := func() *http.Request {
+		req := newOIDCRequest("GET", authorizeURL, "")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+		return req
+	}
+
+	t.Run("NoCookie", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		oh.Authorize(w, newOIDCRequest("GET", authorizeURL, ""))
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, configs.OIDC_LOGIN_URL+url.QueryEscape(authorizeURL), w.Header().Get("Location"))
+	})
+
+	t.Run("NoSession", func(t *testing.T) {
+		req := newAuthorizeRequest()
+		w := httptest.NewRecorder()
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(nil)
+
+		oh.Authorize(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.True(t, strings.HasPrefix(w.Header().Get("Location"), configs.OIDC_LOGIN_URL))
+	})
+
+	t.Run("Consented", func(t *testing.T) {
+		req := newAuthorizeRequest()
+		w := httptest.NewRecorder()
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "session", UserID: 1})
+		mockOIDCServiceClient.EXPECT().AuthorizeOIDC(gomock.Any(), &auth_proto.AuthorizeOIDCRequest{
+			SessionId:           "session",
+			ResponseType:        "code",
+			ClientId:            "client",
+			RedirectUri:         "https://app.example.com/callback",
+			Scope:               "openid",
+			State:               "state",
+			CodeChallenge:       "challenge",
+			CodeChallengeMethod: "S256",
+		}).Return(&auth_proto.AuthorizeOIDCReply{RedirectUri: "https://app.example.com/callback?code=code&state=state"}, nil)
+
+		oh.Authorize(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://app.example.com/callback?code=code&state=state", w.Header().Get("Location"))
+	})
+
+	t.Run("ConsentRequired", func(t *testing.T) {
+		req := newAuthorizeRequest()
+		w := httptest.NewRecorder()
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "session", UserID: 1})
+		mockOIDCServiceClient.EXPECT().AuthorizeOIDC(gomock.Any(), gomock.Any()).
+			Return(&auth_proto.AuthorizeOIDCReply{ConsentRequired: true, ConsentRequest: "signed.request"}, nil)
+
+		oh.Authorize(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, configs.OIDC_CONSENT_URL+"signed.request", w.Header().Get("Location"))
+	})
+
+	t.Run("InvalidClient", func(t *testing.T) {
+		req := newAuthorizeRequest()
+		w := httptest.NewRecorder()
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "session", UserID: 1})
+		mockOIDCServiceClient.EXPECT().AuthorizeOIDC(gomock.Any(), gomock.Any()).Return(nil, errors.New("unknown client"))
+
+		oh.Authorize(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, w.Header().Get("Location"))
+	})
+}
+
+func TestOIDCHandler_Token(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOIDCServiceClient := auth_mock.NewMockOIDCServiceClient(ctrl)
+	oh := &OIDCHandler{OIDCServiceClient: mockOIDCServiceClient}
+
+	form := "grant_type=authorization_code&code=code&redirect_uri=https%3A%2F%2Fapp.example.com%2Fcallback&code_verifier=verifier"
+	newTokenRequest := func(body string) *http.Request {
+		req := newOIDCRequest("POST", "/api/v1/oidc/token", body)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+	expectedRequest := &auth_proto.ExchangeOIDCCodeRequest{
+		GrantType:    "authorization_code",
+		Code:         "code",
+		RedirectUri:  "https://app.example.com/callback",
+		ClientId:     "client",
+		ClientSecret: "secret",
+		CodeVerifier: "verifier",
+	}
+
+	t.Run("BasicAuth", func(t *testing.T) {
+		req := newTokenRequest(form)
+		req.SetBasicAuth("client", "secret")
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().ExchangeOIDCCode(gomock.Any(), expectedRequest).Return(&auth_proto.ExchangeOIDCCodeReply{
+			IdToken: "id", AccessToken: "access", TokenType: "Bearer", ExpiresIn: 3600, Scope: "openid",
+		}, nil)
+
+		oh.Token(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		assert.JSONEq(t, `{"access_token":"access","token_type":"Bearer","expires_in":3600,"id_token":"id","scope":"openid"}`, w.Body.String())
+	})
+
+	t.Run("PostCredentials", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().ExchangeOIDCCode(gomock.Any(), expectedRequest).
+			Return(&auth_proto.ExchangeOIDCCodeReply{Error: "invalid_grant"}, nil)
+
+		oh.Token(w, newTokenRequest(form+"&client_id=client&client_secret=secret"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{"error":"invalid_grant"}`, w.Body.String())
+	})
+
+	t.Run("InvalidClient", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().ExchangeOIDCCode(gomock.Any(), gomock.Any()).
+			Return(&auth_proto.ExchangeOIDCCodeReply{Error: "invalid_client"}, nil)
+
+		oh.Token(w, newTokenRequest(form+"&client_id=client"))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().ExchangeOIDCCode(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+		oh.Token(w, newTokenRequest(form+"&client_id=client"))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestOIDCHandler_UserInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOIDCServiceClient := auth_mock.NewMockOIDCServiceClient(ctrl)
+	oh := &OIDCHandler{OIDCServiceClient: mockOIDCServiceClient}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newOIDCRequest("GET", "/api/v1/oidc/userinfo", "")
+		req.Header.Set("Authorization", "Bearer token")
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().GetOIDCUserInfo(gomock.Any(), &auth_proto.GetOIDCUserInfoRequest{AccessToken: "token"}).
+			Return(&auth_proto.GetOIDCUserInfoReply{Claims: []byte(`{"sub":"7"}`)}, nil)
+
+		oh.UserInfo(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"sub":"7"}`, w.Body.String())
+	})
+
+	t.Run("NoToken", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		oh.UserInfo(w, newOIDCRequest("GET", "/api/v1/oidc/userinfo", ""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		req := newOIDCRequest("GET", "/api/v1/oidc/userinfo", "")
+		req.Header.Set("Authorization", "Bearer token")
+		w := httptest.NewRecorder()
+		mockOIDCServiceClient.EXPECT().GetOIDCUserInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid access token"))
+
+		oh.UserInfo(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Header().Get("WWW-Authenticate"), `error="invalid_token"`)
+	})
+}
+
+func TestOIDCHandler_Consent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOIDCServiceClient := auth_mock.NewMockOIDCServiceClient(ctrl)
+	mockSessionsManager := session_mock.NewMockSessionsManager(ctrl)
+	oh := &OIDCHandler{Sessions: mockSessionsManager, OIDCServiceClient: mockOIDCServiceClient}
+
+	t.Run("Get", func(t *testing.T) {
+		req := newOIDCRequest("GET", "/api/v1/user/oidc/consent?request=signed", "")
+		w := httptest.NewRecorder()
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "session", UserID: 1})
+		mockOIDCServiceClient.EXPECT().GetOIDCConsent(gomock.Any(), &auth_proto.GetOIDCConsentRequest{SessionId: "session", ConsentRequest: "signed"}).
+			Return(&auth_proto.GetOIDCConsentReply{ClientId: "client", ClientName: "App", Scopes: []string{"openid"}}, nil)
+
+		oh.GetConsent(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"status":200,"body":{"consent":{"clientId":"client","clientName":"App","scopes":["openid"]}}}`, w.Body.String())
+	})
+
+	t.Run("GetNoRequest", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		oh.GetConsent(w, newOIDCRequest("GET", "/api/v1/user/oidc/consent", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Grant", func(t *testing.T) {
+		req := newOIDCRequest("POST", "/api/v1/user/oidc/consent", `{"request":"signed","approve":true}`)
+		w := httptest.NewRecorder()
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "session", UserID: 1})
+		mockOIDCServiceClient.EXPECT().GrantOIDCConsent(gomock.Any(), &auth_proto.GrantOIDCConsentRequest{SessionId: "session", ConsentRequest: "signed", Approve: true}).
+			Return(&auth_proto.GrantOIDCConsentReply{RedirectUri: "https://app.example.com/callback?code=code"}, nil)
+
+		oh.GrantConsent(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"status":200,"body":{"redirect":{"redirectUri":"https://app.example.com/callback?code=code"}}}`, w.Body.String())
+	})
+
+	t.Run("GrantExpired", func(t *testing.T) {
+		req := newOIDCRequest("POST", "/api/v1/user/oidc/consent", `{"request":"signed","approve":true}`)
+		w := httptest.NewRecorder()
+		mockSessionsManager.EXPECT().GetSession(req, req.Context()).Return(&api.Session{ID: "session", UserID: 1})
+		mockOIDCServiceClient.EXPECT().GrantOIDCConsent(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid consent request"))
+
+		oh.GrantConsent(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GrantInvalidBody", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		oh.GrantConsent(w, newOIDCRequest("POST", "/api/v1/user/oidc/consent", `{`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
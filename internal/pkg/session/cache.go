@@ -0,0 +1,145 @@
+package session
+
+import (
+	"sync"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+const (
+	// sessionCacheLifeTime is the time a checked session is trusted without asking the session service again.
+	// Sessions revoked through other replicas are forgotten when their session_revoked event arrives,
+	// if the event is lost they stay usable here for at most this time.
+	sessionCacheLifeTime = 5 * time.Second
+	// sessionCachePruneSize is the number of cached sessions after which the expired ones are removed.
+	sessionCachePruneSize = 10000
+)
+
+// sessionCacheEntry is a cached session with the time it stops being trusted.
+type sessionCacheEntry struct {
+	session    domain.Session
+	expiration time.Time
+}
+
+// sessionCache keeps the recently checked sessions of this process so that
+// repeated requests with the same session skip the session service.
+type sessionCache struct {
+	mu       sync.Mutex
+	lifeTime time.Duration
+	entries  map[string]sessionCacheEntry
+}
+
+// newSessionCache creates a new cache trusting sessions for the lifetime.
+func newSessionCache(lifeTime time.Duration) *sessionCache {
+	return &sessionCache{
+		lifeTime: lifeTime,
+		entries:  make(map[string]sessionCacheEntry),
+	}
+}
+
+// get returns a copy of the cached session, false if it is not cached or no longer trusted.
+func (c *sessionCache) get(sessionID string, now time.Time) (*domain.Session, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[sessionID]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(entry.expiration) {
+		delete(c.entries, sessionID)
+		return nil, false
+	}
+
+	session := entry.session
+
+	return &session, true
+}
+
+// set caches a copy of the session, it is trusted until the cache lifetime passes or the session expires.
+func (c *sessionCache) set(session *domain.Session, now time.Time) {
+	if c == nil {
+		return
+	}
+
+	expiration := now.Add(c.lifeTime)
+	if sessionExpiration := session.ExpirationDate(); sessionExpiration.Before(expiration) {
+		expiration = sessionExpiration
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= sessionCachePruneSize {
+		c.prune(now)
+	}
+
+	c.entries[session.ID] = sessionCacheEntry{session: *session, expiration: expiration}
+}
+
+// prune removes the entries which are no longer trusted, or every entry if all of them still are.
+func (c *sessionCache) prune(now time.Time) {
+	for sessionID, entry := range c.entries {
+		if !now.Before(entry.expiration) {
+			delete(c.entries, sessionID)
+		}
+	}
+
+	if len(c.entries) >= sessionCachePruneSize {
+		c.entries = make(map[string]sessionCacheEntry)
+	}
+}
+
+// delete removes the session from the cache.
+func (c *sessionCache) delete(sessionID string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, sessionID)
+}
+
+// deleteByPublicID removes the sessions shown to their owner with the public identifiers from the cache.
+func (c *sessionCache) deleteByPublicID(publicIDs ...string) {
+	if c == nil || len(publicIDs) == 0 {
+		return
+	}
+
+	revoked := make(map[string]struct{}, len(publicIDs))
+	for _, publicID := range publicIDs {
+		revoked[publicID] = struct{}{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for sessionID := range c.entries {
+		if _, ok := revoked[domain.SessionPublicID(sessionID)]; ok {
+			delete(c.entries, sessionID)
+		}
+	}
+}
+
+// deleteByUserID removes every session of the user from the cache.
+func (c *sessionCache) deleteByUserID(userID uint32) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for sessionID, entry := range c.entries {
+		if entry.session.UserID == userID {
+			delete(c.entries, sessionID)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package session
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+)
+
+// newCacheTestSession returns a session which expires in an hour.
+func newCacheTestSession(sessionID string, userID uint32, now time.Time) *domain.Session {
+	return &domain.Session{
+		ID:           sessionID,
+		UserID:       userID,
+		CreationDate: now,
+		LastSeenDate: now,
+		LifeTime:     3600,
+		CsrfToken:    "csrf",
+	}
+}
+
+func TestSessionCache_GetSet(t *testing.T) {
+	cache := newSessionCache(5 * time.Second)
+	now := time.Now()
+
+	_, ok := cache.get("123", now)
+	assert.False(t, ok)
+
+	cache.set(newCacheTestSession("123", 1, now), now)
+
+	session, ok := cache.get("123", now.Add(4*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, "csrf", session.CsrfToken)
+
+	session.CsrfToken = "changed"
+	session, _ = cache.get("123", now)
+	assert.Equal(t, "csrf", session.CsrfToken)
+
+	_, ok = cache.get("123", now.Add(5*time.Second))
+	assert.False(t, ok)
+}
+
+func TestSessionCache_SessionExpiration(t *testing.T) {
+	cache := newSessionCache(5 * time.Second)
+	now := time.Now()
+
+	session := newCacheTestSession("123", 1, now.Add(-time.Hour))
+	session.LastSeenDate = now.Add(-time.Hour + 2*time.Second)
+	cache.set(session, now)
+
+	_, ok := cache.get("123", now.Add(time.Second))
+	assert.True(t, ok)
+
+	_, ok = cache.get("123", now.Add(2*time.Second))
+	assert.False(t, ok)
+
+	cache.set(&domain.Session{ID: "expired"}, now)
+	_, ok = cache.get("expired", now)
+	assert.False(t, ok)
+}
+
+func TestSessionCache_Delete(t *testing.T) {
+	now := time.Now()
+
+	newFilledCache := func() *sessionCache {
+		cache := newSessionCache(time.Minute)
+		cache.set(newCacheTestSession("first", 1, now), now)
+		cache.set(newCacheTestSession("second", 1, now), now)
+		cache.set(newCacheTestSession("other", 2, now), now)
+
+		return cache
+	}
+
+	t.Run("ByID", func(t *testing.T) {
+		cache := newFilledCache()
+		cache.delete("first")
+
+		_, ok := cache.get("first", now)
+		assert.False(t, ok)
+		_, ok = cache.get("second", now)
+		assert.True(t, ok)
+	})
+
+	t.Run("ByPublicID", func(t *testing.T) {
+		cache := newFilledCache()
+		cache.deleteByPublicID(domain.SessionPublicID("second"))
+
+		_, ok := cache.get("second", now)
+		assert.False(t, ok)
+		_, ok = cache.get("first", now)
+		assert.True(t, ok)
+	})
+
+	t.Run("ByPublicIDs", func(t *testing.T) {
+		cache := newFilledCache()
+		cache.deleteByPublicID(domain.SessionPublicID("first"), domain.SessionPublicID("other"))
+
+		_, ok := cache.get("first", now)
+		assert.False(t, ok)
+		_, ok = cache.get("other", now)
+		assert.False(t, ok)
+		_, ok = cache.get("second", now)
+		assert.True(t, ok)
+	})
+
+	t.Run("ByUserID", func(t *testing.T) {
+		cache := newFilledCache()
+		cache.deleteByUserID(1)
+
+		_, ok := cache.get("first", now)
+		assert.False(t, ok)
+		_, ok = cache.get("second", now)
+		assert.False(t, ok)
+		_, ok = cache.get("other", now)
+		assert.True(t, ok)
+	})
+}
+
+func TestSessionCache_Prune(t *testing.T) {
+	cache := newSessionCache(time.Second)
+	now := time.Now()
+
+	for i := 0; i < sessionCachePruneSize; i++ {
+		cache.set(newCacheTestSession(strconv.Itoa(i), 1, now), now)
+	}
+
+	cache.set(newCacheTestSession("new", 1, now), now.Add(2*time.Second))
+
+	assert.Len(t, cache.entries, 1)
+}
+
+func TestSessionCache_Nil(t *testing.T) {
+	var cache *sessionCache
+
+	cache.set(newCacheTestSession("123", 1, time.Now()), time.Now())
+	cache.delete("123")
+	cache.deleteByPublicID("123")
+	cache.deleteByUserID(1)
+
+	_, ok := cache.get("123", time.Now())
+	assert.False(t, ok)
+}
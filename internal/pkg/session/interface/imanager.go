@@ -20,9 +20,15 @@ type SessionsManager interface {
 	// Check checks the validity of the session and CSRF token in the request.
 	Check(r *http.Request, ctx context.Context) (*api.Session, error)
 
-	// CheckLogin checks if the login associated with the session matches the provided login.
+	// CheckAPIToken checks the personal access token in the request and returns its owner.
+	CheckAPIToken(r *http.Request, ctx context.Context) (*api.APITokenOwner, error)
+
+	// CheckLogin checks if the session may act as the provided login, either its own or a shared mailbox.
 	CheckLogin(login string, r *http.Request, ctx context.Context) error
 
+	// CheckMailboxAccess checks if the session login has the permission in the mailbox and returns the session login.
+	CheckMailboxAccess(mailbox, permission string, r *http.Request, ctx context.Context) (string, error)
+
 	// GetLoginBySession retrieves the login associated with the session from the request.
 	GetLoginBySession(r *http.Request, ctx context.Context) (string, error)
 
@@ -30,8 +36,20 @@ type SessionsManager interface {
 	GetProfileIDBySessionID(r *http.Request, ctx context.Context) (uint32, error)
 
 	// Create creates a new session for the user and sets the session ID cookie in the response.
-	Create(w http.ResponseWriter, userID uint32, ctx context.Context) (*api.Session, error)
+	Create(w http.ResponseWriter, r *http.Request, userID uint32, ctx context.Context) (*api.Session, error)
+
+	// RotateCsrfToken replaces the CSRF token of the current session and sends the new one in the response.
+	RotateCsrfToken(w http.ResponseWriter, r *http.Request, ctx context.Context) error
 
 	// DestroyCurrent destroys the current session by deleting the session ID cookie from the response.
 	DestroyCurrent(w http.ResponseWriter, r *http.Request, ctx context.Context) error
+
+	// GetActiveSessions retrieves the active sessions of the user of the current session.
+	GetActiveSessions(r *http.Request, ctx context.Context) ([]*api.ActiveSession, error)
+
+	// DestroyByID destroys a session of the user of the current session by its public identifier.
+	DestroyByID(id string, r *http.Request, ctx context.Context) error
+
+	// DestroyOthers destroys every session of the user except the current one and returns their number.
+	DestroyOthers(r *http.Request, ctx context.Context) (int64, error)
 }
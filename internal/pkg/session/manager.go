@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"google.golang.org/grpc/metadata"
 	"net/http"
+	"strings"
 	"time"
 
+	domain "mail/internal/microservice/models/domain_models"
 	"mail/internal/microservice/models/proto_converters"
 	session_proto "mail/internal/microservice/session/proto"
+	user_proto "mail/internal/microservice/user/proto"
 	converters "mail/internal/models/delivery_converters"
 	api "mail/internal/models/delivery_models"
+	"mail/internal/pkg/logger"
+	domainSession "mail/internal/pkg/session/interface"
+	"mail/internal/pkg/utils/client_info"
 )
 
 // GlobalSessionManager is a global instance of SessionsManager.
@@ -18,9 +24,14 @@ var (
 	GlobalSessionManager = &SessionsManager{}
 )
 
+// apiTokenContextKey is the context key of the owner of the personal access token the request is made with.
+var apiTokenContextKey interface{} = "apiTokenOwner"
+
 // SessionsManager manages user sessions.
 type SessionsManager struct {
 	sessionServiceClient session_proto.SessionServiceClient
+	userServiceClient    user_proto.UserServiceClient
+	cache                *sessionCache
 }
 
 // InitializationGlobalSessionManager initializes the global session manager.
@@ -29,39 +40,104 @@ func InitializationGlobalSessionManager(sessionManager *SessionsManager) {
 }
 
 // NewSessionsManager creates a new instance of SessionsManager.
-func NewSessionsManager(sessionServiceClient session_proto.SessionServiceClient) *SessionsManager {
+func NewSessionsManager(sessionServiceClient session_proto.SessionServiceClient, userServiceClient user_proto.UserServiceClient) *SessionsManager {
 	return &SessionsManager{
 		sessionServiceClient: sessionServiceClient,
+		userServiceClient:    userServiceClient,
+		cache:                newSessionCache(sessionCacheLifeTime),
 	}
 }
 
-// SetSession set the session in the request.
-func (sm *SessionsManager) SetSession(sessionId string, w http.ResponseWriter, r *http.Request, ctx context.Context) error {
-	sess, errStatus := sm.sessionServiceClient.GetSession(
+// ForgetSessions removes the revoked sessions from the cache of this replica.
+// It is called for the session_revoked events, so a session revoked through any replica stops working here too.
+func (sm *SessionsManager) ForgetSessions(publicIDs []string) {
+	sm.cache.deleteByPublicID(publicIDs...)
+}
+
+// BearerToken returns the personal access token from the Authorization header of the request.
+func BearerToken(r *http.Request) (string, bool) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
+
+// WithAPITokenOwner returns a copy of the context in which the request acts for the owner of a personal access token.
+func WithAPITokenOwner(ctx context.Context, owner *api.APITokenOwner) context.Context {
+	return context.WithValue(ctx, apiTokenContextKey, owner)
+}
+
+// apiTokenOwner returns the owner of the personal access token the request is made with, or nil for a session.
+func apiTokenOwner(ctx context.Context) *api.APITokenOwner {
+	owner, _ := ctx.Value(apiTokenContextKey).(*api.APITokenOwner)
+
+	return owner
+}
+
+// CheckAPIToken checks the personal access token from the Authorization header and returns its owner.
+// Requests made with a token have no session, so there is no CSRF token to check.
+func (sm *SessionsManager) CheckAPIToken(r *http.Request, ctx context.Context) (*api.APITokenOwner, error) {
+	token, ok := BearerToken(r)
+	if !ok {
+		return nil, fmt.Errorf("api token not found in request headers")
+	}
+
+	ownerProto, errStatus := sm.userServiceClient.AuthenticateAPIToken(
 		metadata.NewOutgoingContext(ctx,
 			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
-		&session_proto.GetSessionRequest{SessionId: sessionId},
+		&user_proto.AuthenticateAPITokenRequest{Token: token},
 	)
 	if errStatus != nil {
-		return fmt.Errorf("session already exist")
+		return nil, fmt.Errorf("invalid api token")
 	}
 
-	w.Header().Set("X-Csrf-Token", sess.Session.CsrfToken)
+	return &api.APITokenOwner{UserID: ownerProto.Id, Login: ownerProto.Login, Scopes: ownerProto.Scopes}, nil
+}
 
+// newSessionCookie returns the cookie with the session ID.
+// The cookie of a remembered session outlives the browser until the maximum lifetime of the session,
+// other sessions end when the browser is closed.
+func newSessionCookie(session *domain.Session) *http.Cookie {
 	sessionCookie := &http.Cookie{
 		Name:     "session_id",
-		Value:    sess.Session.SessionId,
-		Expires:  time.Now().Add(24 * time.Hour),
+		Value:    session.ID,
 		Path:     "/",
 		HttpOnly: true,
 	}
-	http.SetCookie(w, sessionCookie)
+	if session.RememberMe() {
+		sessionCookie.Expires = session.CreationDate.Add(domain.SessionMaxLifeTime)
+	}
+
+	return sessionCookie
+}
+
+// SetSession set the session in the request.
+func (sm *SessionsManager) SetSession(sessionId string, w http.ResponseWriter, r *http.Request, ctx context.Context) error {
+	sess, errStatus := sm.sessionServiceClient.GetSession(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
+		&session_proto.GetSessionRequest{SessionId: sessionId},
+	)
+	if errStatus != nil {
+		return fmt.Errorf("session already exist")
+	}
+
+	w.Header().Set("X-Csrf-Token", sess.Session.CsrfToken)
+	http.SetCookie(w, newSessionCookie(proto_converters.SessionConvertProtoInCore(sess.Session)))
 
 	return nil
 }
 
 // GetSession retrieves the session from the request.
 func (sm *SessionsManager) GetSession(r *http.Request, ctx context.Context) *api.Session {
+	if owner := apiTokenOwner(ctx); owner != nil {
+		return &api.Session{UserID: owner.UserID}
+	}
+
 	sessionCookie, _ := r.Cookie("session_id")
 
 	sessionProto, errStatus := sm.sessionServiceClient.GetSession(
@@ -79,6 +155,8 @@ func (sm *SessionsManager) GetSession(r *http.Request, ctx context.Context) *api
 }
 
 // Check checks the validity of the session and CSRF token in the request.
+// Sessions checked in the last few seconds are taken from the cache of this process,
+// a CSRF token not matching the cached one is checked again with the session service as it may have been rotated.
 func (sm *SessionsManager) Check(r *http.Request, ctx context.Context) (*api.Session, error) {
 	csrfToken := r.Header.Get("X-Csrf-Token")
 	if r.URL.Path != "/api/v1/verify-auth" && csrfToken == "" {
@@ -90,17 +168,22 @@ func (sm *SessionsManager) Check(r *http.Request, ctx context.Context) (*api.Ses
 		return nil, fmt.Errorf("no session found")
 	}
 
-	sessionProto, errStatus := sm.sessionServiceClient.GetSession(
-		metadata.NewOutgoingContext(ctx,
-			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
-		&session_proto.GetSessionRequest{SessionId: sessionCookie.Value},
-	)
-	if errStatus != nil {
-		return nil, fmt.Errorf("no session found")
+	sessionCore, ok := sm.cache.get(sessionCookie.Value, time.Now())
+	if !ok || (r.URL.Path != "/api/v1/verify-auth" && sessionCore.CsrfToken != csrfToken) {
+		sessionProto, errStatus := sm.sessionServiceClient.GetSession(
+			metadata.NewOutgoingContext(ctx,
+				metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
+			&session_proto.GetSessionRequest{SessionId: sessionCookie.Value},
+		)
+		if errStatus != nil {
+			sm.cache.delete(sessionCookie.Value)
+			return nil, fmt.Errorf("no session found")
+		}
+
+		sessionCore = proto_converters.SessionConvertProtoInCore(sessionProto.Session)
+		sm.cache.set(sessionCore, time.Now())
 	}
 
-	sessionCore := proto_converters.SessionConvertProtoInCore(sessionProto.Session)
-
 	if r.URL.Path != "/api/v1/verify-auth" && sessionCore.CsrfToken != csrfToken {
 		return nil, fmt.Errorf("CSRF token mismatch")
 	}
@@ -108,28 +191,53 @@ func (sm *SessionsManager) Check(r *http.Request, ctx context.Context) (*api.Ses
 	return converters.SessionConvertCoreInApi(sessionCore), nil
 }
 
-// CheckLogin checks if the login associated with the session matches the provided login.
+// CheckLogin checks if the session may act as the provided login: the login must be the session login
+// or a shared mailbox the session login is allowed to send from.
 func (sm *SessionsManager) CheckLogin(login string, r *http.Request, ctx context.Context) error {
+	_, err := sm.CheckMailboxAccess(login, domain.MailboxPermissionWrite, r, ctx)
+	if err != nil {
+		return fmt.Errorf("no right sender email")
+	}
+
+	return nil
+}
+
+// CheckMailboxAccess checks if the session login has the permission in the mailbox and returns the session login.
+// The owner of the mailbox has every permission, delegates have the permissions of their role.
+// A personal access token gives access to the mailbox of its owner only, never to the delegated ones.
+func (sm *SessionsManager) CheckMailboxAccess(mailbox, permission string, r *http.Request, ctx context.Context) (string, error) {
+	if owner := apiTokenOwner(ctx); owner != nil {
+		if owner.Login != mailbox {
+			return "", fmt.Errorf("no %s access to mailbox %s", permission, mailbox)
+		}
+
+		return owner.Login, nil
+	}
+
 	sessionCookie, _ := r.Cookie("session_id")
 
-	loginProto, errStatus := sm.sessionServiceClient.GetLoginBySession(
+	roleProto, errStatus := sm.sessionServiceClient.GetMailboxRole(
 		metadata.NewOutgoingContext(ctx,
 			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
-		&session_proto.GetLoginBySessionRequest{SessionId: sessionCookie.Value},
+		&session_proto.GetMailboxRoleRequest{SessionId: sessionCookie.Value, Mailbox: mailbox},
 	)
 	if errStatus != nil {
-		return errStatus
+		return "", errStatus
 	}
 
-	if loginProto.Login != login {
-		return fmt.Errorf("no right sender email")
+	if !domain.MailboxRoleAllows(roleProto.Role, permission) {
+		return "", fmt.Errorf("no %s access to mailbox %s", permission, mailbox)
 	}
 
-	return nil
+	return roleProto.Login, nil
 }
 
 // GetLoginBySession retrieves the login associated with the session from the request.
 func (sm SessionsManager) GetLoginBySession(r *http.Request, ctx context.Context) (string, error) {
+	if owner := apiTokenOwner(ctx); owner != nil {
+		return owner.Login, nil
+	}
+
 	sessionCookie, _ := r.Cookie("session_id")
 
 	loginProto, errStatus := sm.sessionServiceClient.GetLoginBySession(
@@ -146,6 +254,10 @@ func (sm SessionsManager) GetLoginBySession(r *http.Request, ctx context.Context
 
 // GetProfileIDBySessionID retrieves the profile ID associated with the given session ID from the session service.
 func (sm SessionsManager) GetProfileIDBySessionID(r *http.Request, ctx context.Context) (uint32, error) {
+	if owner := apiTokenOwner(ctx); owner != nil {
+		return owner.UserID, nil
+	}
+
 	sessionCookie, _ := r.Cookie("session_id")
 
 	idProto, errStatus := sm.sessionServiceClient.GetProfileIDBySession(
@@ -161,13 +273,14 @@ func (sm SessionsManager) GetProfileIDBySessionID(r *http.Request, ctx context.C
 }
 
 // Create creates a new session for the user and sets the session ID cookie in the response.
-func (sm *SessionsManager) Create(w http.ResponseWriter, userID uint32, ctx context.Context) (*api.Session, error) {
+func (sm *SessionsManager) Create(w http.ResponseWriter, r *http.Request, userID uint32, ctx context.Context) (*api.Session, error) {
 	sessionId, errStatus := sm.sessionServiceClient.CreateSession(
 		metadata.NewOutgoingContext(ctx,
 			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
 		&session_proto.CreateSessionRequest{Session: &session_proto.Session{UserId: userID,
-			Device:   "",
-			LifeTime: 60 * 60 * 24},
+			Device:    r.UserAgent(),
+			IpAddress: client_info.ClientIP(r),
+			LifeTime:  int32(domain.SessionLifeTimeSeconds(false))},
 		},
 	)
 	if errStatus != nil {
@@ -184,21 +297,44 @@ func (sm *SessionsManager) Create(w http.ResponseWriter, userID uint32, ctx cont
 	}
 
 	w.Header().Set("X-Csrf-Token", sess.Session.CsrfToken)
-
-	sessionCookie := &http.Cookie{
-		Name:     "session_id",
-		Value:    sess.Session.SessionId,
-		Expires:  time.Now().Add(24 * time.Hour),
-		Path:     "/",
-		HttpOnly: true,
-	}
-	http.SetCookie(w, sessionCookie)
+	http.SetCookie(w, newSessionCookie(proto_converters.SessionConvertProtoInCore(sess.Session)))
 
 	sessionCore := proto_converters.SessionConvertProtoInCore(sess.Session)
 
 	return converters.SessionConvertCoreInApi(sessionCore), nil
 }
 
+// RotateCsrfToken replaces the CSRF token of the current session and sends the new one in the response.
+// It is called after privilege-sensitive actions so a leaked token stops working.
+func (sm *SessionsManager) RotateCsrfToken(w http.ResponseWriter, r *http.Request, ctx context.Context) error {
+	sessionCookie, err := r.Cookie("session_id")
+	if err != nil {
+		return fmt.Errorf("no session found")
+	}
+
+	csrfProto, errStatus := sm.sessionServiceClient.RotateCsrfToken(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
+		&session_proto.RotateCsrfTokenRequest{SessionId: sessionCookie.Value},
+	)
+	sm.cache.delete(sessionCookie.Value)
+	if errStatus != nil {
+		return fmt.Errorf("failed to rotate csrf token")
+	}
+
+	w.Header().Set("X-Csrf-Token", csrfProto.CsrfToken)
+
+	return nil
+}
+
+// RotateCsrfTokenAfterAction rotates the CSRF token after a privilege-sensitive action which has already succeeded.
+// The old token keeps working if the rotation fails, so the failure is only logged and the response still reports the action.
+func RotateCsrfTokenAfterAction(sessions domainSession.SessionsManager, w http.ResponseWriter, r *http.Request) {
+	if err := sessions.RotateCsrfToken(w, r, r.Context()); err != nil {
+		logger.LogRequestError(r.Context(), "failed to rotate csrf token", err)
+	}
+}
+
 // DestroyCurrent destroys the current session by deleting the session ID cookie from the response.
 func (sm *SessionsManager) DestroyCurrent(w http.ResponseWriter, r *http.Request, ctx context.Context) error {
 	sessionCookie, err := r.Cookie("session_id")
@@ -211,6 +347,7 @@ func (sm *SessionsManager) DestroyCurrent(w http.ResponseWriter, r *http.Request
 			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
 		&session_proto.DeleteSessionRequest{SessionId: sessionCookie.Value},
 	)
+	sm.cache.delete(sessionCookie.Value)
 	if errStatus != nil {
 		return fmt.Errorf("session delete fail")
 	}
@@ -228,3 +365,79 @@ func (sm *SessionsManager) DestroyCurrent(w http.ResponseWriter, r *http.Request
 
 	return nil
 }
+
+// GetActiveSessions retrieves the active sessions of the user of the current session.
+func (sm *SessionsManager) GetActiveSessions(r *http.Request, ctx context.Context) ([]*api.ActiveSession, error) {
+	sessionCookie, err := r.Cookie("session_id")
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsProto, errStatus := sm.sessionServiceClient.GetUserSessions(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
+		&session_proto.GetUserSessionsRequest{SessionId: sessionCookie.Value},
+	)
+	if errStatus != nil {
+		return nil, fmt.Errorf("failed to get sessions")
+	}
+
+	sessions := make([]*api.ActiveSession, 0, len(sessionsProto.Sessions))
+	for _, sessionProto := range sessionsProto.Sessions {
+		sessions = append(sessions, &api.ActiveSession{
+			ID:           sessionProto.Id,
+			Device:       sessionProto.Device,
+			IPAddress:    sessionProto.IpAddress,
+			CreationDate: sessionProto.CreationDate.AsTime(),
+			LastSeenDate: sessionProto.LastSeenDate.AsTime(),
+			Current:      sessionProto.Current,
+		})
+	}
+
+	return sessions, nil
+}
+
+// DestroyByID destroys a session of the user of the current session by its public identifier.
+func (sm *SessionsManager) DestroyByID(id string, r *http.Request, ctx context.Context) error {
+	sessionCookie, err := r.Cookie("session_id")
+	if err != nil {
+		return err
+	}
+
+	status, errStatus := sm.sessionServiceClient.DeleteUserSession(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
+		&session_proto.DeleteUserSessionRequest{SessionId: sessionCookie.Value, Id: id},
+	)
+	if errStatus != nil || !status.Status {
+		return fmt.Errorf("no session found")
+	}
+
+	sm.cache.deleteByPublicID(id)
+
+	return nil
+}
+
+// DestroyOthers destroys every session of the user except the current one and returns their number.
+func (sm *SessionsManager) DestroyOthers(r *http.Request, ctx context.Context) (int64, error) {
+	sessionCookie, err := r.Cookie("session_id")
+	if err != nil {
+		return 0, err
+	}
+
+	reply, errStatus := sm.sessionServiceClient.DeleteOtherSessions(
+		metadata.NewOutgoingContext(ctx,
+			metadata.New(map[string]string{"requestID": ctx.Value("requestID").(string)})),
+		&session_proto.DeleteOtherSessionsRequest{SessionId: sessionCookie.Value},
+	)
+	if errStatus != nil {
+		return 0, fmt.Errorf("session delete fail")
+	}
+
+	// The other replicas forget the sessions when the session service publishes their revocation.
+	if current, ok := sm.cache.get(sessionCookie.Value, time.Now()); ok {
+		sm.cache.deleteByUserID(current.UserID)
+	}
+
+	return reply.Count, nil
+}
@@ -11,11 +11,16 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	domain "mail/internal/microservice/models/domain_models"
 	"mail/internal/microservice/session/mock"
 	"mail/internal/pkg/session"
 
 	session_proto "mail/internal/microservice/session/proto"
+	user_mock "mail/internal/microservice/user/mock"
+	user_proto "mail/internal/microservice/user/proto"
+	api "mail/internal/models/delivery_models"
 )
 
 func TestSessionsManager_SetSession_Success(t *testing.T) {
@@ -24,7 +29,7 @@ func TestSessionsManager_SetSession_Success(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).
 		Return(&session_proto.GetSessionReply{
@@ -58,13 +63,44 @@ func TestSessionsManager_SetSession_Success(t *testing.T) {
 	}
 }
 
+func TestSessionsManager_SetSession_RememberMe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	creationDate := time.Now().Truncate(time.Second)
+
+	mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetSessionReply{
+			Session: &session_proto.Session{
+				SessionId:    "123",
+				CsrfToken:    "csrfToken",
+				CreationDate: timestamppb.New(creationDate),
+				LifeTime:     int32(domain.SessionLifeTimeSeconds(true)),
+			},
+		}, nil)
+
+	rr := httptest.NewRecorder()
+
+	err := sm.SetSession("123", rr, httptest.NewRequest("POST", "/api/v1/auth/login", nil),
+		context.WithValue(context.Background(), "requestID", "testID"))
+	assert.NoError(t, err)
+
+	cookie := rr.Result().Cookies()[0]
+	assert.Equal(t, "123", cookie.Value)
+	assert.WithinDuration(t, creationDate.Add(domain.SessionMaxLifeTime), cookie.Expires, time.Second)
+}
+
 func TestSessionsManager_SetSession_SessionServiceError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).
 		Return(nil, fmt.Errorf("session not found"))
@@ -86,7 +122,7 @@ func TestSessionsManager_GetSession_Success(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -121,7 +157,7 @@ func TestSessionsManager_GetSession_SessionNotFound(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -145,7 +181,7 @@ func TestSessionsManager_Check_Success(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -175,13 +211,89 @@ func TestSessionsManager_Check_Success(t *testing.T) {
 	}
 }
 
+func TestSessionsManager_Check_Cached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	ctx := context.WithValue(context.Background(), "requestID", "testID")
+
+	newRequest := func(csrfToken string) *http.Request {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+		req.Header.Set("X-Csrf-Token", csrfToken)
+
+		return req
+	}
+
+	newReply := func(csrfToken string) *session_proto.GetSessionReply {
+		return &session_proto.GetSessionReply{
+			Session: &session_proto.Session{
+				SessionId:    "123",
+				UserId:       1,
+				CsrfToken:    csrfToken,
+				CreationDate: timestamppb.Now(),
+				LastSeenDate: timestamppb.Now(),
+				LifeTime:     3600,
+			},
+		}
+	}
+
+	mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).Return(newReply("csrfToken"), nil).Times(1)
+
+	for i := 0; i < 3; i++ {
+		sessionTest, err := sm.Check(newRequest("csrfToken"), ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), sessionTest.UserID)
+	}
+
+	t.Run("RotatedCsrfToken", func(t *testing.T) {
+		// The token was rotated through another replica, the cached session still has the old one.
+		// Every mismatch is checked again with the session service, the old token is then rejected.
+		mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).Return(newReply("rotated"), nil).Times(2)
+
+		_, err := sm.Check(newRequest("rotated"), ctx)
+		assert.NoError(t, err)
+
+		_, err = sm.Check(newRequest("csrfToken"), ctx)
+		assert.EqualError(t, err, "CSRF token mismatch")
+	})
+
+	t.Run("RevokedByOtherReplica", func(t *testing.T) {
+		sm.ForgetSessions([]string{domain.SessionPublicID("123")})
+
+		mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).Return(nil, errors.New("session not found"))
+
+		_, err := sm.Check(newRequest("rotated"), ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Logout", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).Return(newReply("rotated"), nil)
+		_, err := sm.Check(newRequest("rotated"), ctx)
+		assert.NoError(t, err)
+
+		mockSessionServiceClient.EXPECT().DeleteSession(gomock.Any(), gomock.Any()).
+			Return(&session_proto.DeleteSessionReply{Status: true}, nil)
+		assert.NoError(t, sm.DestroyCurrent(httptest.NewRecorder(), newRequest("rotated"), ctx))
+
+		mockSessionServiceClient.EXPECT().GetSession(gomock.Any(), gomock.Any()).Return(nil, errors.New("session not found"))
+
+		_, err = sm.Check(newRequest("rotated"), ctx)
+		assert.Error(t, err)
+	})
+}
+
 func TestSessionsManager_Check_NoCsrf(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -200,7 +312,7 @@ func TestSessionsManager_Check_NoSession(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -220,7 +332,7 @@ func TestSessionsManager_Check_ErrorNotSession(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -245,7 +357,7 @@ func TestSessionsManager_Check_ErrorCsrf(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -274,7 +386,7 @@ func TestSessionsManager_CheckLogin_Success(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -282,8 +394,8 @@ func TestSessionsManager_CheckLogin_Success(t *testing.T) {
 	}
 	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
 
-	mockSessionServiceClient.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).
-		Return(&session_proto.GetLoginBySessionReply{Login: "user@mailhub.su"}, nil)
+	mockSessionServiceClient.EXPECT().GetMailboxRole(gomock.Any(), &session_proto.GetMailboxRoleRequest{SessionId: "123", Mailbox: "user@mailhub.su"}).
+		Return(&session_proto.GetMailboxRoleReply{Login: "user@mailhub.su", Role: "owner"}, nil)
 
 	err = sm.CheckLogin("user@mailhub.su", req, context.WithValue(context.Background(), "requestID", "testID"))
 
@@ -296,7 +408,7 @@ func TestSessionsManager_CheckLogin_WrongLogin(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -304,21 +416,73 @@ func TestSessionsManager_CheckLogin_WrongLogin(t *testing.T) {
 	}
 	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
 
-	mockSessionServiceClient.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).
-		Return(&session_proto.GetLoginBySessionReply{Login: "anotheruser@mailhub.su"}, nil)
+	mockSessionServiceClient.EXPECT().GetMailboxRole(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetMailboxRoleReply{Login: "anotheruser@mailhub.su", Role: ""}, nil)
 
 	err = sm.CheckLogin("user@mailhub.su", req, context.WithValue(context.Background(), "requestID", "testID"))
 
 	assert.Error(t, err)
 }
 
+func TestSessionsManager_CheckLogin_SharedMailbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	req, err := http.NewRequest("GET", "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+	ctx := context.WithValue(context.Background(), "requestID", "testID")
+
+	mockSessionServiceClient.EXPECT().GetMailboxRole(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetMailboxRoleReply{Login: "user@mailhub.su", Role: "send_on_behalf"}, nil)
+	assert.NoError(t, sm.CheckLogin("support@mailhub.su", req, ctx))
+
+	mockSessionServiceClient.EXPECT().GetMailboxRole(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetMailboxRoleReply{Login: "user@mailhub.su", Role: "read"}, nil)
+	assert.Error(t, sm.CheckLogin("support@mailhub.su", req, ctx))
+}
+
+func TestSessionsManager_CheckMailboxAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	req, err := http.NewRequest("GET", "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+	ctx := context.WithValue(context.Background(), "requestID", "testID")
+
+	mockSessionServiceClient.EXPECT().GetMailboxRole(gomock.Any(), gomock.Any()).
+		Return(&session_proto.GetMailboxRoleReply{Login: "user@mailhub.su", Role: "read"}, nil)
+	login, err := sm.CheckMailboxAccess("support@mailhub.su", "read", req, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@mailhub.su", login)
+
+	mockSessionServiceClient.EXPECT().GetMailboxRole(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("session not found"))
+	login, err = sm.CheckMailboxAccess("support@mailhub.su", "read", req, ctx)
+	assert.Error(t, err)
+	assert.Empty(t, login)
+}
+
 func TestSessionsManager_GetLoginBySession_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -346,7 +510,7 @@ func TestSessionsManager_GetLoginBySession_NoSessionCookie(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -373,7 +537,7 @@ func TestSessionsManager_GetProfileIDBySessionID_Success(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -396,7 +560,7 @@ func TestSessionsManager_GetProfileIDBySessionID_Error(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req, err := http.NewRequest("GET", "/test", nil)
 	if err != nil {
@@ -419,7 +583,7 @@ func TestSessionsManager_Create_Success(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	mockSessionServiceClient.EXPECT().
 		CreateSession(gomock.Any(), gomock.Any()).
@@ -440,7 +604,7 @@ func TestSessionsManager_Create_Success(t *testing.T) {
 
 	ctx := context.WithValue(context.Background(), "requestID", "testID")
 
-	sessionTest, err := sm.Create(w, 123, ctx)
+	sessionTest, err := sm.Create(w, httptest.NewRequest("POST", "/api/v1/auth/login", nil), 123, ctx)
 
 	assert.NoError(t, err)
 
@@ -449,7 +613,7 @@ func TestSessionsManager_Create_Success(t *testing.T) {
 	cookie := w.Result().Cookies()[0]
 	assert.Equal(t, "session_id", cookie.Name)
 	assert.Equal(t, "123", cookie.Value)
-	assert.WithinDuration(t, time.Now().Add(24*time.Hour), cookie.Expires, 2*time.Second)
+	assert.True(t, cookie.Expires.IsZero())
 
 	assert.Equal(t, "123", sessionTest.ID)
 	assert.Equal(t, "csrfToken", sessionTest.CsrfToken)
@@ -461,7 +625,7 @@ func TestSessionsManager_Create_SessionCreateError(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	mockSessionServiceClient.EXPECT().CreateSession(gomock.Any(), gomock.Any()).
 		Return(nil, fmt.Errorf("session already exists"))
@@ -470,7 +634,7 @@ func TestSessionsManager_Create_SessionCreateError(t *testing.T) {
 
 	ctx := context.WithValue(context.Background(), "requestID", "testID")
 
-	sessionTest, err := sm.Create(w, 123, ctx)
+	sessionTest, err := sm.Create(w, httptest.NewRequest("POST", "/api/v1/auth/login", nil), 123, ctx)
 
 	assert.Error(t, err)
 	assert.Nil(t, sessionTest)
@@ -482,7 +646,7 @@ func TestSessionsManager_Create_SessionGetError(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	mockSessionServiceClient.EXPECT().
 		CreateSession(gomock.Any(), gomock.Any()).
@@ -498,7 +662,7 @@ func TestSessionsManager_Create_SessionGetError(t *testing.T) {
 
 	ctx := context.WithValue(context.Background(), "requestID", "testID")
 
-	sessionTest, err := sm.Create(w, 123, ctx)
+	sessionTest, err := sm.Create(w, httptest.NewRequest("POST", "/api/v1/auth/login", nil), 123, ctx)
 
 	assert.Error(t, err)
 	assert.Nil(t, sessionTest)
@@ -510,7 +674,7 @@ func TestSessionsManager_DestroyCurrent_Success(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req := httptest.NewRequest("GET", "/", nil)
 	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
@@ -537,7 +701,7 @@ func TestSessionsManager_DestroyCurrent_NoSessionCookie(t *testing.T) {
 
 	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
 
-	sm := session.NewSessionsManager(mockSessionServiceClient)
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
 
 	req := httptest.NewRequest("GET", "/", nil)
 
@@ -548,3 +712,204 @@ func TestSessionsManager_DestroyCurrent_NoSessionCookie(t *testing.T) {
 	assert.Error(t, err)
 	assert.EqualError(t, err, "http: named cookie not present")
 }
+
+func TestSessionsManager_Create_RecordsClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	req := httptest.NewRequest("POST", "/api/v1/auth/login", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.RemoteAddr = "10.0.0.1:54321"
+
+	mockSessionServiceClient.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, in *session_proto.CreateSessionRequest, _ ...interface{}) (*session_proto.CreateSessionReply, error) {
+			assert.Equal(t, "Mozilla/5.0", in.Session.Device)
+			assert.Equal(t, "10.0.0.1", in.Session.IpAddress)
+			return nil, errors.New("stop")
+		})
+
+	_, err := sm.Create(httptest.NewRecorder(), req, 123, context.WithValue(context.Background(), "requestID", "testID"))
+
+	assert.Error(t, err)
+}
+
+func TestSessionsManager_GetActiveSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+
+	mockSessionServiceClient.EXPECT().
+		GetUserSessions(gomock.Any(), &session_proto.GetUserSessionsRequest{SessionId: "123"}).
+		Return(&session_proto.GetUserSessionsReply{Sessions: []*session_proto.ActiveSession{
+			{Id: "public", Device: "Mozilla/5.0", IpAddress: "10.0.0.1", Current: true},
+		}}, nil)
+
+	sessions, err := sm.GetActiveSessions(req, context.WithValue(context.Background(), "requestID", "testID"))
+
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "public", sessions[0].ID)
+	assert.True(t, sessions[0].Current)
+}
+
+func TestSessionsManager_DestroyByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	req := httptest.NewRequest("DELETE", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+	ctx := context.WithValue(context.Background(), "requestID", "testID")
+
+	mockSessionServiceClient.EXPECT().
+		DeleteUserSession(gomock.Any(), &session_proto.DeleteUserSessionRequest{SessionId: "123", Id: "public"}).
+		Return(&session_proto.DeleteSessionReply{Status: true}, nil)
+	mockSessionServiceClient.EXPECT().
+		DeleteUserSession(gomock.Any(), &session_proto.DeleteUserSessionRequest{SessionId: "123", Id: "unknown"}).
+		Return(&session_proto.DeleteSessionReply{Status: false}, errors.New("session not found"))
+
+	assert.NoError(t, sm.DestroyByID("public", req, ctx))
+	assert.Error(t, sm.DestroyByID("unknown", req, ctx))
+}
+
+func TestSessionsManager_DestroyOthers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	req := httptest.NewRequest("POST", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+
+	mockSessionServiceClient.EXPECT().
+		DeleteOtherSessions(gomock.Any(), &session_proto.DeleteOtherSessionsRequest{SessionId: "123"}).
+		Return(&session_proto.DeleteOtherSessionsReply{Count: 2}, nil)
+
+	count, err := sm.DestroyOthers(req, context.WithValue(context.Background(), "requestID", "testID"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestBearerToken(t *testing.T) {
+	req := httptest.NewRequest("GET", "/api/v1/emails/incoming", nil)
+	_, ok := session.BearerToken(req)
+	assert.False(t, ok)
+
+	req.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	_, ok = session.BearerToken(req)
+	assert.False(t, ok)
+
+	req.Header.Set("Authorization", "Bearer mhp_secret")
+	token, ok := session.BearerToken(req)
+	assert.True(t, ok)
+	assert.Equal(t, "mhp_secret", token)
+}
+
+func TestSessionsManager_CheckAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := user_mock.NewMockUserServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(nil, mockUserServiceClient)
+	ctx := context.WithValue(context.Background(), "requestID", "testID")
+
+	req := httptest.NewRequest("GET", "/api/v1/emails/incoming", nil)
+	req.Header.Set("Authorization", "Bearer mhp_secret")
+
+	mockUserServiceClient.EXPECT().AuthenticateAPIToken(gomock.Any(), &user_proto.AuthenticateAPITokenRequest{Token: "mhp_secret"}).
+		Return(&user_proto.AuthenticateAPITokenReply{Id: 1, Login: "user@mailhub.su", Scopes: []string{"mail:read"}}, nil)
+	owner, err := sm.CheckAPIToken(req, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &api.APITokenOwner{UserID: 1, Login: "user@mailhub.su", Scopes: []string{"mail:read"}}, owner)
+
+	mockUserServiceClient.EXPECT().AuthenticateAPIToken(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid api token"))
+	_, err = sm.CheckAPIToken(req, ctx)
+	assert.Error(t, err)
+
+	_, err = sm.CheckAPIToken(httptest.NewRequest("GET", "/api/v1/emails/incoming", nil), ctx)
+	assert.Error(t, err)
+}
+
+func TestSessionsManager_APITokenOwner(t *testing.T) {
+	sm := session.NewSessionsManager(nil, nil)
+	owner := &api.APITokenOwner{UserID: 1, Login: "user@mailhub.su", Scopes: []string{"mail:read"}}
+	ctx := session.WithAPITokenOwner(context.WithValue(context.Background(), "requestID", "testID"), owner)
+	req := httptest.NewRequest("GET", "/api/v1/emails/incoming", nil).WithContext(ctx)
+
+	assert.Equal(t, uint32(1), sm.GetSession(req, ctx).UserID)
+
+	login, err := sm.GetLoginBySession(req, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@mailhub.su", login)
+
+	profileID, err := sm.GetProfileIDBySessionID(req, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), profileID)
+
+	login, err = sm.CheckMailboxAccess("user@mailhub.su", "write", req, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@mailhub.su", login)
+
+	_, err = sm.CheckMailboxAccess("support@mailhub.su", "read", req, ctx)
+	assert.Error(t, err)
+}
+
+func TestSessionsManager_RotateCsrfToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionServiceClient := mock.NewMockSessionServiceClient(ctrl)
+
+	sm := session.NewSessionsManager(mockSessionServiceClient, nil)
+
+	ctx := context.WithValue(context.Background(), "requestID", "testID")
+
+	t.Run("Success", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().
+			RotateCsrfToken(gomock.Any(), &session_proto.RotateCsrfTokenRequest{SessionId: "123"}).
+			Return(&session_proto.RotateCsrfTokenReply{CsrfToken: "newCsrfToken"}, nil)
+
+		req := httptest.NewRequest("POST", "/api/v1/user/password", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+		w := httptest.NewRecorder()
+
+		assert.NoError(t, sm.RotateCsrfToken(w, req, ctx))
+		assert.Equal(t, "newCsrfToken", w.Header().Get("X-Csrf-Token"))
+	})
+
+	t.Run("NoSessionCookie", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		assert.Error(t, sm.RotateCsrfToken(w, httptest.NewRequest("POST", "/api/v1/user/password", nil), ctx))
+		assert.Empty(t, w.Header().Get("X-Csrf-Token"))
+	})
+
+	t.Run("ServiceError", func(t *testing.T) {
+		mockSessionServiceClient.EXPECT().
+			RotateCsrfToken(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("session not found"))
+
+		req := httptest.NewRequest("POST", "/api/v1/user/password", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "123"})
+
+		assert.Error(t, sm.RotateCsrfToken(httptest.NewRecorder(), req, ctx))
+	})
+}
@@ -51,6 +51,21 @@ func (mr *MockSessionsManagerMockRecorder) Check(r, ctx interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockSessionsManager)(nil).Check), r, ctx)
 }
 
+// CheckAPIToken mocks base method.
+func (m *MockSessionsManager) CheckAPIToken(r *http.Request, ctx context.Context) (*delivery_models.APITokenOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAPIToken", r, ctx)
+	ret0, _ := ret[0].(*delivery_models.APITokenOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAPIToken indicates an expected call of CheckAPIToken.
+func (mr *MockSessionsManagerMockRecorder) CheckAPIToken(r, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAPIToken", reflect.TypeOf((*MockSessionsManager)(nil).CheckAPIToken), r, ctx)
+}
+
 // CheckLogin mocks base method.
 func (m *MockSessionsManager) CheckLogin(login string, r *http.Request, ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -65,19 +80,48 @@ func (mr *MockSessionsManagerMockRecorder) CheckLogin(login, r, ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLogin", reflect.TypeOf((*MockSessionsManager)(nil).CheckLogin), login, r, ctx)
 }
 
+// CheckMailboxAccess mocks base method.
+func (m *MockSessionsManager) CheckMailboxAccess(mailbox, permission string, r *http.Request, ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckMailboxAccess", mailbox, permission, r, ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckMailboxAccess indicates an expected call of CheckMailboxAccess.
+func (mr *MockSessionsManagerMockRecorder) CheckMailboxAccess(mailbox, permission, r, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMailboxAccess", reflect.TypeOf((*MockSessionsManager)(nil).CheckMailboxAccess), mailbox, permission, r, ctx)
+}
+
 // Create mocks base method.
-func (m *MockSessionsManager) Create(w http.ResponseWriter, userID uint32, ctx context.Context) (*delivery_models.Session, error) {
+func (m *MockSessionsManager) Create(w http.ResponseWriter, r *http.Request, userID uint32, ctx context.Context) (*delivery_models.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", w, userID, ctx)
+	ret := m.ctrl.Call(m, "Create", w, r, userID, ctx)
 	ret0, _ := ret[0].(*delivery_models.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockSessionsManagerMockRecorder) Create(w, userID, ctx interface{}) *gomock.Call {
+func (mr *MockSessionsManagerMockRecorder) Create(w, r, userID, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionsManager)(nil).Create), w, r, userID, ctx)
+}
+
+// DestroyByID mocks base method.
+func (m *MockSessionsManager) DestroyByID(id string, r *http.Request, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyByID", id, r, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyByID indicates an expected call of DestroyByID.
+func (mr *MockSessionsManagerMockRecorder) DestroyByID(id, r, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionsManager)(nil).Create), w, userID, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyByID", reflect.TypeOf((*MockSessionsManager)(nil).DestroyByID), id, r, ctx)
 }
 
 // DestroyCurrent mocks base method.
@@ -94,6 +138,36 @@ func (mr *MockSessionsManagerMockRecorder) DestroyCurrent(w, r, ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyCurrent", reflect.TypeOf((*MockSessionsManager)(nil).DestroyCurrent), w, r, ctx)
 }
 
+// DestroyOthers mocks base method.
+func (m *MockSessionsManager) DestroyOthers(r *http.Request, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyOthers", r, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyOthers indicates an expected call of DestroyOthers.
+func (mr *MockSessionsManagerMockRecorder) DestroyOthers(r, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyOthers", reflect.TypeOf((*MockSessionsManager)(nil).DestroyOthers), r, ctx)
+}
+
+// GetActiveSessions mocks base method.
+func (m *MockSessionsManager) GetActiveSessions(r *http.Request, ctx context.Context) ([]*delivery_models.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveSessions", r, ctx)
+	ret0, _ := ret[0].([]*delivery_models.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveSessions indicates an expected call of GetActiveSessions.
+func (mr *MockSessionsManagerMockRecorder) GetActiveSessions(r, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSessions", reflect.TypeOf((*MockSessionsManager)(nil).GetActiveSessions), r, ctx)
+}
+
 // GetLoginBySession mocks base method.
 func (m *MockSessionsManager) GetLoginBySession(r *http.Request, ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +212,20 @@ func (mr *MockSessionsManagerMockRecorder) GetSession(r, ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionsManager)(nil).GetSession), r, ctx)
 }
 
+// RotateCsrfToken mocks base method.
+func (m *MockSessionsManager) RotateCsrfToken(w http.ResponseWriter, r *http.Request, ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCsrfToken", w, r, ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateCsrfToken indicates an expected call of RotateCsrfToken.
+func (mr *MockSessionsManagerMockRecorder) RotateCsrfToken(w, r, ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCsrfToken", reflect.TypeOf((*MockSessionsManager)(nil).RotateCsrfToken), w, r, ctx)
+}
+
 // SetSession mocks base method.
 func (m *MockSessionsManager) SetSession(sessionId string, w http.ResponseWriter, r *http.Request, ctx context.Context) error {
 	m.ctrl.T.Helper()
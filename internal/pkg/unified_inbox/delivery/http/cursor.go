@@ -0,0 +1,71 @@
+package http
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+// encodeCursor returns the opaque cursor of the page which starts after the email.
+// The cursor keeps the position of the email rather than an offset, so the pages stay stable when new mail arrives.
+func encodeCursor(email *api.UnifiedEmail) string {
+	position := fmt.Sprintf("%d|%s|%s", email.DateOfDispatch.UnixNano(), email.Source, email.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(position))
+}
+
+// decodeCursor returns the position of the email the page starts after.
+func decodeCursor(cursor string) (*api.UnifiedEmail, error) {
+	position, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+
+	parts := strings.SplitN(string(position), "|", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	date, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor date: %v", err)
+	}
+
+	switch parts[1] {
+	case domain.UnifiedSourceMailHub:
+		if _, err := strconv.ParseUint(parts[2], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid cursor id: %v", err)
+		}
+	case domain.UnifiedSourceGMail:
+		if parts[2] == "" {
+			return nil, fmt.Errorf("invalid cursor id")
+		}
+	default:
+		return nil, fmt.Errorf("invalid cursor source: %s", parts[1])
+	}
+
+	return &api.UnifiedEmail{DateOfDispatch: time.Unix(0, date), Source: parts[1], ID: parts[2]}, nil
+}
+
+// before reports whether the email goes before the other one in the unified inbox.
+// The newest emails go first, the emails of the same date are ordered by the mailbox and then by the ID,
+// so the order is total and a cursor points to exactly one place in it.
+func before(email, other *api.UnifiedEmail) bool {
+	if !email.DateOfDispatch.Equal(other.DateOfDispatch) {
+		return email.DateOfDispatch.After(other.DateOfDispatch)
+	}
+	if email.Source != other.Source {
+		return email.Source < other.Source
+	}
+	if email.Source == domain.UnifiedSourceMailHub {
+		id, _ := strconv.ParseUint(email.ID, 10, 64)
+		otherID, _ := strconv.ParseUint(other.ID, 10, 64)
+		return id > otherID
+	}
+
+	return email.ID > other.ID
+}
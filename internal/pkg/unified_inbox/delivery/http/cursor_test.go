@@ -0,0 +1,41 @@
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	api "mail/internal/models/delivery_models"
+)
+
+func TestCursor(t *testing.T) {
+	email := &api.UnifiedEmail{ID: "18c1", Source: domain.UnifiedSourceGMail, DateOfDispatch: time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC)}
+
+	position, err := decodeCursor(encodeCursor(email))
+	assert.NoError(t, err)
+	assert.True(t, position.DateOfDispatch.Equal(email.DateOfDispatch))
+	assert.Equal(t, domain.UnifiedSourceGMail, position.Source)
+	assert.Equal(t, "18c1", position.ID)
+
+	for _, cursor := range []string{"%%%", "MTIz", encodeCursor(&api.UnifiedEmail{Source: "imap", ID: "1"}), encodeCursor(&api.UnifiedEmail{Source: domain.UnifiedSourceMailHub, ID: "abc"})} {
+		_, err := decodeCursor(cursor)
+		assert.Error(t, err, cursor)
+	}
+}
+
+func TestBefore(t *testing.T) {
+	date := time.Now()
+
+	newer := &api.UnifiedEmail{ID: "1", Source: domain.UnifiedSourceMailHub, DateOfDispatch: date.Add(time.Hour)}
+	gmail := &api.UnifiedEmail{ID: "18c1", Source: domain.UnifiedSourceGMail, DateOfDispatch: date}
+	mailhub9 := &api.UnifiedEmail{ID: "9", Source: domain.UnifiedSourceMailHub, DateOfDispatch: date}
+	mailhub10 := &api.UnifiedEmail{ID: "10", Source: domain.UnifiedSourceMailHub, DateOfDispatch: date}
+
+	assert.True(t, before(newer, gmail))
+	assert.True(t, before(gmail, mailhub10))
+	assert.True(t, before(mailhub10, mailhub9))
+	assert.False(t, before(mailhub9, mailhub10))
+	assert.False(t, before(gmail, gmail))
+}
@@ -0,0 +1,296 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"mail/internal/microservice/email/proto"
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/pkg/utils/validators"
+
+	domain "mail/internal/microservice/models/domain_models"
+	converters "mail/internal/models/delivery_converters"
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+	gmailSync "mail/internal/pkg/gmail/gmail_sync"
+	domainSession "mail/internal/pkg/session/interface"
+)
+
+var (
+	requestIDContextKey interface{} = "requestid"
+)
+
+// UnifiedInboxHandler handles the HTTP requests to the unified inbox, which merges the MailHub mailbox of the user
+// with the Gmail mailbox of the same address.
+type UnifiedInboxHandler struct {
+	Sessions           domainSession.SessionsManager
+	EmailServiceClient proto.EmailServiceClient
+	GMailSync          *gmailSync.Worker
+}
+
+// GetIncoming handles requests to list the unified inbox.
+// @Summary Get the unified inbox
+// @Description List the incoming emails of the MailHub mailbox and of the Gmail mailbox of the user merged by date, the newest first. Each email is tagged with its source (mailhub or gmail) and account. Pass the nextCursor of the response as "cursor" to read the next page.
+// @Tags unified
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param limit query int false "Number of emails, 20 by default, at most 100"
+// @Param cursor query string false "Cursor of the page returned with the previous page"
+// @Success 200 {object} response.Response "Emails and the cursor of the next page"
+// @Failure 400 {object} response.ErrorResponse "Invalid query"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to get emails"
+// @Router /api/v1/unified/emails/incoming [get]
+func (h *UnifiedInboxHandler) GetIncoming(w http.ResponseWriter, r *http.Request) {
+	limit := domain.UnifiedInboxLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			response.HandleError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+		if limit > domain.UnifiedInboxMaxLimit {
+			limit = domain.UnifiedInboxMaxLimit
+		}
+	}
+
+	var after *api.UnifiedEmail
+	if value := r.URL.Query().Get("cursor"); value != "" {
+		var err error
+		after, err = decodeCursor(value)
+		if err != nil {
+			response.HandleError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	emails, err := h.mailhubEmails(login, after, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to get MailHub emails")
+		return
+	}
+
+	if validators.IsValidEmailFormatGmail(login) {
+		gmailEmails, err := h.gmailEmails(login, after, limit+1, r.Context())
+		if err != nil {
+			response.HandleError(w, http.StatusInternalServerError, "Failed to get Gmail emails")
+			return
+		}
+		emails = append(emails, gmailEmails...)
+	}
+
+	sort.SliceStable(emails, func(i, j int) bool {
+		return before(emails[i], emails[j])
+	})
+
+	nextCursor := ""
+	if len(emails) > limit {
+		emails = emails[:limit]
+		nextCursor = encodeCursor(emails[limit-1])
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"emails": emails, "nextCursor": nextCursor})
+}
+
+// Action handles requests to act on an email of the unified inbox.
+// @Summary Act on an email of the unified inbox
+// @Description Mark read or unread, mark spam or not spam, delete or move an email. The action is applied by the mailbox the email comes from: a MailHub folder ID or a Gmail label ID is expected as the target of the move.
+// @Tags unified
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param action body response.UnifiedActionSwag true "Action, source and ID of the email"
+// @Success 200 {object} response.Response "Success status"
+// @Failure 400 {object} response.ErrorResponse "Bad JSON in request"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to apply the action"
+// @Router /api/v1/unified/email/action [post]
+func (h *UnifiedInboxHandler) Action(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var action api.UnifiedAction
+	if err := action.UnmarshalJSON(body); err != nil || action.ID == "" {
+		response.HandleError(w, http.StatusBadRequest, "Bad JSON in request")
+		return
+	}
+
+	login, err := h.Sessions.GetLoginBySession(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad user session")
+		return
+	}
+
+	switch action.Source {
+	case domain.UnifiedSourceMailHub:
+		h.mailhubAction(w, r, login, &action)
+	case domain.UnifiedSourceGMail:
+		h.gmailAction(w, r, login, &action)
+	default:
+		response.HandleError(w, http.StatusBadRequest, "Bad source in request")
+	}
+}
+
+// mailhubAction applies the action to the email of the MailHub mailbox by the email service.
+func (h *UnifiedInboxHandler) mailhubAction(w http.ResponseWriter, r *http.Request, login string, action *api.UnifiedAction) {
+	id, err := strconv.ParseUint(action.ID, 10, 64)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	request := &proto.BulkEmailsRequest{Action: action.Action, EmailIds: []uint64{id}, Login: login}
+	switch action.Action {
+	case domain.BulkActionRead, domain.BulkActionUnread, domain.BulkActionSpam, domain.BulkActionNotSpam, domain.BulkActionDelete:
+	case domain.BulkActionMove:
+		folderID, err := strconv.ParseUint(action.Folder, 10, 32)
+		if err != nil {
+			response.HandleError(w, http.StatusBadRequest, "Bad folder in request")
+			return
+		}
+		request.FolderId = uint32(folderID)
+	default:
+		response.HandleError(w, http.StatusBadRequest, "Bad action in request")
+		return
+	}
+
+	resultsDataProto, err := h.EmailServiceClient.BulkEmails(outgoingContext(r.Context()), request)
+	if err != nil || len(resultsDataProto.Results) != 1 || !resultsDataProto.Results[0].Success {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to apply the action")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": true})
+}
+
+// gmailAction applies the action to the email of the Gmail mailbox, in Gmail and in its local copy.
+func (h *UnifiedInboxHandler) gmailAction(w http.ResponseWriter, r *http.Request, login string, action *api.UnifiedAction) {
+	if !validators.IsValidEmailFormatGmail(login) {
+		response.HandleError(w, http.StatusBadRequest, "Login must end with @gmail.com")
+		return
+	}
+
+	if action.Action == domain.BulkActionDelete {
+		if err := h.GMailSync.Delete(login, action.ID, r.Context()); err != nil {
+			response.HandleError(w, http.StatusInternalServerError, "Failed to apply the action")
+			return
+		}
+
+		response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": true})
+		return
+	}
+
+	addLabelIDs, removeLabelIDs, ok := gmailLabelChanges(action.Action, action.Folder)
+	if !ok {
+		response.HandleError(w, http.StatusBadRequest, "Bad action in request")
+		return
+	}
+
+	if err := h.GMailSync.ModifyLabels(login, action.ID, addLabelIDs, removeLabelIDs, r.Context()); err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to apply the action")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": true})
+}
+
+// gmailLabelChanges returns the labels to add and remove to apply the action in Gmail,
+// where the read state, spam and folders are all labels.
+func gmailLabelChanges(action, folder string) ([]string, []string, bool) {
+	switch action {
+	case domain.BulkActionRead:
+		return nil, []string{domain.GMailLabelUnread}, true
+	case domain.BulkActionUnread:
+		return []string{domain.GMailLabelUnread}, nil, true
+	case domain.BulkActionSpam:
+		return []string{domain.GMailLabelSpam}, []string{domain.GMailLabelInbox}, true
+	case domain.BulkActionNotSpam:
+		return []string{domain.GMailLabelInbox}, []string{domain.GMailLabelSpam}, true
+	case domain.BulkActionMove:
+		if folder == "" || folder == domain.GMailLabelInbox {
+			return nil, nil, false
+		}
+		return []string{folder}, []string{domain.GMailLabelInbox}, true
+	}
+
+	return nil, nil, false
+}
+
+// mailhubEmails returns the incoming emails of the MailHub mailbox which go after the cursor email.
+func (h *UnifiedInboxHandler) mailhubEmails(login string, after *api.UnifiedEmail, ctx context.Context) ([]*api.UnifiedEmail, error) {
+	emailDataProto, err := h.EmailServiceClient.GetAllIncoming(
+		outgoingContext(ctx),
+		&proto.LoginOffsetLimit{Login: login, Offset: 0, Limit: 0},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]*api.UnifiedEmail, 0, len(emailDataProto.Emails))
+	for _, emailCore := range proto_converters.EmailsConvertProtoInCore(emailDataProto) {
+		email := converters.EmailConvertCoreInUnifiedApi(*emailCore, login)
+		if after == nil || before(after, email) {
+			emails = append(emails, email)
+		}
+	}
+
+	return emails, nil
+}
+
+// gmailEmails returns up to limit of the emails of the inbox of the local copy of the Gmail mailbox
+// which go after the cursor email, a mailbox without a copy yet is queued for copying and has no emails until then.
+func (h *UnifiedInboxHandler) gmailEmails(login string, after *api.UnifiedEmail, limit int, ctx context.Context) ([]*api.UnifiedEmail, error) {
+	if err := h.GMailSync.Ensure(login, ctx); err != nil {
+		return nil, err
+	}
+
+	request := &proto.GMailLabelAndLogin{Login: login, LabelId: domain.GMailLabelInbox, Limit: int64(limit)}
+	if after != nil {
+		request.BeforeDate = timestamppb.New(after.DateOfDispatch)
+		// The Gmail emails of the same date go before the MailHub ones, all of them have been listed already.
+		if after.Source == domain.UnifiedSourceGMail {
+			request.BeforeId = after.ID
+		}
+	}
+
+	reply, err := h.EmailServiceClient.GetGMailMessages(
+		outgoingContext(ctx),
+		request,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p := bluemonday.StripTagsPolicy()
+	emails := make([]*api.UnifiedEmail, 0, len(reply.Messages))
+	for _, m := range reply.Messages {
+		email := converters.GMailMessageConvertCoreInUnifiedApi(proto_converters.GMailMessageConvertProtoInCore(m), login)
+		email.Text = strings.Join(strings.Fields(p.Sanitize(email.Text)), " ")
+		emails = append(emails, email)
+	}
+
+	return emails, nil
+}
+
+// outgoingContext passes the request ID of the gateway request to the email service.
+func outgoingContext(ctx context.Context) context.Context {
+	return metadata.NewOutgoingContext(ctx,
+		metadata.New(map[string]string{"requestID": ctx.Value(requestIDContextKey).(string)}))
+}
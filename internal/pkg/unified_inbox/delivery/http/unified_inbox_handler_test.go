@@ -0,0 +1,205 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	emailMock "mail/internal/microservice/email/mock"
+	emailProto "mail/internal/microservice/email/proto"
+	domain "mail/internal/microservice/models/domain_models"
+	gmailSync "mail/internal/pkg/gmail/gmail_sync"
+	gmailMock "mail/internal/pkg/gmail/mock"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func newUnifiedRequest(t *testing.T, method, url, body string) *http.Request {
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	assert.NoError(t, err)
+
+	return req.WithContext(context.WithValue(req.Context(), "requestid", "testID"))
+}
+
+func newTestHandler(ctrl *gomock.Controller) (*UnifiedInboxHandler, *sessionMock.MockSessionsManager, *emailMock.MockEmailServiceClient, *gmailMock.MockGMailClientFactory) {
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+	mockClient := emailMock.NewMockEmailServiceClient(ctrl)
+	mockFactory := gmailMock.NewMockGMailClientFactory(ctrl)
+
+	return &UnifiedInboxHandler{
+		Sessions:           mockSessionsManager,
+		EmailServiceClient: mockClient,
+		GMailSync:          gmailSync.NewWorker(mockFactory, mockClient),
+	}, mockSessionsManager, mockClient, mockFactory
+}
+
+type unifiedPage struct {
+	Body struct {
+		Emails []struct {
+			ID     string `json:"id"`
+			Source string `json:"source"`
+		} `json:"emails"`
+		NextCursor string `json:"nextCursor"`
+	} `json:"body"`
+}
+
+func TestGetIncoming(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, _ := newTestHandler(ctrl)
+
+	date := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	localEmails := &emailProto.Emails{Emails: []*emailProto.Email{
+		{Id: 1, Topic: "Newest", DateOfDispatch: timestamppb.New(date)},
+		{Id: 2, Topic: "Oldest", DateOfDispatch: timestamppb.New(date.Add(-2 * time.Hour))},
+	}}
+
+	t.Run("MergedByDate", func(t *testing.T) {
+		req := newUnifiedRequest(t, "GET", "/api/v1/unified/emails/incoming?limit=2", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(req, gomock.Any()).Return("user@gmail.com", nil)
+		mockClient.EXPECT().GetAllIncoming(gomock.Any(), &emailProto.LoginOffsetLimit{Login: "user@gmail.com"}).Return(localEmails, nil)
+		mockClient.EXPECT().GetGMailSyncState(gomock.Any(), &emailProto.GMailLogin{Login: "user@gmail.com"}).
+			Return(&emailProto.GMailSyncState{HistoryId: 2048, Found: true}, nil)
+		mockClient.EXPECT().GetGMailMessages(gomock.Any(), &emailProto.GMailLabelAndLogin{Login: "user@gmail.com", LabelId: domain.GMailLabelInbox, Limit: 3}).
+			Return(&emailProto.GMailMessages{Messages: []*emailProto.GMailMessage{
+				{Id: "18c1", LabelIds: []string{domain.GMailLabelInbox}, Text: "<p>Hello</p>", Date: timestamppb.New(date.Add(-time.Hour))},
+			}}, nil)
+
+		http.HandlerFunc(handler.GetIncoming).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var page unifiedPage
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &page))
+		assert.Len(t, page.Body.Emails, 2)
+		assert.Equal(t, "1", page.Body.Emails[0].ID)
+		assert.Equal(t, domain.UnifiedSourceMailHub, page.Body.Emails[0].Source)
+		assert.Equal(t, "18c1", page.Body.Emails[1].ID)
+		assert.Equal(t, domain.UnifiedSourceGMail, page.Body.Emails[1].Source)
+		assert.NotEmpty(t, page.Body.NextCursor)
+		assert.Contains(t, rr.Body.String(), `"text":"Hello"`)
+
+		req = newUnifiedRequest(t, "GET", "/api/v1/unified/emails/incoming?limit=2&cursor="+page.Body.NextCursor, "")
+		rr = httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(req, gomock.Any()).Return("user@gmail.com", nil)
+		mockClient.EXPECT().GetAllIncoming(gomock.Any(), gomock.Any()).Return(localEmails, nil)
+		mockClient.EXPECT().GetGMailSyncState(gomock.Any(), gomock.Any()).Return(&emailProto.GMailSyncState{HistoryId: 2048, Found: true}, nil)
+		mockClient.EXPECT().GetGMailMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, in *emailProto.GMailLabelAndLogin, _ ...interface{}) (*emailProto.GMailMessages, error) {
+				assert.True(t, in.BeforeDate.AsTime().Equal(date.Add(-time.Hour)))
+				assert.Equal(t, "18c1", in.BeforeId)
+				return &emailProto.GMailMessages{}, nil
+			})
+
+		http.HandlerFunc(handler.GetIncoming).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		page = unifiedPage{}
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &page))
+		assert.Len(t, page.Body.Emails, 1)
+		assert.Equal(t, "2", page.Body.Emails[0].ID)
+		assert.Empty(t, page.Body.NextCursor)
+	})
+
+	t.Run("MailHubOnly", func(t *testing.T) {
+		req := newUnifiedRequest(t, "GET", "/api/v1/unified/emails/incoming", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(req, gomock.Any()).Return("user@mailhub.su", nil)
+		mockClient.EXPECT().GetAllIncoming(gomock.Any(), &emailProto.LoginOffsetLimit{Login: "user@mailhub.su"}).Return(localEmails, nil)
+
+		http.HandlerFunc(handler.GetIncoming).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"account":"user@mailhub.su"`)
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		req := newUnifiedRequest(t, "GET", "/api/v1/unified/emails/incoming?cursor=bad", "")
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(handler.GetIncoming).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, mockSessionsManager, mockClient, mockFactory := newTestHandler(ctrl)
+
+	t.Run("MailHub", func(t *testing.T) {
+		req := newUnifiedRequest(t, "POST", "/api/v1/unified/email/action", `{"action":"move","source":"mailhub","id":"12","folder":"3"}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(req, gomock.Any()).Return("user@gmail.com", nil)
+		mockClient.EXPECT().BulkEmails(gomock.Any(), &emailProto.BulkEmailsRequest{
+			Action: domain.BulkActionMove, EmailIds: []uint64{12}, FolderId: 3, Login: "user@gmail.com",
+		}).Return(&emailProto.BulkEmailsResults{Results: []*emailProto.BulkEmailResult{{EmailId: 12, Success: true}}}, nil)
+
+		http.HandlerFunc(handler.Action).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("GMail", func(t *testing.T) {
+		req := newUnifiedRequest(t, "POST", "/api/v1/unified/email/action", `{"action":"spam","source":"gmail","id":"18c1"}`)
+		rr := httptest.NewRecorder()
+
+		mockGMailClient := gmailMock.NewMockGMailClient(ctrl)
+		mockSessionsManager.EXPECT().GetLoginBySession(req, gomock.Any()).Return("user@gmail.com", nil)
+		mockFactory.EXPECT().Client("user@gmail.com", gomock.Any()).Return(mockGMailClient, nil)
+		mockGMailClient.EXPECT().ModifyLabels("18c1", []string{domain.GMailLabelSpam}, []string{domain.GMailLabelInbox}).Return(nil)
+		mockClient.EXPECT().UpdateGMailMessageLabels(gomock.Any(), gomock.Any()).Return(&emailProto.StatusEmail{Status: true}, nil)
+
+		http.HandlerFunc(handler.Action).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("GMailOfMailHubUser", func(t *testing.T) {
+		req := newUnifiedRequest(t, "POST", "/api/v1/unified/email/action", `{"action":"read","source":"gmail","id":"18c1"}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(req, gomock.Any()).Return("user@mailhub.su", nil)
+
+		http.HandlerFunc(handler.Action).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("BadAction", func(t *testing.T) {
+		req := newUnifiedRequest(t, "POST", "/api/v1/unified/email/action", `{"action":"label","source":"mailhub","id":"12"}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetLoginBySession(req, gomock.Any()).Return("user@mailhub.su", nil)
+
+		http.HandlerFunc(handler.Action).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestGMailLabelChanges(t *testing.T) {
+	add, remove, ok := gmailLabelChanges(domain.BulkActionMove, "Label_1")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"Label_1"}, add)
+	assert.Equal(t, []string{domain.GMailLabelInbox}, remove)
+
+	_, remove, ok = gmailLabelChanges(domain.BulkActionRead, "")
+	assert.True(t, ok)
+	assert.Equal(t, []string{domain.GMailLabelUnread}, remove)
+
+	_, _, ok = gmailLabelChanges(domain.BulkActionMove, "")
+	assert.False(t, ok)
+}
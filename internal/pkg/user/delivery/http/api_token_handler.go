@@ -0,0 +1,140 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/microservice/user/proto"
+	"mail/internal/pkg/session"
+	"mail/internal/pkg/utils/sanitize"
+
+	converters "mail/internal/models/delivery_converters"
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// GetAPITokens handles requests to list the personal access tokens of the user.
+// @Summary Get personal access tokens
+// @Description List the personal access tokens of the user with their scopes, expiry and last use
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Personal access tokens"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to get tokens"
+// @Router /api/v1/user/tokens [get]
+func (uh *UserHandler) GetAPITokens(w http.ResponseWriter, r *http.Request) {
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	tokensProto, err := uh.UserServiceClient.GetAPITokens(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.GetAPITokensRequest{Id: sessionUser.UserID},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to get tokens")
+		return
+	}
+
+	tokens := make([]*api.APIToken, 0, len(tokensProto.ApiTokens))
+	for _, tokenProto := range tokensProto.ApiTokens {
+		tokens = append(tokens, converters.APITokenConvertCoreInApi(proto_converters.APITokenConvertProtoInCore(tokenProto)))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"tokens": tokens})
+}
+
+// CreateAPIToken handles requests to create a personal access token.
+// @Summary Create a personal access token
+// @Description Create a personal access token for scripts, sent as "Authorization: Bearer <token>" instead of the session cookie and the CSRF token. The token is returned only once.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param token body response.APITokenCreateSwag true "Name, scopes (mail:read, mail:send, folders:manage) and life time in days"
+// @Success 200 {object} response.Response "Created token"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 422 {object} response.ErrorResponse "Failed to create token"
+// @Router /api/v1/user/token/create [post]
+func (uh *UserHandler) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return
+	}
+	var tokenCreate api.APITokenCreate
+	if err := tokenCreate.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	tokenCreate.Name = sanitize.SanitizeString(tokenCreate.Name)
+	if validUtil.IsEmpty(tokenCreate.Name) || len(tokenCreate.Scopes) == 0 || tokenCreate.LifeTimeDays <= 0 {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	tokenProto, err := uh.UserServiceClient.CreateAPIToken(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.CreateAPITokenRequest{
+			Id:           sessionUser.UserID,
+			Name:         tokenCreate.Name,
+			Scopes:       tokenCreate.Scopes,
+			LifeTimeDays: int32(tokenCreate.LifeTimeDays),
+		},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusUnprocessableEntity, "Failed to create token")
+		return
+	}
+
+	token := converters.APITokenConvertCoreInApi(proto_converters.APITokenConvertProtoInCore(tokenProto.ApiToken))
+	token.Token = tokenProto.Token
+
+	session.RotateCsrfTokenAfterAction(uh.Sessions, w, r)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"token": token})
+}
+
+// DeleteAPIToken handles requests to revoke a personal access token.
+// @Summary Revoke a personal access token
+// @Description Revoke a personal access token of the user, scripts using it stop working at once
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param id path integer true "Token ID"
+// @Success 200 {object} response.Response "Token revoked"
+// @Failure 400 {object} response.ErrorResponse "Bad id in request"
+// @Failure 404 {object} response.ErrorResponse "Token not found"
+// @Router /api/v1/user/token/delete/{id} [delete]
+func (uh *UserHandler) DeleteAPIToken(w http.ResponseWriter, r *http.Request) {
+	tokenID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil || tokenID == 0 {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	_, err = uh.UserServiceClient.DeleteAPIToken(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.DeleteAPITokenRequest{Id: sessionUser.UserID, TokenId: uint32(tokenID)},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusNotFound, "Token not found")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Token revoked"})
+}
@@ -0,0 +1,164 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userMock "mail/internal/microservice/user/mock"
+	userProto "mail/internal/microservice/user/proto"
+	api "mail/internal/models/delivery_models"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func TestGetAPITokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/tokens", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetAPITokens(gomock.Any(), &userProto.GetAPITokensRequest{Id: 1}).
+			Return(&userProto.GetAPITokensReply{ApiTokens: []*userProto.APIToken{{
+				Id:             3,
+				Name:           "backup",
+				Scopes:         []string{"mail:read"},
+				CreationDate:   timestamppb.Now(),
+				ExpirationDate: timestamppb.Now(),
+			}}}, nil)
+
+		http.HandlerFunc(userHandler.GetAPITokens).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"name":"backup"`)
+		assert.NotContains(t, rr.Body.String(), `"lastUsedDate"`)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/tokens", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetAPITokens(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+		http.HandlerFunc(userHandler.GetAPITokens).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestCreateAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/token/create", `{"name":"backup","scopes":["mail:read","mail:send"],"lifeTimeDays":30}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().
+			CreateAPIToken(gomock.Any(), &userProto.CreateAPITokenRequest{Id: 1, Name: "backup", Scopes: []string{"mail:read", "mail:send"}, LifeTimeDays: 30}).
+			Return(&userProto.CreateAPITokenReply{
+				Token:    "mhp_secret",
+				ApiToken: &userProto.APIToken{Id: 3, Name: "backup", CreationDate: timestamppb.Now(), ExpirationDate: timestamppb.Now()},
+			}, nil)
+		mockSessionsManager.EXPECT().RotateCsrfToken(rr, req, gomock.Any()).Return(nil)
+
+		http.HandlerFunc(userHandler.CreateAPIToken).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"token":"mhp_secret"`)
+	})
+
+	t.Run("MissingFields", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/token/create", `{"name":"backup"}`)
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(userHandler.CreateAPIToken).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/token/create", `{"name":"backup","scopes":["admin"],"lifeTimeDays":30}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().CreateAPIToken(gomock.Any(), gomock.Any()).Return(nil, errors.New("unknown scope"))
+
+		http.HandlerFunc(userHandler.CreateAPIToken).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
+
+func TestDeleteAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := mux.SetURLVars(newUserRequest(t, "DELETE", "/api/v1/user/token/delete/3", ""), map[string]string{"id": "3"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().DeleteAPIToken(gomock.Any(), &userProto.DeleteAPITokenRequest{Id: 1, TokenId: 3}).
+			Return(&userProto.DeleteAPITokenReply{Status: true}, nil)
+
+		http.HandlerFunc(userHandler.DeleteAPIToken).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("BadID", func(t *testing.T) {
+		req := mux.SetURLVars(newUserRequest(t, "DELETE", "/api/v1/user/token/delete/abc", ""), map[string]string{"id": "abc"})
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(userHandler.DeleteAPIToken).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		req := mux.SetURLVars(newUserRequest(t, "DELETE", "/api/v1/user/token/delete/4", ""), map[string]string{"id": "4"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().DeleteAPIToken(gomock.Any(), gomock.Any()).Return(nil, errors.New("not found"))
+
+		http.HandlerFunc(userHandler.DeleteAPIToken).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,41 @@
+package http
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/user/proto"
+
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+)
+
+// GetRecoveryEmail handles requests to get the address password reset links are sent to.
+// @Summary Get recovery email
+// @Description Returns the recovery email of the user and whether it is verified, reset links are sent only to a verified address
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Recovery email"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/v1/user/recovery-email [get]
+func (uh *UserHandler) GetRecoveryEmail(w http.ResponseWriter, r *http.Request) {
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	recoveryEmailProto, err := uh.UserServiceClient.GetRecoveryEmail(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.TwoFactorUserRequest{Id: sessionUser.UserID},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Internal Server Error")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"recoveryEmail": api.RecoveryEmailStatus{
+		RecoveryEmail: recoveryEmailProto.RecoveryEmail,
+		Verified:      recoveryEmailProto.Verified,
+	}})
+}
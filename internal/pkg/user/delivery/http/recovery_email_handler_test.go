@@ -0,0 +1,57 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	userMock "mail/internal/microservice/user/mock"
+	userProto "mail/internal/microservice/user/proto"
+	api "mail/internal/models/delivery_models"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func TestGetRecoveryEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/recovery-email", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().
+			GetRecoveryEmail(gomock.Any(), &userProto.TwoFactorUserRequest{Id: 1}).
+			Return(&userProto.GetRecoveryEmailReply{RecoveryEmail: "user@example.com", Verified: true}, nil)
+
+		http.HandlerFunc(userHandler.GetRecoveryEmail).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"recoveryEmail":"user@example.com"`)
+		assert.Contains(t, rr.Body.String(), `"verified":true`)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/recovery-email", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetRecoveryEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		http.HandlerFunc(userHandler.GetRecoveryEmail).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
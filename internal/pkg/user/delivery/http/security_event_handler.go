@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/models/proto_converters"
+	"mail/internal/microservice/user/proto"
+
+	converters "mail/internal/models/delivery_converters"
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+)
+
+// GetSecurityEvents handles requests to read the security audit log of the user.
+// @Summary Get security events
+// @Description Read the security audit log of the user: logins with their method, logouts, password, two-factor, avatar and delegation changes, the latest first. Pass the id of the last event received as "before" to read the next page.
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param limit query int false "Number of events, 50 by default, at most 200"
+// @Param before query int false "Return the events recorded before the event with this id"
+// @Success 200 {object} response.Response "Security events"
+// @Failure 400 {object} response.ErrorResponse "Invalid query"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to get security events"
+// @Router /api/v1/user/security-events [get]
+func (uh *UserHandler) GetSecurityEvents(w http.ResponseWriter, r *http.Request) {
+	var limit int64
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.ParseInt(value, 10, 32)
+		if err != nil || limit <= 0 {
+			response.HandleError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	var beforeID uint64
+	if value := r.URL.Query().Get("before"); value != "" {
+		var err error
+		beforeID, err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			response.HandleError(w, http.StatusBadRequest, "Invalid before")
+			return
+		}
+	}
+
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	eventsProto, err := uh.UserServiceClient.GetSecurityEvents(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.GetSecurityEventsRequest{Id: sessionUser.UserID, BeforeId: beforeID, Limit: int32(limit)},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to get security events")
+		return
+	}
+
+	events := make([]*api.SecurityEvent, 0, len(eventsProto.Events))
+	for _, eventProto := range eventsProto.Events {
+		events = append(events, converters.SecurityEventConvertCoreInApi(proto_converters.SecurityEventConvertProtoInCore(eventProto)))
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"events": events})
+}
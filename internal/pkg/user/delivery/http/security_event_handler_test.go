@@ -0,0 +1,90 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userMock "mail/internal/microservice/user/mock"
+	userProto "mail/internal/microservice/user/proto"
+	api "mail/internal/models/delivery_models"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func TestGetSecurityEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/security-events?limit=20&before=6", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetSecurityEvents(gomock.Any(), &userProto.GetSecurityEventsRequest{Id: 1, BeforeId: 6, Limit: 20}).
+			Return(&userProto.SecurityEventsReply{Events: []*userProto.SecurityEvent{{
+				Id:           5,
+				ProfileId:    1,
+				Type:         "login",
+				Method:       "vk",
+				Success:      true,
+				IpAddress:    "127.0.0.1",
+				CreationDate: timestamppb.Now(),
+			}}}, nil)
+
+		http.HandlerFunc(userHandler.GetSecurityEvents).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"method":"vk"`)
+		assert.NotContains(t, rr.Body.String(), `"details"`)
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/security-events", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetSecurityEvents(gomock.Any(), &userProto.GetSecurityEventsRequest{Id: 1}).
+			Return(&userProto.SecurityEventsReply{}, nil)
+
+		http.HandlerFunc(userHandler.GetSecurityEvents).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"events":[]`)
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		for _, url := range []string{"/api/v1/user/security-events?limit=-1", "/api/v1/user/security-events?before=abc"} {
+			req := newUserRequest(t, "GET", url, "")
+			rr := httptest.NewRecorder()
+
+			http.HandlerFunc(userHandler.GetSecurityEvents).ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, url)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/security-events", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetSecurityEvents(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+		http.HandlerFunc(userHandler.GetSecurityEvents).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
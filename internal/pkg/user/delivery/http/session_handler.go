@@ -0,0 +1,78 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"mail/internal/pkg/utils/sanitize"
+
+	response "mail/internal/models/response"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// GetActiveSessions handles requests to list the active sessions of the user.
+// @Summary Get active sessions
+// @Description List the active sessions of the user with their device, IP address and last activity
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Active sessions"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to get sessions"
+// @Router /api/v1/user/sessions [get]
+func (uh *UserHandler) GetActiveSessions(w http.ResponseWriter, r *http.Request) {
+	sessions, err := uh.Sessions.GetActiveSessions(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to get sessions")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"sessions": sessions})
+}
+
+// DeleteActiveSession handles requests to sign out one of the sessions of the user.
+// @Summary Sign out a session
+// @Description Sign out one of the active sessions of the user by its identifier from the session list
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param id path string true "Session identifier from the session list"
+// @Success 200 {object} response.Response "Session signed out"
+// @Failure 400 {object} response.ErrorResponse "Bad id in request"
+// @Failure 404 {object} response.ErrorResponse "Session not found"
+// @Router /api/v1/user/session/delete/{id} [delete]
+func (uh *UserHandler) DeleteActiveSession(w http.ResponseWriter, r *http.Request) {
+	id := sanitize.SanitizeString(mux.Vars(r)["id"])
+	if validUtil.IsEmpty(id) {
+		response.HandleError(w, http.StatusBadRequest, "Bad id in request")
+		return
+	}
+
+	if err := uh.Sessions.DestroyByID(id, r, r.Context()); err != nil {
+		response.HandleError(w, http.StatusNotFound, "Session not found")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Session signed out"})
+}
+
+// DeleteOtherSessions handles requests to sign out every session of the user except the current one.
+// @Summary Sign out other sessions
+// @Description Sign out every active session of the user except the current one
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Number of signed out sessions"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to sign out sessions"
+// @Router /api/v1/user/sessions/delete-others [post]
+func (uh *UserHandler) DeleteOtherSessions(w http.ResponseWriter, r *http.Request) {
+	count, err := uh.Sessions.DestroyOthers(r, r.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to sign out sessions")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"count": count})
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	api "mail/internal/models/delivery_models"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func TestGetActiveSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+	userHandler := UserHandler{Sessions: mockSessionsManager}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/sessions", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetActiveSessions(req, gomock.Any()).
+			Return([]*api.ActiveSession{{ID: "public", Device: "Mozilla/5.0", Current: true}}, nil)
+
+		http.HandlerFunc(userHandler.GetActiveSessions).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":"public"`)
+		assert.Contains(t, rr.Body.String(), `"current":true`)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/sessions", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetActiveSessions(req, gomock.Any()).Return(nil, errors.New("error"))
+
+		http.HandlerFunc(userHandler.GetActiveSessions).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestDeleteActiveSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+	userHandler := UserHandler{Sessions: mockSessionsManager}
+
+	t.Run("Success", func(t *testing.T) {
+		req := mux.SetURLVars(newUserRequest(t, "DELETE", "/api/v1/user/session/delete/public", ""), map[string]string{"id": "public"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().DestroyByID("public", req, gomock.Any()).Return(nil)
+
+		http.HandlerFunc(userHandler.DeleteActiveSession).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		req := mux.SetURLVars(newUserRequest(t, "DELETE", "/api/v1/user/session/delete/unknown", ""), map[string]string{"id": "unknown"})
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().DestroyByID("unknown", req, gomock.Any()).Return(errors.New("no session found"))
+
+		http.HandlerFunc(userHandler.DeleteActiveSession).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestDeleteOtherSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+	userHandler := UserHandler{Sessions: mockSessionsManager}
+
+	req := newUserRequest(t, "POST", "/api/v1/user/sessions/delete-others", "")
+	rr := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().DestroyOthers(req, gomock.Any()).Return(int64(3), nil)
+
+	http.HandlerFunc(userHandler.DeleteOtherSessions).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `{"status":200,"body":{"count":3}}`+"\n", rr.Body.String())
+}
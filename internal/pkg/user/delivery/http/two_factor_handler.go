@@ -0,0 +1,175 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/microservice/user/proto"
+	"mail/internal/pkg/session"
+	"mail/internal/pkg/utils/sanitize"
+
+	api "mail/internal/models/delivery_models"
+	response "mail/internal/models/response"
+	validUtil "mail/internal/pkg/utils/validators"
+)
+
+// GetTwoFactorStatus handles requests to get the two-factor authentication settings of the user.
+// @Summary Get two-factor authentication status
+// @Description Returns whether two-factor authentication is enabled and the number of unused recovery codes
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Two-factor authentication status"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/v1/user/2fa [get]
+func (uh *UserHandler) GetTwoFactorStatus(w http.ResponseWriter, r *http.Request) {
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	statusProto, err := uh.UserServiceClient.GetTwoFactorStatus(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.TwoFactorUserRequest{Id: sessionUser.UserID},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Internal Server Error")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"twoFactor": api.TwoFactorStatus{
+		Enabled:           statusProto.Enabled,
+		RecoveryCodesLeft: int(statusProto.RecoveryCodesLeft),
+	}})
+}
+
+// BeginTwoFactorSetup handles requests to start enabling two-factor authentication.
+// @Summary Begin two-factor authentication setup
+// @Description Generates a new TOTP secret to add to an authenticator application
+// @Tags users
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Success 200 {object} response.Response "Secret and otpauth URI"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to begin two-factor setup"
+// @Router /api/v1/user/2fa/setup [post]
+func (uh *UserHandler) BeginTwoFactorSetup(w http.ResponseWriter, r *http.Request) {
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	setupProto, err := uh.UserServiceClient.BeginTwoFactorSetup(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.TwoFactorUserRequest{Id: sessionUser.UserID},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to begin two-factor setup")
+		return
+	}
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"setup": api.TwoFactorSetup{
+		Secret: setupProto.Secret,
+		URI:    setupProto.Uri,
+	}})
+}
+
+// ConfirmTwoFactorSetup handles requests to enable two-factor authentication.
+// @Summary Confirm two-factor authentication setup
+// @Description Enables two-factor authentication after a valid code and returns the recovery codes
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param code body response.TwoFactorCodeSwag true "Code from the authenticator application"
+// @Success 200 {object} response.Response "Recovery codes"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to confirm two-factor setup"
+// @Router /api/v1/user/2fa/confirm [post]
+func (uh *UserHandler) ConfirmTwoFactorSetup(w http.ResponseWriter, r *http.Request) {
+	twoFactorCode, ok := readTwoFactorCode(w, r)
+	if !ok {
+		return
+	}
+
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	confirmProto, err := uh.UserServiceClient.ConfirmTwoFactorSetup(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.ConfirmTwoFactorSetupRequest{Id: sessionUser.UserID, Code: twoFactorCode.Code},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to confirm two-factor setup")
+		return
+	}
+
+	session.RotateCsrfTokenAfterAction(uh.Sessions, w, r)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"recoveryCodes": confirmProto.RecoveryCodes})
+}
+
+// DisableTwoFactor handles requests to disable two-factor authentication.
+// @Summary Disable two-factor authentication
+// @Description Disables two-factor authentication, the password and a TOTP or recovery code are required
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param X-Csrf-Token header string true "CSRF Token"
+// @Param code body response.TwoFactorCodeSwag true "Password and code"
+// @Success 200 {object} response.Response "Two-factor authentication disabled"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Not Authorized"
+// @Failure 500 {object} response.ErrorResponse "Failed to disable two-factor authentication"
+// @Router /api/v1/user/2fa/disable [post]
+func (uh *UserHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	twoFactorCode, ok := readTwoFactorCode(w, r)
+	if !ok {
+		return
+	}
+
+	twoFactorCode.Password = sanitize.SanitizeString(twoFactorCode.Password)
+	if validUtil.IsEmpty(twoFactorCode.Password) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return
+	}
+
+	sessionUser := uh.Sessions.GetSession(r, r.Context())
+
+	_, err := uh.UserServiceClient.DisableTwoFactor(
+		metadata.NewOutgoingContext(r.Context(),
+			metadata.New(map[string]string{"requestID": r.Context().Value(requestIDContextKey).(string)})),
+		&proto.DisableTwoFactorRequest{Id: sessionUser.UserID, Password: twoFactorCode.Password, Code: twoFactorCode.Code},
+	)
+	if err != nil {
+		response.HandleError(w, http.StatusInternalServerError, "Failed to disable two-factor authentication")
+		return
+	}
+
+	session.RotateCsrfTokenAfterAction(uh.Sessions, w, r)
+
+	response.HandleSuccess(w, http.StatusOK, map[string]interface{}{"Success": "Two-factor authentication disabled"})
+}
+
+// readTwoFactorCode reads the code of the second factor from the request body.
+func readTwoFactorCode(w http.ResponseWriter, r *http.Request) (api.TwoFactorCode, bool) {
+	var twoFactorCode api.TwoFactorCode
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid input body")
+		return twoFactorCode, false
+	}
+	if err := twoFactorCode.UnmarshalJSON(body); err != nil {
+		response.HandleError(w, http.StatusBadRequest, "Invalid request body")
+		return twoFactorCode, false
+	}
+
+	twoFactorCode.Code = sanitize.SanitizeString(twoFactorCode.Code)
+	if validUtil.IsEmpty(twoFactorCode.Code) {
+		response.HandleError(w, http.StatusBadRequest, "All fields must be filled in")
+		return twoFactorCode, false
+	}
+
+	return twoFactorCode, true
+}
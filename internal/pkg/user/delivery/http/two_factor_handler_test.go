@@ -0,0 +1,174 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	userMock "mail/internal/microservice/user/mock"
+	userProto "mail/internal/microservice/user/proto"
+	api "mail/internal/models/delivery_models"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func newUserRequest(t *testing.T, method, url, body string) *http.Request {
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	assert.NoError(t, err)
+
+	return req.WithContext(context.WithValue(req.Context(), "requestid", "testID"))
+}
+
+func TestGetTwoFactorStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/2fa", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetTwoFactorStatus(gomock.Any(), &userProto.TwoFactorUserRequest{Id: 1}).
+			Return(&userProto.GetTwoFactorStatusReply{Enabled: true, RecoveryCodesLeft: 8}, nil)
+
+		http.HandlerFunc(userHandler.GetTwoFactorStatus).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, `{"status":200,"body":{"twoFactor":{"enabled":true,"recoveryCodesLeft":8}}}`+"\n", rr.Body.String())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		req := newUserRequest(t, "GET", "/api/v1/user/2fa", "")
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().GetTwoFactorStatus(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+		http.HandlerFunc(userHandler.GetTwoFactorStatus).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestBeginTwoFactorSetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	req := newUserRequest(t, "POST", "/api/v1/user/2fa/setup", "")
+	rr := httptest.NewRecorder()
+
+	mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+	mockUserServiceClient.EXPECT().BeginTwoFactorSetup(gomock.Any(), &userProto.TwoFactorUserRequest{Id: 1}).
+		Return(&userProto.BeginTwoFactorSetupReply{Secret: "SECRET", Uri: "otpauth://totp/MailHub"}, nil)
+
+	http.HandlerFunc(userHandler.BeginTwoFactorSetup).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `{"status":200,"body":{"setup":{"secret":"SECRET","uri":"otpauth://totp/MailHub"}}}`+"\n", rr.Body.String())
+}
+
+func TestConfirmTwoFactorSetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/2fa/confirm", `{"code":"123456"}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().ConfirmTwoFactorSetup(gomock.Any(), &userProto.ConfirmTwoFactorSetupRequest{Id: 1, Code: "123456"}).
+			Return(&userProto.ConfirmTwoFactorSetupReply{RecoveryCodes: []string{"aaaaa-bbbbb"}}, nil)
+		mockSessionsManager.EXPECT().RotateCsrfToken(rr, req, gomock.Any()).Return(nil)
+
+		http.HandlerFunc(userHandler.ConfirmTwoFactorSetup).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, `{"status":200,"body":{"recoveryCodes":["aaaaa-bbbbb"]}}`+"\n", rr.Body.String())
+	})
+
+	t.Run("EmptyCode", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/2fa/confirm", `{"code":""}`)
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(userHandler.ConfirmTwoFactorSetup).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("InvalidCode", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/2fa/confirm", `{"code":"000000"}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().ConfirmTwoFactorSetup(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid code"))
+
+		http.HandlerFunc(userHandler.ConfirmTwoFactorSetup).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserServiceClient := userMock.NewMockUserServiceClient(ctrl)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	userHandler := UserHandler{
+		Sessions:          mockSessionsManager,
+		UserServiceClient: mockUserServiceClient,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/2fa/disable", `{"code":"123456","password":"pass"}`)
+		rr := httptest.NewRecorder()
+
+		mockSessionsManager.EXPECT().GetSession(req, gomock.Any()).Return(&api.Session{UserID: 1})
+		mockUserServiceClient.EXPECT().DisableTwoFactor(gomock.Any(), &userProto.DisableTwoFactorRequest{Id: 1, Password: "pass", Code: "123456"}).
+			Return(&userProto.DisableTwoFactorReply{Status: true}, nil)
+		mockSessionsManager.EXPECT().RotateCsrfToken(rr, req, gomock.Any()).Return(nil)
+
+		http.HandlerFunc(userHandler.DisableTwoFactor).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("MissingPassword", func(t *testing.T) {
+		req := newUserRequest(t, "POST", "/api/v1/user/2fa/disable", `{"code":"123456"}`)
+		rr := httptest.NewRecorder()
+
+		http.HandlerFunc(userHandler.DisableTwoFactor).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,109 @@
+package client_info
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// trustedProxies are the networks of the reverse proxies whose forwarding headers are believed.
+// The headers of any other peer are ignored, the client could set them to any address.
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies sets the reverse proxies whose forwarding headers are believed,
+// each one is an IP address or a network in the CIDR notation.
+func SetTrustedProxies(proxies []string) error {
+	networks := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %q: %v", proxy, err)
+		}
+		networks = append(networks, network)
+	}
+
+	trustedProxies = networks
+	return nil
+}
+
+// isTrustedProxy reports whether the address belongs to one of the trusted reverse proxies.
+func isTrustedProxy(ip net.IP) bool {
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientIP returns the IP address of the client who sent the request.
+// The forwarding headers are read only when the connection comes from a trusted reverse proxy:
+// the rightmost address of X-Forwarded-For that is not a trusted proxy is the one the proxies have seen,
+// the ones to the left of it are set by the client. Otherwise the address of the connection is returned.
+func ClientIP(r *http.Request) string {
+	remoteAddr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteAddr = r.RemoteAddr
+	}
+
+	remoteIP := net.ParseIP(remoteAddr)
+	if remoteIP == nil || !isTrustedProxy(remoteIP) {
+		return remoteAddr
+	}
+
+	if forwardedFor := r.Header.Values("X-Forwarded-For"); len(forwardedFor) > 0 {
+		addresses := strings.Split(strings.Join(forwardedFor, ","), ",")
+		for i := len(addresses) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(addresses[i]))
+			if ip == nil {
+				break
+			}
+			if !isTrustedProxy(ip) {
+				return ip.String()
+			}
+		}
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil && !isTrustedProxy(ip) {
+		return ip.String()
+	}
+
+	return remoteAddr
+}
+
+// clientInfoContextKey is the context key for the client of the request.
+type clientInfoContextKey struct{}
+
+// ClientInfo describes the client who sent the request.
+type ClientInfo struct {
+	IPAddress string // IPAddress is the address of the client.
+	UserAgent string // UserAgent is the device of the client.
+}
+
+// NewContext returns a copy of the context carrying the client who sent the request.
+func NewContext(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, clientInfoContextKey{}, ClientInfo{IPAddress: ClientIP(r), UserAgent: r.UserAgent()})
+}
+
+// FromContext returns the client stored in the context, false if there is none.
+func FromContext(ctx context.Context) (ClientInfo, bool) {
+	info, ok := ctx.Value(clientInfoContextKey{}).(ClientInfo)
+	return info, ok
+}
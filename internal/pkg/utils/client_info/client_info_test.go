@@ -0,0 +1,88 @@
+package client_info
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIP(t *testing.T) {
+	assert.NoError(t, SetTrustedProxies([]string{"172.16.0.0/12", "127.0.0.1"}))
+	defer SetTrustedProxies(nil)
+
+	t.Run("ForwardedFor", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "172.16.0.5:54321"
+		req.Header.Set("X-Forwarded-For", "10.0.0.2, 192.168.0.1")
+
+		assert.Equal(t, "192.168.0.1", ClientIP(req))
+	})
+
+	t.Run("ForwardedForSkipsProxies", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "127.0.0.1:54321"
+		req.Header.Add("X-Forwarded-For", "1.2.3.4, 192.168.0.1")
+		req.Header.Add("X-Forwarded-For", "172.16.0.7")
+
+		assert.Equal(t, "192.168.0.1", ClientIP(req))
+	})
+
+	t.Run("ForwardedForInvalid", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "172.16.0.5:54321"
+		req.Header.Set("X-Forwarded-For", "unknown")
+
+		assert.Equal(t, "172.16.0.5", ClientIP(req))
+	})
+
+	t.Run("RealIP", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "172.16.0.5:54321"
+		req.Header.Set("X-Real-IP", "10.0.0.1")
+
+		assert.Equal(t, "10.0.0.1", ClientIP(req))
+	})
+
+	t.Run("ForgedHeadersIgnored", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "203.0.113.7:54321"
+		req.Header.Set("X-Real-IP", "10.0.0.1")
+		req.Header.Set("X-Forwarded-For", "10.0.0.2")
+
+		assert.Equal(t, "203.0.113.7", ClientIP(req))
+	})
+
+	t.Run("RemoteAddr", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "172.16.0.5:54321"
+
+		assert.Equal(t, "172.16.0.5", ClientIP(req))
+	})
+}
+
+func TestSetTrustedProxies(t *testing.T) {
+	defer SetTrustedProxies(nil)
+
+	assert.NoError(t, SetTrustedProxies([]string{"10.0.0.0/8", " ::1 ", ""}))
+	assert.True(t, isTrustedProxy(net.ParseIP("10.1.2.3")))
+	assert.True(t, isTrustedProxy(net.ParseIP("::1")))
+	assert.False(t, isTrustedProxy(net.ParseIP("11.0.0.1")))
+
+	assert.Error(t, SetTrustedProxies([]string{"proxy"}))
+	assert.Error(t, SetTrustedProxies([]string{"10.0.0.0/33"}))
+}
+
+func TestContext(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "172.16.0.5:54321"
+	req.Header.Set("User-Agent", "Firefox")
+
+	_, ok := FromContext(req.Context())
+	assert.False(t, ok)
+
+	info, ok := FromContext(NewContext(req.Context(), req))
+	assert.True(t, ok)
+	assert.Equal(t, ClientInfo{IPAddress: "172.16.0.5", UserAgent: "Firefox"}, info)
+}
@@ -1,19 +1,52 @@
 package connect_microservice
 
 import (
+	"context"
 	"fmt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 
 	"mail/cmd/configs"
+	"mail/internal/pkg/utils/client_info"
 )
 
 // OpenGRPCConnection opens a connection to the RPC server on the specified port.
 func OpenGRPCConnection(port string) (*grpc.ClientConn, error) {
-	conn, err := grpc.Dial(configs.IP_ADDRESS+":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(configs.IP_ADDRESS+":"+port,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(ForwardClientInfoInterceptor),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial gRPC server: %v", err)
 	}
 
 	return conn, nil
 }
+
+// ForwardClientInfoInterceptor adds the IP address and the user agent of the client to the outgoing metadata,
+// so the services can audit the actions of the client. They are taken from the HTTP request the call is made for,
+// or from the incoming metadata if the call is made by another service. Values set by the caller are kept.
+func ForwardClientInfoInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var ipAddress, userAgent string
+	if info, ok := client_info.FromContext(ctx); ok {
+		ipAddress, userAgent = info.IPAddress, info.UserAgent
+	} else if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("ipAddress"); len(values) > 0 {
+			ipAddress = values[0]
+		}
+		if values := md.Get("userAgent"); len(values) > 0 {
+			userAgent = values[0]
+		}
+	}
+
+	outgoing, _ := metadata.FromOutgoingContext(ctx)
+	if ipAddress != "" && len(outgoing.Get("ipAddress")) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, "ipAddress", ipAddress)
+	}
+	if userAgent != "" && len(outgoing.Get("userAgent")) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, "userAgent", userAgent)
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
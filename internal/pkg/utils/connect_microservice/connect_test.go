@@ -0,0 +1,66 @@
+package connect_microservice
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"mail/internal/pkg/utils/client_info"
+)
+
+// invokeWithClientInfo runs the interceptor and returns the outgoing metadata of the call.
+func invokeWithClientInfo(t *testing.T, ctx context.Context) metadata.MD {
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	assert.NoError(t, ForwardClientInfoInterceptor(ctx, "/proto.UserService/GetUser", nil, nil, nil, invoker))
+
+	return outgoing
+}
+
+func TestForwardClientInfoInterceptor(t *testing.T) {
+	t.Run("FromRequest", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "172.16.0.5:54321"
+		req.Header.Set("User-Agent", "Firefox")
+		ctx := metadata.NewOutgoingContext(client_info.NewContext(req.Context(), req),
+			metadata.New(map[string]string{"requestID": "testID"}))
+
+		outgoing := invokeWithClientInfo(t, ctx)
+		assert.Equal(t, []string{"testID"}, outgoing.Get("requestID"))
+		assert.Equal(t, []string{"172.16.0.5"}, outgoing.Get("ipAddress"))
+		assert.Equal(t, []string{"Firefox"}, outgoing.Get("userAgent"))
+	})
+
+	t.Run("FromIncomingMetadata", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.New(map[string]string{"ipAddress": "10.0.0.1", "userAgent": "Chrome"}))
+
+		outgoing := invokeWithClientInfo(t, ctx)
+		assert.Equal(t, []string{"10.0.0.1"}, outgoing.Get("ipAddress"))
+		assert.Equal(t, []string{"Chrome"}, outgoing.Get("userAgent"))
+	})
+
+	t.Run("SetByCaller", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "172.16.0.5:54321"
+		ctx := metadata.NewOutgoingContext(client_info.NewContext(req.Context(), req),
+			metadata.New(map[string]string{"ipAddress": "10.0.0.1"}))
+
+		outgoing := invokeWithClientInfo(t, ctx)
+		assert.Equal(t, []string{"10.0.0.1"}, outgoing.Get("ipAddress"))
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		outgoing := invokeWithClientInfo(t, context.Background())
+		assert.Empty(t, outgoing.Get("ipAddress"))
+		assert.Empty(t, outgoing.Get("userAgent"))
+	})
+}
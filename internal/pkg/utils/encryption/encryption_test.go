@@ -0,0 +1,67 @@
+package encryption
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := Key("test key")
+	plaintext := []byte(`{"access_token":"token"}`)
+
+	data, err := Encrypt(plaintext, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bytes.Contains(data, plaintext) {
+		t.Errorf("expected the plaintext to be encrypted")
+	}
+
+	decrypted, err := Decrypt(data, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("expected %q, got %q", plaintext, decrypted)
+	}
+}
+
+func TestEncrypt_NewNonce(t *testing.T) {
+	key := Key("test key")
+
+	first, _ := Encrypt([]byte("data"), key)
+	second, _ := Encrypt([]byte("data"), key)
+	if bytes.Equal(first, second) {
+		t.Errorf("expected different ciphertexts for the same plaintext")
+	}
+}
+
+func TestDecrypt_WrongKey(t *testing.T) {
+	data, _ := Encrypt([]byte("data"), Key("test key"))
+
+	if _, err := Decrypt(data, Key("other key")); err == nil {
+		t.Errorf("expected error for wrong key")
+	}
+}
+
+func TestDecrypt_Tampered(t *testing.T) {
+	key := Key("test key")
+	data, _ := Encrypt([]byte("data"), key)
+	data[len(data)-1] ^= 1
+
+	if _, err := Decrypt(data, key); err == nil {
+		t.Errorf("expected error for tampered data")
+	}
+}
+
+func TestDecrypt_Short(t *testing.T) {
+	if _, err := Decrypt([]byte("short"), Key("test key")); err == nil {
+		t.Errorf("expected error for short data")
+	}
+}
+
+func TestEncrypt_InvalidKey(t *testing.T) {
+	if _, err := Encrypt([]byte("data"), []byte("short")); err == nil {
+		t.Errorf("expected error for invalid key")
+	}
+}
@@ -0,0 +1,150 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// AlgorithmRS256 is the only signing algorithm supported, RSASSA-PKCS1-v1_5 with SHA-256.
+const AlgorithmRS256 = "RS256"
+
+// encoding is the URL safe base64 encoding without padding used by every part of a token.
+var encoding = base64.RawURLEncoding
+
+// Header represents the header of a token.
+type Header struct {
+	Algorithm string `json:"alg"`           // Algorithm is the signing algorithm of the token.
+	Type      string `json:"typ,omitempty"` // Type tells the kinds of tokens signed with the same key apart.
+	KeyID     string `json:"kid,omitempty"` // KeyID identifies the key the token is signed with.
+}
+
+// JWK represents an RSA public key in the JSON Web Key format.
+type JWK struct {
+	KeyType   string `json:"kty"` // KeyType is always RSA.
+	Use       string `json:"use"` // Use is always sig, the keys only sign tokens.
+	Algorithm string `json:"alg"` // Algorithm is the signing algorithm used with the key.
+	KeyID     string `json:"kid"` // KeyID identifies the key.
+	Modulus   string `json:"n"`   // Modulus is the base64url encoded modulus of the key.
+	Exponent  string `json:"e"`   // Exponent is the base64url encoded public exponent of the key.
+}
+
+// Sign returns a token of the given type carrying the claims, signed with the key identified by keyID.
+func Sign(claims interface{}, tokenType, keyID string, key *rsa.PrivateKey) (string, error) {
+	header, err := json.Marshal(Header{Algorithm: AlgorithmRS256, Type: tokenType, KeyID: keyID})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token header: %v", err)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token claims: %v", err)
+	}
+
+	unsigned := encoding.EncodeToString(header) + "." + encoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(unsigned))
+
+	sign, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %v", err)
+	}
+
+	return unsigned + "." + encoding.EncodeToString(sign), nil
+}
+
+// Verify checks the signature of the token with the key returned by keys for its key ID and decodes its claims.
+// keys returns nil for unknown key IDs. The validity dates of the claims are up to the caller.
+func Verify(token string, keys func(keyID string) *rsa.PublicKey, claims interface{}) (*Header, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	headerJSON, err := encoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token header: %v", err)
+	}
+
+	var header Header
+	if err = json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %v", err)
+	}
+	if header.Algorithm != AlgorithmRS256 {
+		return nil, fmt.Errorf("unsupported token algorithm %q", header.Algorithm)
+	}
+
+	key := keys(header.KeyID)
+	if key == nil {
+		return nil, fmt.Errorf("unknown token key %q", header.KeyID)
+	}
+
+	sign, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %v", err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sign); err != nil {
+		return nil, fmt.Errorf("invalid token signature")
+	}
+
+	payload, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token claims: %v", err)
+	}
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %v", err)
+	}
+
+	return &header, nil
+}
+
+// PublicJWK returns the public part of the key in the format published to the clients.
+func PublicJWK(keyID string, key *rsa.PublicKey) JWK {
+	return JWK{
+		KeyType:   "RSA",
+		Use:       "sig",
+		Algorithm: AlgorithmRS256,
+		KeyID:     keyID,
+		Modulus:   encoding.EncodeToString(key.N.Bytes()),
+		Exponent:  encoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// GenerateKey returns a new RSA key of the given size in bits.
+func GenerateKey(bits int) (*rsa.PrivateKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %v", err)
+	}
+
+	return key, nil
+}
+
+// EncodePrivateKey returns the key in the PEM format it is stored in.
+func EncodePrivateKey(key *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+// DecodePrivateKey parses a key stored in the PEM format.
+func DecodePrivateKey(encoded string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil || block.Type != "RSA PRIVATE KEY" {
+		return nil, fmt.Errorf("malformed private key")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("malformed private key: %v", err)
+	}
+
+	return key, nil
+}
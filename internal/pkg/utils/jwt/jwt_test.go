@@ -0,0 +1,115 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+type testClaims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func newTestKey(t *testing.T) *rsa.PrivateKey {
+	key, err := GenerateKey(1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return key
+}
+
+func TestSignVerify(t *testing.T) {
+	key := newTestKey(t)
+	token, err := Sign(testClaims{Subject: "1", ExpiresAt: 1700000000}, "at+jwt", "kid1", key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var claims testClaims
+	header, err := Verify(token, func(keyID string) *rsa.PublicKey {
+		if keyID == "kid1" {
+			return &key.PublicKey
+		}
+		return nil
+	}, &claims)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if header.Type != "at+jwt" || header.KeyID != "kid1" || header.Algorithm != AlgorithmRS256 {
+		t.Errorf("unexpected header %+v", header)
+	}
+	if claims.Subject != "1" || claims.ExpiresAt != 1700000000 {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	key := newTestKey(t)
+	otherKey := newTestKey(t)
+	token, err := Sign(testClaims{Subject: "1"}, "", "kid1", key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parts := strings.Split(token, ".")
+	noneHeader := encoding.EncodeToString([]byte(`{"alg":"none","kid":"kid1"}`))
+
+	tests := map[string]struct {
+		token string
+		key   *rsa.PublicKey
+	}{
+		"WrongKey":       {token: token, key: &otherKey.PublicKey},
+		"UnknownKey":     {token: token, key: nil},
+		"Malformed":      {token: "payload", key: &key.PublicKey},
+		"ChangedClaims":  {token: parts[0] + "." + encoding.EncodeToString([]byte(`{"sub":"2"}`)) + "." + parts[2], key: &key.PublicKey},
+		"AlgorithmNone":  {token: noneHeader + "." + parts[1] + ".", key: &key.PublicKey},
+		"EmptySignature": {token: parts[0] + "." + parts[1] + ".", key: &key.PublicKey},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var claims testClaims
+			_, err := Verify(tt.token, func(string) *rsa.PublicKey { return tt.key }, &claims)
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestPublicJWK(t *testing.T) {
+	key := newTestKey(t)
+	jwk := PublicJWK("kid1", &key.PublicKey)
+
+	if jwk.KeyType != "RSA" || jwk.Use != "sig" || jwk.Algorithm != AlgorithmRS256 || jwk.KeyID != "kid1" {
+		t.Errorf("unexpected key %+v", jwk)
+	}
+	if jwk.Exponent != "AQAB" {
+		t.Errorf("expected exponent AQAB, got %s", jwk.Exponent)
+	}
+
+	modulus, err := encoding.DecodeString(jwk.Modulus)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if new(big.Int).SetBytes(modulus).Cmp(key.N) != 0 {
+		t.Error("modulus does not match the key")
+	}
+}
+
+func TestEncodeDecodePrivateKey(t *testing.T) {
+	key := newTestKey(t)
+
+	decoded, err := DecodePrivateKey(EncodePrivateKey(key))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decoded.Equal(key) {
+		t.Error("decoded key does not match")
+	}
+
+	if _, err = DecodePrivateKey("not a key"); err == nil {
+		t.Error("expected an error for a malformed key")
+	}
+}
@@ -0,0 +1,59 @@
+package signed_token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// encoding is the URL safe base64 encoding without padding, so the tokens can be put into links as is.
+var encoding = base64.RawURLEncoding
+
+// Sign returns a token carrying the payload which is valid until the expiration date.
+// The token has the form payload.expiration.signature, where the signature is the HMAC-SHA256 of the first two parts.
+// The payload is only encoded, not encrypted, so it must not contain secrets.
+func Sign(payload string, expiration time.Time, key []byte) string {
+	unsigned := encoding.EncodeToString([]byte(payload)) + "." + strconv.FormatInt(expiration.Unix(), 10)
+
+	return unsigned + "." + encoding.EncodeToString(signature(unsigned, key))
+}
+
+// Verify checks the signature and the expiration date of the token and returns its payload.
+func Verify(token string, key []byte, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("malformed token")
+	}
+
+	sign, err := encoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(sign, signature(parts[0]+"."+parts[1], key)) {
+		return "", fmt.Errorf("invalid token signature")
+	}
+
+	expiration, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("malformed token expiration: %v", err)
+	}
+	if now.Unix() >= expiration {
+		return "", fmt.Errorf("token has expired")
+	}
+
+	payload, err := encoding.DecodeString(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("malformed token payload: %v", err)
+	}
+
+	return string(payload), nil
+}
+
+// signature returns the HMAC-SHA256 of the unsigned part of a token.
+func signature(unsigned string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(unsigned))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,56 @@
+package signed_token
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+var testKey = []byte("test key")
+
+func TestSignVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	token := Sign("1:user@example.com", now.Add(time.Hour), testKey)
+
+	payload, err := Verify(token, testKey, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload != "1:user@example.com" {
+		t.Errorf("expected payload %q, got %q", "1:user@example.com", payload)
+	}
+}
+
+func TestVerifyExpired(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	token := Sign("payload", now.Add(time.Hour), testKey)
+
+	if _, err := Verify(token, testKey, now.Add(time.Hour)); err == nil {
+		t.Error("expected an error for an expired token")
+	}
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	token := Sign("payload", now.Add(time.Hour), testKey)
+	parts := strings.Split(token, ".")
+
+	tests := map[string]string{
+		"WrongKey":          token,
+		"Malformed":         "payload",
+		"ChangedPayload":    encoding.EncodeToString([]byte("other")) + "." + parts[1] + "." + parts[2],
+		"ChangedExpiration": parts[0] + ".9999999999." + parts[2],
+	}
+	for name, tokenToVerify := range tests {
+		t.Run(name, func(t *testing.T) {
+			key := testKey
+			if name == "WrongKey" {
+				key = []byte("other key")
+			}
+
+			if _, err := Verify(tokenToVerify, key, now); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 key "12345678901234567890" from the RFC 6238 test vectors encoded in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code() error = %v", err)
+		}
+		if code != tt.code {
+			t.Errorf("Code() at %d = %s, want %s", tt.unix, code, tt.code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	code, _ := Code(rfcSecret, Step(now.Add(-Period*time.Second)))
+	if step, ok := Validate(rfcSecret, code, now); !ok || step != Step(now)-1 {
+		t.Errorf("Validate() of the previous step = %d, %v", step, ok)
+	}
+
+	code, _ = Code(rfcSecret, Step(now.Add(-2*Period*time.Second)))
+	if _, ok := Validate(rfcSecret, code, now); ok {
+		t.Errorf("Validate() accepted an expired code")
+	}
+
+	if _, ok := Validate(rfcSecret, "12345", now); ok {
+		t.Errorf("Validate() accepted a short code")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	if len(secret) != 32 {
+		t.Errorf("GenerateSecret() = %q, want 32 characters", secret)
+	}
+
+	if _, err := Code(secret, 1); err != nil {
+		t.Errorf("Code() of the generated secret error = %v", err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("MailHub", "user@mailhub.su", rfcSecret)
+
+	if !strings.HasPrefix(uri, "otpauth://totp/MailHub:user@mailhub.su?") {
+		t.Errorf("URI() = %s", uri)
+	}
+	if !strings.Contains(uri, "secret="+rfcSecret) || !strings.Contains(uri, "issuer=MailHub") {
+		t.Errorf("URI() = %s", uri)
+	}
+}
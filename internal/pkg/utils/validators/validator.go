@@ -18,9 +18,29 @@ func IsValidEmailFormat(email string) bool {
 	return emailRegex.MatchString(email)
 }
 
+// IsValidEmail checks if the provided string is an email address of any domain.
+func IsValidEmail(email string) bool {
+	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+	return len(email) <= 100 && emailRegex.MatchString(email)
+}
+
+// IsValidRecoveryEmail checks if the provided string can be a recovery email.
+// It has to be an external address, a mailbox of the service would be unreachable without the password.
+func IsValidRecoveryEmail(email string) bool {
+	return IsValidEmail(email) && !IsValidEmailFormat(email)
+}
+
 // IsValidEmailFormatGmail checks if the provided email string matches the specific format for emails ending with "@gmail.com".
 func IsValidEmailFormatGmail(email string) bool {
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@gmail\.com$`)
 
 	return emailRegex.MatchString(email)
 }
+
+// IsValidColor checks if the provided string is a color in the #RRGGBB format.
+func IsValidColor(color string) bool {
+	colorRegex := regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+	return colorRegex.MatchString(color)
+}
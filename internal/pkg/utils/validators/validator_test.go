@@ -51,3 +51,72 @@ func TestIsValidEmailFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidColor(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"#000000", true},
+		{"#1a2B3c", true},
+		{"#fff", false},
+		{"000000", false},
+		{"#gggggg", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result := IsValidColor(test.input)
+			if result != test.expected {
+				t.Errorf("Expected IsValidColor(%q) to return %t, but got %t", test.input, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsValidEmail(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"user@example.com", true},
+		{"first.last+tag@mail.example.org", true},
+		{"user@mailhub.su", true},
+		{"user@localhost", false},
+		{"user@", false},
+		{"@example.com", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result := IsValidEmail(test.input)
+			if result != test.expected {
+				t.Errorf("Expected IsValidEmail(%q) to return %t, but got %t", test.input, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsValidRecoveryEmail(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"user@example.com", true},
+		{"user@gmail.com", true},
+		{"user@mailhub.su", false},
+		{"not an email", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result := IsValidRecoveryEmail(test.input)
+			if result != test.expected {
+				t.Errorf("Expected IsValidRecoveryEmail(%q) to return %t, but got %t", test.input, test.expected, result)
+			}
+		})
+	}
+}
@@ -1,41 +1,86 @@
 package websocket
 
 import (
+	"time"
+
 	"github.com/gorilla/websocket"
 )
 
-// client represents a single chatting user.
+const (
+	// writeWait is the time allowed to write a message to the peer.
+	writeWait = 10 * time.Second
+
+	// pongWait is the time allowed to read the next pong message from the peer.
+	pongWait = 60 * time.Second
 
+	// pingPeriod is the period of the pings sent to the peer, it must be less than pongWait.
+	pingPeriod = pongWait * 9 / 10
+
+	// maxMessageSize is the maximum size of a message read from the peer.
+	maxMessageSize = 512 * 1024
+)
+
+// client represents a single connection of a user, a user has one per tab or device.
 type client struct {
-	// socket is the web socket for this client.
+	// socket is the web socket for this client, it is nil for the server-sent events streams.
 	socket *websocket.Conn
 
-	// receive is a channel to receive messages from other clients.
-	receive chan []byte
-
-	// room is the room this client is chatting in.
-	room *room
+	// receive is a channel to receive the events of the mailbox of the user.
+	receive chan *message
 
+	// login is the login of the user authenticated by the session of the connection.
 	login string
+
+	// replay tells the room to send the events after lastEventID when the client joins.
+	replay bool
+
+	// lastEventID is the sequence number of the last event received before the client reconnected.
+	lastEventID uint64
 }
 
+// read waits until the connection is closed or the peer stops answering the pings.
+// The events are only published by the server, the messages of the peer are discarded.
 func (c *client) read() {
 	defer c.socket.Close()
+
+	c.socket.SetReadLimit(maxMessageSize)
+	_ = c.socket.SetReadDeadline(time.Now().Add(pongWait))
+	c.socket.SetPongHandler(func(string) error {
+		return c.socket.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
 	for {
-		_, msg, err := c.socket.ReadMessage()
-		if err != nil {
+		if _, _, err := c.socket.ReadMessage(); err != nil {
 			return
 		}
-		c.room.forward <- msg
 	}
 }
 
+// write sends the messages of the room and the pings to the peer,
+// the connection is closed when the room closes the receive channel or a write times out.
 func (c *client) write() {
-	defer c.socket.Close()
-	for msg := range c.receive {
-		err := c.socket.WriteMessage(websocket.TextMessage, msg)
-		if err != nil {
-			return
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.socket.Close()
+	}()
+
+	for {
+		select {
+		case msg, ok := <-c.receive:
+			_ = c.socket.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = c.socket.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.socket.WriteMessage(websocket.TextMessage, msg.data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.socket.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.socket.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
 		}
 	}
 }
@@ -0,0 +1,58 @@
+package websocket
+
+import "time"
+
+// eventLogSize is the number of the last events of a user kept for the replay,
+// it is less than messageBufferSize so a whole replay fits in the receive channel.
+const eventLogSize = 100
+
+// message is an event marshalled for the clients with its sequence number.
+type message struct {
+	seq  uint64
+	data []byte
+}
+
+// eventLog holds the last events delivered to a user, in the order of their sequence numbers.
+type eventLog struct {
+	messages []*message
+
+	// updated is the time the last event was added.
+	updated time.Time
+}
+
+// add appends the message to the log. A gap in the sequence numbers means the replica has missed events,
+// the log is then restarted so that it never replays an incomplete history.
+func (l *eventLog) add(msg *message) {
+	if n := len(l.messages); n > 0 && msg.seq != l.messages[n-1].seq+1 {
+		l.messages = nil
+	}
+
+	l.messages = append(l.messages, msg)
+	l.updated = time.Now()
+	if len(l.messages) > eventLogSize {
+		l.messages = append([]*message(nil), l.messages[len(l.messages)-eventLogSize:]...)
+	}
+}
+
+// latest returns the sequence number of the last event in the log, or 0 for an empty log.
+func (l *eventLog) latest() uint64 {
+	if len(l.messages) == 0 {
+		return 0
+	}
+	return l.messages[len(l.messages)-1].seq
+}
+
+// since returns the messages after the event lastEventID, ok is false when the log
+// no longer holds all of them and the client has to resync.
+func (l *eventLog) since(lastEventID uint64) (messages []*message, ok bool) {
+	if len(l.messages) == 0 {
+		return nil, false
+	}
+
+	first := l.messages[0].seq
+	if lastEventID+1 < first || lastEventID > l.latest() {
+		return nil, false
+	}
+
+	return l.messages[lastEventID+1-first:], true
+}
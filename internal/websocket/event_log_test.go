@@ -0,0 +1,61 @@
+package websocket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func seqs(messages []*message) []uint64 {
+	var result []uint64
+	for _, msg := range messages {
+		result = append(result, msg.seq)
+	}
+	return result
+}
+
+func TestEventLog_Since(t *testing.T) {
+	log := &eventLog{}
+	_, ok := log.since(0)
+	assert.False(t, ok)
+
+	for seq := uint64(1); seq <= eventLogSize+5; seq++ {
+		log.add(&message{seq: seq})
+	}
+	assert.Len(t, log.messages, eventLogSize)
+	assert.Equal(t, uint64(eventLogSize+5), log.latest())
+
+	messages, ok := log.since(eventLogSize + 2)
+	assert.True(t, ok)
+	assert.Equal(t, []uint64{eventLogSize + 3, eventLogSize + 4, eventLogSize + 5}, seqs(messages))
+
+	messages, ok = log.since(eventLogSize + 5)
+	assert.True(t, ok)
+	assert.Empty(t, messages)
+
+	messages, ok = log.since(5)
+	assert.True(t, ok)
+	assert.Len(t, messages, eventLogSize)
+
+	// The events before the first one in the log were dropped.
+	_, ok = log.since(4)
+	assert.False(t, ok)
+
+	// An id the log has never seen.
+	_, ok = log.since(eventLogSize + 6)
+	assert.False(t, ok)
+}
+
+func TestEventLog_AddGap(t *testing.T) {
+	log := &eventLog{}
+	log.add(&message{seq: 1})
+	log.add(&message{seq: 2})
+	log.add(&message{seq: 5})
+
+	_, ok := log.since(2)
+	assert.False(t, ok)
+
+	messages, ok := log.since(4)
+	assert.True(t, ok)
+	assert.Equal(t, []uint64{5}, seqs(messages))
+}
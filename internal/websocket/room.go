@@ -1,26 +1,51 @@
 package websocket
 
 import (
-	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
-	"log"
+
+	domain "mail/internal/microservice/models/domain_models"
 	emailApi "mail/internal/models/delivery_models"
 	"mail/internal/models/response"
-	"mail/internal/pkg/middleware"
-	"net/http"
+	"mail/internal/pkg/logger"
+	domainSession "mail/internal/pkg/session/interface"
 )
 
 const (
 	socketBufferSize  = 1024
 	messageBufferSize = 256
+
+	// eventLogTTL is how long the log of a user without connections is kept for the clients reconnecting.
+	eventLogTTL = 10 * time.Minute
+
+	// evictPeriod is the period of the removal of the expired logs.
+	evictPeriod = time.Minute
 )
 
 var upgrader = &websocket.Upgrader{ReadBufferSize: socketBufferSize, WriteBufferSize: socketBufferSize}
 
+// delivery is an event waiting to be sent to the connections of the user.
+type delivery struct {
+	login string
+	event *emailApi.MailboxEvent
+}
+
 type room struct {
-	// clients holds all current clients in this room.
-	clients map[string]*client
+	// sessions authenticates the users opening the connections.
+	sessions domainSession.SessionsManager
+
+	// clients holds all current connections in this room by the login of their user,
+	// it is only touched by the Run goroutine.
+	clients map[string]map[*client]struct{}
+
+	// logs holds the last events of each user for the clients reconnecting with the last event they received,
+	// it is only touched by the Run goroutine.
+	logs map[string]*eventLog
 
 	// join is a channel for clients wishing to join the room.
 	join chan *client
@@ -28,84 +53,227 @@ type room struct {
 	// leave is a channel for clients wishing to leave the room.
 	leave chan *client
 
-	// forward is a channel that holds incoming messages that should be forwarded to the other clients.
-	forward chan []byte
-}
+	// deliver is a channel that holds the events that should be sent to the clients of their user.
+	deliver chan delivery
 
-// newRoom create a new chat room
+	// resync is a channel for the requests to resync all the clients after the events bus has missed events.
+	resync chan struct{}
+}
 
-func NewRoom() *room {
+// NewRoom create a new room, the connections are authenticated by the session of the user.
+func NewRoom(sessions domainSession.SessionsManager) *room {
 	return &room{
-		forward: make(chan []byte),
-		join:    make(chan *client),
-		leave:   make(chan *client),
-		clients: make(map[string]*client),
+		sessions: sessions,
+		deliver:  make(chan delivery),
+		resync:   make(chan struct{}),
+		join:     make(chan *client),
+		leave:    make(chan *client),
+		clients:  make(map[string]map[*client]struct{}),
+		logs:     make(map[string]*eventLog),
 	}
 }
 
+// Deliver sends the event received from the events bus to all the connections of the user to this replica.
+func (r *room) Deliver(login string, event *emailApi.MailboxEvent) {
+	r.deliver <- delivery{login: login, event: event}
+}
+
+// Resync tells all the connections to this replica to resync, the events bus calls it after it may have missed events.
+func (r *room) Resync() {
+	r.resync <- struct{}{}
+}
+
+// Run serves the joins, the leaves and the events of the room.
 func (r *room) Run() {
+	ticker := time.NewTicker(evictPeriod)
+	defer ticker.Stop()
+
 	for {
 		select {
-		case client := <-r.join:
-			r.clients[client.login] = client
-		case client := <-r.leave:
-			delete(r.clients, client.login)
-			//close(client.receive)
-		case msg := <-r.forward:
-			var newEmail emailApi.Email
-			if err := newEmail.UnmarshalJSON(msg); err != nil {
-				fmt.Println("Bad JSON in request in Run")
+		case c := <-r.join:
+			if r.clients[c.login] == nil {
+				r.clients[c.login] = make(map[*client]struct{})
 			}
-			for login, client := range r.clients {
-				if login == newEmail.RecipientEmail {
-					client.receive <- msg
-				}
+			r.clients[c.login][c] = struct{}{}
+			if c.replay {
+				r.replay(c)
 			}
+		case c := <-r.leave:
+			r.remove(c)
+		case d := <-r.deliver:
+			r.send(d.login, d.event)
+		case <-r.resync:
+			r.resyncAll()
+		case now := <-ticker.C:
+			r.evict(now)
 		}
 	}
 }
 
-func (r *room) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	login, ok := vars["login"]
-	if !ok {
-		response.HandleError(w, http.StatusBadRequest, "Bad login in request")
+// send logs the event and writes it to all the connections of the user.
+func (r *room) send(login string, event *emailApi.MailboxEvent) {
+	msg, err := newMessage(event)
+	if err != nil {
+		log.Printf("failed to marshal %s event to %s: %v", event.Type, login, err)
 		return
 	}
-	newW, _ := w.(*middleware.LoggingResponseWriter)
-	NewW := newW.ResponseWriter
-	wrap, _ := NewW.(*middleware.LoggingResponseWriter)
-	wr := wrap.ResponseWriter
-	socket, err := upgrader.Upgrade(wr, req, nil)
-	if err != nil {
-		log.Fatal("ServeHTTP:", err)
+
+	if r.logs[login] == nil {
+		r.logs[login] = &eventLog{}
+	}
+	r.logs[login].add(msg)
+
+	for c := range r.clients[login] {
+		r.push(c, msg)
+	}
+}
+
+// replay writes to the joining client the events it missed since its last event,
+// or a resync event when they are no longer in the log.
+func (r *room) replay(c *client) {
+	events := r.logs[c.login]
+	if events == nil {
+		events = &eventLog{}
+	}
+
+	if messages, ok := events.since(c.lastEventID); ok {
+		for _, msg := range messages {
+			r.push(c, msg)
+		}
 		return
 	}
-	_, ok = r.clients[login]
-	if ok {
-		r.leave <- r.clients[login]
+
+	r.pushResync(c, events.latest())
+}
+
+// resyncAll writes a resync event to all the clients and drops the logs, which may miss events now,
+// so that no reconnecting client is given an incomplete replay.
+func (r *room) resyncAll() {
+	for login, clients := range r.clients {
+		var seq uint64
+		if events := r.logs[login]; events != nil {
+			seq = events.latest()
+		}
+		for c := range clients {
+			r.pushResync(c, seq)
+		}
 	}
-	/*
-		for cl := range r.clients {
-			if cl.login == login {
-				cl = &client{
-					socket:  socket,
-					receive: make(chan []byte, messageBufferSize),
-					room:    r,
-					login:   login,
-				}
 
-			}
+	r.logs = make(map[string]*eventLog)
+}
+
+// pushResync writes to the client a resync event with the sequence number of the last logged event.
+func (r *room) pushResync(c *client, seq uint64) {
+	msg, err := newMessage(&emailApi.MailboxEvent{Seq: seq, Type: domain.MailboxEventResync, Date: time.Now()})
+	if err != nil {
+		log.Printf("failed to marshal %s event to %s: %v", domain.MailboxEventResync, c.login, err)
+		return
+	}
+	r.push(c, msg)
+}
+
+// evict drops the logs of the users without connections which got no events for eventLogTTL,
+// so the room doesn't keep a log for every user who has ever been sent an event.
+func (r *room) evict(now time.Time) {
+	for login, events := range r.logs {
+		if _, connected := r.clients[login]; !connected && now.Sub(events.updated) > eventLogTTL {
+			delete(r.logs, login)
 		}
-	*/
-	client := &client{
-		socket:  socket,
-		receive: make(chan []byte, messageBufferSize),
-		room:    r,
+	}
+}
+
+// push writes the message to the client without blocking.
+func (r *room) push(c *client, msg *message) {
+	select {
+	case c.receive <- msg:
+	default:
+		// The connection doesn't keep up, it is closed rather than blocking the whole room.
+		r.remove(c)
+	}
+}
+
+// newMessage marshals the event for the clients.
+func newMessage(event *emailApi.MailboxEvent) (*message, error) {
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return &message{seq: event.Seq, data: data}, nil
+}
+
+// remove takes the client out of the room and stops its writer, it is a no-op for a removed client.
+func (r *room) remove(c *client) {
+	clients, ok := r.clients[c.login]
+	if !ok {
+		return
+	}
+	if _, ok := clients[c]; !ok {
+		return
+	}
+
+	delete(clients, c)
+	close(c.receive)
+	if len(clients) == 0 {
+		delete(r.clients, c.login)
+	}
+}
+
+// newClient authenticates the request and reads the last event the client received before reconnecting,
+// the error has already been answered to the client when ok is false.
+func (r *room) newClient(w http.ResponseWriter, req *http.Request, lastEventID string) (c *client, ok bool) {
+	if _, err := req.Cookie("session_id"); err != nil {
+		response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+		return nil, false
+	}
+
+	login, err := r.sessions.GetLoginBySession(req, req.Context())
+	if err != nil {
+		response.HandleError(w, http.StatusUnauthorized, "Not Authorized")
+		return nil, false
+	}
+
+	if pathLogin, ok := mux.Vars(req)["login"]; ok && pathLogin != login {
+		response.HandleError(w, http.StatusForbidden, "Login does not match the session")
+		return nil, false
+	}
+
+	c = &client{
+		receive: make(chan *message, messageBufferSize),
 		login:   login,
 	}
-	r.join <- client
-	defer func() { r.leave <- client }()
-	go client.write()
-	client.read()
+	if lastEventID != "" {
+		c.lastEventID, err = strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			response.HandleError(w, http.StatusBadRequest, "Bad last event id")
+			return nil, false
+		}
+		c.replay = true
+	}
+
+	return c, true
+}
+
+// ServeHTTP upgrades the request of the authenticated user to a websocket connection.
+// The login in the path, kept for the old clients, must be the one of the session.
+// A client reconnecting with the last_event_id query parameter first receives the events it missed.
+func (r *room) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	c, ok := r.newClient(w, req, req.URL.Query().Get("last_event_id"))
+	if !ok {
+		return
+	}
+
+	// The client joins before the handshake is answered, so it receives every event delivered once its connection is open.
+	r.join <- c
+	defer func() { r.leave <- c }()
+
+	socket, err := upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		// The upgrader has already answered the client with the error.
+		logger.LogRequestError(req.Context(), "failed to upgrade websocket connection", err)
+		return
+	}
+
+	c.socket = socket
+	go c.write()
+	c.read()
 }
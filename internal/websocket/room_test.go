@@ -0,0 +1,297 @@
+package websocket
+
+import (
+	"bufio"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	domain "mail/internal/microservice/models/domain_models"
+	emailApi "mail/internal/models/delivery_models"
+	sessionMock "mail/internal/pkg/session/mock"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *room, *sessionMock.MockSessionsManager) {
+	ctrl := gomock.NewController(t)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+
+	r := NewRoom(mockSessionsManager)
+	go r.Run()
+
+	router := mux.NewRouter()
+	router.Handle("/ws", r)
+	router.Handle("/ws/{login}", r)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return server, r, mockSessionsManager
+}
+
+func dial(server *httptest.Server, path, sessionID string) (*websocket.Conn, *http.Response, error) {
+	header := http.Header{}
+	if sessionID != "" {
+		header.Set("Cookie", "session_id="+sessionID)
+	}
+	return websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+path, header)
+}
+
+func sessionLogin(r *http.Request) string {
+	cookie, _ := r.Cookie("session_id")
+	return cookie.Value + "@mailhub.su"
+}
+
+func TestRoom_ServeHTTP_Unauthorized(t *testing.T) {
+	server, _, mockSessionsManager := newTestServer(t)
+
+	_, resp, err := dial(server, "/ws", "")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).Return("", errors.New("no session"))
+	_, resp, err = dial(server, "/ws", "expired")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).Return("alice@mailhub.su", nil)
+	_, resp, err = dial(server, "/ws/bob@mailhub.su", "alice")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func readEvent(t *testing.T, conn *websocket.Conn) *emailApi.MailboxEvent {
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, received, err := conn.ReadMessage()
+	assert.NoError(t, err)
+
+	var event emailApi.MailboxEvent
+	assert.NoError(t, event.UnmarshalJSON(received))
+	return &event
+}
+
+func TestRoom_Deliver(t *testing.T) {
+	server, r, mockSessionsManager := newTestServer(t)
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(r *http.Request, _ interface{}) (string, error) { return sessionLogin(r), nil }).AnyTimes()
+
+	tab, _, err := dial(server, "/ws", "bob")
+	assert.NoError(t, err)
+	defer tab.Close()
+	phone, _, err := dial(server, "/ws/bob@mailhub.su", "bob")
+	assert.NoError(t, err)
+	defer phone.Close()
+	other, _, err := dial(server, "/ws", "carol")
+	assert.NoError(t, err)
+	defer other.Close()
+
+	unread := int64(3)
+	r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{
+		Seq:     1,
+		Type:    domain.MailboxEventNewMail,
+		Date:    time.Now(),
+		EmailID: 7,
+		Email:   &emailApi.Email{ID: 7, Topic: "Hi", SenderEmail: "alice@mailhub.su", RecipientEmail: "bob@mailhub.su"},
+	})
+	r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: 2, Type: domain.MailboxEventUnreadCount, Date: time.Now(), Unread: &unread})
+
+	for _, conn := range []*websocket.Conn{tab, phone} {
+		event := readEvent(t, conn)
+		assert.Equal(t, uint64(1), event.Seq)
+		assert.Equal(t, domain.MailboxEventNewMail, event.Type)
+		assert.Equal(t, "Hi", event.Email.Topic)
+		assert.False(t, event.Date.IsZero())
+
+		event = readEvent(t, conn)
+		assert.Equal(t, uint64(2), event.Seq)
+		assert.Equal(t, domain.MailboxEventUnreadCount, event.Type)
+		assert.Equal(t, unread, *event.Unread)
+	}
+
+	assert.NoError(t, other.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+	_, _, err = other.ReadMessage()
+	assert.Error(t, err)
+}
+
+func TestRoom_ClientsCannotPush(t *testing.T) {
+	server, r, mockSessionsManager := newTestServer(t)
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(r *http.Request, _ interface{}) (string, error) { return sessionLogin(r), nil }).AnyTimes()
+
+	recipient, _, err := dial(server, "/ws", "bob")
+	assert.NoError(t, err)
+	defer recipient.Close()
+	sender, _, err := dial(server, "/ws", "alice")
+	assert.NoError(t, err)
+	defer sender.Close()
+
+	msg := `{"type":"new_mail","email":{"topic":"Fake","senderEmail":"alice@mailhub.su","recipientEmail":"bob@mailhub.su"}}`
+	assert.NoError(t, sender.WriteMessage(websocket.TextMessage, []byte(msg)))
+
+	assert.NoError(t, recipient.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+	_, _, err = recipient.ReadMessage()
+	assert.Error(t, err)
+
+	// The connection of the sender is kept open and still gets its own events.
+	r.Deliver("alice@mailhub.su", &emailApi.MailboxEvent{Seq: 1, Type: domain.MailboxEventFolderCreated, FolderID: 2, FolderName: "Work"})
+	event := readEvent(t, sender)
+	assert.Equal(t, uint64(1), event.Seq)
+	assert.Equal(t, "Work", event.FolderName)
+}
+
+func TestRoom_ServeHTTP_Replay(t *testing.T) {
+	server, r, mockSessionsManager := newTestServer(t)
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(r *http.Request, _ interface{}) (string, error) { return sessionLogin(r), nil }).AnyTimes()
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: seq, Type: domain.MailboxEventDeleted, EmailID: seq})
+	}
+
+	conn, _, err := dial(server, "/ws?last_event_id=1", "bob")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	for _, seq := range []uint64{2, 3} {
+		event := readEvent(t, conn)
+		assert.Equal(t, seq, event.Seq)
+		assert.Equal(t, seq, event.EmailID)
+	}
+
+	// The events delivered after the replay follow it.
+	r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: 4, Type: domain.MailboxEventDeleted, EmailID: 4})
+	assert.Equal(t, uint64(4), readEvent(t, conn).Seq)
+
+	_, resp, err := dial(server, "/ws?last_event_id=abc", "bob")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRoom_ServeHTTP_Resync(t *testing.T) {
+	server, r, mockSessionsManager := newTestServer(t)
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(r *http.Request, _ interface{}) (string, error) { return sessionLogin(r), nil }).AnyTimes()
+
+	for seq := uint64(1); seq <= eventLogSize+10; seq++ {
+		r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: seq, Type: domain.MailboxEventDeleted, EmailID: seq})
+	}
+
+	conn, _, err := dial(server, "/ws?last_event_id=3", "bob")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	event := readEvent(t, conn)
+	assert.Equal(t, domain.MailboxEventResync, event.Type)
+	assert.Equal(t, uint64(eventLogSize+10), event.Seq)
+
+	// A user without any logged event has to resync too.
+	fresh, _, err := dial(server, "/ws?last_event_id=3", "carol")
+	assert.NoError(t, err)
+	defer fresh.Close()
+
+	event = readEvent(t, fresh)
+	assert.Equal(t, domain.MailboxEventResync, event.Type)
+	assert.Equal(t, uint64(0), event.Seq)
+}
+
+func TestRoom_Resync(t *testing.T) {
+	server, r, mockSessionsManager := newTestServer(t)
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(r *http.Request, _ interface{}) (string, error) { return sessionLogin(r), nil }).AnyTimes()
+
+	conn, _, err := dial(server, "/ws", "bob")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: 1, Type: domain.MailboxEventDeleted, EmailID: 1})
+	assert.Equal(t, uint64(1), readEvent(t, conn).Seq)
+
+	r.Resync()
+	event := readEvent(t, conn)
+	assert.Equal(t, domain.MailboxEventResync, event.Type)
+	assert.Equal(t, uint64(1), event.Seq)
+
+	// The log may miss events after the resync, a reconnecting client resyncs rather than replays it.
+	reconnected, _, err := dial(server, "/ws?last_event_id=0", "bob")
+	assert.NoError(t, err)
+	defer reconnected.Close()
+
+	event = readEvent(t, reconnected)
+	assert.Equal(t, domain.MailboxEventResync, event.Type)
+}
+
+func TestRoom_Evict(t *testing.T) {
+	r := NewRoom(nil)
+	now := time.Now()
+
+	connected := &client{login: "bob@mailhub.su", receive: make(chan *message, messageBufferSize)}
+	r.clients[connected.login] = map[*client]struct{}{connected: {}}
+	for _, login := range []string{"bob@mailhub.su", "carol@mailhub.su", "dave@mailhub.su"} {
+		r.send(login, &emailApi.MailboxEvent{Seq: 1, Type: domain.MailboxEventDeleted, EmailID: 1})
+	}
+	r.logs["carol@mailhub.su"].updated = now.Add(-eventLogTTL - time.Second)
+	r.logs["bob@mailhub.su"].updated = now.Add(-eventLogTTL - time.Second)
+
+	r.evict(now)
+
+	// The logs of the connected users and the recent ones are kept.
+	assert.Contains(t, r.logs, "bob@mailhub.su")
+	assert.NotContains(t, r.logs, "carol@mailhub.su")
+	assert.Contains(t, r.logs, "dave@mailhub.su")
+}
+
+func TestRoom_SSE(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSessionsManager := sessionMock.NewMockSessionsManager(ctrl)
+	mockSessionsManager.EXPECT().GetLoginBySession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(r *http.Request, _ interface{}) (string, error) { return sessionLogin(r), nil }).AnyTimes()
+
+	r := NewRoom(mockSessionsManager)
+	go r.Run()
+	server := httptest.NewServer(r.SSE())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: 1, Type: domain.MailboxEventDeleted, EmailID: 1})
+	r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: 2, Type: domain.MailboxEventDeleted, EmailID: 2})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+	req.Header.Set("Cookie", "session_id=bob")
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readField := func() string {
+		line, err := reader.ReadString('\n')
+		assert.NoError(t, err)
+		return strings.TrimSuffix(line, "\n")
+	}
+
+	assert.Equal(t, "id: 2", readField())
+	data := readField()
+	assert.True(t, strings.HasPrefix(data, "data: "))
+	var event emailApi.MailboxEvent
+	assert.NoError(t, event.UnmarshalJSON([]byte(strings.TrimPrefix(data, "data: "))))
+	assert.Equal(t, uint64(2), event.EmailID)
+	assert.Equal(t, "", readField())
+
+	r.Deliver("bob@mailhub.su", &emailApi.MailboxEvent{Seq: 3, Type: domain.MailboxEventDeleted, EmailID: 3})
+	assert.Equal(t, "id: 3", readField())
+}
@@ -0,0 +1,74 @@
+package websocket
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"mail/internal/pkg/logger"
+)
+
+// sseHandler serves the events of the room as server-sent events.
+type sseHandler struct {
+	room *room
+}
+
+// SSE returns the handler streaming the same events as the websocket connections as server-sent events,
+// for the clients behind the proxies blocking the websockets.
+func (r *room) SSE() http.Handler {
+	return &sseHandler{room: r}
+}
+
+// ServeHTTP streams the events of the authenticated user until the request is canceled.
+// A reconnecting client first receives the events after the one in the Last-Event-ID header,
+// the last_event_id query parameter is used by the clients that can't set the header.
+func (h *sseHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	lastEventID := req.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = req.URL.Query().Get("last_event_id")
+	}
+
+	c, ok := h.room.newClient(w, req, lastEventID)
+	if !ok {
+		return
+	}
+
+	controller := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		logger.LogRequestError(req.Context(), "failed to flush event stream", err)
+		return
+	}
+
+	h.room.join <- c
+	defer func() { h.room.leave <- c }()
+
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg, ok := <-c.receive:
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", msg.seq, msg.data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			// The comment keeps the proxies from closing the idle stream.
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case <-req.Context().Done():
+			return
+		}
+
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}